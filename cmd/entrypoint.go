@@ -87,8 +87,9 @@ func GenerateSpec(args arguments.CliArguments) error {
 	}
 
 	// Generate the spec
-	if err := swagen.GenerateAndOutputSpec(
-		&config.OpenAPIGeneratorConfig,
+	if err := swagen.GenerateAndOutputVersionedSpecs(
+		config.GetOpenAPIGeneratorConfig(),
+		config.GetVersioningConfig(),
 		meta.Flat,
 		&meta.Models,
 		meta.PlainErrorPresent,
@@ -131,8 +132,9 @@ func GenerateSpecAndRoutes(args arguments.CliArguments) error {
 	}
 
 	// Generate the spec
-	if err := swagen.GenerateAndOutputSpec(
-		&config.OpenAPIGeneratorConfig,
+	if err := swagen.GenerateAndOutputVersionedSpecs(
+		config.GetOpenAPIGeneratorConfig(),
+		config.GetVersioningConfig(),
 		meta.Flat,
 		&meta.Models,
 		meta.PlainErrorPresent,
@@ -6,6 +6,7 @@ package language
 // Local changes:
 //     * Slight modifications to terminology
 //     * Removed 'Match' method (not necessary for us)
+//     * Added 'Closest' method (returns the best match rather than the first)
 
 /*
 	MIT License
@@ -116,3 +117,32 @@ func DidYouMean(key string, list []string) (result string) {
 	}
 	return
 }
+
+// Closest returns the option with the smallest edit distance from the given key.
+//
+// Unlike DidYouMean, all options are considered and the first best match is returned.
+// ThresholdRate and CaseInsensitive are honored in the same manner
+func Closest(key string, list []string) (result string) {
+	if len(key) == 0 {
+		return
+	}
+	if CaseInsensitive {
+		key = strings.ToLower(key)
+	}
+	var winner int
+	if ThresholdRate > 0 {
+		winner = int(ThresholdRate * float64(len(key)))
+	}
+	best := -1
+	for _, str := range list {
+		distance := findEditDistance(key, str)
+		if winner > 0 && distance > winner {
+			continue
+		}
+		if best < 0 || distance < best {
+			best = distance
+			result = str
+		}
+	}
+	return
+}
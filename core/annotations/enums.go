@@ -4,26 +4,55 @@ const (
 	PropertyName            = "name"
 	PropertySecurityScopes  = "scopes"
 	PropertyValidatorString = "validate"
+	PropertyUrl             = "url"
+	PropertyDescription     = "description"
+	PropertyExternalDocs    = "externalDocs"
+	PropertyType            = "type"
+	PropertyStatusCodes     = "statusCodes"
+	PropertyValue           = "value"
+	PropertyParam           = "param"
+	PropertySecurities      = "securities"
+	PropertyRelation        = "relation"
+	PropertyRequests        = "requests"
+	PropertyPer             = "per"
+	PropertyKey             = "key"
+	PropertyTtl             = "ttl"
+	PropertyOperations      = "operations"
 )
 
 type GleeceAnnotation = string
 
 const (
-	GleeceAnnotationTag             GleeceAnnotation = "Tag"
-	GleeceAnnotationQuery           GleeceAnnotation = "Query"
-	GleeceAnnotationPath            GleeceAnnotation = "Path"
-	GleeceAnnotationBody            GleeceAnnotation = "Body"
-	GleeceAnnotationHeader          GleeceAnnotation = "Header"
-	GleeceAnnotationFormField       GleeceAnnotation = "FormField"
-	GleeceAnnotationDeprecated      GleeceAnnotation = "Deprecated"
-	GleeceAnnotationHidden          GleeceAnnotation = "Hidden"
-	GleeceAnnotationSecurity        GleeceAnnotation = "Security"
-	GleeceAnnotationRoute           GleeceAnnotation = "Route"
-	GleeceAnnotationResponse        GleeceAnnotation = "Response"
-	GleeceAnnotationDescription     GleeceAnnotation = "Description"
-	GleeceAnnotationMethod          GleeceAnnotation = "Method"
-	GleeceAnnotationErrorResponse   GleeceAnnotation = "ErrorResponse"
-	GleeceAnnotationTemplateContext GleeceAnnotation = "TemplateContext"
+	GleeceAnnotationTag              GleeceAnnotation = "Tag"
+	GleeceAnnotationQuery            GleeceAnnotation = "Query"
+	GleeceAnnotationPath             GleeceAnnotation = "Path"
+	GleeceAnnotationBody             GleeceAnnotation = "Body"
+	GleeceAnnotationHeader           GleeceAnnotation = "Header"
+	GleeceAnnotationFormField        GleeceAnnotation = "FormField"
+	GleeceAnnotationPrincipal        GleeceAnnotation = "Principal"
+	GleeceAnnotationDeprecated       GleeceAnnotation = "Deprecated"
+	GleeceAnnotationHidden           GleeceAnnotation = "Hidden"
+	GleeceAnnotationSecurity         GleeceAnnotation = "Security"
+	GleeceAnnotationAdvancedSecurity GleeceAnnotation = "AdvancedSecurity"
+	GleeceAnnotationPublic           GleeceAnnotation = "Public"
+	GleeceAnnotationRoute            GleeceAnnotation = "Route"
+	GleeceAnnotationResponse         GleeceAnnotation = "Response"
+	GleeceAnnotationDescription      GleeceAnnotation = "Description"
+	GleeceAnnotationMethod           GleeceAnnotation = "Method"
+	GleeceAnnotationErrorResponse    GleeceAnnotation = "ErrorResponse"
+	GleeceAnnotationTemplateContext  GleeceAnnotation = "TemplateContext"
+	GleeceAnnotationServer           GleeceAnnotation = "Server"
+	GleeceAnnotationResponseHeader   GleeceAnnotation = "ResponseHeader"
+	GleeceAnnotationExtension        GleeceAnnotation = "Extension"
+	GleeceAnnotationWebhook          GleeceAnnotation = "Webhook"
+	GleeceAnnotationMiddleware       GleeceAnnotation = "Middleware"
+	GleeceAnnotationVersion          GleeceAnnotation = "Version"
+	GleeceAnnotationSince            GleeceAnnotation = "Since"
+	GleeceAnnotationUntil            GleeceAnnotation = "Until"
+	GleeceAnnotationRateLimit        GleeceAnnotation = "RateLimit"
+	GleeceAnnotationTimeout          GleeceAnnotation = "Timeout"
+	GleeceAnnotationMaxBodySize      GleeceAnnotation = "MaxBodySize"
+	GleeceAnnotationIdempotent       GleeceAnnotation = "Idempotent"
 )
 
 type CommentSource string
@@ -27,8 +27,13 @@ type AnnotationHolder struct {
 	docRange common.ResolvedRange
 }
 
-// Captures: 1. TEXT (after @), 2. TEXT (inside parentheses), 3. JSON5 Object, 4. Remaining TEXT
-var parsingRegex *regexp.Regexp = regexp.MustCompile(`^// @(\w+)(?:(?:\(([\w-_/\\{} ]+))(?:\s*,\s*(\{.*\}))?\))?(?:\s+(.+))?$`)
+// Captures: 1. TEXT (after @), 2. TEXT (inside parentheses), 3. JSON5 Object (following the text),
+// 4. JSON5 Object (sole content of the parentheses), 5. Remaining TEXT
+//
+// The text inside the parentheses may be a comma separated list, e.g. '@Version(1, 2)'.
+// Whether an annotation accepts a list is left for the validators to decide.
+// Annotations without a value may hold a properties object alone, e.g. '@RateLimit({ requests: 100, per: "1m" })'
+var parsingRegex *regexp.Regexp = regexp.MustCompile(`^// @(\w+)(?:\((?:([\w-_/\\{} ]+(?:\s*,\s*[\w-_/\\ ]+)*)(?:\s*,\s*(\{.*\}))?|(\{.*\}))\))?(?:\s+(.+))?$`)
 
 // NewAnnotationHolder creates a holder from CommentNode entries (with positions).
 func NewAnnotationHolder(commentBlock gast.CommentBlock, source CommentSource) (AnnotationHolder, error) {
@@ -215,10 +220,10 @@ func parseCommentNode(parsingRegex *regexp.Regexp, comment gast.CommentNode) (At
 		return Attribute{}, false, nil
 	}
 
-	name, _ := getGroupString(comment.Text, matchIndices, 1)                 // Attr. name
-	value, _ := getGroupString(comment.Text, matchIndices, 2)                // Attr. Value (inside parentheses)
-	jsonConfig, jsonPresent := getGroupString(comment.Text, matchIndices, 3) // Optional JSON5 props object
-	description, _ := getGroupString(comment.Text, matchIndices, 4)          // Optional description
+	name, _ := getGroupString(comment.Text, matchIndices, 1)                                                // Attr. name
+	value, _ := getGroupString(comment.Text, matchIndices, 2)                                               // Attr. Value (inside parentheses)
+	jsonConfig, jsonPresent := getGroupString(comment.Text, matchIndices, getPropertiesGroup(matchIndices)) // Optional JSON5 props object
+	description, _ := getGroupString(comment.Text, matchIndices, 5)                                         // Optional description
 
 	var parsedProps map[string]any
 	if jsonPresent && jsonConfig != "" {
@@ -238,10 +243,10 @@ func parseCommentNode(parsingRegex *regexp.Regexp, comment gast.CommentNode) (At
 }
 
 func getPropertiesRange(comment gast.CommentNode, matchIndices []int) common.ResolvedRange {
-	// if group 3 exists, compute its absolute ResolvedRange
+	// if the properties group exists, compute its absolute ResolvedRange
 
 	propsRange := common.ResolvedRange{}
-	if startByte, endByte, ok := getGroupOffsets(matchIndices, 3); ok {
+	if startByte, endByte, ok := getGroupOffsets(matchIndices, getPropertiesGroup(matchIndices)); ok {
 		startLine, startCol := byteOffsetToLineCol(comment.Text, startByte, comment.Position.StartLine, comment.Position.StartCol)
 		endLine, endCol := byteOffsetToLineCol(comment.Text, endByte, comment.Position.StartLine, comment.Position.StartCol)
 
@@ -257,6 +262,15 @@ func getPropertiesRange(comment gast.CommentNode, matchIndices []int) common.Res
 
 }
 
+// helper: return the index of the group holding the JSON5 props object - the one following a value, if matched,
+// or the one standing alone in the parentheses
+func getPropertiesGroup(matchIndices []int) int {
+	if _, _, ok := getGroupOffsets(matchIndices, 3); ok {
+		return 3
+	}
+	return 4
+}
+
 // helper: return start,end and ok for group (1-based group index)
 func getGroupOffsets(matchIndices []int, group int) (startByte, endByte int, ok bool) {
 	pairIndex := 2 * group
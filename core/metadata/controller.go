@@ -18,33 +18,92 @@ func (m ControllerMeta) Reduce(ctx ReductionContext) (definitions.ControllerMeta
 		return definitions.ControllerMetadata{}, err
 	}
 
-	// If there are no explicitly defined securities, check for inherited ones
-	if len(security) <= 0 {
+	isPublic := m.Struct.Annotations.Has(annotations.GleeceAnnotationPublic)
+
+	// If there are no explicitly defined securities, check for inherited ones.
+	// Public controllers do not inherit the default security
+	if len(security) <= 0 && !isPublic {
 		logger.Debug("Controller %s does not have explicit security; Using user-defined defaults", m.Struct.Name)
 		security = GetDefaultSecurity(ctx.GleeceConfig)
 	}
 
-	var reducedReceivers []definitions.RouteMetadata
-	for _, rec := range m.Receivers {
-		reduced, err := rec.Reduce(ctx, security)
-		if err != nil {
-			logger.Error("Failed to reduce receiver '%s' of controller '%s' - %w", rec.Name, m.Struct.Name, err)
-			return definitions.ControllerMetadata{}, err
-		}
-		reducedReceivers = append(reducedReceivers, reduced)
+	tags, err := GetTags(m.Struct.Annotations)
+	if err != nil {
+		return definitions.ControllerMetadata{}, err
+	}
+
+	servers, err := GetServers(m.Struct.Annotations, ctx.GleeceConfig)
+	if err != nil {
+		return definitions.ControllerMetadata{}, err
+	}
+
+	responseHeaders, err := GetResponseHeaders(m.Struct.Annotations)
+	if err != nil {
+		return definitions.ControllerMetadata{}, err
+	}
+
+	extensions, err := GetExtensions(m.Struct.Annotations, "")
+	if err != nil {
+		return definitions.ControllerMetadata{}, err
+	}
+
+	middlewares, err := GetMiddlewares(m.Struct.Annotations)
+	if err != nil {
+		return definitions.ControllerMetadata{}, err
+	}
+
+	versions, err := GetVersions(m.Struct.Annotations, ctx.GleeceConfig)
+	if err != nil {
+		return definitions.ControllerMetadata{}, err
+	}
+
+	rateLimit, err := GetRateLimit(m.Struct.Annotations)
+	if err != nil {
+		return definitions.ControllerMetadata{}, err
+	}
+
+	timeout, err := GetTimeout(m.Struct.Annotations, ctx.GleeceConfig)
+	if err != nil {
+		return definitions.ControllerMetadata{}, err
+	}
+
+	maxBodySize, err := GetMaxBodySize(m.Struct.Annotations, ctx.GleeceConfig)
+	if err != nil {
+		return definitions.ControllerMetadata{}, err
 	}
 
 	meta := definitions.ControllerMetadata{
 		Name:        m.Struct.Name,
 		PkgPath:     m.Struct.PkgPath,
 		Tag:         annotations.GetTag(m.Struct.Annotations),
+		Tags:        tags,
 		Description: m.Struct.Annotations.GetDescription(),
 		RestMetadata: definitions.RestMetadata{
 			Path: m.Struct.Annotations.GetFirstValueOrEmpty(annotations.GleeceAnnotationRoute),
 		},
-		Routes:   reducedReceivers,
-		Security: security,
+		Security:        security,
+		IsPublic:        isPublic,
+		ResponseHeaders: responseHeaders,
+		Servers:         servers,
+		Extensions:      extensions,
+		Middlewares:     middlewares,
+		Versions:        versions,
+		RateLimit:       rateLimit,
+		Timeout:         timeout,
+		MaxBodySize:     maxBodySize,
+	}
+
+	// Receivers inherit controller-level settings so they're reduced against the (route-less) controller metadata
+	var reducedReceivers []definitions.RouteMetadata
+	for _, rec := range m.Receivers {
+		reduced, err := rec.Reduce(ctx, meta)
+		if err != nil {
+			logger.Error("Failed to reduce receiver '%s' of controller '%s' - %w", rec.Name, m.Struct.Name, err)
+			return definitions.ControllerMetadata{}, err
+		}
+		reducedReceivers = append(reducedReceivers, reduced)
 	}
 
+	meta.Routes = reducedReceivers
 	return meta, nil
 }
@@ -28,6 +28,11 @@ func (f FieldMeta) Reduce(_ ReductionContext) (definitions.FieldMetadata, error)
 		tag = strings.Trim(fieldNode.Tag.Value, "`")
 	}
 
+	extensions, err := GetExtensions(f.Annotations, "")
+	if err != nil {
+		return definitions.FieldMetadata{}, fmt.Errorf("failed to obtain extensions for field '%s' - %v", f.Name, err)
+	}
+
 	return definitions.FieldMetadata{
 		Name:        f.Name,
 		Type:        f.Type.Root.SimpleTypeString(),
@@ -35,6 +40,7 @@ func (f FieldMeta) Reduce(_ ReductionContext) (definitions.FieldMetadata, error)
 		Tag:         tag,
 		IsEmbedded:  f.IsEmbedded,
 		Deprecation: common.Ptr(GetDeprecationOpts(f.Annotations)),
+		Extensions:  extensions,
 	}, nil
 }
 
@@ -2,7 +2,12 @@ package metadata
 
 import (
 	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	MapSet "github.com/deckarep/golang-set/v2"
 	"github.com/gopher-fleece/gleece/v2/core/annotations"
@@ -54,11 +59,16 @@ func GetDeprecationOpts(holder *annotations.AnnotationHolder) definitions.Deprec
 	}
 }
 
+const (
+	securityRelationAnd = "AND"
+	securityRelationOr  = "OR"
+)
+
 // GetSecurityFromContext Creates an array of RouteSecurity out of the given holder's attributes
 func GetSecurityFromContext(holder *annotations.AnnotationHolder) ([]definitions.RouteSecurity, error) {
 	securities := []definitions.RouteSecurity{}
 
-	// Process @Security annotations. In the future, we'll support @AdvancedSecurity
+	// Process @Security annotations. Each annotation is an alternative to the others
 	normalSec := holder.GetAll(annotations.GleeceAnnotationSecurity)
 	if len(normalSec) > 0 {
 		for _, secAttrib := range normalSec {
@@ -86,11 +96,135 @@ func GetSecurityFromContext(holder *annotations.AnnotationHolder) ([]definitions
 		}
 	}
 
-	// AdvanceSecurity processing goes here
+	// Process @AdvancedSecurity annotations. Each expression adds its alternatives to those of the plain @Security annotations
+	for _, advSecAttrib := range holder.GetAll(annotations.GleeceAnnotationAdvancedSecurity) {
+		advancedSecurities, err := GetAdvancedSecurity(advSecAttrib)
+		if err != nil {
+			return securities, err
+		}
+		securities = append(securities, advancedSecurities...)
+	}
 
 	return securities, nil
 }
 
+// GetAdvancedSecurity Expands the given @AdvancedSecurity attribute's AND/OR expression into its equivalent list of
+// alternative RouteSecurity entries, each requiring all of its security schemas.
+//
+// For example, @AdvancedSecurity(OR, { securities: [{ relation: "AND", securities: [{ name: "apiKey" }, { name: "mTLS" }] }, { name: "oauth2", scopes: ["admin"] }] })
+// yields the alternatives (apiKey AND mTLS) and (oauth2[admin])
+func GetAdvancedSecurity(attr *annotations.Attribute) ([]definitions.RouteSecurity, error) {
+	alternatives, err := expandSecurityExpression(attr.Value, attr.GetProperty(annotations.PropertySecurities))
+	if err != nil {
+		return []definitions.RouteSecurity{}, err
+	}
+
+	securities := []definitions.RouteSecurity{}
+	for _, alternative := range alternatives {
+		securities = append(securities, definitions.RouteSecurity{SecurityAnnotation: alternative})
+	}
+	return securities, nil
+}
+
+// expandSecurityExpression recursively expands the given relation and its operands into a list of alternatives (OR)
+// of security schemas which must all be satisfied (AND)
+func expandSecurityExpression(relation string, operands *any) ([][]definitions.SecurityAnnotationComponent, error) {
+	if relation != securityRelationAnd && relation != securityRelationOr {
+		return nil, fmt.Errorf(
+			"invalid security relation '%s' - expected '%s' or '%s'",
+			relation,
+			securityRelationAnd,
+			securityRelationOr,
+		)
+	}
+
+	var operandList []any
+	if operands != nil {
+		operandList, _ = (*operands).([]any)
+	}
+
+	if len(operandList) == 0 {
+		return nil, fmt.Errorf("a security expression's '%s' property must be a non-empty array", annotations.PropertySecurities)
+	}
+
+	// An AND expression starts off with a single, empty alternative which is then multiplied by each operand's alternatives
+	alternatives := [][]definitions.SecurityAnnotationComponent{}
+	if relation == securityRelationAnd {
+		alternatives = append(alternatives, []definitions.SecurityAnnotationComponent{})
+	}
+
+	for index, operand := range operandList {
+		operandAlternatives, err := expandSecurityOperand(operand)
+		if err != nil {
+			return nil, fmt.Errorf("invalid security expression operand at index %d - %w", index, err)
+		}
+
+		if relation == securityRelationOr {
+			alternatives = append(alternatives, operandAlternatives...)
+			continue
+		}
+
+		product := [][]definitions.SecurityAnnotationComponent{}
+		for _, alternative := range alternatives {
+			for _, operandAlternative := range operandAlternatives {
+				product = append(product, append(slices.Clone(alternative), operandAlternative...))
+			}
+		}
+		alternatives = product
+	}
+
+	return alternatives, nil
+}
+
+// expandSecurityOperand expands a single operand of a security expression - either a nested { relation, securities }
+// expression or a { name, scopes } security schema reference
+func expandSecurityOperand(operand any) ([][]definitions.SecurityAnnotationComponent, error) {
+	operandObj, isObject := operand.(map[string]any)
+	if !isObject {
+		return nil, fmt.Errorf("expected an object but got '%v'", operand)
+	}
+
+	if relationValue, isNested := operandObj[annotations.PropertyRelation]; isNested {
+		relation, _ := relationValue.(string)
+		securities := operandObj[annotations.PropertySecurities]
+		return expandSecurityExpression(relation, &securities)
+	}
+
+	schemaName, _ := operandObj[annotations.PropertyName].(string)
+	if len(schemaName) <= 0 {
+		return nil, fmt.Errorf("a security schema's name cannot be empty")
+	}
+
+	scopes := []string{}
+	if definedScopes, hasScopes := operandObj[annotations.PropertySecurityScopes]; hasScopes {
+		scopeList, isList := definedScopes.([]any)
+		if !isList {
+			return nil, fmt.Errorf("the scopes of security schema '%s' must be an array of strings", schemaName)
+		}
+
+		for _, scope := range scopeList {
+			scopeStr, isString := scope.(string)
+			if !isString {
+				return nil, fmt.Errorf("the scopes of security schema '%s' must be an array of strings", schemaName)
+			}
+			scopes = append(scopes, scopeStr)
+		}
+	}
+
+	return [][]definitions.SecurityAnnotationComponent{{{SchemaName: schemaName, Scopes: scopes}}}, nil
+}
+
+// IsConfiguredSecuritySchema Returns whether a security schema with the given name is defined in the Gleece configuration
+func IsConfiguredSecuritySchema(config *definitions.GleeceConfig, name string) bool {
+	if config == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(config.OpenAPIGeneratorConfig.SecuritySchemes, func(schema definitions.SecuritySchemeConfig) bool {
+		return schema.SecurityName == name
+	})
+}
+
 func GetRouteSecurityWithInheritance(
 	receiverAnnotations *annotations.AnnotationHolder,
 	parentSecurity []definitions.RouteSecurity,
@@ -107,6 +241,161 @@ func GetRouteSecurityWithInheritance(
 	return parentSecurity, nil
 }
 
+// IsPublicRoute returns a boolean indicating whether a route is exempt from security.
+//
+// A route is public if it has a @Public annotation or if its controller is public and the route
+// does not declare any security of its own
+func IsPublicRoute(receiverAnnotations *annotations.AnnotationHolder, isParentPublic bool) bool {
+	if receiverAnnotations.Has(annotations.GleeceAnnotationPublic) {
+		return true
+	}
+
+	hasExplicitSecurity := receiverAnnotations.Has(annotations.GleeceAnnotationSecurity) ||
+		receiverAnnotations.Has(annotations.GleeceAnnotationAdvancedSecurity)
+
+	return isParentPublic && !hasExplicitSecurity
+}
+
+// GetTags Creates an array of OpenAPITag out of the given holder's @Tag attributes, in order of declaration
+func GetTags(holder *annotations.AnnotationHolder) ([]definitions.OpenAPITag, error) {
+	tags := []definitions.OpenAPITag{}
+	if holder == nil {
+		return tags, nil
+	}
+
+	encounteredNames := MapSet.NewSet[string]()
+	for _, attr := range holder.GetAll(annotations.GleeceAnnotationTag) {
+		if len(attr.Value) <= 0 {
+			return tags, fmt.Errorf("a tag's name cannot be empty")
+		}
+
+		if encounteredNames.ContainsOne(attr.Value) {
+			return tags, fmt.Errorf("duplicate tag '%s'", attr.Value)
+		}
+		encounteredNames.Add(attr.Value)
+
+		externalDocs, err := getExternalDocs(attr)
+		if err != nil {
+			return tags, err
+		}
+
+		tags = append(tags, definitions.OpenAPITag{
+			Name:         attr.Value,
+			Description:  attr.Description,
+			ExternalDocs: externalDocs,
+		})
+	}
+
+	return tags, nil
+}
+
+func getExternalDocs(attr *annotations.Attribute) (*definitions.OpenAPIExternalDocs, error) {
+	docs, err := annotations.GetCastProperty[map[string]any](attr, annotations.PropertyExternalDocs)
+	if err != nil || docs == nil {
+		return nil, err
+	}
+
+	url, isString := (*docs)[annotations.PropertyUrl].(string)
+	if !isString || len(url) <= 0 {
+		return nil, fmt.Errorf("property '%s' of @%s(%s) must have a '%s' string", annotations.PropertyExternalDocs, attr.Name, attr.Value, annotations.PropertyUrl)
+	}
+
+	description, _ := (*docs)[annotations.PropertyDescription].(string)
+	return &definitions.OpenAPIExternalDocs{URL: url, Description: description}, nil
+}
+
+// GetRouteTagsWithInheritance Returns the route's explicit tags or, if there are none, the parent controller's
+func GetRouteTagsWithInheritance(
+	receiverAnnotations *annotations.AnnotationHolder,
+	parentTags []definitions.OpenAPITag,
+) ([]definitions.OpenAPITag, error) {
+	explicitTags, err := GetTags(receiverAnnotations)
+	if err != nil {
+		return []definitions.OpenAPITag{}, err
+	}
+
+	if len(explicitTags) > 0 {
+		return explicitTags, nil
+	}
+
+	return parentTags, nil
+}
+
+// GetServers Creates an array of OpenAPIServer out of the given holder's @Server attributes.
+//
+// A @Server attribute either references a server defined in the Gleece configuration by name or,
+// if a 'url' property is given, defines an ad-hoc server.
+// The routes' base path, if configured, is appended to the servers' URLs
+func GetServers(holder *annotations.AnnotationHolder, config *definitions.GleeceConfig) ([]definitions.OpenAPIServer, error) {
+	servers := []definitions.OpenAPIServer{}
+	if holder == nil {
+		return servers, nil
+	}
+
+	for _, attr := range holder.GetAll(annotations.GleeceAnnotationServer) {
+		if len(attr.Value) <= 0 {
+			return servers, fmt.Errorf("a server's name cannot be empty")
+		}
+
+		url, err := annotations.GetCastProperty[string](attr, annotations.PropertyUrl)
+		if err != nil {
+			return servers, err
+		}
+
+		if url != nil && len(*url) > 0 {
+			servers = append(servers, definitions.OpenAPIServer{
+				Name:        attr.Value,
+				URL:         config.WithRoutesBasePath(*url),
+				Description: attr.Description,
+			})
+			continue
+		}
+
+		server := FindConfiguredServer(config, attr.Value)
+		if server == nil {
+			return servers, fmt.Errorf("server '%s' is not defined in the Gleece configuration", attr.Value)
+		}
+
+		server.URL = config.WithRoutesBasePath(server.URL)
+		servers = append(servers, *server)
+	}
+
+	return servers, nil
+}
+
+// FindConfiguredServer Returns the server with the given name from the Gleece configuration or nil if no such server exists
+func FindConfiguredServer(config *definitions.GleeceConfig, name string) *definitions.OpenAPIServer {
+	if config == nil {
+		return nil
+	}
+
+	for _, server := range config.OpenAPIGeneratorConfig.Servers {
+		if server.Name == name {
+			return &server
+		}
+	}
+
+	return nil
+}
+
+// GetRouteServersWithInheritance Returns the route's explicit servers or, if there are none, the parent controller's
+func GetRouteServersWithInheritance(
+	receiverAnnotations *annotations.AnnotationHolder,
+	config *definitions.GleeceConfig,
+	parentServers []definitions.OpenAPIServer,
+) ([]definitions.OpenAPIServer, error) {
+	explicitServers, err := GetServers(receiverAnnotations, config)
+	if err != nil {
+		return []definitions.OpenAPIServer{}, err
+	}
+
+	if len(explicitServers) > 0 {
+		return explicitServers, nil
+	}
+
+	return parentServers, nil
+}
+
 func GetTemplateContextMetadata(attributes *annotations.AnnotationHolder) (map[string]definitions.TemplateContext, error) {
 	customAttributes := attributes.GetAll(annotations.GleeceAnnotationTemplateContext)
 
@@ -184,6 +473,8 @@ func GetParamPassedIn(
 		// Currently, form fields are the only supported form of form parameters,
 		// in the future, a full form object may be supported too
 		return definitions.PassedInForm, nil
+	case "principal":
+		return definitions.PassedInPrincipal, nil
 	default:
 		return definitions.PassedInHeader,
 			fmt.Errorf(
@@ -291,6 +582,572 @@ func GetErrorResponses(routeAnnotations *annotations.AnnotationHolder) ([]defini
 	return responses, nil
 }
 
+// GetResponseHeaders Creates an array of ResponseHeader out of the given holder's @ResponseHeader attributes
+func GetResponseHeaders(holder *annotations.AnnotationHolder) ([]definitions.ResponseHeader, error) {
+	headers := []definitions.ResponseHeader{}
+	if holder == nil {
+		return headers, nil
+	}
+
+	for _, attr := range holder.GetAll(annotations.GleeceAnnotationResponseHeader) {
+		if len(attr.Value) <= 0 {
+			return headers, fmt.Errorf("a response header's name cannot be empty")
+		}
+
+		headerType, err := annotations.GetCastProperty[string](attr, annotations.PropertyType)
+		if err != nil {
+			return headers, err
+		}
+
+		description, err := annotations.GetCastProperty[string](attr, annotations.PropertyDescription)
+		if err != nil {
+			return headers, err
+		}
+
+		rawCodes, err := annotations.GetCastProperty[[]float64](attr, annotations.PropertyStatusCodes)
+		if err != nil {
+			return headers, err
+		}
+
+		header := definitions.ResponseHeader{
+			Name:        attr.Value,
+			Type:        "string",
+			Description: attr.Description,
+			StatusCodes: []runtime.HttpStatusCode{},
+		}
+
+		if headerType != nil && len(*headerType) > 0 {
+			header.Type = *headerType
+		}
+
+		// An explicit 'description' property takes precedence over the annotation's trailing text
+		if description != nil && len(*description) > 0 {
+			header.Description = *description
+		}
+
+		if rawCodes != nil {
+			for _, rawCode := range *rawCodes {
+				code, err := definitions.ConvertToHttpStatus(fmt.Sprint(rawCode))
+				if err != nil {
+					return headers, err
+				}
+				header.StatusCodes = append(header.StatusCodes, code)
+			}
+		}
+
+		headers = append(headers, header)
+	}
+
+	return headers, nil
+}
+
+// GetRouteResponseHeadersWithInheritance Returns the parent controller's response headers merged with the route's.
+//
+// Route-level headers override controller-level ones with the same (case-insensitive) name
+func GetRouteResponseHeadersWithInheritance(
+	receiverAnnotations *annotations.AnnotationHolder,
+	parentHeaders []definitions.ResponseHeader,
+) ([]definitions.ResponseHeader, error) {
+	explicitHeaders, err := GetResponseHeaders(receiverAnnotations)
+	if err != nil {
+		return []definitions.ResponseHeader{}, err
+	}
+
+	headers := append([]definitions.ResponseHeader{}, parentHeaders...)
+	for _, header := range explicitHeaders {
+		existingIndex := slices.IndexFunc(headers, func(h definitions.ResponseHeader) bool {
+			return strings.EqualFold(h.Name, header.Name)
+		})
+
+		if existingIndex >= 0 {
+			headers[existingIndex] = header
+		} else {
+			headers = append(headers, header)
+		}
+	}
+
+	return headers, nil
+}
+
+// IsValidExtensionName Returns a boolean indicating whether the given name is a valid OpenAPI vendor extension name
+func IsValidExtensionName(name string) bool {
+	return strings.HasPrefix(name, "x-") && len(name) > len("x-")
+}
+
+// GetExtensions Creates a map of OpenAPI vendor extensions out of the given holder's @Extension attributes.
+//
+// If paramName is empty, only extensions without a 'param' property are returned.
+// Otherwise, only extensions targeting the given parameter are returned.
+//
+// Returns a nil map if there are no relevant extensions
+func GetExtensions(holder *annotations.AnnotationHolder, paramName string) (map[string]any, error) {
+	var extensions map[string]any
+	if holder == nil {
+		return extensions, nil
+	}
+
+	for _, attr := range holder.GetAll(annotations.GleeceAnnotationExtension) {
+		if !IsValidExtensionName(attr.Value) {
+			return extensions, fmt.Errorf("extension '%s' is invalid - extension names must begin with 'x-'", attr.Value)
+		}
+
+		targetParam, err := annotations.GetCastProperty[string](attr, annotations.PropertyParam)
+		if err != nil {
+			return extensions, err
+		}
+
+		target := ""
+		if targetParam != nil {
+			target = *targetParam
+		}
+
+		if target != paramName {
+			continue
+		}
+
+		if _, exists := extensions[attr.Value]; exists {
+			return extensions, fmt.Errorf("extension '%s' is defined more than once", attr.Value)
+		}
+
+		value := attr.GetProperty(annotations.PropertyValue)
+		if value == nil {
+			return extensions, fmt.Errorf("extension '%s' does not specify a '%s' property", attr.Value, annotations.PropertyValue)
+		}
+
+		if extensions == nil {
+			extensions = map[string]any{}
+		}
+		extensions[attr.Value] = *value
+	}
+
+	return extensions, nil
+}
+
+// GetRouteExtensionsWithInheritance Returns the parent controller's extensions merged with the route's.
+//
+// Route-level extensions override controller-level ones with the same name
+func GetRouteExtensionsWithInheritance(
+	receiverAnnotations *annotations.AnnotationHolder,
+	parentExtensions map[string]any,
+) (map[string]any, error) {
+	explicitExtensions, err := GetExtensions(receiverAnnotations, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parentExtensions) == 0 {
+		return explicitExtensions, nil
+	}
+
+	extensions := maps.Clone(parentExtensions)
+	maps.Copy(extensions, explicitExtensions)
+	return extensions, nil
+}
+
+// GetMiddlewares Returns the names of the given holder's @Middleware attributes, in order of declaration
+func GetMiddlewares(holder *annotations.AnnotationHolder) ([]string, error) {
+	middlewares := []string{}
+	if holder == nil {
+		return middlewares, nil
+	}
+
+	for _, attr := range holder.GetAll(annotations.GleeceAnnotationMiddleware) {
+		if len(attr.Value) <= 0 {
+			return middlewares, fmt.Errorf("a middleware's name cannot be empty")
+		}
+		middlewares = append(middlewares, attr.Value)
+	}
+
+	return middlewares, nil
+}
+
+// GetRouteMiddlewaresWithInheritance Returns the parent controller's middlewares followed by the route's.
+//
+// Middlewares referenced more than once are invoked only once, at their first position
+func GetRouteMiddlewaresWithInheritance(
+	receiverAnnotations *annotations.AnnotationHolder,
+	parentMiddlewares []string,
+) ([]string, error) {
+	explicitMiddlewares, err := GetMiddlewares(receiverAnnotations)
+	if err != nil {
+		return []string{}, err
+	}
+
+	middlewares := []string{}
+	for _, name := range slices.Concat(parentMiddlewares, explicitMiddlewares) {
+		if !slices.Contains(middlewares, name) {
+			middlewares = append(middlewares, name)
+		}
+	}
+
+	return middlewares, nil
+}
+
+// ParseVersionList parses the comma separated API versions of a @Version annotation's value, e.g. '1, 2'.
+//
+// Returns the unique versions in ascending order
+func ParseVersionList(value string) ([]int, error) {
+	versions := []int{}
+	for _, segment := range strings.Split(value, ",") {
+		segment = strings.TrimSpace(segment)
+		version, err := strconv.Atoi(segment)
+		if err != nil || version <= 0 {
+			return nil, fmt.Errorf("'%s' is not a valid API version - versions must be positive integers", segment)
+		}
+
+		if !slices.Contains(versions, version) {
+			versions = append(versions, version)
+		}
+	}
+
+	slices.Sort(versions)
+	return versions, nil
+}
+
+// ParseVersion parses the single API version of a @Since or @Until annotation's value
+func ParseVersion(value string) (int, error) {
+	versions, err := ParseVersionList(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(versions) != 1 {
+		return 0, fmt.Errorf("expected a single API version but got '%s'", value)
+	}
+
+	return versions[0], nil
+}
+
+// HasVersioningAnnotations returns whether the given holder has any of the @Version, @Since or @Until annotations
+func HasVersioningAnnotations(holder *annotations.AnnotationHolder) bool {
+	return holder != nil && (holder.Has(annotations.GleeceAnnotationVersion) ||
+		holder.Has(annotations.GleeceAnnotationSince) ||
+		holder.Has(annotations.GleeceAnnotationUntil))
+}
+
+// GetVersions Returns the API versions in which a controller's operations are served, per its @Version, @Since and @Until annotations.
+//
+// Returns nil if the controller is unversioned
+func GetVersions(holder *annotations.AnnotationHolder, config *definitions.GleeceConfig) ([]int, error) {
+	return GetRouteVersionsWithInheritance(holder, config, nil)
+}
+
+// GetRouteVersionsWithInheritance Returns the API versions in which an operation is served.
+//
+// Versions given via @Version take precedence over the parent controller's which, in turn, take precedence over the configured versions.
+// These are then narrowed down to the inclusive range given via @Since and @Until.
+// Operations without any versioning annotations inherit the parent controller's versions as-is
+func GetRouteVersionsWithInheritance(
+	receiverAnnotations *annotations.AnnotationHolder,
+	config *definitions.GleeceConfig,
+	parentVersions []int,
+) ([]int, error) {
+	if !HasVersioningAnnotations(receiverAnnotations) {
+		return parentVersions, nil
+	}
+
+	var versions []int
+	switch {
+	case receiverAnnotations.Has(annotations.GleeceAnnotationVersion):
+		explicitVersions, err := ParseVersionList(receiverAnnotations.GetFirstValueOrEmpty(annotations.GleeceAnnotationVersion))
+		if err != nil {
+			return nil, err
+		}
+		versions = explicitVersions
+	case parentVersions != nil:
+		versions = slices.Clone(parentVersions)
+	case config != nil && config.Versioning != nil:
+		versions = config.GetVersioningConfig().Versions
+	default:
+		return nil, fmt.Errorf("the @Since and @Until annotations require API versions to be configured")
+	}
+
+	if receiverAnnotations.Has(annotations.GleeceAnnotationSince) {
+		since, err := ParseVersion(receiverAnnotations.GetFirstValueOrEmpty(annotations.GleeceAnnotationSince))
+		if err != nil {
+			return nil, err
+		}
+		versions = slices.DeleteFunc(versions, func(version int) bool { return version < since })
+	}
+
+	if receiverAnnotations.Has(annotations.GleeceAnnotationUntil) {
+		until, err := ParseVersion(receiverAnnotations.GetFirstValueOrEmpty(annotations.GleeceAnnotationUntil))
+		if err != nil {
+			return nil, err
+		}
+		versions = slices.DeleteFunc(versions, func(version int) bool { return version > until })
+	}
+
+	return versions, nil
+}
+
+// ParseRateLimit Creates a RateLimit out of the given @RateLimit attribute's properties.
+//
+// The 'requests' property must be a positive integer, 'per' a positive Go duration (e.g. '1m') and 'key', if given,
+// one of 'ip', 'principal' or 'header:<Name>'
+func ParseRateLimit(attr *annotations.Attribute) (*definitions.RateLimit, error) {
+	requests, err := annotations.GetCastProperty[float64](attr, annotations.PropertyRequests)
+	if err != nil {
+		return nil, err
+	}
+
+	if requests == nil || *requests < 1 || *requests != math.Trunc(*requests) {
+		return nil, fmt.Errorf("property '%s' must be a positive integer", annotations.PropertyRequests)
+	}
+
+	per, err := annotations.GetCastProperty[string](attr, annotations.PropertyPer)
+	if err != nil {
+		return nil, err
+	}
+
+	if per == nil {
+		return nil, fmt.Errorf("property '%s' must be specified", annotations.PropertyPer)
+	}
+
+	if window, err := time.ParseDuration(*per); err != nil || window <= 0 {
+		return nil, fmt.Errorf("property '%s' must be a positive duration, e.g. '1m' or '30s', but got '%s'", annotations.PropertyPer, *per)
+	}
+
+	key, err := annotations.GetCastProperty[string](attr, annotations.PropertyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimit := &definitions.RateLimit{
+		Requests: int(*requests),
+		Per:      *per,
+		Key:      definitions.RateLimitKeyIp,
+	}
+
+	if key != nil {
+		rateLimit.Key = *key
+	}
+
+	headerName, isHeaderKey := strings.CutPrefix(rateLimit.Key, definitions.RateLimitKeyHeaderPrefix)
+	isValidKey := rateLimit.Key == definitions.RateLimitKeyIp ||
+		rateLimit.Key == definitions.RateLimitKeyPrincipal ||
+		(isHeaderKey && headerName != "")
+	if !isValidKey {
+		return nil, fmt.Errorf(
+			"property '%s' must be one of '%s', '%s' or '%s<Name>' but got '%s'",
+			annotations.PropertyKey,
+			definitions.RateLimitKeyIp,
+			definitions.RateLimitKeyPrincipal,
+			definitions.RateLimitKeyHeaderPrefix,
+			rateLimit.Key,
+		)
+	}
+
+	return rateLimit, nil
+}
+
+// GetRateLimit Creates a RateLimit out of the given holder's @RateLimit attribute.
+//
+// Returns nil if the holder has no @RateLimit attribute
+func GetRateLimit(holder *annotations.AnnotationHolder) (*definitions.RateLimit, error) {
+	if holder == nil {
+		return nil, nil
+	}
+
+	attr := holder.GetFirst(annotations.GleeceAnnotationRateLimit)
+	if attr == nil {
+		return nil, nil
+	}
+
+	return ParseRateLimit(attr)
+}
+
+// GetRouteRateLimitWithInheritance Returns the route's rate limit or, if the route has no @RateLimit annotation,
+// the parent controller's, marked as inherited
+func GetRouteRateLimitWithInheritance(
+	receiverAnnotations *annotations.AnnotationHolder,
+	parentRateLimit *definitions.RateLimit,
+) (*definitions.RateLimit, error) {
+	rateLimit, err := GetRateLimit(receiverAnnotations)
+	if err != nil || rateLimit != nil || parentRateLimit == nil {
+		return rateLimit, err
+	}
+
+	inherited := *parentRateLimit
+	inherited.IsInherited = true
+	return &inherited, nil
+}
+
+// The multipliers of the units accepted by ParseByteSize
+var byteSizeUnits = map[string]int64{
+	"":   1,
+	"B":  1,
+	"KB": 1 << 10,
+	"MB": 1 << 20,
+	"GB": 1 << 30,
+}
+
+// ParseTimeout Verifies the given value is a positive Go duration, e.g. '5s' or '500ms'
+func ParseTimeout(value string) (string, error) {
+	if timeout, err := time.ParseDuration(value); err != nil || timeout <= 0 {
+		return "", fmt.Errorf("a timeout must be a positive duration, e.g. '5s' or '500ms', but got '%s'", value)
+	}
+
+	return value, nil
+}
+
+// ParseByteSize Converts the given size, e.g. '512KB' or '1MB', to bytes.
+//
+// Sizes are given in bytes or using the 'B', 'KB', 'MB' or 'GB' (1024-based, case-insensitive) units
+func ParseByteSize(value string) (int64, error) {
+	trimmed := strings.TrimSpace(value)
+	digitsEnd := strings.IndexFunc(trimmed, func(r rune) bool { return r < '0' || r > '9' })
+	if digitsEnd < 0 {
+		digitsEnd = len(trimmed)
+	}
+
+	size, err := strconv.ParseInt(trimmed[:digitsEnd], 10, 64)
+	multiplier, isKnownUnit := byteSizeUnits[strings.ToUpper(strings.TrimSpace(trimmed[digitsEnd:]))]
+	if err != nil || !isKnownUnit || size <= 0 || size > math.MaxInt64/multiplier {
+		return 0, fmt.Errorf("a size must be a positive number of bytes, optionally followed by a 'KB', 'MB' or 'GB' unit, e.g. '1MB', but got '%s'", value)
+	}
+
+	return size * multiplier, nil
+}
+
+// GetTimeout Returns the value of the given holder's @Timeout attribute or, if there is none, the configured default timeout.
+//
+// Returns an empty string if neither is specified
+func GetTimeout(holder *annotations.AnnotationHolder, config *definitions.GleeceConfig) (string, error) {
+	if holder != nil && holder.Has(annotations.GleeceAnnotationTimeout) {
+		return ParseTimeout(holder.GetFirstValueOrEmpty(annotations.GleeceAnnotationTimeout))
+	}
+
+	if config == nil || config.RoutesConfig.DefaultTimeout == "" {
+		return "", nil
+	}
+
+	timeout, err := ParseTimeout(config.RoutesConfig.DefaultTimeout)
+	if err != nil {
+		return "", fmt.Errorf("invalid 'defaultTimeout' configuration - %w", err)
+	}
+	return timeout, nil
+}
+
+// GetRouteTimeoutWithInheritance Returns the route's timeout or, if the route has no @Timeout annotation, the parent controller's
+func GetRouteTimeoutWithInheritance(receiverAnnotations *annotations.AnnotationHolder, parentTimeout string) (string, error) {
+	if receiverAnnotations == nil || !receiverAnnotations.Has(annotations.GleeceAnnotationTimeout) {
+		return parentTimeout, nil
+	}
+
+	return ParseTimeout(receiverAnnotations.GetFirstValueOrEmpty(annotations.GleeceAnnotationTimeout))
+}
+
+// GetMaxBodySize Returns the size, in bytes, given by the holder's @MaxBodySize attribute or, if there is none,
+// by the configured default maximum body size.
+//
+// Returns zero if neither is specified
+func GetMaxBodySize(holder *annotations.AnnotationHolder, config *definitions.GleeceConfig) (int64, error) {
+	if holder != nil && holder.Has(annotations.GleeceAnnotationMaxBodySize) {
+		return ParseByteSize(holder.GetFirstValueOrEmpty(annotations.GleeceAnnotationMaxBodySize))
+	}
+
+	if config == nil || config.RoutesConfig.DefaultMaxBodySize == "" {
+		return 0, nil
+	}
+
+	maxBodySize, err := ParseByteSize(config.RoutesConfig.DefaultMaxBodySize)
+	if err != nil {
+		return 0, fmt.Errorf("invalid 'defaultMaxBodySize' configuration - %w", err)
+	}
+	return maxBodySize, nil
+}
+
+// GetRouteMaxBodySizeWithInheritance Returns the route's maximum body size or, if the route has no @MaxBodySize annotation, the parent controller's
+func GetRouteMaxBodySizeWithInheritance(receiverAnnotations *annotations.AnnotationHolder, parentMaxBodySize int64) (int64, error) {
+	if receiverAnnotations == nil || !receiverAnnotations.Has(annotations.GleeceAnnotationMaxBodySize) {
+		return parentMaxBodySize, nil
+	}
+
+	return ParseByteSize(receiverAnnotations.GetFirstValueOrEmpty(annotations.GleeceAnnotationMaxBodySize))
+}
+
+// GetIdempotency Creates an Idempotency out of the given holder's @Idempotent attribute.
+//
+// Returns nil if the holder has no @Idempotent attribute
+func GetIdempotency(holder *annotations.AnnotationHolder) (*definitions.Idempotency, error) {
+	if holder == nil {
+		return nil, nil
+	}
+
+	attr := holder.GetFirst(annotations.GleeceAnnotationIdempotent)
+	if attr == nil {
+		return nil, nil
+	}
+
+	return ParseIdempotency(attr)
+}
+
+// ParseIdempotency Creates an Idempotency out of the given @Idempotent attribute's properties.
+//
+// The 'ttl' property, if given, must be a positive Go duration (e.g. '1h')
+func ParseIdempotency(attr *annotations.Attribute) (*definitions.Idempotency, error) {
+	ttl, err := annotations.GetCastProperty[string](attr, annotations.PropertyTtl)
+	if err != nil {
+		return nil, err
+	}
+
+	idempotency := &definitions.Idempotency{Ttl: definitions.DefaultIdempotencyTtl}
+	if ttl == nil {
+		return idempotency, nil
+	}
+
+	if duration, err := time.ParseDuration(*ttl); err != nil || duration <= 0 {
+		return nil, fmt.Errorf("property '%s' must be a positive duration, e.g. '24h' or '30m', but got '%s'", annotations.PropertyTtl, *ttl)
+	}
+
+	idempotency.Ttl = *ttl
+	return idempotency, nil
+}
+
+// GetWebhook Creates a WebhookMetadata out of the given holder's @Webhook attribute.
+//
+// Returns nil if the holder has no @Webhook attribute
+func GetWebhook(holder *annotations.AnnotationHolder) (*definitions.WebhookMetadata, error) {
+	if holder == nil {
+		return nil, nil
+	}
+
+	attr := holder.GetFirst(annotations.GleeceAnnotationWebhook)
+	if attr == nil {
+		return nil, nil
+	}
+
+	if len(attr.Value) <= 0 {
+		return nil, fmt.Errorf("a webhook's name cannot be empty")
+	}
+
+	callbackUrl, err := annotations.GetCastProperty[string](attr, annotations.PropertyUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	operations, err := annotations.GetCastProperty[[]string](attr, annotations.PropertyOperations)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook := &definitions.WebhookMetadata{
+		Name:        attr.Value,
+		CallbackUrl: definitions.DefaultWebhookCallbackUrl,
+	}
+
+	if callbackUrl != nil && len(*callbackUrl) > 0 {
+		webhook.CallbackUrl = *callbackUrl
+	}
+
+	if operations != nil {
+		webhook.Operations = *operations
+	}
+
+	return webhook, nil
+}
+
 // GetDefaultSecurity Returns the default securities defined at the Gleece configuration file level
 func GetDefaultSecurity(config *definitions.GleeceConfig) []definitions.RouteSecurity {
 	defaultSecurity := []definitions.RouteSecurity{}
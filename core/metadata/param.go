@@ -49,6 +49,11 @@ func (v FuncParam) Reduce(ctx ReductionContext) (definitions.FuncParam, error) {
 		paramDescription = paramAttrib.Description
 	}
 
+	extensions, err := GetExtensions(v.Annotations, v.Name)
+	if err != nil {
+		return definitions.FuncParam{}, err
+	}
+
 	symKey, err := v.Type.Root.CacheLookupKey(v.FVersion)
 	if err != nil {
 		return definitions.FuncParam{}, fmt.Errorf(
@@ -71,5 +76,6 @@ func (v FuncParam) Reduce(ctx ReductionContext) (definitions.FuncParam, error) {
 		UniqueImportSerial: ctx.SyncedProvider.GetIdForKey(symKey),
 		Validator:          validator,
 		Deprecation:        GetDeprecationOpts(v.Annotations),
+		Extensions:         extensions,
 	}, nil
 }
@@ -18,7 +18,7 @@ type ReceiverMeta struct {
 
 func (m ReceiverMeta) Reduce(
 	ctx ReductionContext,
-	parentSecurity []definitions.RouteSecurity,
+	parent definitions.ControllerMetadata,
 ) (definitions.RouteMetadata, error) {
 
 	verbAnnotation := m.Annotations.GetFirst(annotations.GleeceAnnotationMethod)
@@ -28,7 +28,22 @@ func (m ReceiverMeta) Reduce(
 		return definitions.RouteMetadata{}, fmt.Errorf("receiver %s has not @Method annotation", m.Name)
 	}
 
-	security, err := GetRouteSecurityWithInheritance(m.Annotations, parentSecurity)
+	security, err := GetRouteSecurityWithInheritance(m.Annotations, parent.Security)
+	if err != nil {
+		return definitions.RouteMetadata{}, err
+	}
+
+	isPublic := IsPublicRoute(m.Annotations, parent.IsPublic)
+	if isPublic {
+		security = []definitions.RouteSecurity{}
+	}
+
+	tags, err := GetRouteTagsWithInheritance(m.Annotations, parent.Tags)
+	if err != nil {
+		return definitions.RouteMetadata{}, err
+	}
+
+	servers, err := GetRouteServersWithInheritance(m.Annotations, ctx.GleeceConfig, parent.Servers)
 	if err != nil {
 		return definitions.RouteMetadata{}, err
 	}
@@ -68,6 +83,51 @@ func (m ReceiverMeta) Reduce(
 		return definitions.RouteMetadata{}, err
 	}
 
+	responseHeaders, err := GetRouteResponseHeadersWithInheritance(m.Annotations, parent.ResponseHeaders)
+	if err != nil {
+		return definitions.RouteMetadata{}, err
+	}
+
+	extensions, err := GetRouteExtensionsWithInheritance(m.Annotations, parent.Extensions)
+	if err != nil {
+		return definitions.RouteMetadata{}, err
+	}
+
+	middlewares, err := GetRouteMiddlewaresWithInheritance(m.Annotations, parent.Middlewares)
+	if err != nil {
+		return definitions.RouteMetadata{}, err
+	}
+
+	webhook, err := GetWebhook(m.Annotations)
+	if err != nil {
+		return definitions.RouteMetadata{}, err
+	}
+
+	versions, err := GetRouteVersionsWithInheritance(m.Annotations, ctx.GleeceConfig, parent.Versions)
+	if err != nil {
+		return definitions.RouteMetadata{}, err
+	}
+
+	rateLimit, err := GetRouteRateLimitWithInheritance(m.Annotations, parent.RateLimit)
+	if err != nil {
+		return definitions.RouteMetadata{}, err
+	}
+
+	timeout, err := GetRouteTimeoutWithInheritance(m.Annotations, parent.Timeout)
+	if err != nil {
+		return definitions.RouteMetadata{}, err
+	}
+
+	maxBodySize, err := GetRouteMaxBodySizeWithInheritance(m.Annotations, parent.MaxBodySize)
+	if err != nil {
+		return definitions.RouteMetadata{}, err
+	}
+
+	idempotency, err := GetIdempotency(m.Annotations)
+	if err != nil {
+		return definitions.RouteMetadata{}, err
+	}
+
 	return definitions.RouteMetadata{
 		OperationId: m.Name,
 		HttpVerb:    definitions.HttpVerb(verbAnnotation.Value),
@@ -81,12 +141,24 @@ func (m ReceiverMeta) Reduce(
 		RequestContentType:  definitions.ContentTypeJSON, // Hardcoded for now, should be supported via annotations later on
 		ResponseContentType: definitions.ContentTypeJSON, // Hardcoded for now, should be supported via annotations later on
 		Security:            security,
+		IsPublic:            isPublic,
+		Tags:                tags,
+		Servers:             servers,
 		TemplateContext:     templateCtx,
 		ResponseSuccessCode: successResponseCode,
 		ResponseDescription: successResponseDescription,
 		FuncParams:          reducedParams,
 		Responses:           responses,
 		ErrorResponses:      errorResponses,
+		ResponseHeaders:     responseHeaders,
+		Extensions:          extensions,
+		Middlewares:         middlewares,
+		Webhook:             webhook,
+		Versions:            versions,
+		RateLimit:           rateLimit,
+		Timeout:             timeout,
+		MaxBodySize:         maxBodySize,
+		Idempotency:         idempotency,
 	}, nil
 }
 
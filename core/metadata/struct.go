@@ -23,11 +23,17 @@ func (s StructMeta) Reduce(ctx ReductionContext) (definitions.StructMetadata, er
 		reducedFields[idx] = reduced
 	}
 
+	extensions, err := GetExtensions(s.Annotations, "")
+	if err != nil {
+		return definitions.StructMetadata{}, fmt.Errorf("failed to obtain extensions for struct '%s' - %v", s.Name, err)
+	}
+
 	return definitions.StructMetadata{
 		Name:        s.Name,
 		PkgPath:     s.PkgPath,
 		Description: annotations.GetDescription(s.Annotations),
 		Fields:      reducedFields,
 		Deprecation: GetDeprecationOpts(s.Annotations),
+		Extensions:  extensions,
 	}, nil
 }
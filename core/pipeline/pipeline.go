@@ -161,12 +161,19 @@ func (p *GleecePipeline) getImports(controllers []definitions.ControllerMetadata
 	imports := make(map[string]MapSet.Set[string])
 
 	for _, controller := range controllers {
+		// Webhooks have no generated routing code so their types and, if they're all the controller has,
+		// the controller itself, must not be imported
+		routes := controller.GetRoutableRoutes()
+		if len(routes) == 0 {
+			continue
+		}
+
 		if imports[controller.PkgPath] == nil {
 			imports[controller.PkgPath] = MapSet.NewSet[string]()
 		}
 
 		imports[controller.PkgPath].Add(controller.Name)
-		for _, route := range controller.Routes {
+		for _, route := range routes {
 			p.appendRouteImports(imports, route)
 		}
 	}
@@ -377,14 +377,16 @@ func classifyAttributes(receiver *metadata.ReceiverMeta) (classifiedAttributes,
 		case annotations.GleeceAnnotationQuery,
 			annotations.GleeceAnnotationHeader,
 			annotations.GleeceAnnotationBody,
-			annotations.GleeceAnnotationFormField:
+			annotations.GleeceAnnotationFormField,
+			annotations.GleeceAnnotationPrincipal:
 			if strings.TrimSpace(attr.Value) != "" {
 				classified.nonPathAttributes = append(classified.nonPathAttributes, attr)
 			}
 		}
 	}
 
-	if routeAttrSeen {
+	// Webhooks have no @Route; they document an outbound call rather than an endpoint
+	if routeAttrSeen || receiver.Annotations.Has(annotations.GleeceAnnotationWebhook) {
 		return classified, nil
 	}
 
@@ -41,9 +41,36 @@ func (v *ApiValidator) Validate() ([]diagnostics.EntityDiagnostic, error) {
 		return controllerDiags, err
 	}
 
+	configurationDiag := v.validateConfiguration()
+	if !configurationDiag.Empty() {
+		conflicts = append(conflicts, configurationDiag)
+	}
+
 	return conflicts, nil
 }
 
+// validateConfiguration checks the parts of the Gleece configuration shared by all routes, namely the default security.
+//
+// Issues are reported once, as warnings, so configurations accepted by earlier versions of Gleece remain valid
+func (v *ApiValidator) validateConfiguration() diagnostics.EntityDiagnostic {
+	configurationDiag := diagnostics.NewEntityDiagnostic(configurationDiagKind, "GleeceConfig")
+	if v.gleeceConfig == nil {
+		return configurationDiag
+	}
+
+	for _, security := range metadata.GetDefaultSecurity(v.gleeceConfig) {
+		source := securitySource{description: "Default security schema", components: security.SecurityAnnotation}
+		for _, component := range source.components {
+			for _, diag := range validateSecurityComponent(v.gleeceConfig, source, component) {
+				diag.Severity = diagnostics.DiagnosticWarning
+				configurationDiag.AddDiagnostic(diag)
+			}
+		}
+	}
+
+	return configurationDiag
+}
+
 func (v *ApiValidator) validateControllers() ([]diagnostics.EntityDiagnostic, []paths.RouteEntry, error) {
 	controllerDiags := []diagnostics.EntityDiagnostic{}
 
@@ -74,6 +101,11 @@ func (v *ApiValidator) getRouteEntries(controller *metadata.ControllerMeta) []pa
 	entries := make([]paths.RouteEntry, 0, len(controller.Receivers))
 
 	for _, route := range controller.Receivers {
+		if route.Annotations.Has(annotations.GleeceAnnotationWebhook) {
+			// Webhooks are not served and cannot conflict with other routes
+			continue
+		}
+
 		entries = append(
 			entries,
 			paths.RouteEntry{
@@ -95,7 +127,7 @@ func (v *ApiValidator) inPlaceAppendPathConflictDiagnostics(
 	routeEntries []paths.RouteEntry,
 ) ([]diagnostics.EntityDiagnostic, error) {
 
-	conflicts := paths.FindConflicts(routeEntries)
+	conflicts := v.findConflicts(routeEntries)
 
 	if len(conflicts) <= 0 {
 		return controllerDiags, nil
@@ -109,6 +141,69 @@ func (v *ApiValidator) inPlaceAppendPathConflictDiagnostics(
 	return controllerDiags, nil
 }
 
+// findConflicts returns the path conflicts between the given route entries.
+//
+// When API versioning is configured, each version's routes are checked separately so routes sharing a path
+// only conflict if served in a common version. Unversioned routes are served in, and checked against, all versions
+func (v *ApiValidator) findConflicts(routeEntries []paths.RouteEntry) []paths.Conflict {
+	if v.gleeceConfig == nil || v.gleeceConfig.Versioning == nil {
+		return paths.FindConflicts(routeEntries)
+	}
+
+	versioning := v.gleeceConfig.GetVersioningConfig()
+	conflicts := []paths.Conflict{}
+	for _, version := range versioning.Versions {
+		versionEntries := []paths.RouteEntry{}
+		for _, entry := range routeEntries {
+			versions := v.getRouteVersions(entry.Meta)
+			if versions == nil {
+				versionEntries = append(versionEntries, entry)
+				continue
+			}
+
+			if !slices.Contains(versions, version) {
+				continue
+			}
+
+			if versioning.Strategy == definitions.VersioningStrategyPath {
+				entry.Path = fmt.Sprintf("/v%d%s", version, entry.Path)
+			}
+			versionEntries = append(versionEntries, entry)
+		}
+
+		// Conflicts between routes sharing several versions are only reported once
+		for _, conflict := range paths.FindConflicts(versionEntries) {
+			isKnown := slices.ContainsFunc(conflicts, func(known paths.Conflict) bool {
+				return (known.A.Meta.Receiver == conflict.A.Meta.Receiver && known.B.Meta.Receiver == conflict.B.Meta.Receiver) ||
+					(known.A.Meta.Receiver == conflict.B.Meta.Receiver && known.B.Meta.Receiver == conflict.A.Meta.Receiver)
+			})
+
+			if !isKnown {
+				conflicts = append(conflicts, conflict)
+			}
+		}
+	}
+
+	return conflicts
+}
+
+// getRouteVersions returns the API versions in which the given entry's route is served, or nil if it's unversioned.
+//
+// Malformed versions are treated as unversioned - these are reported by the common validator
+func (v *ApiValidator) getRouteVersions(meta paths.RouteEntryMeta) []int {
+	controllerVersions, err := metadata.GetVersions(meta.Controller.Struct.Annotations, v.gleeceConfig)
+	if err != nil {
+		return nil
+	}
+
+	versions, err := metadata.GetRouteVersionsWithInheritance(meta.Receiver.Annotations, v.gleeceConfig, controllerVersions)
+	if err != nil {
+		return nil
+	}
+
+	return versions
+}
+
 // adjustDiagsForConflictingEntry merges the given controller-level diagnostics with the given
 // global path conflict entry
 func (v *ApiValidator) adjustDiagsForConflictingEntry(
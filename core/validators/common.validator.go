@@ -8,13 +8,15 @@ import (
 
 	"github.com/gopher-fleece/gleece/v2/common"
 	"github.com/gopher-fleece/gleece/v2/core/annotations"
+	"github.com/gopher-fleece/gleece/v2/core/metadata"
 	"github.com/gopher-fleece/gleece/v2/core/validators/configuration"
 	"github.com/gopher-fleece/gleece/v2/core/validators/diagnostics"
 	"github.com/gopher-fleece/gleece/v2/definitions"
 )
 
 type CommonValidator struct {
-	holder *annotations.AnnotationHolder
+	holder       *annotations.AnnotationHolder
+	gleeceConfig *definitions.GleeceConfig
 }
 
 func (g CommonValidator) Validate() []diagnostics.ResolvedDiagnostic {
@@ -70,6 +72,9 @@ func (g *CommonValidator) validateAnnotation(
 	// Check if the annotation requires/has a value
 	diags = common.AppendIfNotNil(diags, g.validateRequiredAnnotationValue(def, attr))
 
+	// Check if the annotation's value is a list and, if so, whether lists are allowed
+	diags = common.AppendIfNotNil(diags, g.validateAnnotationValueList(def, attr))
+
 	// Check if the annotation properties are as expected
 	diags = common.AppendIfNotNil(diags, g.validateAnnotationProperties(def, attr))
 
@@ -124,6 +129,24 @@ func (g *CommonValidator) validateRequiredAnnotationValue(
 	return &diag
 }
 
+func (g *CommonValidator) validateAnnotationValueList(
+	def configuration.AnnotationConfigDefinition,
+	attr annotations.Attribute,
+) *diagnostics.ResolvedDiagnostic {
+	if def.AllowsValueList || !strings.Contains(attr.Value, ",") {
+		return nil
+	}
+
+	diag := g.getDiagnosticForAttributeValue(
+		attr,
+		fmt.Sprintf("Annotation '@%s' does not accept a comma separated list of values", attr.Name),
+		diagnostics.DiagAnnotationValueInvalid,
+		diagnostics.DiagnosticError,
+	)
+
+	return &diag
+}
+
 func (g *CommonValidator) validateAnnotationProperties(
 	def configuration.AnnotationConfigDefinition,
 	attr annotations.Attribute,
@@ -278,6 +301,26 @@ func (g *CommonValidator) validateAttribute(attr annotations.Attribute) *diagnos
 		return g.validateMethodAttribute(attr)
 	case annotations.GleeceAnnotationResponse, annotations.GleeceAnnotationErrorResponse:
 		return g.validateStatusCodeBearingAttribute(attr)
+	case annotations.GleeceAnnotationServer:
+		return g.validateServerAttribute(attr)
+	case annotations.GleeceAnnotationTag:
+		return g.validateTagAttribute(attr)
+	case annotations.GleeceAnnotationResponseHeader:
+		return g.validateResponseHeaderAttribute(attr)
+	case annotations.GleeceAnnotationExtension:
+		return g.validateExtensionAttribute(attr)
+	case annotations.GleeceAnnotationAdvancedSecurity:
+		return g.validateAdvancedSecurityAttribute(attr)
+	case annotations.GleeceAnnotationVersion, annotations.GleeceAnnotationSince, annotations.GleeceAnnotationUntil:
+		return g.validateVersioningAttribute(attr)
+	case annotations.GleeceAnnotationRateLimit:
+		return g.validateRateLimitAttribute(attr)
+	case annotations.GleeceAnnotationTimeout:
+		return g.validateTimeoutAttribute(attr)
+	case annotations.GleeceAnnotationMaxBodySize:
+		return g.validateMaxBodySizeAttribute(attr)
+	case annotations.GleeceAnnotationIdempotent:
+		return g.validateIdempotentAttribute(attr)
 	}
 	return nil
 }
@@ -290,6 +333,26 @@ func (g *CommonValidator) validateMethodAttribute(attribute annotations.Attribut
 		return nil
 	}
 
+	if definitions.IsCustomHttpVerb(attribute.Value) {
+		registrationMsg := "the verb may need to be registered with the routing engine"
+		if g.gleeceConfig != nil && g.gleeceConfig.RoutesConfig.Engine == definitions.RoutingEngineFiber {
+			// Fiber panics when adding a route whose method is not listed in the app's configuration
+			registrationMsg = "the verb must be listed in the Fiber app's Config.RequestMethods"
+		}
+
+		diag := g.getDiagnosticForAttributeValue(
+			attribute,
+			fmt.Sprintf(
+				"HTTP verb '%s' is not a standard verb. The operation is omitted from the OpenAPI specification and %s",
+				attribute.Value,
+				registrationMsg,
+			),
+			diagnostics.DiagRouteCustomHttpVerb,
+			diagnostics.DiagnosticWarning,
+		)
+		return &diag
+	}
+
 	isValid := definitions.IsValidHttpVerb(attribute.Value)
 
 	var diag diagnostics.ResolvedDiagnostic
@@ -325,6 +388,288 @@ func (g *CommonValidator) validateMethodAttribute(attribute annotations.Attribut
 	return &diag
 }
 
+// validateServerAttribute checks that a @Server annotation without an explicit URL references a configured server
+func (g *CommonValidator) validateServerAttribute(attribute annotations.Attribute) *diagnostics.ResolvedDiagnostic {
+	if attribute.Value == "" || attribute.HasProperty(annotations.PropertyUrl) {
+		return nil
+	}
+
+	if metadata.FindConfiguredServer(g.gleeceConfig, attribute.Value) != nil {
+		return nil
+	}
+
+	return common.Ptr(
+		g.getDiagnosticForAttributeValue(
+			attribute,
+			fmt.Sprintf(
+				"Server '%s' is not defined in the Gleece configuration and no 'url' property was provided",
+				attribute.Value,
+			),
+			diagnostics.DiagAnnotationValueInvalid,
+			diagnostics.DiagnosticError,
+		),
+	)
+}
+
+// validateTagAttribute checks that a @Tag annotation's external docs, if any, specify a URL
+func (g *CommonValidator) validateTagAttribute(attribute annotations.Attribute) *diagnostics.ResolvedDiagnostic {
+	externalDocs, isObject := attribute.Properties[annotations.PropertyExternalDocs].(map[string]any)
+	if !isObject {
+		// Either not provided or not an object, in which case the property validation will have already emitted a diagnostic
+		return nil
+	}
+
+	if url, isString := externalDocs[annotations.PropertyUrl].(string); isString && url != "" {
+		return nil
+	}
+
+	return common.Ptr(
+		g.getDiagnosticForAttribute(
+			attribute,
+			fmt.Sprintf("Property '%s' must specify a 'url' string", annotations.PropertyExternalDocs),
+			diagnostics.DiagAnnotationPropertiesInvalidValueForKey,
+			diagnostics.DiagnosticError,
+		),
+	)
+}
+
+// validateResponseHeaderAttribute checks that a @ResponseHeader annotation's status codes, if any, are valid
+func (g *CommonValidator) validateResponseHeaderAttribute(attribute annotations.Attribute) *diagnostics.ResolvedDiagnostic {
+	statusCodes, isArray := attribute.Properties[annotations.PropertyStatusCodes].([]any)
+	if !isArray {
+		// Either not provided or not an array, in which case the property validation will have already emitted a diagnostic
+		return nil
+	}
+
+	for _, statusCode := range statusCodes {
+		code, isNumber := statusCode.(float64)
+		if isNumber && code == float64(uint(code)) && definitions.IsValidHttpStatusCode(uint(code)) {
+			continue
+		}
+
+		return common.Ptr(
+			g.getDiagnosticForAttribute(
+				attribute,
+				fmt.Sprintf("Invalid HTTP status code '%v' in property '%s'", statusCode, annotations.PropertyStatusCodes),
+				diagnostics.DiagAnnotationPropertiesInvalidValueForKey,
+				diagnostics.DiagnosticError,
+			),
+		)
+	}
+
+	return nil
+}
+
+// validateExtensionAttribute checks that an @Extension annotation's key is a valid OpenAPI vendor extension name
+func (g *CommonValidator) validateExtensionAttribute(attribute annotations.Attribute) *diagnostics.ResolvedDiagnostic {
+	if attribute.Value == "" || metadata.IsValidExtensionName(attribute.Value) {
+		return nil
+	}
+
+	return common.Ptr(
+		g.getDiagnosticForAttributeValue(
+			attribute,
+			fmt.Sprintf("Extension '%s' is invalid. Extension names must begin with 'x-'", attribute.Value),
+			diagnostics.DiagAnnotationValueInvalid,
+			diagnostics.DiagnosticError,
+		),
+	)
+}
+
+// validateAdvancedSecurityAttribute checks that an @AdvancedSecurity annotation's expression is well-formed
+// and that it only references security schemas defined in the Gleece configuration
+func (g *CommonValidator) validateAdvancedSecurityAttribute(attribute annotations.Attribute) *diagnostics.ResolvedDiagnostic {
+	if attribute.Value == "" || !attribute.HasProperty(annotations.PropertySecurities) {
+		// Missing values and properties are reported by the generic validations
+		return nil
+	}
+
+	securities, err := metadata.GetAdvancedSecurity(&attribute)
+	if err != nil {
+		return common.Ptr(
+			g.getDiagnosticForAttribute(
+				attribute,
+				fmt.Sprintf("Invalid security expression - %v", err),
+				diagnostics.DiagAnnotationPropertiesInvalid,
+				diagnostics.DiagnosticError,
+			),
+		)
+	}
+
+	for _, security := range securities {
+		for _, component := range security.SecurityAnnotation {
+			if metadata.IsConfiguredSecuritySchema(g.gleeceConfig, component.SchemaName) {
+				continue
+			}
+
+			return common.Ptr(
+				g.getDiagnosticForAttribute(
+					attribute,
+					fmt.Sprintf("Security schema '%s' is not defined in the Gleece configuration", component.SchemaName),
+					diagnostics.DiagAnnotationPropertiesInvalidValueForKey,
+					diagnostics.DiagnosticError,
+				),
+			)
+		}
+	}
+
+	return nil
+}
+
+// validateRateLimitAttribute checks that a @RateLimit annotation's request count, window and key are valid
+func (g *CommonValidator) validateRateLimitAttribute(attribute annotations.Attribute) *diagnostics.ResolvedDiagnostic {
+	_, isNumber := attribute.Properties[annotations.PropertyRequests].(float64)
+	_, isString := attribute.Properties[annotations.PropertyPer].(string)
+	if !isNumber || !isString {
+		// Either not provided or of the wrong type, in which case the property validation will have already emitted a diagnostic
+		return nil
+	}
+
+	if _, err := metadata.ParseRateLimit(&attribute); err != nil {
+		return common.Ptr(
+			g.getDiagnosticForAttribute(
+				attribute,
+				fmt.Sprintf("Invalid rate limit - %v", err),
+				diagnostics.DiagAnnotationPropertiesInvalidValueForKey,
+				diagnostics.DiagnosticError,
+			),
+		)
+	}
+
+	return nil
+}
+
+// validateTimeoutAttribute checks that a @Timeout annotation's value is a positive duration
+func (g *CommonValidator) validateTimeoutAttribute(attribute annotations.Attribute) *diagnostics.ResolvedDiagnostic {
+	if attribute.Value == "" {
+		// Missing values are reported by the generic validations
+		return nil
+	}
+
+	if _, err := metadata.ParseTimeout(attribute.Value); err != nil {
+		return common.Ptr(
+			g.getDiagnosticForAttributeValue(
+				attribute,
+				fmt.Sprintf("Invalid timeout - %v", err),
+				diagnostics.DiagAnnotationValueInvalid,
+				diagnostics.DiagnosticError,
+			),
+		)
+	}
+
+	return nil
+}
+
+// validateMaxBodySizeAttribute checks that a @MaxBodySize annotation's value is a positive size
+func (g *CommonValidator) validateMaxBodySizeAttribute(attribute annotations.Attribute) *diagnostics.ResolvedDiagnostic {
+	if attribute.Value == "" {
+		// Missing values are reported by the generic validations
+		return nil
+	}
+
+	if _, err := metadata.ParseByteSize(attribute.Value); err != nil {
+		return common.Ptr(
+			g.getDiagnosticForAttributeValue(
+				attribute,
+				fmt.Sprintf("Invalid maximum body size - %v", err),
+				diagnostics.DiagAnnotationValueInvalid,
+				diagnostics.DiagnosticError,
+			),
+		)
+	}
+
+	return nil
+}
+
+// validateIdempotentAttribute checks that an @Idempotent annotation's TTL, if given, is valid
+func (g *CommonValidator) validateIdempotentAttribute(attribute annotations.Attribute) *diagnostics.ResolvedDiagnostic {
+	if _, isString := attribute.Properties[annotations.PropertyTtl].(string); !isString {
+		// Either not provided or of the wrong type, in which case the property validation will have already emitted a diagnostic
+		return nil
+	}
+
+	if _, err := metadata.ParseIdempotency(&attribute); err != nil {
+		return common.Ptr(
+			g.getDiagnosticForAttribute(
+				attribute,
+				fmt.Sprintf("Invalid idempotency - %v", err),
+				diagnostics.DiagAnnotationPropertiesInvalidValueForKey,
+				diagnostics.DiagnosticError,
+			),
+		)
+	}
+
+	return nil
+}
+
+// validateVersioningAttribute checks that a @Version, @Since or @Until annotation
+// only references API versions declared in the Gleece configuration
+func (g *CommonValidator) validateVersioningAttribute(attribute annotations.Attribute) *diagnostics.ResolvedDiagnostic {
+	if attribute.Value == "" {
+		// Missing values are reported by the generic validations
+		return nil
+	}
+
+	if g.gleeceConfig == nil || g.gleeceConfig.Versioning == nil {
+		return common.Ptr(
+			g.getDiagnosticForAttribute(
+				attribute,
+				fmt.Sprintf("@%s requires API versions to be declared via the 'versioning' configuration", attribute.Name),
+				diagnostics.DiagVersioningNotConfigured,
+				diagnostics.DiagnosticError,
+			),
+		)
+	}
+
+	var versions []int
+	var err error
+	if attribute.Name == annotations.GleeceAnnotationVersion {
+		versions, err = metadata.ParseVersionList(attribute.Value)
+	} else {
+		var version int
+		version, err = metadata.ParseVersion(attribute.Value)
+		versions = []int{version}
+	}
+
+	if err != nil {
+		return common.Ptr(
+			g.getDiagnosticForAttributeValue(
+				attribute,
+				fmt.Sprintf("Invalid API version '%s' - %v", attribute.Value, err),
+				diagnostics.DiagAnnotationValueInvalid,
+				diagnostics.DiagnosticError,
+			),
+		)
+	}
+
+	declaredVersions := g.gleeceConfig.GetVersioningConfig().Versions
+	for _, version := range versions {
+		if slices.Contains(declaredVersions, version) {
+			continue
+		}
+
+		declared := []string{}
+		for _, declaredVersion := range declaredVersions {
+			declared = append(declared, strconv.Itoa(declaredVersion))
+		}
+
+		return common.Ptr(
+			g.getDiagnosticForAttributeValue(
+				attribute,
+				fmt.Sprintf(
+					"API version '%d' is not declared in the Gleece configuration. Declared versions are: %s",
+					version,
+					strings.Join(declared, ", "),
+				),
+				diagnostics.DiagVersionUnknown,
+				diagnostics.DiagnosticError,
+			),
+		)
+	}
+
+	return nil
+}
+
 // validateStatusCodeBearingAttribute checks if the status code is valid
 func (g *CommonValidator) validateStatusCodeBearingAttribute(attribute annotations.Attribute) *diagnostics.ResolvedDiagnostic {
 	parsed, err := strconv.ParseUint(attribute.Value, 10, 32)
@@ -2,3 +2,4 @@ package validators
 
 const controllerDiagKind = "Controller"
 const receiverDiagKind = "Receiver"
+const configurationDiagKind = "Configuration"
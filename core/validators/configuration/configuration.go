@@ -5,19 +5,106 @@ import "github.com/gopher-fleece/gleece/v2/core/annotations"
 var ValidatorConfigMap = map[string]AnnotationConfigDefinition{
 	// Controller (Class-Level) Annotations
 	annotations.GleeceAnnotationTag: {
-		Contexts:            []annotations.CommentSource{"controller"},
+		Contexts:      []annotations.CommentSource{"controller", "route"},
+		RequiresValue: true,
+		AllowedProperties: map[string]PropertyDefinition{
+			"externalDocs": {
+				Required:     false,
+				Type:         "object",
+				DefaultValue: nil,
+			},
+		},
+		AllowsMultiple:      true,
+		RequiresUniqueValue: false,
+	},
+	annotations.GleeceAnnotationRoute: {
+		Contexts:            []annotations.CommentSource{"controller", "route"},
 		RequiresValue:       true,
 		AllowedProperties:   map[string]PropertyDefinition{},
 		AllowsMultiple:      false,
 		RequiresUniqueValue: false,
 	},
-	annotations.GleeceAnnotationRoute: {
+	annotations.GleeceAnnotationMiddleware: {
+		Contexts:            []annotations.CommentSource{"controller", "route"},
+		RequiresValue:       true,
+		AllowedProperties:   map[string]PropertyDefinition{},
+		AllowsMultiple:      true,
+		RequiresUniqueValue: false,
+	},
+	annotations.GleeceAnnotationVersion: {
 		Contexts:            []annotations.CommentSource{"controller", "route"},
 		RequiresValue:       true,
+		AllowsValueList:     true,
 		AllowedProperties:   map[string]PropertyDefinition{},
 		AllowsMultiple:      false,
 		RequiresUniqueValue: false,
 	},
+	annotations.GleeceAnnotationSince: {
+		Contexts:            []annotations.CommentSource{"controller", "route"},
+		RequiresValue:       true,
+		AllowsValueList:     true,
+		AllowedProperties:   map[string]PropertyDefinition{},
+		AllowsMultiple:      false,
+		RequiresUniqueValue: false,
+	},
+	annotations.GleeceAnnotationUntil: {
+		Contexts:            []annotations.CommentSource{"controller", "route"},
+		RequiresValue:       true,
+		AllowsValueList:     true,
+		AllowedProperties:   map[string]PropertyDefinition{},
+		AllowsMultiple:      false,
+		RequiresUniqueValue: false,
+	},
+	annotations.GleeceAnnotationRateLimit: {
+		Contexts:      []annotations.CommentSource{"controller", "route"},
+		RequiresValue: false,
+		AllowedProperties: map[string]PropertyDefinition{
+			"requests": {
+				Required:     true,
+				Type:         "number",
+				DefaultValue: nil,
+			},
+			"per": {
+				Required:     true,
+				Type:         "string",
+				DefaultValue: nil,
+			},
+			"key": {
+				Required:     false,
+				Type:         "string",
+				DefaultValue: "ip",
+			},
+		},
+		AllowsMultiple:      false,
+		RequiresUniqueValue: false,
+	},
+	annotations.GleeceAnnotationTimeout: {
+		Contexts:            []annotations.CommentSource{"controller", "route"},
+		RequiresValue:       true,
+		AllowedProperties:   map[string]PropertyDefinition{},
+		AllowsMultiple:      false,
+		RequiresUniqueValue: false,
+	},
+	annotations.GleeceAnnotationMaxBodySize: {
+		Contexts:            []annotations.CommentSource{"controller", "route"},
+		RequiresValue:       true,
+		AllowedProperties:   map[string]PropertyDefinition{},
+		AllowsMultiple:      false,
+		RequiresUniqueValue: false,
+	},
+	annotations.GleeceAnnotationIdempotent: {
+		Contexts:      []annotations.CommentSource{"route"},
+		RequiresValue: false,
+		AllowedProperties: map[string]PropertyDefinition{
+			"ttl": {
+				Required:     false,
+				Type:         "string",
+				DefaultValue: "24h",
+			},
+		},
+		AllowsMultiple:      false,
+		RequiresUniqueValue: false,
+	},
 	annotations.GleeceAnnotationSecurity: {
 		Contexts:      []annotations.CommentSource{"controller", "route"},
 		RequiresValue: true,
@@ -29,6 +116,63 @@ var ValidatorConfigMap = map[string]AnnotationConfigDefinition{
 			},
 		},
 		AllowsMultiple:      true,
+		MutuallyExclusive:   []string{annotations.GleeceAnnotationPublic},
+		RequiresUniqueValue: false,
+	},
+	annotations.GleeceAnnotationAdvancedSecurity: {
+		Contexts:      []annotations.CommentSource{"controller", "route"},
+		RequiresValue: true,
+		AllowedProperties: map[string]PropertyDefinition{
+			"securities": {
+				Required:     true,
+				Type:         "array",
+				DefaultValue: nil,
+			},
+		},
+		AllowsMultiple:      true,
+		MutuallyExclusive:   []string{annotations.GleeceAnnotationPublic},
+		RequiresUniqueValue: false,
+	},
+	annotations.GleeceAnnotationPublic: {
+		Contexts:          []annotations.CommentSource{"controller", "route"},
+		RequiresValue:     false,
+		AllowedProperties: map[string]PropertyDefinition{},
+		AllowsMultiple:    false,
+		MutuallyExclusive: []string{
+			annotations.GleeceAnnotationSecurity,
+			annotations.GleeceAnnotationAdvancedSecurity,
+		},
+		RequiresUniqueValue: false,
+	},
+	annotations.GleeceAnnotationServer: {
+		Contexts:      []annotations.CommentSource{"controller", "route"},
+		RequiresValue: true,
+		AllowedProperties: map[string]PropertyDefinition{
+			"url": {
+				Required:     false,
+				Type:         "string",
+				DefaultValue: "",
+			},
+		},
+		AllowsMultiple:      true,
+		RequiresUniqueValue: false,
+	},
+	annotations.GleeceAnnotationExtension: {
+		Contexts:      []annotations.CommentSource{"controller", "route", "schema", "property"},
+		RequiresValue: true,
+		AllowedProperties: map[string]PropertyDefinition{
+			"value": {
+				Required:     true,
+				Type:         "any",
+				DefaultValue: nil,
+			},
+			"param": {
+				Required:     false,
+				Type:         "string",
+				DefaultValue: "",
+			},
+		},
+		AllowsMultiple:      true,
 		RequiresUniqueValue: false,
 	},
 	annotations.GleeceAnnotationDescription: {
@@ -54,6 +198,25 @@ var ValidatorConfigMap = map[string]AnnotationConfigDefinition{
 		AllowsMultiple:      false,
 		RequiresUniqueValue: false,
 	},
+	annotations.GleeceAnnotationWebhook: {
+		Contexts:      []annotations.CommentSource{"route"},
+		RequiresValue: true,
+		AllowedProperties: map[string]PropertyDefinition{
+			"url": {
+				Required:     false,
+				Type:         "string",
+				DefaultValue: "",
+			},
+			"operations": {
+				Required:     false,
+				Type:         "array",
+				DefaultValue: []any{},
+			},
+		},
+		AllowsMultiple:      false,
+		MutuallyExclusive:   []string{annotations.GleeceAnnotationRoute},
+		RequiresUniqueValue: false,
+	},
 	annotations.GleeceAnnotationQuery: {
 		Contexts:      []annotations.CommentSource{"route"},
 		RequiresValue: true,
@@ -141,6 +304,13 @@ var ValidatorConfigMap = map[string]AnnotationConfigDefinition{
 		MutuallyExclusive:   []string{annotations.GleeceAnnotationBody},
 		RequiresUniqueValue: true, // Values must be unique across all HTTP params annotations
 	},
+	annotations.GleeceAnnotationPrincipal: {
+		Contexts:            []annotations.CommentSource{"route"},
+		RequiresValue:       true,
+		AllowedProperties:   map[string]PropertyDefinition{},
+		AllowsMultiple:      false,
+		RequiresUniqueValue: true, // Values must be unique across all HTTP params annotations
+	},
 	annotations.GleeceAnnotationResponse: {
 		Contexts:            []annotations.CommentSource{"route"},
 		RequiresValue:       true,
@@ -155,6 +325,30 @@ var ValidatorConfigMap = map[string]AnnotationConfigDefinition{
 		AllowsMultiple:      true,
 		RequiresUniqueValue: false,
 	},
+	annotations.GleeceAnnotationResponseHeader: {
+		Contexts:      []annotations.CommentSource{"controller", "route"},
+		RequiresValue: true,
+		AllowedProperties: map[string]PropertyDefinition{
+			"type": {
+				Required:      false,
+				Type:          "string",
+				DefaultValue:  "string",
+				AllowedValues: []any{"string", "integer", "number", "boolean"},
+			},
+			"description": {
+				Required:     false,
+				Type:         "string",
+				DefaultValue: "",
+			},
+			"statusCodes": {
+				Required:     false,
+				Type:         "array",
+				DefaultValue: []any{},
+			},
+		},
+		AllowsMultiple:      true,
+		RequiresUniqueValue: false,
+	},
 	annotations.GleeceAnnotationHidden: {
 		Contexts:            []annotations.CommentSource{"route"},
 		RequiresValue:       false,
@@ -13,6 +13,7 @@ type PropertyDefinition struct {
 type AnnotationConfigDefinition struct {
 	Contexts            []annotations.CommentSource   // Where this annotation can be used (controller, route, schema, property)
 	RequiresValue       bool                          // Whether the annotation requires a basic parameter
+	AllowsValueList     bool                          // Whether the annotation's value may be a comma separated list
 	AllowedProperties   map[string]PropertyDefinition // Allowed properties and their definitions
 	AllowsMultiple      bool                          // Whether multiple instances of this annotation are allowed
 	MutuallyExclusive   []string                      // Names of annotations that cannot be used together with this annotation
@@ -13,7 +13,6 @@ import (
 type ControllerValidator struct {
 	CommonValidator
 
-	gleeceConfig   *definitions.GleeceConfig
 	packagesFacade *arbitrators.PackagesFacade
 	controller     *metadata.ControllerMeta
 }
@@ -25,9 +24,9 @@ func NewControllerValidator(
 ) ControllerValidator {
 	return ControllerValidator{
 		CommonValidator: CommonValidator{
-			holder: controller.Struct.Annotations,
+			holder:       controller.Struct.Annotations,
+			gleeceConfig: gleeceConfig,
 		},
-		gleeceConfig:   gleeceConfig,
 		packagesFacade: packagesFacade,
 		controller:     controller,
 	}
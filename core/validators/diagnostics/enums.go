@@ -61,6 +61,16 @@ const (
 	DiagReceiverRetValsInvalidSignature        DiagnosticCode = "receiver-return-values-invalid-signature"
 	DiagReceiverRetValsIsNotError              DiagnosticCode = "receiver-return-value-is-not-an-error"
 	DiagReceiverMissingSecurity                DiagnosticCode = "receiver-missing-security"
+	DiagReceiverPrincipalTypeMismatch          DiagnosticCode = "receiver-principal-type-mismatch"
+	DiagReceiverPublic                         DiagnosticCode = "receiver-public"
+	DiagReceiverSecuritySchemaUnknown          DiagnosticCode = "receiver-security-schema-unknown"
+	DiagReceiverSecurityScopeUnknown           DiagnosticCode = "receiver-security-scope-unknown"
+	DiagReceiverSecurityScopesUnsupported      DiagnosticCode = "receiver-security-scopes-unsupported"
 	DiagFeatureUnsupported                     DiagnosticCode = "unsupported-feature"
 	DiagRouteConflict                          DiagnosticCode = "route-conflict"
+	DiagRouteCustomHttpVerb                    DiagnosticCode = "route-custom-http-verb"
+	DiagVersioningNotConfigured                DiagnosticCode = "versioning-not-configured"
+	DiagVersionUnknown                         DiagnosticCode = "version-unknown"
+	DiagReceiverNoVersions                     DiagnosticCode = "receiver-no-versions"
+	DiagReceiverIdempotentSafeMethod           DiagnosticCode = "receiver-idempotent-safe-method"
 )
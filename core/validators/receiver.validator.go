@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	"github.com/gopher-fleece/gleece/v2/common"
+	"github.com/gopher-fleece/gleece/v2/common/language"
+	"github.com/gopher-fleece/gleece/v2/core/annotations"
 	"github.com/gopher-fleece/gleece/v2/core/arbitrators"
 	"github.com/gopher-fleece/gleece/v2/core/metadata"
 	"github.com/gopher-fleece/gleece/v2/core/validators/diagnostics"
@@ -19,7 +21,6 @@ type funcParamEx struct {
 
 type ReceiverValidator struct {
 	CommonValidator
-	gleeceConfig     *definitions.GleeceConfig
 	packagesFacade   *arbitrators.PackagesFacade
 	parentController *metadata.ControllerMeta
 	receiver         *metadata.ReceiverMeta
@@ -33,9 +34,9 @@ func NewReceiverValidator(
 ) ReceiverValidator {
 	return ReceiverValidator{
 		CommonValidator: CommonValidator{
-			holder: receiver.Annotations,
+			holder:       receiver.Annotations,
+			gleeceConfig: gleeceConfig,
 		},
-		gleeceConfig:     gleeceConfig,
 		packagesFacade:   packagesFacade,
 		parentController: controller,
 		receiver:         receiver,
@@ -54,6 +55,7 @@ func (v ReceiverValidator) Validate() (diagnostics.EntityDiagnostic, error) {
 	}
 
 	receiverDiag.AddDiagnostics(paramDiags)
+	receiverDiag.AddDiagnostics(v.validateExtensionTargets(v.receiver))
 
 	retTypeDiag, err := v.validateReturnTypes(v.receiver)
 	if err != nil {
@@ -66,6 +68,9 @@ func (v ReceiverValidator) Validate() (diagnostics.EntityDiagnostic, error) {
 		return receiverDiag, fmt.Errorf("could not validate security for receiver '%s' - %w", v.receiver.Name, err)
 	}
 	receiverDiag.AddDiagnosticIfNotNil(secDiag)
+	receiverDiag.AddDiagnostics(v.validateSecurityScopes(v.receiver))
+	receiverDiag.AddDiagnosticIfNotNil(v.validateVersions(v.receiver))
+	receiverDiag.AddDiagnosticIfNotNil(v.validateIdempotency(v.receiver))
 
 	linkValidator, err := NewAnnotationLinkValidator(v.receiver)
 	if err != nil {
@@ -101,6 +106,8 @@ func (v ReceiverValidator) validateParams(receiver *metadata.ReceiverMeta) ([]di
 		switch *passedIn {
 		case definitions.PassedInBody:
 			diags = common.AppendIfNotNil(diags, v.validateBodyParam(receiver, param))
+		case definitions.PassedInPrincipal:
+			diags = common.AppendIfNotNil(diags, v.validatePrincipalParam(receiver, param))
 		default:
 			diags = common.AppendIfNotNil(diags, v.validateNonBodyParam(receiver, param, *passedIn))
 		}
@@ -113,6 +120,36 @@ func (v ReceiverValidator) validateParams(receiver *metadata.ReceiverMeta) ([]di
 	return diags, nil
 }
 
+// validateExtensionTargets checks that any @Extension annotations targeting a parameter reference an existing one
+func (v ReceiverValidator) validateExtensionTargets(receiver *metadata.ReceiverMeta) []diagnostics.ResolvedDiagnostic {
+	diags := []diagnostics.ResolvedDiagnostic{}
+	if receiver.Annotations == nil {
+		return diags
+	}
+
+	for _, attr := range receiver.Annotations.GetAll(annotations.GleeceAnnotationExtension) {
+		paramName, isString := attr.Properties[annotations.PropertyParam].(string)
+		if !isString || paramName == "" {
+			continue
+		}
+
+		paramExists := slices.ContainsFunc(receiver.Params, func(param metadata.FuncParam) bool {
+			return param.Name == paramName
+		})
+
+		if !paramExists {
+			diags = append(diags, v.getDiagnosticForAttribute(
+				*attr,
+				fmt.Sprintf("Extension '%s' targets unknown parameter '%s'", attr.Value, paramName),
+				diagnostics.DiagAnnotationPropertiesInvalidValueForKey,
+				diagnostics.DiagnosticError,
+			))
+		}
+	}
+
+	return diags
+}
+
 func (v ReceiverValidator) getPassedInValue(param metadata.FuncParam) (*definitions.ParamPassedIn, error) {
 	// This function gets the parameter's passed-in value (e.g. passed-in-body or passed-in-header)
 	// If it fails, it may return a standard error or an InvalidAnnotation error.
@@ -163,6 +200,50 @@ func (v ReceiverValidator) validateBodyParam(
 	return nil
 }
 
+// validatePrincipalParam verifies the given @Principal parameter is of the type declared by the authorization configuration.
+// Pointers are allowed, as the principal is always retrieved by reference
+func (v ReceiverValidator) validatePrincipalParam(
+	receiver *metadata.ReceiverMeta,
+	param metadata.FuncParam,
+) *diagnostics.ResolvedDiagnostic {
+	declaredType := ""
+	if v.gleeceConfig != nil {
+		declaredType = v.gleeceConfig.RoutesConfig.AuthorizationConfig.PrincipalType
+	}
+
+	paramType := param.Type.Root.SimpleTypeString()
+	if param.Type.PkgPath != "" {
+		paramType = param.Type.PkgPath + "." + paramType
+	}
+
+	var message string
+	switch declaredType {
+	case "":
+		message = fmt.Sprintf(
+			"principal parameter '%s' (type '%s') requires a 'principalType' to be declared in the authorization configuration",
+			param.Name,
+			paramType,
+		)
+	case paramType:
+		return nil
+	default:
+		message = fmt.Sprintf(
+			"principal parameter '%s' is of type '%s' but the authorization configuration declares principal type '%s'",
+			param.Name,
+			paramType,
+			declaredType,
+		)
+	}
+
+	diag := diagnostics.NewErrorDiagnostic(
+		receiver.Annotations.FileName(),
+		message,
+		diagnostics.DiagReceiverPrincipalTypeMismatch,
+		param.Range,
+	)
+	return &diag
+}
+
 func (v ReceiverValidator) validateNonBodyParam(
 	receiver *metadata.ReceiverMeta,
 	param metadata.FuncParam,
@@ -315,7 +396,24 @@ func (v ReceiverValidator) validateReturnTypes(receiver *metadata.ReceiverMeta)
 }
 
 func (v ReceiverValidator) validateSecurity(receiver *metadata.ReceiverMeta) (*diagnostics.ResolvedDiagnostic, error) {
-	// First, check if we're enforcing security on all routes
+	// Public routes are exempt from security, enforced or not. They're always reported so they may be audited
+	isControllerPublic := v.parentController.Struct.Annotations.Has(annotations.GleeceAnnotationPublic)
+	if metadata.IsPublicRoute(receiver.Annotations, isControllerPublic) {
+		rng := receiver.RetValsRange()
+		if publicAttr := receiver.Annotations.GetFirst(annotations.GleeceAnnotationPublic); publicAttr != nil {
+			rng = publicAttr.Comment.Range()
+		}
+
+		diag := diagnostics.NewWarningDiagnostic(
+			receiver.Annotations.FileName(),
+			fmt.Sprintf("Route with operation ID '%s' is public and is not subject to any security", receiver.Name),
+			diagnostics.DiagReceiverPublic,
+			rng,
+		)
+		return &diag, nil
+	}
+
+	// Next, check if we're enforcing security on all routes
 	if v.gleeceConfig == nil || !v.gleeceConfig.RoutesConfig.AuthorizationConfig.EnforceSecurityOnAllRoutes {
 		return nil, nil
 	}
@@ -367,6 +465,279 @@ func (v ReceiverValidator) validateSecurity(receiver *metadata.ReceiverMeta) (*d
 	return &diag, nil
 }
 
+// securitySource is a list of security components along with the location at which they were declared
+type securitySource struct {
+	// A description of the source, used to prefix diagnostic messages, e.g. 'Security schema'
+	description string
+	fileName    string
+	rng         common.ResolvedRange
+	components  []definitions.SecurityAnnotationComponent
+	// Whether the components originate from an @AdvancedSecurity expression.
+	// The schemas of such expressions are validated by the common validator and are not re-validated here
+	isAdvanced bool
+}
+
+// validateVersions checks that a versioned route is served in at least one API version,
+// i.e., that its @Since and @Until annotations do not exclude all of its versions
+func (v ReceiverValidator) validateVersions(receiver *metadata.ReceiverMeta) *diagnostics.ResolvedDiagnostic {
+	if v.gleeceConfig == nil || v.gleeceConfig.Versioning == nil {
+		// Versioning annotations without a versioning configuration are reported by the common validator
+		return nil
+	}
+
+	controllerVersions, err := metadata.GetVersions(v.parentController.Struct.Annotations, v.gleeceConfig)
+	if err != nil {
+		return nil
+	}
+
+	versions, err := metadata.GetRouteVersionsWithInheritance(receiver.Annotations, v.gleeceConfig, controllerVersions)
+	if err != nil || versions == nil || len(versions) > 0 {
+		// Malformed versions are reported by the common validator
+		return nil
+	}
+
+	rng := receiver.RetValsRange()
+	for _, name := range []string{annotations.GleeceAnnotationUntil, annotations.GleeceAnnotationSince} {
+		if attr := receiver.Annotations.GetFirst(name); attr != nil {
+			rng = attr.Comment.Range()
+		}
+	}
+
+	return common.Ptr(diagnostics.NewErrorDiagnostic(
+		receiver.Annotations.FileName(),
+		fmt.Sprintf(
+			"Route with operation ID '%s' is not served in any API version. Check its @Since and @Until annotations",
+			receiver.Name,
+		),
+		diagnostics.DiagReceiverNoVersions,
+		rng,
+	))
+}
+
+// validateIdempotency warns of @Idempotent annotations on routes whose HTTP method is safe and, hence, idempotent by definition
+func (v ReceiverValidator) validateIdempotency(receiver *metadata.ReceiverMeta) *diagnostics.ResolvedDiagnostic {
+	attr := receiver.Annotations.GetFirst(annotations.GleeceAnnotationIdempotent)
+	if attr == nil {
+		return nil
+	}
+
+	verb := strings.ToUpper(receiver.Annotations.GetFirstValueOrEmpty(annotations.GleeceAnnotationMethod))
+	if !slices.Contains([]string{"GET", "HEAD", "OPTIONS", "TRACE"}, verb) {
+		return nil
+	}
+
+	return common.Ptr(diagnostics.NewWarningDiagnostic(
+		receiver.Annotations.FileName(),
+		fmt.Sprintf(
+			"Route with operation ID '%s' uses the safe HTTP method %s and does not require an 'Idempotency-Key' header",
+			receiver.Name,
+			verb,
+		),
+		diagnostics.DiagReceiverIdempotentSafeMethod,
+		attr.Comment.Range(),
+	))
+}
+
+// validateSecurityScopes cross-checks the schemas and scopes of the route's explicit or controller-inherited security
+// against the security schemes declared in the configuration.
+//
+// The configured default security is validated once, by the API validator
+func (v ReceiverValidator) validateSecurityScopes(receiver *metadata.ReceiverMeta) []diagnostics.ResolvedDiagnostic {
+	diags := []diagnostics.ResolvedDiagnostic{}
+	if v.gleeceConfig == nil {
+		return diags
+	}
+
+	isControllerPublic := v.parentController.Struct.Annotations.Has(annotations.GleeceAnnotationPublic)
+	if metadata.IsPublicRoute(receiver.Annotations, isControllerPublic) {
+		return diags
+	}
+
+	for _, source := range v.getExplicitSecuritySources(receiver) {
+		for _, component := range source.components {
+			diags = append(diags, validateSecurityComponent(v.gleeceConfig, source, component)...)
+		}
+	}
+
+	return diags
+}
+
+// getExplicitSecuritySources returns the sources of the route's own security or, if it has none, its controller's.
+// Routes with neither use the default security, which is not reported per route
+func (v ReceiverValidator) getExplicitSecuritySources(receiver *metadata.ReceiverMeta) []securitySource {
+	for _, holder := range []*annotations.AnnotationHolder{receiver.Annotations, v.parentController.Struct.Annotations} {
+		if holder.Has(annotations.GleeceAnnotationSecurity) || holder.Has(annotations.GleeceAnnotationAdvancedSecurity) {
+			return getSecuritySourcesFromHolder(holder)
+		}
+	}
+
+	return nil
+}
+
+// getSecuritySourcesFromHolder returns a security source for each of the holder's @Security and @AdvancedSecurity attributes.
+//
+// Malformed attributes are skipped - these are reported by the common validator
+func getSecuritySourcesFromHolder(holder *annotations.AnnotationHolder) []securitySource {
+	sources := []securitySource{}
+
+	for _, attr := range holder.GetAll(annotations.GleeceAnnotationSecurity) {
+		scopes, err := annotations.GetCastProperty[[]string](attr, annotations.PropertySecurityScopes)
+		if err != nil || attr.Value == "" {
+			continue
+		}
+
+		component := definitions.SecurityAnnotationComponent{SchemaName: attr.Value}
+		if scopes != nil {
+			component.Scopes = *scopes
+		}
+
+		sources = append(sources, securitySource{
+			description: "Security schema",
+			fileName:    holder.FileName(),
+			rng:         attr.Comment.Range(),
+			components:  []definitions.SecurityAnnotationComponent{component},
+		})
+	}
+
+	for _, attr := range holder.GetAll(annotations.GleeceAnnotationAdvancedSecurity) {
+		securities, err := metadata.GetAdvancedSecurity(attr)
+		if err != nil {
+			continue
+		}
+
+		for _, security := range securities {
+			sources = append(sources, securitySource{
+				description: "Security schema",
+				fileName:    holder.FileName(),
+				rng:         attr.Comment.Range(),
+				components:  security.SecurityAnnotation,
+				isAdvanced:  true,
+			})
+		}
+	}
+
+	return sources
+}
+
+// validateSecurityComponent checks that the component's schema is declared in the configuration and that its scopes,
+// if any, are supported and declared by the schema
+func validateSecurityComponent(
+	gleeceConfig *definitions.GleeceConfig,
+	source securitySource,
+	component definitions.SecurityAnnotationComponent,
+) []diagnostics.ResolvedDiagnostic {
+	schemes := gleeceConfig.OpenAPIGeneratorConfig.SecuritySchemes
+	schemeIndex := slices.IndexFunc(schemes, func(scheme definitions.SecuritySchemeConfig) bool {
+		return scheme.SecurityName == component.SchemaName
+	})
+
+	if schemeIndex < 0 {
+		if source.isAdvanced {
+			return nil
+		}
+
+		schemeNames := []string{}
+		for _, scheme := range schemes {
+			schemeNames = append(schemeNames, scheme.SecurityName)
+		}
+
+		return []diagnostics.ResolvedDiagnostic{diagnostics.NewErrorDiagnostic(
+			source.fileName,
+			fmt.Sprintf(
+				"%s '%s' is not defined in the Gleece configuration%s",
+				source.description,
+				component.SchemaName,
+				getClosestAppendedSuggestion(component.SchemaName, schemeNames),
+			),
+			diagnostics.DiagReceiverSecuritySchemaUnknown,
+			source.rng,
+		)}
+	}
+
+	scheme := schemes[schemeIndex]
+	if len(component.Scopes) <= 0 {
+		return nil
+	}
+
+	if scheme.Type != definitions.OAuth2 && scheme.Type != definitions.OpenIDConnect {
+		// Scopes of other schemas are passed along to the authorization function and may serve as roles
+		return []diagnostics.ResolvedDiagnostic{diagnostics.NewWarningDiagnostic(
+			source.fileName,
+			fmt.Sprintf(
+				"%s '%s' is of type '%s' which does not support scopes but specifies scopes '%s'",
+				source.description,
+				component.SchemaName,
+				scheme.Type,
+				strings.Join(component.Scopes, "', '"),
+			),
+			diagnostics.DiagReceiverSecurityScopesUnsupported,
+			source.rng,
+		)}
+	}
+
+	if scheme.Type == definitions.OpenIDConnect && scheme.Flows == nil {
+		// OpenID Connect scopes are usually published via the discovery document and cannot be checked here
+		return nil
+	}
+
+	declaredScopes := getDeclaredScopes(scheme.Flows)
+
+	diags := []diagnostics.ResolvedDiagnostic{}
+	for _, scope := range component.Scopes {
+		if slices.Contains(declaredScopes, scope) {
+			continue
+		}
+
+		diags = append(diags, diagnostics.NewErrorDiagnostic(
+			source.fileName,
+			fmt.Sprintf(
+				"Scope '%s' is not declared by any flow of security schema '%s'%s",
+				scope,
+				component.SchemaName,
+				getClosestAppendedSuggestion(scope, declaredScopes),
+			),
+			diagnostics.DiagReceiverSecurityScopeUnknown,
+			source.rng,
+		))
+	}
+
+	return diags
+}
+
+// getDeclaredScopes returns the sorted, de-duplicated scopes declared across all of the given OAuth flows
+func getDeclaredScopes(flows *definitions.OAuthFlows) []string {
+	scopes := []string{}
+	if flows == nil {
+		return scopes
+	}
+
+	for _, flow := range []*definitions.OAuthFlow{flows.Implicit, flows.Password, flows.ClientCredentials, flows.AuthorizationCode} {
+		if flow == nil {
+			continue
+		}
+
+		for scope := range flow.Scopes {
+			if !slices.Contains(scopes, scope) {
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+
+	slices.Sort(scopes)
+	return scopes
+}
+
+// getClosestAppendedSuggestion returns a ". Did you mean 'x'?" suffix for the option closest to the given input,
+// or an empty string if there are no options
+func getClosestAppendedSuggestion(input string, opts []string) string {
+	suggestion := language.Closest(input, opts)
+	if suggestion == "" {
+		return ""
+	}
+
+	return fmt.Sprintf(". Did you mean '%s'?", suggestion)
+}
+
 func getDiagForRetSig(receiver *metadata.ReceiverMeta) (int, *diagnostics.ResolvedDiagnostic) {
 	// Note that controller methods must return and error or (any, error)
 	var errorRetTypeIndex int
@@ -35,6 +35,11 @@ func (c executionContext) IsApiEndpoint() bool {
 		return false
 	}
 
+	// Webhooks document outbound calls and therefore have no route of their own
+	if c.Annotations.Has(annotations.GleeceAnnotationWebhook) {
+		return true
+	}
+
 	routePath := c.Annotations.GetFirstValueOrEmpty(annotations.GleeceAnnotationRoute)
 	return len(routePath) > 0
 }
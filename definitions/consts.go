@@ -3,6 +3,12 @@ package definitions
 // The name of the RFC7807 error struct
 const Rfc7807ErrorName = "Rfc7807Error"
 
+// The name of the RFC7807 error struct returned when a request fails validation
+const ValidationErrorName = "ValidationError"
+
+// The name of the struct describing a single failed validation within a ValidationError
+const ValidationIssueName = "ValidationIssue"
+
 // The full package path of the RFC7807 error struct
 const Rfc7807ErrorFullPackage = "github.com/gopher-fleece/runtime"
 
@@ -9,6 +9,8 @@ const (
 	PassedInPath   ParamPassedIn = "Path"
 	PassedInBody   ParamPassedIn = "Body"
 	PassedInForm   ParamPassedIn = "Form"
+	// The parameter is the request's authenticated principal, as attached by the authorization layer
+	PassedInPrincipal ParamPassedIn = "Principal"
 )
 
 // An HTTP verb such as POST or GET
@@ -118,3 +120,41 @@ const (
 	RoutingEngineFiber RoutingEngineType = "fiber"
 	RoutingEngineChi   RoutingEngineType = "chi"
 )
+
+// Determines how the generated routing code is split into files
+type RoutesOutputMode string
+
+const (
+	// All routing code is written to the configured output path
+	RoutesOutputModeSingleFile RoutesOutputMode = "singleFile"
+	// Each controller's routes are written to a separate file alongside the configured output path,
+	// which holds the shared helpers and registration code
+	RoutesOutputModePerController RoutesOutputMode = "perController"
+	// The routes of each source package's controllers are written to a separate file alongside the configured output path,
+	// which holds the shared helpers and registration code
+	RoutesOutputModePerPackage RoutesOutputMode = "perPackage"
+)
+
+// Determines how consumers select the API version of an operation
+type VersioningStrategy string
+
+const (
+	// The version is selected via a '/v{version}' path prefix, e.g. '/v2/users'
+	VersioningStrategyPath VersioningStrategy = "path"
+	// The version is selected via a request header, e.g. 'X-API-Version: 2'
+	VersioningStrategyHeader VersioningStrategy = "header"
+	// The version is selected via a parameter of the 'Accept' header's media type, e.g. 'application/json; version=2'
+	VersioningStrategyMediaType VersioningStrategy = "mediaType"
+)
+
+// Determines by which key the requests counted against an operation's rate limit are grouped
+type RateLimitKey = string
+
+const (
+	// Requests are counted per client IP address
+	RateLimitKeyIp RateLimitKey = "ip"
+	// Requests are counted per authenticated principal, as attached by the security handlers
+	RateLimitKeyPrincipal RateLimitKey = "principal"
+	// Requests are counted per value of a request header, named after the prefix, e.g. 'header:X-Api-Key'
+	RateLimitKeyHeaderPrefix RateLimitKey = "header:"
+)
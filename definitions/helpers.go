@@ -3,6 +3,7 @@ package definitions
 import (
 	"fmt"
 	"os"
+	"regexp"
 	"slices"
 	"strconv"
 
@@ -23,15 +24,22 @@ var validHttpVerbs = map[string]struct{}{
 }
 
 // A map of HTTP verbs supported by Gleece routes.
-// This is expected to eventually become deprecated as support for additional verbs is added
+//
+// CONNECT is omitted as it establishes a tunnel rather than performing an operation
 var routeSupportedHttpVerbs = map[string]struct{}{
-	string(HttpGet):    {},
-	string(HttpPost):   {},
-	string(HttpPut):    {},
-	string(HttpDelete): {},
-	string(HttpPatch):  {},
+	string(HttpGet):     {},
+	string(HttpPost):    {},
+	string(HttpPut):     {},
+	string(HttpDelete):  {},
+	string(HttpPatch):   {},
+	string(HttpOptions): {},
+	string(HttpHead):    {},
+	string(HttpTrace):   {},
 }
 
+// The format of custom, non-standard HTTP verbs such as PURGE or PROPFIND
+var customHttpVerbRegex = regexp.MustCompile(`^[A-Z][A-Z-]*$`)
+
 var validHttpStatusCode = map[uint]struct{}{
 	uint(runtime.StatusContinue):                      {},
 	uint(runtime.StatusSwitchingProtocols):            {},
@@ -135,6 +143,13 @@ func IsValidRouteHttpVerb(verb string) bool {
 	return exists
 }
 
+// IsCustomHttpVerb determines whether a given string is a non-standard HTTP verb, i.e. 'PURGE'.
+//
+// Custom verbs must be upper-case and cannot be represented in the OpenAPI specification
+func IsCustomHttpVerb(verb string) bool {
+	return !IsValidHttpVerb(verb) && customHttpVerbRegex.MatchString(verb)
+}
+
 // IsValidHttpStatusCode determines whether the given code is a known, valid HTTP Status Code
 func IsValidHttpStatusCode(code uint) bool {
 	_, exists := validHttpStatusCode[code]
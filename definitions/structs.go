@@ -1,6 +1,10 @@
 package definitions
 
 import (
+	"maps"
+	"slices"
+	"strings"
+
 	"github.com/gopher-fleece/gleece/v2/common"
 	"github.com/gopher-fleece/runtime"
 )
@@ -65,6 +69,8 @@ type FuncParam struct {
 	Validator string
 	// Information about whether this parameter has been deprecated and why
 	Deprecation DeprecationOptions
+	// OpenAPI vendor extensions (x-*) for the parameter, provided via @Extension annotations with a 'param' property
+	Extensions map[string]any
 }
 
 // Describes a method's return value
@@ -204,6 +210,20 @@ type ErrorResponse struct {
 	Description string
 }
 
+// A header returned by an API endpoint, in the context of the OpenAPI schema
+type ResponseHeader struct {
+	// The header's name, e.g. "X-RateLimit-Remaining"
+	Name string
+	// The header value's OpenAPI type, i.e., "string", "integer", "number" or "boolean"
+	Type string
+	// A description for the header
+	Description string
+	// The status codes of the responses that carry the header.
+	//
+	// An empty list denotes all of the endpoint's responses
+	StatusCodes []runtime.HttpStatusCode
+}
+
 // Additional context to be made available when rendering the routing template.
 //
 // This is used via the @TemplateContext annotation to allow for injection of custom behaviors at the route level.
@@ -284,8 +304,95 @@ type RouteMetadata struct {
 	// The security schema/s used for the operation
 	Security []RouteSecurity // OR between security routes
 
+	// Whether the operation is explicitly exempt from security, via the @Public annotation.
+	//
+	// Public operations skip authorization altogether and are not subject to default or enforced security
+	IsPublic bool
+
+	// Whether a HEAD route sharing the operation's handler is registered alongside it.
+	//
+	// Set by the routes generator for GET operations when 'deriveHeadFromGet' is enabled and no explicit HEAD operation shares the path
+	HasDerivedHead bool
+
+	// Headers returned by the operation.
+	//
+	// Provided using the @ResponseHeader annotation and merged with the controller's, with route-level headers taking precedence
+	ResponseHeaders []ResponseHeader
+
+	// OpenAPI vendor extensions (x-*) for the operation.
+	//
+	// Provided using the @Extension annotation and merged with the controller's, with route-level extensions taking precedence
+	Extensions map[string]any
+
+	// The operation's OpenAPI tags.
+	//
+	// Provided using the @Tag annotation and inherited from the controller, if not overridden
+	Tags []OpenAPITag
+
+	// Alternative servers serving the operation.
+	//
+	// Provided using the @Server annotation and inherited from the controller, if not overridden.
+	// When empty, the operation is served by the root-level servers
+	Servers []OpenAPIServer
+
 	// Custom template context for the operation, provided by the route developer, used template extension/override
 	TemplateContext map[string]TemplateContext
+
+	// The names of the middlewares to invoke, in order, before the operation.
+	//
+	// Provided using the @Middleware annotation and appended to the controller's.
+	// Named middlewares are registered at runtime via the generated RegisterNamedMiddleware function
+	Middlewares []string
+
+	// Information about the outbound webhook this method documents.
+	//
+	// Provided using the @Webhook annotation. When set, the method is emitted only into the specification
+	// (as a 3.1 webhook or a 3.0 callback) and no routing code is generated for it
+	Webhook *WebhookMetadata
+
+	// The API versions in which the operation is served, in ascending order.
+	//
+	// Provided using the @Version, @Since and @Until annotations and inherited from the controller.
+	// Nil for unversioned operations, which are served in all versions
+	Versions []int
+
+	// The rate limit applied to the operation's requests.
+	//
+	// Provided using the @RateLimit annotation and inherited from the controller, if not overridden.
+	// Nil for operations without a rate limit
+	RateLimit *RateLimit
+
+	// The duration, in Go duration format (e.g. '5s'), after which the operation's request context expires.
+	//
+	// Provided using the @Timeout annotation and inherited from the controller or the configured default, if not overridden.
+	// Empty for operations without a deadline
+	Timeout string
+
+	// The maximum size, in bytes, of the operation's request body.
+	//
+	// Provided using the @MaxBodySize annotation and inherited from the controller or the configured default, if not overridden.
+	// Zero for operations without a body size limit
+	MaxBodySize int64
+
+	// Determines how repeated requests of the operation are deduplicated.
+	//
+	// Provided using the @Idempotent annotation. Nil for operations that do not require an 'Idempotency-Key' header
+	Idempotency *Idempotency
+}
+
+// IsVersioned returns whether the operation is served in specific API versions only
+func (m RouteMetadata) IsVersioned() bool {
+	return m.Versions != nil
+}
+
+// IsServedInVersion returns whether the operation is served in the given API version
+func (m RouteMetadata) IsServedInVersion(version int) bool {
+	return !m.IsVersioned() || slices.Contains(m.Versions, version)
+}
+
+// IsWebhook returns whether the method documents an outbound webhook rather than an API endpoint
+func (m RouteMetadata) IsWebhook() bool {
+	return m.Webhook != nil
 }
 
 // GetValueReturnType returns the TypeMetadata for the API endpoint's primary (success) response type.
@@ -326,6 +433,57 @@ func (m RouteMetadata) GetErrorReturnType() *TypeMetadata {
 	return &m.Responses[1].TypeMetadata
 }
 
+// The default runtime expression used as the callback URL of webhooks emitted as OpenAPI 3.0 callbacks
+const DefaultWebhookCallbackUrl = "{$request.body#/callbackUrl}"
+
+// Describes the number of requests an operation accepts per time window, as provided by the @RateLimit annotation
+type RateLimit struct {
+	// The number of requests allowed per window
+	Requests int `json:"requests"`
+
+	// The window's duration, in Go duration format, e.g. '1m' or '30s'
+	Per string `json:"per"`
+
+	// The key by which requests are counted - 'ip', 'principal' or 'header:<Name>'.
+	//
+	// Defaults to 'ip'
+	Key RateLimitKey `json:"key"`
+
+	// Whether the limit is inherited from the route's controller, in which case it is shared by all of the controller's routes
+	// inheriting it rather than applied to each separately
+	IsInherited bool `json:"-"`
+}
+
+// The request header carrying the key by which repeated requests of idempotent operations are identified
+const IdempotencyKeyHeaderName = "Idempotency-Key"
+
+// The default duration for which the responses of idempotent operations are replayed
+const DefaultIdempotencyTtl = "24h"
+
+// Describes how repeated requests of an operation are deduplicated, as provided by the @Idempotent annotation
+type Idempotency struct {
+	// The duration, in Go duration format, for which the operation's responses are replayed to repeated requests.
+	//
+	// Defaults to DefaultIdempotencyTtl
+	Ttl string `json:"ttl"`
+}
+
+// Describes an outbound webhook (or callback) documented by a controller method
+type WebhookMetadata struct {
+	// The webhook's name, used as its key in the 3.1 'webhooks' and 3.0 'callbacks' sections
+	Name string
+
+	// The runtime expression used as the callback URL in 3.0 'callbacks' sections.
+	//
+	// Defaults to DefaultWebhookCallbackUrl
+	CallbackUrl string
+
+	// The IDs of the operations that trigger the webhook.
+	//
+	// In 3.0 specifications, the webhook is emitted as a callback of these operations only
+	Operations []string
+}
+
 // Describes the security that applies to an API endpoint
 type RouteSecurity struct {
 	// The security annotations for the endpoint.
@@ -358,12 +516,17 @@ type ControllerMetadata struct {
 	// The controller's full package path
 	PkgPath string
 
-	// The controller's OpenAPI tag
+	// The controller's primary OpenAPI tag, i.e., the first of its Tags
 	//
 	// Provided using the @Tag annotation, tags are emitted to the OpenAPI schemas
 	// and are mostly used by visualizers to group API endpoints in the UI.
 	Tag string
 
+	// The controller's OpenAPI tags, in order of declaration.
+	//
+	// Provided using one or more @Tag annotations and may be overridden at the route level
+	Tags []OpenAPITag
+
 	// The controller's description
 	//
 	// Provided via the @Description annotation, if one exists, or the first contiguous lines of the struct's standard Go description
@@ -378,6 +541,63 @@ type ControllerMetadata struct {
 	// The default security schema/s used for the controller's operations.
 	// Inherited from configuration and may be overridden at either controller or route levels
 	Security []RouteSecurity
+
+	// Whether the controller's operations are exempt from security, via the @Public annotation.
+	//
+	// Operations with an explicit @Security or @AdvancedSecurity annotation remain secured
+	IsPublic bool
+
+	// OpenAPI vendor extensions (x-*) applied to all of the controller's operations.
+	//
+	// Provided using the @Extension annotation
+	Extensions map[string]any
+
+	// The names of the middlewares to invoke, in order, before each of the controller's operations.
+	//
+	// Provided using the @Middleware annotation
+	Middlewares []string
+
+	// Headers returned by all of the controller's operations.
+	//
+	// Provided using the @ResponseHeader annotation
+	ResponseHeaders []ResponseHeader
+
+	// Alternative servers serving the controller's operations.
+	//
+	// Provided using the @Server annotation and may be overridden at the route level
+	Servers []OpenAPIServer
+
+	// The API versions in which the controller's operations are served, in ascending order.
+	//
+	// Provided using the @Version, @Since and @Until annotations and may be narrowed or overridden at the route level.
+	// Nil for unversioned controllers
+	Versions []int
+
+	// The rate limit applied to each of the controller's operations.
+	//
+	// Provided using the @RateLimit annotation and may be overridden at the route level
+	RateLimit *RateLimit
+
+	// The duration, in Go duration format, after which the request context of each of the controller's operations expires.
+	//
+	// Provided using the @Timeout annotation or the configured default and may be overridden at the route level
+	Timeout string
+
+	// The maximum size, in bytes, of the request body of each of the controller's operations.
+	//
+	// Provided using the @MaxBodySize annotation or the configured default and may be overridden at the route level
+	MaxBodySize int64
+}
+
+// GetRoutableRoutes returns the controller's routes for which routing code is generated, i.e., all non-webhook routes
+func (m ControllerMetadata) GetRoutableRoutes() []RouteMetadata {
+	routes := []RouteMetadata{}
+	for _, route := range m.Routes {
+		if !route.IsWebhook() {
+			routes = append(routes, route)
+		}
+	}
+	return routes
 }
 
 // Encapsulates information about a particular structure.
@@ -400,6 +620,9 @@ type StructMetadata struct {
 
 	// Information about whether the structure has been deprecated and why
 	Deprecation DeprecationOptions
+
+	// OpenAPI vendor extensions (x-*) for the structure's schema, provided via @Extension annotations
+	Extensions map[string]any
 }
 
 // Clone returns a copy of the structure's metadata
@@ -421,6 +644,7 @@ func (s StructMetadata) Clone() StructMetadata {
 			Tag:         field.Tag,
 			IsEmbedded:  field.IsEmbedded,
 			Deprecation: deprecationOpts,
+			Extensions:  maps.Clone(field.Extensions),
 		})
 	}
 
@@ -430,6 +654,7 @@ func (s StructMetadata) Clone() StructMetadata {
 		Description: s.Description,
 		Fields:      fields,
 		Deprecation: s.Deprecation,
+		Extensions:  maps.Clone(s.Extensions),
 	}
 }
 
@@ -490,6 +715,9 @@ type FieldMetadata struct {
 
 	// Information about whether the field has been deprecated
 	Deprecation *DeprecationOptions
+
+	// OpenAPI vendor extensions (x-*) for the field's schema, provided via @Extension annotations
+	Extensions map[string]any
 }
 
 // Contains models for the OpenAPI schema
@@ -578,6 +806,53 @@ type OpenAPIInfo struct {
 	Version string `json:"version" validate:"required"`
 }
 
+// A variable used for substitution in a server's URL template
+type OpenAPIServerVariable struct {
+	// The set of values the variable may take, if limited
+	Enum []string `json:"enum"`
+	// The value to use for substitution when no other value is supplied.
+	//
+	// Must be one of the 'Enum' values, if any are given
+	Default string `json:"default" validate:"required,server_variable_default"`
+	// A description for the variable
+	Description string `json:"description"`
+}
+
+// A server on which the API is hosted
+type OpenAPIServer struct {
+	// A unique name for the server.
+	//
+	// Used to reference the server from @Server annotations and is not emitted to the schema
+	Name string `json:"name"`
+	// The server's URL.
+	//
+	// May contain variables in curly braces, e.g. `https://{region}.api.example.com`.
+	// Each such variable must be declared in 'Variables'
+	URL string `json:"url" validate:"required,server_url_variables"`
+	// A description for the server, e.g. "Staging"
+	Description string `json:"description"`
+	// Variables for substitution in the server's URL template
+	Variables map[string]OpenAPIServerVariable `json:"variables" validate:"dive"`
+}
+
+// A reference to external documentation
+type OpenAPIExternalDocs struct {
+	// A description for the referenced documentation
+	Description string `json:"description"`
+	// The documentation's URL
+	URL string `json:"url" validate:"required,url"`
+}
+
+// Describes an OpenAPI tag, used to group operations
+type OpenAPITag struct {
+	// The tag's name, as referenced by @Tag annotations
+	Name string `json:"name" validate:"required"`
+	// A description for the tag
+	Description string `json:"description"`
+	// Additional external documentation for the tag
+	ExternalDocs *OpenAPIExternalDocs `json:"externalDocs"`
+}
+
 // Configuration for the OpenAPI generator
 type OpenAPIGeneratorConfig struct {
 	// The OpenAPI schema version, e.g., "3.0.0"
@@ -588,7 +863,23 @@ type OpenAPIGeneratorConfig struct {
 	//
 	// Final API endpoint URL is comprised of
 	//	`{BASE_URL}/{CONTROLLER_URL}/{ROUTE_URL}`
-	BaseURL string `json:"baseUrl" validate:"required,url"`
+	//
+	// Optional when 'Servers' are specified.
+	BaseURL string `json:"baseUrl" validate:"required_without=Servers,omitempty,url"`
+	// The servers on which the API is hosted.
+	//
+	// If a BaseURL is specified as well, it is emitted as the first server
+	Servers []OpenAPIServer `json:"servers" validate:"dive"`
+	// Top-level tag definitions.
+	//
+	// Tags are emitted in the order given here, followed by any other described tags
+	// (i.e., tags that have a description or external docs in their @Tag annotations), sorted by name.
+	// Descriptions given here take precedence over those given via annotations
+	Tags []OpenAPITag `json:"tags" validate:"dive"`
+	// OpenAPI vendor extensions (x-*) for the schema's root document.
+	//
+	// Keys must begin with 'x-'. Values are emitted verbatim
+	Extensions map[string]any `json:"extensions" validate:"dive,keys,startswith=x-,endkeys"`
 	// The security schema definitions for the API.
 	//
 	// Controllers and routes may specify which of the schemas they adhere to
@@ -609,6 +900,14 @@ type RoutesConfig struct {
 	PackageName string `json:"packageName"`
 	// The path to output the generated routing code to
 	OutputPath string `json:"outputPath" validate:"required,filepath"`
+	// Determines how the generated routing code is split into files. Defaults to 'singleFile'.
+	//
+	// In 'perController' mode, each controller's routes are written to '<OutputPath name>.<lower-cased controller>.go' next to OutputPath.
+	// In 'perPackage' mode, the routes of each source package's controllers are written to
+	// '<OutputPath name>.<lower-cased package name>.go' next to OutputPath.
+	// Generated package, registration functions and helpers are identical in all modes.
+	// Generated '<OutputPath name>.*.go' files not written by the current run, e.g. those of removed controllers, are deleted
+	OutputMode RoutesOutputMode `json:"outputMode" validate:"omitempty,oneof=singleFile perController perPackage"`
 	// The permissions for the generated routing code.
 	//
 	// Not relevant for Windows-based machines
@@ -637,16 +936,92 @@ type RoutesConfig struct {
 	//
 	// Generation timestamps, while handy, can create 'fake' changes to the generated routing code.
 	SkipGenerateDateComment bool `json:"skipGenerateDateComment"`
+	// Determines whether raw error strings returned by controllers are omitted from RFC7807 error responses.
+	//
+	// By default, the text of a plain 'error' is exposed via the 'error' extension which may leak internal details.
+	HideRawErrors bool `json:"hideRawErrors"`
+	// Determines whether every controller must have a factory registered via the generated 'RegisterControllerFactory'.
+	//
+	// When set, 'RegisterRoutes' panics if a controller has no registered factory.
+	// Otherwise, controllers without a factory are instantiated using their zero value
+	RequireControllerFactories bool `json:"requireControllerFactories"`
+	// The path under which the generated 'RegisterRoutes' mounts the API's routes, e.g. '/api'.
+	//
+	// The base path is appended to the URLs of the OpenAPI specification's root servers
+	BasePath string `json:"basePath" validate:"omitempty,startswith=/"`
+	// Determines whether the generated routes are instrumented using OpenTelemetry.
+	//
+	// When set, each operation is traced by a span named after its operation ID, with child spans covering
+	// authorization, validation and handler execution, and request duration and count metrics are recorded.
+	// The generated code references the OpenTelemetry API only when this option is set
+	EnableOpenTelemetry bool `json:"enableOpenTelemetry"`
+	// Determines whether panics raised by controller operations are recovered by the generated routes.
+	//
+	// When set, a recovered panic is passed to the 'OnOperationError' middlewares as a 'PanicError' carrying the stack trace
+	// and is answered with a 500 RFC7807 error. Panics may additionally be reported via the generated 'RegisterPanicReporter'
+	RecoverPanics bool `json:"recoverPanics"`
+	// Determines whether each GET route is also registered for HEAD requests, using the same handler.
+	//
+	// Routes with an explicit @Method(HEAD) operation on the same path are left as-is.
+	// Derived HEAD routes are not included in the OpenAPI specification
+	DeriveHeadFromGet bool `json:"deriveHeadFromGet"`
+	// The duration, in Go duration format (e.g. '30s'), after which the request context of operations without a @Timeout annotation expires.
+	//
+	// Operations whose deadline expires are answered with a 503 (before the operation is invoked) or 504 (after it returns) RFC7807 error.
+	// Operations are not interrupted - controllers are expected to honor their context's cancellation
+	DefaultTimeout string `json:"defaultTimeout"`
+	// The maximum request body size of operations without a @MaxBodySize annotation, e.g. '1MB'.
+	//
+	// Sizes are given in bytes or using the 'KB', 'MB' or 'GB' (1024-based) units.
+	// Requests whose body exceeds the limit are answered with a 413 RFC7807 error
+	DefaultMaxBodySize string `json:"defaultMaxBodySize"`
+}
+
+// Configuration for API versioning
+type VersioningConfig struct {
+	// The API's versions, e.g. [1, 2].
+	//
+	// Controllers and routes select the versions they're served in via the @Version, @Since and @Until annotations.
+	// Operations without such annotations are served, unprefixed, in all versions
+	Versions []int `json:"versions" validate:"required,min=1,dive,min=1"`
+	// Determines how consumers select a version. Defaults to 'path'.
+	//
+	// In 'path' mode, versioned operations are served under a '/v{version}' prefix.
+	// In 'header' and 'mediaType' modes, operations are served under their plain paths and dispatched according to the requested version
+	Strategy VersioningStrategy `json:"strategy" validate:"omitempty,oneof=path header mediaType"`
+	// The request header specifying the requested version in 'header' mode. Defaults to 'X-API-Version'
+	HeaderName string `json:"headerName"`
+	// The 'Accept' media type parameter specifying the requested version in 'mediaType' mode. Defaults to 'version'
+	MediaTypeParameter string `json:"mediaTypeParameter"`
+	// The version served to requests that do not specify one in 'header' and 'mediaType' modes.
+	// Defaults to the latest version.
+	//
+	// The default version's OpenAPI specification is written to the configured output path
+	DefaultVersion int `json:"defaultVersion" validate:"omitempty,min=1"`
 }
 
 // Configuration pertaining to the API authentication/authorization
 type AuthorizationConfig struct {
-	// The full package name for the file containing the authentication middleware
-	AuthFileFullPackageName string `json:"authFileFullPackageName" validate:"required,filepath"`
+	// The full package name for the file containing the authentication middleware.
+	//
+	// Not required when UseSecurityHandlers is set
+	AuthFileFullPackageName string `json:"authFileFullPackageName" validate:"required_unless=UseSecurityHandlers true,omitempty,filepath"`
 	// Determines whether the generation pipeline should fail if any route does not have any security.
 	//
 	// This feature meant to ensure all routes are secured by default regardless of developer familiarity and care
 	EnforceSecurityOnAllRoutes bool `json:"enforceSecurityOnAllRoutes"`
+	// Determines whether each security scheme is authorized by its own handler, registered via RegisterSecurityHandler,
+	// instead of the authentication package's GleeceRequestAuthorization function.
+	//
+	// Handlers receive the credentials extracted from the location defined by the scheme's configuration.
+	// A handler must be registered for every configured security scheme, which is verified when registering the routes
+	UseSecurityHandlers bool `json:"useSecurityHandlers"`
+	// The fully qualified type of the principal the authorization layer attaches to authorized requests,
+	// i.e., 'github.com/org/project/auth.User'.
+	//
+	// Security handlers attach the principal by returning a context wrapped with the generated WithPrincipal function.
+	// Parameters annotated with @Principal must be of this type (or a pointer to it)
+	PrincipalType string `json:"principalType"`
 }
 
 // Common Gleece pipeline configurations
@@ -709,4 +1084,69 @@ type GleeceConfig struct {
 	OpenAPIGeneratorConfig OpenAPIGeneratorConfig `json:"openapiGeneratorConfig" validate:"required"`
 	// Configuration of experimental or otherwise advanced features
 	ExperimentalConfig ExperimentalConfig `json:"experimentalConfig"` // TODO add docs
+	// Configuration for API versioning. Versioning is disabled when omitted
+	Versioning *VersioningConfig `json:"versioning"`
+}
+
+// GetVersioningConfig returns the versioning configuration with defaults applied to any omitted settings,
+// or nil if versioning is not configured
+func (c *GleeceConfig) GetVersioningConfig() *VersioningConfig {
+	if c.Versioning == nil {
+		return nil
+	}
+
+	versioning := *c.Versioning
+	versioning.Versions = slices.Sorted(slices.Values(c.Versioning.Versions))
+	if versioning.Strategy == "" {
+		versioning.Strategy = VersioningStrategyPath
+	}
+
+	if versioning.HeaderName == "" {
+		versioning.HeaderName = "X-API-Version"
+	}
+
+	if versioning.MediaTypeParameter == "" {
+		versioning.MediaTypeParameter = "version"
+	}
+
+	if versioning.DefaultVersion == 0 && len(versioning.Versions) > 0 {
+		versioning.DefaultVersion = versioning.Versions[len(versioning.Versions)-1]
+	}
+
+	return &versioning
+}
+
+// GetOpenAPIGeneratorConfig returns the OpenAPI generator's configuration with the routes' base path, if any,
+// appended to the URLs of the root servers so the specification matches the served routes
+func (c *GleeceConfig) GetOpenAPIGeneratorConfig() *OpenAPIGeneratorConfig {
+	specConfig := c.OpenAPIGeneratorConfig
+	if strings.Trim(c.RoutesConfig.BasePath, "/") == "" {
+		return &specConfig
+	}
+
+	if specConfig.BaseURL != "" {
+		specConfig.BaseURL = c.WithRoutesBasePath(specConfig.BaseURL)
+	}
+
+	specConfig.Servers = make([]OpenAPIServer, 0, len(c.OpenAPIGeneratorConfig.Servers))
+	for _, server := range c.OpenAPIGeneratorConfig.Servers {
+		server.URL = c.WithRoutesBasePath(server.URL)
+		specConfig.Servers = append(specConfig.Servers, server)
+	}
+
+	return &specConfig
+}
+
+// WithRoutesBasePath returns the given server URL with the routes' base path, if any, appended
+func (c *GleeceConfig) WithRoutesBasePath(url string) string {
+	if c == nil {
+		return url
+	}
+
+	basePath := strings.Trim(c.RoutesConfig.BasePath, "/")
+	if basePath == "" {
+		return url
+	}
+
+	return strings.TrimSuffix(url, "/") + "/" + basePath
 }
@@ -3,6 +3,7 @@ package assets
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
 	"net/http"
@@ -45,10 +46,17 @@ type ObjectWithByteSlice struct {
 // @Tag(E2E)
 type E2EController struct {
 	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+	greeting                 string
+}
+
+// NewE2EController creates an E2EController with the given injected greeting
+func NewE2EController(greeting string) *E2EController {
+	return &E2EController{greeting: greeting}
 }
 
 // @Method(GET) This text is not part of the OpenAPI spec
 // @Route(/simple-get)
+// @ResponseHeader(X-Test-Header, { statusCodes: [200] }) A header set by the operation
 func (ec *E2EController) SimpleGet() (string, error) {
 	ec.SetHeader("X-Test-Header", "test")
 	return "works", nil
@@ -73,6 +81,14 @@ func (ec *E2EController) SimpleGetNullString() (*string, error) {
 	return nil, nil
 }
 
+// @Method(GET) This text is not part of the OpenAPI spec
+// @Route(/named-middlewares)
+// @Middleware(audit)
+// @Middleware(trace)
+func (ec *E2EController) NamedMiddlewares() (string, error) {
+	return "works", nil
+}
+
 type BodyResponse struct {
 	Data string `json:"data"`
 }
@@ -257,6 +273,25 @@ func (ec *E2EClassSecController) WithOverrideClassSecurity(headerParam string) (
 	return headerParam, nil
 }
 
+// @Route(/e2e/shared-rate-limit)
+// @RateLimit({ requests: 2, per: "1h", key: "header:X-Rate-Limit-Key" })
+// @Tag(E2E)
+type E2ESharedRateLimitController struct {
+	runtime.GleeceController // Embedding the GleeceController to inherit its methods
+}
+
+// @Method(GET)
+// @Route(/first)
+func (ec *E2ESharedRateLimitController) SharedRateLimitFirst() (string, error) {
+	return "first", nil
+}
+
+// @Method(GET)
+// @Route(/second)
+func (ec *E2ESharedRateLimitController) SharedRateLimitSecond() (string, error) {
+	return "second", nil
+}
+
 // @Method(GET)
 // @Header(headerParam, { name: "x-test-scopes" })
 // @Route(/with-default-config-security)
@@ -281,6 +316,115 @@ func (ec *E2EController) WithTwoSecurity(headerParam string) (string, error) {
 	return headerParam, nil
 }
 
+// @Method(GET)
+// @Route(/with-advanced-security)
+// @Header(headerParam, { name: "x-test-scopes" })
+// @AdvancedSecurity(OR, { securities: [{ relation: "AND", securities: [{ name: "securitySchemaName", scopes: ["other"] }, { name: "securitySchemaName2", scopes: ["write"] }] }, { name: "securitySchemaName3", scopes: ["admin"] }] })
+func (ec *E2EController) WithAdvancedSecurity(headerParam string) (string, error) {
+	return headerParam, nil
+}
+
+// @Method(GET)
+// @Route(/with-principal)
+// @Principal(principal)
+// @Security(securitySchemaName, { scopes: ["other"] })
+func (ec *E2EController) WithPrincipal(principal *E2EPrincipal) (string, error) {
+	return principal.Name, nil
+}
+
+// @Method(GET)
+// @Route(/with-principal-advanced-security)
+// @Principal(principal)
+// @AdvancedSecurity(OR, { securities: [{ relation: "AND", securities: [{ name: "securitySchemaName", scopes: ["other"] }, { name: "securitySchemaName2", scopes: ["write"] }] }, { name: "securitySchemaName3", scopes: ["admin"] }] })
+func (ec *E2EController) WithPrincipalAdvancedSecurity(principal *E2EPrincipal) (string, error) {
+	return principal.Name, nil
+}
+
+// @Method(GET)
+// @Route(/public)
+// @Public
+func (ec *E2EController) PublicRoute() (string, error) {
+	return "public", nil
+}
+
+// @Method(HEAD)
+// @Route(/head-check)
+// @ResponseHeader(X-Head-Check) Set when the resource exists
+func (ec *E2EController) HeadCheck() error {
+	ec.SetHeader("X-Head-Check", "exists")
+	return nil
+}
+
+// @Method(OPTIONS)
+// @Route(/options-check)
+func (ec *E2EController) OptionsCheck() (string, error) {
+	return "options", nil
+}
+
+// @Method(TRACE)
+// @Route(/trace-check)
+func (ec *E2EController) TraceCheck() (string, error) {
+	return "trace", nil
+}
+
+// @Method(PURGE)
+// @Route(/purge-check)
+func (ec *E2EController) PurgeCheck() (string, error) {
+	return "purge", nil
+}
+
+// @Method(GET)
+// @Route(/versioned)
+// @Version(1)
+func (ec *E2EController) VersionedV1() (string, error) {
+	return "v1", nil
+}
+
+// @Method(GET)
+// @Route(/versioned)
+// @Since(2)
+func (ec *E2EController) VersionedV2() (string, error) {
+	return "v2", nil
+}
+
+// @Method(GET)
+// @Route(/rate-limited)
+// @RateLimit({ requests: 2, per: "1h", key: "header:X-Rate-Limit-Key" })
+func (ec *E2EController) RateLimited() (string, error) {
+	return "allowed", nil
+}
+
+// @Method(GET)
+// @Route(/timeout)
+// @Timeout(50ms)
+func (ec *E2EController) TimedOut(ctx context.Context) (string, error) {
+	<-ctx.Done()
+	return "too late", nil
+}
+
+// @Method(POST)
+// @Route(/max-body-size)
+// @Body(data)
+// @MaxBodySize(64B)
+func (ec *E2EController) MaxBodySize(data ObjectWithByteSlice) (string, error) {
+	return "accepted", nil
+}
+
+// @Method(POST)
+// @Route(/idempotent)
+// @Idempotent({ ttl: "1h" })
+func (ec *E2EController) Idempotent() (string, error) {
+	return "processed", nil
+}
+
+// @Method(POST)
+// @Route(/idempotent-with-body)
+// @Body(data)
+// @Idempotent({ ttl: "1h" })
+func (ec *E2EController) IdempotentWithBody(data BodyInfo) (string, error) {
+	return data.BodyParam, nil
+}
+
 // @Method(GET)
 // @Route(/with-two-security-same-method)
 // @Header(headerParam, { name: "x-test-scopes" })
@@ -314,6 +458,48 @@ func (ec *E2EController) DefaultErrorWithPayload() (*string, error) {
 	return nil, fmt.Errorf("default error")
 }
 
+var ErrE2EResourceMissing = errors.New("e2e resource missing")
+
+type E2EConflictError struct {
+	Resource string
+}
+
+func (e *E2EConflictError) Error() string {
+	return fmt.Sprintf("resource '%s' is in conflict", e.Resource)
+}
+
+// @Method(GET)
+// @Route(/injected-dependency)
+func (ec *E2EController) InjectedDependency() (string, error) {
+	return ec.greeting, nil
+}
+
+// @Method(GET)
+// @Route(/mapped-error)
+// @ErrorResponse(404) The resource was not found
+func (ec *E2EController) MappedError() error {
+	return fmt.Errorf("failed to load resource - %w", ErrE2EResourceMissing)
+}
+
+// @Method(GET)
+// @Route(/undeclared-mapped-error)
+func (ec *E2EController) UndeclaredMappedError() error {
+	return fmt.Errorf("failed to load resource - %w", ErrE2EResourceMissing)
+}
+
+// @Method(GET)
+// @Route(/mapped-typed-error)
+// @ErrorResponse(409) The resource is in conflict
+func (ec *E2EController) MappedTypedError() (string, error) {
+	return "", fmt.Errorf("failed to update resource - %w", &E2EConflictError{Resource: "e2e"})
+}
+
+// @Method(GET)
+// @Route(/panic)
+func (ec *E2EController) Panic() (string, error) {
+	panic("e2e operation panic")
+}
+
 // TODO: is pointer error not officially supported?
 // // @Method(GET)
 // // @Route(/default-error-ptr)
@@ -692,6 +878,16 @@ func (ec *E2EController) StructsWithInnerPointer(data TheModelWithInnerPointer)
 	return *data.RecursiveModelWithPointer.Prop2, nil
 }
 
+// E2EPrincipal is the principal attached by the e2e security handlers
+type E2EPrincipal struct {
+	Name string
+}
+
+// IdempotencyIdentity scopes the principal's idempotent requests by its name
+func (principal *E2EPrincipal) IdempotencyIdentity() string {
+	return principal.Name
+}
+
 type ContextAuthInjectType int
 type ContextMiddlewareInjectType int
 
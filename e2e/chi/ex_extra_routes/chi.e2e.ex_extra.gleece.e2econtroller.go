@@ -0,0 +1,9482 @@
+/*
+--
+This file is automatically generated. Any manual changes to this file may be overwritten.
+It includes the routes and handlers of one or more controllers by the Gleece API Routes Generator.
+--
+Authors: Haim Kastner & Yuval Pomerchik
+Generated by: Gleece Routes Generator
+Routes File: chi.e2e.ex_extra.gleece.go
+Generated Date: 2026-10-19
+Target Engine: Chi v5 (https://github.com/go-chi/chi)
+--
+Usage:
+Refer to the Gleece documentation https://docs.gleece.dev for details on how to use the generated routes and handlers.
+--
+Repository: https://github.com/gopher-fleece/gleece
+--
+*/
+package ex_extra_routes
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"github.com/go-chi/chi/v5"
+	"github.com/gopher-fleece/runtime"
+	E2EController "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param14value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param18data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param19data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param20data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param21data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param22data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param23data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param24arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param25object "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param26num "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param27str "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param28values "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param29values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param30values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param32values "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param33values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param4value2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param5data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param5theBody "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param6principal "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Response9CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param16data "github.com/haimkastner/unitsnet-go/units"
+	Param17unit "github.com/haimkastner/unitsnet-go/units"
+	// import extension placeholder
+)
+// registerE2EControllerRoutes registers the E2EController controller's routes on the given router, under the given base path
+func registerE2EControllerRoutes(engine chi.Router, basePath string) {
+	e2EcontrollerSimpleGetErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SimpleGet")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SimpleGet()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGet'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGet",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/simple-get")), e2EcontrollerSimpleGetHandler)
+	e2EcontrollerSimpleGetEmptyStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyStringHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SimpleGetEmptyString")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SimpleGetEmptyString()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetEmptyString'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetEmptyString",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/simple-get-empty-string")), e2EcontrollerSimpleGetEmptyStringHandler)
+	e2EcontrollerSimpleGetPtrStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetPtrStringHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SimpleGetPtrString")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SimpleGetPtrString()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetPtrString'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetPtrString",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/simple-get-ptr-string")), e2EcontrollerSimpleGetPtrStringHandler)
+	e2EcontrollerSimpleGetNullStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetNullStringHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SimpleGetNullString")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SimpleGetNullString()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetNullString'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetNullString",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/simple-get-null-string")), e2EcontrollerSimpleGetNullStringHandler)
+	e2EcontrollerNamedMiddlewaresErrorStatusCodes := []int{}
+	e2EcontrollerNamedMiddlewaresHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "NamedMiddlewares")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		routeMiddlewares := []MiddlewareFunc{namedMiddlewares["audit"], namedMiddlewares["trace"]}
+		// Middlewares routeMiddlewares section
+		for _, middleware := range routeMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares routeMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.NamedMiddlewares()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'NamedMiddlewares'",
+				Status:     statusCode,
+				Instance:   "/controller/error/NamedMiddlewares",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/named-middlewares")), e2EcontrollerNamedMiddlewaresHandler)
+	e2EcontrollerSimpleGetObjectErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SimpleGetObject")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SimpleGetObject()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObject'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetObject",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/simple-get-object")), e2EcontrollerSimpleGetObjectHandler)
+	e2EcontrollerSimpleGetObjectPtrErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectPtrHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SimpleGetObjectPtr")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SimpleGetObjectPtr()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObjectPtr'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetObjectPtr",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/simple-get-object-ptr")), e2EcontrollerSimpleGetObjectPtrHandler)
+	e2EcontrollerSimpleGetObjectNullErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectNullHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SimpleGetObjectNull")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SimpleGetObjectNull()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObjectNull'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetObjectNull",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/simple-get-object-null")), e2EcontrollerSimpleGetObjectNullHandler)
+	e2EcontrollerPrimitiveReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveReturnTypeHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PrimitiveReturnType")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PrimitiveReturnType()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PrimitiveReturnType'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PrimitiveReturnType",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/primitive-return-type")), e2EcontrollerPrimitiveReturnTypeHandler)
+	e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveArrayReturnTypeHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PrimitiveArrayReturnType")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PrimitiveArrayReturnType()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PrimitiveArrayReturnType'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PrimitiveArrayReturnType",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/primitive-array-return-type")), e2EcontrollerPrimitiveArrayReturnTypeHandler)
+	e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasReturnTypeHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PrimitiveAliasReturnType")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PrimitiveAliasReturnType()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PrimitiveAliasReturnType'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PrimitiveAliasReturnType",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/primitive-alias-return-type")), e2EcontrollerPrimitiveAliasReturnTypeHandler)
+	e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasArrayReturnTypeHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PrimitiveAliasArrayReturnType")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PrimitiveAliasArrayReturnType()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PrimitiveAliasArrayReturnType'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PrimitiveAliasArrayReturnType",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/primitive-alias-array-return-type")), e2EcontrollerPrimitiveAliasArrayReturnTypeHandler)
+	e2EcontrollerSimpleGetEmptyErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SimpleGetEmpty")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var queryParamRawPtr *string = nil
+		queryParamRaw := req.URL.Query().Get("queryParam")
+		isqueryParamExists := req.URL.Query().Has("queryParam")
+		if isqueryParamExists {
+			queryParam := queryParamRaw
+			queryParamRawPtr = &queryParam
+		}
+		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "queryParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.SimpleGetEmpty(*queryParamRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetEmpty'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetEmpty",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/simple-get-empty")), e2EcontrollerSimpleGetEmptyHandler)
+	e2EcontrollerGetWithAllParamsErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "GetWithAllParams")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var queryParamRawPtr *string = nil
+		queryParamRaw := req.URL.Query().Get("queryParam")
+		isqueryParamExists := req.URL.Query().Has("queryParam")
+		if isqueryParamExists {
+			queryParam := queryParamRaw
+			queryParamRawPtr = &queryParam
+		}
+		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "queryParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var pathParamRawPtr *string = nil
+		pathParamRaw := chi.URLParam(req, "pathParam")
+		ispathParamExists := true // if parameter is in route but not provided, it won't reach this handler
+		if ispathParamExists {
+			pathParam := pathParamRaw
+			pathParamRawPtr = &pathParam
+		}
+		if validatorErr := validatorInstance.Var(pathParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "pathParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("headerParam")
+		_, isheaderParamExists := req.Header["headerParam"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("headerParam")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.GetWithAllParams(*queryParamRawPtr, *pathParamRawPtr, *headerParamRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GetWithAllParams'",
+				Status:     statusCode,
+				Instance:   "/controller/error/GetWithAllParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")), e2EcontrollerGetWithAllParamsHandler)
+	e2EcontrollerGetWithAllParamsPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsPtrHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "GetWithAllParamsPtr")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var queryParamRawPtr *string = nil
+		queryParamRaw := req.URL.Query().Get("queryParam")
+		isqueryParamExists := req.URL.Query().Has("queryParam")
+		if isqueryParamExists {
+			queryParam := queryParamRaw
+			queryParamRawPtr = &queryParam
+		}
+		var pathParamRawPtr *string = nil
+		pathParamRaw := chi.URLParam(req, "pathParam")
+		ispathParamExists := true // if parameter is in route but not provided, it won't reach this handler
+		if ispathParamExists {
+			pathParam := pathParamRaw
+			pathParamRawPtr = &pathParam
+		}
+		if validatorErr := validatorInstance.Var(pathParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "pathParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("headerParam")
+		_, isheaderParamExists := req.Header["headerParam"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("headerParam")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.GetWithAllParamsPtr(queryParamRawPtr, pathParamRawPtr, headerParamRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GetWithAllParamsPtr'",
+				Status:     statusCode,
+				Instance:   "/controller/error/GetWithAllParamsPtr",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsPtrHandler)
+	e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsRequiredPtrHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "GetWithAllParamsRequiredPtr")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var queryParamRawPtr *string = nil
+		queryParamRaw := req.URL.Query().Get("queryParam")
+		isqueryParamExists := req.URL.Query().Has("queryParam")
+		if isqueryParamExists {
+			queryParam := queryParamRaw
+			queryParamRawPtr = &queryParam
+		}
+		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "queryParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var pathParamRawPtr *string = nil
+		pathParamRaw := chi.URLParam(req, "pathParam")
+		ispathParamExists := true // if parameter is in route but not provided, it won't reach this handler
+		if ispathParamExists {
+			pathParam := pathParamRaw
+			pathParamRawPtr = &pathParam
+		}
+		if validatorErr := validatorInstance.Var(pathParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "pathParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("headerParam")
+		_, isheaderParamExists := req.Header["headerParam"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("headerParam")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.GetWithAllParamsRequiredPtr(queryParamRawPtr, pathParamRawPtr, headerParamRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GetWithAllParamsRequiredPtr'",
+				Status:     statusCode,
+				Instance:   "/controller/error/GetWithAllParamsRequiredPtr",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsRequiredPtrHandler)
+	e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBody")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var queryParamRawPtr *string = nil
+		queryParamRaw := req.URL.Query().Get("queryParam")
+		isqueryParamExists := req.URL.Query().Has("queryParam")
+		if isqueryParamExists {
+			queryParam := queryParamRaw
+			queryParamRawPtr = &queryParam
+		}
+		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "queryParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("headerParam")
+		_, isheaderParamExists := req.Header["headerParam"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("headerParam")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var theBodyRawPtr *Param5theBody.BodyInfo = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &theBodyRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBody",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/PostWithAllParamsWithBody",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PostWithAllParamsWithBody(*queryParamRawPtr, *headerParamRawPtr, *theBodyRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBody'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PostWithAllParamsWithBody",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/post-with-all-params-body")), e2EcontrollerPostWithAllParamsWithBodyHandler)
+	e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyPtrHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBodyPtr")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var queryParamRawPtr *string = nil
+		queryParamRaw := req.URL.Query().Get("queryParam")
+		isqueryParamExists := req.URL.Query().Has("queryParam")
+		if isqueryParamExists {
+			queryParam := queryParamRaw
+			queryParamRawPtr = &queryParam
+		}
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("headerParam")
+		_, isheaderParamExists := req.Header["headerParam"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("headerParam")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		var theBodyRawPtr *Param5theBody.BodyInfo = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "", &theBodyRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/PostWithAllParamsWithBodyPtr",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PostWithAllParamsWithBodyPtr(queryParamRawPtr, headerParamRawPtr, theBodyRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyPtr'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PostWithAllParamsWithBodyPtr",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-ptr")), e2EcontrollerPostWithAllParamsWithBodyPtrHandler)
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBodyRequiredPtr")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var theBodyRawPtr *Param5theBody.BodyInfo = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &theBodyRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyRequiredPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/PostWithAllParamsWithBodyRequiredPtr",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PostWithAllParamsWithBodyRequiredPtr(theBodyRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyRequiredPtr'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PostWithAllParamsWithBodyRequiredPtr",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-required-ptr")), e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler)
+	e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes := []int{}
+	e2EcontrollerGetHeaderStartWithLetterHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "GetHeaderStartWithLetter")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("headerParam")
+		_, isheaderParamExists := req.Header["headerParam"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("headerParam")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required,validate_starts_with_letter"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.GetHeaderStartWithLetter(*headerParamRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GetHeaderStartWithLetter'",
+				Status:     statusCode,
+				Instance:   "/controller/error/GetHeaderStartWithLetter",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/get-header-start-with-letter")), e2EcontrollerGetHeaderStartWithLetterHandler)
+	e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithDefaultConfigSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithDefaultConfigSecurity")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("x-test-scopes")
+		_, isheaderParamExists := req.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WithDefaultConfigSecurity(*headerParamRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithDefaultConfigSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithDefaultConfigSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/with-default-config-security")), e2EcontrollerWithDefaultConfigSecurityHandler)
+	e2EcontrollerWithOneSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithOneSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithOneSecurity")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("x-test-scopes")
+		_, isheaderParamExists := req.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WithOneSecurity(*headerParamRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithOneSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithOneSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/with-one-security")), e2EcontrollerWithOneSecurityHandler)
+	e2EcontrollerWithTwoSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+								"read",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithTwoSecurity")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("x-test-scopes")
+		_, isheaderParamExists := req.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WithTwoSecurity(*headerParamRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithTwoSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithTwoSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/with-two-security")), e2EcontrollerWithTwoSecurityHandler)
+	e2EcontrollerWithAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithAdvancedSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName3",
+							Scopes: []string{
+								"admin",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithAdvancedSecurity")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("x-test-scopes")
+		_, isheaderParamExists := req.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithAdvancedSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WithAdvancedSecurity(*headerParamRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithAdvancedSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithAdvancedSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/with-advanced-security")), e2EcontrollerWithAdvancedSecurityHandler)
+	e2EcontrollerWithPrincipalErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithPrincipal")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(req))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			handleAuthorizationError(w, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipal")
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WithPrincipal(principalRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithPrincipal'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithPrincipal",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/with-principal")), e2EcontrollerWithPrincipalHandler)
+	e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalAdvancedSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName3",
+							Scopes: []string{
+								"admin",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithPrincipalAdvancedSecurity")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(req))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			handleAuthorizationError(w, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipalAdvancedSecurity")
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WithPrincipalAdvancedSecurity(principalRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithPrincipalAdvancedSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithPrincipalAdvancedSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/with-principal-advanced-security")), e2EcontrollerWithPrincipalAdvancedSecurityHandler)
+	e2EcontrollerPublicRouteErrorStatusCodes := []int{}
+	e2EcontrollerPublicRouteHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PublicRoute()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PublicRoute'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PublicRoute",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/public")), e2EcontrollerPublicRouteHandler)
+	e2EcontrollerHeadCheckErrorStatusCodes := []int{}
+	e2EcontrollerHeadCheckHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "HeadCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.HeadCheck()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'HeadCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/HeadCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/head-check")), e2EcontrollerHeadCheckHandler)
+	e2EcontrollerOptionsCheckErrorStatusCodes := []int{}
+	e2EcontrollerOptionsCheckHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "OptionsCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.OptionsCheck()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'OptionsCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/OptionsCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("OPTIONS", toChiUrl(withBasePath(basePath, "/e2e/options-check")), e2EcontrollerOptionsCheckHandler)
+	e2EcontrollerTraceCheckErrorStatusCodes := []int{}
+	e2EcontrollerTraceCheckHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TraceCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.TraceCheck()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TraceCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TraceCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("TRACE", toChiUrl(withBasePath(basePath, "/e2e/trace-check")), e2EcontrollerTraceCheckHandler)
+	e2EcontrollerPurgeCheckErrorStatusCodes := []int{}
+	e2EcontrollerPurgeCheckHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PurgeCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PurgeCheck()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PurgeCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PurgeCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	// Chi only routes standard methods unless others are registered beforehand
+	chi.RegisterMethod("PURGE")
+	engine.MethodFunc("PURGE", toChiUrl(withBasePath(basePath, "/e2e/purge-check")), e2EcontrollerPurgeCheckHandler)
+	e2EcontrollerVersionedV1ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV1Handler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "VersionedV1")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.VersionedV1()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV1'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV1",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	e2EcontrollerVersionedV1Versions := []int{1}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV1Versions, e2EcontrollerVersionedV1Handler)
+	e2EcontrollerVersionedV2ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV2Handler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "VersionedV2")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.VersionedV2()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV2'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV2",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	e2EcontrollerVersionedV2Versions := []int{2}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV2Versions, e2EcontrollerVersionedV2Handler)
+	e2EcontrollerRateLimitedErrorStatusCodes := []int{}
+	e2EcontrollerRateLimitedRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "RateLimited")
+	e2EcontrollerRateLimitedHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		if !enforceRateLimit(w, req, "RateLimited", e2EcontrollerRateLimitedRateLimit) {
+			return
+		}
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "RateLimited")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.RateLimited()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'RateLimited'",
+				Status:     statusCode,
+				Instance:   "/controller/error/RateLimited",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/rate-limited")), e2EcontrollerRateLimitedHandler)
+	e2EcontrollerTimedOutErrorStatusCodes := []int{}
+	e2EcontrollerTimedOutTimeout := newOperationTimeout("50ms")
+	e2EcontrollerTimedOutHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		cancelTimeout := applyOperationTimeout(req, e2EcontrollerTimedOutTimeout)
+		defer cancelTimeout()
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TimedOut")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		if isDeadlineExceeded(req) {
+			handleDeadlineExceeded(w, "TimedOut", http.StatusServiceUnavailable)
+			return
+		}
+		value, opError := controller.TimedOut(getRequestContext(req))
+		if isDeadlineExceeded(req) {
+			handleDeadlineExceeded(w, "TimedOut", http.StatusGatewayTimeout)
+			return
+		}
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTimedOutErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TimedOut'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TimedOut",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTimedOutErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/timeout")), e2EcontrollerTimedOutHandler)
+	e2EcontrollerMaxBodySizeErrorStatusCodes := []int{}
+	e2EcontrollerMaxBodySizeHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		if !enforceMaxBodySize(w, req, "MaxBodySize", 64) {
+			return
+		}
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "MaxBodySize")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var dataRawPtr *Param8data.ObjectWithByteSlice = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "MaxBodySize",
+						"parameter": "data",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/MaxBodySize",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.MaxBodySize(*dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MaxBodySize'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MaxBodySize",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/max-body-size")), e2EcontrollerMaxBodySizeHandler)
+	e2EcontrollerIdempotentErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "Idempotent")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed := hashRequestBody(w, req, "Idempotent", 0)
+		if !isBodyHashed {
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		idempotentWriter, completeIdempotentRequest := beginIdempotentRequest(w, req, "Idempotent", idempotentRequestHash, e2EcontrollerIdempotentIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return
+		}
+		w = idempotentWriter
+		defer completeIdempotentRequest()
+		value, opError := controller.Idempotent()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Idempotent'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Idempotent",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/idempotent")), e2EcontrollerIdempotentHandler)
+	e2EcontrollerIdempotentWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentWithBodyIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentWithBodyHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "IdempotentWithBody")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed := hashRequestBody(w, req, "IdempotentWithBody", 0)
+		if !isBodyHashed {
+			return
+		}
+		var conversionErr error
+		var dataRawPtr *Param5data.BodyInfo = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "IdempotentWithBody",
+						"parameter": "data",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/IdempotentWithBody",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		idempotentWriter, completeIdempotentRequest := beginIdempotentRequest(w, req, "IdempotentWithBody", idempotentRequestHash, e2EcontrollerIdempotentWithBodyIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return
+		}
+		w = idempotentWriter
+		defer completeIdempotentRequest()
+		value, opError := controller.IdempotentWithBody(*dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'IdempotentWithBody'",
+				Status:     statusCode,
+				Instance:   "/controller/error/IdempotentWithBody",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/idempotent-with-body")), e2EcontrollerIdempotentWithBodyHandler)
+	e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecuritySameMethodHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"write",
+								"read",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithTwoSecuritySameMethod")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("x-test-scopes")
+		_, isheaderParamExists := req.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithTwoSecuritySameMethod",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/with-two-security-same-method")), e2EcontrollerWithTwoSecuritySameMethodHandler)
+	e2EcontrollerDefaultErrorErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "DefaultError")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.DefaultError()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DefaultError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DefaultError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/default-error")), e2EcontrollerDefaultErrorHandler)
+	e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorWithPayloadHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "DefaultErrorWithPayload")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.DefaultErrorWithPayload()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DefaultErrorWithPayload",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/default-error-with-payload")), e2EcontrollerDefaultErrorWithPayloadHandler)
+	e2EcontrollerInjectedDependencyErrorStatusCodes := []int{}
+	e2EcontrollerInjectedDependencyHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "InjectedDependency")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.InjectedDependency()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'InjectedDependency'",
+				Status:     statusCode,
+				Instance:   "/controller/error/InjectedDependency",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/injected-dependency")), e2EcontrollerInjectedDependencyHandler)
+	e2EcontrollerMappedErrorErrorStatusCodes := []int{404}
+	e2EcontrollerMappedErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "MappedError")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.MappedError()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerMappedErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MappedError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MappedError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedErrorErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/mapped-error")), e2EcontrollerMappedErrorHandler)
+	e2EcontrollerUndeclaredMappedErrorErrorStatusCodes := []int{}
+	e2EcontrollerUndeclaredMappedErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "UndeclaredMappedError")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.UndeclaredMappedError()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'UndeclaredMappedError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/UndeclaredMappedError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/undeclared-mapped-error")), e2EcontrollerUndeclaredMappedErrorHandler)
+	e2EcontrollerMappedTypedErrorErrorStatusCodes := []int{409}
+	e2EcontrollerMappedTypedErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "MappedTypedError")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.MappedTypedError()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MappedTypedError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MappedTypedError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/mapped-typed-error")), e2EcontrollerMappedTypedErrorHandler)
+	e2EcontrollerPanicErrorStatusCodes := []int{}
+	e2EcontrollerPanicHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "Panic")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.Panic()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPanicErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Panic'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Panic",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPanicErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/panic")), e2EcontrollerPanicHandler)
+	e2EcontrollerCustomErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "CustomError")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.CustomError()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomErrorErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
+		if opError != emptyErr {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(opError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/custom-error")), e2EcontrollerCustomErrorHandler)
+	e2EcontrollerCustomPtrErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomPtrErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "CustomPtrError")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.CustomPtrError()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomPtrErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(opError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/custom-error-ptr")), e2EcontrollerCustomPtrErrorHandler)
+	e2EcontrollerError503ErrorStatusCodes := []int{}
+	e2EcontrollerError503Handler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "Error503")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.Error503()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerError503ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Error503'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Error503",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerError503ErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/503-error-code")), e2EcontrollerError503Handler)
+	e2EcontrollerCustomError503ErrorStatusCodes := []int{}
+	e2EcontrollerCustomError503Handler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "CustomError503")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.CustomError503()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomError503ErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
+		if opError != emptyErr {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(opError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/custom-error-503")), e2EcontrollerCustomError503Handler)
+	e2EcontrollerContextAccessErrorStatusCodes := []int{}
+	e2EcontrollerContextAccessHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ContextAccess")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.ContextAccess()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextAccessErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ContextAccess'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ContextAccess",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextAccessErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/context-access")), e2EcontrollerContextAccessHandler)
+	e2EcontrollerGetErrorStatusCodes := []int{}
+	e2EcontrollerGetHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "Get")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.Get()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerGetErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Get'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Get",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerGetHandler)
+	e2EcontrollerPostErrorStatusCodes := []int{}
+	e2EcontrollerPostHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "Post")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.Post()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPostErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Post'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Post",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPostHandler)
+	e2EcontrollerPutErrorStatusCodes := []int{}
+	e2EcontrollerPutHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "Put")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.Put()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPutErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Put'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Put",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPutErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("PUT", toChiUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPutHandler)
+	e2EcontrollerDeleteErrorStatusCodes := []int{}
+	e2EcontrollerDeleteHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "Delete")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.Delete()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDeleteErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Delete'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Delete",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeleteErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("DELETE", toChiUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerDeleteHandler)
+	e2EcontrollerPatchErrorStatusCodes := []int{}
+	e2EcontrollerPatchHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "Patch")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.Patch()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPatchErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Patch'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Patch",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPatchErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("PATCH", toChiUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPatchHandler)
+	e2EcontrollerTemplateContext1ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext1Handler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TemplateContext1")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.TemplateContext1()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TemplateContext1'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TemplateContext1",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/template-context-1")), e2EcontrollerTemplateContext1Handler)
+	e2EcontrollerTemplateContext2ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext2Handler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TemplateContext2")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.TemplateContext2()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TemplateContext2'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TemplateContext2",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/template-context-2")), e2EcontrollerTemplateContext2Handler)
+	e2EcontrollerTestFormErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TestForm")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		req.ParseForm()
+		var item1RawPtr *string = nil
+		item1RawArr, isitem1Exists := req.PostForm["item1"]
+		item1Raw := ""
+		if isitem1Exists {
+			item1Raw = item1RawArr[0] // Get first value since form values are slices
+		}
+		if isitem1Exists {
+			item1 := item1Raw
+			item1RawPtr = &item1
+		}
+		if validatorErr := validatorInstance.Var(item1RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "item1"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		req.ParseForm()
+		var item2RawPtr *string = nil
+		item2RawArr, isitem2Exists := req.PostForm["item2"]
+		item2Raw := ""
+		if isitem2Exists {
+			item2Raw = item2RawArr[0] // Get first value since form values are slices
+		}
+		if isitem2Exists {
+			item2 := item2Raw
+			item2RawPtr = &item2
+		}
+		if validatorErr := validatorInstance.Var(item2RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "item2"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.TestForm(*item1RawPtr, *item2RawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TestForm'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TestForm",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/form")), e2EcontrollerTestFormHandler)
+	e2EcontrollerTestFormExtraErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormExtraHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TestFormExtra")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		req.ParseForm()
+		var item1RawPtr *int64 = nil
+		item1RawArr, isitem1Exists := req.PostForm["item1"]
+		item1Raw := ""
+		if isitem1Exists {
+			item1Raw = item1RawArr[0] // Get first value since form values are slices
+		}
+		if isitem1Exists {
+			item1, conversionErr := parseIntegerParam[int64](item1Raw)
+			if conversionErr != nil {
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item1",
+					"form",
+					"/item1",
+					"int64",
+					reflect.TypeOf(item1Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				// params validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+			item1RawPtr = &item1
+		}
+		if validatorErr := validatorInstance.Var(item1RawPtr, "required,gte=80"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "item1"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		req.ParseForm()
+		var item2RawPtr *string = nil
+		item2RawArr, isitem2Exists := req.PostForm["item2"]
+		item2Raw := ""
+		if isitem2Exists {
+			item2Raw = item2RawArr[0] // Get first value since form values are slices
+		}
+		if isitem2Exists {
+			item2 := item2Raw
+			item2RawPtr = &item2
+		}
+		if validatorErr := validatorInstance.Var(item2RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "item2"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var item3RawPtr *int64 = nil
+		item3Raw := req.URL.Query().Get("item3")
+		isitem3Exists := req.URL.Query().Has("item3")
+		if isitem3Exists {
+			item3, conversionErr := parseIntegerParam[int64](item3Raw)
+			if conversionErr != nil {
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item3",
+					"query",
+					"/item3",
+					"int64",
+					reflect.TypeOf(item3Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				// params validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+			item3RawPtr = &item3
+		}
+		if validatorErr := validatorInstance.Var(item3RawPtr, "required,gte=80"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "item3"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/item3", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.TestFormExtra(*item1RawPtr, *item2RawPtr, *item3RawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TestFormExtra'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TestFormExtra",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/form-extra")), e2EcontrollerTestFormExtraHandler)
+	e2EcontrollerTestResponseValidationErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TestResponseValidation")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.TestResponseValidation()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TestResponseValidation'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TestResponseValidation",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/test-response-validation")), e2EcontrollerTestResponseValidationHandler)
+	e2EcontrollerTestResponseValidationPtrErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationPtrHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TestResponseValidationPtr")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.TestResponseValidationPtr()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TestResponseValidationPtr'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TestResponseValidationPtr",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/test-response-validation-ptr")), e2EcontrollerTestResponseValidationPtrHandler)
+	e2EcontrollerTestResponseValidationNullErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationNullHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TestResponseValidationNull")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.TestResponseValidationNull()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TestResponseValidationNull'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TestResponseValidationNull",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/test-response-validation-null")), e2EcontrollerTestResponseValidationNullHandler)
+	e2EcontrollerTestPrimitiveConversionsErrorStatusCodes := []int{500}
+	e2EcontrollerTestPrimitiveConversionsHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TestPrimitiveConversions")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var value1RawPtr *int64 = nil
+		value1Raw := req.URL.Query().Get("value1")
+		isvalue1Exists := req.URL.Query().Has("value1")
+		if isvalue1Exists {
+			value1, conversionErr := parseIntegerParam[int64](value1Raw)
+			if conversionErr != nil {
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value1",
+					"query",
+					"/value1",
+					"int64",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				// params validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+			value1RawPtr = &value1
+		}
+		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "value1"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var value2RawPtr *bool = nil
+		value2Raw := req.URL.Query().Get("value2")
+		isvalue2Exists := req.URL.Query().Has("value2")
+		if isvalue2Exists {
+			value2, conversionErr := strconv.ParseBool(value2Raw)
+			if conversionErr != nil {
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value2",
+					"query",
+					"/value2",
+					"bool",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				// params validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+			value2RawPtr = &value2
+		}
+		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "value2"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var value3RawPtr *int = nil
+		value3Raw := req.URL.Query().Get("value3")
+		isvalue3Exists := req.URL.Query().Has("value3")
+		if isvalue3Exists {
+			value3, conversionErr := parseIntegerParam[int](value3Raw)
+			if conversionErr != nil {
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value3",
+					"query",
+					"/value3",
+					"int",
+					reflect.TypeOf(value3Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				// params validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+			value3RawPtr = &value3
+		}
+		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "value3"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value3", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var value4RawPtr *float64 = nil
+		value4Raw := req.URL.Query().Get("value4")
+		isvalue4Exists := req.URL.Query().Has("value4")
+		if isvalue4Exists {
+			value4, conversionErr := parseFloatParam[float64](value4Raw)
+			if conversionErr != nil {
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value4",
+					"query",
+					"/value4",
+					"float64",
+					reflect.TypeOf(value4Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				// params validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+			value4RawPtr = &value4
+		}
+		if validatorErr := validatorInstance.Var(value4RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "value4"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value4", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.TestPrimitiveConversions(*value1RawPtr, *value2RawPtr, *value3RawPtr, *value4RawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TestPrimitiveConversions'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TestPrimitiveConversions",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/test-primitive-conversions")), e2EcontrollerTestPrimitiveConversionsHandler)
+	e2EcontrollerTestEnumsErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TestEnums")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
+		value1Raw := req.URL.Query().Get("value1")
+		isvalue1Exists := req.URL.Query().Has("value1")
+		if isvalue1Exists {
+			value1 := value1Raw
+			value1Var := Param15value1.StatusEnumeration(value1)
+			value1RawPtr = &value1Var
+		}
+		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "value1"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var value2RawPtr *Param4value2.NumberEnumeration = nil
+		value2Raw := req.URL.Query().Get("value2")
+		isvalue2Exists := req.URL.Query().Has("value2")
+		if isvalue2Exists {
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
+			if conversionErr != nil {
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value2",
+					"query",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				// params validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+			value2Var := Param4value2.NumberEnumeration(value2)
+			value2RawPtr = &value2Var
+		}
+		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "value2"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var value3RawPtr *Param14value3.ObjectWithEnum = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &value3RawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "TestEnums",
+						"parameter": "value3",
+						"type":      "ObjectWithEnum",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/TestEnums",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.TestEnums(*value1RawPtr, *value2RawPtr, *value3RawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TestEnums'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TestEnums",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/test-enums")), e2EcontrollerTestEnumsHandler)
+	e2EcontrollerTestEnumsInAllErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsInAllHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TestEnumsInAll")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
+		value1Raw := chi.URLParam(req, "value1")
+		isvalue1Exists := true // if parameter is in route but not provided, it won't reach this handler
+		if isvalue1Exists {
+			value1 := value1Raw
+			value1Var := Param15value1.StatusEnumeration(value1)
+			value1RawPtr = &value1Var
+		}
+		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "value1"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/value1", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var value2RawPtr *Param4value2.NumberEnumeration = nil
+		value2Raw := req.Header.Get("value2")
+		_, isvalue2Exists := req.Header["value2"]
+		if !isvalue2Exists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("value2")
+			isvalue2Exists = len(headerValues) > 0
+		}
+		if isvalue2Exists {
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
+			if conversionErr != nil {
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value2",
+					"header",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				// params validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+			value2Var := Param4value2.NumberEnumeration(value2)
+			value2RawPtr = &value2Var
+		}
+		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "value2"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/value2", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		req.ParseForm()
+		var value3RawPtr *Param15value3.StatusEnumeration = nil
+		value3RawArr, isvalue3Exists := req.PostForm["value3"]
+		value3Raw := ""
+		if isvalue3Exists {
+			value3Raw = value3RawArr[0] // Get first value since form values are slices
+		}
+		if isvalue3Exists {
+			value3 := value3Raw
+			value3Var := Param15value3.StatusEnumeration(value3)
+			value3RawPtr = &value3Var
+		}
+		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "value3"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/value3", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.TestEnumsInAll(*value1RawPtr, *value2RawPtr, *value3RawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TestEnumsInAll'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TestEnumsInAll",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/test-enums-in-all/{value1}")), e2EcontrollerTestEnumsInAllHandler)
+	e2EcontrollerTestEnumsOptionalErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsOptionalHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TestEnumsOptional")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
+		value1Raw := req.Header.Get("value1")
+		_, isvalue1Exists := req.Header["value1"]
+		if !isvalue1Exists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("value1")
+			isvalue1Exists = len(headerValues) > 0
+		}
+		if isvalue1Exists {
+			value1 := value1Raw
+			value1Var := Param15value1.StatusEnumeration(value1)
+			value1RawPtr = &value1Var
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.TestEnumsOptional(value1RawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TestEnumsOptional'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TestEnumsOptional",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/test-enums-optional")), e2EcontrollerTestEnumsOptionalHandler)
+	e2EcontrollerExternalPackagesErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ExternalPackages")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var unitRawPtr *Param17unit.LengthUnits = nil
+		unitRaw := req.URL.Query().Get("unit")
+		isunitExists := req.URL.Query().Has("unit")
+		if isunitExists {
+			unit := unitRaw
+			unitVar := Param17unit.LengthUnits(unit)
+			unitRawPtr = &unitVar
+		}
+		var dataRawPtr *Param16data.LengthDto = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackages",
+						"parameter": "data",
+						"type":      "LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/ExternalPackages",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.ExternalPackages(unitRawPtr, *dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ExternalPackages'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ExternalPackages",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/external-packages")), e2EcontrollerExternalPackagesHandler)
+	e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesUniqueInStructHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ExternalPackagesUniqueInStruct")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var dataRawPtr *Param18data.UniqueExternalUsage = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesUniqueInStruct",
+						"parameter": "data",
+						"type":      "UniqueExternalUsage",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/ExternalPackagesUniqueInStruct",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.ExternalPackagesUniqueInStruct(*dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ExternalPackagesUniqueInStruct'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ExternalPackagesUniqueInStruct",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/external-packages-unique-in-struct")), e2EcontrollerExternalPackagesUniqueInStructHandler)
+	e2EcontrollerExternalPackagesValidationErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesValidationHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ExternalPackagesValidation")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var unitRawPtr *Param17unit.LengthUnits = nil
+		unitRaw := req.URL.Query().Get("unit")
+		isunitExists := req.URL.Query().Has("unit")
+		if isunitExists {
+			unit := unitRaw
+			unitVar := Param17unit.LengthUnits(unit)
+			unitRawPtr = &unitVar
+		}
+		var dataRawPtr *Param19data.LengthDtoWithValidation = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesValidation",
+						"parameter": "data",
+						"type":      "LengthDtoWithValidation",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/ExternalPackagesValidation",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.ExternalPackagesValidation(unitRawPtr, *dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ExternalPackagesValidation'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ExternalPackagesValidation",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/external-packages-validation")), e2EcontrollerExternalPackagesValidationHandler)
+	e2EcontrollerArraysInBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInBodyAndResHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ArraysInBodyAndRes")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var dataRawPtr *[]Param16data.LengthDto = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInBodyAndRes",
+						"parameter": "data",
+						"type":      "[]LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/ArraysInBodyAndRes",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.ArraysInBodyAndRes(*dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ArraysInBodyAndRes'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ArraysInBodyAndRes",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/arrays-in-body-and-res")), e2EcontrollerArraysInBodyAndResHandler)
+	e2EcontrollerArraysInsideBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInsideBodyAndResHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ArraysInsideBodyAndRes")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var dataRawPtr *[]Param20data.BlaBla = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInsideBodyAndRes",
+						"parameter": "data",
+						"type":      "[]BlaBla",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/ArraysInsideBodyAndRes",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.ArraysInsideBodyAndRes(dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ArraysInsideBodyAndRes'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ArraysInsideBodyAndRes",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/arrays-inside-body-and-res")), e2EcontrollerArraysInsideBodyAndResHandler)
+	e2EcontrollerDeepArraysWithValidationErrorStatusCodes := []int{500}
+	e2EcontrollerDeepArraysWithValidationHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "DeepArraysWithValidation")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var dataRawPtr *[][]Param21data.BlaBla2 = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "DeepArraysWithValidation",
+						"parameter": "data",
+						"type":      "[][]BlaBla2",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/DeepArraysWithValidation",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.DeepArraysWithValidation(*dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DeepArraysWithValidation'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DeepArraysWithValidation",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/deep-arrays-with-validation")), e2EcontrollerDeepArraysWithValidationHandler)
+	e2EcontrollerEmbeddedStructsErrorStatusCodes := []int{500}
+	e2EcontrollerEmbeddedStructsHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "EmbeddedStructs")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var dataRawPtr *Param22data.TheModel = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "EmbeddedStructs",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/EmbeddedStructs",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.EmbeddedStructs(*dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'EmbeddedStructs'",
+				Status:     statusCode,
+				Instance:   "/controller/error/EmbeddedStructs",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/embedded-structs")), e2EcontrollerEmbeddedStructsHandler)
+	e2EcontrollerStructsWithInnerPointerErrorStatusCodes := []int{500}
+	e2EcontrollerStructsWithInnerPointerHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "StructsWithInnerPointer")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var dataRawPtr *Param23data.TheModelWithInnerPointer = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "StructsWithInnerPointer",
+						"parameter": "data",
+						"type":      "TheModelWithInnerPointer",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/StructsWithInnerPointer",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.StructsWithInnerPointer(*dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'StructsWithInnerPointer'",
+				Status:     statusCode,
+				Instance:   "/controller/error/StructsWithInnerPointer",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/structs-with-inner-pointer")), e2EcontrollerStructsWithInnerPointerHandler)
+	e2EcontrollerContextInjectionEmptyErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionEmptyHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ContextInjectionEmpty")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.ContextInjectionEmpty(getRequestContext(req))
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ContextInjectionEmpty'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ContextInjectionEmpty",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/context-injection-empty")), e2EcontrollerContextInjectionEmptyHandler)
+	e2EcontrollerContextInjectionErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ContextInjection")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var dataRawPtr *Param22data.TheModel = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ContextInjection",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/ContextInjection",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.ContextInjection(getRequestContext(req), *dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ContextInjection'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ContextInjection",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/context-injection")), e2EcontrollerContextInjectionHandler)
+	e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithByteSliceHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ReturnsStructWithByteSlice")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var arriveRawPtr *Param8arrive.ObjectWithByteSlice = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "", &arriveRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithByteSlice",
+						"parameter": "arrive",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/ReturnsStructWithByteSlice",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.ReturnsStructWithByteSlice(arriveRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ReturnsStructWithByteSlice'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ReturnsStructWithByteSlice",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/byte-slice")), e2EcontrollerReturnsStructWithByteSliceHandler)
+	e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithSpecialPrimitivesHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ReturnsStructWithSpecialPrimitives")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var arriveRawPtr *Param24arrive.ObjectWithSpecialPrimitives = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "", &arriveRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithSpecialPrimitives",
+						"parameter": "arrive",
+						"type":      "ObjectWithSpecialPrimitives",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/ReturnsStructWithSpecialPrimitives",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.ReturnsStructWithSpecialPrimitives(arriveRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ReturnsStructWithSpecialPrimitives'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ReturnsStructWithSpecialPrimitives",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/special-primitives")), e2EcontrollerReturnsStructWithSpecialPrimitivesHandler)
+	e2EcontrollerAliasOfStringErrorStatusCodes := []int{}
+	e2EcontrollerAliasOfStringHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "AliasOfString")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var objectRawPtr *Param25object.ObjectWithAliasOfString = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &objectRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "AliasOfString",
+						"parameter": "object",
+						"type":      "ObjectWithAliasOfString",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/AliasOfString",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var numRawPtr *Param26num.AliasOfInt = nil
+		numRaw := req.URL.Query().Get("num")
+		isnumExists := req.URL.Query().Has("num")
+		if isnumExists {
+			num, conversionErr := parseIntegerParam[int](numRaw)
+			if conversionErr != nil {
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"AliasOfString",
+					"num",
+					"query",
+					"/num",
+					"AliasOfInt",
+					reflect.TypeOf(numRaw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				// params validation error response extension placeholder
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+			numVar := Param26num.AliasOfInt(num)
+			numRawPtr = &numVar
+		}
+		if validatorErr := validatorInstance.Var(numRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "num"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/num", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var strRawPtr *Param27str.AliasOfDirectString = nil
+		strRaw := req.URL.Query().Get("str")
+		isstrExists := req.URL.Query().Has("str")
+		if isstrExists {
+			str := strRaw
+			strVar := Param27str.AliasOfDirectString(str)
+			strRawPtr = &strVar
+		}
+		if validatorErr := validatorInstance.Var(strRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "str"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/str", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.AliasOfString(objectRawPtr, *numRawPtr, *strRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'AliasOfString'",
+				Status:     statusCode,
+				Instance:   "/controller/error/AliasOfString",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/alias-of-primitive")), e2EcontrollerAliasOfStringHandler)
+	e2EcontrollerBodyArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "BodyArrayOfString")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var valuesRawPtr *[]string = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &valuesRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfString",
+						"parameter": "values",
+						"type":      "[]string",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/BodyArrayOfString",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.BodyArrayOfString(*valuesRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'BodyArrayOfString'",
+				Status:     statusCode,
+				Instance:   "/controller/error/BodyArrayOfString",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/body-array-of-string")), e2EcontrollerBodyArrayOfStringHandler)
+	e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringEnumHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "BodyArrayOfStringEnum")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var valuesRawPtr *[]Param28values.Myemamium = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &valuesRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfStringEnum",
+						"parameter": "values",
+						"type":      "[]Myemamium",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/BodyArrayOfStringEnum",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.BodyArrayOfStringEnum(*valuesRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'BodyArrayOfStringEnum'",
+				Status:     statusCode,
+				Instance:   "/controller/error/BodyArrayOfStringEnum",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/body-array-of-enum-string")), e2EcontrollerBodyArrayOfStringEnumHandler)
+	e2EcontrollerQueryArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfStringHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "QueryArrayOfString")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var valuesRawPtr *[]string = nil
+		valuesRawArray := req.URL.Query()["values"]
+		isvaluesExists := req.URL.Query().Has("values")
+		if isvaluesExists {
+			values := make([]string, 0, len(valuesRawArray))
+			for _, valuesRaw := range valuesRawArray {
+				valuesItem := valuesRaw
+				values = append(values, string(valuesItem))
+			}
+			valuesRawPtr = &values
+			valuesRawPtr = &values
+		}
+		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "values"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.QueryArrayOfString(*valuesRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'QueryArrayOfString'",
+				Status:     statusCode,
+				Instance:   "/controller/error/QueryArrayOfString",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/query-array-of-string")), e2EcontrollerQueryArrayOfStringHandler)
+	e2EcontrollerQueryArrayOfEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfEnumHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "QueryArrayOfEnum")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var valuesRawPtr *[]Param28values.Myemamium = nil
+		valuesRawArray := req.URL.Query()["values"]
+		isvaluesExists := req.URL.Query().Has("values")
+		if isvaluesExists {
+			values := make([]Param28values.Myemamium, 0, len(valuesRawArray))
+			for _, valuesRaw := range valuesRawArray {
+				valuesItem := valuesRaw
+				values = append(values, Param28values.Myemamium(valuesItem))
+			}
+			valuesRawPtr = &values
+			valuesVar := []Param28values.Myemamium(values)
+			valuesRawPtr = &valuesVar
+		}
+		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "values"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var values2RawPtr *[]Param29values2.MyaliasString = nil
+		values2RawArray := req.URL.Query()["values2"]
+		isvalues2Exists := req.URL.Query().Has("values2")
+		if isvalues2Exists {
+			values2 := make([]Param29values2.MyaliasString, 0, len(values2RawArray))
+			for _, values2Raw := range values2RawArray {
+				values2Item := values2Raw
+				values2 = append(values2, Param29values2.MyaliasString(values2Item))
+			}
+			values2RawPtr = &values2
+			values2Var := []Param29values2.MyaliasString(values2)
+			values2RawPtr = &values2Var
+		}
+		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "values2"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.QueryArrayOfEnum(*valuesRawPtr, *values2RawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'QueryArrayOfEnum'",
+				Status:     statusCode,
+				Instance:   "/controller/error/QueryArrayOfEnum",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/query-array-of-enum")), e2EcontrollerQueryArrayOfEnumHandler)
+	e2EcontrollerQueryArrayOfOthersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "QueryArrayOfOthers")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var valuesRawPtr *[]int = nil
+		valuesRawArray := req.URL.Query()["values"]
+		isvaluesExists := req.URL.Query().Has("values")
+		if isvaluesExists {
+			values := make([]int, 0, len(valuesRawArray))
+			for _, valuesRaw := range valuesRawArray {
+				valuesItem, conversionErr := parseIntegerParam[int](valuesRaw)
+				if conversionErr != nil {
+					// Middlewares onInputValidationMiddlewares section
+					for _, middleware := range onInputValidationMiddlewares {
+						middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+						setRequestContext(req, middlewareCtx)
+						if !continueOperation {
+							return
+						}
+					}
+					// End middlewares onInputValidationMiddlewares section
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values",
+						"query",
+						"/values",
+						"[]int",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
+					// params validation error response extension placeholder
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+					return
+				}
+				values = append(values, int(valuesItem))
+			}
+			valuesRawPtr = &values
+			valuesRawPtr = &values
+		}
+		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "values"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var values2RawPtr *[]Param30values2.MyaliasInt = nil
+		values2RawArray := req.URL.Query()["values2"]
+		isvalues2Exists := req.URL.Query().Has("values2")
+		if isvalues2Exists {
+			values2 := make([]Param30values2.MyaliasInt, 0, len(values2RawArray))
+			for _, values2Raw := range values2RawArray {
+				values2Item, conversionErr := parseIntegerParam[int](values2Raw)
+				if conversionErr != nil {
+					// Middlewares onInputValidationMiddlewares section
+					for _, middleware := range onInputValidationMiddlewares {
+						middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+						setRequestContext(req, middlewareCtx)
+						if !continueOperation {
+							return
+						}
+					}
+					// End middlewares onInputValidationMiddlewares section
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values2",
+						"query",
+						"/values2",
+						"[]MyaliasInt",
+						reflect.TypeOf(values2Raw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
+					// params validation error response extension placeholder
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+					return
+				}
+				values2 = append(values2, Param30values2.MyaliasInt(values2Item))
+			}
+			values2RawPtr = &values2
+			values2Var := []Param30values2.MyaliasInt(values2)
+			values2RawPtr = &values2Var
+		}
+		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "values2"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var values3RawPtr *[]bool = nil
+		values3RawArray := req.URL.Query()["values3"]
+		isvalues3Exists := req.URL.Query().Has("values3")
+		if isvalues3Exists {
+			values3 := make([]bool, 0, len(values3RawArray))
+			for _, values3Raw := range values3RawArray {
+				values3Item, conversionErr := strconv.ParseBool(values3Raw)
+				if conversionErr != nil {
+					// Middlewares onInputValidationMiddlewares section
+					for _, middleware := range onInputValidationMiddlewares {
+						middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+						setRequestContext(req, middlewareCtx)
+						if !continueOperation {
+							return
+						}
+					}
+					// End middlewares onInputValidationMiddlewares section
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values3",
+						"query",
+						"/values3",
+						"[]bool",
+						reflect.TypeOf(values3Raw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
+					// params validation error response extension placeholder
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+					return
+				}
+				values3 = append(values3, bool(values3Item))
+			}
+			values3RawPtr = &values3
+			values3RawPtr = &values3
+		}
+		if validatorErr := validatorInstance.Var(values3RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "values3"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values3", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var values4RawPtr *[]int32 = nil
+		values4RawArray := req.URL.Query()["values4"]
+		isvalues4Exists := req.URL.Query().Has("values4")
+		if isvalues4Exists {
+			values4 := make([]int32, 0, len(values4RawArray))
+			for _, values4Raw := range values4RawArray {
+				values4Item, conversionErr := parseIntegerParam[int32](values4Raw)
+				if conversionErr != nil {
+					// Middlewares onInputValidationMiddlewares section
+					for _, middleware := range onInputValidationMiddlewares {
+						middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+						setRequestContext(req, middlewareCtx)
+						if !continueOperation {
+							return
+						}
+					}
+					// End middlewares onInputValidationMiddlewares section
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values4",
+						"query",
+						"/values4",
+						"[]int32",
+						reflect.TypeOf(values4Raw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
+					// params validation error response extension placeholder
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+					return
+				}
+				values4 = append(values4, int32(values4Item))
+			}
+			values4RawPtr = &values4
+			values4RawPtr = &values4
+		}
+		if validatorErr := validatorInstance.Var(values4RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "values4"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values4", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.QueryArrayOfOthers(*valuesRawPtr, *values2RawPtr, *values3RawPtr, *values4RawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'QueryArrayOfOthers'",
+				Status:     statusCode,
+				Instance:   "/controller/error/QueryArrayOfOthers",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/query-array-of-others")), e2EcontrollerQueryArrayOfOthersHandler)
+	e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersEnumHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "QueryArrayOfOthersEnum")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var valuesRawPtr *[]Param32values.NumberEnum = nil
+		valuesRawArray := req.URL.Query()["values"]
+		isvaluesExists := req.URL.Query().Has("values")
+		if isvaluesExists {
+			values := make([]Param32values.NumberEnum, 0, len(valuesRawArray))
+			for _, valuesRaw := range valuesRawArray {
+				valuesItem, conversionErr := parseIntegerParam[int16](valuesRaw)
+				if conversionErr != nil {
+					// Middlewares onInputValidationMiddlewares section
+					for _, middleware := range onInputValidationMiddlewares {
+						middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+						setRequestContext(req, middlewareCtx)
+						if !continueOperation {
+							return
+						}
+					}
+					// End middlewares onInputValidationMiddlewares section
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values",
+						"query",
+						"/values",
+						"[]NumberEnum",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
+					// params validation error response extension placeholder
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+					return
+				}
+				values = append(values, Param32values.NumberEnum(valuesItem))
+			}
+			valuesRawPtr = &values
+			valuesVar := []Param32values.NumberEnum(values)
+			valuesRawPtr = &valuesVar
+		}
+		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "values"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var values2RawPtr *[]Param33values2.BoolEnum = nil
+		values2RawArray := req.URL.Query()["values2"]
+		isvalues2Exists := req.URL.Query().Has("values2")
+		if isvalues2Exists {
+			values2 := make([]Param33values2.BoolEnum, 0, len(values2RawArray))
+			for _, values2Raw := range values2RawArray {
+				values2Item := values2Raw
+				values2 = append(values2, Param33values2.BoolEnum(values2Item))
+			}
+			values2RawPtr = &values2
+			values2Var := []Param33values2.BoolEnum(values2)
+			values2RawPtr = &values2Var
+		}
+		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "values2"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.QueryArrayOfOthersEnum(*valuesRawPtr, *values2RawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'QueryArrayOfOthersEnum'",
+				Status:     statusCode,
+				Instance:   "/controller/error/QueryArrayOfOthersEnum",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/query-array-of-others-enum")), e2EcontrollerQueryArrayOfOthersEnumHandler)
+	e2EcontrollerQueryArrayOfPointersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfPointersHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "QueryArrayOfPointers")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var values07RawPtr *[]string = nil
+		values07RawArray := req.URL.Query()["values07"]
+		isvalues07Exists := req.URL.Query().Has("values07")
+		if isvalues07Exists {
+			values07 := make([]string, 0, len(values07RawArray))
+			for _, values07Raw := range values07RawArray {
+				values07Item := values07Raw
+				values07 = append(values07, string(values07Item))
+			}
+			values07RawPtr = &values07
+			values07RawPtr = &values07
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.QueryArrayOfPointers(values07RawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'QueryArrayOfPointers'",
+				Status:     statusCode,
+				Instance:   "/controller/error/QueryArrayOfPointers",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/query-pointer-to-array")), e2EcontrollerQueryArrayOfPointersHandler)
+}
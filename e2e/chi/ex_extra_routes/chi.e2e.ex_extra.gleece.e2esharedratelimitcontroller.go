@@ -0,0 +1,199 @@
+/*
+--
+This file is automatically generated. Any manual changes to this file may be overwritten.
+It includes the routes and handlers of one or more controllers by the Gleece API Routes Generator.
+--
+Authors: Haim Kastner & Yuval Pomerchik
+Generated by: Gleece Routes Generator
+Routes File: chi.e2e.ex_extra.gleece.go
+Generated Date: 2026-10-19
+Target Engine: Chi v5 (https://github.com/go-chi/chi)
+--
+Usage:
+Refer to the Gleece documentation https://docs.gleece.dev for details on how to use the generated routes and handlers.
+--
+Repository: https://github.com/gopher-fleece/gleece
+--
+*/
+package ex_extra_routes
+import (
+	"encoding/json"
+	"net/http"
+	"github.com/go-chi/chi/v5"
+	"github.com/gopher-fleece/runtime"
+	E2ESharedRateLimitController "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	// import extension placeholder
+)
+// registerE2ESharedRateLimitControllerRoutes registers the E2ESharedRateLimitController controller's routes on the given router, under the given base path
+func registerE2ESharedRateLimitControllerRoutes(engine chi.Router, basePath string) {
+	e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitFirstHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		if !enforceRateLimit(w, req, "SharedRateLimitFirst", e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit) {
+			return
+		}
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SharedRateLimitFirst")
+			return
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SharedRateLimitFirst()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitFirst'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitFirst",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/shared-rate-limit/first")), e2EsharedRateLimitControllerSharedRateLimitFirstHandler)
+	e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitSecondHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		if !enforceRateLimit(w, req, "SharedRateLimitSecond", e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit) {
+			return
+		}
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SharedRateLimitSecond")
+			return
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SharedRateLimitSecond()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitSecond'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitSecond",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/shared-rate-limit/second")), e2EsharedRateLimitControllerSharedRateLimitSecondHandler)
+}
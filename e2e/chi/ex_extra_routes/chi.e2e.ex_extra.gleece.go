@@ -5,7 +5,7 @@ It includes routes and handlers by the Gleece API Routes Generator.
 --
 Authors: Haim Kastner & Yuval Pomerchik
 Generated by: Gleece Routes Generator
-Generated Date: 2025-12-25
+Generated Date: 2026-10-19
 Target Engine: Chi v5 (https://github.com/go-chi/chi)
 --
 Usage:
@@ -16,45 +16,28 @@ Repository: https://github.com/gopher-fleece/gleece
 */
 package ex_extra_routes
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
+	"net"
 	"net/http"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-playground/validator/v10"
 	RequestAuth "github.com/gopher-fleece/gleece/v2/e2e/chi/auth"
 	"github.com/gopher-fleece/runtime"
-	E2EClassSecController "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	E2EController "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param11value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param12value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param12value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param15data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param16data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param17data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param18data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param19data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param20data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param22arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param23arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param24object "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param25num "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param26str "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param27values "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param28values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param29values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param31values "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param32values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param4value2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param5theBody "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Response6CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param13data "github.com/haimkastner/unitsnet-go/units"
-	Param14unit "github.com/haimkastner/unitsnet-go/units"
 	// import extension placeholder
 )
 var validatorInstance = validator.New()
@@ -67,6 +50,24 @@ type SecurityCheckList struct {
 	Checks   []runtime.SecurityCheck
 	Relation SecurityListRelation
 }
+// ValidationIssue describes a single failed validation of a request's input
+type ValidationIssue struct {
+	// The location of the offending input - body, query, path, header or form
+	In string `json:"in"`
+	// A JSON pointer to the offending value, relative to its location
+	Pointer string `json:"pointer"`
+	// The validation rule that failed
+	Rule string `json:"rule"`
+	// The failed rule's parameter, if any
+	Param string `json:"param,omitempty"`
+	// A human-readable description of the failure
+	Message string `json:"message"`
+}
+// ValidationProblem is an RFC7807 error detailing the failed validations of a request
+type ValidationProblem struct {
+	runtime.Rfc7807Error
+	Errors []ValidationIssue `json:"errors"`
+}
 // type declarations extension placeholder
 func getRequestContext(req *http.Request) context.Context {
 	return req.Context()
@@ -76,6 +77,56 @@ func setRequestContext(req *http.Request, ctx context.Context) {
 		*req = *req.WithContext(ctx)
 	}
 }
+type principalContextKey struct{}
+// WithPrincipal attaches the request's authenticated principal to the given context.
+//
+// Security handlers return the resulting context to make the principal available to @Principal parameters
+func WithPrincipal(ctx context.Context, principal any) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+// getPrincipal retrieves the principal attached to the given context via WithPrincipal.
+// Yields nil if no principal of type TPrincipal (or a pointer to one) was attached
+func getPrincipal[TPrincipal any](ctx context.Context) *TPrincipal {
+	switch principal := ctx.Value(principalContextKey{}).(type) {
+	case *TPrincipal:
+		return principal
+	case TPrincipal:
+		return &principal
+	default:
+		return nil
+	}
+}
+// IdentifiablePrincipal may be implemented by principals to provide a stable identity, e.g. a user ID,
+// by which their idempotent requests and principal keyed rate limits are scoped
+type IdentifiablePrincipal interface {
+	IdempotencyIdentity() string
+}
+// describePrincipal returns a stable textual identity of the principal attached to the given context via WithPrincipal,
+// i.e., its IdempotencyIdentity or String method's result, if any, or its dereferenced value if of a primitive kind.
+// Yields an empty string if no principal was attached.
+//
+// Returns false for principals lacking a stable identity, e.g. structs, whose formatting may vary between requests
+func describePrincipal(ctx context.Context) (string, bool) {
+	principal := ctx.Value(principalContextKey{})
+	switch identifiable := principal.(type) {
+	case nil:
+		return "", true
+	case IdentifiablePrincipal:
+		return identifiable.IdempotencyIdentity(), true
+	case fmt.Stringer:
+		return identifiable.String(), true
+	}
+	value := reflect.Indirect(reflect.ValueOf(principal))
+	switch value.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprintf("%v", value), true
+	default:
+		return "", false
+	}
+}
 func registerEnumValidation(validate *validator.Validate, validationName string, allowedValues []string) {
 	// Convert the array to a map for O(1) lookup
 	lookup := make(map[string]struct{})
@@ -89,12 +140,12 @@ func registerEnumValidation(validate *validator.Validate, validationName string,
 		return exists
 	})
 }
-func extractValidationErrorMessage(err error, fieldName *string) string {
+func extractValidationErrorMessage(err error, fieldName *string, languages []string) string {
 	if err == nil {
 		return ""
 	}
-	validationErrors, ok := err.(validator.ValidationErrors)
-	if !ok {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
 		return err.Error()
 	}
 	var errStr string
@@ -103,15 +154,26 @@ func extractValidationErrorMessage(err error, fieldName *string) string {
 		if fieldName != nil {
 			fName = *fieldName
 		}
-		errStr += fmt.Sprintf("Field '%s' failed validation with tag '%s'. ", fName, validationErr.Tag())
+		errStr += translateMessage(
+			languages,
+			map[string]string{"field": fName, "tag": validationErr.Tag(), "param": validationErr.Param()},
+			ValidationTagMessageKey(validationErr.Tag()),
+			MessageKeyFieldValidation,
+		) + ". "
 	}
 	return errStr
 }
-func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error) int {
+// getStatusCode returns the status code of an operation's response - the one set via the controller's SetStatus, if any,
+// that of the error mapping matching err among the operation's declared error status codes or a 500 for other errors,
+// or the success status code
+func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error, routeErrorStatusCodes []int) int {
 	if controller.GetStatus() != nil {
 		return int(*controller.GetStatus())
 	}
 	if err != nil {
+		if mapping := getErrorMapping(err, routeErrorStatusCodes); mapping != nil {
+			return mapping.StatusCode
+		}
 		return http.StatusInternalServerError
 	}
 	if hasReturnValue {
@@ -124,7 +186,7 @@ func bindAndValidateBody[TOutput any](req *http.Request, contentType string, val
 	bodyBytes, err := io.ReadAll(req.Body)
 	if err != nil || len(bodyBytes) == 0 {
 		if strings.Contains(validation, "required") {
-			return fmt.Errorf("body is required but was not provided")
+			return errBodyRequired
 		}
 		return nil
 	}
@@ -159,12 +221,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Slice, reflect.Array:
 		// For slices/arrays, validate each element recursively
 		for i := 0; i < val.Len(); i++ {
-			elemPath := path
-			if path != "" {
-				elemPath = fmt.Sprintf("%s[%d]", path, i)
-			} else {
-				elemPath = fmt.Sprintf("[%d]", i)
-			}
+			elemPath := fmt.Sprintf("%s/%d", path, i)
 			// Get the element - handle case where element might be nil
 			elem := val.Index(i)
 			if elem.Kind() == reflect.Ptr && elem.IsNil() {
@@ -179,12 +236,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Map:
 		// For maps, validate each value recursively
 		for _, key := range val.MapKeys() {
-			elemPath := path
-			if path != "" {
-				elemPath = fmt.Sprintf("%s.%v", path, key.Interface())
-			} else {
-				elemPath = fmt.Sprintf("%v", key.Interface())
-			}
+			elemPath := fmt.Sprintf("%s/%s", path, escapeJsonPointerToken(fmt.Sprintf("%v", key.Interface())))
 			elemVal := val.MapIndex(key)
 			if elemVal.Kind() == reflect.Ptr && elemVal.IsNil() {
 				continue
@@ -197,10 +249,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Struct:
 		// Validate structs with the validator
 		if err := validatorInstance.Struct(data); err != nil {
-			if path != "" {
-				return fmt.Errorf("validation error at %s: %w", path, err)
-			}
-			return err
+			return &structValidationError{pointer: path, structType: val.Type(), err: err}
 		}
 		return nil
 	default:
@@ -211,16 +260,30 @@ func validateDataRecursive(data interface{}, path string) error {
 func toChiUrl(url string) string {
 	return url
 }
+// withBasePath prefixes the given route path with the given base path
+func withBasePath(basePath string, path string) string {
+	basePath = strings.TrimSuffix(basePath, "/")
+	if basePath != "" && !strings.HasPrefix(basePath, "/") {
+		basePath = "/" + basePath
+	}
+	return basePath + path
+}
+// authorize succeeds if all checks of any of the given lists pass.
+// Each list is an alternative (OR) of security schemas that must all be satisfied (AND), as expanded
+// from the route's @Security and @AdvancedSecurity annotations
 func authorize(req *http.Request, checksLists []SecurityCheckList) *runtime.SecurityError {
 	var lastError *runtime.SecurityError
 	for _, list := range checksLists {
 		if list.Relation != SecurityListRelationAnd {
-			panic(
-				"Encountered a security list relation of type '%s' - this is unexpected and indicates a bug in Gleece itself." +
+			panic(fmt.Sprintf(
+				"Encountered a security list relation of type '%s' - this is unexpected and indicates a bug in Gleece itself. "+
 					"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
-			)
+				list.Relation,
+			))
 		}
-		// Iterate over each security list
+		// Iterate over each security list.
+		// Checks may attach values (e.g. a principal) to the request context - these are discarded if the list fails
+		listCtx := getRequestContext(req)
 		encounteredErrorInList := false
 		for _, check := range list.Checks {
 			secCtx, secErr := RequestAuth.GleeceRequestAuthorization(getRequestContext(req), req, check)
@@ -236,6 +299,7 @@ func authorize(req *http.Request, checksLists []SecurityCheckList) *runtime.Secu
 		if !encounteredErrorInList {
 			return nil
 		}
+		setRequestContext(req, listCtx)
 	}
 	// If we got here it means authentication has failed
 	return lastError
@@ -257,20 +321,954 @@ func handleAuthorizationError(w http.ResponseWriter, authErr *runtime.SecurityEr
 	w.WriteHeader(statusCode)
 	json.NewEncoder(w).Encode(stdError)
 }
-func wrapValidatorError(validatorErr error, operationId string, fieldName string) runtime.Rfc7807Error {
+func wrapValidatorError(validatorErr error, operationId string, fieldName string, languages []string) runtime.Rfc7807Error {
 	return runtime.Rfc7807Error{
 		Type: http.StatusText(http.StatusUnprocessableEntity),
-		Detail: fmt.Sprintf(
-			"A request was made to operation '%s' but parameter '%s' did not pass validation - %s",
-			operationId,
-			fieldName,
-			extractValidationErrorMessage(validatorErr, &fieldName),
+		Detail: translateMessage(
+			languages,
+			map[string]string{
+				"operation": operationId,
+				"parameter": fieldName,
+				"details":   extractValidationErrorMessage(validatorErr, &fieldName, languages),
+			},
+			MessageKeyParamValidation,
 		),
 		Status:   http.StatusUnprocessableEntity,
 		Instance: fmt.Sprintf("/validation/error/%s", operationId),
 	}
 }
+// paramInteger is the set of signed integer types a request parameter may be converted to
+type paramInteger interface {
+	int | int8 | int16 | int32 | int64
+}
+// paramUnsigned is the set of unsigned integer types a request parameter may be converted to
+type paramUnsigned interface {
+	uint | uint8 | uint16 | uint32 | uint64
+}
+// paramFloat is the set of floating point types a request parameter may be converted to
+type paramFloat interface {
+	float32 | float64
+}
+// parseIntegerParam converts the raw value of a request parameter to the given signed integer type
+func parseIntegerParam[T paramInteger](raw string) (T, error) {
+	parsed, err := strconv.ParseInt(raw, 10, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// parseUnsignedParam converts the raw value of a request parameter to the given unsigned integer type
+func parseUnsignedParam[T paramUnsigned](raw string) (T, error) {
+	parsed, err := strconv.ParseUint(raw, 10, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// parseFloatParam converts the raw value of a request parameter to the given floating point type
+func parseFloatParam[T paramFloat](raw string) (T, error) {
+	parsed, err := strconv.ParseFloat(raw, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// wrapConversionError creates the RFC7807 error and validation issues describing a request parameter
+// whose raw value could not be converted to the parameter's type
+func wrapConversionError(
+	conversionErr error,
+	operationId string,
+	fieldName string,
+	in string,
+	pointer string,
+	expectedType string,
+	actualType string,
+	languages []string,
+) (runtime.Rfc7807Error, []ValidationIssue) {
+	validationError := runtime.Rfc7807Error{
+		Type: http.StatusText(http.StatusUnprocessableEntity),
+		Detail: translateMessage(
+			languages,
+			map[string]string{
+				"operation": operationId,
+				"parameter": fieldName,
+				"expected":  expectedType,
+				"actual":    actualType,
+			},
+			MessageKeyParamConversion,
+		),
+		Status:     http.StatusUnprocessableEntity,
+		Instance:   fmt.Sprintf("/validation/error/%s", operationId),
+		Extensions: map[string]string{"error": conversionErr.Error()},
+	}
+	validationIssues := []ValidationIssue{
+		{
+			In:      in,
+			Pointer: pointer,
+			Rule:    "type",
+			Param:   expectedType,
+			Message: conversionErr.Error(),
+		},
+	}
+	return validationError, validationIssues
+}
+var errBodyRequired = errors.New("body is required but was not provided")
+// structValidationError associates a struct's validation errors with the struct's location within the validated payload
+type structValidationError struct {
+	pointer    string
+	structType reflect.Type
+	err        error
+}
+func (e *structValidationError) Error() string {
+	if e.pointer == "" {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("validation error at %s: %s", e.pointer, e.err.Error())
+}
+func (e *structValidationError) Unwrap() error {
+	return e.err
+}
+func escapeJsonPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+// getJsonFieldName returns the name of the given field within its struct's JSON representation.
+//
+// Embedded structs without an explicit name are flattened by encoding/json and therefore yield an empty name
+func getJsonFieldName(field reflect.StructField) string {
+	if name := strings.Split(field.Tag.Get("json"), ",")[0]; name != "" {
+		return name
+	}
+	fieldType := field.Type
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	if field.Anonymous && fieldType.Kind() == reflect.Struct {
+		return ""
+	}
+	return field.Name
+}
+// toJsonPointer converts a validator struct namespace (e.g. 'Model.Items[0].Name') to a JSON pointer (e.g. '/items/0/name')
+// using the json tags of the root type's fields
+func toJsonPointer(rootType reflect.Type, structNamespace string) string {
+	pointer := ""
+	currentType := rootType
+	segments := strings.Split(structNamespace, ".")
+	for _, segment := range segments[1:] {
+		name, indices := segment, []string{}
+		if bracket := strings.Index(segment, "["); bracket >= 0 {
+			name = segment[:bracket]
+			indices = strings.Split(strings.TrimSuffix(segment[bracket+1:], "]"), "][")
+		}
+		for currentType != nil && currentType.Kind() == reflect.Ptr {
+			currentType = currentType.Elem()
+		}
+		token := name
+		if currentType != nil && currentType.Kind() == reflect.Struct {
+			if field, found := currentType.FieldByName(name); found {
+				currentType = field.Type
+				token = getJsonFieldName(field)
+			} else {
+				currentType = nil
+			}
+		}
+		if token != "" {
+			pointer += "/" + escapeJsonPointerToken(token)
+		}
+		for _, index := range indices {
+			pointer += "/" + escapeJsonPointerToken(index)
+			for currentType != nil && currentType.Kind() == reflect.Ptr {
+				currentType = currentType.Elem()
+			}
+			if currentType != nil && (currentType.Kind() == reflect.Slice || currentType.Kind() == reflect.Array || currentType.Kind() == reflect.Map) {
+				currentType = currentType.Elem()
+			} else {
+				currentType = nil
+			}
+		}
+	}
+	return pointer
+}
+func getValidationIssueMessage(pointer string, tag string, param string, languages []string) string {
+	args := map[string]string{"tag": tag, "param": param}
+	if pointer == "" {
+		return translateMessage(languages, args, ValidationTagMessageKey(tag), MessageKeyValueValidation)
+	}
+	args["field"] = pointer[strings.LastIndex(pointer, "/")+1:]
+	return translateMessage(languages, args, ValidationTagMessageKey(tag), MessageKeyFieldValidation)
+}
+// getValidationIssues converts the given validation or deserialization error to a list of issues at the given location.
+//
+// The pointer is the JSON pointer of the validated value, relative to its location
+func getValidationIssues(err error, in string, pointer string, languages []string) []ValidationIssue {
+	var structType reflect.Type
+	var structErr *structValidationError
+	if errors.As(err, &structErr) {
+		pointer += structErr.pointer
+		structType = structErr.structType
+	}
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		issues := make([]ValidationIssue, 0, len(validationErrors))
+		for _, validationErr := range validationErrors {
+			issuePointer := pointer
+			if structType != nil {
+				issuePointer += toJsonPointer(structType, validationErr.StructNamespace())
+			}
+			issues = append(issues, ValidationIssue{
+				In:      in,
+				Pointer: issuePointer,
+				Rule:    validationErr.Tag(),
+				Param:   validationErr.Param(),
+				Message: getValidationIssueMessage(issuePointer, validationErr.Tag(), validationErr.Param(), languages),
+			})
+		}
+		return issues
+	}
+	issue := ValidationIssue{In: in, Pointer: pointer, Rule: "parse", Message: err.Error()}
+	var typeErr *json.UnmarshalTypeError
+	if errors.Is(err, errBodyRequired) {
+		issue.Rule = "required"
+	} else if errors.As(err, &typeErr) {
+		if typeErr.Field != "" {
+			issue.Pointer += "/" + strings.ReplaceAll(typeErr.Field, ".", "/")
+		}
+		issue.Rule = "type"
+		issue.Param = typeErr.Type.String()
+	}
+	return []ValidationIssue{issue}
+}
+// Keys of the translatable validation messages.
+//
+// Message templates use named placeholders, such as '{field}', which are replaced with the message's arguments
+const (
+	// Describes a parameter that did not pass validation. Placeholders: {operation}, {parameter}, {details}
+	MessageKeyParamValidation = "paramValidation"
+	// Describes a body that did not pass validation. Placeholders: {operation}, {parameter}, {type}, {details}
+	MessageKeyBodyValidation = "bodyValidation"
+	// Describes a parameter that could not be converted to its declared type. Placeholders: {operation}, {parameter}, {expected}, {actual}
+	MessageKeyParamConversion = "paramConversion"
+	// Describes a field that failed a validation tag with no dedicated message. Placeholders: {field}, {tag}, {param}
+	MessageKeyFieldValidation = "fieldValidation"
+	// Describes a value that failed a validation tag with no dedicated message. Placeholders: {tag}, {param}
+	MessageKeyValueValidation = "valueValidation"
+)
+// The language of the default message catalogue
+const defaultMessageLanguage = "en"
+// The default English message catalogue, used whenever no translation is available
+var defaultMessages = map[string]string{
+	MessageKeyParamValidation: "A request was made to operation '{operation}' but parameter '{parameter}' did not pass validation - {details}",
+	MessageKeyBodyValidation:  "A request was made to operation '{operation}' but body parameter '{parameter}' did not pass validation of '{type}' - {details}",
+	MessageKeyParamConversion: "A request was made to operation '{operation}' but parameter '{parameter}' was not properly sent - Expected {expected} but got {actual}",
+	MessageKeyFieldValidation: "Field '{field}' failed validation with tag '{tag}'",
+	MessageKeyValueValidation: "Value failed validation with tag '{tag}'",
+}
+// MessageTranslator provides validation message templates in the languages requested via the Accept-Language header
+type MessageTranslator interface {
+	// Translate returns the message template registered for the given language and key, if any
+	Translate(language string, key string) (string, bool)
+}
+// MessageCatalogue is a MessageTranslator backed by an in-memory map of language to message key to template.
+//
+// Languages are matched case-insensitively
+type MessageCatalogue map[string]map[string]string
+func (catalogue MessageCatalogue) Translate(language string, key string) (string, bool) {
+	template, found := catalogue[strings.ToLower(language)][key]
+	return template, found
+}
+var messageCatalogue = MessageCatalogue{}
+var messageTranslator MessageTranslator = messageCatalogue
+// SetMessageTranslator replaces the built-in message catalogue with the given translator,
+// e.g. an adapter over go-playground's universal translator.
+//
+// Messages the translator does not provide fall back to the default English catalogue
+func SetMessageTranslator(translator MessageTranslator) {
+	if translator == nil {
+		translator = messageCatalogue
+	}
+	messageTranslator = translator
+}
+// RegisterTranslation registers the template of the given message key for the given language in the built-in catalogue.
+// Has no effect on translators set via SetMessageTranslator
+func RegisterTranslation(language string, key string, template string) {
+	language = strings.ToLower(language)
+	if messageCatalogue[language] == nil {
+		messageCatalogue[language] = map[string]string{}
+	}
+	messageCatalogue[language][key] = template
+}
+// ValidationTagMessageKey returns the message key of the given validation tag, e.g. 'required'.
+//
+// Templates registered under this key take precedence over the generic field and value messages.
+// Placeholders: {field}, {tag}, {param}
+func ValidationTagMessageKey(tag string) string {
+	return "tag." + tag
+}
+// RegisterValidationTagTranslation registers the message used when the given validation tag fails, for the given language
+func RegisterValidationTagTranslation(language string, tag string, template string) {
+	RegisterTranslation(language, ValidationTagMessageKey(tag), template)
+}
+// getAcceptedLanguages returns the languages listed in the given Accept-Language header, most preferred first.
+// Regional variants are followed by their base language, e.g. 'fr-CA' by 'fr'
+func getAcceptedLanguages(header string) []string {
+	type weightedLanguage struct {
+		tag    string
+		weight float64
+	}
+	weightedLanguages := []weightedLanguage{}
+	for _, entry := range strings.Split(header, ",") {
+		parts := strings.Split(entry, ";")
+		tag := strings.TrimSpace(parts[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		weight := 1.0
+		for _, param := range parts[1:] {
+			if value, found := strings.CutPrefix(strings.TrimSpace(param), "q="); found {
+				if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+					weight = parsed
+				}
+			}
+		}
+		if weight > 0 {
+			weightedLanguages = append(weightedLanguages, weightedLanguage{tag: tag, weight: weight})
+		}
+	}
+	slices.SortStableFunc(weightedLanguages, func(a, b weightedLanguage) int {
+		if a.weight > b.weight {
+			return -1
+		}
+		if a.weight < b.weight {
+			return 1
+		}
+		return 0
+	})
+	languages := make([]string, 0, len(weightedLanguages))
+	for _, language := range weightedLanguages {
+		languages = append(languages, language.tag)
+		if base, _, hasRegion := strings.Cut(language.tag, "-"); hasRegion {
+			languages = append(languages, base)
+		}
+	}
+	return languages
+}
+// getMessageTemplate returns the template of the first of the given keys available in the most preferred of the given languages,
+// falling back to English and finally to the default English catalogue
+func getMessageTemplate(languages []string, keys ...string) string {
+	for _, language := range append(slices.Clip(languages), defaultMessageLanguage) {
+		for _, key := range keys {
+			if template, found := messageTranslator.Translate(language, key); found {
+				return template
+			}
+		}
+	}
+	for _, key := range keys {
+		if template, found := defaultMessages[key]; found {
+			return template
+		}
+	}
+	return ""
+}
+// translateMessage formats the template of the given keys in the given languages, replacing its placeholders with the given arguments
+func translateMessage(languages []string, args map[string]string, keys ...string) string {
+	replacements := make([]string, 0, len(args)*2)
+	for name, value := range args {
+		replacements = append(replacements, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(replacements...).Replace(getMessageTemplate(languages, keys...))
+}
+// ControllerFactory creates the controller instance handling a single request.
+//
+// The given context is the request's context
+type ControllerFactory[TController any] func(ctx context.Context) *TController
+var controllerFactories = map[reflect.Type]func(ctx context.Context) any{}
+// RegisterControllerFactory registers the factory used to create a controller for each request it handles.
+//
+// Factories allow controllers to hold injected dependencies such as database handles, services or loggers.
+// Must be called before RegisterRoutes; the factory must not return nil
+func RegisterControllerFactory[TController any](factory ControllerFactory[TController]) {
+	controllerFactories[reflect.TypeOf((*TController)(nil)).Elem()] = func(ctx context.Context) any {
+		return factory(ctx)
+	}
+}
+// createController creates a controller using its registered factory or, if none exists, its zero value
+func createController[TController any](ctx context.Context) *TController {
+	if factory, exists := controllerFactories[reflect.TypeOf((*TController)(nil)).Elem()]; exists {
+		return factory(ctx).(*TController)
+	}
+	return new(TController)
+}
+// ensureControllerFactoriesRegistered panics if any of the given controller types has no registered factory
+func ensureControllerFactoriesRegistered(controllerTypes []reflect.Type) {
+	missing := []string{}
+	for _, controllerType := range controllerTypes {
+		if _, registered := controllerFactories[controllerType]; !registered {
+			missing = append(missing, controllerType.String())
+		}
+	}
+	if len(missing) > 0 {
+		panic(fmt.Sprintf(
+			"controller factories are required but were never registered using RegisterControllerFactory: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
+// ErrorMapping describes the response returned when a controller's error matches a registered mapping
+type ErrorMapping struct {
+	// The HTTP status code to respond with. Must be declared by at least one @ErrorResponse annotation.
+	// The mapping applies only to operations declaring the status code - errors of other operations remain 500s
+	StatusCode int
+	// The RFC7807 title. Defaults to the status code's text
+	Title string
+	// A client-safe description of the error. Defaults to a generic, operation-specific message
+	Detail string
+}
+type registeredErrorMapping struct {
+	matches func(err error) bool
+	mapping ErrorMapping
+}
+var errorMappings = []registeredErrorMapping{}
+var declaredErrorStatusCodes = []int{404, 409, 500}
+// RegisterErrorMapping maps errors matching the given target, via errors.Is, to the given HTTP status code.
+//
+// Mappings are evaluated in order of registration and are superseded by an explicit call to the controller's SetStatus
+func RegisterErrorMapping(target error, statusCode int) {
+	RegisterDetailedErrorMapping(target, ErrorMapping{StatusCode: statusCode})
+}
+// RegisterDetailedErrorMapping maps errors matching the given target, via errors.Is, to the given response
+func RegisterDetailedErrorMapping(target error, mapping ErrorMapping) {
+	addErrorMapping(func(err error) bool { return errors.Is(err, target) }, mapping)
+}
+// RegisterErrorTypeMapping maps errors of type TError, matched via errors.As, to the given response
+func RegisterErrorTypeMapping[TError error](mapping ErrorMapping) {
+	addErrorMapping(func(err error) bool {
+		var target TError
+		return errors.As(err, &target)
+	}, mapping)
+}
+func addErrorMapping(matches func(err error) bool, mapping ErrorMapping) {
+	if !slices.Contains(declaredErrorStatusCodes, mapping.StatusCode) {
+		panic(fmt.Sprintf("Error mapping status code %d is not declared by any @ErrorResponse annotation", mapping.StatusCode))
+	}
+	errorMappings = append(errorMappings, registeredErrorMapping{matches: matches, mapping: mapping})
+}
+// getErrorMapping returns the first registered mapping matching err whose status code is among the given ones, declared by
+// the operation that produced err, or nil if there is none
+func getErrorMapping(err error, routeErrorStatusCodes []int) *ErrorMapping {
+	for _, registered := range errorMappings {
+		if slices.Contains(routeErrorStatusCodes, registered.mapping.StatusCode) && registered.matches(err) {
+			return &registered.mapping
+		}
+	}
+	return nil
+}
+// applyErrorMapping overrides the given RFC7807 error's title and detail with those of the mapping matching err, if any
+func applyErrorMapping(stdError *runtime.Rfc7807Error, err error, routeErrorStatusCodes []int) {
+	mapping := getErrorMapping(err, routeErrorStatusCodes)
+	if mapping == nil {
+		return
+	}
+	stdError.Title = http.StatusText(stdError.Status)
+	if mapping.Title != "" {
+		stdError.Title = mapping.Title
+	}
+	if mapping.Detail != "" {
+		stdError.Detail = mapping.Detail
+	}
+}
 // function declarations extension placeholder
+// DefaultApiVersion is the API version served to requests that do not specify one
+const DefaultApiVersion = 2
+// The request header specifying the requested API version
+const apiVersionHeader = "X-API-Version"
+// The handlers of the versioned routes registered by RegisterGroupRoutes, per method and URL and then per API version
+var versionedRouteHandlers map[string]map[int]http.HandlerFunc
+// registerVersionedRoute registers the given handler for each of the given API versions.
+//
+// Routes sharing a method and a path are registered once and dispatched according to the requested API version
+func registerVersionedRoute(engine chi.Router, basePath string, method string, path string, versions []int, handler http.HandlerFunc) {
+	url := toChiUrl(withBasePath(basePath, path))
+	handlers, exists := versionedRouteHandlers[method+" "+url]
+	if !exists {
+		handlers = map[int]http.HandlerFunc{}
+		versionedRouteHandlers[method+" "+url] = handlers
+		engine.MethodFunc(method, url, func(w http.ResponseWriter, req *http.Request) {
+			version, isValid := getRequestedApiVersion(req.Header.Get(apiVersionHeader))
+			versionHandler, isServed := handlers[version]
+			if !isValid || !isServed {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusNotFound)
+				json.NewEncoder(w).Encode(getUnservedApiVersionError(req.Header.Get(apiVersionHeader)))
+				return
+			}
+			versionHandler(w, req)
+		})
+	}
+	for _, version := range versions {
+		handlers[version] = handler
+	}
+}
+// getRequestedApiVersion returns the API version specified by the given header value, or DefaultApiVersion if none is specified.
+// Versions may be given with or without a 'v' prefix, e.g. '2' or 'v2'
+func getRequestedApiVersion(headerValue string) (int, bool) {
+	requested := strings.TrimSpace(headerValue)
+	if requested == "" {
+		return DefaultApiVersion, true
+	}
+	version, err := strconv.Atoi(strings.TrimPrefix(strings.ToLower(requested), "v"))
+	return version, err == nil
+}
+// getUnservedApiVersionError returns the RFC7807 error with which requests for an API version not served by a route are answered
+func getUnservedApiVersionError(headerValue string) runtime.Rfc7807Error {
+	return runtime.Rfc7807Error{
+		Type:       http.StatusText(http.StatusNotFound),
+		Detail:     fmt.Sprintf("The requested API version is not served by this route (%s: '%s')", apiVersionHeader, headerValue),
+		Status:     http.StatusNotFound,
+		Instance:   "/versioning/error",
+		Extensions: map[string]string{},
+	}
+}
+// RateLimit describes the number of requests an operation accepts per time window, as declared via @RateLimit
+type RateLimit struct {
+	// The number of requests allowed per window
+	Requests int
+	// The window's duration
+	Per time.Duration
+	// The key by which requests are counted - 'ip', 'principal' or 'header:<Name>'
+	Key string
+	// The scope within which requests are counted - the declaring controller's name for limits inherited from a controller,
+	// which are shared by all of its operations inheriting them, or the operation's ID for limits declared by the operation
+	Scope string
+}
+// RateLimiter decides whether requests may proceed under their operation's rate limit.
+//
+// Implementations must be safe for concurrent use
+type RateLimiter interface {
+	// Allow consumes a single request of the given key against the given operation's limit, counted within the limit's scope.
+	// Returns whether the request may proceed and, if not, how long until it may be retried
+	Allow(ctx context.Context, operationId string, key string, limit RateLimit) (bool, time.Duration)
+}
+// InMemoryRateLimiter is a RateLimiter holding a token bucket per limit scope and key in the process's memory.
+//
+// Each bucket holds up to the limit's number of requests and is refilled evenly over the limit's window.
+// Limits are not shared across instances - deployments with multiple instances should use a shared RateLimiter instead
+type InMemoryRateLimiter struct {
+	mutex     sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+type tokenBucket struct {
+	tokens          float64
+	capacity        float64
+	refillPerSecond float64
+	lastRefill      time.Time
+}
+// The interval at which an InMemoryRateLimiter drops buckets that have been refilled to capacity
+const rateLimitSweepInterval = time.Minute
+// NewInMemoryRateLimiter creates an InMemoryRateLimiter with no buckets
+func NewInMemoryRateLimiter() *InMemoryRateLimiter {
+	return &InMemoryRateLimiter{buckets: map[string]*tokenBucket{}, lastSweep: time.Now()}
+}
+func (limiter *InMemoryRateLimiter) Allow(_ context.Context, _ string, key string, limit RateLimit) (bool, time.Duration) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	now := time.Now()
+	limiter.sweep(now)
+	bucketKey := limit.Scope + "\x00" + key
+	bucket, exists := limiter.buckets[bucketKey]
+	if !exists {
+		capacity := float64(limit.Requests)
+		bucket = &tokenBucket{
+			tokens:          capacity,
+			capacity:        capacity,
+			refillPerSecond: capacity / limit.Per.Seconds(),
+			lastRefill:      now,
+		}
+		limiter.buckets[bucketKey] = bucket
+	}
+	bucket.tokens = bucket.tokensAt(now)
+	bucket.lastRefill = now
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - bucket.tokens) / bucket.refillPerSecond * float64(time.Second))
+}
+// sweep drops the buckets that have been refilled to capacity, as those are indistinguishable from new ones
+func (limiter *InMemoryRateLimiter) sweep(now time.Time) {
+	if now.Sub(limiter.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	for bucketKey, bucket := range limiter.buckets {
+		if bucket.tokensAt(now) >= bucket.capacity {
+			delete(limiter.buckets, bucketKey)
+		}
+	}
+	limiter.lastSweep = now
+}
+// tokensAt returns the number of tokens the bucket holds at the given time
+func (bucket *tokenBucket) tokensAt(now time.Time) float64 {
+	return math.Min(bucket.capacity, bucket.tokens+now.Sub(bucket.lastRefill).Seconds()*bucket.refillPerSecond)
+}
+var rateLimiter RateLimiter = NewInMemoryRateLimiter()
+// SetRateLimiter replaces the default InMemoryRateLimiter with the given limiter,
+// e.g. one backed by a store shared across instances
+func SetRateLimiter(limiter RateLimiter) {
+	if limiter == nil {
+		limiter = NewInMemoryRateLimiter()
+	}
+	rateLimiter = limiter
+}
+// newRateLimit creates an operation's RateLimit, counted within the given scope, out of its @RateLimit annotation's properties
+func newRateLimit(requests int, per string, key string, scope string) RateLimit {
+	window, err := time.ParseDuration(per)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid rate limit window '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			per,
+		))
+	}
+	return RateLimit{Requests: requests, Per: window, Key: key, Scope: scope}
+}
+// getClientIp returns the IP address of the request's remote peer
+func getClientIp(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
+// getRateLimitKey returns the value by which the request is counted against a rate limit with the given key.
+//
+// Requests lacking the key's header or an identifiable principal are counted by their client IP
+func getRateLimitKey(req *http.Request, key string) string {
+	value := ""
+	if headerName, isHeaderKey := strings.CutPrefix(key, "header:"); isHeaderKey {
+		value = req.Header.Get(headerName)
+	} else if key == "principal" {
+		value, _ = describePrincipal(getRequestContext(req))
+	}
+	if value == "" {
+		return getClientIp(req)
+	}
+	return key + "=" + value
+}
+// enforceRateLimit counts the request against the given operation's rate limit.
+//
+// Requests exceeding the limit are answered with a 429 RFC7807 error and a 'Retry-After' header, in which case false is returned
+func enforceRateLimit(w http.ResponseWriter, req *http.Request, operationId string, limit RateLimit) bool {
+	allowed, retryAfter := rateLimiter.Allow(getRequestContext(req), operationId, getRateLimitKey(req, limit.Key), limit)
+	if allowed {
+		return true
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	json.NewEncoder(w).Encode(runtime.Rfc7807Error{
+		Type:     http.StatusText(http.StatusTooManyRequests),
+		Detail:   fmt.Sprintf("Too many requests were made to operation '%s'. Please retry later", operationId),
+		Status:   http.StatusTooManyRequests,
+		Instance: "/ratelimit/error/" + operationId,
+	})
+	return false
+}
+// newOperationTimeout creates an operation's deadline duration out of its @Timeout annotation or the configured default timeout
+func newOperationTimeout(timeout string) time.Duration {
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid operation timeout '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			timeout,
+		))
+	}
+	return duration
+}
+// applyOperationTimeout replaces the request's context with one that expires once the given timeout elapses.
+//
+// Operations are not interrupted when the deadline expires - controllers are expected to honor their context's cancellation.
+// The returned function releases the context's resources and must be called once the request has been handled
+func applyOperationTimeout(req *http.Request, timeout time.Duration) context.CancelFunc {
+	ctx, cancel := context.WithTimeout(getRequestContext(req), timeout)
+	setRequestContext(req, ctx)
+	return cancel
+}
+// isDeadlineExceeded returns whether the request's context has expired due to its operation's timeout
+func isDeadlineExceeded(req *http.Request) bool {
+	return errors.Is(getRequestContext(req).Err(), context.DeadlineExceeded)
+}
+// handleDeadlineExceeded answers a request whose operation's deadline has expired with an RFC7807 error of the given status -
+// 503 if the operation has not been invoked yet or 504 if it has
+func handleDeadlineExceeded(w http.ResponseWriter, operationId string, status int) {
+	detail := fmt.Sprintf("The deadline of operation '%s' expired before the operation was invoked", operationId)
+	if status == http.StatusGatewayTimeout {
+		detail = fmt.Sprintf("Operation '%s' did not complete before its deadline expired", operationId)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(runtime.Rfc7807Error{
+		Type:     http.StatusText(status),
+		Detail:   detail,
+		Status:   status,
+		Instance: "/timeout/error/" + operationId,
+	})
+}
+// enforceMaxBodySize checks the request's body against the given operation's maximum body size.
+//
+// Bodies of unknown length are read up-front, up to the maximum size.
+// Requests whose body exceeds the maximum size are answered with a 413 RFC7807 error, in which case false is returned
+func enforceMaxBodySize(w http.ResponseWriter, req *http.Request, operationId string, maxBodySize int64) bool {
+	bodySize := req.ContentLength
+	if bodySize < 0 {
+		body, _ := io.ReadAll(io.LimitReader(req.Body, maxBodySize+1))
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		bodySize = int64(len(body))
+	}
+	if bodySize <= maxBodySize {
+		return true
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusRequestEntityTooLarge)
+	json.NewEncoder(w).Encode(runtime.Rfc7807Error{
+		Type:     http.StatusText(http.StatusRequestEntityTooLarge),
+		Detail:   fmt.Sprintf("The request body of operation '%s' exceeds the maximum size of %d bytes", operationId, maxBodySize),
+		Status:   http.StatusRequestEntityTooLarge,
+		Instance: "/body/error/" + operationId,
+	})
+	return false
+}
+// IdempotentResponse is a response of an idempotent operation, as stored to be replayed to repeated requests
+type IdempotentResponse struct {
+	// The response's HTTP status code
+	Status int
+	// The response's headers
+	Headers http.Header
+	// The response's body
+	Body []byte
+}
+// IdempotencyStore stores the responses of operations declared via @Idempotent, by request key.
+//
+// Implementations must be safe for concurrent use
+type IdempotencyStore interface {
+	// Reserve claims the given key for a new request with the given body hash, for the given duration.
+	// If the key has already been claimed, returns false along with the stored response or,
+	// if the original request is still in flight, a nil response.
+	// If the key has been claimed by a request with a different body hash, returns ErrIdempotencyKeyReused
+	Reserve(ctx context.Context, key string, requestHash string, ttl time.Duration) (bool, *IdempotentResponse, error)
+	// Complete stores the response of the request that claimed the given key, along with the request's body hash, for the given duration
+	Complete(ctx context.Context, key string, requestHash string, response IdempotentResponse, ttl time.Duration) error
+	// Release drops the claim of a request that did not produce a replayable response, so that it may be retried
+	Release(ctx context.Context, key string) error
+}
+// ErrIdempotencyKeyReused is returned by an IdempotencyStore when a key is reused by a request with a different body
+var ErrIdempotencyKeyReused = errors.New("the idempotency key has already been used by a request with a different body")
+// InMemoryIdempotencyStore is an IdempotencyStore holding responses in the process's memory.
+//
+// Responses are not shared across instances - deployments with multiple instances should use a shared IdempotencyStore instead
+type InMemoryIdempotencyStore struct {
+	mutex     sync.Mutex
+	entries   map[string]*idempotencyEntry
+	lastSweep time.Time
+}
+type idempotencyEntry struct {
+	// The stored response. Nil while the request is in flight
+	response *IdempotentResponse
+	// The hash of the body of the request that claimed the entry's key
+	requestHash string
+	expiresAt   time.Time
+}
+// The interval at which an InMemoryIdempotencyStore drops expired entries
+const idempotencySweepInterval = time.Minute
+// NewInMemoryIdempotencyStore creates an InMemoryIdempotencyStore with no entries
+func NewInMemoryIdempotencyStore() *InMemoryIdempotencyStore {
+	return &InMemoryIdempotencyStore{entries: map[string]*idempotencyEntry{}, lastSweep: time.Now()}
+}
+func (store *InMemoryIdempotencyStore) Reserve(_ context.Context, key string, requestHash string, ttl time.Duration) (bool, *IdempotentResponse, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	now := time.Now()
+	store.sweep(now)
+	if entry, exists := store.entries[key]; exists && now.Before(entry.expiresAt) {
+		if entry.requestHash != requestHash {
+			return false, nil, ErrIdempotencyKeyReused
+		}
+		return false, entry.response, nil
+	}
+	store.entries[key] = &idempotencyEntry{requestHash: requestHash, expiresAt: now.Add(ttl)}
+	return true, nil, nil
+}
+func (store *InMemoryIdempotencyStore) Complete(_ context.Context, key string, requestHash string, response IdempotentResponse, ttl time.Duration) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.entries[key] = &idempotencyEntry{response: &response, requestHash: requestHash, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+func (store *InMemoryIdempotencyStore) Release(_ context.Context, key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.entries, key)
+	return nil
+}
+// sweep drops the entries that have expired
+func (store *InMemoryIdempotencyStore) sweep(now time.Time) {
+	if now.Sub(store.lastSweep) < idempotencySweepInterval {
+		return
+	}
+	for key, entry := range store.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(store.entries, key)
+		}
+	}
+	store.lastSweep = now
+}
+var idempotencyStore IdempotencyStore = NewInMemoryIdempotencyStore()
+// SetIdempotencyStore replaces the default InMemoryIdempotencyStore with the given store,
+// e.g. one backed by a database shared across instances
+func SetIdempotencyStore(store IdempotencyStore) {
+	if store == nil {
+		store = NewInMemoryIdempotencyStore()
+	}
+	idempotencyStore = store
+}
+// newIdempotencyTtl creates an operation's response retention duration out of its @Idempotent annotation's 'ttl' property
+func newIdempotencyTtl(ttl string) time.Duration {
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid idempotency TTL '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			ttl,
+		))
+	}
+	return duration
+}
+// idempotentResponseRecorder records the status and body written to the response so they may be stored once the operation completes
+type idempotentResponseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+func (recorder *idempotentResponseRecorder) WriteHeader(status int) {
+	if recorder.status == 0 {
+		recorder.status = status
+	}
+	recorder.ResponseWriter.WriteHeader(status)
+}
+func (recorder *idempotentResponseRecorder) Write(data []byte) (int, error) {
+	if recorder.status == 0 {
+		recorder.status = http.StatusOK
+	}
+	recorder.body.Write(data)
+	return recorder.ResponseWriter.Write(data)
+}
+// The maximum size, in bytes, of the request bodies hashed by idempotent operations without a @MaxBodySize limit
+const defaultMaxIdempotentBodySize = 10 << 20
+// hashRequestBody returns the hex encoded SHA-256 hash of the request's body. The body remains readable.
+//
+// Requests whose body exceeds the given maximum size, or defaultMaxIdempotentBodySize if zero,
+// are answered with a 413 RFC7807 error, in which case false is returned
+func hashRequestBody(w http.ResponseWriter, req *http.Request, operationId string, maxBodySize int64) (string, bool) {
+	if maxBodySize <= 0 {
+		maxBodySize = defaultMaxIdempotentBodySize
+	}
+	body, _ := io.ReadAll(io.LimitReader(req.Body, maxBodySize+1))
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	if int64(len(body)) > maxBodySize {
+		writeIdempotencyError(w, operationId, http.StatusRequestEntityTooLarge, fmt.Sprintf("The request body of operation '%s' exceeds the maximum size of %d bytes", operationId, maxBodySize))
+		return "", false
+	}
+	hash := sha256.Sum256(body)
+	return hex.EncodeToString(hash[:]), true
+}
+// beginIdempotentRequest claims the request's 'Idempotency-Key' header, scoped to the given operation and the request's principal.
+//
+// If the operation may proceed, returns the writer through which it should respond along with a function storing its response,
+// which must be deferred. Otherwise, the request is answered - with the stored response if the key has been used before,
+// or with an RFC7807 error if the header is missing (400), the original request is still in flight (409),
+// the principal lacks a stable identity (500)
+// or the key has been used by a request with a different body (422) - and a nil function is returned
+func beginIdempotentRequest(w http.ResponseWriter, req *http.Request, operationId string, requestHash string, ttl time.Duration) (http.ResponseWriter, func()) {
+	idempotencyKey := req.Header.Get("Idempotency-Key")
+	if idempotencyKey == "" {
+		writeIdempotencyError(
+			w,
+			operationId,
+			http.StatusBadRequest,
+			fmt.Sprintf("Operation '%s' requires an 'Idempotency-Key' header", operationId),
+		)
+		return w, nil
+	}
+	ctx := getRequestContext(req)
+	// Keys are scoped by principal so that they may not collide across clients. Principals lacking a stable identity are
+	// rejected, as their requests could otherwise never be recognized as repeated
+	principalIdentity, isIdentifiable := describePrincipal(ctx)
+	if !isIdentifiable {
+		writeIdempotencyError(
+			w,
+			operationId,
+			http.StatusInternalServerError,
+			fmt.Sprintf("The principal of operation '%s' lacks a stable identity to scope its 'Idempotency-Key' by", operationId),
+		)
+		return w, nil
+	}
+	key := operationId + "\x00" + principalIdentity + "\x00" + idempotencyKey
+	reserved, response, err := idempotencyStore.Reserve(ctx, key, requestHash, ttl)
+	switch {
+	case errors.Is(err, ErrIdempotencyKeyReused):
+		writeIdempotencyError(
+			w,
+			operationId,
+			http.StatusUnprocessableEntity,
+			fmt.Sprintf("The 'Idempotency-Key' has already been used by a request to operation '%s' with a different body", operationId),
+		)
+		return w, nil
+	case err != nil:
+		writeIdempotencyError(
+			w,
+			operationId,
+			http.StatusInternalServerError,
+			fmt.Sprintf("Failed to process the 'Idempotency-Key' header of operation '%s'", operationId),
+		)
+		return w, nil
+	case !reserved && response == nil:
+		writeIdempotencyError(
+			w,
+			operationId,
+			http.StatusConflict,
+			fmt.Sprintf("A request to operation '%s' with the same 'Idempotency-Key' is still being processed", operationId),
+		)
+		return w, nil
+	case !reserved:
+		for name, values := range response.Headers {
+			w.Header()[name] = values
+		}
+		w.Header().Set("Idempotent-Replayed", "true")
+		w.WriteHeader(response.Status)
+		w.Write(response.Body)
+		return w, nil
+	}
+	recorder := &idempotentResponseRecorder{ResponseWriter: w}
+	return recorder, func() {
+		// Requests that panicked or failed on the server's side are not replayed so they may be retried
+		recovered := recover()
+		if recovered != nil || recorder.status == 0 || recorder.status >= http.StatusInternalServerError {
+			idempotencyStore.Release(context.WithoutCancel(ctx), key)
+			if recovered != nil {
+				panic(recovered)
+			}
+			return
+		}
+		idempotencyStore.Complete(
+			context.WithoutCancel(ctx),
+			key,
+			requestHash,
+			IdempotentResponse{Status: recorder.status, Headers: recorder.Header().Clone(), Body: recorder.body.Bytes()},
+			ttl,
+		)
+	}
+}
+// writeIdempotencyError answers a request to an idempotent operation with an RFC7807 error of the given status
+func writeIdempotencyError(w http.ResponseWriter, operationId string, status int, detail string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(runtime.Rfc7807Error{
+		Type:     http.StatusText(status),
+		Detail:   detail,
+		Status:   status,
+		Instance: "/idempotency/error/" + operationId,
+	})
+}
 type MiddlewareFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool)
 type ErrorMiddlewareFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) (context.Context, bool)
 var beforeOperationMiddlewares []MiddlewareFunc
@@ -296,7425 +1294,51 @@ func RegisterErrorMiddleware(executionType runtime.ErrorMiddlewareExecutionType,
 		onErrorMiddlewares = append(onErrorMiddlewares, errorMiddlewareFunc)
 	}
 }
+var namedMiddlewares = map[string]MiddlewareFunc{}
+// RegisterNamedMiddleware registers a middleware that controllers and routes may reference by name using @Middleware annotations.
+//
+// Named middlewares must be registered before RegisterRoutes is called
+func RegisterNamedMiddleware(name string, middlewareFunc MiddlewareFunc) {
+	namedMiddlewares[name] = middlewareFunc
+}
+func ensureNamedMiddlewaresRegistered(names []string) {
+	missing := []string{}
+	for _, name := range names {
+		if _, registered := namedMiddlewares[name]; !registered {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		panic(fmt.Sprintf(
+			"middlewares referenced via @Middleware annotations were never registered using RegisterNamedMiddleware: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
 func RegisterCustomValidator(validateTagName string, validateFunc runtime.ValidationFunc) {
 	validatorInstance.RegisterValidation(validateTagName, func(fl validator.FieldLevel) bool {
 		return validateFunc(fl)
 	})
 }
+// BasePath is the path under which RegisterRoutes mounts the API's routes
+const BasePath = ""
+// RegisterRoutes registers the API's routes on the given engine, under BasePath
 func RegisterRoutes(engine *chi.Mux) {
+	RegisterGroupRoutes(engine, BasePath)
+}
+// RegisterGroupRoutes registers the API's routes on the given router, e.g. a sub-router created via Route or Group, under the given base path.
+//
+// The base path is relative to the router's own prefix.
+// For the OpenAPI specification to match the served routes, the two should add up to BasePath
+func RegisterGroupRoutes(engine chi.Router, basePath string) {
 	urlParamRegex = regexp.MustCompile(`\{([\w\d-_]+)\}`)
+	versionedRouteHandlers = map[string]map[int]http.HandlerFunc{}
+	ensureNamedMiddlewaresRegistered([]string{"audit", "trace"})
 	// register routes extension placeholder
 	// E2EClassSecController
-	engine.Get(toChiUrl("/e2e/with-default-class-security"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName",
-							Scopes: []string{
-								"class",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "WithDefaultClassSecurity")
-			return
-		}
-		controller := E2EClassSecController.E2EClassSecController{}
-		controller.InitController(req)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := req.Header.Get("x-test-scopes")
-		_, isheaderParamExists := req.Header["x-test-scopes"]
-		if !isheaderParamExists {
-			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("x-test-scopes")
-			isheaderParamExists = len(headerValues) > 0
-		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.WithDefaultClassSecurity(*headerParamRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithDefaultClassSecurity'",
-				Status:     statusCode,
-				Instance:   "/controller/error/WithDefaultClassSecurity",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/with-default-override-class-security"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName",
-							Scopes: []string{
-								"method",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "WithOverrideClassSecurity")
-			return
-		}
-		controller := E2EClassSecController.E2EClassSecController{}
-		controller.InitController(req)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := req.Header.Get("x-test-scopes")
-		_, isheaderParamExists := req.Header["x-test-scopes"]
-		if !isheaderParamExists {
-			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("x-test-scopes")
-			isheaderParamExists = len(headerValues) > 0
-		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.WithOverrideClassSecurity(*headerParamRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithOverrideClassSecurity'",
-				Status:     statusCode,
-				Instance:   "/controller/error/WithOverrideClassSecurity",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
+	registerE2EClassSecControllerRoutes(engine, basePath)
 	// E2EController
-	engine.Get(toChiUrl("/e2e/simple-get"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "SimpleGet")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.SimpleGet()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGet'",
-				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGet",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/simple-get-empty-string"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "SimpleGetEmptyString")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.SimpleGetEmptyString()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetEmptyString'",
-				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetEmptyString",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/simple-get-ptr-string"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "SimpleGetPtrString")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.SimpleGetPtrString()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetPtrString'",
-				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetPtrString",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/simple-get-null-string"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "SimpleGetNullString")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.SimpleGetNullString()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetNullString'",
-				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetNullString",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/simple-get-object"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "SimpleGetObject")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.SimpleGetObject()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObject'",
-				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetObject",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/simple-get-object-ptr"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "SimpleGetObjectPtr")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.SimpleGetObjectPtr()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObjectPtr'",
-				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetObjectPtr",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/simple-get-object-null"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "SimpleGetObjectNull")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.SimpleGetObjectNull()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObjectNull'",
-				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetObjectNull",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/primitive-return-type"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "PrimitiveReturnType")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.PrimitiveReturnType()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'PrimitiveReturnType'",
-				Status:     statusCode,
-				Instance:   "/controller/error/PrimitiveReturnType",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/primitive-array-return-type"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "PrimitiveArrayReturnType")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.PrimitiveArrayReturnType()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'PrimitiveArrayReturnType'",
-				Status:     statusCode,
-				Instance:   "/controller/error/PrimitiveArrayReturnType",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/primitive-alias-return-type"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "PrimitiveAliasReturnType")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.PrimitiveAliasReturnType()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'PrimitiveAliasReturnType'",
-				Status:     statusCode,
-				Instance:   "/controller/error/PrimitiveAliasReturnType",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/primitive-alias-array-return-type"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "PrimitiveAliasArrayReturnType")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.PrimitiveAliasArrayReturnType()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'PrimitiveAliasArrayReturnType'",
-				Status:     statusCode,
-				Instance:   "/controller/error/PrimitiveAliasArrayReturnType",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/simple-get-empty"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "SimpleGetEmpty")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var queryParamRawPtr *string = nil
-		queryParamRaw := req.URL.Query().Get("queryParam")
-		isqueryParamExists := req.URL.Query().Has("queryParam")
-		if isqueryParamExists {
-			queryParam := queryParamRaw
-			queryParamRawPtr = &queryParam
-		}
-		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		opError := controller.SimpleGetEmpty(*queryParamRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetEmpty'",
-				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetEmpty",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.WriteHeader(statusCode)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/get-with-all-params/{pathParam}"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "GetWithAllParams")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var queryParamRawPtr *string = nil
-		queryParamRaw := req.URL.Query().Get("queryParam")
-		isqueryParamExists := req.URL.Query().Has("queryParam")
-		if isqueryParamExists {
-			queryParam := queryParamRaw
-			queryParamRawPtr = &queryParam
-		}
-		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var pathParamRawPtr *string = nil
-		pathParamRaw := chi.URLParam(req, "pathParam")
-		ispathParamExists := true // if parameter is in route but not provided, it won't reach this handler
-		if ispathParamExists {
-			pathParam := pathParamRaw
-			pathParamRawPtr = &pathParam
-		}
-		if validatorErr := validatorInstance.Var(pathParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var headerParamRawPtr *string = nil
-		headerParamRaw := req.Header.Get("headerParam")
-		_, isheaderParamExists := req.Header["headerParam"]
-		if !isheaderParamExists {
-			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("headerParam")
-			isheaderParamExists = len(headerValues) > 0
-		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.GetWithAllParams(*queryParamRawPtr, *pathParamRawPtr, *headerParamRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'GetWithAllParams'",
-				Status:     statusCode,
-				Instance:   "/controller/error/GetWithAllParams",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/get-with-all-params-ptr/{pathParam}"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "GetWithAllParamsPtr")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var queryParamRawPtr *string = nil
-		queryParamRaw := req.URL.Query().Get("queryParam")
-		isqueryParamExists := req.URL.Query().Has("queryParam")
-		if isqueryParamExists {
-			queryParam := queryParamRaw
-			queryParamRawPtr = &queryParam
-		}
-		var pathParamRawPtr *string = nil
-		pathParamRaw := chi.URLParam(req, "pathParam")
-		ispathParamExists := true // if parameter is in route but not provided, it won't reach this handler
-		if ispathParamExists {
-			pathParam := pathParamRaw
-			pathParamRawPtr = &pathParam
-		}
-		if validatorErr := validatorInstance.Var(pathParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var headerParamRawPtr *string = nil
-		headerParamRaw := req.Header.Get("headerParam")
-		_, isheaderParamExists := req.Header["headerParam"]
-		if !isheaderParamExists {
-			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("headerParam")
-			isheaderParamExists = len(headerValues) > 0
-		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.GetWithAllParamsPtr(queryParamRawPtr, pathParamRawPtr, headerParamRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'GetWithAllParamsPtr'",
-				Status:     statusCode,
-				Instance:   "/controller/error/GetWithAllParamsPtr",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/get-with-all-params-required-ptr/{pathParam}"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "GetWithAllParamsRequiredPtr")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var queryParamRawPtr *string = nil
-		queryParamRaw := req.URL.Query().Get("queryParam")
-		isqueryParamExists := req.URL.Query().Has("queryParam")
-		if isqueryParamExists {
-			queryParam := queryParamRaw
-			queryParamRawPtr = &queryParam
-		}
-		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var pathParamRawPtr *string = nil
-		pathParamRaw := chi.URLParam(req, "pathParam")
-		ispathParamExists := true // if parameter is in route but not provided, it won't reach this handler
-		if ispathParamExists {
-			pathParam := pathParamRaw
-			pathParamRawPtr = &pathParam
-		}
-		if validatorErr := validatorInstance.Var(pathParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var headerParamRawPtr *string = nil
-		headerParamRaw := req.Header.Get("headerParam")
-		_, isheaderParamExists := req.Header["headerParam"]
-		if !isheaderParamExists {
-			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("headerParam")
-			isheaderParamExists = len(headerValues) > 0
-		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.GetWithAllParamsRequiredPtr(queryParamRawPtr, pathParamRawPtr, headerParamRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'GetWithAllParamsRequiredPtr'",
-				Status:     statusCode,
-				Instance:   "/controller/error/GetWithAllParamsRequiredPtr",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/post-with-all-params-body"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBody")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var conversionErr error
-		var queryParamRawPtr *string = nil
-		queryParamRaw := req.URL.Query().Get("queryParam")
-		isqueryParamExists := req.URL.Query().Has("queryParam")
-		if isqueryParamExists {
-			queryParam := queryParamRaw
-			queryParamRawPtr = &queryParam
-		}
-		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var headerParamRawPtr *string = nil
-		headerParamRaw := req.Header.Get("headerParam")
-		_, isheaderParamExists := req.Header["headerParam"]
-		if !isheaderParamExists {
-			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("headerParam")
-			isheaderParamExists = len(headerValues) > 0
-		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var theBodyRawPtr *Param5theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "required", &theBodyRawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBody' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/PostWithAllParamsWithBody",
-			}
-			// json body validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.PostWithAllParamsWithBody(*queryParamRawPtr, *headerParamRawPtr, *theBodyRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBody'",
-				Status:     statusCode,
-				Instance:   "/controller/error/PostWithAllParamsWithBody",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/post-with-all-params-body-ptr"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBodyPtr")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var conversionErr error
-		var queryParamRawPtr *string = nil
-		queryParamRaw := req.URL.Query().Get("queryParam")
-		isqueryParamExists := req.URL.Query().Has("queryParam")
-		if isqueryParamExists {
-			queryParam := queryParamRaw
-			queryParamRawPtr = &queryParam
-		}
-		var headerParamRawPtr *string = nil
-		headerParamRaw := req.Header.Get("headerParam")
-		_, isheaderParamExists := req.Header["headerParam"]
-		if !isheaderParamExists {
-			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("headerParam")
-			isheaderParamExists = len(headerValues) > 0
-		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		var theBodyRawPtr *Param5theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "", &theBodyRawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBodyPtr' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/PostWithAllParamsWithBodyPtr",
-			}
-			// json body validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.PostWithAllParamsWithBodyPtr(queryParamRawPtr, headerParamRawPtr, theBodyRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyPtr'",
-				Status:     statusCode,
-				Instance:   "/controller/error/PostWithAllParamsWithBodyPtr",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/post-with-all-params-body-required-ptr"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBodyRequiredPtr")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var conversionErr error
-		var theBodyRawPtr *Param5theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "required", &theBodyRawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBodyRequiredPtr' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/PostWithAllParamsWithBodyRequiredPtr",
-			}
-			// json body validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.PostWithAllParamsWithBodyRequiredPtr(theBodyRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyRequiredPtr'",
-				Status:     statusCode,
-				Instance:   "/controller/error/PostWithAllParamsWithBodyRequiredPtr",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/get-header-start-with-letter"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "GetHeaderStartWithLetter")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := req.Header.Get("headerParam")
-		_, isheaderParamExists := req.Header["headerParam"]
-		if !isheaderParamExists {
-			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("headerParam")
-			isheaderParamExists = len(headerValues) > 0
-		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required,validate_starts_with_letter"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.GetHeaderStartWithLetter(*headerParamRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'GetHeaderStartWithLetter'",
-				Status:     statusCode,
-				Instance:   "/controller/error/GetHeaderStartWithLetter",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/with-default-config-security"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "WithDefaultConfigSecurity")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := req.Header.Get("x-test-scopes")
-		_, isheaderParamExists := req.Header["x-test-scopes"]
-		if !isheaderParamExists {
-			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("x-test-scopes")
-			isheaderParamExists = len(headerValues) > 0
-		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.WithDefaultConfigSecurity(*headerParamRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithDefaultConfigSecurity'",
-				Status:     statusCode,
-				Instance:   "/controller/error/WithDefaultConfigSecurity",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/with-one-security"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName",
-							Scopes: []string{
-								"other",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "WithOneSecurity")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := req.Header.Get("x-test-scopes")
-		_, isheaderParamExists := req.Header["x-test-scopes"]
-		if !isheaderParamExists {
-			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("x-test-scopes")
-			isheaderParamExists = len(headerValues) > 0
-		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.WithOneSecurity(*headerParamRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithOneSecurity'",
-				Status:     statusCode,
-				Instance:   "/controller/error/WithOneSecurity",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/with-two-security"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName",
-							Scopes: []string{
-								"other",
-							},
-						},
-					},
-				},
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"write",
-								"read",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "WithTwoSecurity")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := req.Header.Get("x-test-scopes")
-		_, isheaderParamExists := req.Header["x-test-scopes"]
-		if !isheaderParamExists {
-			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("x-test-scopes")
-			isheaderParamExists = len(headerValues) > 0
-		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.WithTwoSecurity(*headerParamRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithTwoSecurity'",
-				Status:     statusCode,
-				Instance:   "/controller/error/WithTwoSecurity",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/with-two-security-same-method"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName",
-							Scopes: []string{
-								"other",
-							},
-						},
-					},
-				},
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName",
-							Scopes: []string{
-								"write",
-								"read",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "WithTwoSecuritySameMethod")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := req.Header.Get("x-test-scopes")
-		_, isheaderParamExists := req.Header["x-test-scopes"]
-		if !isheaderParamExists {
-			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("x-test-scopes")
-			isheaderParamExists = len(headerValues) > 0
-		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
-				Status:     statusCode,
-				Instance:   "/controller/error/WithTwoSecuritySameMethod",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/default-error"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "DefaultError")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		opError := controller.DefaultError()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DefaultError'",
-				Status:     statusCode,
-				Instance:   "/controller/error/DefaultError",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.WriteHeader(statusCode)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/default-error-with-payload"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "DefaultErrorWithPayload")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.DefaultErrorWithPayload()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
-				Status:     statusCode,
-				Instance:   "/controller/error/DefaultErrorWithPayload",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/custom-error"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "CustomError")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		opError := controller.CustomError()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		emptyErr := Response6CustomError.CustomError{}
-		if opError != emptyErr {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(opError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.WriteHeader(statusCode)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/custom-error-ptr"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "CustomPtrError")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		opError := controller.CustomPtrError()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(opError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.WriteHeader(statusCode)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/503-error-code"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "Error503")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		opError := controller.Error503()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'Error503'",
-				Status:     statusCode,
-				Instance:   "/controller/error/Error503",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.WriteHeader(statusCode)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/custom-error-503"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "CustomError503")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		opError := controller.CustomError503()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		emptyErr := Response6CustomError.CustomError{}
-		if opError != emptyErr {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(opError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.WriteHeader(statusCode)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/context-access"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "ContextAccess")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		opError := controller.ContextAccess()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'ContextAccess'",
-				Status:     statusCode,
-				Instance:   "/controller/error/ContextAccess",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.WriteHeader(statusCode)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/http-method"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "Get")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		opError := controller.Get()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'Get'",
-				Status:     statusCode,
-				Instance:   "/controller/error/Get",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.WriteHeader(statusCode)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/http-method"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "Post")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		opError := controller.Post()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'Post'",
-				Status:     statusCode,
-				Instance:   "/controller/error/Post",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.WriteHeader(statusCode)
-		// route end routes extension placeholder
-	})
-	engine.Put(toChiUrl("/e2e/http-method"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "Put")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		opError := controller.Put()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'Put'",
-				Status:     statusCode,
-				Instance:   "/controller/error/Put",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.WriteHeader(statusCode)
-		// route end routes extension placeholder
-	})
-	engine.Delete(toChiUrl("/e2e/http-method"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "Delete")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		opError := controller.Delete()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'Delete'",
-				Status:     statusCode,
-				Instance:   "/controller/error/Delete",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.WriteHeader(statusCode)
-		// route end routes extension placeholder
-	})
-	engine.Patch(toChiUrl("/e2e/http-method"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "Patch")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		opError := controller.Patch()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'Patch'",
-				Status:     statusCode,
-				Instance:   "/controller/error/Patch",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.WriteHeader(statusCode)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/template-context-1"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "TemplateContext1")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.TemplateContext1()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'TemplateContext1'",
-				Status:     statusCode,
-				Instance:   "/controller/error/TemplateContext1",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Get(toChiUrl("/e2e/template-context-2"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "TemplateContext2")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.TemplateContext2()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'TemplateContext2'",
-				Status:     statusCode,
-				Instance:   "/controller/error/TemplateContext2",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/form"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "TestForm")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		req.ParseForm()
-		var item1RawPtr *string = nil
-		item1RawArr, isitem1Exists := req.PostForm["item1"]
-		item1Raw := ""
-		if isitem1Exists {
-			item1Raw = item1RawArr[0] // Get first value since form values are slices
-		}
-		if isitem1Exists {
-			item1 := item1Raw
-			item1RawPtr = &item1
-		}
-		if validatorErr := validatorInstance.Var(item1RawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "item1"
-			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		req.ParseForm()
-		var item2RawPtr *string = nil
-		item2RawArr, isitem2Exists := req.PostForm["item2"]
-		item2Raw := ""
-		if isitem2Exists {
-			item2Raw = item2RawArr[0] // Get first value since form values are slices
-		}
-		if isitem2Exists {
-			item2 := item2Raw
-			item2RawPtr = &item2
-		}
-		if validatorErr := validatorInstance.Var(item2RawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "item2"
-			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.TestForm(*item1RawPtr, *item2RawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'TestForm'",
-				Status:     statusCode,
-				Instance:   "/controller/error/TestForm",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/form-extra"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "TestFormExtra")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		req.ParseForm()
-		var item1RawPtr *int64 = nil
-		item1RawArr, isitem1Exists := req.PostForm["item1"]
-		item1Raw := ""
-		if isitem1Exists {
-			item1Raw = item1RawArr[0] // Get first value since form values are slices
-		}
-		if isitem1Exists {
-			item1Uint64, conversionErr := strconv.ParseInt(item1Raw, 10, 64)
-			if conversionErr != nil {
-				// Middlewares onInputValidationMiddlewares section
-				for _, middleware := range onInputValidationMiddlewares {
-					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-					setRequestContext(req, middlewareCtx)
-					if !continueOperation {
-						return
-					}
-				}
-				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestFormExtra' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"item1",
-						"int64",
-						reflect.TypeOf(item1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestFormExtra",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
-				// params validation error response extension placeholder
-				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
-				return
-			}
-			item1 := int64(item1Uint64)
-			item1RawPtr = &item1
-		}
-		if validatorErr := validatorInstance.Var(item1RawPtr, "required,gte=80"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "item1"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		req.ParseForm()
-		var item2RawPtr *string = nil
-		item2RawArr, isitem2Exists := req.PostForm["item2"]
-		item2Raw := ""
-		if isitem2Exists {
-			item2Raw = item2RawArr[0] // Get first value since form values are slices
-		}
-		if isitem2Exists {
-			item2 := item2Raw
-			item2RawPtr = &item2
-		}
-		if validatorErr := validatorInstance.Var(item2RawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "item2"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var item3RawPtr *int64 = nil
-		item3Raw := req.URL.Query().Get("item3")
-		isitem3Exists := req.URL.Query().Has("item3")
-		if isitem3Exists {
-			item3Uint64, conversionErr := strconv.ParseInt(item3Raw, 10, 64)
-			if conversionErr != nil {
-				// Middlewares onInputValidationMiddlewares section
-				for _, middleware := range onInputValidationMiddlewares {
-					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-					setRequestContext(req, middlewareCtx)
-					if !continueOperation {
-						return
-					}
-				}
-				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestFormExtra' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"item3",
-						"int64",
-						reflect.TypeOf(item3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestFormExtra",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
-				// params validation error response extension placeholder
-				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
-				return
-			}
-			item3 := int64(item3Uint64)
-			item3RawPtr = &item3
-		}
-		if validatorErr := validatorInstance.Var(item3RawPtr, "required,gte=80"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "item3"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.TestFormExtra(*item1RawPtr, *item2RawPtr, *item3RawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'TestFormExtra'",
-				Status:     statusCode,
-				Instance:   "/controller/error/TestFormExtra",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/test-response-validation"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "TestResponseValidation")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.TestResponseValidation()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'TestResponseValidation'",
-				Status:     statusCode,
-				Instance:   "/controller/error/TestResponseValidation",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/test-response-validation-ptr"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "TestResponseValidationPtr")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.TestResponseValidationPtr()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'TestResponseValidationPtr'",
-				Status:     statusCode,
-				Instance:   "/controller/error/TestResponseValidationPtr",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/test-response-validation-null"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "TestResponseValidationNull")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.TestResponseValidationNull()
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'TestResponseValidationNull'",
-				Status:     statusCode,
-				Instance:   "/controller/error/TestResponseValidationNull",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/test-primitive-conversions"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "TestPrimitiveConversions")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var value1RawPtr *int64 = nil
-		value1Raw := req.URL.Query().Get("value1")
-		isvalue1Exists := req.URL.Query().Has("value1")
-		if isvalue1Exists {
-			value1Uint64, conversionErr := strconv.ParseInt(value1Raw, 10, 64)
-			if conversionErr != nil {
-				// Middlewares onInputValidationMiddlewares section
-				for _, middleware := range onInputValidationMiddlewares {
-					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-					setRequestContext(req, middlewareCtx)
-					if !continueOperation {
-						return
-					}
-				}
-				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"int64",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
-				// params validation error response extension placeholder
-				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
-				return
-			}
-			value1 := int64(value1Uint64)
-			value1RawPtr = &value1
-		}
-		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var value2RawPtr *bool = nil
-		value2Raw := req.URL.Query().Get("value2")
-		isvalue2Exists := req.URL.Query().Has("value2")
-		if isvalue2Exists {
-			value2Bool, conversionErr := strconv.ParseBool(value2Raw)
-			if conversionErr != nil {
-				// Middlewares onInputValidationMiddlewares section
-				for _, middleware := range onInputValidationMiddlewares {
-					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-					setRequestContext(req, middlewareCtx)
-					if !continueOperation {
-						return
-					}
-				}
-				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"bool",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
-				// params validation error response extension placeholder
-				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
-				return
-			}
-			value2 := value2Bool
-			value2RawPtr = &value2
-		}
-		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var value3RawPtr *int = nil
-		value3Raw := req.URL.Query().Get("value3")
-		isvalue3Exists := req.URL.Query().Has("value3")
-		if isvalue3Exists {
-			value3Uint64, conversionErr := strconv.Atoi(value3Raw)
-			if conversionErr != nil {
-				// Middlewares onInputValidationMiddlewares section
-				for _, middleware := range onInputValidationMiddlewares {
-					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-					setRequestContext(req, middlewareCtx)
-					if !continueOperation {
-						return
-					}
-				}
-				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value3",
-						"int",
-						reflect.TypeOf(value3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
-				// params validation error response extension placeholder
-				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
-				return
-			}
-			value3 := int(value3Uint64)
-			value3RawPtr = &value3
-		}
-		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "value3"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var value4RawPtr *float64 = nil
-		value4Raw := req.URL.Query().Get("value4")
-		isvalue4Exists := req.URL.Query().Has("value4")
-		if isvalue4Exists {
-			value4Float64, conversionErr := strconv.ParseFloat(value4Raw, 64)
-			if conversionErr != nil {
-				// Middlewares onInputValidationMiddlewares section
-				for _, middleware := range onInputValidationMiddlewares {
-					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-					setRequestContext(req, middlewareCtx)
-					if !continueOperation {
-						return
-					}
-				}
-				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value4",
-						"float64",
-						reflect.TypeOf(value4Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
-				// params validation error response extension placeholder
-				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
-				return
-			}
-			value4 := float64(value4Float64)
-			value4RawPtr = &value4
-		}
-		if validatorErr := validatorInstance.Var(value4RawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "value4"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.TestPrimitiveConversions(*value1RawPtr, *value2RawPtr, *value3RawPtr, *value4RawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'TestPrimitiveConversions'",
-				Status:     statusCode,
-				Instance:   "/controller/error/TestPrimitiveConversions",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/test-enums"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "TestEnums")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var conversionErr error
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
-		value1Raw := req.URL.Query().Get("value1")
-		isvalue1Exists := req.URL.Query().Has("value1")
-		if isvalue1Exists {
-			value1 := value1Raw
-			value1Var := Param12value1.StatusEnumeration(value1)
-			value1RawPtr = &value1Var
-		}
-		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var value2RawPtr *Param4value2.NumberEnumeration = nil
-		value2Raw := req.URL.Query().Get("value2")
-		isvalue2Exists := req.URL.Query().Has("value2")
-		if isvalue2Exists {
-			value2Uint64, conversionErr := strconv.Atoi(value2Raw)
-			if conversionErr != nil {
-				// Middlewares onInputValidationMiddlewares section
-				for _, middleware := range onInputValidationMiddlewares {
-					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-					setRequestContext(req, middlewareCtx)
-					if !continueOperation {
-						return
-					}
-				}
-				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnums' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnums",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
-				// params validation error response extension placeholder
-				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
-				return
-			}
-			value2 := int(value2Uint64)
-			value2Var := Param4value2.NumberEnumeration(value2)
-			value2RawPtr = &value2Var
-		}
-		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var value3RawPtr *Param11value3.ObjectWithEnum = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "required", &value3RawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'TestEnums' but body parameter '%s' did not pass validation of '%s' - %s",
-					"value3",
-					"ObjectWithEnum",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/TestEnums",
-			}
-			// json body validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.TestEnums(*value1RawPtr, *value2RawPtr, *value3RawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'TestEnums'",
-				Status:     statusCode,
-				Instance:   "/controller/error/TestEnums",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/test-enums-in-all/{value1}"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "TestEnumsInAll")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
-		value1Raw := chi.URLParam(req, "value1")
-		isvalue1Exists := true // if parameter is in route but not provided, it won't reach this handler
-		if isvalue1Exists {
-			value1 := value1Raw
-			value1Var := Param12value1.StatusEnumeration(value1)
-			value1RawPtr = &value1Var
-		}
-		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var value2RawPtr *Param4value2.NumberEnumeration = nil
-		value2Raw := req.Header.Get("value2")
-		_, isvalue2Exists := req.Header["value2"]
-		if !isvalue2Exists {
-			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("value2")
-			isvalue2Exists = len(headerValues) > 0
-		}
-		if isvalue2Exists {
-			value2Uint64, conversionErr := strconv.Atoi(value2Raw)
-			if conversionErr != nil {
-				// Middlewares onInputValidationMiddlewares section
-				for _, middleware := range onInputValidationMiddlewares {
-					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-					setRequestContext(req, middlewareCtx)
-					if !continueOperation {
-						return
-					}
-				}
-				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
-				// params validation error response extension placeholder
-				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
-				return
-			}
-			value2 := int(value2Uint64)
-			value2Var := Param4value2.NumberEnumeration(value2)
-			value2RawPtr = &value2Var
-		}
-		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		req.ParseForm()
-		var value3RawPtr *Param12value3.StatusEnumeration = nil
-		value3RawArr, isvalue3Exists := req.PostForm["value3"]
-		value3Raw := ""
-		if isvalue3Exists {
-			value3Raw = value3RawArr[0] // Get first value since form values are slices
-		}
-		if isvalue3Exists {
-			value3 := value3Raw
-			value3Var := Param12value3.StatusEnumeration(value3)
-			value3RawPtr = &value3Var
-		}
-		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "value3"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.TestEnumsInAll(*value1RawPtr, *value2RawPtr, *value3RawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'TestEnumsInAll'",
-				Status:     statusCode,
-				Instance:   "/controller/error/TestEnumsInAll",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/test-enums-optional"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "TestEnumsOptional")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
-		value1Raw := req.Header.Get("value1")
-		_, isvalue1Exists := req.Header["value1"]
-		if !isvalue1Exists {
-			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("value1")
-			isvalue1Exists = len(headerValues) > 0
-		}
-		if isvalue1Exists {
-			value1 := value1Raw
-			value1Var := Param12value1.StatusEnumeration(value1)
-			value1RawPtr = &value1Var
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.TestEnumsOptional(value1RawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'TestEnumsOptional'",
-				Status:     statusCode,
-				Instance:   "/controller/error/TestEnumsOptional",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/external-packages"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "ExternalPackages")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var conversionErr error
-		var unitRawPtr *Param14unit.LengthUnits = nil
-		unitRaw := req.URL.Query().Get("unit")
-		isunitExists := req.URL.Query().Has("unit")
-		if isunitExists {
-			unit := unitRaw
-			unitVar := Param14unit.LengthUnits(unit)
-			unitRawPtr = &unitVar
-		}
-		var dataRawPtr *Param13data.LengthDto = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackages' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"LengthDto",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/ExternalPackages",
-			}
-			// json body validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.ExternalPackages(unitRawPtr, *dataRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'ExternalPackages'",
-				Status:     statusCode,
-				Instance:   "/controller/error/ExternalPackages",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/external-packages-unique-in-struct"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "ExternalPackagesUniqueInStruct")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var conversionErr error
-		var dataRawPtr *Param15data.UniqueExternalUsage = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackagesUniqueInStruct' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"UniqueExternalUsage",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/ExternalPackagesUniqueInStruct",
-			}
-			// json body validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.ExternalPackagesUniqueInStruct(*dataRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'ExternalPackagesUniqueInStruct'",
-				Status:     statusCode,
-				Instance:   "/controller/error/ExternalPackagesUniqueInStruct",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/external-packages-validation"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "ExternalPackagesValidation")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var conversionErr error
-		var unitRawPtr *Param14unit.LengthUnits = nil
-		unitRaw := req.URL.Query().Get("unit")
-		isunitExists := req.URL.Query().Has("unit")
-		if isunitExists {
-			unit := unitRaw
-			unitVar := Param14unit.LengthUnits(unit)
-			unitRawPtr = &unitVar
-		}
-		var dataRawPtr *Param16data.LengthDtoWithValidation = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackagesValidation' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"LengthDtoWithValidation",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/ExternalPackagesValidation",
-			}
-			// json body validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.ExternalPackagesValidation(unitRawPtr, *dataRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'ExternalPackagesValidation'",
-				Status:     statusCode,
-				Instance:   "/controller/error/ExternalPackagesValidation",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/arrays-in-body-and-res"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "ArraysInBodyAndRes")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var conversionErr error
-		var dataRawPtr *[]Param13data.LengthDto = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ArraysInBodyAndRes' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[]LengthDto",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/ArraysInBodyAndRes",
-			}
-			// json body validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.ArraysInBodyAndRes(*dataRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'ArraysInBodyAndRes'",
-				Status:     statusCode,
-				Instance:   "/controller/error/ArraysInBodyAndRes",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/arrays-inside-body-and-res"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "ArraysInsideBodyAndRes")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var conversionErr error
-		var dataRawPtr *[]Param17data.BlaBla = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "", &dataRawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ArraysInsideBodyAndRes' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[]BlaBla",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/ArraysInsideBodyAndRes",
-			}
-			// json body validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.ArraysInsideBodyAndRes(dataRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'ArraysInsideBodyAndRes'",
-				Status:     statusCode,
-				Instance:   "/controller/error/ArraysInsideBodyAndRes",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/deep-arrays-with-validation"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "DeepArraysWithValidation")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var conversionErr error
-		var dataRawPtr *[][]Param18data.BlaBla2 = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'DeepArraysWithValidation' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[][]BlaBla2",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/DeepArraysWithValidation",
-			}
-			// json body validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.DeepArraysWithValidation(*dataRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DeepArraysWithValidation'",
-				Status:     statusCode,
-				Instance:   "/controller/error/DeepArraysWithValidation",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/embedded-structs"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "EmbeddedStructs")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var conversionErr error
-		var dataRawPtr *Param19data.TheModel = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'EmbeddedStructs' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModel",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/EmbeddedStructs",
-			}
-			// json body validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.EmbeddedStructs(*dataRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'EmbeddedStructs'",
-				Status:     statusCode,
-				Instance:   "/controller/error/EmbeddedStructs",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/structs-with-inner-pointer"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "StructsWithInnerPointer")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var conversionErr error
-		var dataRawPtr *Param20data.TheModelWithInnerPointer = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'StructsWithInnerPointer' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModelWithInnerPointer",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/StructsWithInnerPointer",
-			}
-			// json body validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.StructsWithInnerPointer(*dataRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'StructsWithInnerPointer'",
-				Status:     statusCode,
-				Instance:   "/controller/error/StructsWithInnerPointer",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/context-injection-empty"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "ContextInjectionEmpty")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		opError := controller.ContextInjectionEmpty(getRequestContext(req))
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'ContextInjectionEmpty'",
-				Status:     statusCode,
-				Instance:   "/controller/error/ContextInjectionEmpty",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.WriteHeader(statusCode)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/context-injection"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "ContextInjection")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var conversionErr error
-		var dataRawPtr *Param19data.TheModel = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ContextInjection' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModel",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/ContextInjection",
-			}
-			// json body validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		opError := controller.ContextInjection(getRequestContext(req), *dataRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'ContextInjection'",
-				Status:     statusCode,
-				Instance:   "/controller/error/ContextInjection",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.WriteHeader(statusCode)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/byte-slice"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "ReturnsStructWithByteSlice")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var conversionErr error
-		var arriveRawPtr *Param22arrive.ObjectWithByteSlice = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "", &arriveRawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ReturnsStructWithByteSlice' but body parameter '%s' did not pass validation of '%s' - %s",
-					"arrive",
-					"ObjectWithByteSlice",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/ReturnsStructWithByteSlice",
-			}
-			// json body validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.ReturnsStructWithByteSlice(arriveRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'ReturnsStructWithByteSlice'",
-				Status:     statusCode,
-				Instance:   "/controller/error/ReturnsStructWithByteSlice",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/special-primitives"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "ReturnsStructWithSpecialPrimitives")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var conversionErr error
-		var arriveRawPtr *Param23arrive.ObjectWithSpecialPrimitives = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "", &arriveRawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ReturnsStructWithSpecialPrimitives' but body parameter '%s' did not pass validation of '%s' - %s",
-					"arrive",
-					"ObjectWithSpecialPrimitives",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/ReturnsStructWithSpecialPrimitives",
-			}
-			// json body validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.ReturnsStructWithSpecialPrimitives(arriveRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'ReturnsStructWithSpecialPrimitives'",
-				Status:     statusCode,
-				Instance:   "/controller/error/ReturnsStructWithSpecialPrimitives",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/alias-of-primitive"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "AliasOfString")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var conversionErr error
-		var objectRawPtr *Param24object.ObjectWithAliasOfString = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "required", &objectRawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'AliasOfString' but body parameter '%s' did not pass validation of '%s' - %s",
-					"object",
-					"ObjectWithAliasOfString",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/AliasOfString",
-			}
-			// json body validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var numRawPtr *Param25num.AliasOfInt = nil
-		numRaw := req.URL.Query().Get("num")
-		isnumExists := req.URL.Query().Has("num")
-		if isnumExists {
-			numUint64, conversionErr := strconv.Atoi(numRaw)
-			if conversionErr != nil {
-				// Middlewares onInputValidationMiddlewares section
-				for _, middleware := range onInputValidationMiddlewares {
-					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-					setRequestContext(req, middlewareCtx)
-					if !continueOperation {
-						return
-					}
-				}
-				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'AliasOfString' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"num",
-						"AliasOfInt",
-						reflect.TypeOf(numRaw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/AliasOfString",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
-				// params validation error response extension placeholder
-				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
-				return
-			}
-			num := int(numUint64)
-			numVar := Param25num.AliasOfInt(num)
-			numRawPtr = &numVar
-		}
-		if validatorErr := validatorInstance.Var(numRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "num"
-			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var strRawPtr *Param26str.AliasOfDirectString = nil
-		strRaw := req.URL.Query().Get("str")
-		isstrExists := req.URL.Query().Has("str")
-		if isstrExists {
-			str := strRaw
-			strVar := Param26str.AliasOfDirectString(str)
-			strRawPtr = &strVar
-		}
-		if validatorErr := validatorInstance.Var(strRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "str"
-			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.AliasOfString(objectRawPtr, *numRawPtr, *strRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'AliasOfString'",
-				Status:     statusCode,
-				Instance:   "/controller/error/AliasOfString",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/body-array-of-string"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "BodyArrayOfString")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var conversionErr error
-		var valuesRawPtr *[]string = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "required", &valuesRawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'BodyArrayOfString' but body parameter '%s' did not pass validation of '%s' - %s",
-					"values",
-					"[]string",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/BodyArrayOfString",
-			}
-			// json body validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.BodyArrayOfString(*valuesRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'BodyArrayOfString'",
-				Status:     statusCode,
-				Instance:   "/controller/error/BodyArrayOfString",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/body-array-of-enum-string"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "BodyArrayOfStringEnum")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var conversionErr error
-		var valuesRawPtr *[]Param27values.Myemamium = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "required", &valuesRawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'BodyArrayOfStringEnum' but body parameter '%s' did not pass validation of '%s' - %s",
-					"values",
-					"[]Myemamium",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/BodyArrayOfStringEnum",
-			}
-			// json body validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.BodyArrayOfStringEnum(*valuesRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'BodyArrayOfStringEnum'",
-				Status:     statusCode,
-				Instance:   "/controller/error/BodyArrayOfStringEnum",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/query-array-of-string"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "QueryArrayOfString")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var valuesRawPtr *[]string = nil
-		valuesRawArray := req.URL.Query()["values"]
-		isvaluesExists := req.URL.Query().Has("values")
-		if isvaluesExists {
-			values := make([]string, 0, len(valuesRawArray))
-			for _, valuesRaw := range valuesRawArray {
-				valuesItem := valuesRaw
-				values = append(values, string(valuesItem))
-			}
-			valuesRawPtr = &values
-			valuesRawPtr = &values
-		}
-		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.QueryArrayOfString(*valuesRawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'QueryArrayOfString'",
-				Status:     statusCode,
-				Instance:   "/controller/error/QueryArrayOfString",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/query-array-of-enum"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "QueryArrayOfEnum")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var valuesRawPtr *[]Param27values.Myemamium = nil
-		valuesRawArray := req.URL.Query()["values"]
-		isvaluesExists := req.URL.Query().Has("values")
-		if isvaluesExists {
-			values := make([]Param27values.Myemamium, 0, len(valuesRawArray))
-			for _, valuesRaw := range valuesRawArray {
-				valuesItem := valuesRaw
-				values = append(values, Param27values.Myemamium(valuesItem))
-			}
-			valuesRawPtr = &values
-			valuesVar := []Param27values.Myemamium(values)
-			valuesRawPtr = &valuesVar
-		}
-		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var values2RawPtr *[]Param28values2.MyaliasString = nil
-		values2RawArray := req.URL.Query()["values2"]
-		isvalues2Exists := req.URL.Query().Has("values2")
-		if isvalues2Exists {
-			values2 := make([]Param28values2.MyaliasString, 0, len(values2RawArray))
-			for _, values2Raw := range values2RawArray {
-				values2Item := values2Raw
-				values2 = append(values2, Param28values2.MyaliasString(values2Item))
-			}
-			values2RawPtr = &values2
-			values2Var := []Param28values2.MyaliasString(values2)
-			values2RawPtr = &values2Var
-		}
-		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.QueryArrayOfEnum(*valuesRawPtr, *values2RawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'QueryArrayOfEnum'",
-				Status:     statusCode,
-				Instance:   "/controller/error/QueryArrayOfEnum",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/query-array-of-others"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "QueryArrayOfOthers")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var valuesRawPtr *[]int = nil
-		valuesRawArray := req.URL.Query()["values"]
-		isvaluesExists := req.URL.Query().Has("values")
-		if isvaluesExists {
-			values := make([]int, 0, len(valuesRawArray))
-			for _, valuesRaw := range valuesRawArray {
-				valuesUint64, conversionErr := strconv.Atoi(valuesRaw)
-				if conversionErr != nil {
-					// Middlewares onInputValidationMiddlewares section
-					for _, middleware := range onInputValidationMiddlewares {
-						middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-						setRequestContext(req, middlewareCtx)
-						if !continueOperation {
-							return
-						}
-					}
-					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]int",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
-					// params validation error response extension placeholder
-					w.WriteHeader(http.StatusUnprocessableEntity)
-					json.NewEncoder(w).Encode(validationError)
-					return
-				}
-				valuesItem := int(valuesUint64)
-				values = append(values, int(valuesItem))
-			}
-			valuesRawPtr = &values
-			valuesRawPtr = &values
-		}
-		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var values2RawPtr *[]Param29values2.MyaliasInt = nil
-		values2RawArray := req.URL.Query()["values2"]
-		isvalues2Exists := req.URL.Query().Has("values2")
-		if isvalues2Exists {
-			values2 := make([]Param29values2.MyaliasInt, 0, len(values2RawArray))
-			for _, values2Raw := range values2RawArray {
-				values2Uint64, conversionErr := strconv.Atoi(values2Raw)
-				if conversionErr != nil {
-					// Middlewares onInputValidationMiddlewares section
-					for _, middleware := range onInputValidationMiddlewares {
-						middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-						setRequestContext(req, middlewareCtx)
-						if !continueOperation {
-							return
-						}
-					}
-					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values2",
-							"[]MyaliasInt",
-							reflect.TypeOf(values2Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
-					// params validation error response extension placeholder
-					w.WriteHeader(http.StatusUnprocessableEntity)
-					json.NewEncoder(w).Encode(validationError)
-					return
-				}
-				values2Item := int(values2Uint64)
-				values2 = append(values2, Param29values2.MyaliasInt(values2Item))
-			}
-			values2RawPtr = &values2
-			values2Var := []Param29values2.MyaliasInt(values2)
-			values2RawPtr = &values2Var
-		}
-		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var values3RawPtr *[]bool = nil
-		values3RawArray := req.URL.Query()["values3"]
-		isvalues3Exists := req.URL.Query().Has("values3")
-		if isvalues3Exists {
-			values3 := make([]bool, 0, len(values3RawArray))
-			for _, values3Raw := range values3RawArray {
-				values3Bool, conversionErr := strconv.ParseBool(values3Raw)
-				if conversionErr != nil {
-					// Middlewares onInputValidationMiddlewares section
-					for _, middleware := range onInputValidationMiddlewares {
-						middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-						setRequestContext(req, middlewareCtx)
-						if !continueOperation {
-							return
-						}
-					}
-					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values3",
-							"[]bool",
-							reflect.TypeOf(values3Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
-					// params validation error response extension placeholder
-					w.WriteHeader(http.StatusUnprocessableEntity)
-					json.NewEncoder(w).Encode(validationError)
-					return
-				}
-				values3Item := values3Bool
-				values3 = append(values3, bool(values3Item))
-			}
-			values3RawPtr = &values3
-			values3RawPtr = &values3
-		}
-		if validatorErr := validatorInstance.Var(values3RawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "values3"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var values4RawPtr *[]int32 = nil
-		values4RawArray := req.URL.Query()["values4"]
-		isvalues4Exists := req.URL.Query().Has("values4")
-		if isvalues4Exists {
-			values4 := make([]int32, 0, len(values4RawArray))
-			for _, values4Raw := range values4RawArray {
-				values4Uint64, conversionErr := strconv.ParseInt(values4Raw, 10, 32)
-				if conversionErr != nil {
-					// Middlewares onInputValidationMiddlewares section
-					for _, middleware := range onInputValidationMiddlewares {
-						middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-						setRequestContext(req, middlewareCtx)
-						if !continueOperation {
-							return
-						}
-					}
-					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values4",
-							"[]int32",
-							reflect.TypeOf(values4Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
-					// params validation error response extension placeholder
-					w.WriteHeader(http.StatusUnprocessableEntity)
-					json.NewEncoder(w).Encode(validationError)
-					return
-				}
-				values4Item := int32(values4Uint64)
-				values4 = append(values4, int32(values4Item))
-			}
-			values4RawPtr = &values4
-			values4RawPtr = &values4
-		}
-		if validatorErr := validatorInstance.Var(values4RawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "values4"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.QueryArrayOfOthers(*valuesRawPtr, *values2RawPtr, *values3RawPtr, *values4RawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'QueryArrayOfOthers'",
-				Status:     statusCode,
-				Instance:   "/controller/error/QueryArrayOfOthers",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/query-array-of-others-enum"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "QueryArrayOfOthersEnum")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var valuesRawPtr *[]Param31values.NumberEnum = nil
-		valuesRawArray := req.URL.Query()["values"]
-		isvaluesExists := req.URL.Query().Has("values")
-		if isvaluesExists {
-			values := make([]Param31values.NumberEnum, 0, len(valuesRawArray))
-			for _, valuesRaw := range valuesRawArray {
-				valuesUint64, conversionErr := strconv.ParseInt(valuesRaw, 10, 16)
-				if conversionErr != nil {
-					// Middlewares onInputValidationMiddlewares section
-					for _, middleware := range onInputValidationMiddlewares {
-						middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
-						setRequestContext(req, middlewareCtx)
-						if !continueOperation {
-							return
-						}
-					}
-					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthersEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]NumberEnum",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthersEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
-					// params validation error response extension placeholder
-					w.WriteHeader(http.StatusUnprocessableEntity)
-					json.NewEncoder(w).Encode(validationError)
-					return
-				}
-				valuesItem := int16(valuesUint64)
-				values = append(values, Param31values.NumberEnum(valuesItem))
-			}
-			valuesRawPtr = &values
-			valuesVar := []Param31values.NumberEnum(values)
-			valuesRawPtr = &valuesVar
-		}
-		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		var values2RawPtr *[]Param32values2.BoolEnum = nil
-		values2RawArray := req.URL.Query()["values2"]
-		isvalues2Exists := req.URL.Query().Has("values2")
-		if isvalues2Exists {
-			values2 := make([]Param32values2.BoolEnum, 0, len(values2RawArray))
-			for _, values2Raw := range values2RawArray {
-				values2Item := values2Raw
-				values2 = append(values2, Param32values2.BoolEnum(values2Item))
-			}
-			values2RawPtr = &values2
-			values2Var := []Param32values2.BoolEnum(values2)
-			values2RawPtr = &values2Var
-		}
-		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName)
-			// validation error response extension placeholder
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.QueryArrayOfOthersEnum(*valuesRawPtr, *values2RawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'QueryArrayOfOthersEnum'",
-				Status:     statusCode,
-				Instance:   "/controller/error/QueryArrayOfOthersEnum",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
-	engine.Post(toChiUrl("/e2e/query-pointer-to-array"), func(w http.ResponseWriter, req *http.Request) {
-		// route start routes extension placeholder
-		authErr := authorize(
-			req,
-			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName2",
-							Scopes: []string{
-								"config",
-							},
-						},
-					},
-				},
-			},
-		)
-		if authErr != nil {
-			handleAuthorizationError(w, authErr, "QueryArrayOfPointers")
-			return
-		}
-		controller := E2EController.E2EController{}
-		controller.InitController(req)
-		var values07RawPtr *[]string = nil
-		values07RawArray := req.URL.Query()["values07"]
-		isvalues07Exists := req.URL.Query().Has("values07")
-		if isvalues07Exists {
-			values07 := make([]string, 0, len(values07RawArray))
-			for _, values07Raw := range values07RawArray {
-				values07Item := values07Raw
-				values07 = append(values07, string(values07Item))
-			}
-			values07RawPtr = &values07
-			values07RawPtr = &values07
-		}
-		// Middlewares beforeOperationMiddlewares section
-		for _, middleware := range beforeOperationMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares beforeOperationMiddlewares section
-		// before operation routes extension placeholder
-		value, opError := controller.QueryArrayOfPointers(values07RawPtr)
-		for key, value := range controller.GetHeaders() {
-			w.Header().Set(key, value)
-		}
-		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
-		if opError != nil {
-			// Middlewares onErrorMiddlewares section
-			for _, middleware := range onErrorMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onErrorMiddlewares section
-			stdError := runtime.Rfc7807Error{
-				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'QueryArrayOfPointers'",
-				Status:     statusCode,
-				Instance:   "/controller/error/QueryArrayOfPointers",
-				Extensions: map[string]string{"error": opError.Error()},
-			}
-			// json error response extension placeholder
-			w.WriteHeader(statusCode)
-			json.NewEncoder(w).Encode(stdError)
-			return
-		}
-		// json response extension placeholder
-		// Middlewares afterOperationSuccessMiddlewares section
-		for _, middleware := range afterOperationSuccessMiddlewares {
-			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
-			setRequestContext(req, middlewareCtx)
-			if !continueOperation {
-				return
-			}
-		}
-		// End middlewares afterOperationSuccessMiddlewares section
-		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		// route end routes extension placeholder
-	})
+	registerE2EControllerRoutes(engine, basePath)
+	// E2ESharedRateLimitController
+	registerE2ESharedRateLimitControllerRoutes(engine, basePath)
 }
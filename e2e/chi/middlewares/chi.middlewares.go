@@ -97,3 +97,14 @@ func MiddlewareOnOutputValidationError(ctx context.Context, w http.ResponseWrite
 	}
 	return ctx, true
 }
+
+func MiddlewareAudit(ctx context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool) {
+	w.Header().Set("X-pass-named-middleware-audit", "true")
+	return ctx, true
+}
+
+func MiddlewareTrace(ctx context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool) {
+	// Named middlewares run in declaration order so the audit middleware must have already run
+	w.Header().Set("X-pass-named-middleware-trace", w.Header().Get("X-pass-named-middleware-audit"))
+	return ctx, true
+}
@@ -0,0 +1,303 @@
+/*
+--
+This file is automatically generated. Any manual changes to this file may be overwritten.
+It includes the routes and handlers of one or more controllers by the Gleece API Routes Generator.
+--
+Authors: Haim Kastner & Yuval Pomerchik
+Generated by: Gleece Routes Generator
+Routes File: chi.e2e.gleece.go
+Target Engine: Chi v5 (https://github.com/go-chi/chi)
+--
+Usage:
+Refer to the Gleece documentation https://docs.gleece.dev for details on how to use the generated routes and handlers.
+--
+Repository: https://github.com/gopher-fleece/gleece
+--
+*/
+package routes
+import (
+	"encoding/json"
+	"net/http"
+	"github.com/go-chi/chi/v5"
+	"github.com/gopher-fleece/runtime"
+	"go.opentelemetry.io/otel/propagation"
+	E2EClassSecController "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	// ImportsExtension - test
+)
+// registerE2EClassSecControllerRoutes registers the E2EClassSecController controller's routes on the given router, under the given base path
+func registerE2EClassSecControllerRoutes(engine chi.Router, basePath string) {
+	e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes := []int{}
+	e2EclassSecControllerWithDefaultClassSecurityRoute := withBasePath(basePath, "/e2e/with-default-class-security")
+	e2EclassSecControllerWithDefaultClassSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"WithDefaultClassSecurity",
+			"E2EClassSecController",
+			"GET",
+			e2EclassSecControllerWithDefaultClassSecurityRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "WithDefaultClassSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"class",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithDefaultClassSecurity")
+			return
+		}
+		controller := createController[E2EClassSecController.E2EClassSecController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("x-test-scopes")
+		_, isheaderParamExists := req.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			w.Header().Set("x-RunValidatorExtension", "WithDefaultClassSecurity")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "WithDefaultClassSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "WithDefaultClassSecurity")
+			}
+		}()
+		value, opError := controller.WithDefaultClassSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "WithDefaultClassSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithDefaultClassSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithDefaultClassSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "WithDefaultClassSecurity")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "WithDefaultClassSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "WithDefaultClassSecurity")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "WithDefaultClassSecurity")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/with-default-class-security")), e2EclassSecControllerWithDefaultClassSecurityHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/with-default-class-security")), e2EclassSecControllerWithDefaultClassSecurityHandler)
+	e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes := []int{}
+	e2EclassSecControllerWithOverrideClassSecurityRoute := withBasePath(basePath, "/e2e/with-default-override-class-security")
+	e2EclassSecControllerWithOverrideClassSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"WithOverrideClassSecurity",
+			"E2EClassSecController",
+			"GET",
+			e2EclassSecControllerWithOverrideClassSecurityRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "WithOverrideClassSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"method",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithOverrideClassSecurity")
+			return
+		}
+		controller := createController[E2EClassSecController.E2EClassSecController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("x-test-scopes")
+		_, isheaderParamExists := req.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			w.Header().Set("x-RunValidatorExtension", "WithOverrideClassSecurity")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "WithOverrideClassSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "WithOverrideClassSecurity")
+			}
+		}()
+		value, opError := controller.WithOverrideClassSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "WithOverrideClassSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithOverrideClassSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithOverrideClassSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "WithOverrideClassSecurity")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "WithOverrideClassSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "WithOverrideClassSecurity")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "WithOverrideClassSecurity")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/with-default-override-class-security")), e2EclassSecControllerWithOverrideClassSecurityHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/with-default-override-class-security")), e2EclassSecControllerWithOverrideClassSecurityHandler)
+}
@@ -0,0 +1,12479 @@
+/*
+--
+This file is automatically generated. Any manual changes to this file may be overwritten.
+It includes the routes and handlers of one or more controllers by the Gleece API Routes Generator.
+--
+Authors: Haim Kastner & Yuval Pomerchik
+Generated by: Gleece Routes Generator
+Routes File: chi.e2e.gleece.go
+Target Engine: Chi v5 (https://github.com/go-chi/chi)
+--
+Usage:
+Refer to the Gleece documentation https://docs.gleece.dev for details on how to use the generated routes and handlers.
+--
+Repository: https://github.com/gopher-fleece/gleece
+--
+*/
+package routes
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"github.com/go-chi/chi/v5"
+	"github.com/gopher-fleece/runtime"
+	"go.opentelemetry.io/otel/propagation"
+	E2EController "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param14value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param18data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param19data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param20data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param21data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param22data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param23data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param24arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param25object "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param26num "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param27str "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param28values "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param29values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param30values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param32values "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param33values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param4value2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param5data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param5theBody "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param6principal "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Response9CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param16data "github.com/haimkastner/unitsnet-go/units"
+	Param17unit "github.com/haimkastner/unitsnet-go/units"
+	// ImportsExtension - test
+)
+// registerE2EControllerRoutes registers the E2EController controller's routes on the given router, under the given base path
+func registerE2EControllerRoutes(engine chi.Router, basePath string) {
+	e2EcontrollerSimpleGetErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetRoute := withBasePath(basePath, "/e2e/simple-get")
+	e2EcontrollerSimpleGetHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SimpleGet",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "SimpleGet")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SimpleGet")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "SimpleGet")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SimpleGet")
+			}
+		}()
+		value, opError := controller.SimpleGet()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "SimpleGet")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGet'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGet",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "SimpleGet")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "SimpleGet")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "SimpleGet")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "SimpleGet")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/simple-get")), e2EcontrollerSimpleGetHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/simple-get")), e2EcontrollerSimpleGetHandler)
+	e2EcontrollerSimpleGetEmptyStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyStringRoute := withBasePath(basePath, "/e2e/simple-get-empty-string")
+	e2EcontrollerSimpleGetEmptyStringHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SimpleGetEmptyString",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetEmptyStringRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "SimpleGetEmptyString")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SimpleGetEmptyString")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetEmptyString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SimpleGetEmptyString")
+			}
+		}()
+		value, opError := controller.SimpleGetEmptyString()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "SimpleGetEmptyString")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetEmptyString'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetEmptyString",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "SimpleGetEmptyString")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "SimpleGetEmptyString")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "SimpleGetEmptyString")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "SimpleGetEmptyString")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/simple-get-empty-string")), e2EcontrollerSimpleGetEmptyStringHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/simple-get-empty-string")), e2EcontrollerSimpleGetEmptyStringHandler)
+	e2EcontrollerSimpleGetPtrStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetPtrStringRoute := withBasePath(basePath, "/e2e/simple-get-ptr-string")
+	e2EcontrollerSimpleGetPtrStringHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SimpleGetPtrString",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetPtrStringRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "SimpleGetPtrString")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SimpleGetPtrString")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetPtrString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SimpleGetPtrString")
+			}
+		}()
+		value, opError := controller.SimpleGetPtrString()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "SimpleGetPtrString")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetPtrString'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetPtrString",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "SimpleGetPtrString")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "SimpleGetPtrString")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "SimpleGetPtrString")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "SimpleGetPtrString")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/simple-get-ptr-string")), e2EcontrollerSimpleGetPtrStringHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/simple-get-ptr-string")), e2EcontrollerSimpleGetPtrStringHandler)
+	e2EcontrollerSimpleGetNullStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetNullStringRoute := withBasePath(basePath, "/e2e/simple-get-null-string")
+	e2EcontrollerSimpleGetNullStringHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SimpleGetNullString",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetNullStringRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "SimpleGetNullString")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SimpleGetNullString")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetNullString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SimpleGetNullString")
+			}
+		}()
+		value, opError := controller.SimpleGetNullString()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "SimpleGetNullString")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetNullString'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetNullString",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "SimpleGetNullString")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "SimpleGetNullString")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "SimpleGetNullString")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "SimpleGetNullString")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/simple-get-null-string")), e2EcontrollerSimpleGetNullStringHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/simple-get-null-string")), e2EcontrollerSimpleGetNullStringHandler)
+	e2EcontrollerNamedMiddlewaresErrorStatusCodes := []int{}
+	e2EcontrollerNamedMiddlewaresRoute := withBasePath(basePath, "/e2e/named-middlewares")
+	e2EcontrollerNamedMiddlewaresHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"NamedMiddlewares",
+			"E2EController",
+			"GET",
+			e2EcontrollerNamedMiddlewaresRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "NamedMiddlewares")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "NamedMiddlewares")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		routeMiddlewares := []MiddlewareFunc{namedMiddlewares["audit"], namedMiddlewares["trace"]}
+		// Middlewares routeMiddlewares section
+		for _, middleware := range routeMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares routeMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "NamedMiddlewares")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "NamedMiddlewares")
+			}
+		}()
+		value, opError := controller.NamedMiddlewares()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "NamedMiddlewares")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'NamedMiddlewares'",
+				Status:     statusCode,
+				Instance:   "/controller/error/NamedMiddlewares",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "NamedMiddlewares")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "NamedMiddlewares")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "NamedMiddlewares")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "NamedMiddlewares")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/named-middlewares")), e2EcontrollerNamedMiddlewaresHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/named-middlewares")), e2EcontrollerNamedMiddlewaresHandler)
+	e2EcontrollerSimpleGetObjectErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectRoute := withBasePath(basePath, "/e2e/simple-get-object")
+	e2EcontrollerSimpleGetObjectHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SimpleGetObject",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetObjectRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "SimpleGetObject")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SimpleGetObject")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetObject")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SimpleGetObject")
+			}
+		}()
+		value, opError := controller.SimpleGetObject()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "SimpleGetObject")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObject'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetObject",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "SimpleGetObject")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "SimpleGetObject")
+		var outputValidationErr error
+		outputValidationErr = validateDataRecursive(value, "")
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObject'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/SimpleGetObject",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "SimpleGetObject")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "SimpleGetObject")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/simple-get-object")), e2EcontrollerSimpleGetObjectHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/simple-get-object")), e2EcontrollerSimpleGetObjectHandler)
+	e2EcontrollerSimpleGetObjectPtrErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectPtrRoute := withBasePath(basePath, "/e2e/simple-get-object-ptr")
+	e2EcontrollerSimpleGetObjectPtrHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SimpleGetObjectPtr",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetObjectPtrRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "SimpleGetObjectPtr")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SimpleGetObjectPtr")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetObjectPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SimpleGetObjectPtr")
+			}
+		}()
+		value, opError := controller.SimpleGetObjectPtr()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "SimpleGetObjectPtr")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObjectPtr'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetObjectPtr",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "SimpleGetObjectPtr")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "SimpleGetObjectPtr")
+		var outputValidationErr error
+		if value == nil {
+			outputValidationErr = fmt.Errorf("Response payload is nil")
+		} else {
+			outputValidationErr = validateDataRecursive(value, "")
+		}
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObjectPtr'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/SimpleGetObjectPtr",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "SimpleGetObjectPtr")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "SimpleGetObjectPtr")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/simple-get-object-ptr")), e2EcontrollerSimpleGetObjectPtrHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/simple-get-object-ptr")), e2EcontrollerSimpleGetObjectPtrHandler)
+	e2EcontrollerSimpleGetObjectNullErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectNullRoute := withBasePath(basePath, "/e2e/simple-get-object-null")
+	e2EcontrollerSimpleGetObjectNullHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SimpleGetObjectNull",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetObjectNullRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "SimpleGetObjectNull")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SimpleGetObjectNull")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetObjectNull")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SimpleGetObjectNull")
+			}
+		}()
+		value, opError := controller.SimpleGetObjectNull()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "SimpleGetObjectNull")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObjectNull'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetObjectNull",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "SimpleGetObjectNull")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "SimpleGetObjectNull")
+		var outputValidationErr error
+		if value == nil {
+			outputValidationErr = fmt.Errorf("Response payload is nil")
+		} else {
+			outputValidationErr = validateDataRecursive(value, "")
+		}
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObjectNull'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/SimpleGetObjectNull",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "SimpleGetObjectNull")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "SimpleGetObjectNull")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/simple-get-object-null")), e2EcontrollerSimpleGetObjectNullHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/simple-get-object-null")), e2EcontrollerSimpleGetObjectNullHandler)
+	e2EcontrollerPrimitiveReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-return-type")
+	e2EcontrollerPrimitiveReturnTypeHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"PrimitiveReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveReturnTypeRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "PrimitiveReturnType")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PrimitiveReturnType")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "PrimitiveReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "PrimitiveReturnType")
+			}
+		}()
+		value, opError := controller.PrimitiveReturnType()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "PrimitiveReturnType")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PrimitiveReturnType'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PrimitiveReturnType",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "PrimitiveReturnType")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "PrimitiveReturnType")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "PrimitiveReturnType")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "PrimitiveReturnType")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/primitive-return-type")), e2EcontrollerPrimitiveReturnTypeHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/primitive-return-type")), e2EcontrollerPrimitiveReturnTypeHandler)
+	e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveArrayReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-array-return-type")
+	e2EcontrollerPrimitiveArrayReturnTypeHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"PrimitiveArrayReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveArrayReturnTypeRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "PrimitiveArrayReturnType")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PrimitiveArrayReturnType")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "PrimitiveArrayReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "PrimitiveArrayReturnType")
+			}
+		}()
+		value, opError := controller.PrimitiveArrayReturnType()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "PrimitiveArrayReturnType")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PrimitiveArrayReturnType'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PrimitiveArrayReturnType",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "PrimitiveArrayReturnType")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "PrimitiveArrayReturnType")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "PrimitiveArrayReturnType")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "PrimitiveArrayReturnType")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/primitive-array-return-type")), e2EcontrollerPrimitiveArrayReturnTypeHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/primitive-array-return-type")), e2EcontrollerPrimitiveArrayReturnTypeHandler)
+	e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-alias-return-type")
+	e2EcontrollerPrimitiveAliasReturnTypeHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"PrimitiveAliasReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveAliasReturnTypeRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "PrimitiveAliasReturnType")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PrimitiveAliasReturnType")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "PrimitiveAliasReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "PrimitiveAliasReturnType")
+			}
+		}()
+		value, opError := controller.PrimitiveAliasReturnType()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "PrimitiveAliasReturnType")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PrimitiveAliasReturnType'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PrimitiveAliasReturnType",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "PrimitiveAliasReturnType")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "PrimitiveAliasReturnType")
+		var outputValidationErr error
+		outputValidationErr = validateDataRecursive(value, "")
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'PrimitiveAliasReturnType'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/PrimitiveAliasReturnType",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "PrimitiveAliasReturnType")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "PrimitiveAliasReturnType")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/primitive-alias-return-type")), e2EcontrollerPrimitiveAliasReturnTypeHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/primitive-alias-return-type")), e2EcontrollerPrimitiveAliasReturnTypeHandler)
+	e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasArrayReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-alias-array-return-type")
+	e2EcontrollerPrimitiveAliasArrayReturnTypeHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"PrimitiveAliasArrayReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveAliasArrayReturnTypeRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "PrimitiveAliasArrayReturnType")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PrimitiveAliasArrayReturnType")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "PrimitiveAliasArrayReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "PrimitiveAliasArrayReturnType")
+			}
+		}()
+		value, opError := controller.PrimitiveAliasArrayReturnType()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "PrimitiveAliasArrayReturnType")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PrimitiveAliasArrayReturnType'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PrimitiveAliasArrayReturnType",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "PrimitiveAliasArrayReturnType")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "PrimitiveAliasArrayReturnType")
+		var outputValidationErr error
+		outputValidationErr = validateDataRecursive(value, "")
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'PrimitiveAliasArrayReturnType'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/PrimitiveAliasArrayReturnType",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "PrimitiveAliasArrayReturnType")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "PrimitiveAliasArrayReturnType")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/primitive-alias-array-return-type")), e2EcontrollerPrimitiveAliasArrayReturnTypeHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/primitive-alias-array-return-type")), e2EcontrollerPrimitiveAliasArrayReturnTypeHandler)
+	e2EcontrollerSimpleGetEmptyErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyRoute := withBasePath(basePath, "/e2e/simple-get-empty")
+	e2EcontrollerSimpleGetEmptyHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SimpleGetEmpty",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetEmptyRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "SimpleGetEmpty")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SimpleGetEmpty")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var queryParamRawPtr *string = nil
+		queryParamRaw := req.URL.Query().Get("queryParam")
+		isqueryParamExists := req.URL.Query().Has("queryParam")
+		if isqueryParamExists {
+			queryParam := queryParamRaw
+			queryParamRawPtr = &queryParam
+		}
+		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "queryParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
+			w.Header().Set("x-RunValidatorExtension", "SimpleGetEmpty")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetEmpty")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SimpleGetEmpty")
+			}
+		}()
+		opError := controller.SimpleGetEmpty(*queryParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "SimpleGetEmpty")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetEmpty'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetEmpty",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "SimpleGetEmpty")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "SimpleGetEmpty")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "SimpleGetEmpty")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "SimpleGetEmpty")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/simple-get-empty")), e2EcontrollerSimpleGetEmptyHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/simple-get-empty")), e2EcontrollerSimpleGetEmptyHandler)
+	e2EcontrollerGetWithAllParamsErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsRoute := withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")
+	e2EcontrollerGetWithAllParamsHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"GetWithAllParams",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetWithAllParamsRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "GetWithAllParams")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "GetWithAllParams")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var queryParamRawPtr *string = nil
+		queryParamRaw := req.URL.Query().Get("queryParam")
+		isqueryParamExists := req.URL.Query().Has("queryParam")
+		if isqueryParamExists {
+			queryParam := queryParamRaw
+			queryParamRawPtr = &queryParam
+		}
+		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "queryParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
+			w.Header().Set("x-RunValidatorExtension", "GetWithAllParams")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var pathParamRawPtr *string = nil
+		pathParamRaw := chi.URLParam(req, "pathParam")
+		ispathParamExists := true // if parameter is in route but not provided, it won't reach this handler
+		if ispathParamExists {
+			pathParam := pathParamRaw
+			pathParamRawPtr = &pathParam
+		}
+		if validatorErr := validatorInstance.Var(pathParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "pathParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
+			w.Header().Set("x-RunValidatorExtension", "GetWithAllParams")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("headerParam")
+		_, isheaderParamExists := req.Header["headerParam"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("headerParam")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
+			w.Header().Set("x-RunValidatorExtension", "GetWithAllParams")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "GetWithAllParams")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "GetWithAllParams")
+			}
+		}()
+		value, opError := controller.GetWithAllParams(*queryParamRawPtr, *pathParamRawPtr, *headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "GetWithAllParams")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GetWithAllParams'",
+				Status:     statusCode,
+				Instance:   "/controller/error/GetWithAllParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "GetWithAllParams")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "GetWithAllParams")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "GetWithAllParams")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "GetWithAllParams")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")), e2EcontrollerGetWithAllParamsHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")), e2EcontrollerGetWithAllParamsHandler)
+	e2EcontrollerGetWithAllParamsPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsPtrRoute := withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")
+	e2EcontrollerGetWithAllParamsPtrHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"GetWithAllParamsPtr",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetWithAllParamsPtrRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "GetWithAllParamsPtr")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "GetWithAllParamsPtr")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var queryParamRawPtr *string = nil
+		queryParamRaw := req.URL.Query().Get("queryParam")
+		isqueryParamExists := req.URL.Query().Has("queryParam")
+		if isqueryParamExists {
+			queryParam := queryParamRaw
+			queryParamRawPtr = &queryParam
+		}
+		var pathParamRawPtr *string = nil
+		pathParamRaw := chi.URLParam(req, "pathParam")
+		ispathParamExists := true // if parameter is in route but not provided, it won't reach this handler
+		if ispathParamExists {
+			pathParam := pathParamRaw
+			pathParamRawPtr = &pathParam
+		}
+		if validatorErr := validatorInstance.Var(pathParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "pathParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
+			w.Header().Set("x-RunValidatorExtension", "GetWithAllParamsPtr")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("headerParam")
+		_, isheaderParamExists := req.Header["headerParam"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("headerParam")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "GetWithAllParamsPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "GetWithAllParamsPtr")
+			}
+		}()
+		value, opError := controller.GetWithAllParamsPtr(queryParamRawPtr, pathParamRawPtr, headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "GetWithAllParamsPtr")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GetWithAllParamsPtr'",
+				Status:     statusCode,
+				Instance:   "/controller/error/GetWithAllParamsPtr",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "GetWithAllParamsPtr")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "GetWithAllParamsPtr")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "GetWithAllParamsPtr")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "GetWithAllParamsPtr")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsPtrHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsPtrHandler)
+	e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsRequiredPtrRoute := withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")
+	e2EcontrollerGetWithAllParamsRequiredPtrHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"GetWithAllParamsRequiredPtr",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetWithAllParamsRequiredPtrRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "GetWithAllParamsRequiredPtr")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "GetWithAllParamsRequiredPtr")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var queryParamRawPtr *string = nil
+		queryParamRaw := req.URL.Query().Get("queryParam")
+		isqueryParamExists := req.URL.Query().Has("queryParam")
+		if isqueryParamExists {
+			queryParam := queryParamRaw
+			queryParamRawPtr = &queryParam
+		}
+		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "queryParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
+			w.Header().Set("x-RunValidatorExtension", "GetWithAllParamsRequiredPtr")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var pathParamRawPtr *string = nil
+		pathParamRaw := chi.URLParam(req, "pathParam")
+		ispathParamExists := true // if parameter is in route but not provided, it won't reach this handler
+		if ispathParamExists {
+			pathParam := pathParamRaw
+			pathParamRawPtr = &pathParam
+		}
+		if validatorErr := validatorInstance.Var(pathParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "pathParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
+			w.Header().Set("x-RunValidatorExtension", "GetWithAllParamsRequiredPtr")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("headerParam")
+		_, isheaderParamExists := req.Header["headerParam"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("headerParam")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
+			w.Header().Set("x-RunValidatorExtension", "GetWithAllParamsRequiredPtr")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "GetWithAllParamsRequiredPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "GetWithAllParamsRequiredPtr")
+			}
+		}()
+		value, opError := controller.GetWithAllParamsRequiredPtr(queryParamRawPtr, pathParamRawPtr, headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "GetWithAllParamsRequiredPtr")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GetWithAllParamsRequiredPtr'",
+				Status:     statusCode,
+				Instance:   "/controller/error/GetWithAllParamsRequiredPtr",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "GetWithAllParamsRequiredPtr")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "GetWithAllParamsRequiredPtr")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "GetWithAllParamsRequiredPtr")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "GetWithAllParamsRequiredPtr")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsRequiredPtrHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsRequiredPtrHandler)
+	e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyRoute := withBasePath(basePath, "/e2e/post-with-all-params-body")
+	e2EcontrollerPostWithAllParamsWithBodyHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"PostWithAllParamsWithBody",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostWithAllParamsWithBodyRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "PostWithAllParamsWithBody")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBody")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var queryParamRawPtr *string = nil
+		queryParamRaw := req.URL.Query().Get("queryParam")
+		isqueryParamExists := req.URL.Query().Has("queryParam")
+		if isqueryParamExists {
+			queryParam := queryParamRaw
+			queryParamRawPtr = &queryParam
+		}
+		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "queryParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
+			w.Header().Set("x-RunValidatorExtension", "PostWithAllParamsWithBody")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("headerParam")
+		_, isheaderParamExists := req.Header["headerParam"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("headerParam")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
+			w.Header().Set("x-RunValidatorExtension", "PostWithAllParamsWithBody")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var theBodyRawPtr *Param5theBody.BodyInfo = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &theBodyRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBody",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/PostWithAllParamsWithBody",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBody")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBody")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "PostWithAllParamsWithBody")
+			}
+		}()
+		value, opError := controller.PostWithAllParamsWithBody(*queryParamRawPtr, *headerParamRawPtr, *theBodyRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "PostWithAllParamsWithBody")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBody'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PostWithAllParamsWithBody",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "PostWithAllParamsWithBody")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "PostWithAllParamsWithBody")
+		var outputValidationErr error
+		outputValidationErr = validateDataRecursive(value, "")
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBody'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/PostWithAllParamsWithBody",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "PostWithAllParamsWithBody")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "PostWithAllParamsWithBody")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/post-with-all-params-body")), e2EcontrollerPostWithAllParamsWithBodyHandler)
+	e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyPtrRoute := withBasePath(basePath, "/e2e/post-with-all-params-body-ptr")
+	e2EcontrollerPostWithAllParamsWithBodyPtrHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"PostWithAllParamsWithBodyPtr",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostWithAllParamsWithBodyPtrRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "PostWithAllParamsWithBodyPtr")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBodyPtr")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var queryParamRawPtr *string = nil
+		queryParamRaw := req.URL.Query().Get("queryParam")
+		isqueryParamExists := req.URL.Query().Has("queryParam")
+		if isqueryParamExists {
+			queryParam := queryParamRaw
+			queryParamRawPtr = &queryParam
+		}
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("headerParam")
+		_, isheaderParamExists := req.Header["headerParam"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("headerParam")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		var theBodyRawPtr *Param5theBody.BodyInfo = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "", &theBodyRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/PostWithAllParamsWithBodyPtr",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBodyPtr")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBodyPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "PostWithAllParamsWithBodyPtr")
+			}
+		}()
+		value, opError := controller.PostWithAllParamsWithBodyPtr(queryParamRawPtr, headerParamRawPtr, theBodyRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "PostWithAllParamsWithBodyPtr")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyPtr'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PostWithAllParamsWithBodyPtr",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "PostWithAllParamsWithBodyPtr")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "PostWithAllParamsWithBodyPtr")
+		var outputValidationErr error
+		if value == nil {
+			outputValidationErr = fmt.Errorf("Response payload is nil")
+		} else {
+			outputValidationErr = validateDataRecursive(value, "")
+		}
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyPtr'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/PostWithAllParamsWithBodyPtr",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "PostWithAllParamsWithBodyPtr")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "PostWithAllParamsWithBodyPtr")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-ptr")), e2EcontrollerPostWithAllParamsWithBodyPtrHandler)
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrRoute := withBasePath(basePath, "/e2e/post-with-all-params-body-required-ptr")
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"PostWithAllParamsWithBodyRequiredPtr",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostWithAllParamsWithBodyRequiredPtrRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBodyRequiredPtr")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var theBodyRawPtr *Param5theBody.BodyInfo = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &theBodyRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyRequiredPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/PostWithAllParamsWithBodyRequiredPtr",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "PostWithAllParamsWithBodyRequiredPtr")
+			}
+		}()
+		value, opError := controller.PostWithAllParamsWithBodyRequiredPtr(theBodyRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyRequiredPtr'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PostWithAllParamsWithBodyRequiredPtr",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		var outputValidationErr error
+		if value == nil {
+			outputValidationErr = fmt.Errorf("Response payload is nil")
+		} else {
+			outputValidationErr = validateDataRecursive(value, "")
+		}
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyRequiredPtr'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/PostWithAllParamsWithBodyRequiredPtr",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-required-ptr")), e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler)
+	e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes := []int{}
+	e2EcontrollerGetHeaderStartWithLetterRoute := withBasePath(basePath, "/e2e/get-header-start-with-letter")
+	e2EcontrollerGetHeaderStartWithLetterHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"GetHeaderStartWithLetter",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetHeaderStartWithLetterRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "GetHeaderStartWithLetter")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "GetHeaderStartWithLetter")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("headerParam")
+		_, isheaderParamExists := req.Header["headerParam"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("headerParam")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required,validate_starts_with_letter"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
+			w.Header().Set("x-RunValidatorExtension", "GetHeaderStartWithLetter")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "GetHeaderStartWithLetter")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "GetHeaderStartWithLetter")
+			}
+		}()
+		value, opError := controller.GetHeaderStartWithLetter(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "GetHeaderStartWithLetter")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GetHeaderStartWithLetter'",
+				Status:     statusCode,
+				Instance:   "/controller/error/GetHeaderStartWithLetter",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "GetHeaderStartWithLetter")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "GetHeaderStartWithLetter")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "GetHeaderStartWithLetter")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "GetHeaderStartWithLetter")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/get-header-start-with-letter")), e2EcontrollerGetHeaderStartWithLetterHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/get-header-start-with-letter")), e2EcontrollerGetHeaderStartWithLetterHandler)
+	e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithDefaultConfigSecurityRoute := withBasePath(basePath, "/e2e/with-default-config-security")
+	e2EcontrollerWithDefaultConfigSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"WithDefaultConfigSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithDefaultConfigSecurityRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "WithDefaultConfigSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithDefaultConfigSecurity")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("x-test-scopes")
+		_, isheaderParamExists := req.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			w.Header().Set("x-RunValidatorExtension", "WithDefaultConfigSecurity")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "WithDefaultConfigSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "WithDefaultConfigSecurity")
+			}
+		}()
+		value, opError := controller.WithDefaultConfigSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "WithDefaultConfigSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithDefaultConfigSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithDefaultConfigSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "WithDefaultConfigSecurity")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "WithDefaultConfigSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "WithDefaultConfigSecurity")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "WithDefaultConfigSecurity")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/with-default-config-security")), e2EcontrollerWithDefaultConfigSecurityHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/with-default-config-security")), e2EcontrollerWithDefaultConfigSecurityHandler)
+	e2EcontrollerWithOneSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithOneSecurityRoute := withBasePath(basePath, "/e2e/with-one-security")
+	e2EcontrollerWithOneSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"WithOneSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithOneSecurityRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "WithOneSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithOneSecurity")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("x-test-scopes")
+		_, isheaderParamExists := req.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			w.Header().Set("x-RunValidatorExtension", "WithOneSecurity")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "WithOneSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "WithOneSecurity")
+			}
+		}()
+		value, opError := controller.WithOneSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "WithOneSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithOneSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithOneSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "WithOneSecurity")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "WithOneSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "WithOneSecurity")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "WithOneSecurity")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/with-one-security")), e2EcontrollerWithOneSecurityHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/with-one-security")), e2EcontrollerWithOneSecurityHandler)
+	e2EcontrollerWithTwoSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecurityRoute := withBasePath(basePath, "/e2e/with-two-security")
+	e2EcontrollerWithTwoSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"WithTwoSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithTwoSecurityRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "WithTwoSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+								"read",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithTwoSecurity")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("x-test-scopes")
+		_, isheaderParamExists := req.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			w.Header().Set("x-RunValidatorExtension", "WithTwoSecurity")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "WithTwoSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "WithTwoSecurity")
+			}
+		}()
+		value, opError := controller.WithTwoSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "WithTwoSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithTwoSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithTwoSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "WithTwoSecurity")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "WithTwoSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "WithTwoSecurity")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "WithTwoSecurity")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/with-two-security")), e2EcontrollerWithTwoSecurityHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/with-two-security")), e2EcontrollerWithTwoSecurityHandler)
+	e2EcontrollerWithAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithAdvancedSecurityRoute := withBasePath(basePath, "/e2e/with-advanced-security")
+	e2EcontrollerWithAdvancedSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"WithAdvancedSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithAdvancedSecurityRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "WithAdvancedSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName3",
+							Scopes: []string{
+								"admin",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithAdvancedSecurity")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("x-test-scopes")
+		_, isheaderParamExists := req.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithAdvancedSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			w.Header().Set("x-RunValidatorExtension", "WithAdvancedSecurity")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "WithAdvancedSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "WithAdvancedSecurity")
+			}
+		}()
+		value, opError := controller.WithAdvancedSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "WithAdvancedSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithAdvancedSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithAdvancedSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "WithAdvancedSecurity")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "WithAdvancedSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "WithAdvancedSecurity")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "WithAdvancedSecurity")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/with-advanced-security")), e2EcontrollerWithAdvancedSecurityHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/with-advanced-security")), e2EcontrollerWithAdvancedSecurityHandler)
+	e2EcontrollerWithPrincipalErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalRoute := withBasePath(basePath, "/e2e/with-principal")
+	e2EcontrollerWithPrincipalHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"WithPrincipal",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithPrincipalRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "WithPrincipal")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithPrincipal")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(req))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			handleAuthorizationError(w, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipal")
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "WithPrincipal")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "WithPrincipal")
+			}
+		}()
+		value, opError := controller.WithPrincipal(principalRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "WithPrincipal")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithPrincipal'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithPrincipal",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "WithPrincipal")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "WithPrincipal")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "WithPrincipal")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "WithPrincipal")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/with-principal")), e2EcontrollerWithPrincipalHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/with-principal")), e2EcontrollerWithPrincipalHandler)
+	e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalAdvancedSecurityRoute := withBasePath(basePath, "/e2e/with-principal-advanced-security")
+	e2EcontrollerWithPrincipalAdvancedSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"WithPrincipalAdvancedSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithPrincipalAdvancedSecurityRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "WithPrincipalAdvancedSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName3",
+							Scopes: []string{
+								"admin",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithPrincipalAdvancedSecurity")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(req))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			handleAuthorizationError(w, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipalAdvancedSecurity")
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "WithPrincipalAdvancedSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "WithPrincipalAdvancedSecurity")
+			}
+		}()
+		value, opError := controller.WithPrincipalAdvancedSecurity(principalRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "WithPrincipalAdvancedSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithPrincipalAdvancedSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithPrincipalAdvancedSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "WithPrincipalAdvancedSecurity")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "WithPrincipalAdvancedSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "WithPrincipalAdvancedSecurity")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "WithPrincipalAdvancedSecurity")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/with-principal-advanced-security")), e2EcontrollerWithPrincipalAdvancedSecurityHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/with-principal-advanced-security")), e2EcontrollerWithPrincipalAdvancedSecurityHandler)
+	e2EcontrollerPublicRouteErrorStatusCodes := []int{}
+	e2EcontrollerPublicRouteRoute := withBasePath(basePath, "/e2e/public")
+	e2EcontrollerPublicRouteHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"PublicRoute",
+			"E2EController",
+			"GET",
+			e2EcontrollerPublicRouteRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "PublicRoute")
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "PublicRoute")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "PublicRoute")
+			}
+		}()
+		value, opError := controller.PublicRoute()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "PublicRoute")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PublicRoute'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PublicRoute",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "PublicRoute")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "PublicRoute")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "PublicRoute")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "PublicRoute")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/public")), e2EcontrollerPublicRouteHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/public")), e2EcontrollerPublicRouteHandler)
+	e2EcontrollerHeadCheckErrorStatusCodes := []int{}
+	e2EcontrollerHeadCheckRoute := withBasePath(basePath, "/e2e/head-check")
+	e2EcontrollerHeadCheckHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"HeadCheck",
+			"E2EController",
+			"HEAD",
+			e2EcontrollerHeadCheckRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "HeadCheck")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "HeadCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "HeadCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "HeadCheck")
+			}
+		}()
+		opError := controller.HeadCheck()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "HeadCheck")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'HeadCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/HeadCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "HeadCheck")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "HeadCheck")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "HeadCheck")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "HeadCheck")
+	}
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/head-check")), e2EcontrollerHeadCheckHandler)
+	e2EcontrollerOptionsCheckErrorStatusCodes := []int{}
+	e2EcontrollerOptionsCheckRoute := withBasePath(basePath, "/e2e/options-check")
+	e2EcontrollerOptionsCheckHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"OptionsCheck",
+			"E2EController",
+			"OPTIONS",
+			e2EcontrollerOptionsCheckRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "OptionsCheck")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "OptionsCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "OptionsCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "OptionsCheck")
+			}
+		}()
+		value, opError := controller.OptionsCheck()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "OptionsCheck")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'OptionsCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/OptionsCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "OptionsCheck")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "OptionsCheck")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "OptionsCheck")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "OptionsCheck")
+	}
+	engine.MethodFunc("OPTIONS", toChiUrl(withBasePath(basePath, "/e2e/options-check")), e2EcontrollerOptionsCheckHandler)
+	e2EcontrollerTraceCheckErrorStatusCodes := []int{}
+	e2EcontrollerTraceCheckRoute := withBasePath(basePath, "/e2e/trace-check")
+	e2EcontrollerTraceCheckHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TraceCheck",
+			"E2EController",
+			"TRACE",
+			e2EcontrollerTraceCheckRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "TraceCheck")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TraceCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "TraceCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TraceCheck")
+			}
+		}()
+		value, opError := controller.TraceCheck()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "TraceCheck")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TraceCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TraceCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "TraceCheck")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "TraceCheck")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "TraceCheck")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "TraceCheck")
+	}
+	engine.MethodFunc("TRACE", toChiUrl(withBasePath(basePath, "/e2e/trace-check")), e2EcontrollerTraceCheckHandler)
+	e2EcontrollerPurgeCheckErrorStatusCodes := []int{}
+	e2EcontrollerPurgeCheckRoute := withBasePath(basePath, "/e2e/purge-check")
+	e2EcontrollerPurgeCheckHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"PurgeCheck",
+			"E2EController",
+			"PURGE",
+			e2EcontrollerPurgeCheckRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "PurgeCheck")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PurgeCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "PurgeCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "PurgeCheck")
+			}
+		}()
+		value, opError := controller.PurgeCheck()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "PurgeCheck")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PurgeCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PurgeCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "PurgeCheck")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "PurgeCheck")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "PurgeCheck")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "PurgeCheck")
+	}
+	// Chi only routes standard methods unless others are registered beforehand
+	chi.RegisterMethod("PURGE")
+	engine.MethodFunc("PURGE", toChiUrl(withBasePath(basePath, "/e2e/purge-check")), e2EcontrollerPurgeCheckHandler)
+	e2EcontrollerVersionedV1ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV1Route := withBasePath(basePath, "/e2e/versioned")
+	e2EcontrollerVersionedV1Handler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"VersionedV1",
+			"E2EController",
+			"GET",
+			e2EcontrollerVersionedV1Route,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "VersionedV1")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "VersionedV1")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "VersionedV1")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "VersionedV1")
+			}
+		}()
+		value, opError := controller.VersionedV1()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "VersionedV1")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV1'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV1",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "VersionedV1")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "VersionedV1")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "VersionedV1")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "VersionedV1")
+	}
+	e2EcontrollerVersionedV1Versions := []int{1}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV1Versions, e2EcontrollerVersionedV1Handler)
+	registerVersionedRoute(engine, basePath, "HEAD", "/e2e/versioned", e2EcontrollerVersionedV1Versions, e2EcontrollerVersionedV1Handler)
+	e2EcontrollerVersionedV2ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV2Route := withBasePath(basePath, "/e2e/versioned")
+	e2EcontrollerVersionedV2Handler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"VersionedV2",
+			"E2EController",
+			"GET",
+			e2EcontrollerVersionedV2Route,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "VersionedV2")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "VersionedV2")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "VersionedV2")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "VersionedV2")
+			}
+		}()
+		value, opError := controller.VersionedV2()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "VersionedV2")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV2'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV2",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "VersionedV2")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "VersionedV2")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "VersionedV2")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "VersionedV2")
+	}
+	e2EcontrollerVersionedV2Versions := []int{2}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV2Versions, e2EcontrollerVersionedV2Handler)
+	registerVersionedRoute(engine, basePath, "HEAD", "/e2e/versioned", e2EcontrollerVersionedV2Versions, e2EcontrollerVersionedV2Handler)
+	e2EcontrollerRateLimitedErrorStatusCodes := []int{}
+	e2EcontrollerRateLimitedRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "RateLimited")
+	e2EcontrollerRateLimitedRoute := withBasePath(basePath, "/e2e/rate-limited")
+	e2EcontrollerRateLimitedHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"RateLimited",
+			"E2EController",
+			"GET",
+			e2EcontrollerRateLimitedRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "RateLimited")
+		if !enforceRateLimit(w, req, "RateLimited", e2EcontrollerRateLimitedRateLimit) {
+			return
+		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "RateLimited")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "RateLimited")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "RateLimited")
+			}
+		}()
+		value, opError := controller.RateLimited()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "RateLimited")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'RateLimited'",
+				Status:     statusCode,
+				Instance:   "/controller/error/RateLimited",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "RateLimited")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "RateLimited")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "RateLimited")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "RateLimited")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/rate-limited")), e2EcontrollerRateLimitedHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/rate-limited")), e2EcontrollerRateLimitedHandler)
+	e2EcontrollerTimedOutErrorStatusCodes := []int{}
+	e2EcontrollerTimedOutTimeout := newOperationTimeout("50ms")
+	e2EcontrollerTimedOutRoute := withBasePath(basePath, "/e2e/timeout")
+	e2EcontrollerTimedOutHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TimedOut",
+			"E2EController",
+			"GET",
+			e2EcontrollerTimedOutRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "TimedOut")
+		cancelTimeout := applyOperationTimeout(req, e2EcontrollerTimedOutTimeout)
+		defer cancelTimeout()
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TimedOut")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "TimedOut")
+		if isDeadlineExceeded(req) {
+			handleDeadlineExceeded(w, "TimedOut", http.StatusServiceUnavailable)
+			return
+		}
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TimedOut")
+			}
+		}()
+		value, opError := controller.TimedOut(getRequestContext(req))
+		telemetry.endPhase()
+		if isDeadlineExceeded(req) {
+			handleDeadlineExceeded(w, "TimedOut", http.StatusGatewayTimeout)
+			return
+		}
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "TimedOut")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTimedOutErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TimedOut'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TimedOut",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTimedOutErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "TimedOut")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "TimedOut")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "TimedOut")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "TimedOut")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/timeout")), e2EcontrollerTimedOutHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/timeout")), e2EcontrollerTimedOutHandler)
+	e2EcontrollerMaxBodySizeErrorStatusCodes := []int{}
+	e2EcontrollerMaxBodySizeRoute := withBasePath(basePath, "/e2e/max-body-size")
+	e2EcontrollerMaxBodySizeHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"MaxBodySize",
+			"E2EController",
+			"POST",
+			e2EcontrollerMaxBodySizeRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "MaxBodySize")
+		if !enforceMaxBodySize(w, req, "MaxBodySize", 64) {
+			return
+		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "MaxBodySize")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var dataRawPtr *Param8data.ObjectWithByteSlice = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "MaxBodySize",
+						"parameter": "data",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/MaxBodySize",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "MaxBodySize")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "MaxBodySize")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "MaxBodySize")
+			}
+		}()
+		value, opError := controller.MaxBodySize(*dataRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "MaxBodySize")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MaxBodySize'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MaxBodySize",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "MaxBodySize")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "MaxBodySize")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "MaxBodySize")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "MaxBodySize")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/max-body-size")), e2EcontrollerMaxBodySizeHandler)
+	e2EcontrollerIdempotentErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentRoute := withBasePath(basePath, "/e2e/idempotent")
+	e2EcontrollerIdempotentHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"Idempotent",
+			"E2EController",
+			"POST",
+			e2EcontrollerIdempotentRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "Idempotent")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "Idempotent")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed := hashRequestBody(w, req, "Idempotent", 0)
+		if !isBodyHashed {
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "Idempotent")
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		idempotentWriter, completeIdempotentRequest := beginIdempotentRequest(w, req, "Idempotent", idempotentRequestHash, e2EcontrollerIdempotentIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return
+		}
+		w = idempotentWriter
+		defer completeIdempotentRequest()
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "Idempotent")
+			}
+		}()
+		value, opError := controller.Idempotent()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "Idempotent")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Idempotent'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Idempotent",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "Idempotent")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "Idempotent")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "Idempotent")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "Idempotent")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/idempotent")), e2EcontrollerIdempotentHandler)
+	e2EcontrollerIdempotentWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentWithBodyIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentWithBodyRoute := withBasePath(basePath, "/e2e/idempotent-with-body")
+	e2EcontrollerIdempotentWithBodyHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"IdempotentWithBody",
+			"E2EController",
+			"POST",
+			e2EcontrollerIdempotentWithBodyRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "IdempotentWithBody")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "IdempotentWithBody")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed := hashRequestBody(w, req, "IdempotentWithBody", 0)
+		if !isBodyHashed {
+			return
+		}
+		var conversionErr error
+		var dataRawPtr *Param5data.BodyInfo = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "IdempotentWithBody",
+						"parameter": "data",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/IdempotentWithBody",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "IdempotentWithBody")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "IdempotentWithBody")
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		idempotentWriter, completeIdempotentRequest := beginIdempotentRequest(w, req, "IdempotentWithBody", idempotentRequestHash, e2EcontrollerIdempotentWithBodyIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return
+		}
+		w = idempotentWriter
+		defer completeIdempotentRequest()
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "IdempotentWithBody")
+			}
+		}()
+		value, opError := controller.IdempotentWithBody(*dataRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "IdempotentWithBody")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'IdempotentWithBody'",
+				Status:     statusCode,
+				Instance:   "/controller/error/IdempotentWithBody",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "IdempotentWithBody")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "IdempotentWithBody")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "IdempotentWithBody")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "IdempotentWithBody")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/idempotent-with-body")), e2EcontrollerIdempotentWithBodyHandler)
+	e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecuritySameMethodRoute := withBasePath(basePath, "/e2e/with-two-security-same-method")
+	e2EcontrollerWithTwoSecuritySameMethodHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"WithTwoSecuritySameMethod",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithTwoSecuritySameMethodRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "WithTwoSecuritySameMethod")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"write",
+								"read",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithTwoSecuritySameMethod")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("x-test-scopes")
+		_, isheaderParamExists := req.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			w.Header().Set("x-RunValidatorExtension", "WithTwoSecuritySameMethod")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "WithTwoSecuritySameMethod")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "WithTwoSecuritySameMethod")
+			}
+		}()
+		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "WithTwoSecuritySameMethod")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithTwoSecuritySameMethod",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "WithTwoSecuritySameMethod")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "WithTwoSecuritySameMethod")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "WithTwoSecuritySameMethod")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "WithTwoSecuritySameMethod")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/with-two-security-same-method")), e2EcontrollerWithTwoSecuritySameMethodHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/with-two-security-same-method")), e2EcontrollerWithTwoSecuritySameMethodHandler)
+	e2EcontrollerDefaultErrorErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorRoute := withBasePath(basePath, "/e2e/default-error")
+	e2EcontrollerDefaultErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"DefaultError",
+			"E2EController",
+			"GET",
+			e2EcontrollerDefaultErrorRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "DefaultError")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "DefaultError")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "DefaultError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "DefaultError")
+			}
+		}()
+		opError := controller.DefaultError()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "DefaultError")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DefaultError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DefaultError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "DefaultError")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "DefaultError")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "DefaultError")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "DefaultError")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/default-error")), e2EcontrollerDefaultErrorHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/default-error")), e2EcontrollerDefaultErrorHandler)
+	e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorWithPayloadRoute := withBasePath(basePath, "/e2e/default-error-with-payload")
+	e2EcontrollerDefaultErrorWithPayloadHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"DefaultErrorWithPayload",
+			"E2EController",
+			"GET",
+			e2EcontrollerDefaultErrorWithPayloadRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "DefaultErrorWithPayload")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "DefaultErrorWithPayload")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "DefaultErrorWithPayload")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "DefaultErrorWithPayload")
+			}
+		}()
+		value, opError := controller.DefaultErrorWithPayload()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "DefaultErrorWithPayload")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DefaultErrorWithPayload",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "DefaultErrorWithPayload")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "DefaultErrorWithPayload")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "DefaultErrorWithPayload")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "DefaultErrorWithPayload")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/default-error-with-payload")), e2EcontrollerDefaultErrorWithPayloadHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/default-error-with-payload")), e2EcontrollerDefaultErrorWithPayloadHandler)
+	e2EcontrollerInjectedDependencyErrorStatusCodes := []int{}
+	e2EcontrollerInjectedDependencyRoute := withBasePath(basePath, "/e2e/injected-dependency")
+	e2EcontrollerInjectedDependencyHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"InjectedDependency",
+			"E2EController",
+			"GET",
+			e2EcontrollerInjectedDependencyRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "InjectedDependency")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "InjectedDependency")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "InjectedDependency")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "InjectedDependency")
+			}
+		}()
+		value, opError := controller.InjectedDependency()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "InjectedDependency")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'InjectedDependency'",
+				Status:     statusCode,
+				Instance:   "/controller/error/InjectedDependency",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "InjectedDependency")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "InjectedDependency")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "InjectedDependency")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "InjectedDependency")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/injected-dependency")), e2EcontrollerInjectedDependencyHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/injected-dependency")), e2EcontrollerInjectedDependencyHandler)
+	e2EcontrollerMappedErrorErrorStatusCodes := []int{404}
+	e2EcontrollerMappedErrorRoute := withBasePath(basePath, "/e2e/mapped-error")
+	e2EcontrollerMappedErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"MappedError",
+			"E2EController",
+			"GET",
+			e2EcontrollerMappedErrorRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "MappedError")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "MappedError")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "MappedError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "MappedError")
+			}
+		}()
+		opError := controller.MappedError()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "MappedError")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerMappedErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MappedError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MappedError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedErrorErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "MappedError")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "MappedError")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "MappedError")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "MappedError")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/mapped-error")), e2EcontrollerMappedErrorHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/mapped-error")), e2EcontrollerMappedErrorHandler)
+	e2EcontrollerUndeclaredMappedErrorErrorStatusCodes := []int{}
+	e2EcontrollerUndeclaredMappedErrorRoute := withBasePath(basePath, "/e2e/undeclared-mapped-error")
+	e2EcontrollerUndeclaredMappedErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"UndeclaredMappedError",
+			"E2EController",
+			"GET",
+			e2EcontrollerUndeclaredMappedErrorRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "UndeclaredMappedError")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "UndeclaredMappedError")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "UndeclaredMappedError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "UndeclaredMappedError")
+			}
+		}()
+		opError := controller.UndeclaredMappedError()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "UndeclaredMappedError")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'UndeclaredMappedError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/UndeclaredMappedError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "UndeclaredMappedError")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "UndeclaredMappedError")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "UndeclaredMappedError")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "UndeclaredMappedError")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/undeclared-mapped-error")), e2EcontrollerUndeclaredMappedErrorHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/undeclared-mapped-error")), e2EcontrollerUndeclaredMappedErrorHandler)
+	e2EcontrollerMappedTypedErrorErrorStatusCodes := []int{409}
+	e2EcontrollerMappedTypedErrorRoute := withBasePath(basePath, "/e2e/mapped-typed-error")
+	e2EcontrollerMappedTypedErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"MappedTypedError",
+			"E2EController",
+			"GET",
+			e2EcontrollerMappedTypedErrorRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "MappedTypedError")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "MappedTypedError")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "MappedTypedError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "MappedTypedError")
+			}
+		}()
+		value, opError := controller.MappedTypedError()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "MappedTypedError")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MappedTypedError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MappedTypedError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "MappedTypedError")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "MappedTypedError")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "MappedTypedError")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "MappedTypedError")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/mapped-typed-error")), e2EcontrollerMappedTypedErrorHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/mapped-typed-error")), e2EcontrollerMappedTypedErrorHandler)
+	e2EcontrollerPanicErrorStatusCodes := []int{}
+	e2EcontrollerPanicRoute := withBasePath(basePath, "/e2e/panic")
+	e2EcontrollerPanicHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"Panic",
+			"E2EController",
+			"GET",
+			e2EcontrollerPanicRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "Panic")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "Panic")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "Panic")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "Panic")
+			}
+		}()
+		value, opError := controller.Panic()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "Panic")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPanicErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Panic'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Panic",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPanicErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "Panic")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "Panic")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "Panic")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "Panic")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/panic")), e2EcontrollerPanicHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/panic")), e2EcontrollerPanicHandler)
+	e2EcontrollerCustomErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomErrorRoute := withBasePath(basePath, "/e2e/custom-error")
+	e2EcontrollerCustomErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"CustomError",
+			"E2EController",
+			"GET",
+			e2EcontrollerCustomErrorRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "CustomError")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "CustomError")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "CustomError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "CustomError")
+			}
+		}()
+		opError := controller.CustomError()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "CustomError")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomErrorErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
+		if opError != emptyErr {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(opError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "CustomError")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "CustomError")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "CustomError")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/custom-error")), e2EcontrollerCustomErrorHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/custom-error")), e2EcontrollerCustomErrorHandler)
+	e2EcontrollerCustomPtrErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomPtrErrorRoute := withBasePath(basePath, "/e2e/custom-error-ptr")
+	e2EcontrollerCustomPtrErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"CustomPtrError",
+			"E2EController",
+			"GET",
+			e2EcontrollerCustomPtrErrorRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "CustomPtrError")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "CustomPtrError")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "CustomPtrError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "CustomPtrError")
+			}
+		}()
+		opError := controller.CustomPtrError()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "CustomPtrError")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomPtrErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(opError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "CustomPtrError")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "CustomPtrError")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "CustomPtrError")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/custom-error-ptr")), e2EcontrollerCustomPtrErrorHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/custom-error-ptr")), e2EcontrollerCustomPtrErrorHandler)
+	e2EcontrollerError503ErrorStatusCodes := []int{}
+	e2EcontrollerError503Route := withBasePath(basePath, "/e2e/503-error-code")
+	e2EcontrollerError503Handler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"Error503",
+			"E2EController",
+			"GET",
+			e2EcontrollerError503Route,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "Error503")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "Error503")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "Error503")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "Error503")
+			}
+		}()
+		opError := controller.Error503()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "Error503")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerError503ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Error503'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Error503",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerError503ErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "Error503")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "Error503")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "Error503")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "Error503")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/503-error-code")), e2EcontrollerError503Handler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/503-error-code")), e2EcontrollerError503Handler)
+	e2EcontrollerCustomError503ErrorStatusCodes := []int{}
+	e2EcontrollerCustomError503Route := withBasePath(basePath, "/e2e/custom-error-503")
+	e2EcontrollerCustomError503Handler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"CustomError503",
+			"E2EController",
+			"GET",
+			e2EcontrollerCustomError503Route,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "CustomError503")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "CustomError503")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "CustomError503")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "CustomError503")
+			}
+		}()
+		opError := controller.CustomError503()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "CustomError503")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomError503ErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
+		if opError != emptyErr {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(opError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "CustomError503")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "CustomError503")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "CustomError503")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/custom-error-503")), e2EcontrollerCustomError503Handler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/custom-error-503")), e2EcontrollerCustomError503Handler)
+	e2EcontrollerContextAccessErrorStatusCodes := []int{}
+	e2EcontrollerContextAccessRoute := withBasePath(basePath, "/e2e/context-access")
+	e2EcontrollerContextAccessHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ContextAccess",
+			"E2EController",
+			"GET",
+			e2EcontrollerContextAccessRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "ContextAccess")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ContextAccess")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "ContextAccess")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ContextAccess")
+			}
+		}()
+		opError := controller.ContextAccess()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "ContextAccess")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextAccessErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ContextAccess'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ContextAccess",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextAccessErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "ContextAccess")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "ContextAccess")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "ContextAccess")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "ContextAccess")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/context-access")), e2EcontrollerContextAccessHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/context-access")), e2EcontrollerContextAccessHandler)
+	e2EcontrollerGetErrorStatusCodes := []int{}
+	e2EcontrollerGetRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerGetHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"Get",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "Get")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "Get")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "Get")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "Get")
+			}
+		}()
+		opError := controller.Get()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "Get")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerGetErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Get'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Get",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "Get")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "Get")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "Get")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "Get")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerGetHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerGetHandler)
+	e2EcontrollerPostErrorStatusCodes := []int{}
+	e2EcontrollerPostRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerPostHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"Post",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "Post")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "Post")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "Post")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "Post")
+			}
+		}()
+		opError := controller.Post()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "Post")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPostErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Post'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Post",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "Post")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "Post")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "Post")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "Post")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPostHandler)
+	e2EcontrollerPutErrorStatusCodes := []int{}
+	e2EcontrollerPutRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerPutHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"Put",
+			"E2EController",
+			"PUT",
+			e2EcontrollerPutRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "Put")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "Put")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "Put")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "Put")
+			}
+		}()
+		opError := controller.Put()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "Put")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPutErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Put'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Put",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPutErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "Put")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "Put")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "Put")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "Put")
+	}
+	engine.MethodFunc("PUT", toChiUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPutHandler)
+	e2EcontrollerDeleteErrorStatusCodes := []int{}
+	e2EcontrollerDeleteRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerDeleteHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"Delete",
+			"E2EController",
+			"DELETE",
+			e2EcontrollerDeleteRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "Delete")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "Delete")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "Delete")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "Delete")
+			}
+		}()
+		opError := controller.Delete()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "Delete")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDeleteErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Delete'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Delete",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeleteErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "Delete")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "Delete")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "Delete")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "Delete")
+	}
+	engine.MethodFunc("DELETE", toChiUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerDeleteHandler)
+	e2EcontrollerPatchErrorStatusCodes := []int{}
+	e2EcontrollerPatchRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerPatchHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"Patch",
+			"E2EController",
+			"PATCH",
+			e2EcontrollerPatchRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "Patch")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "Patch")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "Patch")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "Patch")
+			}
+		}()
+		opError := controller.Patch()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "Patch")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPatchErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Patch'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Patch",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPatchErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "Patch")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "Patch")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "Patch")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "Patch")
+	}
+	engine.MethodFunc("PATCH", toChiUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPatchHandler)
+	e2EcontrollerTemplateContext1ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext1Route := withBasePath(basePath, "/e2e/template-context-1")
+	e2EcontrollerTemplateContext1Handler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TemplateContext1",
+			"E2EController",
+			"GET",
+			e2EcontrollerTemplateContext1Route,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "TemplateContext1")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TemplateContext1")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "TemplateContext1")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TemplateContext1")
+			}
+		}()
+		value, opError := controller.TemplateContext1()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "TemplateContext1")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TemplateContext1'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TemplateContext1",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "TemplateContext1")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "TemplateContext1")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "TemplateContext1")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "TemplateContext1")
+		w.Header().Set("x-level", "high")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/template-context-1")), e2EcontrollerTemplateContext1Handler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/template-context-1")), e2EcontrollerTemplateContext1Handler)
+	e2EcontrollerTemplateContext2ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext2Route := withBasePath(basePath, "/e2e/template-context-2")
+	e2EcontrollerTemplateContext2Handler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TemplateContext2",
+			"E2EController",
+			"GET",
+			e2EcontrollerTemplateContext2Route,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "TemplateContext2")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TemplateContext2")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "TemplateContext2")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TemplateContext2")
+			}
+		}()
+		value, opError := controller.TemplateContext2()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "TemplateContext2")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TemplateContext2'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TemplateContext2",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "TemplateContext2")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "TemplateContext2")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "TemplateContext2")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "TemplateContext2")
+		w.Header().Set("x-mode", "100")
+		w.Header().Set("x-level", "low")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/template-context-2")), e2EcontrollerTemplateContext2Handler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/template-context-2")), e2EcontrollerTemplateContext2Handler)
+	e2EcontrollerTestFormErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormRoute := withBasePath(basePath, "/e2e/form")
+	e2EcontrollerTestFormHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TestForm",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestFormRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "TestForm")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TestForm")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		req.ParseForm()
+		var item1RawPtr *string = nil
+		item1RawArr, isitem1Exists := req.PostForm["item1"]
+		item1Raw := ""
+		if isitem1Exists {
+			item1Raw = item1RawArr[0] // Get first value since form values are slices
+		}
+		if isitem1Exists {
+			item1 := item1Raw
+			item1RawPtr = &item1
+		}
+		if validatorErr := validatorInstance.Var(item1RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "item1"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
+			w.Header().Set("x-RunValidatorExtension", "TestForm")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		req.ParseForm()
+		var item2RawPtr *string = nil
+		item2RawArr, isitem2Exists := req.PostForm["item2"]
+		item2Raw := ""
+		if isitem2Exists {
+			item2Raw = item2RawArr[0] // Get first value since form values are slices
+		}
+		if isitem2Exists {
+			item2 := item2Raw
+			item2RawPtr = &item2
+		}
+		if validatorErr := validatorInstance.Var(item2RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "item2"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
+			w.Header().Set("x-RunValidatorExtension", "TestForm")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "TestForm")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TestForm")
+			}
+		}()
+		value, opError := controller.TestForm(*item1RawPtr, *item2RawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "TestForm")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TestForm'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TestForm",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "TestForm")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "TestForm")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "TestForm")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "TestForm")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/form")), e2EcontrollerTestFormHandler)
+	e2EcontrollerTestFormExtraErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormExtraRoute := withBasePath(basePath, "/e2e/form-extra")
+	e2EcontrollerTestFormExtraHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TestFormExtra",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestFormExtraRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "TestFormExtra")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TestFormExtra")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		req.ParseForm()
+		var item1RawPtr *int64 = nil
+		item1RawArr, isitem1Exists := req.PostForm["item1"]
+		item1Raw := ""
+		if isitem1Exists {
+			item1Raw = item1RawArr[0] // Get first value since form values are slices
+		}
+		if isitem1Exists {
+			item1, conversionErr := parseIntegerParam[int64](item1Raw)
+			if conversionErr != nil {
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item1",
+					"form",
+					"/item1",
+					"int64",
+					reflect.TypeOf(item1Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestFormExtra")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+			item1RawPtr = &item1
+		}
+		if validatorErr := validatorInstance.Var(item1RawPtr, "required,gte=80"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "item1"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
+			w.Header().Set("x-RunValidatorExtension", "TestFormExtra")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		req.ParseForm()
+		var item2RawPtr *string = nil
+		item2RawArr, isitem2Exists := req.PostForm["item2"]
+		item2Raw := ""
+		if isitem2Exists {
+			item2Raw = item2RawArr[0] // Get first value since form values are slices
+		}
+		if isitem2Exists {
+			item2 := item2Raw
+			item2RawPtr = &item2
+		}
+		if validatorErr := validatorInstance.Var(item2RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "item2"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
+			w.Header().Set("x-RunValidatorExtension", "TestFormExtra")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var item3RawPtr *int64 = nil
+		item3Raw := req.URL.Query().Get("item3")
+		isitem3Exists := req.URL.Query().Has("item3")
+		if isitem3Exists {
+			item3, conversionErr := parseIntegerParam[int64](item3Raw)
+			if conversionErr != nil {
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item3",
+					"query",
+					"/item3",
+					"int64",
+					reflect.TypeOf(item3Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestFormExtra")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+			item3RawPtr = &item3
+		}
+		if validatorErr := validatorInstance.Var(item3RawPtr, "required,gte=80"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "item3"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/item3", languages)
+			w.Header().Set("x-RunValidatorExtension", "TestFormExtra")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "TestFormExtra")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TestFormExtra")
+			}
+		}()
+		value, opError := controller.TestFormExtra(*item1RawPtr, *item2RawPtr, *item3RawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "TestFormExtra")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TestFormExtra'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TestFormExtra",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "TestFormExtra")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "TestFormExtra")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "TestFormExtra")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "TestFormExtra")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/form-extra")), e2EcontrollerTestFormExtraHandler)
+	e2EcontrollerTestResponseValidationErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationRoute := withBasePath(basePath, "/e2e/test-response-validation")
+	e2EcontrollerTestResponseValidationHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TestResponseValidation",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestResponseValidationRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "TestResponseValidation")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TestResponseValidation")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "TestResponseValidation")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TestResponseValidation")
+			}
+		}()
+		value, opError := controller.TestResponseValidation()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "TestResponseValidation")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TestResponseValidation'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TestResponseValidation",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "TestResponseValidation")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "TestResponseValidation")
+		var outputValidationErr error
+		outputValidationErr = validateDataRecursive(value, "")
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'TestResponseValidation'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/TestResponseValidation",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "TestResponseValidation")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "TestResponseValidation")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/test-response-validation")), e2EcontrollerTestResponseValidationHandler)
+	e2EcontrollerTestResponseValidationPtrErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationPtrRoute := withBasePath(basePath, "/e2e/test-response-validation-ptr")
+	e2EcontrollerTestResponseValidationPtrHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TestResponseValidationPtr",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestResponseValidationPtrRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "TestResponseValidationPtr")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TestResponseValidationPtr")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "TestResponseValidationPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TestResponseValidationPtr")
+			}
+		}()
+		value, opError := controller.TestResponseValidationPtr()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "TestResponseValidationPtr")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TestResponseValidationPtr'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TestResponseValidationPtr",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "TestResponseValidationPtr")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "TestResponseValidationPtr")
+		var outputValidationErr error
+		if value == nil {
+			outputValidationErr = fmt.Errorf("Response payload is nil")
+		} else {
+			outputValidationErr = validateDataRecursive(value, "")
+		}
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'TestResponseValidationPtr'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/TestResponseValidationPtr",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "TestResponseValidationPtr")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "TestResponseValidationPtr")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/test-response-validation-ptr")), e2EcontrollerTestResponseValidationPtrHandler)
+	e2EcontrollerTestResponseValidationNullErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationNullRoute := withBasePath(basePath, "/e2e/test-response-validation-null")
+	e2EcontrollerTestResponseValidationNullHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TestResponseValidationNull",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestResponseValidationNullRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "TestResponseValidationNull")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TestResponseValidationNull")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "TestResponseValidationNull")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TestResponseValidationNull")
+			}
+		}()
+		value, opError := controller.TestResponseValidationNull()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "TestResponseValidationNull")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TestResponseValidationNull'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TestResponseValidationNull",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "TestResponseValidationNull")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "TestResponseValidationNull")
+		var outputValidationErr error
+		if value == nil {
+			outputValidationErr = fmt.Errorf("Response payload is nil")
+		} else {
+			outputValidationErr = validateDataRecursive(value, "")
+		}
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'TestResponseValidationNull'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/TestResponseValidationNull",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "TestResponseValidationNull")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "TestResponseValidationNull")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/test-response-validation-null")), e2EcontrollerTestResponseValidationNullHandler)
+	e2EcontrollerTestPrimitiveConversionsErrorStatusCodes := []int{500}
+	e2EcontrollerTestPrimitiveConversionsRoute := withBasePath(basePath, "/e2e/test-primitive-conversions")
+	e2EcontrollerTestPrimitiveConversionsHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TestPrimitiveConversions",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestPrimitiveConversionsRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "TestPrimitiveConversions")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TestPrimitiveConversions")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var value1RawPtr *int64 = nil
+		value1Raw := req.URL.Query().Get("value1")
+		isvalue1Exists := req.URL.Query().Has("value1")
+		if isvalue1Exists {
+			value1, conversionErr := parseIntegerParam[int64](value1Raw)
+			if conversionErr != nil {
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value1",
+					"query",
+					"/value1",
+					"int64",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+			value1RawPtr = &value1
+		}
+		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "value1"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
+			w.Header().Set("x-RunValidatorExtension", "TestPrimitiveConversions")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var value2RawPtr *bool = nil
+		value2Raw := req.URL.Query().Get("value2")
+		isvalue2Exists := req.URL.Query().Has("value2")
+		if isvalue2Exists {
+			value2, conversionErr := strconv.ParseBool(value2Raw)
+			if conversionErr != nil {
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value2",
+					"query",
+					"/value2",
+					"bool",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+			value2RawPtr = &value2
+		}
+		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "value2"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
+			w.Header().Set("x-RunValidatorExtension", "TestPrimitiveConversions")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var value3RawPtr *int = nil
+		value3Raw := req.URL.Query().Get("value3")
+		isvalue3Exists := req.URL.Query().Has("value3")
+		if isvalue3Exists {
+			value3, conversionErr := parseIntegerParam[int](value3Raw)
+			if conversionErr != nil {
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value3",
+					"query",
+					"/value3",
+					"int",
+					reflect.TypeOf(value3Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+			value3RawPtr = &value3
+		}
+		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "value3"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value3", languages)
+			w.Header().Set("x-RunValidatorExtension", "TestPrimitiveConversions")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var value4RawPtr *float64 = nil
+		value4Raw := req.URL.Query().Get("value4")
+		isvalue4Exists := req.URL.Query().Has("value4")
+		if isvalue4Exists {
+			value4, conversionErr := parseFloatParam[float64](value4Raw)
+			if conversionErr != nil {
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value4",
+					"query",
+					"/value4",
+					"float64",
+					reflect.TypeOf(value4Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+			value4RawPtr = &value4
+		}
+		if validatorErr := validatorInstance.Var(value4RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "value4"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value4", languages)
+			w.Header().Set("x-RunValidatorExtension", "TestPrimitiveConversions")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "TestPrimitiveConversions")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TestPrimitiveConversions")
+			}
+		}()
+		value, opError := controller.TestPrimitiveConversions(*value1RawPtr, *value2RawPtr, *value3RawPtr, *value4RawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "TestPrimitiveConversions")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TestPrimitiveConversions'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TestPrimitiveConversions",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "TestPrimitiveConversions")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "TestPrimitiveConversions")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "TestPrimitiveConversions")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "TestPrimitiveConversions")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/test-primitive-conversions")), e2EcontrollerTestPrimitiveConversionsHandler)
+	e2EcontrollerTestEnumsErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsRoute := withBasePath(basePath, "/e2e/test-enums")
+	e2EcontrollerTestEnumsHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TestEnums",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestEnumsRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "TestEnums")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TestEnums")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
+		value1Raw := req.URL.Query().Get("value1")
+		isvalue1Exists := req.URL.Query().Has("value1")
+		if isvalue1Exists {
+			value1 := value1Raw
+			switch value1Raw {
+			case "active", "inactive":
+				value1Var := Param15value1.StatusEnumeration(value1)
+				value1RawPtr = &value1Var
+			default:
+				conversionErr := fmt.Errorf("value1 must be one of \"active, inactive\" options only but got \"%s\"", value1Raw)
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value1",
+					"query",
+					"/value1",
+					"StatusEnumeration",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnums")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+		}
+		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "value1"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
+			w.Header().Set("x-RunValidatorExtension", "TestEnums")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var value2RawPtr *Param4value2.NumberEnumeration = nil
+		value2Raw := req.URL.Query().Get("value2")
+		isvalue2Exists := req.URL.Query().Has("value2")
+		if isvalue2Exists {
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
+			if conversionErr != nil {
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value2",
+					"query",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnums")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+			switch value2Raw {
+			case "1", "2":
+				value2Var := Param4value2.NumberEnumeration(value2)
+				value2RawPtr = &value2Var
+			default:
+				conversionErr := fmt.Errorf("value2 must be one of \"1, 2\" options only but got \"%s\"", value2Raw)
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value2",
+					"query",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnums")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+		}
+		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "value2"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
+			w.Header().Set("x-RunValidatorExtension", "TestEnums")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var value3RawPtr *Param14value3.ObjectWithEnum = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &value3RawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "TestEnums",
+						"parameter": "value3",
+						"type":      "ObjectWithEnum",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/TestEnums",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "TestEnums")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "TestEnums")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TestEnums")
+			}
+		}()
+		value, opError := controller.TestEnums(*value1RawPtr, *value2RawPtr, *value3RawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "TestEnums")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TestEnums'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TestEnums",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "TestEnums")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "TestEnums")
+		var outputValidationErr error
+		outputValidationErr = validateDataRecursive(value, "")
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'TestEnums'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/TestEnums",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "TestEnums")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "TestEnums")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/test-enums")), e2EcontrollerTestEnumsHandler)
+	e2EcontrollerTestEnumsInAllErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsInAllRoute := withBasePath(basePath, "/e2e/test-enums-in-all/{value1}")
+	e2EcontrollerTestEnumsInAllHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TestEnumsInAll",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestEnumsInAllRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "TestEnumsInAll")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TestEnumsInAll")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
+		value1Raw := chi.URLParam(req, "value1")
+		isvalue1Exists := true // if parameter is in route but not provided, it won't reach this handler
+		if isvalue1Exists {
+			value1 := value1Raw
+			switch value1Raw {
+			case "active", "inactive":
+				value1Var := Param15value1.StatusEnumeration(value1)
+				value1RawPtr = &value1Var
+			default:
+				conversionErr := fmt.Errorf("value1 must be one of \"active, inactive\" options only but got \"%s\"", value1Raw)
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value1",
+					"path",
+					"/value1",
+					"StatusEnumeration",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+		}
+		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "value1"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/value1", languages)
+			w.Header().Set("x-RunValidatorExtension", "TestEnumsInAll")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var value2RawPtr *Param4value2.NumberEnumeration = nil
+		value2Raw := req.Header.Get("value2")
+		_, isvalue2Exists := req.Header["value2"]
+		if !isvalue2Exists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("value2")
+			isvalue2Exists = len(headerValues) > 0
+		}
+		if isvalue2Exists {
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
+			if conversionErr != nil {
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value2",
+					"header",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+			switch value2Raw {
+			case "1", "2":
+				value2Var := Param4value2.NumberEnumeration(value2)
+				value2RawPtr = &value2Var
+			default:
+				conversionErr := fmt.Errorf("value2 must be one of \"1, 2\" options only but got \"%s\"", value2Raw)
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value2",
+					"header",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+		}
+		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "value2"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/value2", languages)
+			w.Header().Set("x-RunValidatorExtension", "TestEnumsInAll")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		req.ParseForm()
+		var value3RawPtr *Param15value3.StatusEnumeration = nil
+		value3RawArr, isvalue3Exists := req.PostForm["value3"]
+		value3Raw := ""
+		if isvalue3Exists {
+			value3Raw = value3RawArr[0] // Get first value since form values are slices
+		}
+		if isvalue3Exists {
+			value3 := value3Raw
+			switch value3Raw {
+			case "active", "inactive":
+				value3Var := Param15value3.StatusEnumeration(value3)
+				value3RawPtr = &value3Var
+			default:
+				conversionErr := fmt.Errorf("value3 must be one of \"active, inactive\" options only but got \"%s\"", value3Raw)
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value3",
+					"form",
+					"/value3",
+					"StatusEnumeration",
+					reflect.TypeOf(value3Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+		}
+		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "value3"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/value3", languages)
+			w.Header().Set("x-RunValidatorExtension", "TestEnumsInAll")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "TestEnumsInAll")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TestEnumsInAll")
+			}
+		}()
+		value, opError := controller.TestEnumsInAll(*value1RawPtr, *value2RawPtr, *value3RawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "TestEnumsInAll")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TestEnumsInAll'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TestEnumsInAll",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "TestEnumsInAll")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "TestEnumsInAll")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "TestEnumsInAll")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "TestEnumsInAll")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/test-enums-in-all/{value1}")), e2EcontrollerTestEnumsInAllHandler)
+	e2EcontrollerTestEnumsOptionalErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsOptionalRoute := withBasePath(basePath, "/e2e/test-enums-optional")
+	e2EcontrollerTestEnumsOptionalHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TestEnumsOptional",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestEnumsOptionalRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "TestEnumsOptional")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TestEnumsOptional")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
+		value1Raw := req.Header.Get("value1")
+		_, isvalue1Exists := req.Header["value1"]
+		if !isvalue1Exists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("value1")
+			isvalue1Exists = len(headerValues) > 0
+		}
+		if isvalue1Exists {
+			value1 := value1Raw
+			switch value1Raw {
+			case "active", "inactive":
+				value1Var := Param15value1.StatusEnumeration(value1)
+				value1RawPtr = &value1Var
+			default:
+				conversionErr := fmt.Errorf("value1 must be one of \"active, inactive\" options only but got \"%s\"", value1Raw)
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsOptional",
+					"value1",
+					"header",
+					"/value1",
+					"StatusEnumeration",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnumsOptional")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "TestEnumsOptional")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TestEnumsOptional")
+			}
+		}()
+		value, opError := controller.TestEnumsOptional(value1RawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "TestEnumsOptional")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TestEnumsOptional'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TestEnumsOptional",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "TestEnumsOptional")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "TestEnumsOptional")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "TestEnumsOptional")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "TestEnumsOptional")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/test-enums-optional")), e2EcontrollerTestEnumsOptionalHandler)
+	e2EcontrollerExternalPackagesErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesRoute := withBasePath(basePath, "/e2e/external-packages")
+	e2EcontrollerExternalPackagesHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ExternalPackages",
+			"E2EController",
+			"POST",
+			e2EcontrollerExternalPackagesRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "ExternalPackages")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ExternalPackages")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var unitRawPtr *Param17unit.LengthUnits = nil
+		unitRaw := req.URL.Query().Get("unit")
+		isunitExists := req.URL.Query().Has("unit")
+		if isunitExists {
+			unit := unitRaw
+			switch unitRaw {
+			case "Angstrom", "AstronomicalUnit", "Centimeter", "Chain", "DataMile", "Decameter", "Decimeter", "DtpPica", "DtpPoint", "Fathom", "Femtometer", "Foot", "Gigameter", "Hand", "Hectometer", "Inch", "Kilofoot", "KilolightYear", "Kilometer", "Kiloparsec", "Kiloyard", "LightYear", "MegalightYear", "Megameter", "Megaparsec", "Meter", "Microinch", "Micrometer", "Mil", "Mile", "Millimeter", "Nanometer", "NauticalMile", "Parsec", "Picometer", "PrinterPica", "PrinterPoint", "Shackle", "SolarRadius", "Twip", "UsSurveyFoot", "Yard":
+				unitVar := Param17unit.LengthUnits(unit)
+				unitRawPtr = &unitVar
+			default:
+				conversionErr := fmt.Errorf("unit must be one of \"Angstrom, AstronomicalUnit, Centimeter, Chain, DataMile, Decameter, Decimeter, DtpPica, DtpPoint, Fathom, Femtometer, Foot, Gigameter, Hand, Hectometer, Inch, Kilofoot, KilolightYear, Kilometer, Kiloparsec, Kiloyard, LightYear, MegalightYear, Megameter, Megaparsec, Meter, Microinch, Micrometer, Mil, Mile, Millimeter, Nanometer, NauticalMile, Parsec, Picometer, PrinterPica, PrinterPoint, Shackle, SolarRadius, Twip, UsSurveyFoot, Yard\" options only but got \"%s\"", unitRaw)
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"ExternalPackages",
+					"unit",
+					"query",
+					"/unit",
+					"LengthUnits",
+					reflect.TypeOf(unitRaw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				w.Header().Set("x-ParamsValidationErrorResponseExtension", "ExternalPackages")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+		}
+		var dataRawPtr *Param16data.LengthDto = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackages",
+						"parameter": "data",
+						"type":      "LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/ExternalPackages",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "ExternalPackages")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "ExternalPackages")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ExternalPackages")
+			}
+		}()
+		value, opError := controller.ExternalPackages(unitRawPtr, *dataRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "ExternalPackages")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ExternalPackages'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ExternalPackages",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "ExternalPackages")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "ExternalPackages")
+		var outputValidationErr error
+		outputValidationErr = validateDataRecursive(value, "")
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'ExternalPackages'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/ExternalPackages",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "ExternalPackages")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "ExternalPackages")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/external-packages")), e2EcontrollerExternalPackagesHandler)
+	e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesUniqueInStructRoute := withBasePath(basePath, "/e2e/external-packages-unique-in-struct")
+	e2EcontrollerExternalPackagesUniqueInStructHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ExternalPackagesUniqueInStruct",
+			"E2EController",
+			"POST",
+			e2EcontrollerExternalPackagesUniqueInStructRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "ExternalPackagesUniqueInStruct")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ExternalPackagesUniqueInStruct")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var dataRawPtr *Param18data.UniqueExternalUsage = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesUniqueInStruct",
+						"parameter": "data",
+						"type":      "UniqueExternalUsage",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/ExternalPackagesUniqueInStruct",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "ExternalPackagesUniqueInStruct")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "ExternalPackagesUniqueInStruct")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ExternalPackagesUniqueInStruct")
+			}
+		}()
+		value, opError := controller.ExternalPackagesUniqueInStruct(*dataRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "ExternalPackagesUniqueInStruct")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ExternalPackagesUniqueInStruct'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ExternalPackagesUniqueInStruct",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "ExternalPackagesUniqueInStruct")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "ExternalPackagesUniqueInStruct")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "ExternalPackagesUniqueInStruct")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "ExternalPackagesUniqueInStruct")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/external-packages-unique-in-struct")), e2EcontrollerExternalPackagesUniqueInStructHandler)
+	e2EcontrollerExternalPackagesValidationErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesValidationRoute := withBasePath(basePath, "/e2e/external-packages-validation")
+	e2EcontrollerExternalPackagesValidationHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ExternalPackagesValidation",
+			"E2EController",
+			"POST",
+			e2EcontrollerExternalPackagesValidationRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "ExternalPackagesValidation")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ExternalPackagesValidation")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var unitRawPtr *Param17unit.LengthUnits = nil
+		unitRaw := req.URL.Query().Get("unit")
+		isunitExists := req.URL.Query().Has("unit")
+		if isunitExists {
+			unit := unitRaw
+			switch unitRaw {
+			case "Angstrom", "AstronomicalUnit", "Centimeter", "Chain", "DataMile", "Decameter", "Decimeter", "DtpPica", "DtpPoint", "Fathom", "Femtometer", "Foot", "Gigameter", "Hand", "Hectometer", "Inch", "Kilofoot", "KilolightYear", "Kilometer", "Kiloparsec", "Kiloyard", "LightYear", "MegalightYear", "Megameter", "Megaparsec", "Meter", "Microinch", "Micrometer", "Mil", "Mile", "Millimeter", "Nanometer", "NauticalMile", "Parsec", "Picometer", "PrinterPica", "PrinterPoint", "Shackle", "SolarRadius", "Twip", "UsSurveyFoot", "Yard":
+				unitVar := Param17unit.LengthUnits(unit)
+				unitRawPtr = &unitVar
+			default:
+				conversionErr := fmt.Errorf("unit must be one of \"Angstrom, AstronomicalUnit, Centimeter, Chain, DataMile, Decameter, Decimeter, DtpPica, DtpPoint, Fathom, Femtometer, Foot, Gigameter, Hand, Hectometer, Inch, Kilofoot, KilolightYear, Kilometer, Kiloparsec, Kiloyard, LightYear, MegalightYear, Megameter, Megaparsec, Meter, Microinch, Micrometer, Mil, Mile, Millimeter, Nanometer, NauticalMile, Parsec, Picometer, PrinterPica, PrinterPoint, Shackle, SolarRadius, Twip, UsSurveyFoot, Yard\" options only but got \"%s\"", unitRaw)
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"ExternalPackagesValidation",
+					"unit",
+					"query",
+					"/unit",
+					"LengthUnits",
+					reflect.TypeOf(unitRaw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				w.Header().Set("x-ParamsValidationErrorResponseExtension", "ExternalPackagesValidation")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+		}
+		var dataRawPtr *Param19data.LengthDtoWithValidation = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesValidation",
+						"parameter": "data",
+						"type":      "LengthDtoWithValidation",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/ExternalPackagesValidation",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "ExternalPackagesValidation")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "ExternalPackagesValidation")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ExternalPackagesValidation")
+			}
+		}()
+		value, opError := controller.ExternalPackagesValidation(unitRawPtr, *dataRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "ExternalPackagesValidation")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ExternalPackagesValidation'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ExternalPackagesValidation",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "ExternalPackagesValidation")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "ExternalPackagesValidation")
+		var outputValidationErr error
+		outputValidationErr = validateDataRecursive(value, "")
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'ExternalPackagesValidation'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/ExternalPackagesValidation",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "ExternalPackagesValidation")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "ExternalPackagesValidation")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/external-packages-validation")), e2EcontrollerExternalPackagesValidationHandler)
+	e2EcontrollerArraysInBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInBodyAndResRoute := withBasePath(basePath, "/e2e/arrays-in-body-and-res")
+	e2EcontrollerArraysInBodyAndResHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ArraysInBodyAndRes",
+			"E2EController",
+			"POST",
+			e2EcontrollerArraysInBodyAndResRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "ArraysInBodyAndRes")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ArraysInBodyAndRes")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var dataRawPtr *[]Param16data.LengthDto = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInBodyAndRes",
+						"parameter": "data",
+						"type":      "[]LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/ArraysInBodyAndRes",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "ArraysInBodyAndRes")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "ArraysInBodyAndRes")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ArraysInBodyAndRes")
+			}
+		}()
+		value, opError := controller.ArraysInBodyAndRes(*dataRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "ArraysInBodyAndRes")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ArraysInBodyAndRes'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ArraysInBodyAndRes",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "ArraysInBodyAndRes")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "ArraysInBodyAndRes")
+		var outputValidationErr error
+		outputValidationErr = validateDataRecursive(value, "")
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'ArraysInBodyAndRes'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/ArraysInBodyAndRes",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "ArraysInBodyAndRes")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "ArraysInBodyAndRes")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/arrays-in-body-and-res")), e2EcontrollerArraysInBodyAndResHandler)
+	e2EcontrollerArraysInsideBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInsideBodyAndResRoute := withBasePath(basePath, "/e2e/arrays-inside-body-and-res")
+	e2EcontrollerArraysInsideBodyAndResHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ArraysInsideBodyAndRes",
+			"E2EController",
+			"POST",
+			e2EcontrollerArraysInsideBodyAndResRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "ArraysInsideBodyAndRes")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ArraysInsideBodyAndRes")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var dataRawPtr *[]Param20data.BlaBla = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInsideBodyAndRes",
+						"parameter": "data",
+						"type":      "[]BlaBla",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/ArraysInsideBodyAndRes",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "ArraysInsideBodyAndRes")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "ArraysInsideBodyAndRes")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ArraysInsideBodyAndRes")
+			}
+		}()
+		value, opError := controller.ArraysInsideBodyAndRes(dataRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "ArraysInsideBodyAndRes")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ArraysInsideBodyAndRes'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ArraysInsideBodyAndRes",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "ArraysInsideBodyAndRes")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "ArraysInsideBodyAndRes")
+		var outputValidationErr error
+		if value == nil {
+			outputValidationErr = fmt.Errorf("Response payload is nil")
+		} else {
+			outputValidationErr = validateDataRecursive(value, "")
+		}
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'ArraysInsideBodyAndRes'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/ArraysInsideBodyAndRes",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "ArraysInsideBodyAndRes")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "ArraysInsideBodyAndRes")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/arrays-inside-body-and-res")), e2EcontrollerArraysInsideBodyAndResHandler)
+	e2EcontrollerDeepArraysWithValidationErrorStatusCodes := []int{500}
+	e2EcontrollerDeepArraysWithValidationRoute := withBasePath(basePath, "/e2e/deep-arrays-with-validation")
+	e2EcontrollerDeepArraysWithValidationHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"DeepArraysWithValidation",
+			"E2EController",
+			"POST",
+			e2EcontrollerDeepArraysWithValidationRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "DeepArraysWithValidation")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "DeepArraysWithValidation")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var dataRawPtr *[][]Param21data.BlaBla2 = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "DeepArraysWithValidation",
+						"parameter": "data",
+						"type":      "[][]BlaBla2",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/DeepArraysWithValidation",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "DeepArraysWithValidation")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "DeepArraysWithValidation")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "DeepArraysWithValidation")
+			}
+		}()
+		value, opError := controller.DeepArraysWithValidation(*dataRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "DeepArraysWithValidation")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DeepArraysWithValidation'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DeepArraysWithValidation",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "DeepArraysWithValidation")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "DeepArraysWithValidation")
+		var outputValidationErr error
+		outputValidationErr = validateDataRecursive(value, "")
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'DeepArraysWithValidation'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/DeepArraysWithValidation",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "DeepArraysWithValidation")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "DeepArraysWithValidation")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/deep-arrays-with-validation")), e2EcontrollerDeepArraysWithValidationHandler)
+	e2EcontrollerEmbeddedStructsErrorStatusCodes := []int{500}
+	e2EcontrollerEmbeddedStructsRoute := withBasePath(basePath, "/e2e/embedded-structs")
+	e2EcontrollerEmbeddedStructsHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"EmbeddedStructs",
+			"E2EController",
+			"POST",
+			e2EcontrollerEmbeddedStructsRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "EmbeddedStructs")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "EmbeddedStructs")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var dataRawPtr *Param22data.TheModel = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "EmbeddedStructs",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/EmbeddedStructs",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "EmbeddedStructs")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "EmbeddedStructs")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "EmbeddedStructs")
+			}
+		}()
+		value, opError := controller.EmbeddedStructs(*dataRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "EmbeddedStructs")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'EmbeddedStructs'",
+				Status:     statusCode,
+				Instance:   "/controller/error/EmbeddedStructs",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "EmbeddedStructs")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "EmbeddedStructs")
+		var outputValidationErr error
+		outputValidationErr = validateDataRecursive(value, "")
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'EmbeddedStructs'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/EmbeddedStructs",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "EmbeddedStructs")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "EmbeddedStructs")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/embedded-structs")), e2EcontrollerEmbeddedStructsHandler)
+	e2EcontrollerStructsWithInnerPointerErrorStatusCodes := []int{500}
+	e2EcontrollerStructsWithInnerPointerRoute := withBasePath(basePath, "/e2e/structs-with-inner-pointer")
+	e2EcontrollerStructsWithInnerPointerHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"StructsWithInnerPointer",
+			"E2EController",
+			"POST",
+			e2EcontrollerStructsWithInnerPointerRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "StructsWithInnerPointer")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "StructsWithInnerPointer")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var dataRawPtr *Param23data.TheModelWithInnerPointer = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "StructsWithInnerPointer",
+						"parameter": "data",
+						"type":      "TheModelWithInnerPointer",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/StructsWithInnerPointer",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "StructsWithInnerPointer")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "StructsWithInnerPointer")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "StructsWithInnerPointer")
+			}
+		}()
+		value, opError := controller.StructsWithInnerPointer(*dataRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "StructsWithInnerPointer")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'StructsWithInnerPointer'",
+				Status:     statusCode,
+				Instance:   "/controller/error/StructsWithInnerPointer",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "StructsWithInnerPointer")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "StructsWithInnerPointer")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "StructsWithInnerPointer")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "StructsWithInnerPointer")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/structs-with-inner-pointer")), e2EcontrollerStructsWithInnerPointerHandler)
+	e2EcontrollerContextInjectionEmptyErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionEmptyRoute := withBasePath(basePath, "/e2e/context-injection-empty")
+	e2EcontrollerContextInjectionEmptyHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ContextInjectionEmpty",
+			"E2EController",
+			"POST",
+			e2EcontrollerContextInjectionEmptyRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "ContextInjectionEmpty")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ContextInjectionEmpty")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "ContextInjectionEmpty")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ContextInjectionEmpty")
+			}
+		}()
+		opError := controller.ContextInjectionEmpty(getRequestContext(req))
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "ContextInjectionEmpty")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ContextInjectionEmpty'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ContextInjectionEmpty",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "ContextInjectionEmpty")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "ContextInjectionEmpty")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "ContextInjectionEmpty")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "ContextInjectionEmpty")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/context-injection-empty")), e2EcontrollerContextInjectionEmptyHandler)
+	e2EcontrollerContextInjectionErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionRoute := withBasePath(basePath, "/e2e/context-injection")
+	e2EcontrollerContextInjectionHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ContextInjection",
+			"E2EController",
+			"POST",
+			e2EcontrollerContextInjectionRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "ContextInjection")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ContextInjection")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var dataRawPtr *Param22data.TheModel = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ContextInjection",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/ContextInjection",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "ContextInjection")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "ContextInjection")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ContextInjection")
+			}
+		}()
+		opError := controller.ContextInjection(getRequestContext(req), *dataRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "ContextInjection")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ContextInjection'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ContextInjection",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "ContextInjection")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "ContextInjection")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "ContextInjection")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "ContextInjection")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/context-injection")), e2EcontrollerContextInjectionHandler)
+	e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithByteSliceRoute := withBasePath(basePath, "/e2e/byte-slice")
+	e2EcontrollerReturnsStructWithByteSliceHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ReturnsStructWithByteSlice",
+			"E2EController",
+			"POST",
+			e2EcontrollerReturnsStructWithByteSliceRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "ReturnsStructWithByteSlice")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ReturnsStructWithByteSlice")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var arriveRawPtr *Param8arrive.ObjectWithByteSlice = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "", &arriveRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithByteSlice",
+						"parameter": "arrive",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/ReturnsStructWithByteSlice",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "ReturnsStructWithByteSlice")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "ReturnsStructWithByteSlice")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ReturnsStructWithByteSlice")
+			}
+		}()
+		value, opError := controller.ReturnsStructWithByteSlice(arriveRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "ReturnsStructWithByteSlice")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ReturnsStructWithByteSlice'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ReturnsStructWithByteSlice",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "ReturnsStructWithByteSlice")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "ReturnsStructWithByteSlice")
+		var outputValidationErr error
+		outputValidationErr = validateDataRecursive(value, "")
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'ReturnsStructWithByteSlice'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/ReturnsStructWithByteSlice",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "ReturnsStructWithByteSlice")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "ReturnsStructWithByteSlice")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/byte-slice")), e2EcontrollerReturnsStructWithByteSliceHandler)
+	e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithSpecialPrimitivesRoute := withBasePath(basePath, "/e2e/special-primitives")
+	e2EcontrollerReturnsStructWithSpecialPrimitivesHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ReturnsStructWithSpecialPrimitives",
+			"E2EController",
+			"POST",
+			e2EcontrollerReturnsStructWithSpecialPrimitivesRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "ReturnsStructWithSpecialPrimitives")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "ReturnsStructWithSpecialPrimitives")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var arriveRawPtr *Param24arrive.ObjectWithSpecialPrimitives = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "", &arriveRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithSpecialPrimitives",
+						"parameter": "arrive",
+						"type":      "ObjectWithSpecialPrimitives",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/ReturnsStructWithSpecialPrimitives",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "ReturnsStructWithSpecialPrimitives")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "ReturnsStructWithSpecialPrimitives")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ReturnsStructWithSpecialPrimitives")
+			}
+		}()
+		value, opError := controller.ReturnsStructWithSpecialPrimitives(arriveRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "ReturnsStructWithSpecialPrimitives")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'ReturnsStructWithSpecialPrimitives'",
+				Status:     statusCode,
+				Instance:   "/controller/error/ReturnsStructWithSpecialPrimitives",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "ReturnsStructWithSpecialPrimitives")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "ReturnsStructWithSpecialPrimitives")
+		var outputValidationErr error
+		outputValidationErr = validateDataRecursive(value, "")
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'ReturnsStructWithSpecialPrimitives'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/ReturnsStructWithSpecialPrimitives",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "ReturnsStructWithSpecialPrimitives")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "ReturnsStructWithSpecialPrimitives")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/special-primitives")), e2EcontrollerReturnsStructWithSpecialPrimitivesHandler)
+	e2EcontrollerAliasOfStringErrorStatusCodes := []int{}
+	e2EcontrollerAliasOfStringRoute := withBasePath(basePath, "/e2e/alias-of-primitive")
+	e2EcontrollerAliasOfStringHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"AliasOfString",
+			"E2EController",
+			"POST",
+			e2EcontrollerAliasOfStringRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "AliasOfString")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "AliasOfString")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var objectRawPtr *Param25object.ObjectWithAliasOfString = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &objectRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "AliasOfString",
+						"parameter": "object",
+						"type":      "ObjectWithAliasOfString",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/AliasOfString",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "AliasOfString")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var numRawPtr *Param26num.AliasOfInt = nil
+		numRaw := req.URL.Query().Get("num")
+		isnumExists := req.URL.Query().Has("num")
+		if isnumExists {
+			num, conversionErr := parseIntegerParam[int](numRaw)
+			if conversionErr != nil {
+				// Middlewares onInputValidationMiddlewares section
+				for _, middleware := range onInputValidationMiddlewares {
+					middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+					setRequestContext(req, middlewareCtx)
+					if !continueOperation {
+						return
+					}
+				}
+				// End middlewares onInputValidationMiddlewares section
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"AliasOfString",
+					"num",
+					"query",
+					"/num",
+					"AliasOfInt",
+					reflect.TypeOf(numRaw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
+				w.Header().Set("x-ParamsValidationErrorResponseExtension", "AliasOfString")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+				return
+			}
+			numVar := Param26num.AliasOfInt(num)
+			numRawPtr = &numVar
+		}
+		if validatorErr := validatorInstance.Var(numRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "num"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/num", languages)
+			w.Header().Set("x-RunValidatorExtension", "AliasOfString")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var strRawPtr *Param27str.AliasOfDirectString = nil
+		strRaw := req.URL.Query().Get("str")
+		isstrExists := req.URL.Query().Has("str")
+		if isstrExists {
+			str := strRaw
+			strVar := Param27str.AliasOfDirectString(str)
+			strRawPtr = &strVar
+		}
+		if validatorErr := validatorInstance.Var(strRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "str"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/str", languages)
+			w.Header().Set("x-RunValidatorExtension", "AliasOfString")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "AliasOfString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "AliasOfString")
+			}
+		}()
+		value, opError := controller.AliasOfString(objectRawPtr, *numRawPtr, *strRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "AliasOfString")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'AliasOfString'",
+				Status:     statusCode,
+				Instance:   "/controller/error/AliasOfString",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "AliasOfString")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "AliasOfString")
+		var outputValidationErr error
+		if value == nil {
+			outputValidationErr = fmt.Errorf("Response payload is nil")
+		} else {
+			outputValidationErr = validateDataRecursive(value, "")
+		}
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'AliasOfString'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/AliasOfString",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "AliasOfString")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "AliasOfString")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/alias-of-primitive")), e2EcontrollerAliasOfStringHandler)
+	e2EcontrollerBodyArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringRoute := withBasePath(basePath, "/e2e/body-array-of-string")
+	e2EcontrollerBodyArrayOfStringHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"BodyArrayOfString",
+			"E2EController",
+			"POST",
+			e2EcontrollerBodyArrayOfStringRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "BodyArrayOfString")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "BodyArrayOfString")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var valuesRawPtr *[]string = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &valuesRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfString",
+						"parameter": "values",
+						"type":      "[]string",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/BodyArrayOfString",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "BodyArrayOfString")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "BodyArrayOfString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "BodyArrayOfString")
+			}
+		}()
+		value, opError := controller.BodyArrayOfString(*valuesRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "BodyArrayOfString")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'BodyArrayOfString'",
+				Status:     statusCode,
+				Instance:   "/controller/error/BodyArrayOfString",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "BodyArrayOfString")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "BodyArrayOfString")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "BodyArrayOfString")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "BodyArrayOfString")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/body-array-of-string")), e2EcontrollerBodyArrayOfStringHandler)
+	e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringEnumRoute := withBasePath(basePath, "/e2e/body-array-of-enum-string")
+	e2EcontrollerBodyArrayOfStringEnumHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"BodyArrayOfStringEnum",
+			"E2EController",
+			"POST",
+			e2EcontrollerBodyArrayOfStringEnumRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "BodyArrayOfStringEnum")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "BodyArrayOfStringEnum")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var valuesRawPtr *[]Param28values.Myemamium = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &valuesRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfStringEnum",
+						"parameter": "values",
+						"type":      "[]Myemamium",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/BodyArrayOfStringEnum",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "BodyArrayOfStringEnum")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "BodyArrayOfStringEnum")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "BodyArrayOfStringEnum")
+			}
+		}()
+		value, opError := controller.BodyArrayOfStringEnum(*valuesRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "BodyArrayOfStringEnum")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'BodyArrayOfStringEnum'",
+				Status:     statusCode,
+				Instance:   "/controller/error/BodyArrayOfStringEnum",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "BodyArrayOfStringEnum")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "BodyArrayOfStringEnum")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "BodyArrayOfStringEnum")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "BodyArrayOfStringEnum")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/body-array-of-enum-string")), e2EcontrollerBodyArrayOfStringEnumHandler)
+	e2EcontrollerQueryArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfStringRoute := withBasePath(basePath, "/e2e/query-array-of-string")
+	e2EcontrollerQueryArrayOfStringHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"QueryArrayOfString",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfStringRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "QueryArrayOfString")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "QueryArrayOfString")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var valuesRawPtr *[]string = nil
+		valuesRawArray := req.URL.Query()["values"]
+		isvaluesExists := req.URL.Query().Has("values")
+		if isvaluesExists {
+			values := make([]string, 0, len(valuesRawArray))
+			for _, valuesRaw := range valuesRawArray {
+				valuesItem := valuesRaw
+				values = append(values, string(valuesItem))
+			}
+			valuesRawPtr = &values
+			valuesRawPtr = &values
+		}
+		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "values"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
+			w.Header().Set("x-RunValidatorExtension", "QueryArrayOfString")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "QueryArrayOfString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "QueryArrayOfString")
+			}
+		}()
+		value, opError := controller.QueryArrayOfString(*valuesRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "QueryArrayOfString")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'QueryArrayOfString'",
+				Status:     statusCode,
+				Instance:   "/controller/error/QueryArrayOfString",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "QueryArrayOfString")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "QueryArrayOfString")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "QueryArrayOfString")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "QueryArrayOfString")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/query-array-of-string")), e2EcontrollerQueryArrayOfStringHandler)
+	e2EcontrollerQueryArrayOfEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfEnumRoute := withBasePath(basePath, "/e2e/query-array-of-enum")
+	e2EcontrollerQueryArrayOfEnumHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"QueryArrayOfEnum",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfEnumRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "QueryArrayOfEnum")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "QueryArrayOfEnum")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var valuesRawPtr *[]Param28values.Myemamium = nil
+		valuesRawArray := req.URL.Query()["values"]
+		isvaluesExists := req.URL.Query().Has("values")
+		if isvaluesExists {
+			values := make([]Param28values.Myemamium, 0, len(valuesRawArray))
+			for _, valuesRaw := range valuesRawArray {
+				valuesItem := valuesRaw
+				switch valuesRaw {
+				case "one", "two":
+					break
+				default:
+					conversionErr := fmt.Errorf("values must be one of \"one, two\" options only but got \"%s\"", valuesRaw)
+					// Middlewares onInputValidationMiddlewares section
+					for _, middleware := range onInputValidationMiddlewares {
+						middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+						setRequestContext(req, middlewareCtx)
+						if !continueOperation {
+							return
+						}
+					}
+					// End middlewares onInputValidationMiddlewares section
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfEnum",
+						"values",
+						"query",
+						"/values",
+						"[]Myemamium",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
+					w.Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfEnum")
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+					return
+				}
+				values = append(values, Param28values.Myemamium(valuesItem))
+			}
+			valuesRawPtr = &values
+		}
+		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "values"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
+			w.Header().Set("x-RunValidatorExtension", "QueryArrayOfEnum")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var values2RawPtr *[]Param29values2.MyaliasString = nil
+		values2RawArray := req.URL.Query()["values2"]
+		isvalues2Exists := req.URL.Query().Has("values2")
+		if isvalues2Exists {
+			values2 := make([]Param29values2.MyaliasString, 0, len(values2RawArray))
+			for _, values2Raw := range values2RawArray {
+				values2Item := values2Raw
+				values2 = append(values2, Param29values2.MyaliasString(values2Item))
+			}
+			values2RawPtr = &values2
+			values2Var := []Param29values2.MyaliasString(values2)
+			values2RawPtr = &values2Var
+		}
+		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "values2"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
+			w.Header().Set("x-RunValidatorExtension", "QueryArrayOfEnum")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "QueryArrayOfEnum")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "QueryArrayOfEnum")
+			}
+		}()
+		value, opError := controller.QueryArrayOfEnum(*valuesRawPtr, *values2RawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "QueryArrayOfEnum")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'QueryArrayOfEnum'",
+				Status:     statusCode,
+				Instance:   "/controller/error/QueryArrayOfEnum",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "QueryArrayOfEnum")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "QueryArrayOfEnum")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "QueryArrayOfEnum")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "QueryArrayOfEnum")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/query-array-of-enum")), e2EcontrollerQueryArrayOfEnumHandler)
+	e2EcontrollerQueryArrayOfOthersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersRoute := withBasePath(basePath, "/e2e/query-array-of-others")
+	e2EcontrollerQueryArrayOfOthersHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"QueryArrayOfOthers",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfOthersRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "QueryArrayOfOthers")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "QueryArrayOfOthers")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var valuesRawPtr *[]int = nil
+		valuesRawArray := req.URL.Query()["values"]
+		isvaluesExists := req.URL.Query().Has("values")
+		if isvaluesExists {
+			values := make([]int, 0, len(valuesRawArray))
+			for _, valuesRaw := range valuesRawArray {
+				valuesItem, conversionErr := parseIntegerParam[int](valuesRaw)
+				if conversionErr != nil {
+					// Middlewares onInputValidationMiddlewares section
+					for _, middleware := range onInputValidationMiddlewares {
+						middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+						setRequestContext(req, middlewareCtx)
+						if !continueOperation {
+							return
+						}
+					}
+					// End middlewares onInputValidationMiddlewares section
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values",
+						"query",
+						"/values",
+						"[]int",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
+					w.Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+					return
+				}
+				values = append(values, int(valuesItem))
+			}
+			valuesRawPtr = &values
+			valuesRawPtr = &values
+		}
+		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "values"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
+			w.Header().Set("x-RunValidatorExtension", "QueryArrayOfOthers")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var values2RawPtr *[]Param30values2.MyaliasInt = nil
+		values2RawArray := req.URL.Query()["values2"]
+		isvalues2Exists := req.URL.Query().Has("values2")
+		if isvalues2Exists {
+			values2 := make([]Param30values2.MyaliasInt, 0, len(values2RawArray))
+			for _, values2Raw := range values2RawArray {
+				values2Item, conversionErr := parseIntegerParam[int](values2Raw)
+				if conversionErr != nil {
+					// Middlewares onInputValidationMiddlewares section
+					for _, middleware := range onInputValidationMiddlewares {
+						middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+						setRequestContext(req, middlewareCtx)
+						if !continueOperation {
+							return
+						}
+					}
+					// End middlewares onInputValidationMiddlewares section
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values2",
+						"query",
+						"/values2",
+						"[]MyaliasInt",
+						reflect.TypeOf(values2Raw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
+					w.Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+					return
+				}
+				values2 = append(values2, Param30values2.MyaliasInt(values2Item))
+			}
+			values2RawPtr = &values2
+			values2Var := []Param30values2.MyaliasInt(values2)
+			values2RawPtr = &values2Var
+		}
+		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "values2"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
+			w.Header().Set("x-RunValidatorExtension", "QueryArrayOfOthers")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var values3RawPtr *[]bool = nil
+		values3RawArray := req.URL.Query()["values3"]
+		isvalues3Exists := req.URL.Query().Has("values3")
+		if isvalues3Exists {
+			values3 := make([]bool, 0, len(values3RawArray))
+			for _, values3Raw := range values3RawArray {
+				values3Item, conversionErr := strconv.ParseBool(values3Raw)
+				if conversionErr != nil {
+					// Middlewares onInputValidationMiddlewares section
+					for _, middleware := range onInputValidationMiddlewares {
+						middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+						setRequestContext(req, middlewareCtx)
+						if !continueOperation {
+							return
+						}
+					}
+					// End middlewares onInputValidationMiddlewares section
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values3",
+						"query",
+						"/values3",
+						"[]bool",
+						reflect.TypeOf(values3Raw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
+					w.Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+					return
+				}
+				values3 = append(values3, bool(values3Item))
+			}
+			values3RawPtr = &values3
+			values3RawPtr = &values3
+		}
+		if validatorErr := validatorInstance.Var(values3RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "values3"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values3", languages)
+			w.Header().Set("x-RunValidatorExtension", "QueryArrayOfOthers")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var values4RawPtr *[]int32 = nil
+		values4RawArray := req.URL.Query()["values4"]
+		isvalues4Exists := req.URL.Query().Has("values4")
+		if isvalues4Exists {
+			values4 := make([]int32, 0, len(values4RawArray))
+			for _, values4Raw := range values4RawArray {
+				values4Item, conversionErr := parseIntegerParam[int32](values4Raw)
+				if conversionErr != nil {
+					// Middlewares onInputValidationMiddlewares section
+					for _, middleware := range onInputValidationMiddlewares {
+						middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+						setRequestContext(req, middlewareCtx)
+						if !continueOperation {
+							return
+						}
+					}
+					// End middlewares onInputValidationMiddlewares section
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values4",
+						"query",
+						"/values4",
+						"[]int32",
+						reflect.TypeOf(values4Raw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
+					w.Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+					return
+				}
+				values4 = append(values4, int32(values4Item))
+			}
+			values4RawPtr = &values4
+			values4RawPtr = &values4
+		}
+		if validatorErr := validatorInstance.Var(values4RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "values4"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values4", languages)
+			w.Header().Set("x-RunValidatorExtension", "QueryArrayOfOthers")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "QueryArrayOfOthers")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "QueryArrayOfOthers")
+			}
+		}()
+		value, opError := controller.QueryArrayOfOthers(*valuesRawPtr, *values2RawPtr, *values3RawPtr, *values4RawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "QueryArrayOfOthers")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'QueryArrayOfOthers'",
+				Status:     statusCode,
+				Instance:   "/controller/error/QueryArrayOfOthers",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "QueryArrayOfOthers")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "QueryArrayOfOthers")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "QueryArrayOfOthers")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "QueryArrayOfOthers")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/query-array-of-others")), e2EcontrollerQueryArrayOfOthersHandler)
+	e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersEnumRoute := withBasePath(basePath, "/e2e/query-array-of-others-enum")
+	e2EcontrollerQueryArrayOfOthersEnumHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"QueryArrayOfOthersEnum",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfOthersEnumRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "QueryArrayOfOthersEnum")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "QueryArrayOfOthersEnum")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var valuesRawPtr *[]Param32values.NumberEnum = nil
+		valuesRawArray := req.URL.Query()["values"]
+		isvaluesExists := req.URL.Query().Has("values")
+		if isvaluesExists {
+			values := make([]Param32values.NumberEnum, 0, len(valuesRawArray))
+			for _, valuesRaw := range valuesRawArray {
+				valuesItem, conversionErr := parseIntegerParam[int16](valuesRaw)
+				if conversionErr != nil {
+					// Middlewares onInputValidationMiddlewares section
+					for _, middleware := range onInputValidationMiddlewares {
+						middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+						setRequestContext(req, middlewareCtx)
+						if !continueOperation {
+							return
+						}
+					}
+					// End middlewares onInputValidationMiddlewares section
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values",
+						"query",
+						"/values",
+						"[]NumberEnum",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
+					w.Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthersEnum")
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+					return
+				}
+				switch valuesRaw {
+				case "1", "2":
+					break
+				default:
+					conversionErr := fmt.Errorf("values must be one of \"1, 2\" options only but got \"%s\"", valuesRaw)
+					// Middlewares onInputValidationMiddlewares section
+					for _, middleware := range onInputValidationMiddlewares {
+						middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+						setRequestContext(req, middlewareCtx)
+						if !continueOperation {
+							return
+						}
+					}
+					// End middlewares onInputValidationMiddlewares section
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values",
+						"query",
+						"/values",
+						"[]NumberEnum",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
+					w.Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthersEnum")
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+					return
+				}
+				values = append(values, Param32values.NumberEnum(valuesItem))
+			}
+			valuesRawPtr = &values
+		}
+		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "values"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
+			w.Header().Set("x-RunValidatorExtension", "QueryArrayOfOthersEnum")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var values2RawPtr *[]Param33values2.BoolEnum = nil
+		values2RawArray := req.URL.Query()["values2"]
+		isvalues2Exists := req.URL.Query().Has("values2")
+		if isvalues2Exists {
+			values2 := make([]Param33values2.BoolEnum, 0, len(values2RawArray))
+			for _, values2Raw := range values2RawArray {
+				values2Item := values2Raw
+				switch values2Raw {
+				case "false", "true":
+					break
+				default:
+					conversionErr := fmt.Errorf("values2 must be one of \"false, true\" options only but got \"%s\"", values2Raw)
+					// Middlewares onInputValidationMiddlewares section
+					for _, middleware := range onInputValidationMiddlewares {
+						middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+						setRequestContext(req, middlewareCtx)
+						if !continueOperation {
+							return
+						}
+					}
+					// End middlewares onInputValidationMiddlewares section
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values2",
+						"query",
+						"/values2",
+						"[]BoolEnum",
+						reflect.TypeOf(values2Raw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
+					w.Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthersEnum")
+					w.WriteHeader(http.StatusUnprocessableEntity)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+					return
+				}
+				values2 = append(values2, Param33values2.BoolEnum(values2Item))
+			}
+			values2RawPtr = &values2
+		}
+		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "values2"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
+			w.Header().Set("x-RunValidatorExtension", "QueryArrayOfOthersEnum")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "QueryArrayOfOthersEnum")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "QueryArrayOfOthersEnum")
+			}
+		}()
+		value, opError := controller.QueryArrayOfOthersEnum(*valuesRawPtr, *values2RawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "QueryArrayOfOthersEnum")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'QueryArrayOfOthersEnum'",
+				Status:     statusCode,
+				Instance:   "/controller/error/QueryArrayOfOthersEnum",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "QueryArrayOfOthersEnum")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "QueryArrayOfOthersEnum")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "QueryArrayOfOthersEnum")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "QueryArrayOfOthersEnum")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/query-array-of-others-enum")), e2EcontrollerQueryArrayOfOthersEnumHandler)
+	e2EcontrollerQueryArrayOfPointersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfPointersRoute := withBasePath(basePath, "/e2e/query-pointer-to-array")
+	e2EcontrollerQueryArrayOfPointersHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"QueryArrayOfPointers",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfPointersRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "QueryArrayOfPointers")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "QueryArrayOfPointers")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var values07RawPtr *[]string = nil
+		values07RawArray := req.URL.Query()["values07"]
+		isvalues07Exists := req.URL.Query().Has("values07")
+		if isvalues07Exists {
+			values07 := make([]string, 0, len(values07RawArray))
+			for _, values07Raw := range values07RawArray {
+				values07Item := values07Raw
+				values07 = append(values07, string(values07Item))
+			}
+			values07RawPtr = &values07
+			values07RawPtr = &values07
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "QueryArrayOfPointers")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "QueryArrayOfPointers")
+			}
+		}()
+		value, opError := controller.QueryArrayOfPointers(values07RawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "QueryArrayOfPointers")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'QueryArrayOfPointers'",
+				Status:     statusCode,
+				Instance:   "/controller/error/QueryArrayOfPointers",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "QueryArrayOfPointers")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "QueryArrayOfPointers")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "QueryArrayOfPointers")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "QueryArrayOfPointers")
+	}
+	engine.MethodFunc("POST", toChiUrl(withBasePath(basePath, "/e2e/query-pointer-to-array")), e2EcontrollerQueryArrayOfPointersHandler)
+}
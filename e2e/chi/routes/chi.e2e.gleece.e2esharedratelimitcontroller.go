@@ -0,0 +1,249 @@
+/*
+--
+This file is automatically generated. Any manual changes to this file may be overwritten.
+It includes the routes and handlers of one or more controllers by the Gleece API Routes Generator.
+--
+Authors: Haim Kastner & Yuval Pomerchik
+Generated by: Gleece Routes Generator
+Routes File: chi.e2e.gleece.go
+Target Engine: Chi v5 (https://github.com/go-chi/chi)
+--
+Usage:
+Refer to the Gleece documentation https://docs.gleece.dev for details on how to use the generated routes and handlers.
+--
+Repository: https://github.com/gopher-fleece/gleece
+--
+*/
+package routes
+import (
+	"encoding/json"
+	"net/http"
+	"github.com/go-chi/chi/v5"
+	"github.com/gopher-fleece/runtime"
+	"go.opentelemetry.io/otel/propagation"
+	E2ESharedRateLimitController "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	// ImportsExtension - test
+)
+// registerE2ESharedRateLimitControllerRoutes registers the E2ESharedRateLimitController controller's routes on the given router, under the given base path
+func registerE2ESharedRateLimitControllerRoutes(engine chi.Router, basePath string) {
+	e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitFirstRoute := withBasePath(basePath, "/e2e/shared-rate-limit/first")
+	e2EsharedRateLimitControllerSharedRateLimitFirstHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SharedRateLimitFirst",
+			"E2ESharedRateLimitController",
+			"GET",
+			e2EsharedRateLimitControllerSharedRateLimitFirstRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "SharedRateLimitFirst")
+		if !enforceRateLimit(w, req, "SharedRateLimitFirst", e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit) {
+			return
+		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SharedRateLimitFirst")
+			return
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "SharedRateLimitFirst")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SharedRateLimitFirst")
+			}
+		}()
+		value, opError := controller.SharedRateLimitFirst()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "SharedRateLimitFirst")
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitFirst'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitFirst",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "SharedRateLimitFirst")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "SharedRateLimitFirst")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "SharedRateLimitFirst")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "SharedRateLimitFirst")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/shared-rate-limit/first")), e2EsharedRateLimitControllerSharedRateLimitFirstHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/shared-rate-limit/first")), e2EsharedRateLimitControllerSharedRateLimitFirstHandler)
+	e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitSecondRoute := withBasePath(basePath, "/e2e/shared-rate-limit/second")
+	e2EsharedRateLimitControllerSharedRateLimitSecondHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SharedRateLimitSecond",
+			"E2ESharedRateLimitController",
+			"GET",
+			e2EsharedRateLimitControllerSharedRateLimitSecondRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "SharedRateLimitSecond")
+		if !enforceRateLimit(w, req, "SharedRateLimitSecond", e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit) {
+			return
+		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SharedRateLimitSecond")
+			return
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "SharedRateLimitSecond")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SharedRateLimitSecond")
+			}
+		}()
+		value, opError := controller.SharedRateLimitSecond()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "SharedRateLimitSecond")
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitSecond'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitSecond",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "SharedRateLimitSecond")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "SharedRateLimitSecond")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "SharedRateLimitSecond")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "SharedRateLimitSecond")
+	}
+	engine.MethodFunc("GET", toChiUrl(withBasePath(basePath, "/e2e/shared-rate-limit/second")), e2EsharedRateLimitControllerSharedRateLimitSecondHandler)
+	engine.MethodFunc("HEAD", toChiUrl(withBasePath(basePath, "/e2e/shared-rate-limit/second")), e2EsharedRateLimitControllerSharedRateLimitSecondHandler)
+}
@@ -157,6 +157,54 @@ var _ = Describe("E2E Authorization Spec", func() {
 		})
 	})
 
+	It("Should pass an advanced security expression when all schemas of its first alternative pass", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should pass an advanced security expression when all schemas of its first alternative pass",
+			ExpectedStatus:      200,
+			ExpectedBodyContain: "securitySchemaName2write",
+			ExpendedHeaders:     nil,
+			Path:                "/e2e/with-advanced-security",
+			Method:              "GET",
+			Body:                nil,
+			Query:               nil,
+			Headers:             nil,
+		})
+	})
+
+	It("Should fall back to the next alternative of an advanced security expression when any of an AND group's schemas fails", func() {
+		for _, failedSchema := range []string{"securitySchemaName", "securitySchemaName2"} {
+			RunRouterTest(common.RouterTest{
+				Name:                "Should fall back to the next alternative when " + failedSchema + " fails",
+				ExpectedStatus:      200,
+				ExpectedBodyContain: "securitySchemaName3admin",
+				ExpendedHeaders:     nil,
+				Path:                "/e2e/with-advanced-security",
+				Method:              "GET",
+				Body:                nil,
+				Query:               nil,
+				Headers: map[string]string{
+					"fail-auth": failedSchema,
+				},
+			})
+		}
+	})
+
+	It("Should not evaluate the remaining alternatives of an advanced security expression once one passes", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should not evaluate the remaining alternatives of an advanced security expression once one passes",
+			ExpectedStatus:      200,
+			ExpectedBodyContain: "securitySchemaName2write",
+			ExpendedHeaders:     nil,
+			Path:                "/e2e/with-advanced-security",
+			Method:              "GET",
+			Body:                nil,
+			Query:               nil,
+			Headers: map[string]string{
+				"fail-auth": "securitySchemaName3",
+			},
+		})
+	})
+
 	It("Should allow set custom auth code", func() {
 		RunRouterTest(common.RouterTest{
 			Name:                "Should allow set custom auth code",
@@ -204,4 +252,20 @@ var _ = Describe("E2E Authorization Spec", func() {
 			Headers:         nil,
 		})
 	})
+
+	It("Should skip authorization for public routes", func() {
+		RunRouterTest(common.RouterTest{
+			Name:            "Should skip authorization for public routes",
+			ExpectedStatus:  200,
+			ExpectedBody:    "\"public\"",
+			ExpendedHeaders: nil,
+			Path:            "/e2e/public",
+			Method:          "GET",
+			Body:            nil,
+			Query:           nil,
+			Headers: map[string]string{
+				"fail-auth": "securitySchemaName2",
+			},
+		})
+	})
 })
@@ -0,0 +1,142 @@
+package e2e
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/mux"
+	"github.com/labstack/echo/v4"
+
+	gleeceChiRoutesExExtra "github.com/gopher-fleece/gleece/v2/e2e/chi/ex_extra_routes"
+	gleeceEchoRoutesExExtra "github.com/gopher-fleece/gleece/v2/e2e/echo/ex_extra_routes"
+	gleeceFiberRoutesExExtra "github.com/gopher-fleece/gleece/v2/e2e/fiber/ex_extra_routes"
+	gleeceGinRoutesExExtra "github.com/gopher-fleece/gleece/v2/e2e/gin/ex_extra_routes"
+	gleeceMuxRoutesExExtra "github.com/gopher-fleece/gleece/v2/e2e/mux/ex_extra_routes"
+
+	chiMiddlewares "github.com/gopher-fleece/gleece/v2/e2e/chi/middlewares"
+	echoMiddlewares "github.com/gopher-fleece/gleece/v2/e2e/echo/middlewares"
+	fiberMiddlewares "github.com/gopher-fleece/gleece/v2/e2e/fiber/middlewares"
+	ginMiddlewares "github.com/gopher-fleece/gleece/v2/e2e/gin/middlewares"
+	muxMiddlewares "github.com/gopher-fleece/gleece/v2/e2e/mux/middlewares"
+)
+
+// benchmarkRequest describes a parameter-heavy request used to measure the cost of parameter binding
+type benchmarkRequest struct {
+	name    string
+	method  string
+	path    string
+	headers map[string]string
+	form    url.Values
+}
+
+var benchmarkRequests = []benchmarkRequest{
+	{
+		name:   "PrimitiveConversions",
+		method: http.MethodPost,
+		path:   "/e2e/test-primitive-conversions?value1=60&value2=true&value3=10&value4=3.5",
+	},
+	{
+		name:    "EnumsInAll",
+		method:  http.MethodPost,
+		path:    "/e2e/test-enums-in-all/active",
+		headers: map[string]string{"value2": "1"},
+		form:    url.Values{"value3": []string{"inactive"}},
+	},
+	{
+		name:    "AllParams",
+		method:  http.MethodGet,
+		path:    "/e2e/get-with-all-params/pathParam?queryParam=queryParam",
+		headers: map[string]string{"headerParam": "headerParam"},
+	},
+}
+
+func newBenchmarkRequest(request benchmarkRequest) *http.Request {
+	var req *http.Request
+	if request.form != nil {
+		req = httptest.NewRequest(request.method, request.path, strings.NewReader(request.form.Encode()))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	} else {
+		req = httptest.NewRequest(request.method, request.path, nil)
+	}
+
+	for key, value := range request.headers {
+		req.Header.Set(key, value)
+	}
+	return req
+}
+
+func runHandlerBenchmark(b *testing.B, handler http.Handler) {
+	for _, request := range benchmarkRequests {
+		b.Run(request.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				w := httptest.NewRecorder()
+				handler.ServeHTTP(w, newBenchmarkRequest(request))
+				if w.Code != http.StatusOK {
+					b.Fatalf("unexpected status %d for %s - %s", w.Code, request.path, w.Body.String())
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkGinParamBinding(b *testing.B) {
+	gin.SetMode(gin.ReleaseMode)
+	router := gin.New()
+	gleeceGinRoutesExExtra.RegisterNamedMiddleware("audit", ginMiddlewares.MiddlewareAudit)
+	gleeceGinRoutesExExtra.RegisterNamedMiddleware("trace", ginMiddlewares.MiddlewareTrace)
+	gleeceGinRoutesExExtra.RegisterRoutes(router)
+	runHandlerBenchmark(b, router)
+}
+
+func BenchmarkEchoParamBinding(b *testing.B) {
+	router := echo.New()
+	gleeceEchoRoutesExExtra.RegisterNamedMiddleware("audit", echoMiddlewares.MiddlewareAudit)
+	gleeceEchoRoutesExExtra.RegisterNamedMiddleware("trace", echoMiddlewares.MiddlewareTrace)
+	gleeceEchoRoutesExExtra.RegisterRoutes(router)
+	runHandlerBenchmark(b, router)
+}
+
+func BenchmarkMuxParamBinding(b *testing.B) {
+	router := mux.NewRouter()
+	gleeceMuxRoutesExExtra.RegisterNamedMiddleware("audit", muxMiddlewares.MiddlewareAudit)
+	gleeceMuxRoutesExExtra.RegisterNamedMiddleware("trace", muxMiddlewares.MiddlewareTrace)
+	gleeceMuxRoutesExExtra.RegisterRoutes(router)
+	runHandlerBenchmark(b, router)
+}
+
+func BenchmarkChiParamBinding(b *testing.B) {
+	router := chi.NewRouter()
+	gleeceChiRoutesExExtra.RegisterNamedMiddleware("audit", chiMiddlewares.MiddlewareAudit)
+	gleeceChiRoutesExExtra.RegisterNamedMiddleware("trace", chiMiddlewares.MiddlewareTrace)
+	gleeceChiRoutesExExtra.RegisterRoutes(router)
+	runHandlerBenchmark(b, router)
+}
+
+func BenchmarkFiberParamBinding(b *testing.B) {
+	router := newFiberApp()
+	gleeceFiberRoutesExExtra.RegisterNamedMiddleware("audit", fiberMiddlewares.MiddlewareAudit)
+	gleeceFiberRoutesExExtra.RegisterNamedMiddleware("trace", fiberMiddlewares.MiddlewareTrace)
+	gleeceFiberRoutesExExtra.RegisterRoutes(router)
+
+	for _, request := range benchmarkRequests {
+		b.Run(request.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for b.Loop() {
+				resp, err := router.Test(newBenchmarkRequest(request), -1)
+				if err != nil {
+					b.Fatal(err)
+				}
+				resp.Body.Close()
+				if resp.StatusCode != http.StatusOK {
+					b.Fatalf("unexpected status %d for %s", resp.StatusCode, request.path)
+				}
+			}
+		})
+	}
+}
@@ -302,4 +302,18 @@ var _ = Describe("E2E Controller Spec", func() {
 			Headers: map[string]string{},
 		})
 	})
+
+	It("Should create controllers using their registered factory", func() {
+		RunRouterTest(common.RouterTest{
+			Name:            "Should create controllers using their registered factory",
+			ExpectedStatus:  200,
+			ExpectedBody:    "\"injected greeting\"",
+			ExpendedHeaders: nil,
+			Path:            "/e2e/injected-dependency",
+			Method:          "GET",
+			Body:            nil,
+			Query:           nil,
+			Headers:         nil,
+		})
+	})
 })
@@ -1,8 +1,12 @@
 package e2e
 
 import (
+	"errors"
+
 	"github.com/gopher-fleece/gleece/v2/e2e/common"
+	gleeceGinRoutes "github.com/gopher-fleece/gleece/v2/e2e/gin/routes"
 	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
 )
 
 var _ = Describe("E2E Errors Spec", func() {
@@ -166,4 +170,91 @@ var _ = Describe("E2E Errors Spec", func() {
 			RunningMode:     &exExtraRouting,
 		})
 	})
+
+	It("Should map a wrapped sentinel error to its registered status code", func() {
+		RunRouterTest(common.RouterTest{
+			Name:            "Should map a wrapped sentinel error to its registered status code",
+			ExpectedStatus:  404,
+			ExpectedBody:    "{\"type\":\"Not Found\",\"title\":\"Not Found\",\"detail\":\"Encountered an error during operation 'MappedError'\",\"status\":404,\"instance\":\"/controller/error/MappedError\",\"extensions\":{\"error\":\"failed to load resource - e2e resource missing\"}}",
+			ExpendedHeaders: nil,
+			Path:            "/e2e/mapped-error",
+			Method:          "GET",
+			Body:            nil,
+			Query:           nil,
+			Headers:         nil,
+		})
+	})
+
+	It("Should not map an error to a status code the operation does not declare", func() {
+		RunRouterTest(common.RouterTest{
+			Name:            "Should not map an error to a status code the operation does not declare",
+			ExpectedStatus:  500,
+			ExpectedBody:    "{\"type\":\"Internal Server Error\",\"title\":\"\",\"detail\":\"Encountered an error during operation 'UndeclaredMappedError'\",\"status\":500,\"instance\":\"/controller/error/UndeclaredMappedError\",\"extensions\":{\"error\":\"failed to load resource - e2e resource missing\"}}",
+			ExpendedHeaders: nil,
+			Path:            "/e2e/undeclared-mapped-error",
+			Method:          "GET",
+			Body:            nil,
+			Query:           nil,
+			Headers:         nil,
+		})
+	})
+
+	It("Should map a wrapped typed error to its registered title and detail", func() {
+		RunRouterTest(common.RouterTest{
+			Name:            "Should map a wrapped typed error to its registered title and detail",
+			ExpectedStatus:  409,
+			ExpectedBody:    "{\"type\":\"Conflict\",\"title\":\"Resource Conflict\",\"detail\":\"The resource was modified by another request\",\"status\":409,\"instance\":\"/controller/error/MappedTypedError\",\"extensions\":{\"error\":\"failed to update resource - resource 'e2e' is in conflict\"}}",
+			ExpendedHeaders: nil,
+			Path:            "/e2e/mapped-typed-error",
+			Method:          "GET",
+			Body:            nil,
+			Query:           nil,
+			Headers:         nil,
+		})
+	})
+
+	It("Should refuse to register an error mapping to an undeclared status code", func() {
+		Expect(func() {
+			gleeceGinRoutes.RegisterErrorMapping(errors.New("teapot"), 418)
+		}).To(PanicWith("Error mapping status code 418 is not declared by any @ErrorResponse annotation"))
+	})
+
+	It("Should recover a panicking operation with an rfc7807 error and run the error middlewares", func() {
+		reportedPanics = nil
+
+		RunRouterTest(common.RouterTest{
+			Name:            "Should recover a panicking operation with an rfc7807 error",
+			ExpectedStatus:  500,
+			ExpectedBody:    "{\"type\":\"Internal Server Error\",\"title\":\"\",\"detail\":\"Encountered an unexpected error during operation 'Panic'\",\"status\":500,\"instance\":\"/controller/error/Panic\",\"extensions\":{\"error\":\"operation panicked - e2e operation panic\"}}",
+			ExpendedHeaders: map[string]string{"X-pass-on-error": "true"},
+			Path:            "/e2e/panic",
+			Method:          "GET",
+			Body:            nil,
+			Query:           nil,
+			Headers:         nil,
+			RunningMode:     &fullyFeaturedRouting,
+		})
+
+		Expect(reportedPanics).To(HaveLen(5))
+		for _, reported := range reportedPanics {
+			Expect(reported.operationId).To(Equal("Panic"))
+			Expect(reported.value).To(Equal("e2e operation panic"))
+			Expect(string(reported.stack)).To(ContainSubstring("E2EController).Panic"))
+		}
+	})
+
+	It("Should let error middlewares handle a recovered panic", func() {
+		RunRouterTest(common.RouterTest{
+			Name:            "Should let error middlewares handle a recovered panic",
+			ExpectedStatus:  400,
+			ExpectedBody:    "{\"error\":\"abort-on-error header is set to true operation panicked - e2e operation panic\"}",
+			ExpendedHeaders: nil,
+			Path:            "/e2e/panic",
+			Method:          "GET",
+			Body:            nil,
+			Query:           nil,
+			Headers:         map[string]string{"abort-on-error": "true"},
+			RunningMode:     &fullyFeaturedRouting,
+		})
+	})
 })
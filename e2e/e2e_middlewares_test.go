@@ -46,6 +46,42 @@ var _ = Describe("E2E Middlewares Spec", func() {
 		})
 	})
 
+	It("Should pass named middlewares in declaration order", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should pass named middlewares in declaration order",
+			ExpectedStatus:      200,
+			ExpectedBodyContain: "works",
+			Path:                "/e2e/named-middlewares",
+			Method:              "GET",
+			Body:                nil,
+			Query:               nil,
+			Headers:             nil,
+			ExpendedHeaders: map[string]string{
+				"X-pass-named-middleware-audit": "true",
+				"X-pass-named-middleware-trace": "true",
+			},
+			RunningMode: &allRouting,
+		})
+	})
+
+	It("Should NOT pass named middlewares on routes that do not reference them", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should NOT pass named middlewares on routes that do not reference them",
+			ExpectedStatus:      200,
+			ExpectedBodyContain: "works",
+			Path:                "/e2e/simple-get",
+			Method:              "GET",
+			Body:                nil,
+			Query:               nil,
+			Headers:             nil,
+			ExpendedHeaders: map[string]string{
+				"X-pass-named-middleware-audit": "",
+				"X-pass-named-middleware-trace": "",
+			},
+			RunningMode: &allRouting,
+		})
+	})
+
 	It("Should pass failed middlewares for default error", func() {
 		RunRouterTest(common.RouterTest{
 			Name:           "Should pass failed middlewares for default error",
@@ -478,7 +514,7 @@ var _ = Describe("E2E Middlewares Spec", func() {
 		RunRouterTest(common.RouterTest{
 			Name:           "Should pass thro validation error middleware for string param - enum conversion",
 			ExpectedStatus: 422,
-			ExpectedBody:   "{\"type\":\"Unprocessable Entity\",\"title\":\"\",\"detail\":\"A request was made to operation 'TestEnums' but parameter 'value1' was not properly sent - Expected StatusEnumeration but got string\",\"status\":422,\"instance\":\"/validation/error/TestEnums\",\"extensions\":{\"error\":\"value1 must be one of \\\"active, inactive\\\" options only but got \\\"activerrrr\\\"\"}}",
+			ExpectedBody:   "{\"type\":\"Unprocessable Entity\",\"title\":\"\",\"detail\":\"A request was made to operation 'TestEnums' but parameter 'value1' was not properly sent - Expected StatusEnumeration but got string\",\"status\":422,\"instance\":\"/validation/error/TestEnums\",\"extensions\":{\"error\":\"value1 must be one of \\\"active, inactive\\\" options only but got \\\"activerrrr\\\"\"},\"errors\":[{\"in\":\"query\",\"pointer\":\"/value1\",\"rule\":\"type\",\"param\":\"StatusEnumeration\",\"message\":\"value1 must be one of \\\"active, inactive\\\" options only but got \\\"activerrrr\\\"\"}]}",
 			ExpendedHeaders: map[string]string{
 				"X-pass-error-validation":        "true",
 				"X-pass-after-succeed-operation": "",
@@ -776,7 +812,7 @@ var _ = Describe("E2E Middlewares Spec", func() {
 		RunRouterTest(common.RouterTest{
 			Name:                "Should NOT pass thro validation error middleware for struct param - missing body",
 			ExpectedStatus:      422,
-			ExpectedBodyContain: "{\"type\":\"Unprocessable Entity\",\"title\":\"\",\"detail\":\"A request was made to operation 'PostWithAllParamsWithBody' but body parameter 'theBody' did not pass validation of 'BodyInfo' - body is required but was not provided\",\"status\":422,\"instance\":\"/validation/error/PostWithAllParamsWithBody\",\"extensions\":null}",
+			ExpectedBodyContain: "{\"type\":\"Unprocessable Entity\",\"title\":\"\",\"detail\":\"A request was made to operation 'PostWithAllParamsWithBody' but body parameter 'theBody' did not pass validation of 'BodyInfo' - body is required but was not provided\",\"status\":422,\"instance\":\"/validation/error/PostWithAllParamsWithBody\",\"extensions\":null,\"errors\":[{\"in\":\"body\",\"pointer\":\"\",\"rule\":\"required\",\"message\":\"body is required but was not provided\"}]}",
 			ExpendedHeaders: map[string]string{
 				"X-pass-error-validation":        "",
 				"X-pass-after-succeed-operation": "",
@@ -288,6 +288,68 @@ var _ = Describe("E2E Models Spec", func() {
 		})
 	})
 
+	It("Should report JSON pointers for failed validations of nested and embedded fields", func() {
+		data := "data"
+		dataPtr := &data
+		model := assets.TheModel{
+			ModelField: "model field",
+			FirstLevelModel: assets.FirstLevelModel{
+				FirstLevelModelField: "first level",
+				SecondLevelModel:     assets.SecondLevelModel{SecondLevelModelField: "second level"},
+			},
+			OtherModel: assets.OtherModel{OtherModelField: "other model"},
+		}
+
+		RunRouterTest(common.RouterTest{
+			Name:                "Should report the JSON pointer of a nested field",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "\"errors\":[{\"in\":\"body\",\"pointer\":\"/recursiveModelWithPointer/prop2\",\"rule\":\"required\",\"message\":\"Field 'prop2' failed validation with tag 'required'\"}]",
+			ExpendedHeaders:     nil,
+			Path:                "/e2e/structs-with-inner-pointer",
+			Method:              "POST",
+			Body: assets.TheModelWithInnerPointer{
+				Model: &model,
+				RecursiveModelWithPointer: &assets.RecursiveModelWithPointer{
+					Prop1: data,
+					Prop3: &dataPtr,
+					Prop4: &model,
+				},
+			},
+			Query:       map[string]string{},
+			Headers:     map[string]string{},
+			RunningMode: &allRouting,
+		})
+
+		model.SecondLevelModelField = ""
+		RunRouterTest(common.RouterTest{
+			Name:                "Should flatten embedded structs in JSON pointers",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "\"errors\":[{\"in\":\"body\",\"pointer\":\"/secondLevelModelField\",\"rule\":\"required\",\"message\":\"Field 'secondLevelModelField' failed validation with tag 'required'\"}]",
+			ExpendedHeaders:     nil,
+			Path:                "/e2e/embedded-structs",
+			Method:              "POST",
+			Body:                model,
+			Query:               map[string]string{},
+			Headers:             map[string]string{},
+			RunningMode:         &allRouting,
+		})
+	})
+
+	It("Should report the JSON pointer of a mistyped body field", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should report the JSON pointer of a mistyped body field",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "\"errors\":[{\"in\":\"body\",\"pointer\":\"/modelField\",\"rule\":\"type\",\"param\":\"string\"",
+			ExpendedHeaders:     nil,
+			Path:                "/e2e/embedded-structs",
+			Method:              "POST",
+			Body:                map[string]any{"modelField": 5},
+			Query:               map[string]string{},
+			Headers:             map[string]string{},
+			RunningMode:         &allRouting,
+		})
+	})
+
 	It("Should return status code 200 for enums", func() {
 		RunRouterTest(common.RouterTest{
 			Name:            "Should return status code 200 for enum - queries & body",
@@ -386,7 +448,7 @@ var _ = Describe("E2E Models Spec", func() {
 		RunRouterTest(common.RouterTest{
 			Name:            "Should return status code 422 for enum prams and body - wrong direct string enum",
 			ExpectedStatus:  422,
-			ExpectedBody:    "{\"type\":\"Unprocessable Entity\",\"title\":\"\",\"detail\":\"A request was made to operation 'TestEnums' but parameter 'value1' was not properly sent - Expected StatusEnumeration but got string\",\"status\":422,\"instance\":\"/validation/error/TestEnums\",\"extensions\":{\"error\":\"value1 must be one of \\\"active, inactive\\\" options only but got \\\"blabla\\\"\"}}",
+			ExpectedBody:    "{\"type\":\"Unprocessable Entity\",\"title\":\"\",\"detail\":\"A request was made to operation 'TestEnums' but parameter 'value1' was not properly sent - Expected StatusEnumeration but got string\",\"status\":422,\"instance\":\"/validation/error/TestEnums\",\"extensions\":{\"error\":\"value1 must be one of \\\"active, inactive\\\" options only but got \\\"blabla\\\"\"},\"errors\":[{\"in\":\"query\",\"pointer\":\"/value1\",\"rule\":\"type\",\"param\":\"StatusEnumeration\",\"message\":\"value1 must be one of \\\"active, inactive\\\" options only but got \\\"blabla\\\"\"}]}",
 			ExpendedHeaders: nil,
 			Path:            "/e2e/test-enums",
 			Method:          "POST",
@@ -404,7 +466,7 @@ var _ = Describe("E2E Models Spec", func() {
 		RunRouterTest(common.RouterTest{
 			Name:            "Should return status code 422 for enum prams and body - wrong direct number enum",
 			ExpectedStatus:  422,
-			ExpectedBody:    "{\"type\":\"Unprocessable Entity\",\"title\":\"\",\"detail\":\"A request was made to operation 'TestEnums' but parameter 'value2' was not properly sent - Expected NumberEnumeration but got string\",\"status\":422,\"instance\":\"/validation/error/TestEnums\",\"extensions\":{\"error\":\"value2 must be one of \\\"1, 2\\\" options only but got \\\"4\\\"\"}}",
+			ExpectedBody:    "{\"type\":\"Unprocessable Entity\",\"title\":\"\",\"detail\":\"A request was made to operation 'TestEnums' but parameter 'value2' was not properly sent - Expected NumberEnumeration but got string\",\"status\":422,\"instance\":\"/validation/error/TestEnums\",\"extensions\":{\"error\":\"value2 must be one of \\\"1, 2\\\" options only but got \\\"4\\\"\"},\"errors\":[{\"in\":\"query\",\"pointer\":\"/value2\",\"rule\":\"type\",\"param\":\"NumberEnumeration\",\"message\":\"value2 must be one of \\\"1, 2\\\" options only but got \\\"4\\\"\"}]}",
 			ExpendedHeaders: nil,
 			Path:            "/e2e/test-enums",
 			Method:          "POST",
@@ -422,7 +484,7 @@ var _ = Describe("E2E Models Spec", func() {
 		RunRouterTest(common.RouterTest{
 			Name:            "Should return status code 422 for enum prams and body - wrong in struct enum validated by generated validator",
 			ExpectedStatus:  422,
-			ExpectedBody:    "{\"type\":\"Unprocessable Entity\",\"title\":\"\",\"detail\":\"A request was made to operation 'TestEnums' but body parameter 'value3' did not pass validation of 'ObjectWithEnum' - Field 'Status' failed validation with tag 'status_enumeration_enum'. \",\"status\":422,\"instance\":\"/validation/error/TestEnums\",\"extensions\":null}",
+			ExpectedBody:    "{\"type\":\"Unprocessable Entity\",\"title\":\"\",\"detail\":\"A request was made to operation 'TestEnums' but body parameter 'value3' did not pass validation of 'ObjectWithEnum' - Field 'Status' failed validation with tag 'status_enumeration_enum'. \",\"status\":422,\"instance\":\"/validation/error/TestEnums\",\"extensions\":null,\"errors\":[{\"in\":\"body\",\"pointer\":\"/status\",\"rule\":\"status_enumeration_enum\",\"message\":\"Field 'status' failed validation with tag 'status_enumeration_enum'\"}]}",
 			ExpendedHeaders: nil,
 			Path:            "/e2e/test-enums",
 			Method:          "POST",
@@ -440,7 +502,7 @@ var _ = Describe("E2E Models Spec", func() {
 		RunRouterTest(common.RouterTest{
 			Name:            "Should return status code 422 for enum prams and body - wrong enum in optional param",
 			ExpectedStatus:  422,
-			ExpectedBody:    "{\"type\":\"Unprocessable Entity\",\"title\":\"\",\"detail\":\"A request was made to operation 'TestEnumsOptional' but parameter 'value1' was not properly sent - Expected StatusEnumeration but got string\",\"status\":422,\"instance\":\"/validation/error/TestEnumsOptional\",\"extensions\":{\"error\":\"value1 must be one of \\\"active, inactive\\\" options only but got \\\"active222\\\"\"}}",
+			ExpectedBody:    "{\"type\":\"Unprocessable Entity\",\"title\":\"\",\"detail\":\"A request was made to operation 'TestEnumsOptional' but parameter 'value1' was not properly sent - Expected StatusEnumeration but got string\",\"status\":422,\"instance\":\"/validation/error/TestEnumsOptional\",\"extensions\":{\"error\":\"value1 must be one of \\\"active, inactive\\\" options only but got \\\"active222\\\"\"},\"errors\":[{\"in\":\"header\",\"pointer\":\"/value1\",\"rule\":\"type\",\"param\":\"StatusEnumeration\",\"message\":\"value1 must be one of \\\"active, inactive\\\" options only but got \\\"active222\\\"\"}]}",
 			ExpendedHeaders: nil,
 			Path:            "/e2e/test-enums-optional",
 			Method:          "POST",
@@ -810,6 +872,54 @@ var _ = Describe("E2E Models Spec", func() {
 		})
 	})
 
+	It("Should return translated validation messages for the accepted language", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should return validation messages in the most preferred registered language",
+			ExpectedStatus: 422,
+			ExpectedBodyContain: "\"detail\":\"Le corps 'object' de l'opération 'AliasOfString' est invalide - " +
+				"Le champ 'ValueWithTag' doit contenir au moins 3 caractères. \"",
+			Body: &assets.ObjectWithAliasOfString{
+				Value:              "hello",
+				ValueDirect:        "world",
+				Number:             5,
+				AssignedInt:        1,
+				ValueWithTag:       "ab",
+				ValueDirectWithTag: "validvalue2",
+				NumberWithTag:      15,
+			},
+			ExpendedHeaders: nil,
+			Path:            "/e2e/alias-of-primitive",
+			Method:          "POST",
+			Query:           map[string]string{"num": "10", "str": "test"},
+			Headers:         map[string]string{"Accept-Language": "de;q=0.9, fr-CA, en;q=0.5"},
+			RunningMode:     &allRouting,
+		})
+	})
+
+	It("Should fall back to the default English messages for untranslated languages", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should return the default English validation messages",
+			ExpectedStatus: 422,
+			ExpectedBodyContain: "\"detail\":\"A request was made to operation 'AliasOfString' but body parameter 'object' " +
+				"did not pass validation of 'ObjectWithAliasOfString' - Field 'ValueWithTag' failed validation with tag 'min'. \"",
+			Body: &assets.ObjectWithAliasOfString{
+				Value:              "hello",
+				ValueDirect:        "world",
+				Number:             5,
+				AssignedInt:        1,
+				ValueWithTag:       "ab",
+				ValueDirectWithTag: "validvalue2",
+				NumberWithTag:      15,
+			},
+			ExpendedHeaders: nil,
+			Path:            "/e2e/alias-of-primitive",
+			Method:          "POST",
+			Query:           map[string]string{"num": "10", "str": "test"},
+			Headers:         map[string]string{"Accept-Language": "de-DE, es;q=0.8"},
+			RunningMode:     &allRouting,
+		})
+	})
+
 	It("Should return status code 422 for alias-of-primitive when number validation fails", func() {
 		RunRouterTest(common.RouterTest{
 			Name:           "Should return 422 when number validation fails",
@@ -488,4 +488,311 @@ var _ = Describe("E2E Routing Spec", func() {
 		})
 	})
 
+	It("Should serve routes registered on a router group under a base path", func() {
+		RunRouterTest(common.RouterTest{
+			Name:            "Should serve routes registered on a router group under a base path - simple get",
+			ExpectedStatus:  200,
+			ExpectedBody:    "\"works\"",
+			ExpendedHeaders: nil,
+			Path:            "/api/v1/e2e/simple-get",
+			Method:          "GET",
+			Body:            nil,
+			Query:           nil,
+			Headers:         nil,
+			RunningMode:     &exExtraRouting,
+		})
+
+		RunRouterTest(common.RouterTest{
+			Name:            "Should serve routes registered on a router group under a base path - path params",
+			ExpectedStatus:  200,
+			ExpectedBody:    "\"pathParamqueryParamheaderParam\"",
+			ExpendedHeaders: nil,
+			Path:            "/api/v1/e2e/get-with-all-params/pathParam",
+			Method:          "GET",
+			Body:            nil,
+			Query:           map[string]string{"queryParam": "queryParam"},
+			Headers: map[string]string{
+				"headerParam": "headerParam",
+			},
+			RunningMode: &exExtraRouting,
+		})
+	})
+
+	It("Should serve explicit HEAD routes", func() {
+		RunRouterTest(common.RouterTest{
+			Name:            "Should serve explicit HEAD routes",
+			ExpectedStatus:  204,
+			ExpendedHeaders: map[string]string{"X-Head-Check": "exists"},
+			Path:            "/e2e/head-check",
+			Method:          "HEAD",
+		})
+	})
+
+	It("Should serve OPTIONS routes", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should serve OPTIONS routes",
+			ExpectedStatus: 200,
+			ExpectedBody:   "\"options\"",
+			Path:           "/e2e/options-check",
+			Method:         "OPTIONS",
+		})
+	})
+
+	It("Should serve TRACE routes", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should serve TRACE routes",
+			ExpectedStatus: 200,
+			ExpectedBody:   "\"trace\"",
+			Path:           "/e2e/trace-check",
+			Method:         "TRACE",
+		})
+	})
+
+	It("Should serve custom HTTP verb routes", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should serve custom HTTP verb routes",
+			ExpectedStatus: 200,
+			ExpectedBody:   "\"purge\"",
+			Path:           "/e2e/purge-check",
+			Method:         "PURGE",
+		})
+	})
+
+	It("Should serve HEAD requests to GET routes when HEAD derivation is enabled", func() {
+		RunRouterTest(common.RouterTest{
+			Name:            "Should serve HEAD requests to GET routes when HEAD derivation is enabled",
+			ExpectedStatus:  200,
+			ExpendedHeaders: map[string]string{"X-Test-Header": "test"},
+			Path:            "/e2e/simple-get",
+			Method:          "HEAD",
+			RunningMode:     &fullyFeaturedRouting,
+		})
+	})
+
+	It("Should serve versioned routes under their version path prefix", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should serve versioned routes under their version path prefix - v1",
+			ExpectedStatus: 200,
+			ExpectedBody:   "\"v1\"",
+			Path:           "/v1/e2e/versioned",
+			Method:         "GET",
+			RunningMode:    &fullyFeaturedRouting,
+		})
+
+		RunRouterTest(common.RouterTest{
+			Name:           "Should serve versioned routes under their version path prefix - v2",
+			ExpectedStatus: 200,
+			ExpectedBody:   "\"v2\"",
+			Path:           "/v2/e2e/versioned",
+			Method:         "GET",
+			RunningMode:    &fullyFeaturedRouting,
+		})
+
+		RunRouterTest(common.RouterTest{
+			Name:           "Should serve versioned routes under their version path prefix - unprefixed",
+			ExpectedStatus: 404,
+			Path:           "/e2e/versioned",
+			Method:         "GET",
+			RunningMode:    &fullyFeaturedRouting,
+		})
+	})
+
+	It("Should answer requests exceeding the route's rate limit with 429 and Retry-After", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should answer requests exceeding the route's rate limit with 429 and Retry-After - attempt 1",
+			ExpectedStatus: 200,
+			ExpectedBody:   "\"allowed\"",
+			Path:           "/e2e/rate-limited",
+			Method:         "GET",
+			Headers:        map[string]string{"X-Rate-Limit-Key": "exhausted"},
+			RunningMode:    &fullyFeaturedRouting,
+		})
+
+		RunRouterTest(common.RouterTest{
+			Name:           "Should answer requests exceeding the route's rate limit with 429 and Retry-After - attempt 2",
+			ExpectedStatus: 200,
+			ExpectedBody:   "\"allowed\"",
+			Path:           "/e2e/rate-limited",
+			Method:         "GET",
+			Headers:        map[string]string{"X-Rate-Limit-Key": "exhausted"},
+			RunningMode:    &fullyFeaturedRouting,
+		})
+
+		RunRouterTest(common.RouterTest{
+			Name:                "Should answer requests exceeding the route's rate limit with 429 and Retry-After - exceeded",
+			ExpectedStatus:      429,
+			ExpectedBodyContain: "Too many requests were made to operation 'RateLimited'",
+			ExpendedHeaders:     map[string]string{"Retry-After": "1800"},
+			Path:                "/e2e/rate-limited",
+			Method:              "GET",
+			Headers:             map[string]string{"X-Rate-Limit-Key": "exhausted"},
+			RunningMode:         &fullyFeaturedRouting,
+		})
+
+		RunRouterTest(common.RouterTest{
+			Name:           "Should answer requests exceeding the route's rate limit with 429 and Retry-After - other key",
+			ExpectedStatus: 200,
+			ExpectedBody:   "\"allowed\"",
+			Path:           "/e2e/rate-limited",
+			Method:         "GET",
+			Headers:        map[string]string{"X-Rate-Limit-Key": "fresh"},
+			RunningMode:    &fullyFeaturedRouting,
+		})
+	})
+
+	It("Should share a controller's rate limit among the routes inheriting it", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should share a controller's rate limit among the routes inheriting it - first route",
+			ExpectedStatus: 200,
+			ExpectedBody:   "\"first\"",
+			Path:           "/e2e/shared-rate-limit/first",
+			Method:         "GET",
+			Headers:        map[string]string{"X-Rate-Limit-Key": "shared"},
+			RunningMode:    &fullyFeaturedRouting,
+		})
+
+		RunRouterTest(common.RouterTest{
+			Name:           "Should share a controller's rate limit among the routes inheriting it - second route",
+			ExpectedStatus: 200,
+			ExpectedBody:   "\"second\"",
+			Path:           "/e2e/shared-rate-limit/second",
+			Method:         "GET",
+			Headers:        map[string]string{"X-Rate-Limit-Key": "shared"},
+			RunningMode:    &fullyFeaturedRouting,
+		})
+
+		RunRouterTest(common.RouterTest{
+			Name:                "Should share a controller's rate limit among the routes inheriting it - exceeded",
+			ExpectedStatus:      429,
+			ExpectedBodyContain: "Too many requests were made to operation 'SharedRateLimitFirst'",
+			Path:                "/e2e/shared-rate-limit/first",
+			Method:              "GET",
+			Headers:             map[string]string{"X-Rate-Limit-Key": "shared"},
+			RunningMode:         &fullyFeaturedRouting,
+		})
+	})
+
+	It("Should answer requests whose operation's deadline expired with 504", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should answer requests whose operation's deadline expired with 504",
+			ExpectedStatus:      504,
+			ExpectedBodyContain: "Operation 'TimedOut' did not complete before its deadline expired",
+			Path:                "/e2e/timeout",
+			Method:              "GET",
+		})
+	})
+
+	It("Should answer requests whose body exceeds the route's maximum size with 413", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should answer requests whose body exceeds the route's maximum size with 413 - within limit",
+			ExpectedStatus: 200,
+			ExpectedBody:   "\"accepted\"",
+			Path:           "/e2e/max-body-size",
+			Method:         "POST",
+			Body:           assets.ObjectWithByteSlice{Value: []byte("small")},
+		})
+
+		RunRouterTest(common.RouterTest{
+			Name:                "Should answer requests whose body exceeds the route's maximum size with 413 - exceeded",
+			ExpectedStatus:      413,
+			ExpectedBodyContain: "The request body of operation 'MaxBodySize' exceeds the maximum size of 64 bytes",
+			Path:                "/e2e/max-body-size",
+			Method:              "POST",
+			Body:                assets.ObjectWithByteSlice{Value: []byte("a body that is way too large for the route's sixty four bytes limit")},
+		})
+	})
+
+	It("Should replay the responses of idempotent routes to repeated requests", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should replay the responses of idempotent routes to repeated requests - original",
+			ExpectedStatus: 200,
+			ExpectedBody:   "\"processed\"",
+			Path:           "/e2e/idempotent",
+			Method:         "POST",
+			Headers:        map[string]string{"Idempotency-Key": "payment-1"},
+		})
+
+		RunRouterTest(common.RouterTest{
+			Name:            "Should replay the responses of idempotent routes to repeated requests - repeated",
+			ExpectedStatus:  200,
+			ExpectedBody:    "\"processed\"",
+			ExpendedHeaders: map[string]string{"Idempotent-Replayed": "true"},
+			Path:            "/e2e/idempotent",
+			Method:          "POST",
+			Headers:         map[string]string{"Idempotency-Key": "payment-1"},
+		})
+
+		RunRouterTest(common.RouterTest{
+			Name:                "Should replay the responses of idempotent routes to repeated requests - missing key",
+			ExpectedStatus:      400,
+			ExpectedBodyContain: "Operation 'Idempotent' requires an 'Idempotency-Key' header",
+			Path:                "/e2e/idempotent",
+			Method:              "POST",
+		})
+	})
+
+	It("Should reject idempotency keys reused with a different body", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should reject idempotency keys reused with a different body - original",
+			ExpectedStatus: 200,
+			ExpectedBody:   "\"first\"",
+			Path:           "/e2e/idempotent-with-body",
+			Method:         "POST",
+			Body:           assets.BodyInfo{BodyParam: "first"},
+			Headers:        map[string]string{"Idempotency-Key": "order-1"},
+		})
+
+		RunRouterTest(common.RouterTest{
+			Name:            "Should reject idempotency keys reused with a different body - same body",
+			ExpectedStatus:  200,
+			ExpectedBody:    "\"first\"",
+			ExpendedHeaders: map[string]string{"Idempotent-Replayed": "true"},
+			Path:            "/e2e/idempotent-with-body",
+			Method:          "POST",
+			Body:            assets.BodyInfo{BodyParam: "first"},
+			Headers:         map[string]string{"Idempotency-Key": "order-1"},
+		})
+
+		RunRouterTest(common.RouterTest{
+			Name:                "Should reject idempotency keys reused with a different body - different body",
+			ExpectedStatus:      422,
+			ExpectedBodyContain: "The 'Idempotency-Key' has already been used by a request to operation 'IdempotentWithBody' with a different body",
+			Path:                "/e2e/idempotent-with-body",
+			Method:              "POST",
+			Body:                assets.BodyInfo{BodyParam: "second"},
+			Headers:             map[string]string{"Idempotency-Key": "order-1"},
+		})
+	})
+
+	It("Should dispatch versioned routes according to the version header", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should dispatch versioned routes according to the version header - v1",
+			ExpectedStatus: 200,
+			ExpectedBody:   "\"v1\"",
+			Path:           "/e2e/versioned",
+			Method:         "GET",
+			Headers:        map[string]string{"X-API-Version": "1"},
+			RunningMode:    &exExtraRouting,
+		})
+
+		RunRouterTest(common.RouterTest{
+			Name:           "Should dispatch versioned routes according to the version header - default",
+			ExpectedStatus: 200,
+			ExpectedBody:   "\"v2\"",
+			Path:           "/e2e/versioned",
+			Method:         "GET",
+			RunningMode:    &exExtraRouting,
+		})
+
+		RunRouterTest(common.RouterTest{
+			Name:                "Should dispatch versioned routes according to the version header - unserved",
+			ExpectedStatus:      404,
+			ExpectedBodyContain: "The requested API version is not served by this route",
+			Path:                "/e2e/versioned",
+			Method:              "GET",
+			Headers:             map[string]string{"X-API-Version": "3"},
+			RunningMode:         &exExtraRouting,
+		})
+	})
+
 })
@@ -0,0 +1,146 @@
+package e2e
+
+import (
+	"github.com/gopher-fleece/gleece/v2/e2e/common"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// The security schemes configured for the e2e routes, each given a handler delegating to the engine's auth package
+var e2eSecuritySchemes = []string{"securitySchemaName", "securitySchemaName2", "securitySchemaName3"}
+
+// authorizedCredential mirrors the SecurityCredentials passed to the fully featured routes' security handlers
+type authorizedCredential struct {
+	Present  bool
+	ApiKey   string
+	Token    string
+	Username string
+	Password string
+}
+
+var authorizedCredentials []authorizedCredential
+
+var _ = Describe("E2E Security Handlers Spec", func() {
+
+	BeforeEach(func() {
+		authorizedCredentials = nil
+	})
+
+	It("Should pass an API key read from the scheme's configured header to its handler", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should pass an API key read from the scheme's configured header to its handler",
+			ExpectedStatus:      200,
+			ExpectedBodyContain: "securitySchemaNameother",
+			Path:                "/e2e/with-one-security",
+			Method:              "GET",
+			Headers:             map[string]string{"x-header-name": "key-123"},
+			RunningMode:         &fullyFeaturedRouting,
+		})
+
+		Expect(authorizedCredentials).To(HaveLen(5))
+		for _, credentials := range authorizedCredentials {
+			Expect(credentials).To(Equal(authorizedCredential{Present: true, ApiKey: "key-123"}))
+		}
+	})
+
+	It("Should pass a bearer token to the handler of an HTTP bearer scheme", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should pass a bearer token to the handler of an HTTP bearer scheme",
+			ExpectedStatus:      200,
+			ExpectedBodyContain: "securitySchemaName3admin",
+			Path:                "/e2e/with-advanced-security",
+			Method:              "GET",
+			Headers: map[string]string{
+				"fail-auth":     "securitySchemaName",
+				"Authorization": "Bearer token-123",
+			},
+			RunningMode: &fullyFeaturedRouting,
+		})
+
+		// Per engine, the failed 'securitySchemaName' check is followed by the passing 'securitySchemaName3' check
+		Expect(authorizedCredentials).To(HaveLen(10))
+		for index := 1; index < len(authorizedCredentials); index += 2 {
+			Expect(authorizedCredentials[index]).To(Equal(authorizedCredential{Present: true, Token: "token-123"}))
+		}
+	})
+
+	It("Should mark credentials missing from the scheme's expected location as absent", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should mark credentials missing from the scheme's expected location as absent",
+			ExpectedStatus:      200,
+			ExpectedBodyContain: "securitySchemaNameother",
+			Path:                "/e2e/with-one-security",
+			Method:              "GET",
+			Headers:             map[string]string{"Authorization": "Bearer token-123"},
+			RunningMode:         &fullyFeaturedRouting,
+		})
+
+		Expect(authorizedCredentials).To(HaveLen(5))
+		for _, credentials := range authorizedCredentials {
+			Expect(credentials.Present).To(BeFalse())
+		}
+	})
+
+	It("Should inject the principal attached by the security handler", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should inject the principal attached by the security handler",
+			ExpectedStatus: 200,
+			ExpectedBody:   "\"principal-123\"",
+			Path:           "/e2e/with-principal",
+			Method:         "GET",
+			Headers:        map[string]string{"x-header-name": "principal-123"},
+			RunningMode:    &fullyFeaturedRouting,
+		})
+	})
+
+	It("Should return 401 when the security handler did not attach a principal", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should return 401 when the security handler did not attach a principal",
+			ExpectedStatus: 401,
+			ExpectedBody:   "{\"type\":\"Unauthorized\",\"title\":\"\",\"detail\":\"The request is missing an authenticated principal\",\"status\":401,\"instance\":\"/authorization/error/WithPrincipal\",\"extensions\":null}",
+			Path:           "/e2e/with-principal",
+			Method:         "GET",
+			RunningMode:    &fullyFeaturedRouting,
+		})
+	})
+
+	It("Should discard the principal attached by a security list that failed", func() {
+		// 'securitySchemaName' attaches a principal but 'securitySchemaName2' fails its list,
+		// leaving 'securitySchemaName3' which attaches none
+		RunRouterTest(common.RouterTest{
+			Name:           "Should discard the principal attached by a security list that failed",
+			ExpectedStatus: 401,
+			ExpectedBody:   "{\"type\":\"Unauthorized\",\"title\":\"\",\"detail\":\"The request is missing an authenticated principal\",\"status\":401,\"instance\":\"/authorization/error/WithPrincipalAdvancedSecurity\",\"extensions\":null}",
+			Path:           "/e2e/with-principal-advanced-security",
+			Method:         "GET",
+			Headers:        map[string]string{"x-header-name": "principal-123", "fail-auth": "securitySchemaName2"},
+			RunningMode:    &fullyFeaturedRouting,
+		})
+	})
+
+		It("Should return 401 for principal parameters when no security handler attaches a principal", func() {
+		RunRouterTest(common.RouterTest{
+			Name:                "Should return 401 for principal parameters when no security handler attaches a principal",
+			ExpectedStatus:      401,
+			ExpectedBodyContain: "The request is missing an authenticated principal",
+			Path:                "/e2e/with-principal",
+			Method:              "GET",
+			Headers:             map[string]string{"x-header-name": "principal-123"},
+			RunningMode:         &exExtraRouting,
+		})
+	})
+
+	It("Should not invoke any security handler for public routes", func() {
+		RunRouterTest(common.RouterTest{
+			Name:           "Should not invoke any security handler for public routes",
+			ExpectedStatus: 200,
+			ExpectedBody:   "\"public\"",
+			Path:           "/e2e/public",
+			Method:         "GET",
+			Headers:        map[string]string{"x-header-name": "key-123"},
+			RunningMode:    &fullyFeaturedRouting,
+		})
+
+		Expect(authorizedCredentials).To(BeEmpty())
+	})
+})
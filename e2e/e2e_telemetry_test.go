@@ -0,0 +1,204 @@
+package e2e
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	chiTester "github.com/gopher-fleece/gleece/v2/e2e/chi"
+	"github.com/gopher-fleece/gleece/v2/e2e/common"
+	echoTester "github.com/gopher-fleece/gleece/v2/e2e/echo"
+	fiberTester "github.com/gopher-fleece/gleece/v2/e2e/fiber"
+	ginTester "github.com/gopher-fleece/gleece/v2/e2e/gin"
+	muxTester "github.com/gopher-fleece/gleece/v2/e2e/mux"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+// The in-memory exporter and reader collecting the telemetry of the fully featured routes of all engines
+var spanExporter = tracetest.NewInMemoryExporter()
+var tracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(spanExporter))
+var metricReader = sdkmetric.NewManualReader()
+var meterProvider = sdkmetric.NewMeterProvider(sdkmetric.WithReader(metricReader))
+
+var telemetryRouterTesters = []struct {
+	engine string
+	test   func(common.RouterTest) common.RouterTestResult
+}{
+	{engine: "gin", test: ginTester.GinRouterTest},
+	{engine: "echo", test: echoTester.EchoRouterTest},
+	{engine: "mux", test: muxTester.MuxRouterTest},
+	{engine: "chi", test: chiTester.ChiRouterTest},
+	{engine: "fiber", test: fiberTester.FiberRouterTest},
+}
+
+func findSpan(spans tracetest.SpanStubs, name string) *tracetest.SpanStub {
+	for index := range spans {
+		if spans[index].Name == name {
+			return &spans[index]
+		}
+	}
+	return nil
+}
+
+func getSpanAttribute(span *tracetest.SpanStub, key attribute.Key) attribute.Value {
+	for _, attr := range span.Attributes {
+		if attr.Key == key {
+			return attr.Value
+		}
+	}
+	return attribute.Value{}
+}
+
+func getRequestCount(metrics metricdata.ResourceMetrics, operationId string) int64 {
+	count := int64(0)
+	for _, scopeMetrics := range metrics.ScopeMetrics {
+		for _, collected := range scopeMetrics.Metrics {
+			if collected.Name != "http.server.request.count" {
+				continue
+			}
+
+			for _, point := range collected.Data.(metricdata.Sum[int64]).DataPoints {
+				if value, exists := point.Attributes.Value("gleece.operation"); exists && value.AsString() == operationId {
+					count += point.Value
+				}
+			}
+		}
+	}
+	return count
+}
+
+func hasRequestDuration(metrics metricdata.ResourceMetrics, operationId string) bool {
+	for _, scopeMetrics := range metrics.ScopeMetrics {
+		for _, collected := range scopeMetrics.Metrics {
+			if collected.Name != "http.server.request.duration" {
+				continue
+			}
+
+			for _, point := range collected.Data.(metricdata.Histogram[float64]).DataPoints {
+				if value, exists := point.Attributes.Value("gleece.operation"); exists && value.AsString() == operationId {
+					return point.Count > 0
+				}
+			}
+		}
+	}
+	return false
+}
+
+var _ = Describe("E2E Telemetry Spec", func() {
+
+	It("Should trace each operation with authorization, validation and handler spans", func() {
+		for _, tester := range telemetryRouterTesters {
+			spanExporter.Reset()
+
+			result := tester.test(common.RouterTest{
+				Name:        "Should trace each operation - " + tester.engine,
+				Path:        "/e2e/get-with-all-params/pathParam",
+				Method:      "GET",
+				Query:       map[string]string{"queryParam": "queryParam"},
+				Headers:     map[string]string{"headerParam": "headerParam"},
+				RunningMode: &fullyFeaturedRouting,
+			})
+			Expect(result.Code).To(Equal(200), tester.engine)
+
+			spans := spanExporter.GetSpans()
+			operationSpan := findSpan(spans, "GetWithAllParams")
+			Expect(operationSpan).ToNot(BeNil(), tester.engine)
+			Expect(operationSpan.SpanKind).To(Equal(trace.SpanKindServer))
+			Expect(getSpanAttribute(operationSpan, "http.route").AsString()).To(Equal("/e2e/get-with-all-params/{pathParam}"))
+			Expect(getSpanAttribute(operationSpan, "http.request.method").AsString()).To(Equal("GET"))
+			Expect(getSpanAttribute(operationSpan, "gleece.controller").AsString()).To(Equal("E2EController"))
+			Expect(getSpanAttribute(operationSpan, "http.response.status_code").AsInt64()).To(Equal(int64(200)))
+			Expect(operationSpan.Status.Code).To(Equal(codes.Unset))
+
+			for _, phase := range []string{"authorization", "validation", "handler"} {
+				phaseSpan := findSpan(spans, phase)
+				Expect(phaseSpan).ToNot(BeNil(), tester.engine+" - "+phase)
+				Expect(phaseSpan.Parent.SpanID()).To(Equal(operationSpan.SpanContext.SpanID()), tester.engine+" - "+phase)
+			}
+		}
+	})
+
+	It("Should not trace the handler of an operation failing validation", func() {
+		for _, tester := range telemetryRouterTesters {
+			spanExporter.Reset()
+
+			result := tester.test(common.RouterTest{
+				Name:        "Should not trace the handler of an operation failing validation - " + tester.engine,
+				Path:        "/e2e/test-primitive-conversions",
+				Method:      "POST",
+				Query:       map[string]string{"value1": "sixty", "value2": "true", "value3": "10", "value4": "3.14"},
+				RunningMode: &fullyFeaturedRouting,
+			})
+			Expect(result.Code).To(Equal(422), tester.engine)
+
+			spans := spanExporter.GetSpans()
+			operationSpan := findSpan(spans, "TestPrimitiveConversions")
+			Expect(operationSpan).ToNot(BeNil(), tester.engine)
+			Expect(getSpanAttribute(operationSpan, "http.response.status_code").AsInt64()).To(Equal(int64(422)))
+			Expect(findSpan(spans, "validation")).ToNot(BeNil(), tester.engine)
+			Expect(findSpan(spans, "handler")).To(BeNil(), tester.engine)
+		}
+	})
+
+	It("Should mark the operation's span as failed on server errors", func() {
+		for _, tester := range telemetryRouterTesters {
+			spanExporter.Reset()
+
+			result := tester.test(common.RouterTest{
+				Name:        "Should mark the operation's span as failed on server errors - " + tester.engine,
+				Path:        "/e2e/default-error",
+				Method:      "GET",
+				RunningMode: &fullyFeaturedRouting,
+			})
+			Expect(result.Code).To(Equal(500), tester.engine)
+
+			operationSpan := findSpan(spanExporter.GetSpans(), "DefaultError")
+			Expect(operationSpan).ToNot(BeNil(), tester.engine)
+			Expect(operationSpan.Status.Code).To(Equal(codes.Error), tester.engine)
+			Expect(getSpanAttribute(operationSpan, "http.response.status_code").AsInt64()).To(Equal(int64(500)))
+		}
+	})
+
+	It("Should continue a trace propagated via the request's headers", func() {
+		const traceId = "4bf92f3577b34da6a3ce929d0e0e4736"
+		for _, tester := range telemetryRouterTesters {
+			spanExporter.Reset()
+
+			tester.test(common.RouterTest{
+				Name:        "Should continue a propagated trace - " + tester.engine,
+				Path:        "/e2e/simple-get",
+				Method:      "GET",
+				Headers:     map[string]string{"traceparent": "00-" + traceId + "-00f067aa0ba902b7-01"},
+				RunningMode: &fullyFeaturedRouting,
+			})
+
+			operationSpan := findSpan(spanExporter.GetSpans(), "SimpleGet")
+			Expect(operationSpan).ToNot(BeNil(), tester.engine)
+			Expect(operationSpan.SpanContext.TraceID().String()).To(Equal(traceId), tester.engine)
+			Expect(operationSpan.Parent.SpanID().String()).To(Equal("00f067aa0ba902b7"), tester.engine)
+		}
+	})
+
+	It("Should record request count and duration metrics", func() {
+		for _, tester := range telemetryRouterTesters {
+			tester.test(common.RouterTest{
+				Name:        "Should record request metrics - " + tester.engine,
+				Path:        "/e2e/simple-get-empty-string",
+				Method:      "GET",
+				RunningMode: &fullyFeaturedRouting,
+			})
+		}
+
+		var metrics metricdata.ResourceMetrics
+		Expect(metricReader.Collect(context.Background(), &metrics)).To(Succeed())
+		Expect(getRequestCount(metrics, "SimpleGetEmptyString")).To(BeNumerically(">=", len(telemetryRouterTesters)))
+		Expect(hasRequestDuration(metrics, "SimpleGetEmptyString")).To(BeTrue())
+	})
+})
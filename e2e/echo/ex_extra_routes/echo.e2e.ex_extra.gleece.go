@@ -5,7 +5,7 @@ It includes routes and handlers by the Gleece API Routes Generator.
 --
 Authors: Haim Kastner & Yuval Pomerchik
 Generated by: Gleece Routes Generator
-Generated Date: 2025-12-25
+Generated Date: 2026-10-19
 Target Engine: Echo v4 (https://github.com/labstack/echo)
 --
 Usage:
@@ -16,45 +16,57 @@ Repository: https://github.com/gopher-fleece/gleece
 */
 package ex_extra_routes
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 	"github.com/go-playground/validator/v10"
 	RequestAuth "github.com/gopher-fleece/gleece/v2/e2e/echo/auth"
 	"github.com/gopher-fleece/runtime"
 	"github.com/labstack/echo/v4"
 	E2EClassSecController "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	E2EController "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param11value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param12value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param12value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param15data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param16data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param17data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	E2ESharedRateLimitController "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param14value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param18data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param19data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param20data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param22arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param23arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param24object "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param25num "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param26str "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param27values "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param28values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param21data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param22data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param23data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param24arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param25object "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param26num "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param27str "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param28values "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param29values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param31values "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param32values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param30values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param32values "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param33values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param4value2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param5data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param5theBody "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Response6CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param13data "github.com/haimkastner/unitsnet-go/units"
-	Param14unit "github.com/haimkastner/unitsnet-go/units"
+	Param6principal "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Response9CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param16data "github.com/haimkastner/unitsnet-go/units"
+	Param17unit "github.com/haimkastner/unitsnet-go/units"
 	// import extension placeholder
 )
 var validatorInstance = validator.New()
@@ -67,6 +79,24 @@ type SecurityCheckList struct {
 	Checks   []runtime.SecurityCheck
 	Relation SecurityListRelation
 }
+// ValidationIssue describes a single failed validation of a request's input
+type ValidationIssue struct {
+	// The location of the offending input - body, query, path, header or form
+	In string `json:"in"`
+	// A JSON pointer to the offending value, relative to its location
+	Pointer string `json:"pointer"`
+	// The validation rule that failed
+	Rule string `json:"rule"`
+	// The failed rule's parameter, if any
+	Param string `json:"param,omitempty"`
+	// A human-readable description of the failure
+	Message string `json:"message"`
+}
+// ValidationProblem is an RFC7807 error detailing the failed validations of a request
+type ValidationProblem struct {
+	runtime.Rfc7807Error
+	Errors []ValidationIssue `json:"errors"`
+}
 // type declarations extension placeholder
 func getRequestContext(echoCtx echo.Context) context.Context {
 	return echoCtx.Request().Context()
@@ -77,6 +107,56 @@ func setRequestContext(echoCtx echo.Context, ctx context.Context) {
 		echoCtx.SetRequest(newCtx)
 	}
 }
+type principalContextKey struct{}
+// WithPrincipal attaches the request's authenticated principal to the given context.
+//
+// Security handlers return the resulting context to make the principal available to @Principal parameters
+func WithPrincipal(ctx context.Context, principal any) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+// getPrincipal retrieves the principal attached to the given context via WithPrincipal.
+// Yields nil if no principal of type TPrincipal (or a pointer to one) was attached
+func getPrincipal[TPrincipal any](ctx context.Context) *TPrincipal {
+	switch principal := ctx.Value(principalContextKey{}).(type) {
+	case *TPrincipal:
+		return principal
+	case TPrincipal:
+		return &principal
+	default:
+		return nil
+	}
+}
+// IdentifiablePrincipal may be implemented by principals to provide a stable identity, e.g. a user ID,
+// by which their idempotent requests and principal keyed rate limits are scoped
+type IdentifiablePrincipal interface {
+	IdempotencyIdentity() string
+}
+// describePrincipal returns a stable textual identity of the principal attached to the given context via WithPrincipal,
+// i.e., its IdempotencyIdentity or String method's result, if any, or its dereferenced value if of a primitive kind.
+// Yields an empty string if no principal was attached.
+//
+// Returns false for principals lacking a stable identity, e.g. structs, whose formatting may vary between requests
+func describePrincipal(ctx context.Context) (string, bool) {
+	principal := ctx.Value(principalContextKey{})
+	switch identifiable := principal.(type) {
+	case nil:
+		return "", true
+	case IdentifiablePrincipal:
+		return identifiable.IdempotencyIdentity(), true
+	case fmt.Stringer:
+		return identifiable.String(), true
+	}
+	value := reflect.Indirect(reflect.ValueOf(principal))
+	switch value.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprintf("%v", value), true
+	default:
+		return "", false
+	}
+}
 func registerEnumValidation(validate *validator.Validate, validationName string, allowedValues []string) {
 	// Convert the array to a map for O(1) lookup
 	lookup := make(map[string]struct{})
@@ -90,12 +170,12 @@ func registerEnumValidation(validate *validator.Validate, validationName string,
 		return exists
 	})
 }
-func extractValidationErrorMessage(err error, fieldName *string) string {
+func extractValidationErrorMessage(err error, fieldName *string, languages []string) string {
 	if err == nil {
 		return ""
 	}
-	validationErrors, ok := err.(validator.ValidationErrors)
-	if !ok {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
 		return err.Error()
 	}
 	var errStr string
@@ -104,15 +184,26 @@ func extractValidationErrorMessage(err error, fieldName *string) string {
 		if fieldName != nil {
 			fName = *fieldName
 		}
-		errStr += fmt.Sprintf("Field '%s' failed validation with tag '%s'. ", fName, validationErr.Tag())
+		errStr += translateMessage(
+			languages,
+			map[string]string{"field": fName, "tag": validationErr.Tag(), "param": validationErr.Param()},
+			ValidationTagMessageKey(validationErr.Tag()),
+			MessageKeyFieldValidation,
+		) + ". "
 	}
 	return errStr
 }
-func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error) int {
+// getStatusCode returns the status code of an operation's response - the one set via the controller's SetStatus, if any,
+// that of the error mapping matching err among the operation's declared error status codes or a 500 for other errors,
+// or the success status code
+func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error, routeErrorStatusCodes []int) int {
 	if controller.GetStatus() != nil {
 		return int(*controller.GetStatus())
 	}
 	if err != nil {
+		if mapping := getErrorMapping(err, routeErrorStatusCodes); mapping != nil {
+			return mapping.StatusCode
+		}
 		return http.StatusInternalServerError
 	}
 	if hasReturnValue {
@@ -125,7 +216,7 @@ func bindAndValidateBody[TOutput any](echoCtx echo.Context, contentType string,
 	bodyBytes, err := io.ReadAll(echoCtx.Request().Body)
 	if err != nil || len(bodyBytes) == 0 {
 		if strings.Contains(validation, "required") {
-			return fmt.Errorf("body is required but was not provided")
+			return errBodyRequired
 		}
 		return nil
 	}
@@ -160,12 +251,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Slice, reflect.Array:
 		// For slices/arrays, validate each element recursively
 		for i := 0; i < val.Len(); i++ {
-			elemPath := path
-			if path != "" {
-				elemPath = fmt.Sprintf("%s[%d]", path, i)
-			} else {
-				elemPath = fmt.Sprintf("[%d]", i)
-			}
+			elemPath := fmt.Sprintf("%s/%d", path, i)
 			// Get the element - handle case where element might be nil
 			elem := val.Index(i)
 			if elem.Kind() == reflect.Ptr && elem.IsNil() {
@@ -180,12 +266,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Map:
 		// For maps, validate each value recursively
 		for _, key := range val.MapKeys() {
-			elemPath := path
-			if path != "" {
-				elemPath = fmt.Sprintf("%s.%v", path, key.Interface())
-			} else {
-				elemPath = fmt.Sprintf("%v", key.Interface())
-			}
+			elemPath := fmt.Sprintf("%s/%s", path, escapeJsonPointerToken(fmt.Sprintf("%v", key.Interface())))
 			elemVal := val.MapIndex(key)
 			if elemVal.Kind() == reflect.Ptr && elemVal.IsNil() {
 				continue
@@ -198,10 +279,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Struct:
 		// Validate structs with the validator
 		if err := validatorInstance.Struct(data); err != nil {
-			if path != "" {
-				return fmt.Errorf("validation error at %s: %w", path, err)
-			}
-			return err
+			return &structValidationError{pointer: path, structType: val.Type(), err: err}
 		}
 		return nil
 	default:
@@ -220,16 +298,34 @@ func toEchoUrl(url string) string {
 	}
 	return processedUrl
 }
+// withBasePath prefixes the given route path with the given base path
+func withBasePath(basePath string, path string) string {
+	basePath = strings.TrimSuffix(basePath, "/")
+	if basePath != "" && !strings.HasPrefix(basePath, "/") {
+		basePath = "/" + basePath
+	}
+	return basePath + path
+}
+// EchoRouter is implemented by both *echo.Echo and *echo.Group
+type EchoRouter interface {
+	Add(method string, path string, handler echo.HandlerFunc, middleware ...echo.MiddlewareFunc) *echo.Route
+}
+// authorize succeeds if all checks of any of the given lists pass.
+// Each list is an alternative (OR) of security schemas that must all be satisfied (AND), as expanded
+// from the route's @Security and @AdvancedSecurity annotations
 func authorize(echoCtx echo.Context, checksLists []SecurityCheckList) *runtime.SecurityError {
 	var lastError *runtime.SecurityError
 	for _, list := range checksLists {
 		if list.Relation != SecurityListRelationAnd {
-			panic(
-				"Encountered a security list relation of type '%s' - this is unexpected and indicates a bug in Gleece itself." +
+			panic(fmt.Sprintf(
+				"Encountered a security list relation of type '%s' - this is unexpected and indicates a bug in Gleece itself. "+
 					"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
-			)
+				list.Relation,
+			))
 		}
-		// Iterate over each security list
+		// Iterate over each security list.
+		// Checks may attach values (e.g. a principal) to the request context - these are discarded if the list fails
+		listCtx := getRequestContext(echoCtx)
 		encounteredErrorInList := false
 		for _, check := range list.Checks {
 			secCtx, secErr := RequestAuth.GleeceRequestAuthorization(getRequestContext(echoCtx), echoCtx, check)
@@ -245,6 +341,7 @@ func authorize(echoCtx echo.Context, checksLists []SecurityCheckList) *runtime.S
 		if !encounteredErrorInList {
 			return nil
 		}
+		setRequestContext(echoCtx, listCtx)
 	}
 	// If we got here it means authentication has failed
 	return lastError
@@ -263,20 +360,921 @@ func handleAuthorizationError(echoCtx echo.Context, authErr *runtime.SecurityErr
 	}
 	return echoCtx.JSON(statusCode, stdError)
 }
-func wrapValidatorError(validatorErr error, operationId string, fieldName string) runtime.Rfc7807Error {
+func wrapValidatorError(validatorErr error, operationId string, fieldName string, languages []string) runtime.Rfc7807Error {
 	return runtime.Rfc7807Error{
 		Type: http.StatusText(http.StatusUnprocessableEntity),
-		Detail: fmt.Sprintf(
-			"A request was made to operation '%s' but parameter '%s' did not pass validation - %s",
-			operationId,
-			fieldName,
-			extractValidationErrorMessage(validatorErr, &fieldName),
+		Detail: translateMessage(
+			languages,
+			map[string]string{
+				"operation": operationId,
+				"parameter": fieldName,
+				"details":   extractValidationErrorMessage(validatorErr, &fieldName, languages),
+			},
+			MessageKeyParamValidation,
 		),
 		Status:   http.StatusUnprocessableEntity,
 		Instance: fmt.Sprintf("/validation/error/%s", operationId),
 	}
 }
+// paramInteger is the set of signed integer types a request parameter may be converted to
+type paramInteger interface {
+	int | int8 | int16 | int32 | int64
+}
+// paramUnsigned is the set of unsigned integer types a request parameter may be converted to
+type paramUnsigned interface {
+	uint | uint8 | uint16 | uint32 | uint64
+}
+// paramFloat is the set of floating point types a request parameter may be converted to
+type paramFloat interface {
+	float32 | float64
+}
+// parseIntegerParam converts the raw value of a request parameter to the given signed integer type
+func parseIntegerParam[T paramInteger](raw string) (T, error) {
+	parsed, err := strconv.ParseInt(raw, 10, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// parseUnsignedParam converts the raw value of a request parameter to the given unsigned integer type
+func parseUnsignedParam[T paramUnsigned](raw string) (T, error) {
+	parsed, err := strconv.ParseUint(raw, 10, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// parseFloatParam converts the raw value of a request parameter to the given floating point type
+func parseFloatParam[T paramFloat](raw string) (T, error) {
+	parsed, err := strconv.ParseFloat(raw, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// wrapConversionError creates the RFC7807 error and validation issues describing a request parameter
+// whose raw value could not be converted to the parameter's type
+func wrapConversionError(
+	conversionErr error,
+	operationId string,
+	fieldName string,
+	in string,
+	pointer string,
+	expectedType string,
+	actualType string,
+	languages []string,
+) (runtime.Rfc7807Error, []ValidationIssue) {
+	validationError := runtime.Rfc7807Error{
+		Type: http.StatusText(http.StatusUnprocessableEntity),
+		Detail: translateMessage(
+			languages,
+			map[string]string{
+				"operation": operationId,
+				"parameter": fieldName,
+				"expected":  expectedType,
+				"actual":    actualType,
+			},
+			MessageKeyParamConversion,
+		),
+		Status:     http.StatusUnprocessableEntity,
+		Instance:   fmt.Sprintf("/validation/error/%s", operationId),
+		Extensions: map[string]string{"error": conversionErr.Error()},
+	}
+	validationIssues := []ValidationIssue{
+		{
+			In:      in,
+			Pointer: pointer,
+			Rule:    "type",
+			Param:   expectedType,
+			Message: conversionErr.Error(),
+		},
+	}
+	return validationError, validationIssues
+}
+var errBodyRequired = errors.New("body is required but was not provided")
+// structValidationError associates a struct's validation errors with the struct's location within the validated payload
+type structValidationError struct {
+	pointer    string
+	structType reflect.Type
+	err        error
+}
+func (e *structValidationError) Error() string {
+	if e.pointer == "" {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("validation error at %s: %s", e.pointer, e.err.Error())
+}
+func (e *structValidationError) Unwrap() error {
+	return e.err
+}
+func escapeJsonPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+// getJsonFieldName returns the name of the given field within its struct's JSON representation.
+//
+// Embedded structs without an explicit name are flattened by encoding/json and therefore yield an empty name
+func getJsonFieldName(field reflect.StructField) string {
+	if name := strings.Split(field.Tag.Get("json"), ",")[0]; name != "" {
+		return name
+	}
+	fieldType := field.Type
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	if field.Anonymous && fieldType.Kind() == reflect.Struct {
+		return ""
+	}
+	return field.Name
+}
+// toJsonPointer converts a validator struct namespace (e.g. 'Model.Items[0].Name') to a JSON pointer (e.g. '/items/0/name')
+// using the json tags of the root type's fields
+func toJsonPointer(rootType reflect.Type, structNamespace string) string {
+	pointer := ""
+	currentType := rootType
+	segments := strings.Split(structNamespace, ".")
+	for _, segment := range segments[1:] {
+		name, indices := segment, []string{}
+		if bracket := strings.Index(segment, "["); bracket >= 0 {
+			name = segment[:bracket]
+			indices = strings.Split(strings.TrimSuffix(segment[bracket+1:], "]"), "][")
+		}
+		for currentType != nil && currentType.Kind() == reflect.Ptr {
+			currentType = currentType.Elem()
+		}
+		token := name
+		if currentType != nil && currentType.Kind() == reflect.Struct {
+			if field, found := currentType.FieldByName(name); found {
+				currentType = field.Type
+				token = getJsonFieldName(field)
+			} else {
+				currentType = nil
+			}
+		}
+		if token != "" {
+			pointer += "/" + escapeJsonPointerToken(token)
+		}
+		for _, index := range indices {
+			pointer += "/" + escapeJsonPointerToken(index)
+			for currentType != nil && currentType.Kind() == reflect.Ptr {
+				currentType = currentType.Elem()
+			}
+			if currentType != nil && (currentType.Kind() == reflect.Slice || currentType.Kind() == reflect.Array || currentType.Kind() == reflect.Map) {
+				currentType = currentType.Elem()
+			} else {
+				currentType = nil
+			}
+		}
+	}
+	return pointer
+}
+func getValidationIssueMessage(pointer string, tag string, param string, languages []string) string {
+	args := map[string]string{"tag": tag, "param": param}
+	if pointer == "" {
+		return translateMessage(languages, args, ValidationTagMessageKey(tag), MessageKeyValueValidation)
+	}
+	args["field"] = pointer[strings.LastIndex(pointer, "/")+1:]
+	return translateMessage(languages, args, ValidationTagMessageKey(tag), MessageKeyFieldValidation)
+}
+// getValidationIssues converts the given validation or deserialization error to a list of issues at the given location.
+//
+// The pointer is the JSON pointer of the validated value, relative to its location
+func getValidationIssues(err error, in string, pointer string, languages []string) []ValidationIssue {
+	var structType reflect.Type
+	var structErr *structValidationError
+	if errors.As(err, &structErr) {
+		pointer += structErr.pointer
+		structType = structErr.structType
+	}
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		issues := make([]ValidationIssue, 0, len(validationErrors))
+		for _, validationErr := range validationErrors {
+			issuePointer := pointer
+			if structType != nil {
+				issuePointer += toJsonPointer(structType, validationErr.StructNamespace())
+			}
+			issues = append(issues, ValidationIssue{
+				In:      in,
+				Pointer: issuePointer,
+				Rule:    validationErr.Tag(),
+				Param:   validationErr.Param(),
+				Message: getValidationIssueMessage(issuePointer, validationErr.Tag(), validationErr.Param(), languages),
+			})
+		}
+		return issues
+	}
+	issue := ValidationIssue{In: in, Pointer: pointer, Rule: "parse", Message: err.Error()}
+	var typeErr *json.UnmarshalTypeError
+	if errors.Is(err, errBodyRequired) {
+		issue.Rule = "required"
+	} else if errors.As(err, &typeErr) {
+		if typeErr.Field != "" {
+			issue.Pointer += "/" + strings.ReplaceAll(typeErr.Field, ".", "/")
+		}
+		issue.Rule = "type"
+		issue.Param = typeErr.Type.String()
+	}
+	return []ValidationIssue{issue}
+}
+// Keys of the translatable validation messages.
+//
+// Message templates use named placeholders, such as '{field}', which are replaced with the message's arguments
+const (
+	// Describes a parameter that did not pass validation. Placeholders: {operation}, {parameter}, {details}
+	MessageKeyParamValidation = "paramValidation"
+	// Describes a body that did not pass validation. Placeholders: {operation}, {parameter}, {type}, {details}
+	MessageKeyBodyValidation = "bodyValidation"
+	// Describes a parameter that could not be converted to its declared type. Placeholders: {operation}, {parameter}, {expected}, {actual}
+	MessageKeyParamConversion = "paramConversion"
+	// Describes a field that failed a validation tag with no dedicated message. Placeholders: {field}, {tag}, {param}
+	MessageKeyFieldValidation = "fieldValidation"
+	// Describes a value that failed a validation tag with no dedicated message. Placeholders: {tag}, {param}
+	MessageKeyValueValidation = "valueValidation"
+)
+// The language of the default message catalogue
+const defaultMessageLanguage = "en"
+// The default English message catalogue, used whenever no translation is available
+var defaultMessages = map[string]string{
+	MessageKeyParamValidation: "A request was made to operation '{operation}' but parameter '{parameter}' did not pass validation - {details}",
+	MessageKeyBodyValidation:  "A request was made to operation '{operation}' but body parameter '{parameter}' did not pass validation of '{type}' - {details}",
+	MessageKeyParamConversion: "A request was made to operation '{operation}' but parameter '{parameter}' was not properly sent - Expected {expected} but got {actual}",
+	MessageKeyFieldValidation: "Field '{field}' failed validation with tag '{tag}'",
+	MessageKeyValueValidation: "Value failed validation with tag '{tag}'",
+}
+// MessageTranslator provides validation message templates in the languages requested via the Accept-Language header
+type MessageTranslator interface {
+	// Translate returns the message template registered for the given language and key, if any
+	Translate(language string, key string) (string, bool)
+}
+// MessageCatalogue is a MessageTranslator backed by an in-memory map of language to message key to template.
+//
+// Languages are matched case-insensitively
+type MessageCatalogue map[string]map[string]string
+func (catalogue MessageCatalogue) Translate(language string, key string) (string, bool) {
+	template, found := catalogue[strings.ToLower(language)][key]
+	return template, found
+}
+var messageCatalogue = MessageCatalogue{}
+var messageTranslator MessageTranslator = messageCatalogue
+// SetMessageTranslator replaces the built-in message catalogue with the given translator,
+// e.g. an adapter over go-playground's universal translator.
+//
+// Messages the translator does not provide fall back to the default English catalogue
+func SetMessageTranslator(translator MessageTranslator) {
+	if translator == nil {
+		translator = messageCatalogue
+	}
+	messageTranslator = translator
+}
+// RegisterTranslation registers the template of the given message key for the given language in the built-in catalogue.
+// Has no effect on translators set via SetMessageTranslator
+func RegisterTranslation(language string, key string, template string) {
+	language = strings.ToLower(language)
+	if messageCatalogue[language] == nil {
+		messageCatalogue[language] = map[string]string{}
+	}
+	messageCatalogue[language][key] = template
+}
+// ValidationTagMessageKey returns the message key of the given validation tag, e.g. 'required'.
+//
+// Templates registered under this key take precedence over the generic field and value messages.
+// Placeholders: {field}, {tag}, {param}
+func ValidationTagMessageKey(tag string) string {
+	return "tag." + tag
+}
+// RegisterValidationTagTranslation registers the message used when the given validation tag fails, for the given language
+func RegisterValidationTagTranslation(language string, tag string, template string) {
+	RegisterTranslation(language, ValidationTagMessageKey(tag), template)
+}
+// getAcceptedLanguages returns the languages listed in the given Accept-Language header, most preferred first.
+// Regional variants are followed by their base language, e.g. 'fr-CA' by 'fr'
+func getAcceptedLanguages(header string) []string {
+	type weightedLanguage struct {
+		tag    string
+		weight float64
+	}
+	weightedLanguages := []weightedLanguage{}
+	for _, entry := range strings.Split(header, ",") {
+		parts := strings.Split(entry, ";")
+		tag := strings.TrimSpace(parts[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		weight := 1.0
+		for _, param := range parts[1:] {
+			if value, found := strings.CutPrefix(strings.TrimSpace(param), "q="); found {
+				if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+					weight = parsed
+				}
+			}
+		}
+		if weight > 0 {
+			weightedLanguages = append(weightedLanguages, weightedLanguage{tag: tag, weight: weight})
+		}
+	}
+	slices.SortStableFunc(weightedLanguages, func(a, b weightedLanguage) int {
+		if a.weight > b.weight {
+			return -1
+		}
+		if a.weight < b.weight {
+			return 1
+		}
+		return 0
+	})
+	languages := make([]string, 0, len(weightedLanguages))
+	for _, language := range weightedLanguages {
+		languages = append(languages, language.tag)
+		if base, _, hasRegion := strings.Cut(language.tag, "-"); hasRegion {
+			languages = append(languages, base)
+		}
+	}
+	return languages
+}
+// getMessageTemplate returns the template of the first of the given keys available in the most preferred of the given languages,
+// falling back to English and finally to the default English catalogue
+func getMessageTemplate(languages []string, keys ...string) string {
+	for _, language := range append(slices.Clip(languages), defaultMessageLanguage) {
+		for _, key := range keys {
+			if template, found := messageTranslator.Translate(language, key); found {
+				return template
+			}
+		}
+	}
+	for _, key := range keys {
+		if template, found := defaultMessages[key]; found {
+			return template
+		}
+	}
+	return ""
+}
+// translateMessage formats the template of the given keys in the given languages, replacing its placeholders with the given arguments
+func translateMessage(languages []string, args map[string]string, keys ...string) string {
+	replacements := make([]string, 0, len(args)*2)
+	for name, value := range args {
+		replacements = append(replacements, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(replacements...).Replace(getMessageTemplate(languages, keys...))
+}
+// ControllerFactory creates the controller instance handling a single request.
+//
+// The given context is the request's context
+type ControllerFactory[TController any] func(ctx context.Context) *TController
+var controllerFactories = map[reflect.Type]func(ctx context.Context) any{}
+// RegisterControllerFactory registers the factory used to create a controller for each request it handles.
+//
+// Factories allow controllers to hold injected dependencies such as database handles, services or loggers.
+// Must be called before RegisterRoutes; the factory must not return nil
+func RegisterControllerFactory[TController any](factory ControllerFactory[TController]) {
+	controllerFactories[reflect.TypeOf((*TController)(nil)).Elem()] = func(ctx context.Context) any {
+		return factory(ctx)
+	}
+}
+// createController creates a controller using its registered factory or, if none exists, its zero value
+func createController[TController any](ctx context.Context) *TController {
+	if factory, exists := controllerFactories[reflect.TypeOf((*TController)(nil)).Elem()]; exists {
+		return factory(ctx).(*TController)
+	}
+	return new(TController)
+}
+// ensureControllerFactoriesRegistered panics if any of the given controller types has no registered factory
+func ensureControllerFactoriesRegistered(controllerTypes []reflect.Type) {
+	missing := []string{}
+	for _, controllerType := range controllerTypes {
+		if _, registered := controllerFactories[controllerType]; !registered {
+			missing = append(missing, controllerType.String())
+		}
+	}
+	if len(missing) > 0 {
+		panic(fmt.Sprintf(
+			"controller factories are required but were never registered using RegisterControllerFactory: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
+// ErrorMapping describes the response returned when a controller's error matches a registered mapping
+type ErrorMapping struct {
+	// The HTTP status code to respond with. Must be declared by at least one @ErrorResponse annotation.
+	// The mapping applies only to operations declaring the status code - errors of other operations remain 500s
+	StatusCode int
+	// The RFC7807 title. Defaults to the status code's text
+	Title string
+	// A client-safe description of the error. Defaults to a generic, operation-specific message
+	Detail string
+}
+type registeredErrorMapping struct {
+	matches func(err error) bool
+	mapping ErrorMapping
+}
+var errorMappings = []registeredErrorMapping{}
+var declaredErrorStatusCodes = []int{404, 409, 500}
+// RegisterErrorMapping maps errors matching the given target, via errors.Is, to the given HTTP status code.
+//
+// Mappings are evaluated in order of registration and are superseded by an explicit call to the controller's SetStatus
+func RegisterErrorMapping(target error, statusCode int) {
+	RegisterDetailedErrorMapping(target, ErrorMapping{StatusCode: statusCode})
+}
+// RegisterDetailedErrorMapping maps errors matching the given target, via errors.Is, to the given response
+func RegisterDetailedErrorMapping(target error, mapping ErrorMapping) {
+	addErrorMapping(func(err error) bool { return errors.Is(err, target) }, mapping)
+}
+// RegisterErrorTypeMapping maps errors of type TError, matched via errors.As, to the given response
+func RegisterErrorTypeMapping[TError error](mapping ErrorMapping) {
+	addErrorMapping(func(err error) bool {
+		var target TError
+		return errors.As(err, &target)
+	}, mapping)
+}
+func addErrorMapping(matches func(err error) bool, mapping ErrorMapping) {
+	if !slices.Contains(declaredErrorStatusCodes, mapping.StatusCode) {
+		panic(fmt.Sprintf("Error mapping status code %d is not declared by any @ErrorResponse annotation", mapping.StatusCode))
+	}
+	errorMappings = append(errorMappings, registeredErrorMapping{matches: matches, mapping: mapping})
+}
+// getErrorMapping returns the first registered mapping matching err whose status code is among the given ones, declared by
+// the operation that produced err, or nil if there is none
+func getErrorMapping(err error, routeErrorStatusCodes []int) *ErrorMapping {
+	for _, registered := range errorMappings {
+		if slices.Contains(routeErrorStatusCodes, registered.mapping.StatusCode) && registered.matches(err) {
+			return &registered.mapping
+		}
+	}
+	return nil
+}
+// applyErrorMapping overrides the given RFC7807 error's title and detail with those of the mapping matching err, if any
+func applyErrorMapping(stdError *runtime.Rfc7807Error, err error, routeErrorStatusCodes []int) {
+	mapping := getErrorMapping(err, routeErrorStatusCodes)
+	if mapping == nil {
+		return
+	}
+	stdError.Title = http.StatusText(stdError.Status)
+	if mapping.Title != "" {
+		stdError.Title = mapping.Title
+	}
+	if mapping.Detail != "" {
+		stdError.Detail = mapping.Detail
+	}
+}
 // function declarations extension placeholder
+// DefaultApiVersion is the API version served to requests that do not specify one
+const DefaultApiVersion = 2
+// The request header specifying the requested API version
+const apiVersionHeader = "X-API-Version"
+// The handlers of the versioned routes registered by RegisterGroupRoutes, per method and URL and then per API version
+var versionedRouteHandlers map[string]map[int]echo.HandlerFunc
+// registerVersionedRoute registers the given handler for each of the given API versions.
+//
+// Routes sharing a method and a path are registered once and dispatched according to the requested API version
+func registerVersionedRoute(engine EchoRouter, basePath string, method string, path string, versions []int, handler echo.HandlerFunc) {
+	url := toEchoUrl(withBasePath(basePath, path))
+	handlers, exists := versionedRouteHandlers[method+" "+url]
+	if !exists {
+		handlers = map[int]echo.HandlerFunc{}
+		versionedRouteHandlers[method+" "+url] = handlers
+		engine.Add(method, url, func(echoCtx echo.Context) error {
+			version, isValid := getRequestedApiVersion(echoCtx.Request().Header.Get(apiVersionHeader))
+			versionHandler, isServed := handlers[version]
+			if !isValid || !isServed {
+				return echoCtx.JSON(http.StatusNotFound, getUnservedApiVersionError(echoCtx.Request().Header.Get(apiVersionHeader)))
+			}
+			return versionHandler(echoCtx)
+		})
+	}
+	for _, version := range versions {
+		handlers[version] = handler
+	}
+}
+// getRequestedApiVersion returns the API version specified by the given header value, or DefaultApiVersion if none is specified.
+// Versions may be given with or without a 'v' prefix, e.g. '2' or 'v2'
+func getRequestedApiVersion(headerValue string) (int, bool) {
+	requested := strings.TrimSpace(headerValue)
+	if requested == "" {
+		return DefaultApiVersion, true
+	}
+	version, err := strconv.Atoi(strings.TrimPrefix(strings.ToLower(requested), "v"))
+	return version, err == nil
+}
+// getUnservedApiVersionError returns the RFC7807 error with which requests for an API version not served by a route are answered
+func getUnservedApiVersionError(headerValue string) runtime.Rfc7807Error {
+	return runtime.Rfc7807Error{
+		Type:       http.StatusText(http.StatusNotFound),
+		Detail:     fmt.Sprintf("The requested API version is not served by this route (%s: '%s')", apiVersionHeader, headerValue),
+		Status:     http.StatusNotFound,
+		Instance:   "/versioning/error",
+		Extensions: map[string]string{},
+	}
+}
+// RateLimit describes the number of requests an operation accepts per time window, as declared via @RateLimit
+type RateLimit struct {
+	// The number of requests allowed per window
+	Requests int
+	// The window's duration
+	Per time.Duration
+	// The key by which requests are counted - 'ip', 'principal' or 'header:<Name>'
+	Key string
+	// The scope within which requests are counted - the declaring controller's name for limits inherited from a controller,
+	// which are shared by all of its operations inheriting them, or the operation's ID for limits declared by the operation
+	Scope string
+}
+// RateLimiter decides whether requests may proceed under their operation's rate limit.
+//
+// Implementations must be safe for concurrent use
+type RateLimiter interface {
+	// Allow consumes a single request of the given key against the given operation's limit, counted within the limit's scope.
+	// Returns whether the request may proceed and, if not, how long until it may be retried
+	Allow(ctx context.Context, operationId string, key string, limit RateLimit) (bool, time.Duration)
+}
+// InMemoryRateLimiter is a RateLimiter holding a token bucket per limit scope and key in the process's memory.
+//
+// Each bucket holds up to the limit's number of requests and is refilled evenly over the limit's window.
+// Limits are not shared across instances - deployments with multiple instances should use a shared RateLimiter instead
+type InMemoryRateLimiter struct {
+	mutex     sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+type tokenBucket struct {
+	tokens          float64
+	capacity        float64
+	refillPerSecond float64
+	lastRefill      time.Time
+}
+// The interval at which an InMemoryRateLimiter drops buckets that have been refilled to capacity
+const rateLimitSweepInterval = time.Minute
+// NewInMemoryRateLimiter creates an InMemoryRateLimiter with no buckets
+func NewInMemoryRateLimiter() *InMemoryRateLimiter {
+	return &InMemoryRateLimiter{buckets: map[string]*tokenBucket{}, lastSweep: time.Now()}
+}
+func (limiter *InMemoryRateLimiter) Allow(_ context.Context, _ string, key string, limit RateLimit) (bool, time.Duration) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	now := time.Now()
+	limiter.sweep(now)
+	bucketKey := limit.Scope + "\x00" + key
+	bucket, exists := limiter.buckets[bucketKey]
+	if !exists {
+		capacity := float64(limit.Requests)
+		bucket = &tokenBucket{
+			tokens:          capacity,
+			capacity:        capacity,
+			refillPerSecond: capacity / limit.Per.Seconds(),
+			lastRefill:      now,
+		}
+		limiter.buckets[bucketKey] = bucket
+	}
+	bucket.tokens = bucket.tokensAt(now)
+	bucket.lastRefill = now
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - bucket.tokens) / bucket.refillPerSecond * float64(time.Second))
+}
+// sweep drops the buckets that have been refilled to capacity, as those are indistinguishable from new ones
+func (limiter *InMemoryRateLimiter) sweep(now time.Time) {
+	if now.Sub(limiter.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	for bucketKey, bucket := range limiter.buckets {
+		if bucket.tokensAt(now) >= bucket.capacity {
+			delete(limiter.buckets, bucketKey)
+		}
+	}
+	limiter.lastSweep = now
+}
+// tokensAt returns the number of tokens the bucket holds at the given time
+func (bucket *tokenBucket) tokensAt(now time.Time) float64 {
+	return math.Min(bucket.capacity, bucket.tokens+now.Sub(bucket.lastRefill).Seconds()*bucket.refillPerSecond)
+}
+var rateLimiter RateLimiter = NewInMemoryRateLimiter()
+// SetRateLimiter replaces the default InMemoryRateLimiter with the given limiter,
+// e.g. one backed by a store shared across instances
+func SetRateLimiter(limiter RateLimiter) {
+	if limiter == nil {
+		limiter = NewInMemoryRateLimiter()
+	}
+	rateLimiter = limiter
+}
+// newRateLimit creates an operation's RateLimit, counted within the given scope, out of its @RateLimit annotation's properties
+func newRateLimit(requests int, per string, key string, scope string) RateLimit {
+	window, err := time.ParseDuration(per)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid rate limit window '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			per,
+		))
+	}
+	return RateLimit{Requests: requests, Per: window, Key: key, Scope: scope}
+}
+// getRateLimitKey returns the value by which the request is counted against a rate limit with the given key.
+//
+// Requests lacking the key's header or an identifiable principal are counted by their client IP
+func getRateLimitKey(echoCtx echo.Context, key string) string {
+	value := ""
+	if headerName, isHeaderKey := strings.CutPrefix(key, "header:"); isHeaderKey {
+		value = echoCtx.Request().Header.Get(headerName)
+	} else if key == "principal" {
+		value, _ = describePrincipal(getRequestContext(echoCtx))
+	}
+	if value == "" {
+		return echoCtx.RealIP()
+	}
+	return key + "=" + value
+}
+// enforceRateLimit counts the request against the given operation's rate limit.
+//
+// Requests exceeding the limit are answered with a 429 RFC7807 error and a 'Retry-After' header, in which case false is returned
+// along with the error of writing the response
+func enforceRateLimit(echoCtx echo.Context, operationId string, limit RateLimit) (bool, error) {
+	allowed, retryAfter := rateLimiter.Allow(getRequestContext(echoCtx), operationId, getRateLimitKey(echoCtx, limit.Key), limit)
+	if allowed {
+		return true, nil
+	}
+	echoCtx.Response().Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	return false, echoCtx.JSON(http.StatusTooManyRequests, runtime.Rfc7807Error{
+		Type:     http.StatusText(http.StatusTooManyRequests),
+		Detail:   fmt.Sprintf("Too many requests were made to operation '%s'. Please retry later", operationId),
+		Status:   http.StatusTooManyRequests,
+		Instance: "/ratelimit/error/" + operationId,
+	})
+}
+// newOperationTimeout creates an operation's deadline duration out of its @Timeout annotation or the configured default timeout
+func newOperationTimeout(timeout string) time.Duration {
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid operation timeout '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			timeout,
+		))
+	}
+	return duration
+}
+// applyOperationTimeout replaces the request's context with one that expires once the given timeout elapses.
+//
+// Operations are not interrupted when the deadline expires - controllers are expected to honor their context's cancellation.
+// The returned function releases the context's resources and must be called once the request has been handled
+func applyOperationTimeout(echoCtx echo.Context, timeout time.Duration) context.CancelFunc {
+	ctx, cancel := context.WithTimeout(getRequestContext(echoCtx), timeout)
+	setRequestContext(echoCtx, ctx)
+	return cancel
+}
+// isDeadlineExceeded returns whether the request's context has expired due to its operation's timeout
+func isDeadlineExceeded(echoCtx echo.Context) bool {
+	return errors.Is(getRequestContext(echoCtx).Err(), context.DeadlineExceeded)
+}
+// handleDeadlineExceeded answers a request whose operation's deadline has expired with an RFC7807 error of the given status -
+// 503 if the operation has not been invoked yet or 504 if it has
+func handleDeadlineExceeded(echoCtx echo.Context, operationId string, status int) error {
+	detail := fmt.Sprintf("The deadline of operation '%s' expired before the operation was invoked", operationId)
+	if status == http.StatusGatewayTimeout {
+		detail = fmt.Sprintf("Operation '%s' did not complete before its deadline expired", operationId)
+	}
+	return echoCtx.JSON(status, runtime.Rfc7807Error{
+		Type:     http.StatusText(status),
+		Detail:   detail,
+		Status:   status,
+		Instance: "/timeout/error/" + operationId,
+	})
+}
+// enforceMaxBodySize checks the request's body against the given operation's maximum body size.
+//
+// Bodies of unknown length are read up-front, up to the maximum size.
+// Requests whose body exceeds the maximum size are answered with a 413 RFC7807 error, in which case false is returned
+func enforceMaxBodySize(echoCtx echo.Context, operationId string, maxBodySize int64) (bool, error) {
+	request := echoCtx.Request()
+	bodySize := request.ContentLength
+	if bodySize < 0 {
+		body, _ := io.ReadAll(io.LimitReader(request.Body, maxBodySize+1))
+		request.Body = io.NopCloser(bytes.NewReader(body))
+		bodySize = int64(len(body))
+	}
+	if bodySize <= maxBodySize {
+		return true, nil
+	}
+	return false, echoCtx.JSON(http.StatusRequestEntityTooLarge, runtime.Rfc7807Error{
+		Type:     http.StatusText(http.StatusRequestEntityTooLarge),
+		Detail:   fmt.Sprintf("The request body of operation '%s' exceeds the maximum size of %d bytes", operationId, maxBodySize),
+		Status:   http.StatusRequestEntityTooLarge,
+		Instance: "/body/error/" + operationId,
+	})
+}
+// IdempotentResponse is a response of an idempotent operation, as stored to be replayed to repeated requests
+type IdempotentResponse struct {
+	// The response's HTTP status code
+	Status int
+	// The response's headers
+	Headers http.Header
+	// The response's body
+	Body []byte
+}
+// IdempotencyStore stores the responses of operations declared via @Idempotent, by request key.
+//
+// Implementations must be safe for concurrent use
+type IdempotencyStore interface {
+	// Reserve claims the given key for a new request with the given body hash, for the given duration.
+	// If the key has already been claimed, returns false along with the stored response or,
+	// if the original request is still in flight, a nil response.
+	// If the key has been claimed by a request with a different body hash, returns ErrIdempotencyKeyReused
+	Reserve(ctx context.Context, key string, requestHash string, ttl time.Duration) (bool, *IdempotentResponse, error)
+	// Complete stores the response of the request that claimed the given key, along with the request's body hash, for the given duration
+	Complete(ctx context.Context, key string, requestHash string, response IdempotentResponse, ttl time.Duration) error
+	// Release drops the claim of a request that did not produce a replayable response, so that it may be retried
+	Release(ctx context.Context, key string) error
+}
+// ErrIdempotencyKeyReused is returned by an IdempotencyStore when a key is reused by a request with a different body
+var ErrIdempotencyKeyReused = errors.New("the idempotency key has already been used by a request with a different body")
+// InMemoryIdempotencyStore is an IdempotencyStore holding responses in the process's memory.
+//
+// Responses are not shared across instances - deployments with multiple instances should use a shared IdempotencyStore instead
+type InMemoryIdempotencyStore struct {
+	mutex     sync.Mutex
+	entries   map[string]*idempotencyEntry
+	lastSweep time.Time
+}
+type idempotencyEntry struct {
+	// The stored response. Nil while the request is in flight
+	response *IdempotentResponse
+	// The hash of the body of the request that claimed the entry's key
+	requestHash string
+	expiresAt   time.Time
+}
+// The interval at which an InMemoryIdempotencyStore drops expired entries
+const idempotencySweepInterval = time.Minute
+// NewInMemoryIdempotencyStore creates an InMemoryIdempotencyStore with no entries
+func NewInMemoryIdempotencyStore() *InMemoryIdempotencyStore {
+	return &InMemoryIdempotencyStore{entries: map[string]*idempotencyEntry{}, lastSweep: time.Now()}
+}
+func (store *InMemoryIdempotencyStore) Reserve(_ context.Context, key string, requestHash string, ttl time.Duration) (bool, *IdempotentResponse, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	now := time.Now()
+	store.sweep(now)
+	if entry, exists := store.entries[key]; exists && now.Before(entry.expiresAt) {
+		if entry.requestHash != requestHash {
+			return false, nil, ErrIdempotencyKeyReused
+		}
+		return false, entry.response, nil
+	}
+	store.entries[key] = &idempotencyEntry{requestHash: requestHash, expiresAt: now.Add(ttl)}
+	return true, nil, nil
+}
+func (store *InMemoryIdempotencyStore) Complete(_ context.Context, key string, requestHash string, response IdempotentResponse, ttl time.Duration) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.entries[key] = &idempotencyEntry{response: &response, requestHash: requestHash, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+func (store *InMemoryIdempotencyStore) Release(_ context.Context, key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.entries, key)
+	return nil
+}
+// sweep drops the entries that have expired
+func (store *InMemoryIdempotencyStore) sweep(now time.Time) {
+	if now.Sub(store.lastSweep) < idempotencySweepInterval {
+		return
+	}
+	for key, entry := range store.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(store.entries, key)
+		}
+	}
+	store.lastSweep = now
+}
+var idempotencyStore IdempotencyStore = NewInMemoryIdempotencyStore()
+// SetIdempotencyStore replaces the default InMemoryIdempotencyStore with the given store,
+// e.g. one backed by a database shared across instances
+func SetIdempotencyStore(store IdempotencyStore) {
+	if store == nil {
+		store = NewInMemoryIdempotencyStore()
+	}
+	idempotencyStore = store
+}
+// newIdempotencyTtl creates an operation's response retention duration out of its @Idempotent annotation's 'ttl' property
+func newIdempotencyTtl(ttl string) time.Duration {
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid idempotency TTL '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			ttl,
+		))
+	}
+	return duration
+}
+// idempotentResponseRecorder records the body written to the response so it may be stored once the operation completes
+type idempotentResponseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+func (recorder *idempotentResponseRecorder) Write(data []byte) (int, error) {
+	recorder.body.Write(data)
+	return recorder.ResponseWriter.Write(data)
+}
+// The maximum size, in bytes, of the request bodies hashed by idempotent operations without a @MaxBodySize limit
+const defaultMaxIdempotentBodySize = 10 << 20
+// hashRequestBody returns the hex encoded SHA-256 hash of the request's body. The body remains readable.
+//
+// Requests whose body exceeds the given maximum size, or defaultMaxIdempotentBodySize if zero,
+// are answered with a 413 RFC7807 error, in which case false is returned
+func hashRequestBody(echoCtx echo.Context, operationId string, maxBodySize int64) (string, bool, error) {
+	if maxBodySize <= 0 {
+		maxBodySize = defaultMaxIdempotentBodySize
+	}
+	body, _ := io.ReadAll(io.LimitReader(echoCtx.Request().Body, maxBodySize+1))
+	echoCtx.Request().Body = io.NopCloser(bytes.NewReader(body))
+	if int64(len(body)) > maxBodySize {
+		return "", false, writeIdempotencyError(echoCtx, operationId, http.StatusRequestEntityTooLarge, fmt.Sprintf("The request body of operation '%s' exceeds the maximum size of %d bytes", operationId, maxBodySize))
+	}
+	hash := sha256.Sum256(body)
+	return hex.EncodeToString(hash[:]), true, nil
+}
+// beginIdempotentRequest claims the request's 'Idempotency-Key' header, scoped to the given operation and the request's principal.
+//
+// If the operation may proceed, returns a function storing its response, which must be deferred.
+// Otherwise, the request is answered - with the stored response if the key has been used before,
+// or with an RFC7807 error if the header is missing (400), the original request is still in flight (409),
+// the principal lacks a stable identity (500)
+// or the key has been used by a request with a different body (422) - and a nil function is returned
+func beginIdempotentRequest(echoCtx echo.Context, operationId string, requestHash string, ttl time.Duration) (func(), error) {
+	idempotencyKey := echoCtx.Request().Header.Get("Idempotency-Key")
+	if idempotencyKey == "" {
+		return nil, writeIdempotencyError(
+			echoCtx,
+			operationId,
+			http.StatusBadRequest,
+			fmt.Sprintf("Operation '%s' requires an 'Idempotency-Key' header", operationId),
+		)
+	}
+	ctx := getRequestContext(echoCtx)
+	// Keys are scoped by principal so that they may not collide across clients. Principals lacking a stable identity are
+	// rejected, as their requests could otherwise never be recognized as repeated
+	principalIdentity, isIdentifiable := describePrincipal(ctx)
+	if !isIdentifiable {
+		return nil, writeIdempotencyError(
+			echoCtx,
+			operationId,
+			http.StatusInternalServerError,
+			fmt.Sprintf("The principal of operation '%s' lacks a stable identity to scope its 'Idempotency-Key' by", operationId),
+		)
+	}
+	key := operationId + "\x00" + principalIdentity + "\x00" + idempotencyKey
+	reserved, response, err := idempotencyStore.Reserve(ctx, key, requestHash, ttl)
+	switch {
+	case errors.Is(err, ErrIdempotencyKeyReused):
+		return nil, writeIdempotencyError(
+			echoCtx,
+			operationId,
+			http.StatusUnprocessableEntity,
+			fmt.Sprintf("The 'Idempotency-Key' has already been used by a request to operation '%s' with a different body", operationId),
+		)
+	case err != nil:
+		return nil, writeIdempotencyError(
+			echoCtx,
+			operationId,
+			http.StatusInternalServerError,
+			fmt.Sprintf("Failed to process the 'Idempotency-Key' header of operation '%s'", operationId),
+		)
+	case !reserved && response == nil:
+		return nil, writeIdempotencyError(
+			echoCtx,
+			operationId,
+			http.StatusConflict,
+			fmt.Sprintf("A request to operation '%s' with the same 'Idempotency-Key' is still being processed", operationId),
+		)
+	case !reserved:
+		for name, values := range response.Headers {
+			echoCtx.Response().Header()[name] = values
+		}
+		echoCtx.Response().Header().Set("Idempotent-Replayed", "true")
+		echoCtx.Response().WriteHeader(response.Status)
+		_, err := echoCtx.Response().Write(response.Body)
+		return nil, err
+	}
+	recorder := &idempotentResponseRecorder{ResponseWriter: echoCtx.Response().Writer}
+	echoCtx.Response().Writer = recorder
+	return func() {
+		// Requests that panicked or failed on the server's side are not replayed so they may be retried
+		recovered := recover()
+		written := echoCtx.Response()
+		if recovered != nil || !written.Committed || written.Status >= http.StatusInternalServerError {
+			idempotencyStore.Release(context.WithoutCancel(ctx), key)
+			if recovered != nil {
+				panic(recovered)
+			}
+			return
+		}
+		idempotencyStore.Complete(
+			context.WithoutCancel(ctx),
+			key,
+			requestHash,
+			IdempotentResponse{Status: written.Status, Headers: written.Header().Clone(), Body: recorder.body.Bytes()},
+			ttl,
+		)
+	}, nil
+}
+// writeIdempotencyError answers a request to an idempotent operation with an RFC7807 error of the given status
+func writeIdempotencyError(echoCtx echo.Context, operationId string, status int, detail string) error {
+	return echoCtx.JSON(status, runtime.Rfc7807Error{
+		Type:     http.StatusText(status),
+		Detail:   detail,
+		Status:   status,
+		Instance: "/idempotency/error/" + operationId,
+	})
+}
 type MiddlewareFunc func(ctx context.Context, echoCtx echo.Context) (context.Context, bool)
 type ErrorMiddlewareFunc func(ctx context.Context, echoCtx echo.Context, err error) (context.Context, bool)
 var beforeOperationMiddlewares []MiddlewareFunc
@@ -302,16 +1300,50 @@ func RegisterErrorMiddleware(executionType runtime.ErrorMiddlewareExecutionType,
 		onErrorMiddlewares = append(onErrorMiddlewares, errorMiddlewareFunc)
 	}
 }
+var namedMiddlewares = map[string]MiddlewareFunc{}
+// RegisterNamedMiddleware registers a middleware that controllers and routes may reference by name using @Middleware annotations.
+//
+// Named middlewares must be registered before RegisterRoutes is called
+func RegisterNamedMiddleware(name string, middlewareFunc MiddlewareFunc) {
+	namedMiddlewares[name] = middlewareFunc
+}
+func ensureNamedMiddlewaresRegistered(names []string) {
+	missing := []string{}
+	for _, name := range names {
+		if _, registered := namedMiddlewares[name]; !registered {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		panic(fmt.Sprintf(
+			"middlewares referenced via @Middleware annotations were never registered using RegisterNamedMiddleware: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
 func RegisterCustomValidator(validateTagName string, validateFunc runtime.ValidationFunc) {
 	validatorInstance.RegisterValidation(validateTagName, func(fl validator.FieldLevel) bool {
 		return validateFunc(fl)
 	})
 }
+// BasePath is the path under which RegisterRoutes mounts the API's routes
+const BasePath = ""
+// RegisterRoutes registers the API's routes on the given engine, under BasePath
 func RegisterRoutes(engine *echo.Echo) {
+	RegisterGroupRoutes(engine, BasePath)
+}
+// RegisterGroupRoutes registers the API's routes on the given Echo instance or group, under the given base path.
+//
+// The base path is relative to the router's own prefix.
+// For the OpenAPI specification to match the served routes, the two should add up to BasePath
+func RegisterGroupRoutes(engine EchoRouter, basePath string) {
 	urlParamRegex = regexp.MustCompile(`\{([\w\d-_]+)\}`)
+	versionedRouteHandlers = map[string]map[int]echo.HandlerFunc{}
+	ensureNamedMiddlewaresRegistered([]string{"audit", "trace"})
 	// register routes extension placeholder
 	// E2EClassSecController
-	engine.GET(toEchoUrl("/e2e/with-default-class-security"), func(echoCtx echo.Context) error {
+	e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes := []int{}
+	e2EclassSecControllerWithDefaultClassSecurityHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -332,7 +1364,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "WithDefaultClassSecurity")
 		}
-		controller := E2EClassSecController.E2EClassSecController{}
+		controller := createController[E2EClassSecController.E2EClassSecController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("x-test-scopes")
@@ -357,9 +1389,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -376,7 +1410,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -394,6 +1428,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/WithDefaultClassSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -411,8 +1446,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/with-default-override-class-security"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/with-default-class-security")), e2EclassSecControllerWithDefaultClassSecurityHandler)
+	e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes := []int{}
+	e2EclassSecControllerWithOverrideClassSecurityHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -433,7 +1470,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "WithOverrideClassSecurity")
 		}
-		controller := E2EClassSecController.E2EClassSecController{}
+		controller := createController[E2EClassSecController.E2EClassSecController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("x-test-scopes")
@@ -458,9 +1495,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -477,7 +1516,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -495,6 +1534,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/WithOverrideClassSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -512,9 +1552,11 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/with-default-override-class-security")), e2EclassSecControllerWithOverrideClassSecurityHandler)
 	// E2EController
-	engine.GET(toEchoUrl("/e2e/simple-get"), func(echoCtx echo.Context) error {
+	e2EcontrollerSimpleGetErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -535,7 +1577,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "SimpleGet")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -552,7 +1594,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -570,6 +1612,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/SimpleGet",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -587,8 +1630,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/simple-get-empty-string"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/simple-get")), e2EcontrollerSimpleGetHandler)
+	e2EcontrollerSimpleGetEmptyStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyStringHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -609,7 +1654,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "SimpleGetEmptyString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -626,7 +1671,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -644,6 +1689,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/SimpleGetEmptyString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -661,8 +1707,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/simple-get-ptr-string"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-empty-string")), e2EcontrollerSimpleGetEmptyStringHandler)
+	e2EcontrollerSimpleGetPtrStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetPtrStringHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -683,7 +1731,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "SimpleGetPtrString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -700,7 +1748,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -718,6 +1766,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/SimpleGetPtrString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -735,8 +1784,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/simple-get-null-string"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-ptr-string")), e2EcontrollerSimpleGetPtrStringHandler)
+	e2EcontrollerSimpleGetNullStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetNullStringHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -757,7 +1808,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "SimpleGetNullString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -774,7 +1825,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -792,6 +1843,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/SimpleGetNullString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -809,8 +1861,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/simple-get-object"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-null-string")), e2EcontrollerSimpleGetNullStringHandler)
+	e2EcontrollerNamedMiddlewaresErrorStatusCodes := []int{}
+	e2EcontrollerNamedMiddlewaresHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -829,9 +1883,9 @@ func RegisterRoutes(engine *echo.Echo) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(echoCtx, authErr, "SimpleGetObject")
+			return handleAuthorizationError(echoCtx, authErr, "NamedMiddlewares")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -842,13 +1896,23 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
+		routeMiddlewares := []MiddlewareFunc{namedMiddlewares["audit"], namedMiddlewares["trace"]}
+		// Middlewares routeMiddlewares section
+		for _, middleware := range routeMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares routeMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.SimpleGetObject()
+		value, opError := controller.NamedMiddlewares()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -861,11 +1925,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObject'",
+				Detail:     "Encountered an error during operation 'NamedMiddlewares'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetObject",
+				Instance:   "/controller/error/NamedMiddlewares",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -883,8 +1948,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/simple-get-object-ptr"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/named-middlewares")), e2EcontrollerNamedMiddlewaresHandler)
+	e2EcontrollerSimpleGetObjectErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -903,9 +1970,9 @@ func RegisterRoutes(engine *echo.Echo) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(echoCtx, authErr, "SimpleGetObjectPtr")
+			return handleAuthorizationError(echoCtx, authErr, "SimpleGetObject")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -917,12 +1984,12 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.SimpleGetObjectPtr()
+		value, opError := controller.SimpleGetObject()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -935,11 +2002,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObjectPtr'",
+				Detail:     "Encountered an error during operation 'SimpleGetObject'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetObjectPtr",
+				Instance:   "/controller/error/SimpleGetObject",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -957,8 +2025,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/simple-get-object-null"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-object")), e2EcontrollerSimpleGetObjectHandler)
+	e2EcontrollerSimpleGetObjectPtrErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectPtrHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -977,9 +2047,9 @@ func RegisterRoutes(engine *echo.Echo) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(echoCtx, authErr, "SimpleGetObjectNull")
+			return handleAuthorizationError(echoCtx, authErr, "SimpleGetObjectPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -991,12 +2061,12 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.SimpleGetObjectNull()
+		value, opError := controller.SimpleGetObjectPtr()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1009,11 +2079,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObjectNull'",
+				Detail:     "Encountered an error during operation 'SimpleGetObjectPtr'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetObjectNull",
+				Instance:   "/controller/error/SimpleGetObjectPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1031,8 +2102,87 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/primitive-return-type"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-object-ptr")), e2EcontrollerSimpleGetObjectPtrHandler)
+	e2EcontrollerSimpleGetObjectNullErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectNullHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "SimpleGetObjectNull")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SimpleGetObjectNull()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObjectNull'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetObjectNull",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-object-null")), e2EcontrollerSimpleGetObjectNullHandler)
+	e2EcontrollerPrimitiveReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveReturnTypeHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -1053,7 +2203,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "PrimitiveReturnType")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1070,7 +2220,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1088,6 +2238,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/PrimitiveReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1105,8 +2256,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/primitive-array-return-type"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/primitive-return-type")), e2EcontrollerPrimitiveReturnTypeHandler)
+	e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveArrayReturnTypeHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -1127,7 +2280,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "PrimitiveArrayReturnType")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1144,7 +2297,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1162,6 +2315,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/PrimitiveArrayReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1179,8 +2333,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/primitive-alias-return-type"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/primitive-array-return-type")), e2EcontrollerPrimitiveArrayReturnTypeHandler)
+	e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasReturnTypeHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -1201,7 +2357,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "PrimitiveAliasReturnType")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1218,7 +2374,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1236,6 +2392,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/PrimitiveAliasReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1253,8 +2410,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/primitive-alias-array-return-type"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/primitive-alias-return-type")), e2EcontrollerPrimitiveAliasReturnTypeHandler)
+	e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasArrayReturnTypeHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -1275,7 +2434,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "PrimitiveAliasArrayReturnType")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1292,7 +2451,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1310,6 +2469,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/PrimitiveAliasArrayReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1327,8 +2487,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/simple-get-empty"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/primitive-alias-array-return-type")), e2EcontrollerPrimitiveAliasArrayReturnTypeHandler)
+	e2EcontrollerSimpleGetEmptyErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -1349,7 +2511,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "SimpleGetEmpty")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var queryParamRawPtr *string = nil
 		queryParamRaw := echoCtx.QueryParam("queryParam")
@@ -1369,9 +2531,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1388,7 +2552,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1406,6 +2570,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/SimpleGetEmpty",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1423,8 +2588,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/get-with-all-params/{pathParam}"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-empty")), e2EcontrollerSimpleGetEmptyHandler)
+	e2EcontrollerGetWithAllParamsErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -1445,7 +2612,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "GetWithAllParams")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var queryParamRawPtr *string = nil
 		queryParamRaw := echoCtx.QueryParam("queryParam")
@@ -1465,9 +2632,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var pathParamRawPtr *string = nil
 		pathParamRaw := echoCtx.Param("pathParam")
@@ -1487,9 +2656,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("headerParam")
@@ -1514,9 +2685,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1533,7 +2706,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1551,6 +2724,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/GetWithAllParams",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1568,8 +2742,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/get-with-all-params-ptr/{pathParam}"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")), e2EcontrollerGetWithAllParamsHandler)
+	e2EcontrollerGetWithAllParamsPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsPtrHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -1590,7 +2766,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "GetWithAllParamsPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var queryParamRawPtr *string = nil
 		queryParamRaw := echoCtx.QueryParam("queryParam")
@@ -1617,9 +2793,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("headerParam")
@@ -1648,7 +2826,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1666,6 +2844,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/GetWithAllParamsPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1683,8 +2862,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/get-with-all-params-required-ptr/{pathParam}"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsPtrHandler)
+	e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsRequiredPtrHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -1705,7 +2886,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "GetWithAllParamsRequiredPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var queryParamRawPtr *string = nil
 		queryParamRaw := echoCtx.QueryParam("queryParam")
@@ -1725,9 +2906,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var pathParamRawPtr *string = nil
 		pathParamRaw := echoCtx.Param("pathParam")
@@ -1747,9 +2930,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("headerParam")
@@ -1774,9 +2959,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1793,7 +2980,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1811,6 +2998,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/GetWithAllParamsRequiredPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1828,8 +3016,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/post-with-all-params-body"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsRequiredPtrHandler)
+	e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -1850,7 +3040,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "PostWithAllParamsWithBody")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var conversionErr error
 		var queryParamRawPtr *string = nil
@@ -1871,9 +3061,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("headerParam")
@@ -1898,9 +3090,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var theBodyRawPtr *Param5theBody.BodyInfo = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &theBodyRawPtr)
@@ -1914,19 +3108,25 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBody' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBody",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBody",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1943,7 +3143,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1961,6 +3161,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/PostWithAllParamsWithBody",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1978,8 +3179,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/post-with-all-params-body-ptr"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/post-with-all-params-body")), e2EcontrollerPostWithAllParamsWithBodyHandler)
+	e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyPtrHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -2000,7 +3203,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "PostWithAllParamsWithBodyPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var conversionErr error
 		var queryParamRawPtr *string = nil
@@ -2034,19 +3237,25 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBodyPtr' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBodyPtr",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2063,7 +3272,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2081,6 +3290,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/PostWithAllParamsWithBodyPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -2098,8 +3308,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/post-with-all-params-body-required-ptr"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-ptr")), e2EcontrollerPostWithAllParamsWithBodyPtrHandler)
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -2120,7 +3332,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "PostWithAllParamsWithBodyRequiredPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var conversionErr error
 		var theBodyRawPtr *Param5theBody.BodyInfo = nil
@@ -2135,19 +3347,25 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBodyRequiredPtr' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyRequiredPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBodyRequiredPtr",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2164,7 +3382,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2182,6 +3400,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/PostWithAllParamsWithBodyRequiredPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -2199,8 +3418,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/get-header-start-with-letter"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-required-ptr")), e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler)
+	e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes := []int{}
+	e2EcontrollerGetHeaderStartWithLetterHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -2221,7 +3442,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "GetHeaderStartWithLetter")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("headerParam")
@@ -2246,9 +3467,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2265,7 +3488,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2283,6 +3506,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/GetHeaderStartWithLetter",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -2300,8 +3524,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/with-default-config-security"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/get-header-start-with-letter")), e2EcontrollerGetHeaderStartWithLetterHandler)
+	e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithDefaultConfigSecurityHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -2322,7 +3548,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "WithDefaultConfigSecurity")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("x-test-scopes")
@@ -2347,9 +3573,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2366,7 +3594,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2384,6 +3612,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/WithDefaultConfigSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -2401,8 +3630,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/with-one-security"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/with-default-config-security")), e2EcontrollerWithDefaultConfigSecurityHandler)
+	e2EcontrollerWithOneSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithOneSecurityHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -2423,7 +3654,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "WithOneSecurity")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("x-test-scopes")
@@ -2448,9 +3679,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2467,7 +3700,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2485,6 +3718,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/WithOneSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -2502,8 +3736,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/with-two-security"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/with-one-security")), e2EcontrollerWithOneSecurityHandler)
+	e2EcontrollerWithTwoSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecurityHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -2536,7 +3772,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "WithTwoSecurity")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("x-test-scopes")
@@ -2561,9 +3797,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2580,7 +3818,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2598,6 +3836,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/WithTwoSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -2615,8 +3854,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/with-two-security-same-method"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/with-two-security")), e2EcontrollerWithTwoSecurityHandler)
+	e2EcontrollerWithAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithAdvancedSecurityHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -2630,16 +3871,21 @@ func RegisterRoutes(engine *echo.Echo) {
 								"other",
 							},
 						},
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+							},
+						},
 					},
 				},
 				{
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName",
+							SchemaName: "securitySchemaName3",
 							Scopes: []string{
-								"write",
-								"read",
+								"admin",
 							},
 						},
 					},
@@ -2647,9 +3893,9 @@ func RegisterRoutes(engine *echo.Echo) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(echoCtx, authErr, "WithTwoSecuritySameMethod")
+			return handleAuthorizationError(echoCtx, authErr, "WithAdvancedSecurity")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("x-test-scopes")
@@ -2674,9 +3920,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithAdvancedSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2688,12 +3936,12 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
+		value, opError := controller.WithAdvancedSecurity(*headerParamRawPtr)
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2706,11 +3954,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
+				Detail:     "Encountered an error during operation 'WithAdvancedSecurity'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithTwoSecuritySameMethod",
+				Instance:   "/controller/error/WithAdvancedSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -2728,8 +3977,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/default-error"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/with-advanced-security")), e2EcontrollerWithAdvancedSecurityHandler)
+	e2EcontrollerWithPrincipalErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -2738,9 +3989,9 @@ func RegisterRoutes(engine *echo.Echo) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName2",
+							SchemaName: "securitySchemaName",
 							Scopes: []string{
-								"config",
+								"other",
 							},
 						},
 					},
@@ -2748,10 +3999,18 @@ func RegisterRoutes(engine *echo.Echo) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(echoCtx, authErr, "DefaultError")
+			return handleAuthorizationError(echoCtx, authErr, "WithPrincipal")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(echoCtx))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			return handleAuthorizationError(echoCtx, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipal")
+		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -2762,12 +4021,12 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		opError := controller.DefaultError()
+		value, opError := controller.WithPrincipal(principalRawPtr)
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2780,11 +4039,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DefaultError'",
+				Detail:     "Encountered an error during operation 'WithPrincipal'",
 				Status:     statusCode,
-				Instance:   "/controller/error/DefaultError",
+				Instance:   "/controller/error/WithPrincipal",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -2799,11 +4059,13 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
 		// after operation routes extension placeholder
-		echoCtx.Response().WriteHeader(statusCode)
+		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/default-error-with-payload"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/with-principal")), e2EcontrollerWithPrincipalHandler)
+	e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalAdvancedSecurityHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -2811,10 +4073,27 @@ func RegisterRoutes(engine *echo.Echo) {
 				{
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
 						{
 							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"config",
+								"write",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName3",
+							Scopes: []string{
+								"admin",
 							},
 						},
 					},
@@ -2822,9 +4101,1687 @@ func RegisterRoutes(engine *echo.Echo) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(echoCtx, authErr, "DefaultErrorWithPayload")
+			return handleAuthorizationError(echoCtx, authErr, "WithPrincipalAdvancedSecurity")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(echoCtx))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			return handleAuthorizationError(echoCtx, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipalAdvancedSecurity")
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WithPrincipalAdvancedSecurity(principalRawPtr)
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithPrincipalAdvancedSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithPrincipalAdvancedSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/with-principal-advanced-security")), e2EcontrollerWithPrincipalAdvancedSecurityHandler)
+	e2EcontrollerPublicRouteErrorStatusCodes := []int{}
+	e2EcontrollerPublicRouteHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PublicRoute()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PublicRoute'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PublicRoute",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/public")), e2EcontrollerPublicRouteHandler)
+	e2EcontrollerHeadCheckErrorStatusCodes := []int{}
+	e2EcontrollerHeadCheckHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "HeadCheck")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.HeadCheck()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'HeadCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/HeadCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.Response().WriteHeader(statusCode)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/head-check")), e2EcontrollerHeadCheckHandler)
+	e2EcontrollerOptionsCheckErrorStatusCodes := []int{}
+	e2EcontrollerOptionsCheckHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "OptionsCheck")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.OptionsCheck()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'OptionsCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/OptionsCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("OPTIONS", toEchoUrl(withBasePath(basePath, "/e2e/options-check")), e2EcontrollerOptionsCheckHandler)
+	e2EcontrollerTraceCheckErrorStatusCodes := []int{}
+	e2EcontrollerTraceCheckHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "TraceCheck")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.TraceCheck()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TraceCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TraceCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("TRACE", toEchoUrl(withBasePath(basePath, "/e2e/trace-check")), e2EcontrollerTraceCheckHandler)
+	e2EcontrollerPurgeCheckErrorStatusCodes := []int{}
+	e2EcontrollerPurgeCheckHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "PurgeCheck")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PurgeCheck()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PurgeCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PurgeCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("PURGE", toEchoUrl(withBasePath(basePath, "/e2e/purge-check")), e2EcontrollerPurgeCheckHandler)
+	e2EcontrollerVersionedV1ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV1Handler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "VersionedV1")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.VersionedV1()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV1'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV1",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	e2EcontrollerVersionedV1Versions := []int{1}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV1Versions, e2EcontrollerVersionedV1Handler)
+	e2EcontrollerVersionedV2ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV2Handler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "VersionedV2")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.VersionedV2()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV2'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV2",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	e2EcontrollerVersionedV2Versions := []int{2}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV2Versions, e2EcontrollerVersionedV2Handler)
+	e2EcontrollerRateLimitedErrorStatusCodes := []int{}
+	e2EcontrollerRateLimitedRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "RateLimited")
+	e2EcontrollerRateLimitedHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		if allowed, err := enforceRateLimit(echoCtx, "RateLimited", e2EcontrollerRateLimitedRateLimit); !allowed {
+			return err
+		}
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "RateLimited")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.RateLimited()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'RateLimited'",
+				Status:     statusCode,
+				Instance:   "/controller/error/RateLimited",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/rate-limited")), e2EcontrollerRateLimitedHandler)
+	e2EcontrollerTimedOutErrorStatusCodes := []int{}
+	e2EcontrollerTimedOutTimeout := newOperationTimeout("50ms")
+	e2EcontrollerTimedOutHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		cancelTimeout := applyOperationTimeout(echoCtx, e2EcontrollerTimedOutTimeout)
+		defer cancelTimeout()
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "TimedOut")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		if isDeadlineExceeded(echoCtx) {
+			return handleDeadlineExceeded(echoCtx, "TimedOut", http.StatusServiceUnavailable)
+		}
+		value, opError := controller.TimedOut(getRequestContext(echoCtx))
+		if isDeadlineExceeded(echoCtx) {
+			return handleDeadlineExceeded(echoCtx, "TimedOut", http.StatusGatewayTimeout)
+		}
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTimedOutErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TimedOut'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TimedOut",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTimedOutErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/timeout")), e2EcontrollerTimedOutHandler)
+	e2EcontrollerMaxBodySizeErrorStatusCodes := []int{}
+	e2EcontrollerMaxBodySizeHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		if allowed, err := enforceMaxBodySize(echoCtx, "MaxBodySize", 64); !allowed {
+			return err
+		}
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "MaxBodySize")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		var conversionErr error
+		var dataRawPtr *Param8data.ObjectWithByteSlice = nil
+		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, conversionErr)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "MaxBodySize",
+						"parameter": "data",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/MaxBodySize",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.MaxBodySize(*dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MaxBodySize'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MaxBodySize",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/max-body-size")), e2EcontrollerMaxBodySizeHandler)
+	e2EcontrollerIdempotentErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "Idempotent")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed, hashErr := hashRequestBody(echoCtx, "Idempotent", 0)
+		if !isBodyHashed {
+			return hashErr
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		completeIdempotentRequest, idempotencyErr := beginIdempotentRequest(echoCtx, "Idempotent", idempotentRequestHash, e2EcontrollerIdempotentIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return idempotencyErr
+		}
+		defer completeIdempotentRequest()
+		value, opError := controller.Idempotent()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Idempotent'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Idempotent",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/idempotent")), e2EcontrollerIdempotentHandler)
+	e2EcontrollerIdempotentWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentWithBodyIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentWithBodyHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "IdempotentWithBody")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed, hashErr := hashRequestBody(echoCtx, "IdempotentWithBody", 0)
+		if !isBodyHashed {
+			return hashErr
+		}
+		var conversionErr error
+		var dataRawPtr *Param5data.BodyInfo = nil
+		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, conversionErr)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "IdempotentWithBody",
+						"parameter": "data",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/IdempotentWithBody",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		completeIdempotentRequest, idempotencyErr := beginIdempotentRequest(echoCtx, "IdempotentWithBody", idempotentRequestHash, e2EcontrollerIdempotentWithBodyIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return idempotencyErr
+		}
+		defer completeIdempotentRequest()
+		value, opError := controller.IdempotentWithBody(*dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'IdempotentWithBody'",
+				Status:     statusCode,
+				Instance:   "/controller/error/IdempotentWithBody",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/idempotent-with-body")), e2EcontrollerIdempotentWithBodyHandler)
+	e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecuritySameMethodHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"write",
+								"read",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "WithTwoSecuritySameMethod")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		var headerParamRawPtr *string = nil
+		headerParamRaw := echoCtx.Request().Header.Get("x-test-scopes")
+		_, isheaderParamExists := echoCtx.Request().Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the echoCtx.Request().Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := echoCtx.Request().Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, validatorErr)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			// validation error response extension placeholder
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithTwoSecuritySameMethod",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/with-two-security-same-method")), e2EcontrollerWithTwoSecuritySameMethodHandler)
+	e2EcontrollerDefaultErrorErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "DefaultError")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.DefaultError()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DefaultError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DefaultError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.Response().WriteHeader(statusCode)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/default-error")), e2EcontrollerDefaultErrorHandler)
+	e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorWithPayloadHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "DefaultErrorWithPayload")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.DefaultErrorWithPayload()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DefaultErrorWithPayload",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/default-error-with-payload")), e2EcontrollerDefaultErrorWithPayloadHandler)
+	e2EcontrollerInjectedDependencyErrorStatusCodes := []int{}
+	e2EcontrollerInjectedDependencyHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "InjectedDependency")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.InjectedDependency()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'InjectedDependency'",
+				Status:     statusCode,
+				Instance:   "/controller/error/InjectedDependency",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/injected-dependency")), e2EcontrollerInjectedDependencyHandler)
+	e2EcontrollerMappedErrorErrorStatusCodes := []int{404}
+	e2EcontrollerMappedErrorHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "MappedError")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.MappedError()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerMappedErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MappedError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MappedError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedErrorErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.Response().WriteHeader(statusCode)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/mapped-error")), e2EcontrollerMappedErrorHandler)
+	e2EcontrollerUndeclaredMappedErrorErrorStatusCodes := []int{}
+	e2EcontrollerUndeclaredMappedErrorHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "UndeclaredMappedError")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.UndeclaredMappedError()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'UndeclaredMappedError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/UndeclaredMappedError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.Response().WriteHeader(statusCode)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/undeclared-mapped-error")), e2EcontrollerUndeclaredMappedErrorHandler)
+	e2EcontrollerMappedTypedErrorErrorStatusCodes := []int{409}
+	e2EcontrollerMappedTypedErrorHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "MappedTypedError")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.MappedTypedError()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MappedTypedError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MappedTypedError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/mapped-typed-error")), e2EcontrollerMappedTypedErrorHandler)
+	e2EcontrollerPanicErrorStatusCodes := []int{}
+	e2EcontrollerPanicHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "Panic")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2836,12 +5793,12 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.DefaultErrorWithPayload()
+		value, opError := controller.Panic()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPanicErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2854,11 +5811,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
+				Detail:     "Encountered an error during operation 'Panic'",
 				Status:     statusCode,
-				Instance:   "/controller/error/DefaultErrorWithPayload",
+				Instance:   "/controller/error/Panic",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPanicErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -2876,8 +5834,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/custom-error"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/panic")), e2EcontrollerPanicHandler)
+	e2EcontrollerCustomErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomErrorHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -2898,7 +5858,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "CustomError")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2915,8 +5875,8 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		emptyErr := Response6CustomError.CustomError{}
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomErrorErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
 		if opError != emptyErr {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2943,8 +5903,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/custom-error-ptr"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/custom-error")), e2EcontrollerCustomErrorHandler)
+	e2EcontrollerCustomPtrErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomPtrErrorHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -2965,7 +5927,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "CustomPtrError")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2982,7 +5944,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomPtrErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3009,8 +5971,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/503-error-code"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/custom-error-ptr")), e2EcontrollerCustomPtrErrorHandler)
+	e2EcontrollerError503ErrorStatusCodes := []int{}
+	e2EcontrollerError503Handler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -3031,7 +5995,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "Error503")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3048,7 +6012,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerError503ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3066,6 +6030,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/Error503",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerError503ErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3083,8 +6048,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/custom-error-503"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/503-error-code")), e2EcontrollerError503Handler)
+	e2EcontrollerCustomError503ErrorStatusCodes := []int{}
+	e2EcontrollerCustomError503Handler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -3105,7 +6072,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "CustomError503")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3122,8 +6089,8 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		emptyErr := Response6CustomError.CustomError{}
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomError503ErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
 		if opError != emptyErr {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3150,8 +6117,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/context-access"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/custom-error-503")), e2EcontrollerCustomError503Handler)
+	e2EcontrollerContextAccessErrorStatusCodes := []int{}
+	e2EcontrollerContextAccessHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -3172,7 +6141,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ContextAccess")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3189,7 +6158,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextAccessErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3207,6 +6176,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ContextAccess",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextAccessErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3224,8 +6194,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/http-method"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/context-access")), e2EcontrollerContextAccessHandler)
+	e2EcontrollerGetErrorStatusCodes := []int{}
+	e2EcontrollerGetHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -3246,7 +6218,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "Get")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3263,7 +6235,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerGetErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3281,6 +6253,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/Get",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3298,8 +6271,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/http-method"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerGetHandler)
+	e2EcontrollerPostErrorStatusCodes := []int{}
+	e2EcontrollerPostHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -3320,7 +6295,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "Post")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3337,7 +6312,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPostErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3355,6 +6330,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/Post",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3372,8 +6348,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.PUT(toEchoUrl("/e2e/http-method"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPostHandler)
+	e2EcontrollerPutErrorStatusCodes := []int{}
+	e2EcontrollerPutHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -3394,7 +6372,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "Put")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3411,7 +6389,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPutErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3429,6 +6407,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/Put",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPutErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3446,8 +6425,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.DELETE(toEchoUrl("/e2e/http-method"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("PUT", toEchoUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPutHandler)
+	e2EcontrollerDeleteErrorStatusCodes := []int{}
+	e2EcontrollerDeleteHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -3468,7 +6449,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "Delete")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3485,7 +6466,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDeleteErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3503,6 +6484,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/Delete",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeleteErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3520,8 +6502,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.PATCH(toEchoUrl("/e2e/http-method"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("DELETE", toEchoUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerDeleteHandler)
+	e2EcontrollerPatchErrorStatusCodes := []int{}
+	e2EcontrollerPatchHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -3542,7 +6526,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "Patch")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3559,7 +6543,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPatchErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3577,6 +6561,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/Patch",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPatchErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3594,8 +6579,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/template-context-1"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("PATCH", toEchoUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPatchHandler)
+	e2EcontrollerTemplateContext1ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext1Handler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -3616,7 +6603,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TemplateContext1")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3633,7 +6620,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3651,6 +6638,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TemplateContext1",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3668,8 +6656,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/template-context-2"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/template-context-1")), e2EcontrollerTemplateContext1Handler)
+	e2EcontrollerTemplateContext2ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext2Handler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -3690,7 +6680,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TemplateContext2")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3707,7 +6697,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3725,6 +6715,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TemplateContext2",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3742,8 +6733,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/form"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/template-context-2")), e2EcontrollerTemplateContext2Handler)
+	e2EcontrollerTestFormErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -3764,7 +6757,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TestForm")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		echoCtx.Request().ParseForm()
 		var item1RawPtr *string = nil
@@ -3788,9 +6781,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item1"
-			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		echoCtx.Request().ParseForm()
 		var item2RawPtr *string = nil
@@ -3814,9 +6809,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item2"
-			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3833,7 +6830,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3851,6 +6848,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TestForm",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3868,8 +6866,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/form-extra"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/form")), e2EcontrollerTestFormHandler)
+	e2EcontrollerTestFormExtraErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormExtraHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -3890,7 +6890,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TestFormExtra")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		echoCtx.Request().ParseForm()
 		var item1RawPtr *int64 = nil
@@ -3900,7 +6900,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			item1Raw = item1RawArr[0] // Get first value since form values are slices
 		}
 		if isitem1Exists {
-			item1Uint64, conversionErr := strconv.ParseInt(item1Raw, 10, 64)
+			item1, conversionErr := parseIntegerParam[int64](item1Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -3911,22 +6911,19 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestFormExtra' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"item1",
-						"int64",
-						reflect.TypeOf(item1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestFormExtra",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item1",
+					"form",
+					"/item1",
+					"int64",
+					reflect.TypeOf(item1Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			item1 := int64(item1Uint64)
 			item1RawPtr = &item1
 		}
 		if validatorErr := validatorInstance.Var(item1RawPtr, "required,gte=80"); validatorErr != nil {
@@ -3940,9 +6937,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item1"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		echoCtx.Request().ParseForm()
 		var item2RawPtr *string = nil
@@ -3966,15 +6965,17 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item2"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var item3RawPtr *int64 = nil
 		item3Raw := echoCtx.QueryParam("item3")
 		isitem3Exists := echoCtx.Request().URL.Query().Has("item3")
 		if isitem3Exists {
-			item3Uint64, conversionErr := strconv.ParseInt(item3Raw, 10, 64)
+			item3, conversionErr := parseIntegerParam[int64](item3Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -3985,22 +6986,19 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestFormExtra' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"item3",
-						"int64",
-						reflect.TypeOf(item3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestFormExtra",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item3",
+					"query",
+					"/item3",
+					"int64",
+					reflect.TypeOf(item3Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			item3 := int64(item3Uint64)
 			item3RawPtr = &item3
 		}
 		if validatorErr := validatorInstance.Var(item3RawPtr, "required,gte=80"); validatorErr != nil {
@@ -4014,9 +7012,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item3"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/item3", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4033,7 +7033,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4051,6 +7051,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TestFormExtra",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -4068,8 +7069,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/test-response-validation"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/form-extra")), e2EcontrollerTestFormExtraHandler)
+	e2EcontrollerTestResponseValidationErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -4090,7 +7093,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TestResponseValidation")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4107,7 +7110,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4125,6 +7128,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TestResponseValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -4142,8 +7146,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/test-response-validation-ptr"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/test-response-validation")), e2EcontrollerTestResponseValidationHandler)
+	e2EcontrollerTestResponseValidationPtrErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationPtrHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -4164,7 +7170,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TestResponseValidationPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4181,7 +7187,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4199,6 +7205,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TestResponseValidationPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -4216,8 +7223,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/test-response-validation-null"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/test-response-validation-ptr")), e2EcontrollerTestResponseValidationPtrHandler)
+	e2EcontrollerTestResponseValidationNullErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationNullHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -4238,7 +7247,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TestResponseValidationNull")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4255,7 +7264,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4273,6 +7282,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TestResponseValidationNull",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -4290,8 +7300,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/test-primitive-conversions"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/test-response-validation-null")), e2EcontrollerTestResponseValidationNullHandler)
+	e2EcontrollerTestPrimitiveConversionsErrorStatusCodes := []int{500}
+	e2EcontrollerTestPrimitiveConversionsHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -4312,13 +7324,13 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TestPrimitiveConversions")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var value1RawPtr *int64 = nil
 		value1Raw := echoCtx.QueryParam("value1")
 		isvalue1Exists := echoCtx.Request().URL.Query().Has("value1")
 		if isvalue1Exists {
-			value1Uint64, conversionErr := strconv.ParseInt(value1Raw, 10, 64)
+			value1, conversionErr := parseIntegerParam[int64](value1Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4329,22 +7341,19 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"int64",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value1",
+					"query",
+					"/value1",
+					"int64",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value1 := int64(value1Uint64)
 			value1RawPtr = &value1
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -4358,15 +7367,17 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value2RawPtr *bool = nil
 		value2Raw := echoCtx.QueryParam("value2")
 		isvalue2Exists := echoCtx.Request().URL.Query().Has("value2")
 		if isvalue2Exists {
-			value2Bool, conversionErr := strconv.ParseBool(value2Raw)
+			value2, conversionErr := strconv.ParseBool(value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4377,22 +7388,19 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"bool",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value2",
+					"query",
+					"/value2",
+					"bool",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value2 := value2Bool
 			value2RawPtr = &value2
 		}
 		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
@@ -4406,15 +7414,17 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value3RawPtr *int = nil
 		value3Raw := echoCtx.QueryParam("value3")
 		isvalue3Exists := echoCtx.Request().URL.Query().Has("value3")
 		if isvalue3Exists {
-			value3Uint64, conversionErr := strconv.Atoi(value3Raw)
+			value3, conversionErr := parseIntegerParam[int](value3Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4425,22 +7435,19 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value3",
-						"int",
-						reflect.TypeOf(value3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value3",
+					"query",
+					"/value3",
+					"int",
+					reflect.TypeOf(value3Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value3 := int(value3Uint64)
 			value3RawPtr = &value3
 		}
 		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
@@ -4454,15 +7461,17 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value3"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value3", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value4RawPtr *float64 = nil
 		value4Raw := echoCtx.QueryParam("value4")
 		isvalue4Exists := echoCtx.Request().URL.Query().Has("value4")
 		if isvalue4Exists {
-			value4Float64, conversionErr := strconv.ParseFloat(value4Raw, 64)
+			value4, conversionErr := parseFloatParam[float64](value4Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4473,22 +7482,19 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value4",
-						"float64",
-						reflect.TypeOf(value4Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value4",
+					"query",
+					"/value4",
+					"float64",
+					reflect.TypeOf(value4Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value4 := float64(value4Float64)
 			value4RawPtr = &value4
 		}
 		if validatorErr := validatorInstance.Var(value4RawPtr, "required"); validatorErr != nil {
@@ -4502,9 +7508,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value4"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value4", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4521,7 +7529,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4539,6 +7547,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TestPrimitiveConversions",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -4556,8 +7565,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/test-enums"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/test-primitive-conversions")), e2EcontrollerTestPrimitiveConversionsHandler)
+	e2EcontrollerTestEnumsErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -4578,15 +7589,15 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TestEnums")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var conversionErr error
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw := echoCtx.QueryParam("value1")
 		isvalue1Exists := echoCtx.Request().URL.Query().Has("value1")
 		if isvalue1Exists {
 			value1 := value1Raw
-			value1Var := Param12value1.StatusEnumeration(value1)
+			value1Var := Param15value1.StatusEnumeration(value1)
 			value1RawPtr = &value1Var
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -4600,15 +7611,17 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value2RawPtr *Param4value2.NumberEnumeration = nil
 		value2Raw := echoCtx.QueryParam("value2")
 		isvalue2Exists := echoCtx.Request().URL.Query().Has("value2")
 		if isvalue2Exists {
-			value2Uint64, conversionErr := strconv.Atoi(value2Raw)
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4619,22 +7632,19 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnums' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnums",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value2",
+					"query",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value2 := int(value2Uint64)
 			value2Var := Param4value2.NumberEnumeration(value2)
 			value2RawPtr = &value2Var
 		}
@@ -4649,11 +7659,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var value3RawPtr *Param11value3.ObjectWithEnum = nil
+		var value3RawPtr *Param14value3.ObjectWithEnum = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &value3RawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -4665,19 +7677,25 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'TestEnums' but body parameter '%s' did not pass validation of '%s' - %s",
-					"value3",
-					"ObjectWithEnum",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "TestEnums",
+						"parameter": "value3",
+						"type":      "ObjectWithEnum",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/TestEnums",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4694,7 +7712,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4712,6 +7730,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TestEnums",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -4729,8 +7748,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/test-enums-in-all/{value1}"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/test-enums")), e2EcontrollerTestEnumsHandler)
+	e2EcontrollerTestEnumsInAllErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsInAllHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -4751,14 +7772,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TestEnumsInAll")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw := echoCtx.Param("value1")
 		isvalue1Exists := true // if parameter is in route but not provided, it won't reach this handler
 		if isvalue1Exists {
 			value1 := value1Raw
-			value1Var := Param12value1.StatusEnumeration(value1)
+			value1Var := Param15value1.StatusEnumeration(value1)
 			value1RawPtr = &value1Var
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -4772,9 +7793,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/value1", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value2RawPtr *Param4value2.NumberEnumeration = nil
 		value2Raw := echoCtx.Request().Header.Get("value2")
@@ -4785,7 +7808,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			isvalue2Exists = len(headerValues) > 0
 		}
 		if isvalue2Exists {
-			value2Uint64, conversionErr := strconv.Atoi(value2Raw)
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4796,22 +7819,19 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value2",
+					"header",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value2 := int(value2Uint64)
 			value2Var := Param4value2.NumberEnumeration(value2)
 			value2RawPtr = &value2Var
 		}
@@ -4826,12 +7846,14 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/value2", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		echoCtx.Request().ParseForm()
-		var value3RawPtr *Param12value3.StatusEnumeration = nil
+		var value3RawPtr *Param15value3.StatusEnumeration = nil
 		value3RawArr, isvalue3Exists := echoCtx.Request().PostForm["value3"]
 		value3Raw := ""
 		if isvalue3Exists {
@@ -4839,7 +7861,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		if isvalue3Exists {
 			value3 := value3Raw
-			value3Var := Param12value3.StatusEnumeration(value3)
+			value3Var := Param15value3.StatusEnumeration(value3)
 			value3RawPtr = &value3Var
 		}
 		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
@@ -4853,9 +7875,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value3"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/value3", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4872,7 +7896,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4890,6 +7914,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TestEnumsInAll",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -4907,8 +7932,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/test-enums-optional"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/test-enums-in-all/{value1}")), e2EcontrollerTestEnumsInAllHandler)
+	e2EcontrollerTestEnumsOptionalErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsOptionalHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -4929,9 +7956,9 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TestEnumsOptional")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw := echoCtx.Request().Header.Get("value1")
 		_, isvalue1Exists := echoCtx.Request().Header["value1"]
 		if !isvalue1Exists {
@@ -4941,7 +7968,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		if isvalue1Exists {
 			value1 := value1Raw
-			value1Var := Param12value1.StatusEnumeration(value1)
+			value1Var := Param15value1.StatusEnumeration(value1)
 			value1RawPtr = &value1Var
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -4959,7 +7986,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4977,6 +8004,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TestEnumsOptional",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -4994,8 +8022,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/external-packages"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/test-enums-optional")), e2EcontrollerTestEnumsOptionalHandler)
+	e2EcontrollerExternalPackagesErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -5016,18 +8046,18 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ExternalPackages")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var conversionErr error
-		var unitRawPtr *Param14unit.LengthUnits = nil
+		var unitRawPtr *Param17unit.LengthUnits = nil
 		unitRaw := echoCtx.QueryParam("unit")
 		isunitExists := echoCtx.Request().URL.Query().Has("unit")
 		if isunitExists {
 			unit := unitRaw
-			unitVar := Param14unit.LengthUnits(unit)
+			unitVar := Param17unit.LengthUnits(unit)
 			unitRawPtr = &unitVar
 		}
-		var dataRawPtr *Param13data.LengthDto = nil
+		var dataRawPtr *Param16data.LengthDto = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5039,19 +8069,25 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackages' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"LengthDto",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackages",
+						"parameter": "data",
+						"type":      "LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackages",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5068,7 +8104,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5086,6 +8122,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ExternalPackages",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -5103,8 +8140,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/external-packages-unique-in-struct"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/external-packages")), e2EcontrollerExternalPackagesHandler)
+	e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesUniqueInStructHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -5125,10 +8164,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ExternalPackagesUniqueInStruct")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var conversionErr error
-		var dataRawPtr *Param15data.UniqueExternalUsage = nil
+		var dataRawPtr *Param18data.UniqueExternalUsage = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5140,19 +8179,25 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackagesUniqueInStruct' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"UniqueExternalUsage",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesUniqueInStruct",
+						"parameter": "data",
+						"type":      "UniqueExternalUsage",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackagesUniqueInStruct",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5169,7 +8214,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5187,6 +8232,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ExternalPackagesUniqueInStruct",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -5204,8 +8250,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/external-packages-validation"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/external-packages-unique-in-struct")), e2EcontrollerExternalPackagesUniqueInStructHandler)
+	e2EcontrollerExternalPackagesValidationErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesValidationHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -5226,18 +8274,18 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ExternalPackagesValidation")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var conversionErr error
-		var unitRawPtr *Param14unit.LengthUnits = nil
+		var unitRawPtr *Param17unit.LengthUnits = nil
 		unitRaw := echoCtx.QueryParam("unit")
 		isunitExists := echoCtx.Request().URL.Query().Has("unit")
 		if isunitExists {
 			unit := unitRaw
-			unitVar := Param14unit.LengthUnits(unit)
+			unitVar := Param17unit.LengthUnits(unit)
 			unitRawPtr = &unitVar
 		}
-		var dataRawPtr *Param16data.LengthDtoWithValidation = nil
+		var dataRawPtr *Param19data.LengthDtoWithValidation = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5249,19 +8297,25 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackagesValidation' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"LengthDtoWithValidation",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesValidation",
+						"parameter": "data",
+						"type":      "LengthDtoWithValidation",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackagesValidation",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5278,7 +8332,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5296,6 +8350,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ExternalPackagesValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -5313,8 +8368,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/arrays-in-body-and-res"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/external-packages-validation")), e2EcontrollerExternalPackagesValidationHandler)
+	e2EcontrollerArraysInBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInBodyAndResHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -5335,10 +8392,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ArraysInBodyAndRes")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var conversionErr error
-		var dataRawPtr *[]Param13data.LengthDto = nil
+		var dataRawPtr *[]Param16data.LengthDto = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5350,19 +8407,25 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ArraysInBodyAndRes' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[]LengthDto",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInBodyAndRes",
+						"parameter": "data",
+						"type":      "[]LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ArraysInBodyAndRes",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5379,7 +8442,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5397,6 +8460,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ArraysInBodyAndRes",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -5414,8 +8478,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/arrays-inside-body-and-res"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/arrays-in-body-and-res")), e2EcontrollerArraysInBodyAndResHandler)
+	e2EcontrollerArraysInsideBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInsideBodyAndResHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -5436,10 +8502,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ArraysInsideBodyAndRes")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var conversionErr error
-		var dataRawPtr *[]Param17data.BlaBla = nil
+		var dataRawPtr *[]Param20data.BlaBla = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5451,19 +8517,25 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ArraysInsideBodyAndRes' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[]BlaBla",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInsideBodyAndRes",
+						"parameter": "data",
+						"type":      "[]BlaBla",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ArraysInsideBodyAndRes",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5480,7 +8552,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5498,6 +8570,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ArraysInsideBodyAndRes",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -5515,8 +8588,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/deep-arrays-with-validation"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/arrays-inside-body-and-res")), e2EcontrollerArraysInsideBodyAndResHandler)
+	e2EcontrollerDeepArraysWithValidationErrorStatusCodes := []int{500}
+	e2EcontrollerDeepArraysWithValidationHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -5537,10 +8612,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "DeepArraysWithValidation")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var conversionErr error
-		var dataRawPtr *[][]Param18data.BlaBla2 = nil
+		var dataRawPtr *[][]Param21data.BlaBla2 = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5552,19 +8627,25 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'DeepArraysWithValidation' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[][]BlaBla2",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "DeepArraysWithValidation",
+						"parameter": "data",
+						"type":      "[][]BlaBla2",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/DeepArraysWithValidation",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5581,7 +8662,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5599,6 +8680,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/DeepArraysWithValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -5616,8 +8698,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/embedded-structs"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/deep-arrays-with-validation")), e2EcontrollerDeepArraysWithValidationHandler)
+	e2EcontrollerEmbeddedStructsErrorStatusCodes := []int{500}
+	e2EcontrollerEmbeddedStructsHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -5638,10 +8722,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "EmbeddedStructs")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var conversionErr error
-		var dataRawPtr *Param19data.TheModel = nil
+		var dataRawPtr *Param22data.TheModel = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5653,19 +8737,25 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'EmbeddedStructs' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModel",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "EmbeddedStructs",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/EmbeddedStructs",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5682,7 +8772,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5700,6 +8790,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/EmbeddedStructs",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -5717,8 +8808,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/structs-with-inner-pointer"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/embedded-structs")), e2EcontrollerEmbeddedStructsHandler)
+	e2EcontrollerStructsWithInnerPointerErrorStatusCodes := []int{500}
+	e2EcontrollerStructsWithInnerPointerHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -5739,10 +8832,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "StructsWithInnerPointer")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var conversionErr error
-		var dataRawPtr *Param20data.TheModelWithInnerPointer = nil
+		var dataRawPtr *Param23data.TheModelWithInnerPointer = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5754,19 +8847,25 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'StructsWithInnerPointer' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModelWithInnerPointer",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "StructsWithInnerPointer",
+						"parameter": "data",
+						"type":      "TheModelWithInnerPointer",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/StructsWithInnerPointer",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5783,7 +8882,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5801,6 +8900,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/StructsWithInnerPointer",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -5818,8 +8918,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/context-injection-empty"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/structs-with-inner-pointer")), e2EcontrollerStructsWithInnerPointerHandler)
+	e2EcontrollerContextInjectionEmptyErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionEmptyHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -5840,7 +8942,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ContextInjectionEmpty")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5857,7 +8959,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5875,6 +8977,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ContextInjectionEmpty",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -5892,8 +8995,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/context-injection"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/context-injection-empty")), e2EcontrollerContextInjectionEmptyHandler)
+	e2EcontrollerContextInjectionErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -5914,10 +9019,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ContextInjection")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var conversionErr error
-		var dataRawPtr *Param19data.TheModel = nil
+		var dataRawPtr *Param22data.TheModel = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5929,19 +9034,25 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ContextInjection' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModel",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ContextInjection",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ContextInjection",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5958,7 +9069,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5976,6 +9087,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ContextInjection",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -5993,8 +9105,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/byte-slice"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/context-injection")), e2EcontrollerContextInjectionHandler)
+	e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithByteSliceHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -6015,10 +9129,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ReturnsStructWithByteSlice")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var conversionErr error
-		var arriveRawPtr *Param22arrive.ObjectWithByteSlice = nil
+		var arriveRawPtr *Param8arrive.ObjectWithByteSlice = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "", &arriveRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6030,19 +9144,25 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ReturnsStructWithByteSlice' but body parameter '%s' did not pass validation of '%s' - %s",
-					"arrive",
-					"ObjectWithByteSlice",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithByteSlice",
+						"parameter": "arrive",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ReturnsStructWithByteSlice",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -6059,7 +9179,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6077,6 +9197,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ReturnsStructWithByteSlice",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -6094,8 +9215,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/special-primitives"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/byte-slice")), e2EcontrollerReturnsStructWithByteSliceHandler)
+	e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithSpecialPrimitivesHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -6116,10 +9239,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ReturnsStructWithSpecialPrimitives")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var conversionErr error
-		var arriveRawPtr *Param23arrive.ObjectWithSpecialPrimitives = nil
+		var arriveRawPtr *Param24arrive.ObjectWithSpecialPrimitives = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "", &arriveRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6131,19 +9254,25 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ReturnsStructWithSpecialPrimitives' but body parameter '%s' did not pass validation of '%s' - %s",
-					"arrive",
-					"ObjectWithSpecialPrimitives",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithSpecialPrimitives",
+						"parameter": "arrive",
+						"type":      "ObjectWithSpecialPrimitives",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ReturnsStructWithSpecialPrimitives",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -6160,7 +9289,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6178,6 +9307,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ReturnsStructWithSpecialPrimitives",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -6195,8 +9325,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/alias-of-primitive"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/special-primitives")), e2EcontrollerReturnsStructWithSpecialPrimitivesHandler)
+	e2EcontrollerAliasOfStringErrorStatusCodes := []int{}
+	e2EcontrollerAliasOfStringHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -6217,10 +9349,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "AliasOfString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var conversionErr error
-		var objectRawPtr *Param24object.ObjectWithAliasOfString = nil
+		var objectRawPtr *Param25object.ObjectWithAliasOfString = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &objectRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6232,25 +9364,31 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'AliasOfString' but body parameter '%s' did not pass validation of '%s' - %s",
-					"object",
-					"ObjectWithAliasOfString",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "AliasOfString",
+						"parameter": "object",
+						"type":      "ObjectWithAliasOfString",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/AliasOfString",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var numRawPtr *Param25num.AliasOfInt = nil
+		var numRawPtr *Param26num.AliasOfInt = nil
 		numRaw := echoCtx.QueryParam("num")
 		isnumExists := echoCtx.Request().URL.Query().Has("num")
 		if isnumExists {
-			numUint64, conversionErr := strconv.Atoi(numRaw)
+			num, conversionErr := parseIntegerParam[int](numRaw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -6261,23 +9399,20 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'AliasOfString' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"num",
-						"AliasOfInt",
-						reflect.TypeOf(numRaw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/AliasOfString",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"AliasOfString",
+					"num",
+					"query",
+					"/num",
+					"AliasOfInt",
+					reflect.TypeOf(numRaw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			num := int(numUint64)
-			numVar := Param25num.AliasOfInt(num)
+			numVar := Param26num.AliasOfInt(num)
 			numRawPtr = &numVar
 		}
 		if validatorErr := validatorInstance.Var(numRawPtr, "required"); validatorErr != nil {
@@ -6291,16 +9426,18 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "num"
-			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/num", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var strRawPtr *Param26str.AliasOfDirectString = nil
+		var strRawPtr *Param27str.AliasOfDirectString = nil
 		strRaw := echoCtx.QueryParam("str")
 		isstrExists := echoCtx.Request().URL.Query().Has("str")
 		if isstrExists {
 			str := strRaw
-			strVar := Param26str.AliasOfDirectString(str)
+			strVar := Param27str.AliasOfDirectString(str)
 			strRawPtr = &strVar
 		}
 		if validatorErr := validatorInstance.Var(strRawPtr, "required"); validatorErr != nil {
@@ -6314,9 +9451,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "str"
-			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/str", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -6333,7 +9472,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6351,6 +9490,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/AliasOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -6368,8 +9508,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/body-array-of-string"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/alias-of-primitive")), e2EcontrollerAliasOfStringHandler)
+	e2EcontrollerBodyArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -6390,7 +9532,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "BodyArrayOfString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var conversionErr error
 		var valuesRawPtr *[]string = nil
@@ -6405,19 +9547,25 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'BodyArrayOfString' but body parameter '%s' did not pass validation of '%s' - %s",
-					"values",
-					"[]string",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfString",
+						"parameter": "values",
+						"type":      "[]string",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/BodyArrayOfString",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -6434,7 +9582,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6452,6 +9600,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/BodyArrayOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -6469,8 +9618,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/body-array-of-enum-string"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/body-array-of-string")), e2EcontrollerBodyArrayOfStringHandler)
+	e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringEnumHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -6491,10 +9642,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "BodyArrayOfStringEnum")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var conversionErr error
-		var valuesRawPtr *[]Param27values.Myemamium = nil
+		var valuesRawPtr *[]Param28values.Myemamium = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &valuesRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6506,19 +9657,25 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'BodyArrayOfStringEnum' but body parameter '%s' did not pass validation of '%s' - %s",
-					"values",
-					"[]Myemamium",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfStringEnum",
+						"parameter": "values",
+						"type":      "[]Myemamium",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/BodyArrayOfStringEnum",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -6535,7 +9692,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6553,6 +9710,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/BodyArrayOfStringEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -6570,8 +9728,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/query-array-of-string"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/body-array-of-enum-string")), e2EcontrollerBodyArrayOfStringEnumHandler)
+	e2EcontrollerQueryArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfStringHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -6592,7 +9752,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "QueryArrayOfString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var valuesRawPtr *[]string = nil
 		valuesRawArray := echoCtx.QueryParams()["values"]
@@ -6617,9 +9777,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -6636,7 +9798,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6654,6 +9816,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/QueryArrayOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -6671,8 +9834,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/query-array-of-enum"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/query-array-of-string")), e2EcontrollerQueryArrayOfStringHandler)
+	e2EcontrollerQueryArrayOfEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfEnumHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -6693,19 +9858,19 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "QueryArrayOfEnum")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
-		var valuesRawPtr *[]Param27values.Myemamium = nil
+		var valuesRawPtr *[]Param28values.Myemamium = nil
 		valuesRawArray := echoCtx.QueryParams()["values"]
 		isvaluesExists := echoCtx.Request().URL.Query().Has("values")
 		if isvaluesExists {
-			values := make([]Param27values.Myemamium, 0, len(valuesRawArray))
+			values := make([]Param28values.Myemamium, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
 				valuesItem := valuesRaw
-				values = append(values, Param27values.Myemamium(valuesItem))
+				values = append(values, Param28values.Myemamium(valuesItem))
 			}
 			valuesRawPtr = &values
-			valuesVar := []Param27values.Myemamium(values)
+			valuesVar := []Param28values.Myemamium(values)
 			valuesRawPtr = &valuesVar
 		}
 		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
@@ -6719,21 +9884,23 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var values2RawPtr *[]Param28values2.MyaliasString = nil
+		var values2RawPtr *[]Param29values2.MyaliasString = nil
 		values2RawArray := echoCtx.QueryParams()["values2"]
 		isvalues2Exists := echoCtx.Request().URL.Query().Has("values2")
 		if isvalues2Exists {
-			values2 := make([]Param28values2.MyaliasString, 0, len(values2RawArray))
+			values2 := make([]Param29values2.MyaliasString, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
 				values2Item := values2Raw
-				values2 = append(values2, Param28values2.MyaliasString(values2Item))
+				values2 = append(values2, Param29values2.MyaliasString(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param28values2.MyaliasString(values2)
+			values2Var := []Param29values2.MyaliasString(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -6747,9 +9914,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -6766,7 +9935,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6784,6 +9953,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/QueryArrayOfEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -6801,8 +9971,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/query-array-of-others"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/query-array-of-enum")), e2EcontrollerQueryArrayOfEnumHandler)
+	e2EcontrollerQueryArrayOfOthersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -6823,7 +9995,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "QueryArrayOfOthers")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var valuesRawPtr *[]int = nil
 		valuesRawArray := echoCtx.QueryParams()["values"]
@@ -6831,7 +10003,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if isvaluesExists {
 			values := make([]int, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
-				valuesUint64, conversionErr := strconv.Atoi(valuesRaw)
+				valuesItem, conversionErr := parseIntegerParam[int](valuesRaw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -6842,22 +10014,19 @@ func RegisterRoutes(engine *echo.Echo) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]int",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values",
+						"query",
+						"/values",
+						"[]int",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+					)
 					// params validation error response extension placeholder
-					return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				valuesItem := int(valuesUint64)
 				values = append(values, int(valuesItem))
 			}
 			valuesRawPtr = &values
@@ -6874,17 +10043,19 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var values2RawPtr *[]Param29values2.MyaliasInt = nil
+		var values2RawPtr *[]Param30values2.MyaliasInt = nil
 		values2RawArray := echoCtx.QueryParams()["values2"]
 		isvalues2Exists := echoCtx.Request().URL.Query().Has("values2")
 		if isvalues2Exists {
-			values2 := make([]Param29values2.MyaliasInt, 0, len(values2RawArray))
+			values2 := make([]Param30values2.MyaliasInt, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
-				values2Uint64, conversionErr := strconv.Atoi(values2Raw)
+				values2Item, conversionErr := parseIntegerParam[int](values2Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -6895,26 +10066,23 @@ func RegisterRoutes(engine *echo.Echo) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values2",
-							"[]MyaliasInt",
-							reflect.TypeOf(values2Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values2",
+						"query",
+						"/values2",
+						"[]MyaliasInt",
+						reflect.TypeOf(values2Raw).String(),
+						getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+					)
 					// params validation error response extension placeholder
-					return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				values2Item := int(values2Uint64)
-				values2 = append(values2, Param29values2.MyaliasInt(values2Item))
+				values2 = append(values2, Param30values2.MyaliasInt(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param29values2.MyaliasInt(values2)
+			values2Var := []Param30values2.MyaliasInt(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -6928,9 +10096,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var values3RawPtr *[]bool = nil
 		values3RawArray := echoCtx.QueryParams()["values3"]
@@ -6938,7 +10108,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if isvalues3Exists {
 			values3 := make([]bool, 0, len(values3RawArray))
 			for _, values3Raw := range values3RawArray {
-				values3Bool, conversionErr := strconv.ParseBool(values3Raw)
+				values3Item, conversionErr := strconv.ParseBool(values3Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -6949,22 +10119,19 @@ func RegisterRoutes(engine *echo.Echo) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values3",
-							"[]bool",
-							reflect.TypeOf(values3Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values3",
+						"query",
+						"/values3",
+						"[]bool",
+						reflect.TypeOf(values3Raw).String(),
+						getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+					)
 					// params validation error response extension placeholder
-					return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				values3Item := values3Bool
 				values3 = append(values3, bool(values3Item))
 			}
 			values3RawPtr = &values3
@@ -6981,9 +10148,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values3"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values3", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var values4RawPtr *[]int32 = nil
 		values4RawArray := echoCtx.QueryParams()["values4"]
@@ -6991,7 +10160,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if isvalues4Exists {
 			values4 := make([]int32, 0, len(values4RawArray))
 			for _, values4Raw := range values4RawArray {
-				values4Uint64, conversionErr := strconv.ParseInt(values4Raw, 10, 32)
+				values4Item, conversionErr := parseIntegerParam[int32](values4Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7002,22 +10171,19 @@ func RegisterRoutes(engine *echo.Echo) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values4",
-							"[]int32",
-							reflect.TypeOf(values4Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values4",
+						"query",
+						"/values4",
+						"[]int32",
+						reflect.TypeOf(values4Raw).String(),
+						getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+					)
 					// params validation error response extension placeholder
-					return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				values4Item := int32(values4Uint64)
 				values4 = append(values4, int32(values4Item))
 			}
 			values4RawPtr = &values4
@@ -7034,9 +10200,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values4"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values4", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -7053,7 +10221,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7071,6 +10239,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/QueryArrayOfOthers",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -7088,8 +10257,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/query-array-of-others-enum"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/query-array-of-others")), e2EcontrollerQueryArrayOfOthersHandler)
+	e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersEnumHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -7110,15 +10281,15 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "QueryArrayOfOthersEnum")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
-		var valuesRawPtr *[]Param31values.NumberEnum = nil
+		var valuesRawPtr *[]Param32values.NumberEnum = nil
 		valuesRawArray := echoCtx.QueryParams()["values"]
 		isvaluesExists := echoCtx.Request().URL.Query().Has("values")
 		if isvaluesExists {
-			values := make([]Param31values.NumberEnum, 0, len(valuesRawArray))
+			values := make([]Param32values.NumberEnum, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
-				valuesUint64, conversionErr := strconv.ParseInt(valuesRaw, 10, 16)
+				valuesItem, conversionErr := parseIntegerParam[int16](valuesRaw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7129,26 +10300,23 @@ func RegisterRoutes(engine *echo.Echo) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthersEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]NumberEnum",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthersEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values",
+						"query",
+						"/values",
+						"[]NumberEnum",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+					)
 					// params validation error response extension placeholder
-					return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				valuesItem := int16(valuesUint64)
-				values = append(values, Param31values.NumberEnum(valuesItem))
+				values = append(values, Param32values.NumberEnum(valuesItem))
 			}
 			valuesRawPtr = &values
-			valuesVar := []Param31values.NumberEnum(values)
+			valuesVar := []Param32values.NumberEnum(values)
 			valuesRawPtr = &valuesVar
 		}
 		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
@@ -7162,21 +10330,23 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var values2RawPtr *[]Param32values2.BoolEnum = nil
+		var values2RawPtr *[]Param33values2.BoolEnum = nil
 		values2RawArray := echoCtx.QueryParams()["values2"]
 		isvalues2Exists := echoCtx.Request().URL.Query().Has("values2")
 		if isvalues2Exists {
-			values2 := make([]Param32values2.BoolEnum, 0, len(values2RawArray))
+			values2 := make([]Param33values2.BoolEnum, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
 				values2Item := values2Raw
-				values2 = append(values2, Param32values2.BoolEnum(values2Item))
+				values2 = append(values2, Param33values2.BoolEnum(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param32values2.BoolEnum(values2)
+			values2Var := []Param33values2.BoolEnum(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -7190,9 +10360,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			// validation error response extension placeholder
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -7209,7 +10381,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7227,6 +10399,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/QueryArrayOfOthersEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -7244,8 +10417,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/query-pointer-to-array"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/query-array-of-others-enum")), e2EcontrollerQueryArrayOfOthersEnumHandler)
+	e2EcontrollerQueryArrayOfPointersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfPointersHandler := func(echoCtx echo.Context) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			echoCtx,
@@ -7266,7 +10441,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "QueryArrayOfPointers")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
 		var values07RawPtr *[]string = nil
 		values07RawArray := echoCtx.QueryParams()["values07"]
@@ -7295,7 +10470,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7313,6 +10488,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/QueryArrayOfPointers",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
 			// json error response extension placeholder
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -7330,5 +10506,169 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
 		return nil
-	})
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/query-pointer-to-array")), e2EcontrollerQueryArrayOfPointersHandler)
+	// E2ESharedRateLimitController
+	e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitFirstHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		if allowed, err := enforceRateLimit(echoCtx, "SharedRateLimitFirst", e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit); !allowed {
+			return err
+		}
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "SharedRateLimitFirst")
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SharedRateLimitFirst()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitFirst'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitFirst",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/shared-rate-limit/first")), e2EsharedRateLimitControllerSharedRateLimitFirstHandler)
+	e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitSecondHandler := func(echoCtx echo.Context) error {
+		// route start routes extension placeholder
+		if allowed, err := enforceRateLimit(echoCtx, "SharedRateLimitSecond", e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit); !allowed {
+			return err
+		}
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "SharedRateLimitSecond")
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SharedRateLimitSecond()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitSecond'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitSecond",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+			// json error response extension placeholder
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		echoCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/shared-rate-limit/second")), e2EsharedRateLimitControllerSharedRateLimitSecondHandler)
 }
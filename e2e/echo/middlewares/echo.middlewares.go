@@ -96,3 +96,14 @@ func MiddlewareOnOutputValidationError(ctx context.Context, echoCtx echo.Context
 	}
 	return ctx, true
 }
+
+func MiddlewareAudit(ctx context.Context, echoCtx echo.Context) (context.Context, bool) {
+	echoCtx.Response().Header().Set("X-pass-named-middleware-audit", "true")
+	return ctx, true
+}
+
+func MiddlewareTrace(ctx context.Context, echoCtx echo.Context) (context.Context, bool) {
+	// Named middlewares run in declaration order so the audit middleware must have already run
+	echoCtx.Response().Header().Set("X-pass-named-middleware-trace", echoCtx.Response().Header().Get("X-pass-named-middleware-audit"))
+	return ctx, true
+}
@@ -15,45 +15,64 @@ Repository: https://github.com/gopher-fleece/gleece
 */
 package routes
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"reflect"
 	"regexp"
+	"runtime/debug"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 	"github.com/go-playground/validator/v10"
-	RequestAuth "github.com/gopher-fleece/gleece/v2/e2e/echo/auth"
 	"github.com/gopher-fleece/runtime"
 	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	E2EClassSecController "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	E2EController "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param11value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param12value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param12value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param15data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param16data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param17data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	E2ESharedRateLimitController "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param14value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param18data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param19data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param20data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param22arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param23arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param24object "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param25num "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param26str "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param27values "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param28values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param21data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param22data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param23data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param24arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param25object "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param26num "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param27str "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param28values "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param29values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param31values "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param32values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param30values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param32values "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param33values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param4value2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param5data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param5theBody "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Response6CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param13data "github.com/haimkastner/unitsnet-go/units"
-	Param14unit "github.com/haimkastner/unitsnet-go/units"
+	Param6principal "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Response9CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param16data "github.com/haimkastner/unitsnet-go/units"
+	Param17unit "github.com/haimkastner/unitsnet-go/units"
 	// ImportsExtension - test
 )
 var validatorInstance = validator.New()
@@ -66,6 +85,24 @@ type SecurityCheckList struct {
 	Checks   []runtime.SecurityCheck
 	Relation SecurityListRelation
 }
+// ValidationIssue describes a single failed validation of a request's input
+type ValidationIssue struct {
+	// The location of the offending input - body, query, path, header or form
+	In string `json:"in"`
+	// A JSON pointer to the offending value, relative to its location
+	Pointer string `json:"pointer"`
+	// The validation rule that failed
+	Rule string `json:"rule"`
+	// The failed rule's parameter, if any
+	Param string `json:"param,omitempty"`
+	// A human-readable description of the failure
+	Message string `json:"message"`
+}
+// ValidationProblem is an RFC7807 error detailing the failed validations of a request
+type ValidationProblem struct {
+	runtime.Rfc7807Error
+	Errors []ValidationIssue `json:"errors"`
+}
 // TypeDeclarationsExtension - test
 func getRequestContext(echoCtx echo.Context) context.Context {
 	return echoCtx.Request().Context()
@@ -76,6 +113,56 @@ func setRequestContext(echoCtx echo.Context, ctx context.Context) {
 		echoCtx.SetRequest(newCtx)
 	}
 }
+type principalContextKey struct{}
+// WithPrincipal attaches the request's authenticated principal to the given context.
+//
+// Security handlers return the resulting context to make the principal available to @Principal parameters
+func WithPrincipal(ctx context.Context, principal any) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+// getPrincipal retrieves the principal attached to the given context via WithPrincipal.
+// Yields nil if no principal of type TPrincipal (or a pointer to one) was attached
+func getPrincipal[TPrincipal any](ctx context.Context) *TPrincipal {
+	switch principal := ctx.Value(principalContextKey{}).(type) {
+	case *TPrincipal:
+		return principal
+	case TPrincipal:
+		return &principal
+	default:
+		return nil
+	}
+}
+// IdentifiablePrincipal may be implemented by principals to provide a stable identity, e.g. a user ID,
+// by which their idempotent requests and principal keyed rate limits are scoped
+type IdentifiablePrincipal interface {
+	IdempotencyIdentity() string
+}
+// describePrincipal returns a stable textual identity of the principal attached to the given context via WithPrincipal,
+// i.e., its IdempotencyIdentity or String method's result, if any, or its dereferenced value if of a primitive kind.
+// Yields an empty string if no principal was attached.
+//
+// Returns false for principals lacking a stable identity, e.g. structs, whose formatting may vary between requests
+func describePrincipal(ctx context.Context) (string, bool) {
+	principal := ctx.Value(principalContextKey{})
+	switch identifiable := principal.(type) {
+	case nil:
+		return "", true
+	case IdentifiablePrincipal:
+		return identifiable.IdempotencyIdentity(), true
+	case fmt.Stringer:
+		return identifiable.String(), true
+	}
+	value := reflect.Indirect(reflect.ValueOf(principal))
+	switch value.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprintf("%v", value), true
+	default:
+		return "", false
+	}
+}
 func registerEnumValidation(validate *validator.Validate, validationName string, allowedValues []string) {
 	// Convert the array to a map for O(1) lookup
 	lookup := make(map[string]struct{})
@@ -89,12 +176,12 @@ func registerEnumValidation(validate *validator.Validate, validationName string,
 		return exists
 	})
 }
-func extractValidationErrorMessage(err error, fieldName *string) string {
+func extractValidationErrorMessage(err error, fieldName *string, languages []string) string {
 	if err == nil {
 		return ""
 	}
-	validationErrors, ok := err.(validator.ValidationErrors)
-	if !ok {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
 		return err.Error()
 	}
 	var errStr string
@@ -103,15 +190,26 @@ func extractValidationErrorMessage(err error, fieldName *string) string {
 		if fieldName != nil {
 			fName = *fieldName
 		}
-		errStr += fmt.Sprintf("Field '%s' failed validation with tag '%s'. ", fName, validationErr.Tag())
+		errStr += translateMessage(
+			languages,
+			map[string]string{"field": fName, "tag": validationErr.Tag(), "param": validationErr.Param()},
+			ValidationTagMessageKey(validationErr.Tag()),
+			MessageKeyFieldValidation,
+		) + ". "
 	}
 	return errStr
 }
-func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error) int {
+// getStatusCode returns the status code of an operation's response - the one set via the controller's SetStatus, if any,
+// that of the error mapping matching err among the operation's declared error status codes or a 500 for other errors,
+// or the success status code
+func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error, routeErrorStatusCodes []int) int {
 	if controller.GetStatus() != nil {
 		return int(*controller.GetStatus())
 	}
 	if err != nil {
+		if mapping := getErrorMapping(err, routeErrorStatusCodes); mapping != nil {
+			return mapping.StatusCode
+		}
 		return http.StatusInternalServerError
 	}
 	if hasReturnValue {
@@ -124,7 +222,7 @@ func bindAndValidateBody[TOutput any](echoCtx echo.Context, contentType string,
 	bodyBytes, err := io.ReadAll(echoCtx.Request().Body)
 	if err != nil || len(bodyBytes) == 0 {
 		if strings.Contains(validation, "required") {
-			return fmt.Errorf("body is required but was not provided")
+			return errBodyRequired
 		}
 		return nil
 	}
@@ -159,12 +257,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Slice, reflect.Array:
 		// For slices/arrays, validate each element recursively
 		for i := 0; i < val.Len(); i++ {
-			elemPath := path
-			if path != "" {
-				elemPath = fmt.Sprintf("%s[%d]", path, i)
-			} else {
-				elemPath = fmt.Sprintf("[%d]", i)
-			}
+			elemPath := fmt.Sprintf("%s/%d", path, i)
 			// Get the element - handle case where element might be nil
 			elem := val.Index(i)
 			if elem.Kind() == reflect.Ptr && elem.IsNil() {
@@ -179,12 +272,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Map:
 		// For maps, validate each value recursively
 		for _, key := range val.MapKeys() {
-			elemPath := path
-			if path != "" {
-				elemPath = fmt.Sprintf("%s.%v", path, key.Interface())
-			} else {
-				elemPath = fmt.Sprintf("%v", key.Interface())
-			}
+			elemPath := fmt.Sprintf("%s/%s", path, escapeJsonPointerToken(fmt.Sprintf("%v", key.Interface())))
 			elemVal := val.MapIndex(key)
 			if elemVal.Kind() == reflect.Ptr && elemVal.IsNil() {
 				continue
@@ -197,10 +285,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Struct:
 		// Validate structs with the validator
 		if err := validatorInstance.Struct(data); err != nil {
-			if path != "" {
-				return fmt.Errorf("validation error at %s: %w", path, err)
-			}
-			return err
+			return &structValidationError{pointer: path, structType: val.Type(), err: err}
 		}
 		return nil
 	default:
@@ -219,19 +304,37 @@ func toEchoUrl(url string) string {
 	}
 	return processedUrl
 }
+// withBasePath prefixes the given route path with the given base path
+func withBasePath(basePath string, path string) string {
+	basePath = strings.TrimSuffix(basePath, "/")
+	if basePath != "" && !strings.HasPrefix(basePath, "/") {
+		basePath = "/" + basePath
+	}
+	return basePath + path
+}
+// EchoRouter is implemented by both *echo.Echo and *echo.Group
+type EchoRouter interface {
+	Add(method string, path string, handler echo.HandlerFunc, middleware ...echo.MiddlewareFunc) *echo.Route
+}
+// authorize succeeds if all checks of any of the given lists pass.
+// Each list is an alternative (OR) of security schemas that must all be satisfied (AND), as expanded
+// from the route's @Security and @AdvancedSecurity annotations
 func authorize(echoCtx echo.Context, checksLists []SecurityCheckList) *runtime.SecurityError {
 	var lastError *runtime.SecurityError
 	for _, list := range checksLists {
 		if list.Relation != SecurityListRelationAnd {
-			panic(
-				"Encountered a security list relation of type '%s' - this is unexpected and indicates a bug in Gleece itself." +
+			panic(fmt.Sprintf(
+				"Encountered a security list relation of type '%s' - this is unexpected and indicates a bug in Gleece itself. "+
 					"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
-			)
+				list.Relation,
+			))
 		}
-		// Iterate over each security list
+		// Iterate over each security list.
+		// Checks may attach values (e.g. a principal) to the request context - these are discarded if the list fails
+		listCtx := getRequestContext(echoCtx)
 		encounteredErrorInList := false
 		for _, check := range list.Checks {
-			secCtx, secErr := RequestAuth.GleeceRequestAuthorization(getRequestContext(echoCtx), echoCtx, check)
+			secCtx, secErr := runSecurityHandler(getRequestContext(echoCtx), echoCtx, check)
 			setRequestContext(echoCtx, secCtx)
 			if secErr != nil {
 				lastError = secErr
@@ -244,6 +347,7 @@ func authorize(echoCtx echo.Context, checksLists []SecurityCheckList) *runtime.S
 		if !encounteredErrorInList {
 			return nil
 		}
+		setRequestContext(echoCtx, listCtx)
 	}
 	// If we got here it means authentication has failed
 	return lastError
@@ -262,20 +366,1134 @@ func handleAuthorizationError(echoCtx echo.Context, authErr *runtime.SecurityErr
 	}
 	return echoCtx.JSON(statusCode, stdError)
 }
-func wrapValidatorError(validatorErr error, operationId string, fieldName string) runtime.Rfc7807Error {
+func wrapValidatorError(validatorErr error, operationId string, fieldName string, languages []string) runtime.Rfc7807Error {
 	return runtime.Rfc7807Error{
 		Type: http.StatusText(http.StatusUnprocessableEntity),
-		Detail: fmt.Sprintf(
-			"A request was made to operation '%s' but parameter '%s' did not pass validation - %s",
-			operationId,
-			fieldName,
-			extractValidationErrorMessage(validatorErr, &fieldName),
+		Detail: translateMessage(
+			languages,
+			map[string]string{
+				"operation": operationId,
+				"parameter": fieldName,
+				"details":   extractValidationErrorMessage(validatorErr, &fieldName, languages),
+			},
+			MessageKeyParamValidation,
 		),
 		Status:   http.StatusUnprocessableEntity,
 		Instance: fmt.Sprintf("/validation/error/%s", operationId),
 	}
 }
+// paramInteger is the set of signed integer types a request parameter may be converted to
+type paramInteger interface {
+	int | int8 | int16 | int32 | int64
+}
+// paramUnsigned is the set of unsigned integer types a request parameter may be converted to
+type paramUnsigned interface {
+	uint | uint8 | uint16 | uint32 | uint64
+}
+// paramFloat is the set of floating point types a request parameter may be converted to
+type paramFloat interface {
+	float32 | float64
+}
+// parseIntegerParam converts the raw value of a request parameter to the given signed integer type
+func parseIntegerParam[T paramInteger](raw string) (T, error) {
+	parsed, err := strconv.ParseInt(raw, 10, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// parseUnsignedParam converts the raw value of a request parameter to the given unsigned integer type
+func parseUnsignedParam[T paramUnsigned](raw string) (T, error) {
+	parsed, err := strconv.ParseUint(raw, 10, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// parseFloatParam converts the raw value of a request parameter to the given floating point type
+func parseFloatParam[T paramFloat](raw string) (T, error) {
+	parsed, err := strconv.ParseFloat(raw, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// wrapConversionError creates the RFC7807 error and validation issues describing a request parameter
+// whose raw value could not be converted to the parameter's type
+func wrapConversionError(
+	conversionErr error,
+	operationId string,
+	fieldName string,
+	in string,
+	pointer string,
+	expectedType string,
+	actualType string,
+	languages []string,
+) (runtime.Rfc7807Error, []ValidationIssue) {
+	validationError := runtime.Rfc7807Error{
+		Type: http.StatusText(http.StatusUnprocessableEntity),
+		Detail: translateMessage(
+			languages,
+			map[string]string{
+				"operation": operationId,
+				"parameter": fieldName,
+				"expected":  expectedType,
+				"actual":    actualType,
+			},
+			MessageKeyParamConversion,
+		),
+		Status:     http.StatusUnprocessableEntity,
+		Instance:   fmt.Sprintf("/validation/error/%s", operationId),
+		Extensions: map[string]string{"error": conversionErr.Error()},
+	}
+	validationIssues := []ValidationIssue{
+		{
+			In:      in,
+			Pointer: pointer,
+			Rule:    "type",
+			Param:   expectedType,
+			Message: conversionErr.Error(),
+		},
+	}
+	return validationError, validationIssues
+}
+var errBodyRequired = errors.New("body is required but was not provided")
+// structValidationError associates a struct's validation errors with the struct's location within the validated payload
+type structValidationError struct {
+	pointer    string
+	structType reflect.Type
+	err        error
+}
+func (e *structValidationError) Error() string {
+	if e.pointer == "" {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("validation error at %s: %s", e.pointer, e.err.Error())
+}
+func (e *structValidationError) Unwrap() error {
+	return e.err
+}
+func escapeJsonPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+// getJsonFieldName returns the name of the given field within its struct's JSON representation.
+//
+// Embedded structs without an explicit name are flattened by encoding/json and therefore yield an empty name
+func getJsonFieldName(field reflect.StructField) string {
+	if name := strings.Split(field.Tag.Get("json"), ",")[0]; name != "" {
+		return name
+	}
+	fieldType := field.Type
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	if field.Anonymous && fieldType.Kind() == reflect.Struct {
+		return ""
+	}
+	return field.Name
+}
+// toJsonPointer converts a validator struct namespace (e.g. 'Model.Items[0].Name') to a JSON pointer (e.g. '/items/0/name')
+// using the json tags of the root type's fields
+func toJsonPointer(rootType reflect.Type, structNamespace string) string {
+	pointer := ""
+	currentType := rootType
+	segments := strings.Split(structNamespace, ".")
+	for _, segment := range segments[1:] {
+		name, indices := segment, []string{}
+		if bracket := strings.Index(segment, "["); bracket >= 0 {
+			name = segment[:bracket]
+			indices = strings.Split(strings.TrimSuffix(segment[bracket+1:], "]"), "][")
+		}
+		for currentType != nil && currentType.Kind() == reflect.Ptr {
+			currentType = currentType.Elem()
+		}
+		token := name
+		if currentType != nil && currentType.Kind() == reflect.Struct {
+			if field, found := currentType.FieldByName(name); found {
+				currentType = field.Type
+				token = getJsonFieldName(field)
+			} else {
+				currentType = nil
+			}
+		}
+		if token != "" {
+			pointer += "/" + escapeJsonPointerToken(token)
+		}
+		for _, index := range indices {
+			pointer += "/" + escapeJsonPointerToken(index)
+			for currentType != nil && currentType.Kind() == reflect.Ptr {
+				currentType = currentType.Elem()
+			}
+			if currentType != nil && (currentType.Kind() == reflect.Slice || currentType.Kind() == reflect.Array || currentType.Kind() == reflect.Map) {
+				currentType = currentType.Elem()
+			} else {
+				currentType = nil
+			}
+		}
+	}
+	return pointer
+}
+func getValidationIssueMessage(pointer string, tag string, param string, languages []string) string {
+	args := map[string]string{"tag": tag, "param": param}
+	if pointer == "" {
+		return translateMessage(languages, args, ValidationTagMessageKey(tag), MessageKeyValueValidation)
+	}
+	args["field"] = pointer[strings.LastIndex(pointer, "/")+1:]
+	return translateMessage(languages, args, ValidationTagMessageKey(tag), MessageKeyFieldValidation)
+}
+// getValidationIssues converts the given validation or deserialization error to a list of issues at the given location.
+//
+// The pointer is the JSON pointer of the validated value, relative to its location
+func getValidationIssues(err error, in string, pointer string, languages []string) []ValidationIssue {
+	var structType reflect.Type
+	var structErr *structValidationError
+	if errors.As(err, &structErr) {
+		pointer += structErr.pointer
+		structType = structErr.structType
+	}
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		issues := make([]ValidationIssue, 0, len(validationErrors))
+		for _, validationErr := range validationErrors {
+			issuePointer := pointer
+			if structType != nil {
+				issuePointer += toJsonPointer(structType, validationErr.StructNamespace())
+			}
+			issues = append(issues, ValidationIssue{
+				In:      in,
+				Pointer: issuePointer,
+				Rule:    validationErr.Tag(),
+				Param:   validationErr.Param(),
+				Message: getValidationIssueMessage(issuePointer, validationErr.Tag(), validationErr.Param(), languages),
+			})
+		}
+		return issues
+	}
+	issue := ValidationIssue{In: in, Pointer: pointer, Rule: "parse", Message: err.Error()}
+	var typeErr *json.UnmarshalTypeError
+	if errors.Is(err, errBodyRequired) {
+		issue.Rule = "required"
+	} else if errors.As(err, &typeErr) {
+		if typeErr.Field != "" {
+			issue.Pointer += "/" + strings.ReplaceAll(typeErr.Field, ".", "/")
+		}
+		issue.Rule = "type"
+		issue.Param = typeErr.Type.String()
+	}
+	return []ValidationIssue{issue}
+}
+// Keys of the translatable validation messages.
+//
+// Message templates use named placeholders, such as '{field}', which are replaced with the message's arguments
+const (
+	// Describes a parameter that did not pass validation. Placeholders: {operation}, {parameter}, {details}
+	MessageKeyParamValidation = "paramValidation"
+	// Describes a body that did not pass validation. Placeholders: {operation}, {parameter}, {type}, {details}
+	MessageKeyBodyValidation = "bodyValidation"
+	// Describes a parameter that could not be converted to its declared type. Placeholders: {operation}, {parameter}, {expected}, {actual}
+	MessageKeyParamConversion = "paramConversion"
+	// Describes a field that failed a validation tag with no dedicated message. Placeholders: {field}, {tag}, {param}
+	MessageKeyFieldValidation = "fieldValidation"
+	// Describes a value that failed a validation tag with no dedicated message. Placeholders: {tag}, {param}
+	MessageKeyValueValidation = "valueValidation"
+)
+// The language of the default message catalogue
+const defaultMessageLanguage = "en"
+// The default English message catalogue, used whenever no translation is available
+var defaultMessages = map[string]string{
+	MessageKeyParamValidation: "A request was made to operation '{operation}' but parameter '{parameter}' did not pass validation - {details}",
+	MessageKeyBodyValidation:  "A request was made to operation '{operation}' but body parameter '{parameter}' did not pass validation of '{type}' - {details}",
+	MessageKeyParamConversion: "A request was made to operation '{operation}' but parameter '{parameter}' was not properly sent - Expected {expected} but got {actual}",
+	MessageKeyFieldValidation: "Field '{field}' failed validation with tag '{tag}'",
+	MessageKeyValueValidation: "Value failed validation with tag '{tag}'",
+}
+// MessageTranslator provides validation message templates in the languages requested via the Accept-Language header
+type MessageTranslator interface {
+	// Translate returns the message template registered for the given language and key, if any
+	Translate(language string, key string) (string, bool)
+}
+// MessageCatalogue is a MessageTranslator backed by an in-memory map of language to message key to template.
+//
+// Languages are matched case-insensitively
+type MessageCatalogue map[string]map[string]string
+func (catalogue MessageCatalogue) Translate(language string, key string) (string, bool) {
+	template, found := catalogue[strings.ToLower(language)][key]
+	return template, found
+}
+var messageCatalogue = MessageCatalogue{}
+var messageTranslator MessageTranslator = messageCatalogue
+// SetMessageTranslator replaces the built-in message catalogue with the given translator,
+// e.g. an adapter over go-playground's universal translator.
+//
+// Messages the translator does not provide fall back to the default English catalogue
+func SetMessageTranslator(translator MessageTranslator) {
+	if translator == nil {
+		translator = messageCatalogue
+	}
+	messageTranslator = translator
+}
+// RegisterTranslation registers the template of the given message key for the given language in the built-in catalogue.
+// Has no effect on translators set via SetMessageTranslator
+func RegisterTranslation(language string, key string, template string) {
+	language = strings.ToLower(language)
+	if messageCatalogue[language] == nil {
+		messageCatalogue[language] = map[string]string{}
+	}
+	messageCatalogue[language][key] = template
+}
+// ValidationTagMessageKey returns the message key of the given validation tag, e.g. 'required'.
+//
+// Templates registered under this key take precedence over the generic field and value messages.
+// Placeholders: {field}, {tag}, {param}
+func ValidationTagMessageKey(tag string) string {
+	return "tag." + tag
+}
+// RegisterValidationTagTranslation registers the message used when the given validation tag fails, for the given language
+func RegisterValidationTagTranslation(language string, tag string, template string) {
+	RegisterTranslation(language, ValidationTagMessageKey(tag), template)
+}
+// getAcceptedLanguages returns the languages listed in the given Accept-Language header, most preferred first.
+// Regional variants are followed by their base language, e.g. 'fr-CA' by 'fr'
+func getAcceptedLanguages(header string) []string {
+	type weightedLanguage struct {
+		tag    string
+		weight float64
+	}
+	weightedLanguages := []weightedLanguage{}
+	for _, entry := range strings.Split(header, ",") {
+		parts := strings.Split(entry, ";")
+		tag := strings.TrimSpace(parts[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		weight := 1.0
+		for _, param := range parts[1:] {
+			if value, found := strings.CutPrefix(strings.TrimSpace(param), "q="); found {
+				if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+					weight = parsed
+				}
+			}
+		}
+		if weight > 0 {
+			weightedLanguages = append(weightedLanguages, weightedLanguage{tag: tag, weight: weight})
+		}
+	}
+	slices.SortStableFunc(weightedLanguages, func(a, b weightedLanguage) int {
+		if a.weight > b.weight {
+			return -1
+		}
+		if a.weight < b.weight {
+			return 1
+		}
+		return 0
+	})
+	languages := make([]string, 0, len(weightedLanguages))
+	for _, language := range weightedLanguages {
+		languages = append(languages, language.tag)
+		if base, _, hasRegion := strings.Cut(language.tag, "-"); hasRegion {
+			languages = append(languages, base)
+		}
+	}
+	return languages
+}
+// getMessageTemplate returns the template of the first of the given keys available in the most preferred of the given languages,
+// falling back to English and finally to the default English catalogue
+func getMessageTemplate(languages []string, keys ...string) string {
+	for _, language := range append(slices.Clip(languages), defaultMessageLanguage) {
+		for _, key := range keys {
+			if template, found := messageTranslator.Translate(language, key); found {
+				return template
+			}
+		}
+	}
+	for _, key := range keys {
+		if template, found := defaultMessages[key]; found {
+			return template
+		}
+	}
+	return ""
+}
+// translateMessage formats the template of the given keys in the given languages, replacing its placeholders with the given arguments
+func translateMessage(languages []string, args map[string]string, keys ...string) string {
+	replacements := make([]string, 0, len(args)*2)
+	for name, value := range args {
+		replacements = append(replacements, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(replacements...).Replace(getMessageTemplate(languages, keys...))
+}
+// ControllerFactory creates the controller instance handling a single request.
+//
+// The given context is the request's context
+type ControllerFactory[TController any] func(ctx context.Context) *TController
+var controllerFactories = map[reflect.Type]func(ctx context.Context) any{}
+// RegisterControllerFactory registers the factory used to create a controller for each request it handles.
+//
+// Factories allow controllers to hold injected dependencies such as database handles, services or loggers.
+// Must be called before RegisterRoutes; the factory must not return nil
+func RegisterControllerFactory[TController any](factory ControllerFactory[TController]) {
+	controllerFactories[reflect.TypeOf((*TController)(nil)).Elem()] = func(ctx context.Context) any {
+		return factory(ctx)
+	}
+}
+// createController creates a controller using its registered factory or, if none exists, its zero value
+func createController[TController any](ctx context.Context) *TController {
+	if factory, exists := controllerFactories[reflect.TypeOf((*TController)(nil)).Elem()]; exists {
+		return factory(ctx).(*TController)
+	}
+	return new(TController)
+}
+// ensureControllerFactoriesRegistered panics if any of the given controller types has no registered factory
+func ensureControllerFactoriesRegistered(controllerTypes []reflect.Type) {
+	missing := []string{}
+	for _, controllerType := range controllerTypes {
+		if _, registered := controllerFactories[controllerType]; !registered {
+			missing = append(missing, controllerType.String())
+		}
+	}
+	if len(missing) > 0 {
+		panic(fmt.Sprintf(
+			"controller factories are required but were never registered using RegisterControllerFactory: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
+// ErrorMapping describes the response returned when a controller's error matches a registered mapping
+type ErrorMapping struct {
+	// The HTTP status code to respond with. Must be declared by at least one @ErrorResponse annotation.
+	// The mapping applies only to operations declaring the status code - errors of other operations remain 500s
+	StatusCode int
+	// The RFC7807 title. Defaults to the status code's text
+	Title string
+	// A client-safe description of the error. Defaults to a generic, operation-specific message
+	Detail string
+}
+type registeredErrorMapping struct {
+	matches func(err error) bool
+	mapping ErrorMapping
+}
+var errorMappings = []registeredErrorMapping{}
+var declaredErrorStatusCodes = []int{404, 409, 500}
+// RegisterErrorMapping maps errors matching the given target, via errors.Is, to the given HTTP status code.
+//
+// Mappings are evaluated in order of registration and are superseded by an explicit call to the controller's SetStatus
+func RegisterErrorMapping(target error, statusCode int) {
+	RegisterDetailedErrorMapping(target, ErrorMapping{StatusCode: statusCode})
+}
+// RegisterDetailedErrorMapping maps errors matching the given target, via errors.Is, to the given response
+func RegisterDetailedErrorMapping(target error, mapping ErrorMapping) {
+	addErrorMapping(func(err error) bool { return errors.Is(err, target) }, mapping)
+}
+// RegisterErrorTypeMapping maps errors of type TError, matched via errors.As, to the given response
+func RegisterErrorTypeMapping[TError error](mapping ErrorMapping) {
+	addErrorMapping(func(err error) bool {
+		var target TError
+		return errors.As(err, &target)
+	}, mapping)
+}
+func addErrorMapping(matches func(err error) bool, mapping ErrorMapping) {
+	if !slices.Contains(declaredErrorStatusCodes, mapping.StatusCode) {
+		panic(fmt.Sprintf("Error mapping status code %d is not declared by any @ErrorResponse annotation", mapping.StatusCode))
+	}
+	errorMappings = append(errorMappings, registeredErrorMapping{matches: matches, mapping: mapping})
+}
+// getErrorMapping returns the first registered mapping matching err whose status code is among the given ones, declared by
+// the operation that produced err, or nil if there is none
+func getErrorMapping(err error, routeErrorStatusCodes []int) *ErrorMapping {
+	for _, registered := range errorMappings {
+		if slices.Contains(routeErrorStatusCodes, registered.mapping.StatusCode) && registered.matches(err) {
+			return &registered.mapping
+		}
+	}
+	return nil
+}
+// applyErrorMapping overrides the given RFC7807 error's title and detail with those of the mapping matching err, if any
+func applyErrorMapping(stdError *runtime.Rfc7807Error, err error, routeErrorStatusCodes []int) {
+	mapping := getErrorMapping(err, routeErrorStatusCodes)
+	if mapping == nil {
+		return
+	}
+	stdError.Title = http.StatusText(stdError.Status)
+	if mapping.Title != "" {
+		stdError.Title = mapping.Title
+	}
+	if mapping.Detail != "" {
+		stdError.Detail = mapping.Detail
+	}
+}
 // FunctionDeclarationsExtension - test
+// The instrumentation scope of the spans and metrics reported by the API's routes
+const telemetryInstrumentationName = "github.com/gopher-fleece/gleece"
+var operationTracer = otel.GetTracerProvider().Tracer(telemetryInstrumentationName)
+var requestMetrics = newRequestInstruments(otel.GetMeterProvider())
+// requestInstruments holds the metric instruments recorded for each request
+type requestInstruments struct {
+	duration metric.Float64Histogram
+	count    metric.Int64Counter
+}
+func newRequestInstruments(provider metric.MeterProvider) requestInstruments {
+	meter := provider.Meter(telemetryInstrumentationName)
+	// Instrument creation only fails for malformed names or units, in which case a no-op instrument is yielded
+	duration, _ := meter.Float64Histogram(
+		"http.server.request.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of HTTP server requests"),
+	)
+	count, _ := meter.Int64Counter(
+		"http.server.request.count",
+		metric.WithUnit("{request}"),
+		metric.WithDescription("Number of HTTP server requests"),
+	)
+	return requestInstruments{duration: duration, count: count}
+}
+// RegisterTracerProvider sets the provider used to create the operations' spans.
+// Defaults to the global tracer provider
+func RegisterTracerProvider(provider trace.TracerProvider) {
+	operationTracer = provider.Tracer(telemetryInstrumentationName)
+}
+// RegisterMeterProvider sets the provider used to record the requests' metrics.
+// Defaults to the global meter provider
+func RegisterMeterProvider(provider metric.MeterProvider) {
+	requestMetrics = newRequestInstruments(provider)
+}
+// operationTelemetry traces and measures a single operation's execution
+type operationTelemetry struct {
+	// The request's context, carrying the operation's span
+	ctx        context.Context
+	span       trace.Span
+	phaseSpan  trace.Span
+	startTime  time.Time
+	attributes []attribute.KeyValue
+}
+// startOperationTelemetry starts the given operation's span.
+// The span is a child of the span propagated via the request's headers, if any
+func startOperationTelemetry(
+	ctx context.Context,
+	headers propagation.TextMapCarrier,
+	operationId string,
+	controllerName string,
+	httpVerb string,
+	routeTemplate string,
+) *operationTelemetry {
+	attributes := []attribute.KeyValue{
+		attribute.String("http.request.method", httpVerb),
+		attribute.String("http.route", routeTemplate),
+		attribute.String("gleece.controller", controllerName),
+		attribute.String("gleece.operation", operationId),
+	}
+	ctx = otel.GetTextMapPropagator().Extract(ctx, headers)
+	ctx, span := operationTracer.Start(
+		ctx,
+		operationId,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attributes...),
+	)
+	return &operationTelemetry{
+		ctx:        ctx,
+		span:       span,
+		startTime:  time.Now(),
+		attributes: attributes,
+	}
+}
+// startPhase ends the current phase's span, if any, and starts a child span for the given phase, e.g. 'authorization'
+func (telemetry *operationTelemetry) startPhase(phase string) {
+	telemetry.endPhase()
+	_, telemetry.phaseSpan = operationTracer.Start(telemetry.ctx, phase)
+}
+// endPhase ends the current phase's span, if any
+func (telemetry *operationTelemetry) endPhase() {
+	if telemetry.phaseSpan != nil {
+		telemetry.phaseSpan.End()
+		telemetry.phaseSpan = nil
+	}
+}
+// end ends the operation's span and records the request's metrics using the given response status code
+func (telemetry *operationTelemetry) end(statusCode int) {
+	telemetry.endPhase()
+	statusAttribute := attribute.Int("http.response.status_code", statusCode)
+	telemetry.span.SetAttributes(statusAttribute)
+	if statusCode >= http.StatusInternalServerError {
+		telemetry.span.SetStatus(codes.Error, http.StatusText(statusCode))
+	}
+	telemetry.span.End()
+	measurementAttributes := metric.WithAttributes(append(telemetry.attributes, statusAttribute)...)
+	requestMetrics.duration.Record(telemetry.ctx, time.Since(telemetry.startTime).Seconds(), measurementAttributes)
+	requestMetrics.count.Add(telemetry.ctx, 1, measurementAttributes)
+}
+// PanicError is the error passed to the error middlewares when an operation panics
+type PanicError struct {
+	// The value the operation panicked with
+	Value any
+	// The stack trace of the panicking goroutine, captured upon recovery
+	Stack []byte
+}
+func (err *PanicError) Error() string {
+	return fmt.Sprintf("operation panicked - %v", err.Value)
+}
+// PanicReporter is invoked with every panic recovered from an operation, e.g. to report it to an error tracking service
+type PanicReporter func(ctx context.Context, operationId string, panicErr *PanicError)
+var panicReporter PanicReporter
+// RegisterPanicReporter sets the hook invoked with every panic recovered from an operation.
+//
+// The reporter runs before the error middlewares
+func RegisterPanicReporter(reporter PanicReporter) {
+	panicReporter = reporter
+}
+// handleOperationPanic reports the given recovered value and runs the error middlewares.
+// Unless a middleware stops the chain, responds with a 500 RFC7807 error
+func handleOperationPanic(echoCtx echo.Context, recovered any, operationId string) {
+	panicErr := &PanicError{Value: recovered, Stack: debug.Stack()}
+	if panicReporter != nil {
+		panicReporter(getRequestContext(echoCtx), operationId, panicErr)
+	}
+	for _, middleware := range onErrorMiddlewares {
+		middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, panicErr)
+		setRequestContext(echoCtx, middlewareCtx)
+		if !continueOperation {
+			return
+		}
+	}
+	stdError := runtime.Rfc7807Error{
+		Type:       http.StatusText(http.StatusInternalServerError),
+		Detail:     fmt.Sprintf("Encountered an unexpected error during operation '%s'", operationId),
+		Status:     http.StatusInternalServerError,
+		Instance:   "/controller/error/" + operationId,
+		Extensions: map[string]string{"error": panicErr.Error()},
+	}
+	// The response is best-effort; the operation has already failed
+	_ = echoCtx.JSON(http.StatusInternalServerError, stdError)
+}
+// SecurityCredentials holds the credentials extracted from a request for a single security scheme,
+// according to the scheme's configured type and location
+type SecurityCredentials struct {
+	// Whether credentials were found at the scheme's expected location
+	Present bool
+	// The API key read from the configured header, query parameter or cookie of an 'apiKey' scheme
+	ApiKey string
+	// The credentials of the 'Authorization' header, without the scheme prefix, for 'http' schemes other than 'basic'.
+	// For 'oauth2' and 'openIdConnect' schemes, this is the bearer token
+	Token string
+	// The user name of a 'basic' HTTP scheme
+	Username string
+	// The password of a 'basic' HTTP scheme
+	Password string
+}
+// SecurityHandler authorizes a request for a single security scheme, using the credentials extracted from the request
+type SecurityHandler func(
+	ctx context.Context,
+	echoCtx echo.Context,
+	check runtime.SecurityCheck,
+	credentials SecurityCredentials,
+) (context.Context, *runtime.SecurityError)
+var securityHandlers = map[string]SecurityHandler{}
+// RegisterSecurityHandler registers the handler authorizing requests for the given security scheme.
+//
+// A handler must be registered for every configured security scheme before RegisterRoutes is called
+func RegisterSecurityHandler(schemaName string, handler SecurityHandler) {
+	securityHandlers[schemaName] = handler
+}
+// securitySchemeDescriptor describes where a security scheme's credentials are located
+type securitySchemeDescriptor struct {
+	schemeType string
+	in         string
+	fieldName  string
+	httpScheme string
+}
+var securitySchemes = map[string]securitySchemeDescriptor{
+	"securitySchemaName":  {schemeType: "apiKey", in: "header", fieldName: "x-header-name", httpScheme: ""},
+	"securitySchemaName2": {schemeType: "apiKey", in: "header", fieldName: "x-header-name", httpScheme: ""},
+	"securitySchemaName3": {schemeType: "http", in: "", fieldName: "", httpScheme: "bearer"},
+}
+// ensureSecurityHandlersRegistered panics if any of the configured security schemes has no registered handler
+func ensureSecurityHandlersRegistered() {
+	missing := []string{}
+	for schemaName := range securitySchemes {
+		if _, registered := securityHandlers[schemaName]; !registered {
+			missing = append(missing, schemaName)
+		}
+	}
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		panic(fmt.Sprintf(
+			"security schemes were never given a handler using RegisterSecurityHandler: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
+// runSecurityHandler invokes the given check's security handler with the credentials extracted for its scheme
+func runSecurityHandler(ctx context.Context, echoCtx echo.Context, check runtime.SecurityCheck) (context.Context, *runtime.SecurityError) {
+	handler, registered := securityHandlers[check.SchemaName]
+	if !registered {
+		return ctx, &runtime.SecurityError{
+			Message:    fmt.Sprintf("No security handler is registered for security scheme '%s'", check.SchemaName),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+	return handler(ctx, echoCtx, check, extractSecurityCredentials(echoCtx, securitySchemes[check.SchemaName]))
+}
+// extractSecurityCredentials reads the credentials of the given security scheme from the request
+func extractSecurityCredentials(echoCtx echo.Context, scheme securitySchemeDescriptor) SecurityCredentials {
+	switch scheme.schemeType {
+	case "apiKey":
+		var apiKey string
+		switch scheme.in {
+		case "header":
+			apiKey = echoCtx.Request().Header.Get(scheme.fieldName)
+		case "query":
+			apiKey = echoCtx.QueryParam(scheme.fieldName)
+		case "cookie":
+			if cookie, err := echoCtx.Cookie(scheme.fieldName); err == nil {
+				apiKey = cookie.Value
+			}
+		}
+		return SecurityCredentials{Present: apiKey != "", ApiKey: apiKey}
+	case "http":
+		return parseAuthorizationHeader(echoCtx.Request().Header.Get("Authorization"), scheme.httpScheme)
+	case "oauth2", "openIdConnect":
+		return parseAuthorizationHeader(echoCtx.Request().Header.Get("Authorization"), "bearer")
+	default:
+		return SecurityCredentials{}
+	}
+}
+// parseAuthorizationHeader extracts the credentials of the given HTTP authentication scheme from an 'Authorization' header
+func parseAuthorizationHeader(header string, httpScheme string) SecurityCredentials {
+	prefix, credentials, found := strings.Cut(strings.TrimSpace(header), " ")
+	credentials = strings.TrimSpace(credentials)
+	if !found || credentials == "" || !strings.EqualFold(prefix, httpScheme) {
+		return SecurityCredentials{}
+	}
+	if !strings.EqualFold(httpScheme, "basic") {
+		return SecurityCredentials{Present: true, Token: credentials}
+	}
+	decoded, err := base64.StdEncoding.DecodeString(credentials)
+	if err != nil {
+		return SecurityCredentials{}
+	}
+	username, password, found := strings.Cut(string(decoded), ":")
+	if !found {
+		return SecurityCredentials{}
+	}
+	return SecurityCredentials{Present: true, Username: username, Password: password}
+}
+// DefaultApiVersion is the API version served to requests that do not specify one
+const DefaultApiVersion = 2
+// registerVersionedRoute registers the given handler under the '/v{version}' prefixed path of each of the given API versions
+func registerVersionedRoute(engine EchoRouter, basePath string, method string, path string, versions []int, handler echo.HandlerFunc) {
+	for _, version := range versions {
+		engine.Add(method, toEchoUrl(withBasePath(basePath, fmt.Sprintf("/v%d%s", version, path))), handler)
+	}
+}
+// RateLimit describes the number of requests an operation accepts per time window, as declared via @RateLimit
+type RateLimit struct {
+	// The number of requests allowed per window
+	Requests int
+	// The window's duration
+	Per time.Duration
+	// The key by which requests are counted - 'ip', 'principal' or 'header:<Name>'
+	Key string
+	// The scope within which requests are counted - the declaring controller's name for limits inherited from a controller,
+	// which are shared by all of its operations inheriting them, or the operation's ID for limits declared by the operation
+	Scope string
+}
+// RateLimiter decides whether requests may proceed under their operation's rate limit.
+//
+// Implementations must be safe for concurrent use
+type RateLimiter interface {
+	// Allow consumes a single request of the given key against the given operation's limit, counted within the limit's scope.
+	// Returns whether the request may proceed and, if not, how long until it may be retried
+	Allow(ctx context.Context, operationId string, key string, limit RateLimit) (bool, time.Duration)
+}
+// InMemoryRateLimiter is a RateLimiter holding a token bucket per limit scope and key in the process's memory.
+//
+// Each bucket holds up to the limit's number of requests and is refilled evenly over the limit's window.
+// Limits are not shared across instances - deployments with multiple instances should use a shared RateLimiter instead
+type InMemoryRateLimiter struct {
+	mutex     sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+type tokenBucket struct {
+	tokens          float64
+	capacity        float64
+	refillPerSecond float64
+	lastRefill      time.Time
+}
+// The interval at which an InMemoryRateLimiter drops buckets that have been refilled to capacity
+const rateLimitSweepInterval = time.Minute
+// NewInMemoryRateLimiter creates an InMemoryRateLimiter with no buckets
+func NewInMemoryRateLimiter() *InMemoryRateLimiter {
+	return &InMemoryRateLimiter{buckets: map[string]*tokenBucket{}, lastSweep: time.Now()}
+}
+func (limiter *InMemoryRateLimiter) Allow(_ context.Context, _ string, key string, limit RateLimit) (bool, time.Duration) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	now := time.Now()
+	limiter.sweep(now)
+	bucketKey := limit.Scope + "\x00" + key
+	bucket, exists := limiter.buckets[bucketKey]
+	if !exists {
+		capacity := float64(limit.Requests)
+		bucket = &tokenBucket{
+			tokens:          capacity,
+			capacity:        capacity,
+			refillPerSecond: capacity / limit.Per.Seconds(),
+			lastRefill:      now,
+		}
+		limiter.buckets[bucketKey] = bucket
+	}
+	bucket.tokens = bucket.tokensAt(now)
+	bucket.lastRefill = now
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - bucket.tokens) / bucket.refillPerSecond * float64(time.Second))
+}
+// sweep drops the buckets that have been refilled to capacity, as those are indistinguishable from new ones
+func (limiter *InMemoryRateLimiter) sweep(now time.Time) {
+	if now.Sub(limiter.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	for bucketKey, bucket := range limiter.buckets {
+		if bucket.tokensAt(now) >= bucket.capacity {
+			delete(limiter.buckets, bucketKey)
+		}
+	}
+	limiter.lastSweep = now
+}
+// tokensAt returns the number of tokens the bucket holds at the given time
+func (bucket *tokenBucket) tokensAt(now time.Time) float64 {
+	return math.Min(bucket.capacity, bucket.tokens+now.Sub(bucket.lastRefill).Seconds()*bucket.refillPerSecond)
+}
+var rateLimiter RateLimiter = NewInMemoryRateLimiter()
+// SetRateLimiter replaces the default InMemoryRateLimiter with the given limiter,
+// e.g. one backed by a store shared across instances
+func SetRateLimiter(limiter RateLimiter) {
+	if limiter == nil {
+		limiter = NewInMemoryRateLimiter()
+	}
+	rateLimiter = limiter
+}
+// newRateLimit creates an operation's RateLimit, counted within the given scope, out of its @RateLimit annotation's properties
+func newRateLimit(requests int, per string, key string, scope string) RateLimit {
+	window, err := time.ParseDuration(per)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid rate limit window '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			per,
+		))
+	}
+	return RateLimit{Requests: requests, Per: window, Key: key, Scope: scope}
+}
+// getRateLimitKey returns the value by which the request is counted against a rate limit with the given key.
+//
+// Requests lacking the key's header or an identifiable principal are counted by their client IP
+func getRateLimitKey(echoCtx echo.Context, key string) string {
+	value := ""
+	if headerName, isHeaderKey := strings.CutPrefix(key, "header:"); isHeaderKey {
+		value = echoCtx.Request().Header.Get(headerName)
+	} else if key == "principal" {
+		value, _ = describePrincipal(getRequestContext(echoCtx))
+	}
+	if value == "" {
+		return echoCtx.RealIP()
+	}
+	return key + "=" + value
+}
+// enforceRateLimit counts the request against the given operation's rate limit.
+//
+// Requests exceeding the limit are answered with a 429 RFC7807 error and a 'Retry-After' header, in which case false is returned
+// along with the error of writing the response
+func enforceRateLimit(echoCtx echo.Context, operationId string, limit RateLimit) (bool, error) {
+	allowed, retryAfter := rateLimiter.Allow(getRequestContext(echoCtx), operationId, getRateLimitKey(echoCtx, limit.Key), limit)
+	if allowed {
+		return true, nil
+	}
+	echoCtx.Response().Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	return false, echoCtx.JSON(http.StatusTooManyRequests, runtime.Rfc7807Error{
+		Type:     http.StatusText(http.StatusTooManyRequests),
+		Detail:   fmt.Sprintf("Too many requests were made to operation '%s'. Please retry later", operationId),
+		Status:   http.StatusTooManyRequests,
+		Instance: "/ratelimit/error/" + operationId,
+	})
+}
+// newOperationTimeout creates an operation's deadline duration out of its @Timeout annotation or the configured default timeout
+func newOperationTimeout(timeout string) time.Duration {
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid operation timeout '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			timeout,
+		))
+	}
+	return duration
+}
+// applyOperationTimeout replaces the request's context with one that expires once the given timeout elapses.
+//
+// Operations are not interrupted when the deadline expires - controllers are expected to honor their context's cancellation.
+// The returned function releases the context's resources and must be called once the request has been handled
+func applyOperationTimeout(echoCtx echo.Context, timeout time.Duration) context.CancelFunc {
+	ctx, cancel := context.WithTimeout(getRequestContext(echoCtx), timeout)
+	setRequestContext(echoCtx, ctx)
+	return cancel
+}
+// isDeadlineExceeded returns whether the request's context has expired due to its operation's timeout
+func isDeadlineExceeded(echoCtx echo.Context) bool {
+	return errors.Is(getRequestContext(echoCtx).Err(), context.DeadlineExceeded)
+}
+// handleDeadlineExceeded answers a request whose operation's deadline has expired with an RFC7807 error of the given status -
+// 503 if the operation has not been invoked yet or 504 if it has
+func handleDeadlineExceeded(echoCtx echo.Context, operationId string, status int) error {
+	detail := fmt.Sprintf("The deadline of operation '%s' expired before the operation was invoked", operationId)
+	if status == http.StatusGatewayTimeout {
+		detail = fmt.Sprintf("Operation '%s' did not complete before its deadline expired", operationId)
+	}
+	return echoCtx.JSON(status, runtime.Rfc7807Error{
+		Type:     http.StatusText(status),
+		Detail:   detail,
+		Status:   status,
+		Instance: "/timeout/error/" + operationId,
+	})
+}
+// enforceMaxBodySize checks the request's body against the given operation's maximum body size.
+//
+// Bodies of unknown length are read up-front, up to the maximum size.
+// Requests whose body exceeds the maximum size are answered with a 413 RFC7807 error, in which case false is returned
+func enforceMaxBodySize(echoCtx echo.Context, operationId string, maxBodySize int64) (bool, error) {
+	request := echoCtx.Request()
+	bodySize := request.ContentLength
+	if bodySize < 0 {
+		body, _ := io.ReadAll(io.LimitReader(request.Body, maxBodySize+1))
+		request.Body = io.NopCloser(bytes.NewReader(body))
+		bodySize = int64(len(body))
+	}
+	if bodySize <= maxBodySize {
+		return true, nil
+	}
+	return false, echoCtx.JSON(http.StatusRequestEntityTooLarge, runtime.Rfc7807Error{
+		Type:     http.StatusText(http.StatusRequestEntityTooLarge),
+		Detail:   fmt.Sprintf("The request body of operation '%s' exceeds the maximum size of %d bytes", operationId, maxBodySize),
+		Status:   http.StatusRequestEntityTooLarge,
+		Instance: "/body/error/" + operationId,
+	})
+}
+// IdempotentResponse is a response of an idempotent operation, as stored to be replayed to repeated requests
+type IdempotentResponse struct {
+	// The response's HTTP status code
+	Status int
+	// The response's headers
+	Headers http.Header
+	// The response's body
+	Body []byte
+}
+// IdempotencyStore stores the responses of operations declared via @Idempotent, by request key.
+//
+// Implementations must be safe for concurrent use
+type IdempotencyStore interface {
+	// Reserve claims the given key for a new request with the given body hash, for the given duration.
+	// If the key has already been claimed, returns false along with the stored response or,
+	// if the original request is still in flight, a nil response.
+	// If the key has been claimed by a request with a different body hash, returns ErrIdempotencyKeyReused
+	Reserve(ctx context.Context, key string, requestHash string, ttl time.Duration) (bool, *IdempotentResponse, error)
+	// Complete stores the response of the request that claimed the given key, along with the request's body hash, for the given duration
+	Complete(ctx context.Context, key string, requestHash string, response IdempotentResponse, ttl time.Duration) error
+	// Release drops the claim of a request that did not produce a replayable response, so that it may be retried
+	Release(ctx context.Context, key string) error
+}
+// ErrIdempotencyKeyReused is returned by an IdempotencyStore when a key is reused by a request with a different body
+var ErrIdempotencyKeyReused = errors.New("the idempotency key has already been used by a request with a different body")
+// InMemoryIdempotencyStore is an IdempotencyStore holding responses in the process's memory.
+//
+// Responses are not shared across instances - deployments with multiple instances should use a shared IdempotencyStore instead
+type InMemoryIdempotencyStore struct {
+	mutex     sync.Mutex
+	entries   map[string]*idempotencyEntry
+	lastSweep time.Time
+}
+type idempotencyEntry struct {
+	// The stored response. Nil while the request is in flight
+	response *IdempotentResponse
+	// The hash of the body of the request that claimed the entry's key
+	requestHash string
+	expiresAt   time.Time
+}
+// The interval at which an InMemoryIdempotencyStore drops expired entries
+const idempotencySweepInterval = time.Minute
+// NewInMemoryIdempotencyStore creates an InMemoryIdempotencyStore with no entries
+func NewInMemoryIdempotencyStore() *InMemoryIdempotencyStore {
+	return &InMemoryIdempotencyStore{entries: map[string]*idempotencyEntry{}, lastSweep: time.Now()}
+}
+func (store *InMemoryIdempotencyStore) Reserve(_ context.Context, key string, requestHash string, ttl time.Duration) (bool, *IdempotentResponse, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	now := time.Now()
+	store.sweep(now)
+	if entry, exists := store.entries[key]; exists && now.Before(entry.expiresAt) {
+		if entry.requestHash != requestHash {
+			return false, nil, ErrIdempotencyKeyReused
+		}
+		return false, entry.response, nil
+	}
+	store.entries[key] = &idempotencyEntry{requestHash: requestHash, expiresAt: now.Add(ttl)}
+	return true, nil, nil
+}
+func (store *InMemoryIdempotencyStore) Complete(_ context.Context, key string, requestHash string, response IdempotentResponse, ttl time.Duration) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.entries[key] = &idempotencyEntry{response: &response, requestHash: requestHash, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+func (store *InMemoryIdempotencyStore) Release(_ context.Context, key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.entries, key)
+	return nil
+}
+// sweep drops the entries that have expired
+func (store *InMemoryIdempotencyStore) sweep(now time.Time) {
+	if now.Sub(store.lastSweep) < idempotencySweepInterval {
+		return
+	}
+	for key, entry := range store.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(store.entries, key)
+		}
+	}
+	store.lastSweep = now
+}
+var idempotencyStore IdempotencyStore = NewInMemoryIdempotencyStore()
+// SetIdempotencyStore replaces the default InMemoryIdempotencyStore with the given store,
+// e.g. one backed by a database shared across instances
+func SetIdempotencyStore(store IdempotencyStore) {
+	if store == nil {
+		store = NewInMemoryIdempotencyStore()
+	}
+	idempotencyStore = store
+}
+// newIdempotencyTtl creates an operation's response retention duration out of its @Idempotent annotation's 'ttl' property
+func newIdempotencyTtl(ttl string) time.Duration {
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid idempotency TTL '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			ttl,
+		))
+	}
+	return duration
+}
+// idempotentResponseRecorder records the body written to the response so it may be stored once the operation completes
+type idempotentResponseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+func (recorder *idempotentResponseRecorder) Write(data []byte) (int, error) {
+	recorder.body.Write(data)
+	return recorder.ResponseWriter.Write(data)
+}
+// The maximum size, in bytes, of the request bodies hashed by idempotent operations without a @MaxBodySize limit
+const defaultMaxIdempotentBodySize = 10 << 20
+// hashRequestBody returns the hex encoded SHA-256 hash of the request's body. The body remains readable.
+//
+// Requests whose body exceeds the given maximum size, or defaultMaxIdempotentBodySize if zero,
+// are answered with a 413 RFC7807 error, in which case false is returned
+func hashRequestBody(echoCtx echo.Context, operationId string, maxBodySize int64) (string, bool, error) {
+	if maxBodySize <= 0 {
+		maxBodySize = defaultMaxIdempotentBodySize
+	}
+	body, _ := io.ReadAll(io.LimitReader(echoCtx.Request().Body, maxBodySize+1))
+	echoCtx.Request().Body = io.NopCloser(bytes.NewReader(body))
+	if int64(len(body)) > maxBodySize {
+		return "", false, writeIdempotencyError(echoCtx, operationId, http.StatusRequestEntityTooLarge, fmt.Sprintf("The request body of operation '%s' exceeds the maximum size of %d bytes", operationId, maxBodySize))
+	}
+	hash := sha256.Sum256(body)
+	return hex.EncodeToString(hash[:]), true, nil
+}
+// beginIdempotentRequest claims the request's 'Idempotency-Key' header, scoped to the given operation and the request's principal.
+//
+// If the operation may proceed, returns a function storing its response, which must be deferred.
+// Otherwise, the request is answered - with the stored response if the key has been used before,
+// or with an RFC7807 error if the header is missing (400), the original request is still in flight (409),
+// the principal lacks a stable identity (500)
+// or the key has been used by a request with a different body (422) - and a nil function is returned
+func beginIdempotentRequest(echoCtx echo.Context, operationId string, requestHash string, ttl time.Duration) (func(), error) {
+	idempotencyKey := echoCtx.Request().Header.Get("Idempotency-Key")
+	if idempotencyKey == "" {
+		return nil, writeIdempotencyError(
+			echoCtx,
+			operationId,
+			http.StatusBadRequest,
+			fmt.Sprintf("Operation '%s' requires an 'Idempotency-Key' header", operationId),
+		)
+	}
+	ctx := getRequestContext(echoCtx)
+	// Keys are scoped by principal so that they may not collide across clients. Principals lacking a stable identity are
+	// rejected, as their requests could otherwise never be recognized as repeated
+	principalIdentity, isIdentifiable := describePrincipal(ctx)
+	if !isIdentifiable {
+		return nil, writeIdempotencyError(
+			echoCtx,
+			operationId,
+			http.StatusInternalServerError,
+			fmt.Sprintf("The principal of operation '%s' lacks a stable identity to scope its 'Idempotency-Key' by", operationId),
+		)
+	}
+	key := operationId + "\x00" + principalIdentity + "\x00" + idempotencyKey
+	reserved, response, err := idempotencyStore.Reserve(ctx, key, requestHash, ttl)
+	switch {
+	case errors.Is(err, ErrIdempotencyKeyReused):
+		return nil, writeIdempotencyError(
+			echoCtx,
+			operationId,
+			http.StatusUnprocessableEntity,
+			fmt.Sprintf("The 'Idempotency-Key' has already been used by a request to operation '%s' with a different body", operationId),
+		)
+	case err != nil:
+		return nil, writeIdempotencyError(
+			echoCtx,
+			operationId,
+			http.StatusInternalServerError,
+			fmt.Sprintf("Failed to process the 'Idempotency-Key' header of operation '%s'", operationId),
+		)
+	case !reserved && response == nil:
+		return nil, writeIdempotencyError(
+			echoCtx,
+			operationId,
+			http.StatusConflict,
+			fmt.Sprintf("A request to operation '%s' with the same 'Idempotency-Key' is still being processed", operationId),
+		)
+	case !reserved:
+		for name, values := range response.Headers {
+			echoCtx.Response().Header()[name] = values
+		}
+		echoCtx.Response().Header().Set("Idempotent-Replayed", "true")
+		echoCtx.Response().WriteHeader(response.Status)
+		_, err := echoCtx.Response().Write(response.Body)
+		return nil, err
+	}
+	recorder := &idempotentResponseRecorder{ResponseWriter: echoCtx.Response().Writer}
+	echoCtx.Response().Writer = recorder
+	return func() {
+		// Requests that panicked or failed on the server's side are not replayed so they may be retried
+		recovered := recover()
+		written := echoCtx.Response()
+		if recovered != nil || !written.Committed || written.Status >= http.StatusInternalServerError {
+			idempotencyStore.Release(context.WithoutCancel(ctx), key)
+			if recovered != nil {
+				panic(recovered)
+			}
+			return
+		}
+		idempotencyStore.Complete(
+			context.WithoutCancel(ctx),
+			key,
+			requestHash,
+			IdempotentResponse{Status: written.Status, Headers: written.Header().Clone(), Body: recorder.body.Bytes()},
+			ttl,
+		)
+	}, nil
+}
+// writeIdempotencyError answers a request to an idempotent operation with an RFC7807 error of the given status
+func writeIdempotencyError(echoCtx echo.Context, operationId string, status int, detail string) error {
+	return echoCtx.JSON(status, runtime.Rfc7807Error{
+		Type:     http.StatusText(status),
+		Detail:   detail,
+		Status:   status,
+		Instance: "/idempotency/error/" + operationId,
+	})
+}
 type MiddlewareFunc func(ctx context.Context, echoCtx echo.Context) (context.Context, bool)
 type ErrorMiddlewareFunc func(ctx context.Context, echoCtx echo.Context, err error) (context.Context, bool)
 var beforeOperationMiddlewares []MiddlewareFunc
@@ -301,13 +1519,46 @@ func RegisterErrorMiddleware(executionType runtime.ErrorMiddlewareExecutionType,
 		onErrorMiddlewares = append(onErrorMiddlewares, errorMiddlewareFunc)
 	}
 }
+var namedMiddlewares = map[string]MiddlewareFunc{}
+// RegisterNamedMiddleware registers a middleware that controllers and routes may reference by name using @Middleware annotations.
+//
+// Named middlewares must be registered before RegisterRoutes is called
+func RegisterNamedMiddleware(name string, middlewareFunc MiddlewareFunc) {
+	namedMiddlewares[name] = middlewareFunc
+}
+func ensureNamedMiddlewaresRegistered(names []string) {
+	missing := []string{}
+	for _, name := range names {
+		if _, registered := namedMiddlewares[name]; !registered {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		panic(fmt.Sprintf(
+			"middlewares referenced via @Middleware annotations were never registered using RegisterNamedMiddleware: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
 func RegisterCustomValidator(validateTagName string, validateFunc runtime.ValidationFunc) {
 	validatorInstance.RegisterValidation(validateTagName, func(fl validator.FieldLevel) bool {
 		return validateFunc(fl)
 	})
 }
+// BasePath is the path under which RegisterRoutes mounts the API's routes
+const BasePath = ""
+// RegisterRoutes registers the API's routes on the given engine, under BasePath
 func RegisterRoutes(engine *echo.Echo) {
+	RegisterGroupRoutes(engine, BasePath)
+}
+// RegisterGroupRoutes registers the API's routes on the given Echo instance or group, under the given base path.
+//
+// The base path is relative to the router's own prefix.
+// For the OpenAPI specification to match the served routes, the two should add up to BasePath
+func RegisterGroupRoutes(engine EchoRouter, basePath string) {
 	urlParamRegex = regexp.MustCompile(`\{([\w\d-_]+)\}`)
+	ensureSecurityHandlersRegistered()
+	ensureNamedMiddlewaresRegistered([]string{"audit", "trace"})
 	registerEnumValidation(validatorInstance, "bool_enum_enum", []string{"false", "true"})
 	registerEnumValidation(validatorInstance, "length_units_enum", []string{"Angstrom", "AstronomicalUnit", "Centimeter", "Chain", "DataMile", "Decameter", "Decimeter", "DtpPica", "DtpPoint", "Fathom", "Femtometer", "Foot", "Gigameter", "Hand", "Hectometer", "Inch", "Kilofoot", "KilolightYear", "Kilometer", "Kiloparsec", "Kiloyard", "LightYear", "MegalightYear", "Megameter", "Megaparsec", "Meter", "Microinch", "Micrometer", "Mil", "Mile", "Millimeter", "Nanometer", "NauticalMile", "Parsec", "Picometer", "PrinterPica", "PrinterPoint", "Shackle", "SolarRadius", "Twip", "UsSurveyFoot", "Yard"})
 	registerEnumValidation(validatorInstance, "myemamium_enum", []string{"one", "two"})
@@ -317,8 +1568,21 @@ func RegisterRoutes(engine *echo.Echo) {
 	registerEnumValidation(validatorInstance, "status_enumeration_enum", []string{"active", "inactive"})
 	// RegisterRoutesExtension - test
 	// E2EClassSecController
-	engine.GET(toEchoUrl("/e2e/with-default-class-security"), func(echoCtx echo.Context) error {
+	e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes := []int{}
+	e2EclassSecControllerWithDefaultClassSecurityRoute := withBasePath(basePath, "/e2e/with-default-class-security")
+	e2EclassSecControllerWithDefaultClassSecurityHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"WithDefaultClassSecurity",
+			"E2EClassSecController",
+			"GET",
+			e2EclassSecControllerWithDefaultClassSecurityRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "WithDefaultClassSecurity")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -338,8 +1602,9 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "WithDefaultClassSecurity")
 		}
-		controller := E2EClassSecController.E2EClassSecController{}
+		controller := createController[E2EClassSecController.E2EClassSecController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("x-test-scopes")
 		_, isheaderParamExists := echoCtx.Request().Header["x-test-scopes"]
@@ -363,10 +1628,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "WithDefaultClassSecurity")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -377,13 +1645,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "WithDefaultClassSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "WithDefaultClassSecurity")
+			}
+		}()
 		value, opError := controller.WithDefaultClassSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "WithDefaultClassSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -401,6 +1676,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/WithDefaultClassSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "WithDefaultClassSecurity")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -418,9 +1694,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "WithDefaultClassSecurity")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/with-default-override-class-security"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/with-default-class-security")), e2EclassSecControllerWithDefaultClassSecurityHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/with-default-class-security")), e2EclassSecControllerWithDefaultClassSecurityHandler)
+	e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes := []int{}
+	e2EclassSecControllerWithOverrideClassSecurityRoute := withBasePath(basePath, "/e2e/with-default-override-class-security")
+	e2EclassSecControllerWithOverrideClassSecurityHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"WithOverrideClassSecurity",
+			"E2EClassSecController",
+			"GET",
+			e2EclassSecControllerWithOverrideClassSecurityRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "WithOverrideClassSecurity")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -440,8 +1731,9 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "WithOverrideClassSecurity")
 		}
-		controller := E2EClassSecController.E2EClassSecController{}
+		controller := createController[E2EClassSecController.E2EClassSecController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("x-test-scopes")
 		_, isheaderParamExists := echoCtx.Request().Header["x-test-scopes"]
@@ -465,10 +1757,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "WithOverrideClassSecurity")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -479,13 +1774,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "WithOverrideClassSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "WithOverrideClassSecurity")
+			}
+		}()
 		value, opError := controller.WithOverrideClassSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "WithOverrideClassSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -503,6 +1805,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/WithOverrideClassSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "WithOverrideClassSecurity")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -520,10 +1823,25 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "WithOverrideClassSecurity")
 		return nil
-	})
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/with-default-override-class-security")), e2EclassSecControllerWithOverrideClassSecurityHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/with-default-override-class-security")), e2EclassSecControllerWithOverrideClassSecurityHandler)
 	// E2EController
-	engine.GET(toEchoUrl("/e2e/simple-get"), func(echoCtx echo.Context) error {
+	e2EcontrollerSimpleGetErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetRoute := withBasePath(basePath, "/e2e/simple-get")
+	e2EcontrollerSimpleGetHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"SimpleGet",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "SimpleGet")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -543,8 +1861,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "SimpleGet")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -555,13 +1875,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "SimpleGet")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "SimpleGet")
+			}
+		}()
 		value, opError := controller.SimpleGet()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "SimpleGet")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -579,6 +1906,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/SimpleGet",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "SimpleGet")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -596,9 +1924,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "SimpleGet")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/simple-get-empty-string"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/simple-get")), e2EcontrollerSimpleGetHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/simple-get")), e2EcontrollerSimpleGetHandler)
+	e2EcontrollerSimpleGetEmptyStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyStringRoute := withBasePath(basePath, "/e2e/simple-get-empty-string")
+	e2EcontrollerSimpleGetEmptyStringHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"SimpleGetEmptyString",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetEmptyStringRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "SimpleGetEmptyString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -618,8 +1961,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "SimpleGetEmptyString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -630,13 +1975,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetEmptyString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "SimpleGetEmptyString")
+			}
+		}()
 		value, opError := controller.SimpleGetEmptyString()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "SimpleGetEmptyString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -654,6 +2006,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/SimpleGetEmptyString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "SimpleGetEmptyString")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -671,9 +2024,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "SimpleGetEmptyString")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/simple-get-ptr-string"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-empty-string")), e2EcontrollerSimpleGetEmptyStringHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-empty-string")), e2EcontrollerSimpleGetEmptyStringHandler)
+	e2EcontrollerSimpleGetPtrStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetPtrStringRoute := withBasePath(basePath, "/e2e/simple-get-ptr-string")
+	e2EcontrollerSimpleGetPtrStringHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"SimpleGetPtrString",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetPtrStringRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "SimpleGetPtrString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -693,8 +2061,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "SimpleGetPtrString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -705,13 +2075,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetPtrString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "SimpleGetPtrString")
+			}
+		}()
 		value, opError := controller.SimpleGetPtrString()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "SimpleGetPtrString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -729,6 +2106,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/SimpleGetPtrString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "SimpleGetPtrString")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -746,9 +2124,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "SimpleGetPtrString")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/simple-get-null-string"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-ptr-string")), e2EcontrollerSimpleGetPtrStringHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-ptr-string")), e2EcontrollerSimpleGetPtrStringHandler)
+	e2EcontrollerSimpleGetNullStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetNullStringRoute := withBasePath(basePath, "/e2e/simple-get-null-string")
+	e2EcontrollerSimpleGetNullStringHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"SimpleGetNullString",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetNullStringRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "SimpleGetNullString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -768,8 +2161,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "SimpleGetNullString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -780,13 +2175,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetNullString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "SimpleGetNullString")
+			}
+		}()
 		value, opError := controller.SimpleGetNullString()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "SimpleGetNullString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -804,6 +2206,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/SimpleGetNullString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "SimpleGetNullString")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -821,9 +2224,134 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "SimpleGetNullString")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/simple-get-object"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-null-string")), e2EcontrollerSimpleGetNullStringHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-null-string")), e2EcontrollerSimpleGetNullStringHandler)
+	e2EcontrollerNamedMiddlewaresErrorStatusCodes := []int{}
+	e2EcontrollerNamedMiddlewaresRoute := withBasePath(basePath, "/e2e/named-middlewares")
+	e2EcontrollerNamedMiddlewaresHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"NamedMiddlewares",
+			"E2EController",
+			"GET",
+			e2EcontrollerNamedMiddlewaresRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "NamedMiddlewares")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "NamedMiddlewares")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		routeMiddlewares := []MiddlewareFunc{namedMiddlewares["audit"], namedMiddlewares["trace"]}
+		// Middlewares routeMiddlewares section
+		for _, middleware := range routeMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares routeMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "NamedMiddlewares")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "NamedMiddlewares")
+			}
+		}()
+		value, opError := controller.NamedMiddlewares()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "NamedMiddlewares")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'NamedMiddlewares'",
+				Status:     statusCode,
+				Instance:   "/controller/error/NamedMiddlewares",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "NamedMiddlewares")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "NamedMiddlewares")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "NamedMiddlewares")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "NamedMiddlewares")
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/named-middlewares")), e2EcontrollerNamedMiddlewaresHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/named-middlewares")), e2EcontrollerNamedMiddlewaresHandler)
+	e2EcontrollerSimpleGetObjectErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectRoute := withBasePath(basePath, "/e2e/simple-get-object")
+	e2EcontrollerSimpleGetObjectHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"SimpleGetObject",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetObjectRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "SimpleGetObject")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -843,8 +2371,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "SimpleGetObject")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -855,13 +2385,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetObject")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "SimpleGetObject")
+			}
+		}()
 		value, opError := controller.SimpleGetObject()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "SimpleGetObject")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -879,6 +2416,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/SimpleGetObject",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "SimpleGetObject")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -918,9 +2456,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "SimpleGetObject")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/simple-get-object-ptr"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-object")), e2EcontrollerSimpleGetObjectHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-object")), e2EcontrollerSimpleGetObjectHandler)
+	e2EcontrollerSimpleGetObjectPtrErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectPtrRoute := withBasePath(basePath, "/e2e/simple-get-object-ptr")
+	e2EcontrollerSimpleGetObjectPtrHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"SimpleGetObjectPtr",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetObjectPtrRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "SimpleGetObjectPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -940,8 +2493,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "SimpleGetObjectPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -952,13 +2507,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetObjectPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "SimpleGetObjectPtr")
+			}
+		}()
 		value, opError := controller.SimpleGetObjectPtr()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "SimpleGetObjectPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -976,6 +2538,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/SimpleGetObjectPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "SimpleGetObjectPtr")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1019,9 +2582,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "SimpleGetObjectPtr")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/simple-get-object-null"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-object-ptr")), e2EcontrollerSimpleGetObjectPtrHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-object-ptr")), e2EcontrollerSimpleGetObjectPtrHandler)
+	e2EcontrollerSimpleGetObjectNullErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectNullRoute := withBasePath(basePath, "/e2e/simple-get-object-null")
+	e2EcontrollerSimpleGetObjectNullHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"SimpleGetObjectNull",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetObjectNullRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "SimpleGetObjectNull")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -1041,8 +2619,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "SimpleGetObjectNull")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -1053,13 +2633,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetObjectNull")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "SimpleGetObjectNull")
+			}
+		}()
 		value, opError := controller.SimpleGetObjectNull()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "SimpleGetObjectNull")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1077,6 +2664,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/SimpleGetObjectNull",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "SimpleGetObjectNull")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1120,9 +2708,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "SimpleGetObjectNull")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/primitive-return-type"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-object-null")), e2EcontrollerSimpleGetObjectNullHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-object-null")), e2EcontrollerSimpleGetObjectNullHandler)
+	e2EcontrollerPrimitiveReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-return-type")
+	e2EcontrollerPrimitiveReturnTypeHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"PrimitiveReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveReturnTypeRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "PrimitiveReturnType")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -1142,8 +2745,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "PrimitiveReturnType")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -1154,13 +2759,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "PrimitiveReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "PrimitiveReturnType")
+			}
+		}()
 		value, opError := controller.PrimitiveReturnType()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "PrimitiveReturnType")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1178,6 +2790,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/PrimitiveReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "PrimitiveReturnType")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1195,9 +2808,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "PrimitiveReturnType")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/primitive-array-return-type"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/primitive-return-type")), e2EcontrollerPrimitiveReturnTypeHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/primitive-return-type")), e2EcontrollerPrimitiveReturnTypeHandler)
+	e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveArrayReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-array-return-type")
+	e2EcontrollerPrimitiveArrayReturnTypeHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"PrimitiveArrayReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveArrayReturnTypeRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "PrimitiveArrayReturnType")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -1217,8 +2845,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "PrimitiveArrayReturnType")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -1229,13 +2859,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "PrimitiveArrayReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "PrimitiveArrayReturnType")
+			}
+		}()
 		value, opError := controller.PrimitiveArrayReturnType()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "PrimitiveArrayReturnType")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1253,6 +2890,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/PrimitiveArrayReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "PrimitiveArrayReturnType")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1270,9 +2908,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "PrimitiveArrayReturnType")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/primitive-alias-return-type"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/primitive-array-return-type")), e2EcontrollerPrimitiveArrayReturnTypeHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/primitive-array-return-type")), e2EcontrollerPrimitiveArrayReturnTypeHandler)
+	e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-alias-return-type")
+	e2EcontrollerPrimitiveAliasReturnTypeHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"PrimitiveAliasReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveAliasReturnTypeRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "PrimitiveAliasReturnType")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -1292,8 +2945,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "PrimitiveAliasReturnType")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -1304,13 +2959,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "PrimitiveAliasReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "PrimitiveAliasReturnType")
+			}
+		}()
 		value, opError := controller.PrimitiveAliasReturnType()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "PrimitiveAliasReturnType")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1328,6 +2990,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/PrimitiveAliasReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "PrimitiveAliasReturnType")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1367,9 +3030,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "PrimitiveAliasReturnType")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/primitive-alias-array-return-type"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/primitive-alias-return-type")), e2EcontrollerPrimitiveAliasReturnTypeHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/primitive-alias-return-type")), e2EcontrollerPrimitiveAliasReturnTypeHandler)
+	e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasArrayReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-alias-array-return-type")
+	e2EcontrollerPrimitiveAliasArrayReturnTypeHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"PrimitiveAliasArrayReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveAliasArrayReturnTypeRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "PrimitiveAliasArrayReturnType")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -1389,8 +3067,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "PrimitiveAliasArrayReturnType")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -1401,13 +3081,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "PrimitiveAliasArrayReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "PrimitiveAliasArrayReturnType")
+			}
+		}()
 		value, opError := controller.PrimitiveAliasArrayReturnType()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "PrimitiveAliasArrayReturnType")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1425,6 +3112,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/PrimitiveAliasArrayReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "PrimitiveAliasArrayReturnType")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1464,9 +3152,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "PrimitiveAliasArrayReturnType")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/simple-get-empty"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/primitive-alias-array-return-type")), e2EcontrollerPrimitiveAliasArrayReturnTypeHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/primitive-alias-array-return-type")), e2EcontrollerPrimitiveAliasArrayReturnTypeHandler)
+	e2EcontrollerSimpleGetEmptyErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyRoute := withBasePath(basePath, "/e2e/simple-get-empty")
+	e2EcontrollerSimpleGetEmptyHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"SimpleGetEmpty",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetEmptyRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "SimpleGetEmpty")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -1486,8 +3189,9 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "SimpleGetEmpty")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var queryParamRawPtr *string = nil
 		queryParamRaw := echoCtx.QueryParam("queryParam")
 		isqueryParamExists := echoCtx.Request().URL.Query().Has("queryParam")
@@ -1506,10 +3210,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "SimpleGetEmpty")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -1520,13 +3227,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetEmpty")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "SimpleGetEmpty")
+			}
+		}()
 		opError := controller.SimpleGetEmpty(*queryParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "SimpleGetEmpty")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1544,6 +3258,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/SimpleGetEmpty",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "SimpleGetEmpty")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1561,9 +3276,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "SimpleGetEmpty")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/get-with-all-params/{pathParam}"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-empty")), e2EcontrollerSimpleGetEmptyHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/simple-get-empty")), e2EcontrollerSimpleGetEmptyHandler)
+	e2EcontrollerGetWithAllParamsErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsRoute := withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")
+	e2EcontrollerGetWithAllParamsHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"GetWithAllParams",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetWithAllParamsRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "GetWithAllParams")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -1583,8 +3313,9 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "GetWithAllParams")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var queryParamRawPtr *string = nil
 		queryParamRaw := echoCtx.QueryParam("queryParam")
 		isqueryParamExists := echoCtx.Request().URL.Query().Has("queryParam")
@@ -1603,9 +3334,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "GetWithAllParams")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var pathParamRawPtr *string = nil
 		pathParamRaw := echoCtx.Param("pathParam")
@@ -1625,9 +3358,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "GetWithAllParams")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("headerParam")
@@ -1652,10 +3387,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "GetWithAllParams")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -1666,13 +3404,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "GetWithAllParams")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "GetWithAllParams")
+			}
+		}()
 		value, opError := controller.GetWithAllParams(*queryParamRawPtr, *pathParamRawPtr, *headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "GetWithAllParams")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1690,6 +3435,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/GetWithAllParams",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "GetWithAllParams")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1707,9 +3453,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "GetWithAllParams")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/get-with-all-params-ptr/{pathParam}"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")), e2EcontrollerGetWithAllParamsHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")), e2EcontrollerGetWithAllParamsHandler)
+	e2EcontrollerGetWithAllParamsPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsPtrRoute := withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")
+	e2EcontrollerGetWithAllParamsPtrHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"GetWithAllParamsPtr",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetWithAllParamsPtrRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "GetWithAllParamsPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -1729,8 +3490,9 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "GetWithAllParamsPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var queryParamRawPtr *string = nil
 		queryParamRaw := echoCtx.QueryParam("queryParam")
 		isqueryParamExists := echoCtx.Request().URL.Query().Has("queryParam")
@@ -1756,9 +3518,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "GetWithAllParamsPtr")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("headerParam")
@@ -1772,6 +3536,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			headerParam := headerParamRaw
 			headerParamRawPtr = &headerParam
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -1782,13 +3547,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "GetWithAllParamsPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "GetWithAllParamsPtr")
+			}
+		}()
 		value, opError := controller.GetWithAllParamsPtr(queryParamRawPtr, pathParamRawPtr, headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "GetWithAllParamsPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1806,6 +3578,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/GetWithAllParamsPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "GetWithAllParamsPtr")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1823,9 +3596,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "GetWithAllParamsPtr")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/get-with-all-params-required-ptr/{pathParam}"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsPtrHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsPtrHandler)
+	e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsRequiredPtrRoute := withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")
+	e2EcontrollerGetWithAllParamsRequiredPtrHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"GetWithAllParamsRequiredPtr",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetWithAllParamsRequiredPtrRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "GetWithAllParamsRequiredPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -1845,8 +3633,9 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "GetWithAllParamsRequiredPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var queryParamRawPtr *string = nil
 		queryParamRaw := echoCtx.QueryParam("queryParam")
 		isqueryParamExists := echoCtx.Request().URL.Query().Has("queryParam")
@@ -1865,9 +3654,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "GetWithAllParamsRequiredPtr")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var pathParamRawPtr *string = nil
 		pathParamRaw := echoCtx.Param("pathParam")
@@ -1887,9 +3678,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "GetWithAllParamsRequiredPtr")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("headerParam")
@@ -1914,10 +3707,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "GetWithAllParamsRequiredPtr")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -1928,13 +3724,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "GetWithAllParamsRequiredPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "GetWithAllParamsRequiredPtr")
+			}
+		}()
 		value, opError := controller.GetWithAllParamsRequiredPtr(queryParamRawPtr, pathParamRawPtr, headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "GetWithAllParamsRequiredPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1952,6 +3755,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/GetWithAllParamsRequiredPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "GetWithAllParamsRequiredPtr")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -1969,9 +3773,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "GetWithAllParamsRequiredPtr")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/post-with-all-params-body"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsRequiredPtrHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsRequiredPtrHandler)
+	e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyRoute := withBasePath(basePath, "/e2e/post-with-all-params-body")
+	e2EcontrollerPostWithAllParamsWithBodyHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"PostWithAllParamsWithBody",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostWithAllParamsWithBodyRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "PostWithAllParamsWithBody")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -1991,8 +3810,9 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "PostWithAllParamsWithBody")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
 		var queryParamRawPtr *string = nil
 		queryParamRaw := echoCtx.QueryParam("queryParam")
@@ -2012,9 +3832,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "PostWithAllParamsWithBody")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("headerParam")
@@ -2039,9 +3861,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "PostWithAllParamsWithBody")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var theBodyRawPtr *Param5theBody.BodyInfo = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &theBodyRawPtr)
@@ -2055,20 +3879,27 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBody' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBody",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBody",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBody")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -2079,13 +3910,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBody")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "PostWithAllParamsWithBody")
+			}
+		}()
 		value, opError := controller.PostWithAllParamsWithBody(*queryParamRawPtr, *headerParamRawPtr, *theBodyRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "PostWithAllParamsWithBody")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2103,6 +3941,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/PostWithAllParamsWithBody",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "PostWithAllParamsWithBody")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -2142,9 +3981,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "PostWithAllParamsWithBody")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/post-with-all-params-body-ptr"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/post-with-all-params-body")), e2EcontrollerPostWithAllParamsWithBodyHandler)
+	e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyPtrRoute := withBasePath(basePath, "/e2e/post-with-all-params-body-ptr")
+	e2EcontrollerPostWithAllParamsWithBodyPtrHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"PostWithAllParamsWithBodyPtr",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostWithAllParamsWithBodyPtrRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "PostWithAllParamsWithBodyPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -2164,8 +4017,9 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "PostWithAllParamsWithBodyPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
 		var queryParamRawPtr *string = nil
 		queryParamRaw := echoCtx.QueryParam("queryParam")
@@ -2198,20 +4052,27 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBodyPtr' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBodyPtr",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBodyPtr")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -2222,13 +4083,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBodyPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "PostWithAllParamsWithBodyPtr")
+			}
+		}()
 		value, opError := controller.PostWithAllParamsWithBodyPtr(queryParamRawPtr, headerParamRawPtr, theBodyRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "PostWithAllParamsWithBodyPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2246,6 +4114,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/PostWithAllParamsWithBodyPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "PostWithAllParamsWithBodyPtr")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -2289,9 +4158,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "PostWithAllParamsWithBodyPtr")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/post-with-all-params-body-required-ptr"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-ptr")), e2EcontrollerPostWithAllParamsWithBodyPtrHandler)
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrRoute := withBasePath(basePath, "/e2e/post-with-all-params-body-required-ptr")
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"PostWithAllParamsWithBodyRequiredPtr",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostWithAllParamsWithBodyRequiredPtrRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -2311,8 +4194,9 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "PostWithAllParamsWithBodyRequiredPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
 		var theBodyRawPtr *Param5theBody.BodyInfo = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &theBodyRawPtr)
@@ -2326,20 +4210,27 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBodyRequiredPtr' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyRequiredPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBodyRequiredPtr",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -2350,13 +4241,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "PostWithAllParamsWithBodyRequiredPtr")
+			}
+		}()
 		value, opError := controller.PostWithAllParamsWithBodyRequiredPtr(theBodyRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "PostWithAllParamsWithBodyRequiredPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2374,6 +4272,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/PostWithAllParamsWithBodyRequiredPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -2417,9 +4316,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/get-header-start-with-letter"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-required-ptr")), e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler)
+	e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes := []int{}
+	e2EcontrollerGetHeaderStartWithLetterRoute := withBasePath(basePath, "/e2e/get-header-start-with-letter")
+	e2EcontrollerGetHeaderStartWithLetterHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"GetHeaderStartWithLetter",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetHeaderStartWithLetterRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "GetHeaderStartWithLetter")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -2439,8 +4352,9 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "GetHeaderStartWithLetter")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("headerParam")
 		_, isheaderParamExists := echoCtx.Request().Header["headerParam"]
@@ -2464,10 +4378,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "GetHeaderStartWithLetter")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -2478,13 +4395,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "GetHeaderStartWithLetter")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "GetHeaderStartWithLetter")
+			}
+		}()
 		value, opError := controller.GetHeaderStartWithLetter(*headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "GetHeaderStartWithLetter")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2502,6 +4426,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/GetHeaderStartWithLetter",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "GetHeaderStartWithLetter")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -2519,9 +4444,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "GetHeaderStartWithLetter")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/with-default-config-security"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/get-header-start-with-letter")), e2EcontrollerGetHeaderStartWithLetterHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/get-header-start-with-letter")), e2EcontrollerGetHeaderStartWithLetterHandler)
+	e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithDefaultConfigSecurityRoute := withBasePath(basePath, "/e2e/with-default-config-security")
+	e2EcontrollerWithDefaultConfigSecurityHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"WithDefaultConfigSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithDefaultConfigSecurityRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "WithDefaultConfigSecurity")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -2541,8 +4481,9 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "WithDefaultConfigSecurity")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("x-test-scopes")
 		_, isheaderParamExists := echoCtx.Request().Header["x-test-scopes"]
@@ -2566,10 +4507,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "WithDefaultConfigSecurity")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -2580,13 +4524,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "WithDefaultConfigSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "WithDefaultConfigSecurity")
+			}
+		}()
 		value, opError := controller.WithDefaultConfigSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "WithDefaultConfigSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2604,6 +4555,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/WithDefaultConfigSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "WithDefaultConfigSecurity")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -2621,9 +4573,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "WithDefaultConfigSecurity")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/with-one-security"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/with-default-config-security")), e2EcontrollerWithDefaultConfigSecurityHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/with-default-config-security")), e2EcontrollerWithDefaultConfigSecurityHandler)
+	e2EcontrollerWithOneSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithOneSecurityRoute := withBasePath(basePath, "/e2e/with-one-security")
+	e2EcontrollerWithOneSecurityHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"WithOneSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithOneSecurityRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "WithOneSecurity")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -2643,8 +4610,9 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "WithOneSecurity")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var headerParamRawPtr *string = nil
 		headerParamRaw := echoCtx.Request().Header.Get("x-test-scopes")
 		_, isheaderParamExists := echoCtx.Request().Header["x-test-scopes"]
@@ -2668,10 +4636,2255 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "WithOneSecurity")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "WithOneSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "WithOneSecurity")
+			}
+		}()
+		value, opError := controller.WithOneSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "WithOneSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithOneSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithOneSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "WithOneSecurity")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "WithOneSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "WithOneSecurity")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "WithOneSecurity")
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/with-one-security")), e2EcontrollerWithOneSecurityHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/with-one-security")), e2EcontrollerWithOneSecurityHandler)
+	e2EcontrollerWithTwoSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecurityRoute := withBasePath(basePath, "/e2e/with-two-security")
+	e2EcontrollerWithTwoSecurityHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"WithTwoSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithTwoSecurityRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "WithTwoSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+								"read",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "WithTwoSecurity")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := echoCtx.Request().Header.Get("x-test-scopes")
+		_, isheaderParamExists := echoCtx.Request().Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the echoCtx.Request().Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := echoCtx.Request().Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, validatorErr)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			echoCtx.Response().Header().Set("x-RunValidatorExtension", "WithTwoSecurity")
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "WithTwoSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "WithTwoSecurity")
+			}
+		}()
+		value, opError := controller.WithTwoSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "WithTwoSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithTwoSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithTwoSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "WithTwoSecurity")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "WithTwoSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "WithTwoSecurity")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "WithTwoSecurity")
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/with-two-security")), e2EcontrollerWithTwoSecurityHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/with-two-security")), e2EcontrollerWithTwoSecurityHandler)
+	e2EcontrollerWithAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithAdvancedSecurityRoute := withBasePath(basePath, "/e2e/with-advanced-security")
+	e2EcontrollerWithAdvancedSecurityHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"WithAdvancedSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithAdvancedSecurityRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "WithAdvancedSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName3",
+							Scopes: []string{
+								"admin",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "WithAdvancedSecurity")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := echoCtx.Request().Header.Get("x-test-scopes")
+		_, isheaderParamExists := echoCtx.Request().Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the echoCtx.Request().Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := echoCtx.Request().Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, validatorErr)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithAdvancedSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			echoCtx.Response().Header().Set("x-RunValidatorExtension", "WithAdvancedSecurity")
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "WithAdvancedSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "WithAdvancedSecurity")
+			}
+		}()
+		value, opError := controller.WithAdvancedSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "WithAdvancedSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithAdvancedSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithAdvancedSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "WithAdvancedSecurity")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "WithAdvancedSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "WithAdvancedSecurity")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "WithAdvancedSecurity")
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/with-advanced-security")), e2EcontrollerWithAdvancedSecurityHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/with-advanced-security")), e2EcontrollerWithAdvancedSecurityHandler)
+	e2EcontrollerWithPrincipalErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalRoute := withBasePath(basePath, "/e2e/with-principal")
+	e2EcontrollerWithPrincipalHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"WithPrincipal",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithPrincipalRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "WithPrincipal")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "WithPrincipal")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(echoCtx))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			return handleAuthorizationError(echoCtx, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipal")
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "WithPrincipal")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "WithPrincipal")
+			}
+		}()
+		value, opError := controller.WithPrincipal(principalRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "WithPrincipal")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithPrincipal'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithPrincipal",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "WithPrincipal")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "WithPrincipal")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "WithPrincipal")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "WithPrincipal")
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/with-principal")), e2EcontrollerWithPrincipalHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/with-principal")), e2EcontrollerWithPrincipalHandler)
+	e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalAdvancedSecurityRoute := withBasePath(basePath, "/e2e/with-principal-advanced-security")
+	e2EcontrollerWithPrincipalAdvancedSecurityHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"WithPrincipalAdvancedSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithPrincipalAdvancedSecurityRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "WithPrincipalAdvancedSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName3",
+							Scopes: []string{
+								"admin",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "WithPrincipalAdvancedSecurity")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(echoCtx))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			return handleAuthorizationError(echoCtx, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipalAdvancedSecurity")
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "WithPrincipalAdvancedSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "WithPrincipalAdvancedSecurity")
+			}
+		}()
+		value, opError := controller.WithPrincipalAdvancedSecurity(principalRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "WithPrincipalAdvancedSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithPrincipalAdvancedSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithPrincipalAdvancedSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "WithPrincipalAdvancedSecurity")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "WithPrincipalAdvancedSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "WithPrincipalAdvancedSecurity")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "WithPrincipalAdvancedSecurity")
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/with-principal-advanced-security")), e2EcontrollerWithPrincipalAdvancedSecurityHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/with-principal-advanced-security")), e2EcontrollerWithPrincipalAdvancedSecurityHandler)
+	e2EcontrollerPublicRouteErrorStatusCodes := []int{}
+	e2EcontrollerPublicRouteRoute := withBasePath(basePath, "/e2e/public")
+	e2EcontrollerPublicRouteHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"PublicRoute",
+			"E2EController",
+			"GET",
+			e2EcontrollerPublicRouteRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "PublicRoute")
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "PublicRoute")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "PublicRoute")
+			}
+		}()
+		value, opError := controller.PublicRoute()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "PublicRoute")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PublicRoute'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PublicRoute",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "PublicRoute")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "PublicRoute")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "PublicRoute")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "PublicRoute")
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/public")), e2EcontrollerPublicRouteHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/public")), e2EcontrollerPublicRouteHandler)
+	e2EcontrollerHeadCheckErrorStatusCodes := []int{}
+	e2EcontrollerHeadCheckRoute := withBasePath(basePath, "/e2e/head-check")
+	e2EcontrollerHeadCheckHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"HeadCheck",
+			"E2EController",
+			"HEAD",
+			e2EcontrollerHeadCheckRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "HeadCheck")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "HeadCheck")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "HeadCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "HeadCheck")
+			}
+		}()
+		opError := controller.HeadCheck()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "HeadCheck")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'HeadCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/HeadCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "HeadCheck")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "HeadCheck")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "HeadCheck")
+		echoCtx.Response().WriteHeader(statusCode)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "HeadCheck")
+		return nil
+	}
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/head-check")), e2EcontrollerHeadCheckHandler)
+	e2EcontrollerOptionsCheckErrorStatusCodes := []int{}
+	e2EcontrollerOptionsCheckRoute := withBasePath(basePath, "/e2e/options-check")
+	e2EcontrollerOptionsCheckHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"OptionsCheck",
+			"E2EController",
+			"OPTIONS",
+			e2EcontrollerOptionsCheckRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "OptionsCheck")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "OptionsCheck")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "OptionsCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "OptionsCheck")
+			}
+		}()
+		value, opError := controller.OptionsCheck()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "OptionsCheck")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'OptionsCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/OptionsCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "OptionsCheck")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "OptionsCheck")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "OptionsCheck")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "OptionsCheck")
+		return nil
+	}
+	engine.Add("OPTIONS", toEchoUrl(withBasePath(basePath, "/e2e/options-check")), e2EcontrollerOptionsCheckHandler)
+	e2EcontrollerTraceCheckErrorStatusCodes := []int{}
+	e2EcontrollerTraceCheckRoute := withBasePath(basePath, "/e2e/trace-check")
+	e2EcontrollerTraceCheckHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"TraceCheck",
+			"E2EController",
+			"TRACE",
+			e2EcontrollerTraceCheckRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "TraceCheck")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "TraceCheck")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "TraceCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "TraceCheck")
+			}
+		}()
+		value, opError := controller.TraceCheck()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "TraceCheck")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TraceCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TraceCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "TraceCheck")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "TraceCheck")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "TraceCheck")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "TraceCheck")
+		return nil
+	}
+	engine.Add("TRACE", toEchoUrl(withBasePath(basePath, "/e2e/trace-check")), e2EcontrollerTraceCheckHandler)
+	e2EcontrollerPurgeCheckErrorStatusCodes := []int{}
+	e2EcontrollerPurgeCheckRoute := withBasePath(basePath, "/e2e/purge-check")
+	e2EcontrollerPurgeCheckHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"PurgeCheck",
+			"E2EController",
+			"PURGE",
+			e2EcontrollerPurgeCheckRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "PurgeCheck")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "PurgeCheck")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "PurgeCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "PurgeCheck")
+			}
+		}()
+		value, opError := controller.PurgeCheck()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "PurgeCheck")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PurgeCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PurgeCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "PurgeCheck")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "PurgeCheck")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "PurgeCheck")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "PurgeCheck")
+		return nil
+	}
+	engine.Add("PURGE", toEchoUrl(withBasePath(basePath, "/e2e/purge-check")), e2EcontrollerPurgeCheckHandler)
+	e2EcontrollerVersionedV1ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV1Route := withBasePath(basePath, "/e2e/versioned")
+	e2EcontrollerVersionedV1Handler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"VersionedV1",
+			"E2EController",
+			"GET",
+			e2EcontrollerVersionedV1Route,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "VersionedV1")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "VersionedV1")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "VersionedV1")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "VersionedV1")
+			}
+		}()
+		value, opError := controller.VersionedV1()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "VersionedV1")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV1'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV1",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "VersionedV1")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "VersionedV1")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "VersionedV1")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "VersionedV1")
+		return nil
+	}
+	e2EcontrollerVersionedV1Versions := []int{1}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV1Versions, e2EcontrollerVersionedV1Handler)
+	registerVersionedRoute(engine, basePath, "HEAD", "/e2e/versioned", e2EcontrollerVersionedV1Versions, e2EcontrollerVersionedV1Handler)
+	e2EcontrollerVersionedV2ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV2Route := withBasePath(basePath, "/e2e/versioned")
+	e2EcontrollerVersionedV2Handler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"VersionedV2",
+			"E2EController",
+			"GET",
+			e2EcontrollerVersionedV2Route,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "VersionedV2")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "VersionedV2")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "VersionedV2")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "VersionedV2")
+			}
+		}()
+		value, opError := controller.VersionedV2()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "VersionedV2")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV2'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV2",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "VersionedV2")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "VersionedV2")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "VersionedV2")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "VersionedV2")
+		return nil
+	}
+	e2EcontrollerVersionedV2Versions := []int{2}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV2Versions, e2EcontrollerVersionedV2Handler)
+	registerVersionedRoute(engine, basePath, "HEAD", "/e2e/versioned", e2EcontrollerVersionedV2Versions, e2EcontrollerVersionedV2Handler)
+	e2EcontrollerRateLimitedErrorStatusCodes := []int{}
+	e2EcontrollerRateLimitedRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "RateLimited")
+	e2EcontrollerRateLimitedRoute := withBasePath(basePath, "/e2e/rate-limited")
+	e2EcontrollerRateLimitedHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"RateLimited",
+			"E2EController",
+			"GET",
+			e2EcontrollerRateLimitedRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "RateLimited")
+		if allowed, err := enforceRateLimit(echoCtx, "RateLimited", e2EcontrollerRateLimitedRateLimit); !allowed {
+			return err
+		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "RateLimited")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "RateLimited")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "RateLimited")
+			}
+		}()
+		value, opError := controller.RateLimited()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "RateLimited")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'RateLimited'",
+				Status:     statusCode,
+				Instance:   "/controller/error/RateLimited",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "RateLimited")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "RateLimited")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "RateLimited")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "RateLimited")
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/rate-limited")), e2EcontrollerRateLimitedHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/rate-limited")), e2EcontrollerRateLimitedHandler)
+	e2EcontrollerTimedOutErrorStatusCodes := []int{}
+	e2EcontrollerTimedOutTimeout := newOperationTimeout("50ms")
+	e2EcontrollerTimedOutRoute := withBasePath(basePath, "/e2e/timeout")
+	e2EcontrollerTimedOutHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"TimedOut",
+			"E2EController",
+			"GET",
+			e2EcontrollerTimedOutRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "TimedOut")
+		cancelTimeout := applyOperationTimeout(echoCtx, e2EcontrollerTimedOutTimeout)
+		defer cancelTimeout()
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "TimedOut")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "TimedOut")
+		if isDeadlineExceeded(echoCtx) {
+			return handleDeadlineExceeded(echoCtx, "TimedOut", http.StatusServiceUnavailable)
+		}
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "TimedOut")
+			}
+		}()
+		value, opError := controller.TimedOut(getRequestContext(echoCtx))
+		telemetry.endPhase()
+		if isDeadlineExceeded(echoCtx) {
+			return handleDeadlineExceeded(echoCtx, "TimedOut", http.StatusGatewayTimeout)
+		}
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "TimedOut")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTimedOutErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TimedOut'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TimedOut",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTimedOutErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "TimedOut")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "TimedOut")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "TimedOut")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "TimedOut")
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/timeout")), e2EcontrollerTimedOutHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/timeout")), e2EcontrollerTimedOutHandler)
+	e2EcontrollerMaxBodySizeErrorStatusCodes := []int{}
+	e2EcontrollerMaxBodySizeRoute := withBasePath(basePath, "/e2e/max-body-size")
+	e2EcontrollerMaxBodySizeHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"MaxBodySize",
+			"E2EController",
+			"POST",
+			e2EcontrollerMaxBodySizeRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "MaxBodySize")
+		if allowed, err := enforceMaxBodySize(echoCtx, "MaxBodySize", 64); !allowed {
+			return err
+		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "MaxBodySize")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var dataRawPtr *Param8data.ObjectWithByteSlice = nil
+		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, conversionErr)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "MaxBodySize",
+						"parameter": "data",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/MaxBodySize",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "MaxBodySize")
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "MaxBodySize")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "MaxBodySize")
+			}
+		}()
+		value, opError := controller.MaxBodySize(*dataRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "MaxBodySize")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MaxBodySize'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MaxBodySize",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "MaxBodySize")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "MaxBodySize")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "MaxBodySize")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "MaxBodySize")
+		return nil
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/max-body-size")), e2EcontrollerMaxBodySizeHandler)
+	e2EcontrollerIdempotentErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentRoute := withBasePath(basePath, "/e2e/idempotent")
+	e2EcontrollerIdempotentHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"Idempotent",
+			"E2EController",
+			"POST",
+			e2EcontrollerIdempotentRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "Idempotent")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "Idempotent")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed, hashErr := hashRequestBody(echoCtx, "Idempotent", 0)
+		if !isBodyHashed {
+			return hashErr
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "Idempotent")
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		completeIdempotentRequest, idempotencyErr := beginIdempotentRequest(echoCtx, "Idempotent", idempotentRequestHash, e2EcontrollerIdempotentIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return idempotencyErr
+		}
+		defer completeIdempotentRequest()
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "Idempotent")
+			}
+		}()
+		value, opError := controller.Idempotent()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "Idempotent")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Idempotent'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Idempotent",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "Idempotent")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "Idempotent")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "Idempotent")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "Idempotent")
+		return nil
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/idempotent")), e2EcontrollerIdempotentHandler)
+	e2EcontrollerIdempotentWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentWithBodyIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentWithBodyRoute := withBasePath(basePath, "/e2e/idempotent-with-body")
+	e2EcontrollerIdempotentWithBodyHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"IdempotentWithBody",
+			"E2EController",
+			"POST",
+			e2EcontrollerIdempotentWithBodyRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "IdempotentWithBody")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "IdempotentWithBody")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed, hashErr := hashRequestBody(echoCtx, "IdempotentWithBody", 0)
+		if !isBodyHashed {
+			return hashErr
+		}
+		var conversionErr error
+		var dataRawPtr *Param5data.BodyInfo = nil
+		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, conversionErr)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "IdempotentWithBody",
+						"parameter": "data",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/IdempotentWithBody",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "IdempotentWithBody")
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "IdempotentWithBody")
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		completeIdempotentRequest, idempotencyErr := beginIdempotentRequest(echoCtx, "IdempotentWithBody", idempotentRequestHash, e2EcontrollerIdempotentWithBodyIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return idempotencyErr
+		}
+		defer completeIdempotentRequest()
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "IdempotentWithBody")
+			}
+		}()
+		value, opError := controller.IdempotentWithBody(*dataRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "IdempotentWithBody")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'IdempotentWithBody'",
+				Status:     statusCode,
+				Instance:   "/controller/error/IdempotentWithBody",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "IdempotentWithBody")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "IdempotentWithBody")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "IdempotentWithBody")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "IdempotentWithBody")
+		return nil
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/idempotent-with-body")), e2EcontrollerIdempotentWithBodyHandler)
+	e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecuritySameMethodRoute := withBasePath(basePath, "/e2e/with-two-security-same-method")
+	e2EcontrollerWithTwoSecuritySameMethodHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"WithTwoSecuritySameMethod",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithTwoSecuritySameMethodRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "WithTwoSecuritySameMethod")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"write",
+								"read",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "WithTwoSecuritySameMethod")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := echoCtx.Request().Header.Get("x-test-scopes")
+		_, isheaderParamExists := echoCtx.Request().Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the echoCtx.Request().Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := echoCtx.Request().Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, validatorErr)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			echoCtx.Response().Header().Set("x-RunValidatorExtension", "WithTwoSecuritySameMethod")
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "WithTwoSecuritySameMethod")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "WithTwoSecuritySameMethod")
+			}
+		}()
+		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "WithTwoSecuritySameMethod")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithTwoSecuritySameMethod",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "WithTwoSecuritySameMethod")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "WithTwoSecuritySameMethod")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "WithTwoSecuritySameMethod")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "WithTwoSecuritySameMethod")
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/with-two-security-same-method")), e2EcontrollerWithTwoSecuritySameMethodHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/with-two-security-same-method")), e2EcontrollerWithTwoSecuritySameMethodHandler)
+	e2EcontrollerDefaultErrorErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorRoute := withBasePath(basePath, "/e2e/default-error")
+	e2EcontrollerDefaultErrorHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"DefaultError",
+			"E2EController",
+			"GET",
+			e2EcontrollerDefaultErrorRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "DefaultError")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "DefaultError")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "DefaultError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "DefaultError")
+			}
+		}()
+		opError := controller.DefaultError()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "DefaultError")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DefaultError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DefaultError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "DefaultError")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "DefaultError")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "DefaultError")
+		echoCtx.Response().WriteHeader(statusCode)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "DefaultError")
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/default-error")), e2EcontrollerDefaultErrorHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/default-error")), e2EcontrollerDefaultErrorHandler)
+	e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorWithPayloadRoute := withBasePath(basePath, "/e2e/default-error-with-payload")
+	e2EcontrollerDefaultErrorWithPayloadHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"DefaultErrorWithPayload",
+			"E2EController",
+			"GET",
+			e2EcontrollerDefaultErrorWithPayloadRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "DefaultErrorWithPayload")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "DefaultErrorWithPayload")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "DefaultErrorWithPayload")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "DefaultErrorWithPayload")
+			}
+		}()
+		value, opError := controller.DefaultErrorWithPayload()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "DefaultErrorWithPayload")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DefaultErrorWithPayload",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "DefaultErrorWithPayload")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "DefaultErrorWithPayload")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "DefaultErrorWithPayload")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "DefaultErrorWithPayload")
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/default-error-with-payload")), e2EcontrollerDefaultErrorWithPayloadHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/default-error-with-payload")), e2EcontrollerDefaultErrorWithPayloadHandler)
+	e2EcontrollerInjectedDependencyErrorStatusCodes := []int{}
+	e2EcontrollerInjectedDependencyRoute := withBasePath(basePath, "/e2e/injected-dependency")
+	e2EcontrollerInjectedDependencyHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"InjectedDependency",
+			"E2EController",
+			"GET",
+			e2EcontrollerInjectedDependencyRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "InjectedDependency")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "InjectedDependency")
 		}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -2681,14 +6894,21 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "WithOneSecurity")
-		value, opError := controller.WithOneSecurity(*headerParamRawPtr)
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "InjectedDependency")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "InjectedDependency")
+			}
+		}()
+		value, opError := controller.InjectedDependency()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
-		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "WithOneSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "InjectedDependency")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2701,15 +6921,16 @@ func RegisterRoutes(engine *echo.Echo) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithOneSecurity'",
+				Detail:     "Encountered an error during operation 'InjectedDependency'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithOneSecurity",
+				Instance:   "/controller/error/InjectedDependency",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "WithOneSecurity")
+			applyErrorMapping(&stdError, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "InjectedDependency")
 			return echoCtx.JSON(statusCode, stdError)
 		}
-		echoCtx.Response().Header().Set("x-JsonResponseExtension", "WithOneSecurity")
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "InjectedDependency")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -2719,35 +6940,38 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "WithOneSecurity")
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "InjectedDependency")
 		echoCtx.JSON(statusCode, value)
-		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "WithOneSecurity")
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "InjectedDependency")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/with-two-security"), func(echoCtx echo.Context) error {
-		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "WithTwoSecurity")
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/injected-dependency")), e2EcontrollerInjectedDependencyHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/injected-dependency")), e2EcontrollerInjectedDependencyHandler)
+	e2EcontrollerMappedErrorErrorStatusCodes := []int{404}
+	e2EcontrollerMappedErrorRoute := withBasePath(basePath, "/e2e/mapped-error")
+	e2EcontrollerMappedErrorHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"MappedError",
+			"E2EController",
+			"GET",
+			e2EcontrollerMappedErrorRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "MappedError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName",
-							Scopes: []string{
-								"other",
-							},
-						},
-					},
-				},
 				{
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
 							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"write",
-								"read",
+								"config",
 							},
 						},
 					},
@@ -2755,37 +6979,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(echoCtx, authErr, "WithTwoSecurity")
+			return handleAuthorizationError(echoCtx, authErr, "MappedError")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := echoCtx.Request().Header.Get("x-test-scopes")
-		_, isheaderParamExists := echoCtx.Request().Header["x-test-scopes"]
-		if !isheaderParamExists {
-			// In echo, the echoCtx.Request().Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := echoCtx.Request().Header.Values("x-test-scopes")
-			isheaderParamExists = len(headerValues) > 0
-		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, validatorErr)
-				setRequestContext(echoCtx, middlewareCtx)
-				if !continueOperation {
-					return nil
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName)
-			echoCtx.Response().Header().Set("x-RunValidatorExtension", "WithTwoSecurity")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
-		}
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -2795,14 +6994,21 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "WithTwoSecurity")
-		value, opError := controller.WithTwoSecurity(*headerParamRawPtr)
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "MappedError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "MappedError")
+			}
+		}()
+		opError := controller.MappedError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
-		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "WithTwoSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "MappedError")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerMappedErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2815,15 +7021,16 @@ func RegisterRoutes(engine *echo.Echo) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithTwoSecurity'",
+				Detail:     "Encountered an error during operation 'MappedError'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithTwoSecurity",
+				Instance:   "/controller/error/MappedError",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "WithTwoSecurity")
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedErrorErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "MappedError")
 			return echoCtx.JSON(statusCode, stdError)
 		}
-		echoCtx.Response().Header().Set("x-JsonResponseExtension", "WithTwoSecurity")
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "MappedError")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -2833,13 +7040,28 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "WithTwoSecurity")
-		echoCtx.JSON(statusCode, value)
-		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "WithTwoSecurity")
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "MappedError")
+		echoCtx.Response().WriteHeader(statusCode)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "MappedError")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/with-two-security-same-method"), func(echoCtx echo.Context) error {
-		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "WithTwoSecuritySameMethod")
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/mapped-error")), e2EcontrollerMappedErrorHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/mapped-error")), e2EcontrollerMappedErrorHandler)
+	e2EcontrollerUndeclaredMappedErrorErrorStatusCodes := []int{}
+	e2EcontrollerUndeclaredMappedErrorRoute := withBasePath(basePath, "/e2e/undeclared-mapped-error")
+	e2EcontrollerUndeclaredMappedErrorHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"UndeclaredMappedError",
+			"E2EController",
+			"GET",
+			e2EcontrollerUndeclaredMappedErrorRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "UndeclaredMappedError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -2847,21 +7069,9 @@ func RegisterRoutes(engine *echo.Echo) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName",
-							Scopes: []string{
-								"other",
-							},
-						},
-					},
-				},
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName",
+							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"write",
-								"read",
+								"config",
 							},
 						},
 					},
@@ -2869,37 +7079,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(echoCtx, authErr, "WithTwoSecuritySameMethod")
+			return handleAuthorizationError(echoCtx, authErr, "UndeclaredMappedError")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := echoCtx.Request().Header.Get("x-test-scopes")
-		_, isheaderParamExists := echoCtx.Request().Header["x-test-scopes"]
-		if !isheaderParamExists {
-			// In echo, the echoCtx.Request().Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := echoCtx.Request().Header.Values("x-test-scopes")
-			isheaderParamExists = len(headerValues) > 0
-		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, validatorErr)
-				setRequestContext(echoCtx, middlewareCtx)
-				if !continueOperation {
-					return nil
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName)
-			echoCtx.Response().Header().Set("x-RunValidatorExtension", "WithTwoSecuritySameMethod")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
-		}
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -2909,14 +7094,21 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "WithTwoSecuritySameMethod")
-		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "UndeclaredMappedError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "UndeclaredMappedError")
+			}
+		}()
+		opError := controller.UndeclaredMappedError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
-		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "WithTwoSecuritySameMethod")
-		statusCode := getStatusCode(&controller, true, opError)
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "UndeclaredMappedError")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2929,15 +7121,16 @@ func RegisterRoutes(engine *echo.Echo) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
+				Detail:     "Encountered an error during operation 'UndeclaredMappedError'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithTwoSecuritySameMethod",
+				Instance:   "/controller/error/UndeclaredMappedError",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "WithTwoSecuritySameMethod")
+			applyErrorMapping(&stdError, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "UndeclaredMappedError")
 			return echoCtx.JSON(statusCode, stdError)
 		}
-		echoCtx.Response().Header().Set("x-JsonResponseExtension", "WithTwoSecuritySameMethod")
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "UndeclaredMappedError")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -2947,13 +7140,28 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "WithTwoSecuritySameMethod")
-		echoCtx.JSON(statusCode, value)
-		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "WithTwoSecuritySameMethod")
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "UndeclaredMappedError")
+		echoCtx.Response().WriteHeader(statusCode)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "UndeclaredMappedError")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/default-error"), func(echoCtx echo.Context) error {
-		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "DefaultError")
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/undeclared-mapped-error")), e2EcontrollerUndeclaredMappedErrorHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/undeclared-mapped-error")), e2EcontrollerUndeclaredMappedErrorHandler)
+	e2EcontrollerMappedTypedErrorErrorStatusCodes := []int{409}
+	e2EcontrollerMappedTypedErrorRoute := withBasePath(basePath, "/e2e/mapped-typed-error")
+	e2EcontrollerMappedTypedErrorHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"MappedTypedError",
+			"E2EController",
+			"GET",
+			e2EcontrollerMappedTypedErrorRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "MappedTypedError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -2971,10 +7179,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(echoCtx, authErr, "DefaultError")
+			return handleAuthorizationError(echoCtx, authErr, "MappedTypedError")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -2984,14 +7194,21 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "DefaultError")
-		opError := controller.DefaultError()
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "MappedTypedError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "MappedTypedError")
+			}
+		}()
+		value, opError := controller.MappedTypedError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
-		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "DefaultError")
-		statusCode := getStatusCode(&controller, false, opError)
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "MappedTypedError")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3004,15 +7221,16 @@ func RegisterRoutes(engine *echo.Echo) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DefaultError'",
+				Detail:     "Encountered an error during operation 'MappedTypedError'",
 				Status:     statusCode,
-				Instance:   "/controller/error/DefaultError",
+				Instance:   "/controller/error/MappedTypedError",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "DefaultError")
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "MappedTypedError")
 			return echoCtx.JSON(statusCode, stdError)
 		}
-		echoCtx.Response().Header().Set("x-JsonResponseExtension", "DefaultError")
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "MappedTypedError")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -3022,13 +7240,28 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "DefaultError")
-		echoCtx.Response().WriteHeader(statusCode)
-		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "DefaultError")
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "MappedTypedError")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "MappedTypedError")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/default-error-with-payload"), func(echoCtx echo.Context) error {
-		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "DefaultErrorWithPayload")
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/mapped-typed-error")), e2EcontrollerMappedTypedErrorHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/mapped-typed-error")), e2EcontrollerMappedTypedErrorHandler)
+	e2EcontrollerPanicErrorStatusCodes := []int{}
+	e2EcontrollerPanicRoute := withBasePath(basePath, "/e2e/panic")
+	e2EcontrollerPanicHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"Panic",
+			"E2EController",
+			"GET",
+			e2EcontrollerPanicRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "Panic")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -3046,10 +7279,12 @@ func RegisterRoutes(engine *echo.Echo) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(echoCtx, authErr, "DefaultErrorWithPayload")
+			return handleAuthorizationError(echoCtx, authErr, "Panic")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -3059,14 +7294,21 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "DefaultErrorWithPayload")
-		value, opError := controller.DefaultErrorWithPayload()
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "Panic")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "Panic")
+			}
+		}()
+		value, opError := controller.Panic()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
-		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "DefaultErrorWithPayload")
-		statusCode := getStatusCode(&controller, true, opError)
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "Panic")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPanicErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3079,15 +7321,16 @@ func RegisterRoutes(engine *echo.Echo) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
+				Detail:     "Encountered an error during operation 'Panic'",
 				Status:     statusCode,
-				Instance:   "/controller/error/DefaultErrorWithPayload",
+				Instance:   "/controller/error/Panic",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "DefaultErrorWithPayload")
+			applyErrorMapping(&stdError, opError, e2EcontrollerPanicErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "Panic")
 			return echoCtx.JSON(statusCode, stdError)
 		}
-		echoCtx.Response().Header().Set("x-JsonResponseExtension", "DefaultErrorWithPayload")
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "Panic")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -3097,13 +7340,28 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "DefaultErrorWithPayload")
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "Panic")
 		echoCtx.JSON(statusCode, value)
-		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "DefaultErrorWithPayload")
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "Panic")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/custom-error"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/panic")), e2EcontrollerPanicHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/panic")), e2EcontrollerPanicHandler)
+	e2EcontrollerCustomErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomErrorRoute := withBasePath(basePath, "/e2e/custom-error")
+	e2EcontrollerCustomErrorHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"CustomError",
+			"E2EController",
+			"GET",
+			e2EcontrollerCustomErrorRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "CustomError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -3123,8 +7381,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "CustomError")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -3135,14 +7395,21 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "CustomError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "CustomError")
+			}
+		}()
 		opError := controller.CustomError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "CustomError")
-		statusCode := getStatusCode(&controller, false, opError)
-		emptyErr := Response6CustomError.CustomError{}
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomErrorErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
 		if opError != emptyErr {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3169,9 +7436,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "CustomError")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/custom-error-ptr"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/custom-error")), e2EcontrollerCustomErrorHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/custom-error")), e2EcontrollerCustomErrorHandler)
+	e2EcontrollerCustomPtrErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomPtrErrorRoute := withBasePath(basePath, "/e2e/custom-error-ptr")
+	e2EcontrollerCustomPtrErrorHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"CustomPtrError",
+			"E2EController",
+			"GET",
+			e2EcontrollerCustomPtrErrorRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "CustomPtrError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -3191,8 +7473,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "CustomPtrError")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -3203,13 +7487,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "CustomPtrError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "CustomPtrError")
+			}
+		}()
 		opError := controller.CustomPtrError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "CustomPtrError")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomPtrErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3236,9 +7527,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "CustomPtrError")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/503-error-code"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/custom-error-ptr")), e2EcontrollerCustomPtrErrorHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/custom-error-ptr")), e2EcontrollerCustomPtrErrorHandler)
+	e2EcontrollerError503ErrorStatusCodes := []int{}
+	e2EcontrollerError503Route := withBasePath(basePath, "/e2e/503-error-code")
+	e2EcontrollerError503Handler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"Error503",
+			"E2EController",
+			"GET",
+			e2EcontrollerError503Route,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "Error503")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -3258,8 +7564,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "Error503")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -3270,13 +7578,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "Error503")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "Error503")
+			}
+		}()
 		opError := controller.Error503()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "Error503")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerError503ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3294,6 +7609,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/Error503",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerError503ErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "Error503")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3311,9 +7627,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "Error503")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/custom-error-503"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/503-error-code")), e2EcontrollerError503Handler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/503-error-code")), e2EcontrollerError503Handler)
+	e2EcontrollerCustomError503ErrorStatusCodes := []int{}
+	e2EcontrollerCustomError503Route := withBasePath(basePath, "/e2e/custom-error-503")
+	e2EcontrollerCustomError503Handler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"CustomError503",
+			"E2EController",
+			"GET",
+			e2EcontrollerCustomError503Route,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "CustomError503")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -3333,8 +7664,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "CustomError503")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -3345,14 +7678,21 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "CustomError503")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "CustomError503")
+			}
+		}()
 		opError := controller.CustomError503()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "CustomError503")
-		statusCode := getStatusCode(&controller, false, opError)
-		emptyErr := Response6CustomError.CustomError{}
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomError503ErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
 		if opError != emptyErr {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3379,9 +7719,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "CustomError503")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/context-access"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/custom-error-503")), e2EcontrollerCustomError503Handler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/custom-error-503")), e2EcontrollerCustomError503Handler)
+	e2EcontrollerContextAccessErrorStatusCodes := []int{}
+	e2EcontrollerContextAccessRoute := withBasePath(basePath, "/e2e/context-access")
+	e2EcontrollerContextAccessHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"ContextAccess",
+			"E2EController",
+			"GET",
+			e2EcontrollerContextAccessRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "ContextAccess")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -3401,8 +7756,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ContextAccess")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -3413,13 +7770,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "ContextAccess")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "ContextAccess")
+			}
+		}()
 		opError := controller.ContextAccess()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "ContextAccess")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextAccessErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3437,6 +7801,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ContextAccess",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextAccessErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "ContextAccess")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3454,9 +7819,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "ContextAccess")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/http-method"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/context-access")), e2EcontrollerContextAccessHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/context-access")), e2EcontrollerContextAccessHandler)
+	e2EcontrollerGetErrorStatusCodes := []int{}
+	e2EcontrollerGetRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerGetHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"Get",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "Get")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -3476,8 +7856,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "Get")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -3488,13 +7870,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "Get")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "Get")
+			}
+		}()
 		opError := controller.Get()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "Get")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerGetErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3512,6 +7901,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/Get",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "Get")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3529,9 +7919,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "Get")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/http-method"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerGetHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerGetHandler)
+	e2EcontrollerPostErrorStatusCodes := []int{}
+	e2EcontrollerPostRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerPostHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"Post",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "Post")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -3551,8 +7956,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "Post")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -3563,13 +7970,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "Post")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "Post")
+			}
+		}()
 		opError := controller.Post()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "Post")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPostErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3587,6 +8001,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/Post",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "Post")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3604,9 +8019,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "Post")
 		return nil
-	})
-	engine.PUT(toEchoUrl("/e2e/http-method"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPostHandler)
+	e2EcontrollerPutErrorStatusCodes := []int{}
+	e2EcontrollerPutRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerPutHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"Put",
+			"E2EController",
+			"PUT",
+			e2EcontrollerPutRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "Put")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -3626,8 +8055,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "Put")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -3638,13 +8069,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "Put")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "Put")
+			}
+		}()
 		opError := controller.Put()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "Put")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPutErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3662,6 +8100,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/Put",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPutErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "Put")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3679,9 +8118,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "Put")
 		return nil
-	})
-	engine.DELETE(toEchoUrl("/e2e/http-method"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("PUT", toEchoUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPutHandler)
+	e2EcontrollerDeleteErrorStatusCodes := []int{}
+	e2EcontrollerDeleteRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerDeleteHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"Delete",
+			"E2EController",
+			"DELETE",
+			e2EcontrollerDeleteRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "Delete")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -3701,8 +8154,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "Delete")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -3713,13 +8168,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "Delete")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "Delete")
+			}
+		}()
 		opError := controller.Delete()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "Delete")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDeleteErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3737,6 +8199,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/Delete",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeleteErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "Delete")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3754,9 +8217,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "Delete")
 		return nil
-	})
-	engine.PATCH(toEchoUrl("/e2e/http-method"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("DELETE", toEchoUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerDeleteHandler)
+	e2EcontrollerPatchErrorStatusCodes := []int{}
+	e2EcontrollerPatchRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerPatchHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"Patch",
+			"E2EController",
+			"PATCH",
+			e2EcontrollerPatchRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "Patch")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -3776,8 +8253,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "Patch")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -3788,13 +8267,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "Patch")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "Patch")
+			}
+		}()
 		opError := controller.Patch()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "Patch")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPatchErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3812,6 +8298,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/Patch",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPatchErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "Patch")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3829,9 +8316,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "Patch")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/template-context-1"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("PATCH", toEchoUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPatchHandler)
+	e2EcontrollerTemplateContext1ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext1Route := withBasePath(basePath, "/e2e/template-context-1")
+	e2EcontrollerTemplateContext1Handler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"TemplateContext1",
+			"E2EController",
+			"GET",
+			e2EcontrollerTemplateContext1Route,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "TemplateContext1")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -3851,8 +8352,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TemplateContext1")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -3863,13 +8366,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "TemplateContext1")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "TemplateContext1")
+			}
+		}()
 		value, opError := controller.TemplateContext1()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "TemplateContext1")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3887,6 +8397,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TemplateContext1",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "TemplateContext1")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3905,9 +8416,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "TemplateContext1")
 		echoCtx.Response().Header().Set("x-level", "high")
 		return nil
-	})
-	engine.GET(toEchoUrl("/e2e/template-context-2"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/template-context-1")), e2EcontrollerTemplateContext1Handler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/template-context-1")), e2EcontrollerTemplateContext1Handler)
+	e2EcontrollerTemplateContext2ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext2Route := withBasePath(basePath, "/e2e/template-context-2")
+	e2EcontrollerTemplateContext2Handler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"TemplateContext2",
+			"E2EController",
+			"GET",
+			e2EcontrollerTemplateContext2Route,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "TemplateContext2")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -3927,8 +8453,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TemplateContext2")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -3939,13 +8467,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "TemplateContext2")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "TemplateContext2")
+			}
+		}()
 		value, opError := controller.TemplateContext2()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "TemplateContext2")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3963,6 +8498,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TemplateContext2",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "TemplateContext2")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -3982,9 +8518,24 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().Header().Set("x-mode", "100")
 		echoCtx.Response().Header().Set("x-level", "low")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/form"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/template-context-2")), e2EcontrollerTemplateContext2Handler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/template-context-2")), e2EcontrollerTemplateContext2Handler)
+	e2EcontrollerTestFormErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormRoute := withBasePath(basePath, "/e2e/form")
+	e2EcontrollerTestFormHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"TestForm",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestFormRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "TestForm")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -4004,8 +8555,9 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TestForm")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		echoCtx.Request().ParseForm()
 		var item1RawPtr *string = nil
 		item1RawArr, isitem1Exists := echoCtx.Request().PostForm["item1"]
@@ -4028,9 +8580,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item1"
-			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "TestForm")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		echoCtx.Request().ParseForm()
 		var item2RawPtr *string = nil
@@ -4054,10 +8608,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item2"
-			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "TestForm")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -4068,13 +8625,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "TestForm")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "TestForm")
+			}
+		}()
 		value, opError := controller.TestForm(*item1RawPtr, *item2RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "TestForm")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4092,6 +8656,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TestForm",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "TestForm")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -4109,9 +8674,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "TestForm")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/form-extra"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/form")), e2EcontrollerTestFormHandler)
+	e2EcontrollerTestFormExtraErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormExtraRoute := withBasePath(basePath, "/e2e/form-extra")
+	e2EcontrollerTestFormExtraHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"TestFormExtra",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestFormExtraRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "TestFormExtra")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -4131,8 +8710,9 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TestFormExtra")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		echoCtx.Request().ParseForm()
 		var item1RawPtr *int64 = nil
 		item1RawArr, isitem1Exists := echoCtx.Request().PostForm["item1"]
@@ -4141,7 +8721,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			item1Raw = item1RawArr[0] // Get first value since form values are slices
 		}
 		if isitem1Exists {
-			item1Uint64, conversionErr := strconv.ParseInt(item1Raw, 10, 64)
+			item1, conversionErr := parseIntegerParam[int64](item1Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4152,22 +8732,19 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestFormExtra' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"item1",
-						"int64",
-						reflect.TypeOf(item1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestFormExtra",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item1",
+					"form",
+					"/item1",
+					"int64",
+					reflect.TypeOf(item1Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "TestFormExtra")
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			item1 := int64(item1Uint64)
 			item1RawPtr = &item1
 		}
 		if validatorErr := validatorInstance.Var(item1RawPtr, "required,gte=80"); validatorErr != nil {
@@ -4181,9 +8758,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item1"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "TestFormExtra")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		echoCtx.Request().ParseForm()
 		var item2RawPtr *string = nil
@@ -4207,15 +8786,17 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item2"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "TestFormExtra")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var item3RawPtr *int64 = nil
 		item3Raw := echoCtx.QueryParam("item3")
 		isitem3Exists := echoCtx.Request().URL.Query().Has("item3")
 		if isitem3Exists {
-			item3Uint64, conversionErr := strconv.ParseInt(item3Raw, 10, 64)
+			item3, conversionErr := parseIntegerParam[int64](item3Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4226,22 +8807,19 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestFormExtra' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"item3",
-						"int64",
-						reflect.TypeOf(item3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestFormExtra",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item3",
+					"query",
+					"/item3",
+					"int64",
+					reflect.TypeOf(item3Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "TestFormExtra")
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			item3 := int64(item3Uint64)
 			item3RawPtr = &item3
 		}
 		if validatorErr := validatorInstance.Var(item3RawPtr, "required,gte=80"); validatorErr != nil {
@@ -4255,10 +8833,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item3"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/item3", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "TestFormExtra")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -4269,13 +8850,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "TestFormExtra")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "TestFormExtra")
+			}
+		}()
 		value, opError := controller.TestFormExtra(*item1RawPtr, *item2RawPtr, *item3RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "TestFormExtra")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4293,6 +8881,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TestFormExtra",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "TestFormExtra")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -4310,9 +8899,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "TestFormExtra")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/test-response-validation"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/form-extra")), e2EcontrollerTestFormExtraHandler)
+	e2EcontrollerTestResponseValidationErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationRoute := withBasePath(basePath, "/e2e/test-response-validation")
+	e2EcontrollerTestResponseValidationHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"TestResponseValidation",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestResponseValidationRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "TestResponseValidation")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -4332,8 +8935,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TestResponseValidation")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -4344,13 +8949,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "TestResponseValidation")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "TestResponseValidation")
+			}
+		}()
 		value, opError := controller.TestResponseValidation()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "TestResponseValidation")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4368,6 +8980,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TestResponseValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "TestResponseValidation")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -4407,9 +9020,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "TestResponseValidation")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/test-response-validation-ptr"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/test-response-validation")), e2EcontrollerTestResponseValidationHandler)
+	e2EcontrollerTestResponseValidationPtrErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationPtrRoute := withBasePath(basePath, "/e2e/test-response-validation-ptr")
+	e2EcontrollerTestResponseValidationPtrHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"TestResponseValidationPtr",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestResponseValidationPtrRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "TestResponseValidationPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -4429,8 +9056,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TestResponseValidationPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -4441,13 +9070,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "TestResponseValidationPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "TestResponseValidationPtr")
+			}
+		}()
 		value, opError := controller.TestResponseValidationPtr()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "TestResponseValidationPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4465,6 +9101,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TestResponseValidationPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "TestResponseValidationPtr")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -4508,9 +9145,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "TestResponseValidationPtr")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/test-response-validation-null"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/test-response-validation-ptr")), e2EcontrollerTestResponseValidationPtrHandler)
+	e2EcontrollerTestResponseValidationNullErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationNullRoute := withBasePath(basePath, "/e2e/test-response-validation-null")
+	e2EcontrollerTestResponseValidationNullHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"TestResponseValidationNull",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestResponseValidationNullRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "TestResponseValidationNull")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -4530,8 +9181,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TestResponseValidationNull")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -4542,13 +9195,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "TestResponseValidationNull")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "TestResponseValidationNull")
+			}
+		}()
 		value, opError := controller.TestResponseValidationNull()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "TestResponseValidationNull")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4566,6 +9226,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TestResponseValidationNull",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "TestResponseValidationNull")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -4609,9 +9270,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "TestResponseValidationNull")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/test-primitive-conversions"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/test-response-validation-null")), e2EcontrollerTestResponseValidationNullHandler)
+	e2EcontrollerTestPrimitiveConversionsErrorStatusCodes := []int{500}
+	e2EcontrollerTestPrimitiveConversionsRoute := withBasePath(basePath, "/e2e/test-primitive-conversions")
+	e2EcontrollerTestPrimitiveConversionsHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"TestPrimitiveConversions",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestPrimitiveConversionsRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "TestPrimitiveConversions")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -4631,13 +9306,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TestPrimitiveConversions")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var value1RawPtr *int64 = nil
 		value1Raw := echoCtx.QueryParam("value1")
 		isvalue1Exists := echoCtx.Request().URL.Query().Has("value1")
 		if isvalue1Exists {
-			value1Uint64, conversionErr := strconv.ParseInt(value1Raw, 10, 64)
+			value1, conversionErr := parseIntegerParam[int64](value1Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4648,22 +9324,19 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"int64",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value1",
+					"query",
+					"/value1",
+					"int64",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value1 := int64(value1Uint64)
 			value1RawPtr = &value1
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -4677,15 +9350,17 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "TestPrimitiveConversions")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value2RawPtr *bool = nil
 		value2Raw := echoCtx.QueryParam("value2")
 		isvalue2Exists := echoCtx.Request().URL.Query().Has("value2")
 		if isvalue2Exists {
-			value2Bool, conversionErr := strconv.ParseBool(value2Raw)
+			value2, conversionErr := strconv.ParseBool(value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4696,22 +9371,19 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"bool",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value2",
+					"query",
+					"/value2",
+					"bool",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value2 := value2Bool
 			value2RawPtr = &value2
 		}
 		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
@@ -4725,15 +9397,17 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "TestPrimitiveConversions")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value3RawPtr *int = nil
 		value3Raw := echoCtx.QueryParam("value3")
 		isvalue3Exists := echoCtx.Request().URL.Query().Has("value3")
 		if isvalue3Exists {
-			value3Uint64, conversionErr := strconv.Atoi(value3Raw)
+			value3, conversionErr := parseIntegerParam[int](value3Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4744,22 +9418,19 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value3",
-						"int",
-						reflect.TypeOf(value3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value3",
+					"query",
+					"/value3",
+					"int",
+					reflect.TypeOf(value3Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value3 := int(value3Uint64)
 			value3RawPtr = &value3
 		}
 		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
@@ -4773,15 +9444,17 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value3"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value3", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "TestPrimitiveConversions")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value4RawPtr *float64 = nil
 		value4Raw := echoCtx.QueryParam("value4")
 		isvalue4Exists := echoCtx.Request().URL.Query().Has("value4")
 		if isvalue4Exists {
-			value4Float64, conversionErr := strconv.ParseFloat(value4Raw, 64)
+			value4, conversionErr := parseFloatParam[float64](value4Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4792,22 +9465,19 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value4",
-						"float64",
-						reflect.TypeOf(value4Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value4",
+					"query",
+					"/value4",
+					"float64",
+					reflect.TypeOf(value4Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value4 := float64(value4Float64)
 			value4RawPtr = &value4
 		}
 		if validatorErr := validatorInstance.Var(value4RawPtr, "required"); validatorErr != nil {
@@ -4821,10 +9491,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value4"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value4", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "TestPrimitiveConversions")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -4835,13 +9508,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "TestPrimitiveConversions")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "TestPrimitiveConversions")
+			}
+		}()
 		value, opError := controller.TestPrimitiveConversions(*value1RawPtr, *value2RawPtr, *value3RawPtr, *value4RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "TestPrimitiveConversions")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4859,6 +9539,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TestPrimitiveConversions",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "TestPrimitiveConversions")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -4876,9 +9557,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "TestPrimitiveConversions")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/test-enums"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/test-primitive-conversions")), e2EcontrollerTestPrimitiveConversionsHandler)
+	e2EcontrollerTestEnumsErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsRoute := withBasePath(basePath, "/e2e/test-enums")
+	e2EcontrollerTestEnumsHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"TestEnums",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestEnumsRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "TestEnums")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -4898,17 +9593,18 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TestEnums")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw := echoCtx.QueryParam("value1")
 		isvalue1Exists := echoCtx.Request().URL.Query().Has("value1")
 		if isvalue1Exists {
 			value1 := value1Raw
 			switch value1Raw {
 			case "active", "inactive":
-				value1Var := Param12value1.StatusEnumeration(value1)
+				value1Var := Param15value1.StatusEnumeration(value1)
 				value1RawPtr = &value1Var
 			default:
 				conversionErr := fmt.Errorf("value1 must be one of \"active, inactive\" options only but got \"%s\"", value1Raw)
@@ -4921,20 +9617,18 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnums' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"StatusEnumeration",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnums",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value1",
+					"query",
+					"/value1",
+					"StatusEnumeration",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnums")
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -4948,15 +9642,17 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "TestEnums")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value2RawPtr *Param4value2.NumberEnumeration = nil
 		value2Raw := echoCtx.QueryParam("value2")
 		isvalue2Exists := echoCtx.Request().URL.Query().Has("value2")
 		if isvalue2Exists {
-			value2Uint64, conversionErr := strconv.Atoi(value2Raw)
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4967,22 +9663,19 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnums' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnums",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value2",
+					"query",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnums")
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value2 := int(value2Uint64)
 			switch value2Raw {
 			case "1", "2":
 				value2Var := Param4value2.NumberEnumeration(value2)
@@ -4998,20 +9691,18 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnums' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnums",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value2",
+					"query",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnums")
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
 		}
 		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
@@ -5025,11 +9716,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "TestEnums")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var value3RawPtr *Param11value3.ObjectWithEnum = nil
+		var value3RawPtr *Param14value3.ObjectWithEnum = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &value3RawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5041,20 +9734,27 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'TestEnums' but body parameter '%s' did not pass validation of '%s' - %s",
-					"value3",
-					"ObjectWithEnum",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "TestEnums",
+						"parameter": "value3",
+						"type":      "ObjectWithEnum",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/TestEnums",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "TestEnums")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -5065,13 +9765,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "TestEnums")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "TestEnums")
+			}
+		}()
 		value, opError := controller.TestEnums(*value1RawPtr, *value2RawPtr, *value3RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "TestEnums")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5089,6 +9796,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TestEnums",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "TestEnums")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -5128,9 +9836,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "TestEnums")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/test-enums-in-all/{value1}"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/test-enums")), e2EcontrollerTestEnumsHandler)
+	e2EcontrollerTestEnumsInAllErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsInAllRoute := withBasePath(basePath, "/e2e/test-enums-in-all/{value1}")
+	e2EcontrollerTestEnumsInAllHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"TestEnumsInAll",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestEnumsInAllRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "TestEnumsInAll")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -5150,16 +9872,17 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TestEnumsInAll")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		telemetry.startPhase("validation")
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw := echoCtx.Param("value1")
 		isvalue1Exists := true // if parameter is in route but not provided, it won't reach this handler
 		if isvalue1Exists {
 			value1 := value1Raw
 			switch value1Raw {
 			case "active", "inactive":
-				value1Var := Param12value1.StatusEnumeration(value1)
+				value1Var := Param15value1.StatusEnumeration(value1)
 				value1RawPtr = &value1Var
 			default:
 				conversionErr := fmt.Errorf("value1 must be one of \"active, inactive\" options only but got \"%s\"", value1Raw)
@@ -5172,20 +9895,18 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"StatusEnumeration",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value1",
+					"path",
+					"/value1",
+					"StatusEnumeration",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -5199,9 +9920,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/value1", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "TestEnumsInAll")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value2RawPtr *Param4value2.NumberEnumeration = nil
 		value2Raw := echoCtx.Request().Header.Get("value2")
@@ -5212,7 +9935,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			isvalue2Exists = len(headerValues) > 0
 		}
 		if isvalue2Exists {
-			value2Uint64, conversionErr := strconv.Atoi(value2Raw)
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -5223,22 +9946,19 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value2",
+					"header",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value2 := int(value2Uint64)
 			switch value2Raw {
 			case "1", "2":
 				value2Var := Param4value2.NumberEnumeration(value2)
@@ -5254,20 +9974,18 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value2",
+					"header",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
 		}
 		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
@@ -5281,12 +9999,14 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/value2", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "TestEnumsInAll")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		echoCtx.Request().ParseForm()
-		var value3RawPtr *Param12value3.StatusEnumeration = nil
+		var value3RawPtr *Param15value3.StatusEnumeration = nil
 		value3RawArr, isvalue3Exists := echoCtx.Request().PostForm["value3"]
 		value3Raw := ""
 		if isvalue3Exists {
@@ -5296,7 +10016,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			value3 := value3Raw
 			switch value3Raw {
 			case "active", "inactive":
-				value3Var := Param12value3.StatusEnumeration(value3)
+				value3Var := Param15value3.StatusEnumeration(value3)
 				value3RawPtr = &value3Var
 			default:
 				conversionErr := fmt.Errorf("value3 must be one of \"active, inactive\" options only but got \"%s\"", value3Raw)
@@ -5309,20 +10029,18 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value3",
-						"StatusEnumeration",
-						reflect.TypeOf(value3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value3",
+					"form",
+					"/value3",
+					"StatusEnumeration",
+					reflect.TypeOf(value3Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
 		}
 		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
@@ -5336,10 +10054,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value3"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/value3", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "TestEnumsInAll")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -5350,13 +10071,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "TestEnumsInAll")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "TestEnumsInAll")
+			}
+		}()
 		value, opError := controller.TestEnumsInAll(*value1RawPtr, *value2RawPtr, *value3RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "TestEnumsInAll")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5374,6 +10102,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TestEnumsInAll",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "TestEnumsInAll")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -5391,9 +10120,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "TestEnumsInAll")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/test-enums-optional"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/test-enums-in-all/{value1}")), e2EcontrollerTestEnumsInAllHandler)
+	e2EcontrollerTestEnumsOptionalErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsOptionalRoute := withBasePath(basePath, "/e2e/test-enums-optional")
+	e2EcontrollerTestEnumsOptionalHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"TestEnumsOptional",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestEnumsOptionalRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "TestEnumsOptional")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -5413,9 +10156,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "TestEnumsOptional")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		telemetry.startPhase("validation")
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw := echoCtx.Request().Header.Get("value1")
 		_, isvalue1Exists := echoCtx.Request().Header["value1"]
 		if !isvalue1Exists {
@@ -5427,7 +10171,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			value1 := value1Raw
 			switch value1Raw {
 			case "active", "inactive":
-				value1Var := Param12value1.StatusEnumeration(value1)
+				value1Var := Param15value1.StatusEnumeration(value1)
 				value1RawPtr = &value1Var
 			default:
 				conversionErr := fmt.Errorf("value1 must be one of \"active, inactive\" options only but got \"%s\"", value1Raw)
@@ -5440,22 +10184,21 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsOptional' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"StatusEnumeration",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsOptional",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsOptional",
+					"value1",
+					"header",
+					"/value1",
+					"StatusEnumeration",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnumsOptional")
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -5466,13 +10209,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "TestEnumsOptional")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "TestEnumsOptional")
+			}
+		}()
 		value, opError := controller.TestEnumsOptional(value1RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "TestEnumsOptional")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5490,6 +10240,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/TestEnumsOptional",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "TestEnumsOptional")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -5507,9 +10258,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "TestEnumsOptional")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/external-packages"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/test-enums-optional")), e2EcontrollerTestEnumsOptionalHandler)
+	e2EcontrollerExternalPackagesErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesRoute := withBasePath(basePath, "/e2e/external-packages")
+	e2EcontrollerExternalPackagesHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"ExternalPackages",
+			"E2EController",
+			"POST",
+			e2EcontrollerExternalPackagesRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "ExternalPackages")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -5529,17 +10294,18 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ExternalPackages")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var unitRawPtr *Param14unit.LengthUnits = nil
+		var unitRawPtr *Param17unit.LengthUnits = nil
 		unitRaw := echoCtx.QueryParam("unit")
 		isunitExists := echoCtx.Request().URL.Query().Has("unit")
 		if isunitExists {
 			unit := unitRaw
 			switch unitRaw {
 			case "Angstrom", "AstronomicalUnit", "Centimeter", "Chain", "DataMile", "Decameter", "Decimeter", "DtpPica", "DtpPoint", "Fathom", "Femtometer", "Foot", "Gigameter", "Hand", "Hectometer", "Inch", "Kilofoot", "KilolightYear", "Kilometer", "Kiloparsec", "Kiloyard", "LightYear", "MegalightYear", "Megameter", "Megaparsec", "Meter", "Microinch", "Micrometer", "Mil", "Mile", "Millimeter", "Nanometer", "NauticalMile", "Parsec", "Picometer", "PrinterPica", "PrinterPoint", "Shackle", "SolarRadius", "Twip", "UsSurveyFoot", "Yard":
-				unitVar := Param14unit.LengthUnits(unit)
+				unitVar := Param17unit.LengthUnits(unit)
 				unitRawPtr = &unitVar
 			default:
 				conversionErr := fmt.Errorf("unit must be one of \"Angstrom, AstronomicalUnit, Centimeter, Chain, DataMile, Decameter, Decimeter, DtpPica, DtpPoint, Fathom, Femtometer, Foot, Gigameter, Hand, Hectometer, Inch, Kilofoot, KilolightYear, Kilometer, Kiloparsec, Kiloyard, LightYear, MegalightYear, Megameter, Megaparsec, Meter, Microinch, Micrometer, Mil, Mile, Millimeter, Nanometer, NauticalMile, Parsec, Picometer, PrinterPica, PrinterPoint, Shackle, SolarRadius, Twip, UsSurveyFoot, Yard\" options only but got \"%s\"", unitRaw)
@@ -5552,23 +10318,21 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'ExternalPackages' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"unit",
-						"LengthUnits",
-						reflect.TypeOf(unitRaw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/ExternalPackages",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"ExternalPackages",
+					"unit",
+					"query",
+					"/unit",
+					"LengthUnits",
+					reflect.TypeOf(unitRaw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "ExternalPackages")
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
 		}
-		var dataRawPtr *Param13data.LengthDto = nil
+		var dataRawPtr *Param16data.LengthDto = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5580,20 +10344,27 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackages' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"LengthDto",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackages",
+						"parameter": "data",
+						"type":      "LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackages",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "ExternalPackages")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -5604,13 +10375,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "ExternalPackages")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "ExternalPackages")
+			}
+		}()
 		value, opError := controller.ExternalPackages(unitRawPtr, *dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "ExternalPackages")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5628,6 +10406,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ExternalPackages",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "ExternalPackages")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -5667,9 +10446,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "ExternalPackages")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/external-packages-unique-in-struct"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/external-packages")), e2EcontrollerExternalPackagesHandler)
+	e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesUniqueInStructRoute := withBasePath(basePath, "/e2e/external-packages-unique-in-struct")
+	e2EcontrollerExternalPackagesUniqueInStructHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"ExternalPackagesUniqueInStruct",
+			"E2EController",
+			"POST",
+			e2EcontrollerExternalPackagesUniqueInStructRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "ExternalPackagesUniqueInStruct")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -5689,10 +10482,11 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ExternalPackagesUniqueInStruct")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *Param15data.UniqueExternalUsage = nil
+		var dataRawPtr *Param18data.UniqueExternalUsage = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5704,20 +10498,27 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackagesUniqueInStruct' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"UniqueExternalUsage",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesUniqueInStruct",
+						"parameter": "data",
+						"type":      "UniqueExternalUsage",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackagesUniqueInStruct",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "ExternalPackagesUniqueInStruct")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -5728,13 +10529,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "ExternalPackagesUniqueInStruct")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "ExternalPackagesUniqueInStruct")
+			}
+		}()
 		value, opError := controller.ExternalPackagesUniqueInStruct(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "ExternalPackagesUniqueInStruct")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5752,6 +10560,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ExternalPackagesUniqueInStruct",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "ExternalPackagesUniqueInStruct")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -5769,9 +10578,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "ExternalPackagesUniqueInStruct")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/external-packages-validation"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/external-packages-unique-in-struct")), e2EcontrollerExternalPackagesUniqueInStructHandler)
+	e2EcontrollerExternalPackagesValidationErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesValidationRoute := withBasePath(basePath, "/e2e/external-packages-validation")
+	e2EcontrollerExternalPackagesValidationHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"ExternalPackagesValidation",
+			"E2EController",
+			"POST",
+			e2EcontrollerExternalPackagesValidationRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "ExternalPackagesValidation")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -5791,17 +10614,18 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ExternalPackagesValidation")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var unitRawPtr *Param14unit.LengthUnits = nil
+		var unitRawPtr *Param17unit.LengthUnits = nil
 		unitRaw := echoCtx.QueryParam("unit")
 		isunitExists := echoCtx.Request().URL.Query().Has("unit")
 		if isunitExists {
 			unit := unitRaw
 			switch unitRaw {
 			case "Angstrom", "AstronomicalUnit", "Centimeter", "Chain", "DataMile", "Decameter", "Decimeter", "DtpPica", "DtpPoint", "Fathom", "Femtometer", "Foot", "Gigameter", "Hand", "Hectometer", "Inch", "Kilofoot", "KilolightYear", "Kilometer", "Kiloparsec", "Kiloyard", "LightYear", "MegalightYear", "Megameter", "Megaparsec", "Meter", "Microinch", "Micrometer", "Mil", "Mile", "Millimeter", "Nanometer", "NauticalMile", "Parsec", "Picometer", "PrinterPica", "PrinterPoint", "Shackle", "SolarRadius", "Twip", "UsSurveyFoot", "Yard":
-				unitVar := Param14unit.LengthUnits(unit)
+				unitVar := Param17unit.LengthUnits(unit)
 				unitRawPtr = &unitVar
 			default:
 				conversionErr := fmt.Errorf("unit must be one of \"Angstrom, AstronomicalUnit, Centimeter, Chain, DataMile, Decameter, Decimeter, DtpPica, DtpPoint, Fathom, Femtometer, Foot, Gigameter, Hand, Hectometer, Inch, Kilofoot, KilolightYear, Kilometer, Kiloparsec, Kiloyard, LightYear, MegalightYear, Megameter, Megaparsec, Meter, Microinch, Micrometer, Mil, Mile, Millimeter, Nanometer, NauticalMile, Parsec, Picometer, PrinterPica, PrinterPoint, Shackle, SolarRadius, Twip, UsSurveyFoot, Yard\" options only but got \"%s\"", unitRaw)
@@ -5814,23 +10638,21 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'ExternalPackagesValidation' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"unit",
-						"LengthUnits",
-						reflect.TypeOf(unitRaw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/ExternalPackagesValidation",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"ExternalPackagesValidation",
+					"unit",
+					"query",
+					"/unit",
+					"LengthUnits",
+					reflect.TypeOf(unitRaw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "ExternalPackagesValidation")
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
 		}
-		var dataRawPtr *Param16data.LengthDtoWithValidation = nil
+		var dataRawPtr *Param19data.LengthDtoWithValidation = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5842,20 +10664,27 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackagesValidation' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"LengthDtoWithValidation",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesValidation",
+						"parameter": "data",
+						"type":      "LengthDtoWithValidation",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackagesValidation",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "ExternalPackagesValidation")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -5866,13 +10695,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "ExternalPackagesValidation")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "ExternalPackagesValidation")
+			}
+		}()
 		value, opError := controller.ExternalPackagesValidation(unitRawPtr, *dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "ExternalPackagesValidation")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5890,6 +10726,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ExternalPackagesValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "ExternalPackagesValidation")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -5929,9 +10766,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "ExternalPackagesValidation")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/arrays-in-body-and-res"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/external-packages-validation")), e2EcontrollerExternalPackagesValidationHandler)
+	e2EcontrollerArraysInBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInBodyAndResRoute := withBasePath(basePath, "/e2e/arrays-in-body-and-res")
+	e2EcontrollerArraysInBodyAndResHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"ArraysInBodyAndRes",
+			"E2EController",
+			"POST",
+			e2EcontrollerArraysInBodyAndResRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "ArraysInBodyAndRes")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -5951,10 +10802,11 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ArraysInBodyAndRes")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *[]Param13data.LengthDto = nil
+		var dataRawPtr *[]Param16data.LengthDto = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5966,20 +10818,27 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ArraysInBodyAndRes' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[]LengthDto",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInBodyAndRes",
+						"parameter": "data",
+						"type":      "[]LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ArraysInBodyAndRes",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "ArraysInBodyAndRes")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -5990,13 +10849,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "ArraysInBodyAndRes")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "ArraysInBodyAndRes")
+			}
+		}()
 		value, opError := controller.ArraysInBodyAndRes(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "ArraysInBodyAndRes")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6014,6 +10880,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ArraysInBodyAndRes",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "ArraysInBodyAndRes")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -6053,9 +10920,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "ArraysInBodyAndRes")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/arrays-inside-body-and-res"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/arrays-in-body-and-res")), e2EcontrollerArraysInBodyAndResHandler)
+	e2EcontrollerArraysInsideBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInsideBodyAndResRoute := withBasePath(basePath, "/e2e/arrays-inside-body-and-res")
+	e2EcontrollerArraysInsideBodyAndResHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"ArraysInsideBodyAndRes",
+			"E2EController",
+			"POST",
+			e2EcontrollerArraysInsideBodyAndResRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "ArraysInsideBodyAndRes")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -6075,10 +10956,11 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ArraysInsideBodyAndRes")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *[]Param17data.BlaBla = nil
+		var dataRawPtr *[]Param20data.BlaBla = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6090,20 +10972,27 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ArraysInsideBodyAndRes' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[]BlaBla",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInsideBodyAndRes",
+						"parameter": "data",
+						"type":      "[]BlaBla",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ArraysInsideBodyAndRes",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "ArraysInsideBodyAndRes")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -6114,13 +11003,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "ArraysInsideBodyAndRes")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "ArraysInsideBodyAndRes")
+			}
+		}()
 		value, opError := controller.ArraysInsideBodyAndRes(dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "ArraysInsideBodyAndRes")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6138,6 +11034,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ArraysInsideBodyAndRes",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "ArraysInsideBodyAndRes")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -6181,9 +11078,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "ArraysInsideBodyAndRes")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/deep-arrays-with-validation"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/arrays-inside-body-and-res")), e2EcontrollerArraysInsideBodyAndResHandler)
+	e2EcontrollerDeepArraysWithValidationErrorStatusCodes := []int{500}
+	e2EcontrollerDeepArraysWithValidationRoute := withBasePath(basePath, "/e2e/deep-arrays-with-validation")
+	e2EcontrollerDeepArraysWithValidationHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"DeepArraysWithValidation",
+			"E2EController",
+			"POST",
+			e2EcontrollerDeepArraysWithValidationRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "DeepArraysWithValidation")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -6203,10 +11114,11 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "DeepArraysWithValidation")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *[][]Param18data.BlaBla2 = nil
+		var dataRawPtr *[][]Param21data.BlaBla2 = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6218,20 +11130,27 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'DeepArraysWithValidation' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[][]BlaBla2",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "DeepArraysWithValidation",
+						"parameter": "data",
+						"type":      "[][]BlaBla2",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/DeepArraysWithValidation",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "DeepArraysWithValidation")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -6242,13 +11161,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "DeepArraysWithValidation")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "DeepArraysWithValidation")
+			}
+		}()
 		value, opError := controller.DeepArraysWithValidation(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "DeepArraysWithValidation")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6266,6 +11192,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/DeepArraysWithValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "DeepArraysWithValidation")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -6305,9 +11232,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "DeepArraysWithValidation")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/embedded-structs"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/deep-arrays-with-validation")), e2EcontrollerDeepArraysWithValidationHandler)
+	e2EcontrollerEmbeddedStructsErrorStatusCodes := []int{500}
+	e2EcontrollerEmbeddedStructsRoute := withBasePath(basePath, "/e2e/embedded-structs")
+	e2EcontrollerEmbeddedStructsHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"EmbeddedStructs",
+			"E2EController",
+			"POST",
+			e2EcontrollerEmbeddedStructsRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "EmbeddedStructs")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -6327,10 +11268,11 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "EmbeddedStructs")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *Param19data.TheModel = nil
+		var dataRawPtr *Param22data.TheModel = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6342,20 +11284,27 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'EmbeddedStructs' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModel",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "EmbeddedStructs",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/EmbeddedStructs",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "EmbeddedStructs")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -6366,13 +11315,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "EmbeddedStructs")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "EmbeddedStructs")
+			}
+		}()
 		value, opError := controller.EmbeddedStructs(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "EmbeddedStructs")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6390,6 +11346,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/EmbeddedStructs",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "EmbeddedStructs")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -6429,9 +11386,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "EmbeddedStructs")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/structs-with-inner-pointer"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/embedded-structs")), e2EcontrollerEmbeddedStructsHandler)
+	e2EcontrollerStructsWithInnerPointerErrorStatusCodes := []int{500}
+	e2EcontrollerStructsWithInnerPointerRoute := withBasePath(basePath, "/e2e/structs-with-inner-pointer")
+	e2EcontrollerStructsWithInnerPointerHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"StructsWithInnerPointer",
+			"E2EController",
+			"POST",
+			e2EcontrollerStructsWithInnerPointerRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "StructsWithInnerPointer")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -6451,10 +11422,11 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "StructsWithInnerPointer")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *Param20data.TheModelWithInnerPointer = nil
+		var dataRawPtr *Param23data.TheModelWithInnerPointer = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6466,20 +11438,27 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'StructsWithInnerPointer' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModelWithInnerPointer",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "StructsWithInnerPointer",
+						"parameter": "data",
+						"type":      "TheModelWithInnerPointer",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/StructsWithInnerPointer",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "StructsWithInnerPointer")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -6490,13 +11469,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "StructsWithInnerPointer")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "StructsWithInnerPointer")
+			}
+		}()
 		value, opError := controller.StructsWithInnerPointer(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "StructsWithInnerPointer")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6514,6 +11500,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/StructsWithInnerPointer",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "StructsWithInnerPointer")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -6531,9 +11518,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "StructsWithInnerPointer")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/context-injection-empty"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/structs-with-inner-pointer")), e2EcontrollerStructsWithInnerPointerHandler)
+	e2EcontrollerContextInjectionEmptyErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionEmptyRoute := withBasePath(basePath, "/e2e/context-injection-empty")
+	e2EcontrollerContextInjectionEmptyHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"ContextInjectionEmpty",
+			"E2EController",
+			"POST",
+			e2EcontrollerContextInjectionEmptyRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "ContextInjectionEmpty")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -6553,8 +11554,10 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ContextInjectionEmpty")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -6565,13 +11568,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "ContextInjectionEmpty")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "ContextInjectionEmpty")
+			}
+		}()
 		opError := controller.ContextInjectionEmpty(getRequestContext(echoCtx))
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "ContextInjectionEmpty")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6589,6 +11599,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ContextInjectionEmpty",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "ContextInjectionEmpty")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -6606,9 +11617,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "ContextInjectionEmpty")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/context-injection"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/context-injection-empty")), e2EcontrollerContextInjectionEmptyHandler)
+	e2EcontrollerContextInjectionErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionRoute := withBasePath(basePath, "/e2e/context-injection")
+	e2EcontrollerContextInjectionHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"ContextInjection",
+			"E2EController",
+			"POST",
+			e2EcontrollerContextInjectionRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "ContextInjection")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -6628,10 +11653,11 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ContextInjection")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *Param19data.TheModel = nil
+		var dataRawPtr *Param22data.TheModel = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6643,20 +11669,27 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ContextInjection' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModel",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ContextInjection",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ContextInjection",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "ContextInjection")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -6667,13 +11700,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "ContextInjection")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "ContextInjection")
+			}
+		}()
 		opError := controller.ContextInjection(getRequestContext(echoCtx), *dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "ContextInjection")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6691,6 +11731,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ContextInjection",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "ContextInjection")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -6708,9 +11749,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.Response().WriteHeader(statusCode)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "ContextInjection")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/byte-slice"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/context-injection")), e2EcontrollerContextInjectionHandler)
+	e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithByteSliceRoute := withBasePath(basePath, "/e2e/byte-slice")
+	e2EcontrollerReturnsStructWithByteSliceHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"ReturnsStructWithByteSlice",
+			"E2EController",
+			"POST",
+			e2EcontrollerReturnsStructWithByteSliceRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "ReturnsStructWithByteSlice")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -6730,10 +11785,11 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ReturnsStructWithByteSlice")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var arriveRawPtr *Param22arrive.ObjectWithByteSlice = nil
+		var arriveRawPtr *Param8arrive.ObjectWithByteSlice = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "", &arriveRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6745,20 +11801,27 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ReturnsStructWithByteSlice' but body parameter '%s' did not pass validation of '%s' - %s",
-					"arrive",
-					"ObjectWithByteSlice",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithByteSlice",
+						"parameter": "arrive",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ReturnsStructWithByteSlice",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "ReturnsStructWithByteSlice")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -6769,13 +11832,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "ReturnsStructWithByteSlice")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "ReturnsStructWithByteSlice")
+			}
+		}()
 		value, opError := controller.ReturnsStructWithByteSlice(arriveRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "ReturnsStructWithByteSlice")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6793,6 +11863,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ReturnsStructWithByteSlice",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "ReturnsStructWithByteSlice")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -6832,9 +11903,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "ReturnsStructWithByteSlice")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/special-primitives"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/byte-slice")), e2EcontrollerReturnsStructWithByteSliceHandler)
+	e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithSpecialPrimitivesRoute := withBasePath(basePath, "/e2e/special-primitives")
+	e2EcontrollerReturnsStructWithSpecialPrimitivesHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"ReturnsStructWithSpecialPrimitives",
+			"E2EController",
+			"POST",
+			e2EcontrollerReturnsStructWithSpecialPrimitivesRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "ReturnsStructWithSpecialPrimitives")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -6854,10 +11939,11 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "ReturnsStructWithSpecialPrimitives")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var arriveRawPtr *Param23arrive.ObjectWithSpecialPrimitives = nil
+		var arriveRawPtr *Param24arrive.ObjectWithSpecialPrimitives = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "", &arriveRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6869,20 +11955,27 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ReturnsStructWithSpecialPrimitives' but body parameter '%s' did not pass validation of '%s' - %s",
-					"arrive",
-					"ObjectWithSpecialPrimitives",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithSpecialPrimitives",
+						"parameter": "arrive",
+						"type":      "ObjectWithSpecialPrimitives",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ReturnsStructWithSpecialPrimitives",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "ReturnsStructWithSpecialPrimitives")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -6893,13 +11986,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "ReturnsStructWithSpecialPrimitives")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "ReturnsStructWithSpecialPrimitives")
+			}
+		}()
 		value, opError := controller.ReturnsStructWithSpecialPrimitives(arriveRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "ReturnsStructWithSpecialPrimitives")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6917,6 +12017,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/ReturnsStructWithSpecialPrimitives",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "ReturnsStructWithSpecialPrimitives")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -6956,9 +12057,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "ReturnsStructWithSpecialPrimitives")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/alias-of-primitive"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/special-primitives")), e2EcontrollerReturnsStructWithSpecialPrimitivesHandler)
+	e2EcontrollerAliasOfStringErrorStatusCodes := []int{}
+	e2EcontrollerAliasOfStringRoute := withBasePath(basePath, "/e2e/alias-of-primitive")
+	e2EcontrollerAliasOfStringHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"AliasOfString",
+			"E2EController",
+			"POST",
+			e2EcontrollerAliasOfStringRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "AliasOfString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -6978,10 +12093,11 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "AliasOfString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var objectRawPtr *Param24object.ObjectWithAliasOfString = nil
+		var objectRawPtr *Param25object.ObjectWithAliasOfString = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &objectRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6993,25 +12109,31 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'AliasOfString' but body parameter '%s' did not pass validation of '%s' - %s",
-					"object",
-					"ObjectWithAliasOfString",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "AliasOfString",
+						"parameter": "object",
+						"type":      "ObjectWithAliasOfString",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/AliasOfString",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "AliasOfString")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var numRawPtr *Param25num.AliasOfInt = nil
+		var numRawPtr *Param26num.AliasOfInt = nil
 		numRaw := echoCtx.QueryParam("num")
 		isnumExists := echoCtx.Request().URL.Query().Has("num")
 		if isnumExists {
-			numUint64, conversionErr := strconv.Atoi(numRaw)
+			num, conversionErr := parseIntegerParam[int](numRaw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -7022,23 +12144,20 @@ func RegisterRoutes(engine *echo.Echo) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'AliasOfString' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"num",
-						"AliasOfInt",
-						reflect.TypeOf(numRaw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/AliasOfString",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"AliasOfString",
+					"num",
+					"query",
+					"/num",
+					"AliasOfInt",
+					reflect.TypeOf(numRaw).String(),
+					getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+				)
 				echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "AliasOfString")
-				return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			num := int(numUint64)
-			numVar := Param25num.AliasOfInt(num)
+			numVar := Param26num.AliasOfInt(num)
 			numRawPtr = &numVar
 		}
 		if validatorErr := validatorInstance.Var(numRawPtr, "required"); validatorErr != nil {
@@ -7052,16 +12171,18 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "num"
-			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/num", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "AliasOfString")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var strRawPtr *Param26str.AliasOfDirectString = nil
+		var strRawPtr *Param27str.AliasOfDirectString = nil
 		strRaw := echoCtx.QueryParam("str")
 		isstrExists := echoCtx.Request().URL.Query().Has("str")
 		if isstrExists {
 			str := strRaw
-			strVar := Param26str.AliasOfDirectString(str)
+			strVar := Param27str.AliasOfDirectString(str)
 			strRawPtr = &strVar
 		}
 		if validatorErr := validatorInstance.Var(strRawPtr, "required"); validatorErr != nil {
@@ -7075,10 +12196,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "str"
-			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/str", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "AliasOfString")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -7089,13 +12213,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "AliasOfString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "AliasOfString")
+			}
+		}()
 		value, opError := controller.AliasOfString(objectRawPtr, *numRawPtr, *strRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "AliasOfString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7113,6 +12244,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/AliasOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "AliasOfString")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -7156,9 +12288,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "AliasOfString")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/body-array-of-string"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/alias-of-primitive")), e2EcontrollerAliasOfStringHandler)
+	e2EcontrollerBodyArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringRoute := withBasePath(basePath, "/e2e/body-array-of-string")
+	e2EcontrollerBodyArrayOfStringHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"BodyArrayOfString",
+			"E2EController",
+			"POST",
+			e2EcontrollerBodyArrayOfStringRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "BodyArrayOfString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -7178,8 +12324,9 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "BodyArrayOfString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
 		var valuesRawPtr *[]string = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &valuesRawPtr)
@@ -7193,20 +12340,27 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'BodyArrayOfString' but body parameter '%s' did not pass validation of '%s' - %s",
-					"values",
-					"[]string",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfString",
+						"parameter": "values",
+						"type":      "[]string",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/BodyArrayOfString",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "BodyArrayOfString")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -7217,13 +12371,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "BodyArrayOfString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "BodyArrayOfString")
+			}
+		}()
 		value, opError := controller.BodyArrayOfString(*valuesRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "BodyArrayOfString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7241,6 +12402,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/BodyArrayOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "BodyArrayOfString")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -7258,9 +12420,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "BodyArrayOfString")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/body-array-of-enum-string"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/body-array-of-string")), e2EcontrollerBodyArrayOfStringHandler)
+	e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringEnumRoute := withBasePath(basePath, "/e2e/body-array-of-enum-string")
+	e2EcontrollerBodyArrayOfStringEnumHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"BodyArrayOfStringEnum",
+			"E2EController",
+			"POST",
+			e2EcontrollerBodyArrayOfStringEnumRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "BodyArrayOfStringEnum")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -7280,10 +12456,11 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "BodyArrayOfStringEnum")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var valuesRawPtr *[]Param27values.Myemamium = nil
+		var valuesRawPtr *[]Param28values.Myemamium = nil
 		conversionErr = bindAndValidateBody(echoCtx, "application/json", "required", &valuesRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -7295,20 +12472,27 @@ func RegisterRoutes(engine *echo.Echo) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'BodyArrayOfStringEnum' but body parameter '%s' did not pass validation of '%s' - %s",
-					"values",
-					"[]Myemamium",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfStringEnum",
+						"parameter": "values",
+						"type":      "[]Myemamium",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/BodyArrayOfStringEnum",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			echoCtx.Response().Header().Set("x-JsonBodyValidationErrorResponseExtension", "BodyArrayOfStringEnum")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -7319,13 +12503,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "BodyArrayOfStringEnum")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "BodyArrayOfStringEnum")
+			}
+		}()
 		value, opError := controller.BodyArrayOfStringEnum(*valuesRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "BodyArrayOfStringEnum")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7343,6 +12534,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/BodyArrayOfStringEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "BodyArrayOfStringEnum")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -7360,9 +12552,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "BodyArrayOfStringEnum")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/query-array-of-string"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/body-array-of-enum-string")), e2EcontrollerBodyArrayOfStringEnumHandler)
+	e2EcontrollerQueryArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfStringRoute := withBasePath(basePath, "/e2e/query-array-of-string")
+	e2EcontrollerQueryArrayOfStringHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"QueryArrayOfString",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfStringRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "QueryArrayOfString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -7382,8 +12588,9 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "QueryArrayOfString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var valuesRawPtr *[]string = nil
 		valuesRawArray := echoCtx.QueryParams()["values"]
 		isvaluesExists := echoCtx.Request().URL.Query().Has("values")
@@ -7407,10 +12614,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "QueryArrayOfString")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -7421,13 +12631,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "QueryArrayOfString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "QueryArrayOfString")
+			}
+		}()
 		value, opError := controller.QueryArrayOfString(*valuesRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "QueryArrayOfString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7445,6 +12662,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/QueryArrayOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "QueryArrayOfString")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -7462,9 +12680,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "QueryArrayOfString")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/query-array-of-enum"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/query-array-of-string")), e2EcontrollerQueryArrayOfStringHandler)
+	e2EcontrollerQueryArrayOfEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfEnumRoute := withBasePath(basePath, "/e2e/query-array-of-enum")
+	e2EcontrollerQueryArrayOfEnumHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"QueryArrayOfEnum",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfEnumRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "QueryArrayOfEnum")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -7484,13 +12716,14 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "QueryArrayOfEnum")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
-		var valuesRawPtr *[]Param27values.Myemamium = nil
+		telemetry.startPhase("validation")
+		var valuesRawPtr *[]Param28values.Myemamium = nil
 		valuesRawArray := echoCtx.QueryParams()["values"]
 		isvaluesExists := echoCtx.Request().URL.Query().Has("values")
 		if isvaluesExists {
-			values := make([]Param27values.Myemamium, 0, len(valuesRawArray))
+			values := make([]Param28values.Myemamium, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
 				valuesItem := valuesRaw
 				switch valuesRaw {
@@ -7507,22 +12740,20 @@ func RegisterRoutes(engine *echo.Echo) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]Myemamium",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfEnum",
+						"values",
+						"query",
+						"/values",
+						"[]Myemamium",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+					)
 					echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfEnum")
-					return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				values = append(values, Param27values.Myemamium(valuesItem))
+				values = append(values, Param28values.Myemamium(valuesItem))
 			}
 			valuesRawPtr = &values
 		}
@@ -7537,21 +12768,23 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "QueryArrayOfEnum")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var values2RawPtr *[]Param28values2.MyaliasString = nil
+		var values2RawPtr *[]Param29values2.MyaliasString = nil
 		values2RawArray := echoCtx.QueryParams()["values2"]
 		isvalues2Exists := echoCtx.Request().URL.Query().Has("values2")
 		if isvalues2Exists {
-			values2 := make([]Param28values2.MyaliasString, 0, len(values2RawArray))
+			values2 := make([]Param29values2.MyaliasString, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
 				values2Item := values2Raw
-				values2 = append(values2, Param28values2.MyaliasString(values2Item))
+				values2 = append(values2, Param29values2.MyaliasString(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param28values2.MyaliasString(values2)
+			values2Var := []Param29values2.MyaliasString(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -7565,10 +12798,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "QueryArrayOfEnum")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -7579,13 +12815,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "QueryArrayOfEnum")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "QueryArrayOfEnum")
+			}
+		}()
 		value, opError := controller.QueryArrayOfEnum(*valuesRawPtr, *values2RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "QueryArrayOfEnum")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7603,6 +12846,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/QueryArrayOfEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "QueryArrayOfEnum")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -7620,9 +12864,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "QueryArrayOfEnum")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/query-array-of-others"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/query-array-of-enum")), e2EcontrollerQueryArrayOfEnumHandler)
+	e2EcontrollerQueryArrayOfOthersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersRoute := withBasePath(basePath, "/e2e/query-array-of-others")
+	e2EcontrollerQueryArrayOfOthersHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"QueryArrayOfOthers",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfOthersRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "QueryArrayOfOthers")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -7642,15 +12900,16 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "QueryArrayOfOthers")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var valuesRawPtr *[]int = nil
 		valuesRawArray := echoCtx.QueryParams()["values"]
 		isvaluesExists := echoCtx.Request().URL.Query().Has("values")
 		if isvaluesExists {
 			values := make([]int, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
-				valuesUint64, conversionErr := strconv.Atoi(valuesRaw)
+				valuesItem, conversionErr := parseIntegerParam[int](valuesRaw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7661,22 +12920,19 @@ func RegisterRoutes(engine *echo.Echo) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]int",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values",
+						"query",
+						"/values",
+						"[]int",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+					)
 					echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
-					return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				valuesItem := int(valuesUint64)
 				values = append(values, int(valuesItem))
 			}
 			valuesRawPtr = &values
@@ -7693,17 +12949,19 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "QueryArrayOfOthers")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var values2RawPtr *[]Param29values2.MyaliasInt = nil
+		var values2RawPtr *[]Param30values2.MyaliasInt = nil
 		values2RawArray := echoCtx.QueryParams()["values2"]
 		isvalues2Exists := echoCtx.Request().URL.Query().Has("values2")
 		if isvalues2Exists {
-			values2 := make([]Param29values2.MyaliasInt, 0, len(values2RawArray))
+			values2 := make([]Param30values2.MyaliasInt, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
-				values2Uint64, conversionErr := strconv.Atoi(values2Raw)
+				values2Item, conversionErr := parseIntegerParam[int](values2Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7714,26 +12972,23 @@ func RegisterRoutes(engine *echo.Echo) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values2",
-							"[]MyaliasInt",
-							reflect.TypeOf(values2Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values2",
+						"query",
+						"/values2",
+						"[]MyaliasInt",
+						reflect.TypeOf(values2Raw).String(),
+						getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+					)
 					echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
-					return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				values2Item := int(values2Uint64)
-				values2 = append(values2, Param29values2.MyaliasInt(values2Item))
+				values2 = append(values2, Param30values2.MyaliasInt(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param29values2.MyaliasInt(values2)
+			values2Var := []Param30values2.MyaliasInt(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -7747,9 +13002,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "QueryArrayOfOthers")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var values3RawPtr *[]bool = nil
 		values3RawArray := echoCtx.QueryParams()["values3"]
@@ -7757,7 +13014,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if isvalues3Exists {
 			values3 := make([]bool, 0, len(values3RawArray))
 			for _, values3Raw := range values3RawArray {
-				values3Bool, conversionErr := strconv.ParseBool(values3Raw)
+				values3Item, conversionErr := strconv.ParseBool(values3Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7768,22 +13025,19 @@ func RegisterRoutes(engine *echo.Echo) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values3",
-							"[]bool",
-							reflect.TypeOf(values3Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values3",
+						"query",
+						"/values3",
+						"[]bool",
+						reflect.TypeOf(values3Raw).String(),
+						getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+					)
 					echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
-					return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				values3Item := values3Bool
 				values3 = append(values3, bool(values3Item))
 			}
 			values3RawPtr = &values3
@@ -7800,9 +13054,11 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values3"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values3", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "QueryArrayOfOthers")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var values4RawPtr *[]int32 = nil
 		values4RawArray := echoCtx.QueryParams()["values4"]
@@ -7810,7 +13066,7 @@ func RegisterRoutes(engine *echo.Echo) {
 		if isvalues4Exists {
 			values4 := make([]int32, 0, len(values4RawArray))
 			for _, values4Raw := range values4RawArray {
-				values4Uint64, conversionErr := strconv.ParseInt(values4Raw, 10, 32)
+				values4Item, conversionErr := parseIntegerParam[int32](values4Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7821,22 +13077,19 @@ func RegisterRoutes(engine *echo.Echo) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values4",
-							"[]int32",
-							reflect.TypeOf(values4Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values4",
+						"query",
+						"/values4",
+						"[]int32",
+						reflect.TypeOf(values4Raw).String(),
+						getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+					)
 					echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
-					return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				values4Item := int32(values4Uint64)
 				values4 = append(values4, int32(values4Item))
 			}
 			values4RawPtr = &values4
@@ -7853,10 +13106,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values4"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values4", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "QueryArrayOfOthers")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -7867,13 +13123,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "QueryArrayOfOthers")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "QueryArrayOfOthers")
+			}
+		}()
 		value, opError := controller.QueryArrayOfOthers(*valuesRawPtr, *values2RawPtr, *values3RawPtr, *values4RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "QueryArrayOfOthers")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7891,6 +13154,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/QueryArrayOfOthers",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "QueryArrayOfOthers")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -7908,9 +13172,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "QueryArrayOfOthers")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/query-array-of-others-enum"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/query-array-of-others")), e2EcontrollerQueryArrayOfOthersHandler)
+	e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersEnumRoute := withBasePath(basePath, "/e2e/query-array-of-others-enum")
+	e2EcontrollerQueryArrayOfOthersEnumHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"QueryArrayOfOthersEnum",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfOthersEnumRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "QueryArrayOfOthersEnum")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -7930,15 +13208,16 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "QueryArrayOfOthersEnum")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
-		var valuesRawPtr *[]Param31values.NumberEnum = nil
+		telemetry.startPhase("validation")
+		var valuesRawPtr *[]Param32values.NumberEnum = nil
 		valuesRawArray := echoCtx.QueryParams()["values"]
 		isvaluesExists := echoCtx.Request().URL.Query().Has("values")
 		if isvaluesExists {
-			values := make([]Param31values.NumberEnum, 0, len(valuesRawArray))
+			values := make([]Param32values.NumberEnum, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
-				valuesUint64, conversionErr := strconv.ParseInt(valuesRaw, 10, 16)
+				valuesItem, conversionErr := parseIntegerParam[int16](valuesRaw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7949,22 +13228,19 @@ func RegisterRoutes(engine *echo.Echo) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthersEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]NumberEnum",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthersEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values",
+						"query",
+						"/values",
+						"[]NumberEnum",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+					)
 					echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthersEnum")
-					return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				valuesItem := int16(valuesUint64)
 				switch valuesRaw {
 				case "1", "2":
 					break
@@ -7979,22 +13255,20 @@ func RegisterRoutes(engine *echo.Echo) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthersEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]NumberEnum",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthersEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values",
+						"query",
+						"/values",
+						"[]NumberEnum",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+					)
 					echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthersEnum")
-					return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				values = append(values, Param31values.NumberEnum(valuesItem))
+				values = append(values, Param32values.NumberEnum(valuesItem))
 			}
 			valuesRawPtr = &values
 		}
@@ -8009,15 +13283,17 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "QueryArrayOfOthersEnum")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var values2RawPtr *[]Param32values2.BoolEnum = nil
+		var values2RawPtr *[]Param33values2.BoolEnum = nil
 		values2RawArray := echoCtx.QueryParams()["values2"]
 		isvalues2Exists := echoCtx.Request().URL.Query().Has("values2")
 		if isvalues2Exists {
-			values2 := make([]Param32values2.BoolEnum, 0, len(values2RawArray))
+			values2 := make([]Param33values2.BoolEnum, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
 				values2Item := values2Raw
 				switch values2Raw {
@@ -8034,22 +13310,20 @@ func RegisterRoutes(engine *echo.Echo) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthersEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values2",
-							"[]BoolEnum",
-							reflect.TypeOf(values2Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthersEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values2",
+						"query",
+						"/values2",
+						"[]BoolEnum",
+						reflect.TypeOf(values2Raw).String(),
+						getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language")),
+					)
 					echoCtx.Response().Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthersEnum")
-					return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				values2 = append(values2, Param32values2.BoolEnum(values2Item))
+				values2 = append(values2, Param33values2.BoolEnum(values2Item))
 			}
 			values2RawPtr = &values2
 		}
@@ -8064,10 +13338,13 @@ func RegisterRoutes(engine *echo.Echo) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName)
+			languages := getAcceptedLanguages(echoCtx.Request().Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			echoCtx.Response().Header().Set("x-RunValidatorExtension", "QueryArrayOfOthersEnum")
-			return echoCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			return echoCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -8078,13 +13355,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "QueryArrayOfOthersEnum")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "QueryArrayOfOthersEnum")
+			}
+		}()
 		value, opError := controller.QueryArrayOfOthersEnum(*valuesRawPtr, *values2RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "QueryArrayOfOthersEnum")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -8102,6 +13386,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/QueryArrayOfOthersEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "QueryArrayOfOthersEnum")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -8119,9 +13404,23 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "QueryArrayOfOthersEnum")
 		return nil
-	})
-	engine.POST(toEchoUrl("/e2e/query-pointer-to-array"), func(echoCtx echo.Context) error {
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/query-array-of-others-enum")), e2EcontrollerQueryArrayOfOthersEnumHandler)
+	e2EcontrollerQueryArrayOfPointersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfPointersRoute := withBasePath(basePath, "/e2e/query-pointer-to-array")
+	e2EcontrollerQueryArrayOfPointersHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"QueryArrayOfPointers",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfPointersRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
 		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "QueryArrayOfPointers")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			echoCtx,
 			[]SecurityCheckList{
@@ -8141,8 +13440,9 @@ func RegisterRoutes(engine *echo.Echo) {
 		if authErr != nil {
 			return handleAuthorizationError(echoCtx, authErr, "QueryArrayOfPointers")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(echoCtx))
 		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
 		var values07RawPtr *[]string = nil
 		values07RawArray := echoCtx.QueryParams()["values07"]
 		isvalues07Exists := echoCtx.Request().URL.Query().Has("values07")
@@ -8155,6 +13455,7 @@ func RegisterRoutes(engine *echo.Echo) {
 			values07RawPtr = &values07
 			values07RawPtr = &values07
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
@@ -8165,13 +13466,20 @@ func RegisterRoutes(engine *echo.Echo) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "QueryArrayOfPointers")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "QueryArrayOfPointers")
+			}
+		}()
 		value, opError := controller.QueryArrayOfPointers(values07RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			echoCtx.Response().Header().Set(key, value)
 		}
 		echoCtx.Response().Header().Set("x-inject", "true")
 		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "QueryArrayOfPointers")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -8189,6 +13497,7 @@ func RegisterRoutes(engine *echo.Echo) {
 				Instance:   "/controller/error/QueryArrayOfPointers",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
 			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "QueryArrayOfPointers")
 			return echoCtx.JSON(statusCode, stdError)
 		}
@@ -8206,5 +13515,215 @@ func RegisterRoutes(engine *echo.Echo) {
 		echoCtx.JSON(statusCode, value)
 		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "QueryArrayOfPointers")
 		return nil
-	})
+	}
+	engine.Add("POST", toEchoUrl(withBasePath(basePath, "/e2e/query-pointer-to-array")), e2EcontrollerQueryArrayOfPointersHandler)
+	// E2ESharedRateLimitController
+	e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitFirstRoute := withBasePath(basePath, "/e2e/shared-rate-limit/first")
+	e2EsharedRateLimitControllerSharedRateLimitFirstHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"SharedRateLimitFirst",
+			"E2ESharedRateLimitController",
+			"GET",
+			e2EsharedRateLimitControllerSharedRateLimitFirstRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "SharedRateLimitFirst")
+		if allowed, err := enforceRateLimit(echoCtx, "SharedRateLimitFirst", e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit); !allowed {
+			return err
+		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "SharedRateLimitFirst")
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "SharedRateLimitFirst")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "SharedRateLimitFirst")
+			}
+		}()
+		value, opError := controller.SharedRateLimitFirst()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "SharedRateLimitFirst")
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitFirst'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitFirst",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "SharedRateLimitFirst")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "SharedRateLimitFirst")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "SharedRateLimitFirst")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "SharedRateLimitFirst")
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/shared-rate-limit/first")), e2EsharedRateLimitControllerSharedRateLimitFirstHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/shared-rate-limit/first")), e2EsharedRateLimitControllerSharedRateLimitFirstHandler)
+	e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitSecondRoute := withBasePath(basePath, "/e2e/shared-rate-limit/second")
+	e2EsharedRateLimitControllerSharedRateLimitSecondHandler := func(echoCtx echo.Context) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(echoCtx),
+			propagation.HeaderCarrier(echoCtx.Request().Header),
+			"SharedRateLimitSecond",
+			"E2ESharedRateLimitController",
+			"GET",
+			e2EsharedRateLimitControllerSharedRateLimitSecondRoute,
+		)
+		setRequestContext(echoCtx, telemetry.ctx)
+		defer func() { telemetry.end(echoCtx.Response().Status) }()
+		echoCtx.Response().Header().Set("x-RouteStartRoutesExtension", "SharedRateLimitSecond")
+		if allowed, err := enforceRateLimit(echoCtx, "SharedRateLimitSecond", e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit); !allowed {
+			return err
+		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			echoCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(echoCtx, authErr, "SharedRateLimitSecond")
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(echoCtx))
+		controller.InitController(echoCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		echoCtx.Response().Header().Set("x-BeforeOperationRoutesExtension", "SharedRateLimitSecond")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(echoCtx, recovered, "SharedRateLimitSecond")
+			}
+		}()
+		value, opError := controller.SharedRateLimitSecond()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			echoCtx.Response().Header().Set(key, value)
+		}
+		echoCtx.Response().Header().Set("x-inject", "true")
+		echoCtx.Response().Header().Set("x-ResponseHeadersExtension", "SharedRateLimitSecond")
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx, opError)
+				setRequestContext(echoCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitSecond'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitSecond",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+			echoCtx.Response().Header().Set("x-JsonErrorResponseExtension", "SharedRateLimitSecond")
+			return echoCtx.JSON(statusCode, stdError)
+		}
+		echoCtx.Response().Header().Set("x-JsonResponseExtension", "SharedRateLimitSecond")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(echoCtx), echoCtx)
+			setRequestContext(echoCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		echoCtx.Response().Header().Set("x-AfterOperationRoutesExtension", "SharedRateLimitSecond")
+		echoCtx.JSON(statusCode, value)
+		echoCtx.Response().Header().Set("x-RouteEndRoutesExtension", "SharedRateLimitSecond")
+		return nil
+	}
+	engine.Add("GET", toEchoUrl(withBasePath(basePath, "/e2e/shared-rate-limit/second")), e2EsharedRateLimitControllerSharedRateLimitSecondHandler)
+	engine.Add("HEAD", toEchoUrl(withBasePath(basePath, "/e2e/shared-rate-limit/second")), e2EsharedRateLimitControllerSharedRateLimitSecondHandler)
 }
@@ -5,7 +5,7 @@ It includes routes and handlers by the Gleece API Routes Generator.
 --
 Authors: Haim Kastner & Yuval Pomerchik
 Generated by: Gleece Routes Generator
-Generated Date: 2025-12-25
+Generated Date: 2026-10-19
 Target Engine: Fiber v2 (https://github.com/gofiber/fiber)
 --
 Usage:
@@ -16,44 +16,56 @@ Repository: https://github.com/gopher-fleece/gleece
 */
 package ex_extra_routes
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
 	RequestAuth "github.com/gopher-fleece/gleece/v2/e2e/fiber/auth"
 	"github.com/gopher-fleece/runtime"
 	E2EClassSecController "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	E2EController "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param11value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param12value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param12value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param15data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param16data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param17data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	E2ESharedRateLimitController "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param14value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param18data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param19data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param20data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param22arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param23arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param24object "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param25num "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param26str "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param27values "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param28values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param21data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param22data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param23data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param24arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param25object "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param26num "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param27str "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param28values "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param29values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param31values "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param32values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param30values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param32values "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param33values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param4value2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param5data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param5theBody "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Response6CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param13data "github.com/haimkastner/unitsnet-go/units"
-	Param14unit "github.com/haimkastner/unitsnet-go/units"
+	Param6principal "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Response9CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param16data "github.com/haimkastner/unitsnet-go/units"
+	Param17unit "github.com/haimkastner/unitsnet-go/units"
 	// import extension placeholder
 )
 var validatorInstance = validator.New()
@@ -66,6 +78,24 @@ type SecurityCheckList struct {
 	Checks   []runtime.SecurityCheck
 	Relation SecurityListRelation
 }
+// ValidationIssue describes a single failed validation of a request's input
+type ValidationIssue struct {
+	// The location of the offending input - body, query, path, header or form
+	In string `json:"in"`
+	// A JSON pointer to the offending value, relative to its location
+	Pointer string `json:"pointer"`
+	// The validation rule that failed
+	Rule string `json:"rule"`
+	// The failed rule's parameter, if any
+	Param string `json:"param,omitempty"`
+	// A human-readable description of the failure
+	Message string `json:"message"`
+}
+// ValidationProblem is an RFC7807 error detailing the failed validations of a request
+type ValidationProblem struct {
+	runtime.Rfc7807Error
+	Errors []ValidationIssue `json:"errors"`
+}
 // type declarations extension placeholder
 func getRequestContext(fiberCtx *fiber.Ctx) context.Context {
 	return fiberCtx.UserContext()
@@ -75,6 +105,56 @@ func setRequestContext(fiberCtx *fiber.Ctx, ctx context.Context) {
 		fiberCtx.SetUserContext(ctx)
 	}
 }
+type principalContextKey struct{}
+// WithPrincipal attaches the request's authenticated principal to the given context.
+//
+// Security handlers return the resulting context to make the principal available to @Principal parameters
+func WithPrincipal(ctx context.Context, principal any) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+// getPrincipal retrieves the principal attached to the given context via WithPrincipal.
+// Yields nil if no principal of type TPrincipal (or a pointer to one) was attached
+func getPrincipal[TPrincipal any](ctx context.Context) *TPrincipal {
+	switch principal := ctx.Value(principalContextKey{}).(type) {
+	case *TPrincipal:
+		return principal
+	case TPrincipal:
+		return &principal
+	default:
+		return nil
+	}
+}
+// IdentifiablePrincipal may be implemented by principals to provide a stable identity, e.g. a user ID,
+// by which their idempotent requests and principal keyed rate limits are scoped
+type IdentifiablePrincipal interface {
+	IdempotencyIdentity() string
+}
+// describePrincipal returns a stable textual identity of the principal attached to the given context via WithPrincipal,
+// i.e., its IdempotencyIdentity or String method's result, if any, or its dereferenced value if of a primitive kind.
+// Yields an empty string if no principal was attached.
+//
+// Returns false for principals lacking a stable identity, e.g. structs, whose formatting may vary between requests
+func describePrincipal(ctx context.Context) (string, bool) {
+	principal := ctx.Value(principalContextKey{})
+	switch identifiable := principal.(type) {
+	case nil:
+		return "", true
+	case IdentifiablePrincipal:
+		return identifiable.IdempotencyIdentity(), true
+	case fmt.Stringer:
+		return identifiable.String(), true
+	}
+	value := reflect.Indirect(reflect.ValueOf(principal))
+	switch value.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprintf("%v", value), true
+	default:
+		return "", false
+	}
+}
 func registerEnumValidation(validate *validator.Validate, validationName string, allowedValues []string) {
 	// Convert the array to a map for O(1) lookup
 	lookup := make(map[string]struct{})
@@ -88,12 +168,12 @@ func registerEnumValidation(validate *validator.Validate, validationName string,
 		return exists
 	})
 }
-func extractValidationErrorMessage(err error, fieldName *string) string {
+func extractValidationErrorMessage(err error, fieldName *string, languages []string) string {
 	if err == nil {
 		return ""
 	}
-	validationErrors, ok := err.(validator.ValidationErrors)
-	if !ok {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
 		return err.Error()
 	}
 	var errStr string
@@ -102,15 +182,26 @@ func extractValidationErrorMessage(err error, fieldName *string) string {
 		if fieldName != nil {
 			fName = *fieldName
 		}
-		errStr += fmt.Sprintf("Field '%s' failed validation with tag '%s'. ", fName, validationErr.Tag())
+		errStr += translateMessage(
+			languages,
+			map[string]string{"field": fName, "tag": validationErr.Tag(), "param": validationErr.Param()},
+			ValidationTagMessageKey(validationErr.Tag()),
+			MessageKeyFieldValidation,
+		) + ". "
 	}
 	return errStr
 }
-func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error) int {
+// getStatusCode returns the status code of an operation's response - the one set via the controller's SetStatus, if any,
+// that of the error mapping matching err among the operation's declared error status codes or a 500 for other errors,
+// or the success status code
+func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error, routeErrorStatusCodes []int) int {
 	if controller.GetStatus() != nil {
 		return int(*controller.GetStatus())
 	}
 	if err != nil {
+		if mapping := getErrorMapping(err, routeErrorStatusCodes); mapping != nil {
+			return mapping.StatusCode
+		}
 		return http.StatusInternalServerError
 	}
 	if hasReturnValue {
@@ -123,7 +214,7 @@ func bindAndValidateBody[TOutput any](fiberCtx *fiber.Ctx, contentType string, v
 	bodyBytes := fiberCtx.Body()
 	if len(bodyBytes) == 0 {
 		if strings.Contains(validation, "required") {
-			return fmt.Errorf("body is required but was not provided")
+			return errBodyRequired
 		}
 		return nil
 	}
@@ -158,12 +249,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Slice, reflect.Array:
 		// For slices/arrays, validate each element recursively
 		for i := 0; i < val.Len(); i++ {
-			elemPath := path
-			if path != "" {
-				elemPath = fmt.Sprintf("%s[%d]", path, i)
-			} else {
-				elemPath = fmt.Sprintf("[%d]", i)
-			}
+			elemPath := fmt.Sprintf("%s/%d", path, i)
 			// Get the element - handle case where element might be nil
 			elem := val.Index(i)
 			if elem.Kind() == reflect.Ptr && elem.IsNil() {
@@ -178,12 +264,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Map:
 		// For maps, validate each value recursively
 		for _, key := range val.MapKeys() {
-			elemPath := path
-			if path != "" {
-				elemPath = fmt.Sprintf("%s.%v", path, key.Interface())
-			} else {
-				elemPath = fmt.Sprintf("%v", key.Interface())
-			}
+			elemPath := fmt.Sprintf("%s/%s", path, escapeJsonPointerToken(fmt.Sprintf("%v", key.Interface())))
 			elemVal := val.MapIndex(key)
 			if elemVal.Kind() == reflect.Ptr && elemVal.IsNil() {
 				continue
@@ -196,10 +277,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Struct:
 		// Validate structs with the validator
 		if err := validatorInstance.Struct(data); err != nil {
-			if path != "" {
-				return fmt.Errorf("validation error at %s: %w", path, err)
-			}
-			return err
+			return &structValidationError{pointer: path, structType: val.Type(), err: err}
 		}
 		return nil
 	default:
@@ -218,16 +296,30 @@ func toFiberUrl(url string) string {
 	}
 	return processedUrl
 }
+// withBasePath prefixes the given route path with the given base path
+func withBasePath(basePath string, path string) string {
+	basePath = strings.TrimSuffix(basePath, "/")
+	if basePath != "" && !strings.HasPrefix(basePath, "/") {
+		basePath = "/" + basePath
+	}
+	return basePath + path
+}
+// authorize succeeds if all checks of any of the given lists pass.
+// Each list is an alternative (OR) of security schemas that must all be satisfied (AND), as expanded
+// from the route's @Security and @AdvancedSecurity annotations
 func authorize(fiberCtx *fiber.Ctx, checksLists []SecurityCheckList) *runtime.SecurityError {
 	var lastError *runtime.SecurityError
 	for _, list := range checksLists {
 		if list.Relation != SecurityListRelationAnd {
-			panic(
-				"Encountered a security list relation of type '%s' - this is unexpected and indicates a bug in Gleece itself." +
+			panic(fmt.Sprintf(
+				"Encountered a security list relation of type '%s' - this is unexpected and indicates a bug in Gleece itself. "+
 					"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
-			)
+				list.Relation,
+			))
 		}
-		// Iterate over each security list
+		// Iterate over each security list.
+		// Checks may attach values (e.g. a principal) to the request context - these are discarded if the list fails
+		listCtx := getRequestContext(fiberCtx)
 		encounteredErrorInList := false
 		for _, check := range list.Checks {
 			secCtx, secErr := RequestAuth.GleeceRequestAuthorization(getRequestContext(fiberCtx), fiberCtx, check)
@@ -243,6 +335,7 @@ func authorize(fiberCtx *fiber.Ctx, checksLists []SecurityCheckList) *runtime.Se
 		if !encounteredErrorInList {
 			return nil
 		}
+		setRequestContext(fiberCtx, listCtx)
 	}
 	// If we got here it means authentication has failed
 	return lastError
@@ -261,20 +354,906 @@ func handleAuthorizationError(fiberCtx *fiber.Ctx, authErr *runtime.SecurityErro
 	}
 	return fiberCtx.Status(statusCode).JSON(stdError)
 }
-func wrapValidatorError(validatorErr error, operationId string, fieldName string) runtime.Rfc7807Error {
+func wrapValidatorError(validatorErr error, operationId string, fieldName string, languages []string) runtime.Rfc7807Error {
 	return runtime.Rfc7807Error{
 		Type: http.StatusText(http.StatusUnprocessableEntity),
-		Detail: fmt.Sprintf(
-			"A request was made to operation '%s' but parameter '%s' did not pass validation - %s",
-			operationId,
-			fieldName,
-			extractValidationErrorMessage(validatorErr, &fieldName),
+		Detail: translateMessage(
+			languages,
+			map[string]string{
+				"operation": operationId,
+				"parameter": fieldName,
+				"details":   extractValidationErrorMessage(validatorErr, &fieldName, languages),
+			},
+			MessageKeyParamValidation,
 		),
 		Status:   http.StatusUnprocessableEntity,
 		Instance: fmt.Sprintf("/validation/error/%s", operationId),
 	}
 }
+// paramInteger is the set of signed integer types a request parameter may be converted to
+type paramInteger interface {
+	int | int8 | int16 | int32 | int64
+}
+// paramUnsigned is the set of unsigned integer types a request parameter may be converted to
+type paramUnsigned interface {
+	uint | uint8 | uint16 | uint32 | uint64
+}
+// paramFloat is the set of floating point types a request parameter may be converted to
+type paramFloat interface {
+	float32 | float64
+}
+// parseIntegerParam converts the raw value of a request parameter to the given signed integer type
+func parseIntegerParam[T paramInteger](raw string) (T, error) {
+	parsed, err := strconv.ParseInt(raw, 10, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// parseUnsignedParam converts the raw value of a request parameter to the given unsigned integer type
+func parseUnsignedParam[T paramUnsigned](raw string) (T, error) {
+	parsed, err := strconv.ParseUint(raw, 10, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// parseFloatParam converts the raw value of a request parameter to the given floating point type
+func parseFloatParam[T paramFloat](raw string) (T, error) {
+	parsed, err := strconv.ParseFloat(raw, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// wrapConversionError creates the RFC7807 error and validation issues describing a request parameter
+// whose raw value could not be converted to the parameter's type
+func wrapConversionError(
+	conversionErr error,
+	operationId string,
+	fieldName string,
+	in string,
+	pointer string,
+	expectedType string,
+	actualType string,
+	languages []string,
+) (runtime.Rfc7807Error, []ValidationIssue) {
+	validationError := runtime.Rfc7807Error{
+		Type: http.StatusText(http.StatusUnprocessableEntity),
+		Detail: translateMessage(
+			languages,
+			map[string]string{
+				"operation": operationId,
+				"parameter": fieldName,
+				"expected":  expectedType,
+				"actual":    actualType,
+			},
+			MessageKeyParamConversion,
+		),
+		Status:     http.StatusUnprocessableEntity,
+		Instance:   fmt.Sprintf("/validation/error/%s", operationId),
+		Extensions: map[string]string{"error": conversionErr.Error()},
+	}
+	validationIssues := []ValidationIssue{
+		{
+			In:      in,
+			Pointer: pointer,
+			Rule:    "type",
+			Param:   expectedType,
+			Message: conversionErr.Error(),
+		},
+	}
+	return validationError, validationIssues
+}
+var errBodyRequired = errors.New("body is required but was not provided")
+// structValidationError associates a struct's validation errors with the struct's location within the validated payload
+type structValidationError struct {
+	pointer    string
+	structType reflect.Type
+	err        error
+}
+func (e *structValidationError) Error() string {
+	if e.pointer == "" {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("validation error at %s: %s", e.pointer, e.err.Error())
+}
+func (e *structValidationError) Unwrap() error {
+	return e.err
+}
+func escapeJsonPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+// getJsonFieldName returns the name of the given field within its struct's JSON representation.
+//
+// Embedded structs without an explicit name are flattened by encoding/json and therefore yield an empty name
+func getJsonFieldName(field reflect.StructField) string {
+	if name := strings.Split(field.Tag.Get("json"), ",")[0]; name != "" {
+		return name
+	}
+	fieldType := field.Type
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	if field.Anonymous && fieldType.Kind() == reflect.Struct {
+		return ""
+	}
+	return field.Name
+}
+// toJsonPointer converts a validator struct namespace (e.g. 'Model.Items[0].Name') to a JSON pointer (e.g. '/items/0/name')
+// using the json tags of the root type's fields
+func toJsonPointer(rootType reflect.Type, structNamespace string) string {
+	pointer := ""
+	currentType := rootType
+	segments := strings.Split(structNamespace, ".")
+	for _, segment := range segments[1:] {
+		name, indices := segment, []string{}
+		if bracket := strings.Index(segment, "["); bracket >= 0 {
+			name = segment[:bracket]
+			indices = strings.Split(strings.TrimSuffix(segment[bracket+1:], "]"), "][")
+		}
+		for currentType != nil && currentType.Kind() == reflect.Ptr {
+			currentType = currentType.Elem()
+		}
+		token := name
+		if currentType != nil && currentType.Kind() == reflect.Struct {
+			if field, found := currentType.FieldByName(name); found {
+				currentType = field.Type
+				token = getJsonFieldName(field)
+			} else {
+				currentType = nil
+			}
+		}
+		if token != "" {
+			pointer += "/" + escapeJsonPointerToken(token)
+		}
+		for _, index := range indices {
+			pointer += "/" + escapeJsonPointerToken(index)
+			for currentType != nil && currentType.Kind() == reflect.Ptr {
+				currentType = currentType.Elem()
+			}
+			if currentType != nil && (currentType.Kind() == reflect.Slice || currentType.Kind() == reflect.Array || currentType.Kind() == reflect.Map) {
+				currentType = currentType.Elem()
+			} else {
+				currentType = nil
+			}
+		}
+	}
+	return pointer
+}
+func getValidationIssueMessage(pointer string, tag string, param string, languages []string) string {
+	args := map[string]string{"tag": tag, "param": param}
+	if pointer == "" {
+		return translateMessage(languages, args, ValidationTagMessageKey(tag), MessageKeyValueValidation)
+	}
+	args["field"] = pointer[strings.LastIndex(pointer, "/")+1:]
+	return translateMessage(languages, args, ValidationTagMessageKey(tag), MessageKeyFieldValidation)
+}
+// getValidationIssues converts the given validation or deserialization error to a list of issues at the given location.
+//
+// The pointer is the JSON pointer of the validated value, relative to its location
+func getValidationIssues(err error, in string, pointer string, languages []string) []ValidationIssue {
+	var structType reflect.Type
+	var structErr *structValidationError
+	if errors.As(err, &structErr) {
+		pointer += structErr.pointer
+		structType = structErr.structType
+	}
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		issues := make([]ValidationIssue, 0, len(validationErrors))
+		for _, validationErr := range validationErrors {
+			issuePointer := pointer
+			if structType != nil {
+				issuePointer += toJsonPointer(structType, validationErr.StructNamespace())
+			}
+			issues = append(issues, ValidationIssue{
+				In:      in,
+				Pointer: issuePointer,
+				Rule:    validationErr.Tag(),
+				Param:   validationErr.Param(),
+				Message: getValidationIssueMessage(issuePointer, validationErr.Tag(), validationErr.Param(), languages),
+			})
+		}
+		return issues
+	}
+	issue := ValidationIssue{In: in, Pointer: pointer, Rule: "parse", Message: err.Error()}
+	var typeErr *json.UnmarshalTypeError
+	if errors.Is(err, errBodyRequired) {
+		issue.Rule = "required"
+	} else if errors.As(err, &typeErr) {
+		if typeErr.Field != "" {
+			issue.Pointer += "/" + strings.ReplaceAll(typeErr.Field, ".", "/")
+		}
+		issue.Rule = "type"
+		issue.Param = typeErr.Type.String()
+	}
+	return []ValidationIssue{issue}
+}
+// Keys of the translatable validation messages.
+//
+// Message templates use named placeholders, such as '{field}', which are replaced with the message's arguments
+const (
+	// Describes a parameter that did not pass validation. Placeholders: {operation}, {parameter}, {details}
+	MessageKeyParamValidation = "paramValidation"
+	// Describes a body that did not pass validation. Placeholders: {operation}, {parameter}, {type}, {details}
+	MessageKeyBodyValidation = "bodyValidation"
+	// Describes a parameter that could not be converted to its declared type. Placeholders: {operation}, {parameter}, {expected}, {actual}
+	MessageKeyParamConversion = "paramConversion"
+	// Describes a field that failed a validation tag with no dedicated message. Placeholders: {field}, {tag}, {param}
+	MessageKeyFieldValidation = "fieldValidation"
+	// Describes a value that failed a validation tag with no dedicated message. Placeholders: {tag}, {param}
+	MessageKeyValueValidation = "valueValidation"
+)
+// The language of the default message catalogue
+const defaultMessageLanguage = "en"
+// The default English message catalogue, used whenever no translation is available
+var defaultMessages = map[string]string{
+	MessageKeyParamValidation: "A request was made to operation '{operation}' but parameter '{parameter}' did not pass validation - {details}",
+	MessageKeyBodyValidation:  "A request was made to operation '{operation}' but body parameter '{parameter}' did not pass validation of '{type}' - {details}",
+	MessageKeyParamConversion: "A request was made to operation '{operation}' but parameter '{parameter}' was not properly sent - Expected {expected} but got {actual}",
+	MessageKeyFieldValidation: "Field '{field}' failed validation with tag '{tag}'",
+	MessageKeyValueValidation: "Value failed validation with tag '{tag}'",
+}
+// MessageTranslator provides validation message templates in the languages requested via the Accept-Language header
+type MessageTranslator interface {
+	// Translate returns the message template registered for the given language and key, if any
+	Translate(language string, key string) (string, bool)
+}
+// MessageCatalogue is a MessageTranslator backed by an in-memory map of language to message key to template.
+//
+// Languages are matched case-insensitively
+type MessageCatalogue map[string]map[string]string
+func (catalogue MessageCatalogue) Translate(language string, key string) (string, bool) {
+	template, found := catalogue[strings.ToLower(language)][key]
+	return template, found
+}
+var messageCatalogue = MessageCatalogue{}
+var messageTranslator MessageTranslator = messageCatalogue
+// SetMessageTranslator replaces the built-in message catalogue with the given translator,
+// e.g. an adapter over go-playground's universal translator.
+//
+// Messages the translator does not provide fall back to the default English catalogue
+func SetMessageTranslator(translator MessageTranslator) {
+	if translator == nil {
+		translator = messageCatalogue
+	}
+	messageTranslator = translator
+}
+// RegisterTranslation registers the template of the given message key for the given language in the built-in catalogue.
+// Has no effect on translators set via SetMessageTranslator
+func RegisterTranslation(language string, key string, template string) {
+	language = strings.ToLower(language)
+	if messageCatalogue[language] == nil {
+		messageCatalogue[language] = map[string]string{}
+	}
+	messageCatalogue[language][key] = template
+}
+// ValidationTagMessageKey returns the message key of the given validation tag, e.g. 'required'.
+//
+// Templates registered under this key take precedence over the generic field and value messages.
+// Placeholders: {field}, {tag}, {param}
+func ValidationTagMessageKey(tag string) string {
+	return "tag." + tag
+}
+// RegisterValidationTagTranslation registers the message used when the given validation tag fails, for the given language
+func RegisterValidationTagTranslation(language string, tag string, template string) {
+	RegisterTranslation(language, ValidationTagMessageKey(tag), template)
+}
+// getAcceptedLanguages returns the languages listed in the given Accept-Language header, most preferred first.
+// Regional variants are followed by their base language, e.g. 'fr-CA' by 'fr'
+func getAcceptedLanguages(header string) []string {
+	type weightedLanguage struct {
+		tag    string
+		weight float64
+	}
+	weightedLanguages := []weightedLanguage{}
+	for _, entry := range strings.Split(header, ",") {
+		parts := strings.Split(entry, ";")
+		tag := strings.TrimSpace(parts[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		weight := 1.0
+		for _, param := range parts[1:] {
+			if value, found := strings.CutPrefix(strings.TrimSpace(param), "q="); found {
+				if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+					weight = parsed
+				}
+			}
+		}
+		if weight > 0 {
+			weightedLanguages = append(weightedLanguages, weightedLanguage{tag: tag, weight: weight})
+		}
+	}
+	slices.SortStableFunc(weightedLanguages, func(a, b weightedLanguage) int {
+		if a.weight > b.weight {
+			return -1
+		}
+		if a.weight < b.weight {
+			return 1
+		}
+		return 0
+	})
+	languages := make([]string, 0, len(weightedLanguages))
+	for _, language := range weightedLanguages {
+		languages = append(languages, language.tag)
+		if base, _, hasRegion := strings.Cut(language.tag, "-"); hasRegion {
+			languages = append(languages, base)
+		}
+	}
+	return languages
+}
+// getMessageTemplate returns the template of the first of the given keys available in the most preferred of the given languages,
+// falling back to English and finally to the default English catalogue
+func getMessageTemplate(languages []string, keys ...string) string {
+	for _, language := range append(slices.Clip(languages), defaultMessageLanguage) {
+		for _, key := range keys {
+			if template, found := messageTranslator.Translate(language, key); found {
+				return template
+			}
+		}
+	}
+	for _, key := range keys {
+		if template, found := defaultMessages[key]; found {
+			return template
+		}
+	}
+	return ""
+}
+// translateMessage formats the template of the given keys in the given languages, replacing its placeholders with the given arguments
+func translateMessage(languages []string, args map[string]string, keys ...string) string {
+	replacements := make([]string, 0, len(args)*2)
+	for name, value := range args {
+		replacements = append(replacements, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(replacements...).Replace(getMessageTemplate(languages, keys...))
+}
+// ControllerFactory creates the controller instance handling a single request.
+//
+// The given context is the request's context
+type ControllerFactory[TController any] func(ctx context.Context) *TController
+var controllerFactories = map[reflect.Type]func(ctx context.Context) any{}
+// RegisterControllerFactory registers the factory used to create a controller for each request it handles.
+//
+// Factories allow controllers to hold injected dependencies such as database handles, services or loggers.
+// Must be called before RegisterRoutes; the factory must not return nil
+func RegisterControllerFactory[TController any](factory ControllerFactory[TController]) {
+	controllerFactories[reflect.TypeOf((*TController)(nil)).Elem()] = func(ctx context.Context) any {
+		return factory(ctx)
+	}
+}
+// createController creates a controller using its registered factory or, if none exists, its zero value
+func createController[TController any](ctx context.Context) *TController {
+	if factory, exists := controllerFactories[reflect.TypeOf((*TController)(nil)).Elem()]; exists {
+		return factory(ctx).(*TController)
+	}
+	return new(TController)
+}
+// ensureControllerFactoriesRegistered panics if any of the given controller types has no registered factory
+func ensureControllerFactoriesRegistered(controllerTypes []reflect.Type) {
+	missing := []string{}
+	for _, controllerType := range controllerTypes {
+		if _, registered := controllerFactories[controllerType]; !registered {
+			missing = append(missing, controllerType.String())
+		}
+	}
+	if len(missing) > 0 {
+		panic(fmt.Sprintf(
+			"controller factories are required but were never registered using RegisterControllerFactory: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
+// ErrorMapping describes the response returned when a controller's error matches a registered mapping
+type ErrorMapping struct {
+	// The HTTP status code to respond with. Must be declared by at least one @ErrorResponse annotation.
+	// The mapping applies only to operations declaring the status code - errors of other operations remain 500s
+	StatusCode int
+	// The RFC7807 title. Defaults to the status code's text
+	Title string
+	// A client-safe description of the error. Defaults to a generic, operation-specific message
+	Detail string
+}
+type registeredErrorMapping struct {
+	matches func(err error) bool
+	mapping ErrorMapping
+}
+var errorMappings = []registeredErrorMapping{}
+var declaredErrorStatusCodes = []int{404, 409, 500}
+// RegisterErrorMapping maps errors matching the given target, via errors.Is, to the given HTTP status code.
+//
+// Mappings are evaluated in order of registration and are superseded by an explicit call to the controller's SetStatus
+func RegisterErrorMapping(target error, statusCode int) {
+	RegisterDetailedErrorMapping(target, ErrorMapping{StatusCode: statusCode})
+}
+// RegisterDetailedErrorMapping maps errors matching the given target, via errors.Is, to the given response
+func RegisterDetailedErrorMapping(target error, mapping ErrorMapping) {
+	addErrorMapping(func(err error) bool { return errors.Is(err, target) }, mapping)
+}
+// RegisterErrorTypeMapping maps errors of type TError, matched via errors.As, to the given response
+func RegisterErrorTypeMapping[TError error](mapping ErrorMapping) {
+	addErrorMapping(func(err error) bool {
+		var target TError
+		return errors.As(err, &target)
+	}, mapping)
+}
+func addErrorMapping(matches func(err error) bool, mapping ErrorMapping) {
+	if !slices.Contains(declaredErrorStatusCodes, mapping.StatusCode) {
+		panic(fmt.Sprintf("Error mapping status code %d is not declared by any @ErrorResponse annotation", mapping.StatusCode))
+	}
+	errorMappings = append(errorMappings, registeredErrorMapping{matches: matches, mapping: mapping})
+}
+// getErrorMapping returns the first registered mapping matching err whose status code is among the given ones, declared by
+// the operation that produced err, or nil if there is none
+func getErrorMapping(err error, routeErrorStatusCodes []int) *ErrorMapping {
+	for _, registered := range errorMappings {
+		if slices.Contains(routeErrorStatusCodes, registered.mapping.StatusCode) && registered.matches(err) {
+			return &registered.mapping
+		}
+	}
+	return nil
+}
+// applyErrorMapping overrides the given RFC7807 error's title and detail with those of the mapping matching err, if any
+func applyErrorMapping(stdError *runtime.Rfc7807Error, err error, routeErrorStatusCodes []int) {
+	mapping := getErrorMapping(err, routeErrorStatusCodes)
+	if mapping == nil {
+		return
+	}
+	stdError.Title = http.StatusText(stdError.Status)
+	if mapping.Title != "" {
+		stdError.Title = mapping.Title
+	}
+	if mapping.Detail != "" {
+		stdError.Detail = mapping.Detail
+	}
+}
 // function declarations extension placeholder
+// DefaultApiVersion is the API version served to requests that do not specify one
+const DefaultApiVersion = 2
+// The request header specifying the requested API version
+const apiVersionHeader = "X-API-Version"
+// The handlers of the versioned routes registered by RegisterGroupRoutes, per method and URL and then per API version
+var versionedRouteHandlers map[string]map[int]fiber.Handler
+// registerVersionedRoute registers the given handler for each of the given API versions.
+//
+// Routes sharing a method and a path are registered once and dispatched according to the requested API version
+func registerVersionedRoute(engine fiber.Router, basePath string, method string, path string, versions []int, handler fiber.Handler) {
+	url := toFiberUrl(withBasePath(basePath, path))
+	handlers, exists := versionedRouteHandlers[method+" "+url]
+	if !exists {
+		handlers = map[int]fiber.Handler{}
+		versionedRouteHandlers[method+" "+url] = handlers
+		engine.Add(method, url, func(fiberCtx *fiber.Ctx) error {
+			version, isValid := getRequestedApiVersion(fiberCtx.Get(apiVersionHeader))
+			versionHandler, isServed := handlers[version]
+			if !isValid || !isServed {
+				return fiberCtx.Status(http.StatusNotFound).JSON(getUnservedApiVersionError(fiberCtx.Get(apiVersionHeader)))
+			}
+			return versionHandler(fiberCtx)
+		})
+	}
+	for _, version := range versions {
+		handlers[version] = handler
+	}
+}
+// getRequestedApiVersion returns the API version specified by the given header value, or DefaultApiVersion if none is specified.
+// Versions may be given with or without a 'v' prefix, e.g. '2' or 'v2'
+func getRequestedApiVersion(headerValue string) (int, bool) {
+	requested := strings.TrimSpace(headerValue)
+	if requested == "" {
+		return DefaultApiVersion, true
+	}
+	version, err := strconv.Atoi(strings.TrimPrefix(strings.ToLower(requested), "v"))
+	return version, err == nil
+}
+// getUnservedApiVersionError returns the RFC7807 error with which requests for an API version not served by a route are answered
+func getUnservedApiVersionError(headerValue string) runtime.Rfc7807Error {
+	return runtime.Rfc7807Error{
+		Type:       http.StatusText(http.StatusNotFound),
+		Detail:     fmt.Sprintf("The requested API version is not served by this route (%s: '%s')", apiVersionHeader, headerValue),
+		Status:     http.StatusNotFound,
+		Instance:   "/versioning/error",
+		Extensions: map[string]string{},
+	}
+}
+// RateLimit describes the number of requests an operation accepts per time window, as declared via @RateLimit
+type RateLimit struct {
+	// The number of requests allowed per window
+	Requests int
+	// The window's duration
+	Per time.Duration
+	// The key by which requests are counted - 'ip', 'principal' or 'header:<Name>'
+	Key string
+	// The scope within which requests are counted - the declaring controller's name for limits inherited from a controller,
+	// which are shared by all of its operations inheriting them, or the operation's ID for limits declared by the operation
+	Scope string
+}
+// RateLimiter decides whether requests may proceed under their operation's rate limit.
+//
+// Implementations must be safe for concurrent use
+type RateLimiter interface {
+	// Allow consumes a single request of the given key against the given operation's limit, counted within the limit's scope.
+	// Returns whether the request may proceed and, if not, how long until it may be retried
+	Allow(ctx context.Context, operationId string, key string, limit RateLimit) (bool, time.Duration)
+}
+// InMemoryRateLimiter is a RateLimiter holding a token bucket per limit scope and key in the process's memory.
+//
+// Each bucket holds up to the limit's number of requests and is refilled evenly over the limit's window.
+// Limits are not shared across instances - deployments with multiple instances should use a shared RateLimiter instead
+type InMemoryRateLimiter struct {
+	mutex     sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+type tokenBucket struct {
+	tokens          float64
+	capacity        float64
+	refillPerSecond float64
+	lastRefill      time.Time
+}
+// The interval at which an InMemoryRateLimiter drops buckets that have been refilled to capacity
+const rateLimitSweepInterval = time.Minute
+// NewInMemoryRateLimiter creates an InMemoryRateLimiter with no buckets
+func NewInMemoryRateLimiter() *InMemoryRateLimiter {
+	return &InMemoryRateLimiter{buckets: map[string]*tokenBucket{}, lastSweep: time.Now()}
+}
+func (limiter *InMemoryRateLimiter) Allow(_ context.Context, _ string, key string, limit RateLimit) (bool, time.Duration) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	now := time.Now()
+	limiter.sweep(now)
+	bucketKey := limit.Scope + "\x00" + key
+	bucket, exists := limiter.buckets[bucketKey]
+	if !exists {
+		capacity := float64(limit.Requests)
+		bucket = &tokenBucket{
+			tokens:          capacity,
+			capacity:        capacity,
+			refillPerSecond: capacity / limit.Per.Seconds(),
+			lastRefill:      now,
+		}
+		limiter.buckets[bucketKey] = bucket
+	}
+	bucket.tokens = bucket.tokensAt(now)
+	bucket.lastRefill = now
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - bucket.tokens) / bucket.refillPerSecond * float64(time.Second))
+}
+// sweep drops the buckets that have been refilled to capacity, as those are indistinguishable from new ones
+func (limiter *InMemoryRateLimiter) sweep(now time.Time) {
+	if now.Sub(limiter.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	for bucketKey, bucket := range limiter.buckets {
+		if bucket.tokensAt(now) >= bucket.capacity {
+			delete(limiter.buckets, bucketKey)
+		}
+	}
+	limiter.lastSweep = now
+}
+// tokensAt returns the number of tokens the bucket holds at the given time
+func (bucket *tokenBucket) tokensAt(now time.Time) float64 {
+	return math.Min(bucket.capacity, bucket.tokens+now.Sub(bucket.lastRefill).Seconds()*bucket.refillPerSecond)
+}
+var rateLimiter RateLimiter = NewInMemoryRateLimiter()
+// SetRateLimiter replaces the default InMemoryRateLimiter with the given limiter,
+// e.g. one backed by a store shared across instances
+func SetRateLimiter(limiter RateLimiter) {
+	if limiter == nil {
+		limiter = NewInMemoryRateLimiter()
+	}
+	rateLimiter = limiter
+}
+// newRateLimit creates an operation's RateLimit, counted within the given scope, out of its @RateLimit annotation's properties
+func newRateLimit(requests int, per string, key string, scope string) RateLimit {
+	window, err := time.ParseDuration(per)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid rate limit window '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			per,
+		))
+	}
+	return RateLimit{Requests: requests, Per: window, Key: key, Scope: scope}
+}
+// getRateLimitKey returns the value by which the request is counted against a rate limit with the given key.
+//
+// Requests lacking the key's header or an identifiable principal are counted by their client IP
+func getRateLimitKey(fiberCtx *fiber.Ctx, key string) string {
+	value := ""
+	if headerName, isHeaderKey := strings.CutPrefix(key, "header:"); isHeaderKey {
+		value = fiberCtx.Get(headerName)
+	} else if key == "principal" {
+		value, _ = describePrincipal(getRequestContext(fiberCtx))
+	}
+	if value == "" {
+		return fiberCtx.IP()
+	}
+	return key + "=" + value
+}
+// enforceRateLimit counts the request against the given operation's rate limit.
+//
+// Requests exceeding the limit are answered with a 429 RFC7807 error and a 'Retry-After' header, in which case false is returned
+// along with the error of writing the response
+func enforceRateLimit(fiberCtx *fiber.Ctx, operationId string, limit RateLimit) (bool, error) {
+	allowed, retryAfter := rateLimiter.Allow(getRequestContext(fiberCtx), operationId, getRateLimitKey(fiberCtx, limit.Key), limit)
+	if allowed {
+		return true, nil
+	}
+	fiberCtx.Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	return false, fiberCtx.Status(http.StatusTooManyRequests).JSON(runtime.Rfc7807Error{
+		Type:     http.StatusText(http.StatusTooManyRequests),
+		Detail:   fmt.Sprintf("Too many requests were made to operation '%s'. Please retry later", operationId),
+		Status:   http.StatusTooManyRequests,
+		Instance: "/ratelimit/error/" + operationId,
+	})
+}
+// newOperationTimeout creates an operation's deadline duration out of its @Timeout annotation or the configured default timeout
+func newOperationTimeout(timeout string) time.Duration {
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid operation timeout '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			timeout,
+		))
+	}
+	return duration
+}
+// applyOperationTimeout replaces the request's context with one that expires once the given timeout elapses.
+//
+// Operations are not interrupted when the deadline expires - controllers are expected to honor their context's cancellation.
+// The returned function releases the context's resources and must be called once the request has been handled
+func applyOperationTimeout(fiberCtx *fiber.Ctx, timeout time.Duration) context.CancelFunc {
+	ctx, cancel := context.WithTimeout(getRequestContext(fiberCtx), timeout)
+	setRequestContext(fiberCtx, ctx)
+	return cancel
+}
+// isDeadlineExceeded returns whether the request's context has expired due to its operation's timeout
+func isDeadlineExceeded(fiberCtx *fiber.Ctx) bool {
+	return errors.Is(getRequestContext(fiberCtx).Err(), context.DeadlineExceeded)
+}
+// handleDeadlineExceeded answers a request whose operation's deadline has expired with an RFC7807 error of the given status -
+// 503 if the operation has not been invoked yet or 504 if it has
+func handleDeadlineExceeded(fiberCtx *fiber.Ctx, operationId string, status int) error {
+	detail := fmt.Sprintf("The deadline of operation '%s' expired before the operation was invoked", operationId)
+	if status == http.StatusGatewayTimeout {
+		detail = fmt.Sprintf("Operation '%s' did not complete before its deadline expired", operationId)
+	}
+	return fiberCtx.Status(status).JSON(runtime.Rfc7807Error{
+		Type:     http.StatusText(status),
+		Detail:   detail,
+		Status:   status,
+		Instance: "/timeout/error/" + operationId,
+	})
+}
+// enforceMaxBodySize checks the request's body against the given operation's maximum body size.
+//
+// Note that Fiber reads request bodies up-front, subject to the application's own 'BodyLimit' configuration.
+// Requests whose body exceeds the maximum size are answered with a 413 RFC7807 error, in which case false is returned
+func enforceMaxBodySize(fiberCtx *fiber.Ctx, operationId string, maxBodySize int64) (bool, error) {
+	if int64(len(fiberCtx.Body())) <= maxBodySize {
+		return true, nil
+	}
+	return false, fiberCtx.Status(http.StatusRequestEntityTooLarge).JSON(runtime.Rfc7807Error{
+		Type:     http.StatusText(http.StatusRequestEntityTooLarge),
+		Detail:   fmt.Sprintf("The request body of operation '%s' exceeds the maximum size of %d bytes", operationId, maxBodySize),
+		Status:   http.StatusRequestEntityTooLarge,
+		Instance: "/body/error/" + operationId,
+	})
+}
+// IdempotentResponse is a response of an idempotent operation, as stored to be replayed to repeated requests
+type IdempotentResponse struct {
+	// The response's HTTP status code
+	Status int
+	// The response's headers
+	Headers http.Header
+	// The response's body
+	Body []byte
+}
+// IdempotencyStore stores the responses of operations declared via @Idempotent, by request key.
+//
+// Implementations must be safe for concurrent use
+type IdempotencyStore interface {
+	// Reserve claims the given key for a new request with the given body hash, for the given duration.
+	// If the key has already been claimed, returns false along with the stored response or,
+	// if the original request is still in flight, a nil response.
+	// If the key has been claimed by a request with a different body hash, returns ErrIdempotencyKeyReused
+	Reserve(ctx context.Context, key string, requestHash string, ttl time.Duration) (bool, *IdempotentResponse, error)
+	// Complete stores the response of the request that claimed the given key, along with the request's body hash, for the given duration
+	Complete(ctx context.Context, key string, requestHash string, response IdempotentResponse, ttl time.Duration) error
+	// Release drops the claim of a request that did not produce a replayable response, so that it may be retried
+	Release(ctx context.Context, key string) error
+}
+// ErrIdempotencyKeyReused is returned by an IdempotencyStore when a key is reused by a request with a different body
+var ErrIdempotencyKeyReused = errors.New("the idempotency key has already been used by a request with a different body")
+// InMemoryIdempotencyStore is an IdempotencyStore holding responses in the process's memory.
+//
+// Responses are not shared across instances - deployments with multiple instances should use a shared IdempotencyStore instead
+type InMemoryIdempotencyStore struct {
+	mutex     sync.Mutex
+	entries   map[string]*idempotencyEntry
+	lastSweep time.Time
+}
+type idempotencyEntry struct {
+	// The stored response. Nil while the request is in flight
+	response *IdempotentResponse
+	// The hash of the body of the request that claimed the entry's key
+	requestHash string
+	expiresAt   time.Time
+}
+// The interval at which an InMemoryIdempotencyStore drops expired entries
+const idempotencySweepInterval = time.Minute
+// NewInMemoryIdempotencyStore creates an InMemoryIdempotencyStore with no entries
+func NewInMemoryIdempotencyStore() *InMemoryIdempotencyStore {
+	return &InMemoryIdempotencyStore{entries: map[string]*idempotencyEntry{}, lastSweep: time.Now()}
+}
+func (store *InMemoryIdempotencyStore) Reserve(_ context.Context, key string, requestHash string, ttl time.Duration) (bool, *IdempotentResponse, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	now := time.Now()
+	store.sweep(now)
+	if entry, exists := store.entries[key]; exists && now.Before(entry.expiresAt) {
+		if entry.requestHash != requestHash {
+			return false, nil, ErrIdempotencyKeyReused
+		}
+		return false, entry.response, nil
+	}
+	store.entries[key] = &idempotencyEntry{requestHash: requestHash, expiresAt: now.Add(ttl)}
+	return true, nil, nil
+}
+func (store *InMemoryIdempotencyStore) Complete(_ context.Context, key string, requestHash string, response IdempotentResponse, ttl time.Duration) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.entries[key] = &idempotencyEntry{response: &response, requestHash: requestHash, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+func (store *InMemoryIdempotencyStore) Release(_ context.Context, key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.entries, key)
+	return nil
+}
+// sweep drops the entries that have expired
+func (store *InMemoryIdempotencyStore) sweep(now time.Time) {
+	if now.Sub(store.lastSweep) < idempotencySweepInterval {
+		return
+	}
+	for key, entry := range store.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(store.entries, key)
+		}
+	}
+	store.lastSweep = now
+}
+var idempotencyStore IdempotencyStore = NewInMemoryIdempotencyStore()
+// SetIdempotencyStore replaces the default InMemoryIdempotencyStore with the given store,
+// e.g. one backed by a database shared across instances
+func SetIdempotencyStore(store IdempotencyStore) {
+	if store == nil {
+		store = NewInMemoryIdempotencyStore()
+	}
+	idempotencyStore = store
+}
+// newIdempotencyTtl creates an operation's response retention duration out of its @Idempotent annotation's 'ttl' property
+func newIdempotencyTtl(ttl string) time.Duration {
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid idempotency TTL '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			ttl,
+		))
+	}
+	return duration
+}
+// The maximum size, in bytes, of the request bodies hashed by idempotent operations without a @MaxBodySize limit
+const defaultMaxIdempotentBodySize = 10 << 20
+// hashRequestBody returns the hex encoded SHA-256 hash of the request's body. The body remains readable.
+//
+// Requests whose body exceeds the given maximum size, or defaultMaxIdempotentBodySize if zero,
+// are answered with a 413 RFC7807 error, in which case false is returned
+func hashRequestBody(fiberCtx *fiber.Ctx, operationId string, maxBodySize int64) (string, bool, error) {
+	if maxBodySize <= 0 {
+		maxBodySize = defaultMaxIdempotentBodySize
+	}
+	body := fiberCtx.Body()
+	if int64(len(body)) > maxBodySize {
+		return "", false, writeIdempotencyError(fiberCtx, operationId, http.StatusRequestEntityTooLarge, fmt.Sprintf("The request body of operation '%s' exceeds the maximum size of %d bytes", operationId, maxBodySize))
+	}
+	hash := sha256.Sum256(body)
+	return hex.EncodeToString(hash[:]), true, nil
+}
+// beginIdempotentRequest claims the request's 'Idempotency-Key' header, scoped to the given operation and the request's principal.
+//
+// If the operation may proceed, returns a function storing its response, which must be deferred.
+// Otherwise, the request is answered - with the stored response if the key has been used before,
+// or with an RFC7807 error if the header is missing (400), the original request is still in flight (409),
+// the principal lacks a stable identity (500)
+// or the key has been used by a request with a different body (422) - and a nil function is returned
+func beginIdempotentRequest(fiberCtx *fiber.Ctx, operationId string, requestHash string, ttl time.Duration) (func(), error) {
+	idempotencyKey := fiberCtx.Get("Idempotency-Key")
+	if idempotencyKey == "" {
+		return nil, writeIdempotencyError(
+			fiberCtx,
+			operationId,
+			http.StatusBadRequest,
+			fmt.Sprintf("Operation '%s' requires an 'Idempotency-Key' header", operationId),
+		)
+	}
+	ctx := getRequestContext(fiberCtx)
+	// Keys are scoped by principal so that they may not collide across clients. Principals lacking a stable identity are
+	// rejected, as their requests could otherwise never be recognized as repeated
+	principalIdentity, isIdentifiable := describePrincipal(ctx)
+	if !isIdentifiable {
+		return nil, writeIdempotencyError(
+			fiberCtx,
+			operationId,
+			http.StatusInternalServerError,
+			fmt.Sprintf("The principal of operation '%s' lacks a stable identity to scope its 'Idempotency-Key' by", operationId),
+		)
+	}
+	key := operationId + "\x00" + principalIdentity + "\x00" + idempotencyKey
+	reserved, response, err := idempotencyStore.Reserve(ctx, key, requestHash, ttl)
+	switch {
+	case errors.Is(err, ErrIdempotencyKeyReused):
+		return nil, writeIdempotencyError(
+			fiberCtx,
+			operationId,
+			http.StatusUnprocessableEntity,
+			fmt.Sprintf("The 'Idempotency-Key' has already been used by a request to operation '%s' with a different body", operationId),
+		)
+	case err != nil:
+		return nil, writeIdempotencyError(
+			fiberCtx,
+			operationId,
+			http.StatusInternalServerError,
+			fmt.Sprintf("Failed to process the 'Idempotency-Key' header of operation '%s'", operationId),
+		)
+	case !reserved && response == nil:
+		return nil, writeIdempotencyError(
+			fiberCtx,
+			operationId,
+			http.StatusConflict,
+			fmt.Sprintf("A request to operation '%s' with the same 'Idempotency-Key' is still being processed", operationId),
+		)
+	case !reserved:
+		for name, values := range response.Headers {
+			for _, value := range values {
+				fiberCtx.Response().Header.Add(name, value)
+			}
+		}
+		fiberCtx.Set("Idempotent-Replayed", "true")
+		return nil, fiberCtx.Status(response.Status).Send(response.Body)
+	}
+	return func() {
+		// Requests that panicked or failed on the server's side are not replayed so they may be retried
+		recovered := recover()
+		status := fiberCtx.Response().StatusCode()
+		if recovered != nil || status >= http.StatusInternalServerError {
+			idempotencyStore.Release(context.WithoutCancel(ctx), key)
+			if recovered != nil {
+				panic(recovered)
+			}
+			return
+		}
+		idempotencyStore.Complete(
+			context.WithoutCancel(ctx),
+			key,
+			requestHash,
+			IdempotentResponse{
+				Status:  status,
+				Headers: http.Header(fiberCtx.GetRespHeaders()),
+				Body:    bytes.Clone(fiberCtx.Response().Body()),
+			},
+			ttl,
+		)
+	}, nil
+}
+// writeIdempotencyError answers a request to an idempotent operation with an RFC7807 error of the given status
+func writeIdempotencyError(fiberCtx *fiber.Ctx, operationId string, status int, detail string) error {
+	return fiberCtx.Status(status).JSON(runtime.Rfc7807Error{
+		Type:     http.StatusText(status),
+		Detail:   detail,
+		Status:   status,
+		Instance: "/idempotency/error/" + operationId,
+	})
+}
 type MiddlewareFunc func(ctx context.Context, fiberCtx *fiber.Ctx) (context.Context, bool)
 type ErrorMiddlewareFunc func(ctx context.Context, fiberCtx *fiber.Ctx, err error) (context.Context, bool)
 var beforeOperationMiddlewares []MiddlewareFunc
@@ -300,16 +1279,54 @@ func RegisterErrorMiddleware(executionType runtime.ErrorMiddlewareExecutionType,
 		onErrorMiddlewares = append(onErrorMiddlewares, errorMiddlewareFunc)
 	}
 }
+var namedMiddlewares = map[string]MiddlewareFunc{}
+// RegisterNamedMiddleware registers a middleware that controllers and routes may reference by name using @Middleware annotations.
+//
+// Named middlewares must be registered before RegisterRoutes is called
+func RegisterNamedMiddleware(name string, middlewareFunc MiddlewareFunc) {
+	namedMiddlewares[name] = middlewareFunc
+}
+func ensureNamedMiddlewaresRegistered(names []string) {
+	missing := []string{}
+	for _, name := range names {
+		if _, registered := namedMiddlewares[name]; !registered {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		panic(fmt.Sprintf(
+			"middlewares referenced via @Middleware annotations were never registered using RegisterNamedMiddleware: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
 func RegisterCustomValidator(validateTagName string, validateFunc runtime.ValidationFunc) {
 	validatorInstance.RegisterValidation(validateTagName, func(fl validator.FieldLevel) bool {
 		return validateFunc(fl)
 	})
 }
+// BasePath is the path under which RegisterRoutes mounts the API's routes
+const BasePath = ""
+// RegisterRoutes registers the API's routes on the given engine, under BasePath.
+//
+// Routes with custom HTTP verbs, e.g. PURGE, require the verbs to be listed in the app's Config.RequestMethods
 func RegisterRoutes(engine *fiber.App) {
+	RegisterGroupRoutes(engine, BasePath)
+}
+// RegisterGroupRoutes registers the API's routes on the given app or group, under the given base path.
+//
+// The base path is relative to the router's own prefix.
+// For the OpenAPI specification to match the served routes, the two should add up to BasePath.
+//
+// Routes with custom HTTP verbs, e.g. PURGE, require the verbs to be listed in the app's Config.RequestMethods
+func RegisterGroupRoutes(engine fiber.Router, basePath string) {
 	urlParamRegex = regexp.MustCompile(`\{([\w\d-_]+)\}`)
+	versionedRouteHandlers = map[string]map[int]fiber.Handler{}
+	ensureNamedMiddlewaresRegistered([]string{"audit", "trace"})
 	// register routes extension placeholder
 	// E2EClassSecController
-	engine.Get(toFiberUrl("/e2e/with-default-class-security"), func(fiberCtx *fiber.Ctx) error {
+	e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes := []int{}
+	e2EclassSecControllerWithDefaultClassSecurityHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -330,7 +1347,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "WithDefaultClassSecurity")
 		}
-		controller := E2EClassSecController.E2EClassSecController{}
+		controller := createController[E2EClassSecController.E2EClassSecController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("x-test-scopes")
@@ -350,9 +1367,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -369,7 +1388,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -387,6 +1406,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/WithDefaultClassSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -404,8 +1424,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/with-default-override-class-security"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/with-default-class-security")), e2EclassSecControllerWithDefaultClassSecurityHandler)
+	e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes := []int{}
+	e2EclassSecControllerWithOverrideClassSecurityHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -426,7 +1448,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "WithOverrideClassSecurity")
 		}
-		controller := E2EClassSecController.E2EClassSecController{}
+		controller := createController[E2EClassSecController.E2EClassSecController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("x-test-scopes")
@@ -446,9 +1468,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -465,7 +1489,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -483,6 +1507,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/WithOverrideClassSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -500,9 +1525,11 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/with-default-override-class-security")), e2EclassSecControllerWithOverrideClassSecurityHandler)
 	// E2EController
-	engine.Get(toFiberUrl("/e2e/simple-get"), func(fiberCtx *fiber.Ctx) error {
+	e2EcontrollerSimpleGetErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -523,7 +1550,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "SimpleGet")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -540,7 +1567,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -558,6 +1585,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/SimpleGet",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -575,8 +1603,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/simple-get-empty-string"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/simple-get")), e2EcontrollerSimpleGetHandler)
+	e2EcontrollerSimpleGetEmptyStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyStringHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -597,7 +1627,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "SimpleGetEmptyString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -614,7 +1644,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -632,6 +1662,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/SimpleGetEmptyString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -649,8 +1680,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/simple-get-ptr-string"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-empty-string")), e2EcontrollerSimpleGetEmptyStringHandler)
+	e2EcontrollerSimpleGetPtrStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetPtrStringHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -671,7 +1704,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "SimpleGetPtrString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -688,7 +1721,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -706,6 +1739,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/SimpleGetPtrString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -723,8 +1757,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/simple-get-null-string"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-ptr-string")), e2EcontrollerSimpleGetPtrStringHandler)
+	e2EcontrollerSimpleGetNullStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetNullStringHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -745,7 +1781,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "SimpleGetNullString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -762,7 +1798,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -780,6 +1816,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/SimpleGetNullString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -797,8 +1834,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/simple-get-object"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-null-string")), e2EcontrollerSimpleGetNullStringHandler)
+	e2EcontrollerNamedMiddlewaresErrorStatusCodes := []int{}
+	e2EcontrollerNamedMiddlewaresHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -817,9 +1856,9 @@ func RegisterRoutes(engine *fiber.App) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(fiberCtx, authErr, "SimpleGetObject")
+			return handleAuthorizationError(fiberCtx, authErr, "NamedMiddlewares")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -830,13 +1869,23 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
+		routeMiddlewares := []MiddlewareFunc{namedMiddlewares["audit"], namedMiddlewares["trace"]}
+		// Middlewares routeMiddlewares section
+		for _, middleware := range routeMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares routeMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.SimpleGetObject()
+		value, opError := controller.NamedMiddlewares()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -849,11 +1898,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObject'",
+				Detail:     "Encountered an error during operation 'NamedMiddlewares'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetObject",
+				Instance:   "/controller/error/NamedMiddlewares",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -871,8 +1921,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/simple-get-object-ptr"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/named-middlewares")), e2EcontrollerNamedMiddlewaresHandler)
+	e2EcontrollerSimpleGetObjectErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -891,9 +1943,9 @@ func RegisterRoutes(engine *fiber.App) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(fiberCtx, authErr, "SimpleGetObjectPtr")
+			return handleAuthorizationError(fiberCtx, authErr, "SimpleGetObject")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -905,12 +1957,12 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.SimpleGetObjectPtr()
+		value, opError := controller.SimpleGetObject()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -923,11 +1975,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObjectPtr'",
+				Detail:     "Encountered an error during operation 'SimpleGetObject'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetObjectPtr",
+				Instance:   "/controller/error/SimpleGetObject",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -945,8 +1998,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/simple-get-object-null"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-object")), e2EcontrollerSimpleGetObjectHandler)
+	e2EcontrollerSimpleGetObjectPtrErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectPtrHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -965,9 +2020,9 @@ func RegisterRoutes(engine *fiber.App) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(fiberCtx, authErr, "SimpleGetObjectNull")
+			return handleAuthorizationError(fiberCtx, authErr, "SimpleGetObjectPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -979,12 +2034,12 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.SimpleGetObjectNull()
+		value, opError := controller.SimpleGetObjectPtr()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -997,11 +2052,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObjectNull'",
+				Detail:     "Encountered an error during operation 'SimpleGetObjectPtr'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetObjectNull",
+				Instance:   "/controller/error/SimpleGetObjectPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1019,8 +2075,87 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/primitive-return-type"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-object-ptr")), e2EcontrollerSimpleGetObjectPtrHandler)
+	e2EcontrollerSimpleGetObjectNullErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectNullHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "SimpleGetObjectNull")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SimpleGetObjectNull()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObjectNull'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetObjectNull",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-object-null")), e2EcontrollerSimpleGetObjectNullHandler)
+	e2EcontrollerPrimitiveReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveReturnTypeHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -1041,7 +2176,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "PrimitiveReturnType")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1058,7 +2193,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1076,6 +2211,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/PrimitiveReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1093,8 +2229,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/primitive-array-return-type"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/primitive-return-type")), e2EcontrollerPrimitiveReturnTypeHandler)
+	e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveArrayReturnTypeHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -1115,7 +2253,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "PrimitiveArrayReturnType")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1132,7 +2270,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1150,6 +2288,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/PrimitiveArrayReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1167,8 +2306,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/primitive-alias-return-type"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/primitive-array-return-type")), e2EcontrollerPrimitiveArrayReturnTypeHandler)
+	e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasReturnTypeHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -1189,7 +2330,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "PrimitiveAliasReturnType")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1206,7 +2347,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1224,6 +2365,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/PrimitiveAliasReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1241,8 +2383,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/primitive-alias-array-return-type"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/primitive-alias-return-type")), e2EcontrollerPrimitiveAliasReturnTypeHandler)
+	e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasArrayReturnTypeHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -1263,7 +2407,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "PrimitiveAliasArrayReturnType")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1280,7 +2424,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1298,6 +2442,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/PrimitiveAliasArrayReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1315,8 +2460,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/simple-get-empty"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/primitive-alias-array-return-type")), e2EcontrollerPrimitiveAliasArrayReturnTypeHandler)
+	e2EcontrollerSimpleGetEmptyErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -1337,7 +2484,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "SimpleGetEmpty")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var queryParamRawPtr *string = nil
 		queryParamRaw := fiberCtx.Query("queryParam")
@@ -1357,9 +2504,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1376,7 +2525,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1394,6 +2543,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/SimpleGetEmpty",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1411,8 +2561,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/get-with-all-params/{pathParam}"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-empty")), e2EcontrollerSimpleGetEmptyHandler)
+	e2EcontrollerGetWithAllParamsErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -1433,7 +2585,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "GetWithAllParams")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var queryParamRawPtr *string = nil
 		queryParamRaw := fiberCtx.Query("queryParam")
@@ -1453,9 +2605,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var pathParamRawPtr *string = nil
 		pathParamRaw := fiberCtx.Params("pathParam")
@@ -1475,9 +2629,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("headerParam")
@@ -1497,9 +2653,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1516,7 +2674,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1534,6 +2692,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/GetWithAllParams",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1551,8 +2710,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/get-with-all-params-ptr/{pathParam}"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")), e2EcontrollerGetWithAllParamsHandler)
+	e2EcontrollerGetWithAllParamsPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsPtrHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -1573,7 +2734,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "GetWithAllParamsPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var queryParamRawPtr *string = nil
 		queryParamRaw := fiberCtx.Query("queryParam")
@@ -1600,9 +2761,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("headerParam")
@@ -1626,7 +2789,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1644,6 +2807,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/GetWithAllParamsPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1661,8 +2825,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/get-with-all-params-required-ptr/{pathParam}"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsPtrHandler)
+	e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsRequiredPtrHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -1683,7 +2849,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "GetWithAllParamsRequiredPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var queryParamRawPtr *string = nil
 		queryParamRaw := fiberCtx.Query("queryParam")
@@ -1703,9 +2869,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var pathParamRawPtr *string = nil
 		pathParamRaw := fiberCtx.Params("pathParam")
@@ -1725,9 +2893,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("headerParam")
@@ -1747,9 +2917,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1766,7 +2938,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1784,6 +2956,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/GetWithAllParamsRequiredPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1801,8 +2974,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/post-with-all-params-body"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsRequiredPtrHandler)
+	e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -1823,7 +2998,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "PostWithAllParamsWithBody")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var conversionErr error
 		var queryParamRawPtr *string = nil
@@ -1844,9 +3019,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("headerParam")
@@ -1866,9 +3043,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var theBodyRawPtr *Param5theBody.BodyInfo = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &theBodyRawPtr)
@@ -1882,19 +3061,25 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBody' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBody",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBody",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1911,7 +3096,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1929,6 +3114,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/PostWithAllParamsWithBody",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1946,8 +3132,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/post-with-all-params-body-ptr"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/post-with-all-params-body")), e2EcontrollerPostWithAllParamsWithBodyHandler)
+	e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyPtrHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -1968,7 +3156,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "PostWithAllParamsWithBodyPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var conversionErr error
 		var queryParamRawPtr *string = nil
@@ -1997,19 +3185,25 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBodyPtr' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBodyPtr",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2026,7 +3220,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2044,6 +3238,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/PostWithAllParamsWithBodyPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -2061,8 +3256,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/post-with-all-params-body-required-ptr"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-ptr")), e2EcontrollerPostWithAllParamsWithBodyPtrHandler)
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -2083,7 +3280,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "PostWithAllParamsWithBodyRequiredPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var conversionErr error
 		var theBodyRawPtr *Param5theBody.BodyInfo = nil
@@ -2098,19 +3295,25 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBodyRequiredPtr' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyRequiredPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBodyRequiredPtr",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2127,7 +3330,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2145,6 +3348,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/PostWithAllParamsWithBodyRequiredPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -2162,8 +3366,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/get-header-start-with-letter"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-required-ptr")), e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler)
+	e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes := []int{}
+	e2EcontrollerGetHeaderStartWithLetterHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -2184,7 +3390,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "GetHeaderStartWithLetter")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("headerParam")
@@ -2204,9 +3410,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2223,7 +3431,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2241,6 +3449,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/GetHeaderStartWithLetter",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -2258,8 +3467,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/with-default-config-security"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/get-header-start-with-letter")), e2EcontrollerGetHeaderStartWithLetterHandler)
+	e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithDefaultConfigSecurityHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -2280,7 +3491,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "WithDefaultConfigSecurity")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("x-test-scopes")
@@ -2300,9 +3511,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2319,7 +3532,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2337,6 +3550,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/WithDefaultConfigSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -2354,8 +3568,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/with-one-security"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/with-default-config-security")), e2EcontrollerWithDefaultConfigSecurityHandler)
+	e2EcontrollerWithOneSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithOneSecurityHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -2376,7 +3592,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "WithOneSecurity")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("x-test-scopes")
@@ -2396,9 +3612,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2415,7 +3633,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2433,6 +3651,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/WithOneSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -2450,8 +3669,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/with-two-security"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/with-one-security")), e2EcontrollerWithOneSecurityHandler)
+	e2EcontrollerWithTwoSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecurityHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -2484,7 +3705,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "WithTwoSecurity")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("x-test-scopes")
@@ -2504,9 +3725,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2523,7 +3746,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2541,6 +3764,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/WithTwoSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -2558,8 +3782,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/with-two-security-same-method"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/with-two-security")), e2EcontrollerWithTwoSecurityHandler)
+	e2EcontrollerWithAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithAdvancedSecurityHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -2573,16 +3799,21 @@ func RegisterRoutes(engine *fiber.App) {
 								"other",
 							},
 						},
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+							},
+						},
 					},
 				},
 				{
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName",
+							SchemaName: "securitySchemaName3",
 							Scopes: []string{
-								"write",
-								"read",
+								"admin",
 							},
 						},
 					},
@@ -2590,9 +3821,9 @@ func RegisterRoutes(engine *fiber.App) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(fiberCtx, authErr, "WithTwoSecuritySameMethod")
+			return handleAuthorizationError(fiberCtx, authErr, "WithAdvancedSecurity")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("x-test-scopes")
@@ -2612,9 +3843,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithAdvancedSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2626,12 +3859,12 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
+		value, opError := controller.WithAdvancedSecurity(*headerParamRawPtr)
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2644,11 +3877,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
+				Detail:     "Encountered an error during operation 'WithAdvancedSecurity'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithTwoSecuritySameMethod",
+				Instance:   "/controller/error/WithAdvancedSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -2666,8 +3900,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/default-error"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/with-advanced-security")), e2EcontrollerWithAdvancedSecurityHandler)
+	e2EcontrollerWithPrincipalErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -2676,9 +3912,9 @@ func RegisterRoutes(engine *fiber.App) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName2",
+							SchemaName: "securitySchemaName",
 							Scopes: []string{
-								"config",
+								"other",
 							},
 						},
 					},
@@ -2686,10 +3922,18 @@ func RegisterRoutes(engine *fiber.App) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(fiberCtx, authErr, "DefaultError")
+			return handleAuthorizationError(fiberCtx, authErr, "WithPrincipal")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(fiberCtx))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			return handleAuthorizationError(fiberCtx, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipal")
+		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -2700,12 +3944,12 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		opError := controller.DefaultError()
+		value, opError := controller.WithPrincipal(principalRawPtr)
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2718,11 +3962,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DefaultError'",
+				Detail:     "Encountered an error during operation 'WithPrincipal'",
 				Status:     statusCode,
-				Instance:   "/controller/error/DefaultError",
+				Instance:   "/controller/error/WithPrincipal",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -2737,11 +3982,13 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
 		// after operation routes extension placeholder
-		fiberCtx.Status(statusCode)
+		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/default-error-with-payload"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/with-principal")), e2EcontrollerWithPrincipalHandler)
+	e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalAdvancedSecurityHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -2749,10 +3996,27 @@ func RegisterRoutes(engine *fiber.App) {
 				{
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
 						{
 							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"config",
+								"write",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName3",
+							Scopes: []string{
+								"admin",
 							},
 						},
 					},
@@ -2760,9 +4024,1682 @@ func RegisterRoutes(engine *fiber.App) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(fiberCtx, authErr, "DefaultErrorWithPayload")
+			return handleAuthorizationError(fiberCtx, authErr, "WithPrincipalAdvancedSecurity")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(fiberCtx))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			return handleAuthorizationError(fiberCtx, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipalAdvancedSecurity")
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WithPrincipalAdvancedSecurity(principalRawPtr)
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithPrincipalAdvancedSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithPrincipalAdvancedSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/with-principal-advanced-security")), e2EcontrollerWithPrincipalAdvancedSecurityHandler)
+	e2EcontrollerPublicRouteErrorStatusCodes := []int{}
+	e2EcontrollerPublicRouteHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PublicRoute()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PublicRoute'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PublicRoute",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/public")), e2EcontrollerPublicRouteHandler)
+	e2EcontrollerHeadCheckErrorStatusCodes := []int{}
+	e2EcontrollerHeadCheckHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "HeadCheck")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.HeadCheck()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'HeadCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/HeadCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/head-check")), e2EcontrollerHeadCheckHandler)
+	e2EcontrollerOptionsCheckErrorStatusCodes := []int{}
+	e2EcontrollerOptionsCheckHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "OptionsCheck")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.OptionsCheck()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'OptionsCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/OptionsCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("OPTIONS", toFiberUrl(withBasePath(basePath, "/e2e/options-check")), e2EcontrollerOptionsCheckHandler)
+	e2EcontrollerTraceCheckErrorStatusCodes := []int{}
+	e2EcontrollerTraceCheckHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "TraceCheck")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.TraceCheck()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TraceCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TraceCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("TRACE", toFiberUrl(withBasePath(basePath, "/e2e/trace-check")), e2EcontrollerTraceCheckHandler)
+	e2EcontrollerPurgeCheckErrorStatusCodes := []int{}
+	e2EcontrollerPurgeCheckHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "PurgeCheck")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PurgeCheck()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PurgeCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PurgeCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("PURGE", toFiberUrl(withBasePath(basePath, "/e2e/purge-check")), e2EcontrollerPurgeCheckHandler)
+	e2EcontrollerVersionedV1ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV1Handler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "VersionedV1")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.VersionedV1()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV1'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV1",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	e2EcontrollerVersionedV1Versions := []int{1}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV1Versions, e2EcontrollerVersionedV1Handler)
+	e2EcontrollerVersionedV2ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV2Handler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "VersionedV2")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.VersionedV2()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV2'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV2",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	e2EcontrollerVersionedV2Versions := []int{2}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV2Versions, e2EcontrollerVersionedV2Handler)
+	e2EcontrollerRateLimitedErrorStatusCodes := []int{}
+	e2EcontrollerRateLimitedRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "RateLimited")
+	e2EcontrollerRateLimitedHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		if allowed, err := enforceRateLimit(fiberCtx, "RateLimited", e2EcontrollerRateLimitedRateLimit); !allowed {
+			return err
+		}
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "RateLimited")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.RateLimited()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'RateLimited'",
+				Status:     statusCode,
+				Instance:   "/controller/error/RateLimited",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/rate-limited")), e2EcontrollerRateLimitedHandler)
+	e2EcontrollerTimedOutErrorStatusCodes := []int{}
+	e2EcontrollerTimedOutTimeout := newOperationTimeout("50ms")
+	e2EcontrollerTimedOutHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		cancelTimeout := applyOperationTimeout(fiberCtx, e2EcontrollerTimedOutTimeout)
+		defer cancelTimeout()
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "TimedOut")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		if isDeadlineExceeded(fiberCtx) {
+			return handleDeadlineExceeded(fiberCtx, "TimedOut", http.StatusServiceUnavailable)
+		}
+		value, opError := controller.TimedOut(getRequestContext(fiberCtx))
+		if isDeadlineExceeded(fiberCtx) {
+			return handleDeadlineExceeded(fiberCtx, "TimedOut", http.StatusGatewayTimeout)
+		}
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTimedOutErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TimedOut'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TimedOut",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTimedOutErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/timeout")), e2EcontrollerTimedOutHandler)
+	e2EcontrollerMaxBodySizeErrorStatusCodes := []int{}
+	e2EcontrollerMaxBodySizeHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		if allowed, err := enforceMaxBodySize(fiberCtx, "MaxBodySize", 64); !allowed {
+			return err
+		}
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "MaxBodySize")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		var conversionErr error
+		var dataRawPtr *Param8data.ObjectWithByteSlice = nil
+		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, conversionErr)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "MaxBodySize",
+						"parameter": "data",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/MaxBodySize",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.MaxBodySize(*dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MaxBodySize'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MaxBodySize",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/max-body-size")), e2EcontrollerMaxBodySizeHandler)
+	e2EcontrollerIdempotentErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "Idempotent")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed, hashErr := hashRequestBody(fiberCtx, "Idempotent", 0)
+		if !isBodyHashed {
+			return hashErr
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		completeIdempotentRequest, idempotencyErr := beginIdempotentRequest(fiberCtx, "Idempotent", idempotentRequestHash, e2EcontrollerIdempotentIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return idempotencyErr
+		}
+		defer completeIdempotentRequest()
+		value, opError := controller.Idempotent()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Idempotent'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Idempotent",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/idempotent")), e2EcontrollerIdempotentHandler)
+	e2EcontrollerIdempotentWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentWithBodyIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentWithBodyHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "IdempotentWithBody")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed, hashErr := hashRequestBody(fiberCtx, "IdempotentWithBody", 0)
+		if !isBodyHashed {
+			return hashErr
+		}
+		var conversionErr error
+		var dataRawPtr *Param5data.BodyInfo = nil
+		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, conversionErr)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "IdempotentWithBody",
+						"parameter": "data",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/IdempotentWithBody",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		completeIdempotentRequest, idempotencyErr := beginIdempotentRequest(fiberCtx, "IdempotentWithBody", idempotentRequestHash, e2EcontrollerIdempotentWithBodyIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return idempotencyErr
+		}
+		defer completeIdempotentRequest()
+		value, opError := controller.IdempotentWithBody(*dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'IdempotentWithBody'",
+				Status:     statusCode,
+				Instance:   "/controller/error/IdempotentWithBody",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/idempotent-with-body")), e2EcontrollerIdempotentWithBodyHandler)
+	e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecuritySameMethodHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"write",
+								"read",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "WithTwoSecuritySameMethod")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		var headerParamRawPtr *string = nil
+		headerParamRaw := fiberCtx.Get("x-test-scopes")
+		isheaderParamExists := len(fiberCtx.Request().Header.Peek("x-test-scopes")) > 0
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, validatorErr)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			// validation error response extension placeholder
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithTwoSecuritySameMethod",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/with-two-security-same-method")), e2EcontrollerWithTwoSecuritySameMethodHandler)
+	e2EcontrollerDefaultErrorErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "DefaultError")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.DefaultError()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DefaultError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DefaultError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/default-error")), e2EcontrollerDefaultErrorHandler)
+	e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorWithPayloadHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "DefaultErrorWithPayload")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.DefaultErrorWithPayload()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DefaultErrorWithPayload",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/default-error-with-payload")), e2EcontrollerDefaultErrorWithPayloadHandler)
+	e2EcontrollerInjectedDependencyErrorStatusCodes := []int{}
+	e2EcontrollerInjectedDependencyHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "InjectedDependency")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.InjectedDependency()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'InjectedDependency'",
+				Status:     statusCode,
+				Instance:   "/controller/error/InjectedDependency",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/injected-dependency")), e2EcontrollerInjectedDependencyHandler)
+	e2EcontrollerMappedErrorErrorStatusCodes := []int{404}
+	e2EcontrollerMappedErrorHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "MappedError")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.MappedError()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerMappedErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MappedError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MappedError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedErrorErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/mapped-error")), e2EcontrollerMappedErrorHandler)
+	e2EcontrollerUndeclaredMappedErrorErrorStatusCodes := []int{}
+	e2EcontrollerUndeclaredMappedErrorHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "UndeclaredMappedError")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.UndeclaredMappedError()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'UndeclaredMappedError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/UndeclaredMappedError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/undeclared-mapped-error")), e2EcontrollerUndeclaredMappedErrorHandler)
+	e2EcontrollerMappedTypedErrorErrorStatusCodes := []int{409}
+	e2EcontrollerMappedTypedErrorHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "MappedTypedError")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.MappedTypedError()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MappedTypedError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MappedTypedError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/mapped-typed-error")), e2EcontrollerMappedTypedErrorHandler)
+	e2EcontrollerPanicErrorStatusCodes := []int{}
+	e2EcontrollerPanicHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "Panic")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2774,12 +5711,12 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.DefaultErrorWithPayload()
+		value, opError := controller.Panic()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPanicErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2792,11 +5729,12 @@ func RegisterRoutes(engine *fiber.App) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
+				Detail:     "Encountered an error during operation 'Panic'",
 				Status:     statusCode,
-				Instance:   "/controller/error/DefaultErrorWithPayload",
+				Instance:   "/controller/error/Panic",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPanicErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -2814,8 +5752,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/custom-error"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/panic")), e2EcontrollerPanicHandler)
+	e2EcontrollerCustomErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomErrorHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -2836,7 +5776,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "CustomError")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2853,8 +5793,8 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		emptyErr := Response6CustomError.CustomError{}
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomErrorErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
 		if opError != emptyErr {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2881,8 +5821,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/custom-error-ptr"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/custom-error")), e2EcontrollerCustomErrorHandler)
+	e2EcontrollerCustomPtrErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomPtrErrorHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -2903,7 +5845,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "CustomPtrError")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2920,7 +5862,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomPtrErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2947,8 +5889,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/503-error-code"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/custom-error-ptr")), e2EcontrollerCustomPtrErrorHandler)
+	e2EcontrollerError503ErrorStatusCodes := []int{}
+	e2EcontrollerError503Handler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -2969,7 +5913,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "Error503")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2986,7 +5930,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerError503ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3004,6 +5948,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/Error503",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerError503ErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3021,8 +5966,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/custom-error-503"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/503-error-code")), e2EcontrollerError503Handler)
+	e2EcontrollerCustomError503ErrorStatusCodes := []int{}
+	e2EcontrollerCustomError503Handler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -3043,7 +5990,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "CustomError503")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3060,8 +6007,8 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		emptyErr := Response6CustomError.CustomError{}
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomError503ErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
 		if opError != emptyErr {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3088,8 +6035,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/context-access"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/custom-error-503")), e2EcontrollerCustomError503Handler)
+	e2EcontrollerContextAccessErrorStatusCodes := []int{}
+	e2EcontrollerContextAccessHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -3110,7 +6059,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ContextAccess")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3127,7 +6076,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextAccessErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3145,6 +6094,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ContextAccess",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextAccessErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3162,8 +6112,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/http-method"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/context-access")), e2EcontrollerContextAccessHandler)
+	e2EcontrollerGetErrorStatusCodes := []int{}
+	e2EcontrollerGetHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -3184,7 +6136,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "Get")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3201,7 +6153,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerGetErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3219,6 +6171,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/Get",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3236,8 +6189,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/http-method"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerGetHandler)
+	e2EcontrollerPostErrorStatusCodes := []int{}
+	e2EcontrollerPostHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -3258,7 +6213,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "Post")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3275,7 +6230,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPostErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3293,6 +6248,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/Post",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3310,8 +6266,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Put(toFiberUrl("/e2e/http-method"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPostHandler)
+	e2EcontrollerPutErrorStatusCodes := []int{}
+	e2EcontrollerPutHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -3332,7 +6290,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "Put")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3349,7 +6307,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPutErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3367,6 +6325,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/Put",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPutErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3384,8 +6343,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Delete(toFiberUrl("/e2e/http-method"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("PUT", toFiberUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPutHandler)
+	e2EcontrollerDeleteErrorStatusCodes := []int{}
+	e2EcontrollerDeleteHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -3406,7 +6367,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "Delete")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3423,7 +6384,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDeleteErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3441,6 +6402,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/Delete",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeleteErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3458,8 +6420,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Patch(toFiberUrl("/e2e/http-method"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("DELETE", toFiberUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerDeleteHandler)
+	e2EcontrollerPatchErrorStatusCodes := []int{}
+	e2EcontrollerPatchHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -3480,7 +6444,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "Patch")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3497,7 +6461,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPatchErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3515,6 +6479,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/Patch",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPatchErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3532,8 +6497,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/template-context-1"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("PATCH", toFiberUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPatchHandler)
+	e2EcontrollerTemplateContext1ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext1Handler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -3554,7 +6521,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TemplateContext1")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3571,7 +6538,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3589,6 +6556,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TemplateContext1",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3606,8 +6574,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/template-context-2"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/template-context-1")), e2EcontrollerTemplateContext1Handler)
+	e2EcontrollerTemplateContext2ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext2Handler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -3628,7 +6598,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TemplateContext2")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3645,7 +6615,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3663,6 +6633,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TemplateContext2",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3680,8 +6651,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/form"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/template-context-2")), e2EcontrollerTemplateContext2Handler)
+	e2EcontrollerTestFormErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -3702,7 +6675,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TestForm")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var item1RawPtr *string = nil
 		item1Raw := fiberCtx.FormValue("item1")
@@ -3722,9 +6695,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item1"
-			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var item2RawPtr *string = nil
 		item2Raw := fiberCtx.FormValue("item2")
@@ -3744,9 +6719,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item2"
-			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3763,7 +6740,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3781,6 +6758,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TestForm",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3798,8 +6776,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/form-extra"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/form")), e2EcontrollerTestFormHandler)
+	e2EcontrollerTestFormExtraErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormExtraHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -3820,13 +6800,13 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TestFormExtra")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var item1RawPtr *int64 = nil
 		item1Raw := fiberCtx.FormValue("item1")
 		isitem1Exists := fiberCtx.Context().PostArgs().Has("item1")
 		if isitem1Exists {
-			item1Uint64, conversionErr := strconv.ParseInt(item1Raw, 10, 64)
+			item1, conversionErr := parseIntegerParam[int64](item1Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -3837,22 +6817,19 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestFormExtra' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"item1",
-						"int64",
-						reflect.TypeOf(item1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestFormExtra",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item1",
+					"form",
+					"/item1",
+					"int64",
+					reflect.TypeOf(item1Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			item1 := int64(item1Uint64)
 			item1RawPtr = &item1
 		}
 		if validatorErr := validatorInstance.Var(item1RawPtr, "required,gte=80"); validatorErr != nil {
@@ -3866,9 +6843,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item1"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var item2RawPtr *string = nil
 		item2Raw := fiberCtx.FormValue("item2")
@@ -3888,15 +6867,17 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item2"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var item3RawPtr *int64 = nil
 		item3Raw := fiberCtx.Query("item3")
 		isitem3Exists := fiberCtx.Context().QueryArgs().Has("item3")
 		if isitem3Exists {
-			item3Uint64, conversionErr := strconv.ParseInt(item3Raw, 10, 64)
+			item3, conversionErr := parseIntegerParam[int64](item3Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -3907,22 +6888,19 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestFormExtra' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"item3",
-						"int64",
-						reflect.TypeOf(item3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestFormExtra",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item3",
+					"query",
+					"/item3",
+					"int64",
+					reflect.TypeOf(item3Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			item3 := int64(item3Uint64)
 			item3RawPtr = &item3
 		}
 		if validatorErr := validatorInstance.Var(item3RawPtr, "required,gte=80"); validatorErr != nil {
@@ -3936,9 +6914,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item3"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/item3", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3955,7 +6935,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3973,6 +6953,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TestFormExtra",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3990,8 +6971,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/test-response-validation"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/form-extra")), e2EcontrollerTestFormExtraHandler)
+	e2EcontrollerTestResponseValidationErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -4012,7 +6995,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TestResponseValidation")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4029,7 +7012,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4047,6 +7030,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TestResponseValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -4064,8 +7048,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/test-response-validation-ptr"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/test-response-validation")), e2EcontrollerTestResponseValidationHandler)
+	e2EcontrollerTestResponseValidationPtrErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationPtrHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -4086,7 +7072,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TestResponseValidationPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4103,7 +7089,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4121,6 +7107,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TestResponseValidationPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -4138,8 +7125,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/test-response-validation-null"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/test-response-validation-ptr")), e2EcontrollerTestResponseValidationPtrHandler)
+	e2EcontrollerTestResponseValidationNullErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationNullHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -4160,7 +7149,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TestResponseValidationNull")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4177,7 +7166,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4195,6 +7184,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TestResponseValidationNull",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -4212,8 +7202,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/test-primitive-conversions"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/test-response-validation-null")), e2EcontrollerTestResponseValidationNullHandler)
+	e2EcontrollerTestPrimitiveConversionsErrorStatusCodes := []int{500}
+	e2EcontrollerTestPrimitiveConversionsHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -4234,13 +7226,13 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TestPrimitiveConversions")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var value1RawPtr *int64 = nil
 		value1Raw := fiberCtx.Query("value1")
 		isvalue1Exists := fiberCtx.Context().QueryArgs().Has("value1")
 		if isvalue1Exists {
-			value1Uint64, conversionErr := strconv.ParseInt(value1Raw, 10, 64)
+			value1, conversionErr := parseIntegerParam[int64](value1Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4251,22 +7243,19 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"int64",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value1",
+					"query",
+					"/value1",
+					"int64",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value1 := int64(value1Uint64)
 			value1RawPtr = &value1
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -4280,15 +7269,17 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value2RawPtr *bool = nil
 		value2Raw := fiberCtx.Query("value2")
 		isvalue2Exists := fiberCtx.Context().QueryArgs().Has("value2")
 		if isvalue2Exists {
-			value2Bool, conversionErr := strconv.ParseBool(value2Raw)
+			value2, conversionErr := strconv.ParseBool(value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4299,22 +7290,19 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"bool",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value2",
+					"query",
+					"/value2",
+					"bool",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value2 := value2Bool
 			value2RawPtr = &value2
 		}
 		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
@@ -4328,15 +7316,17 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value3RawPtr *int = nil
 		value3Raw := fiberCtx.Query("value3")
 		isvalue3Exists := fiberCtx.Context().QueryArgs().Has("value3")
 		if isvalue3Exists {
-			value3Uint64, conversionErr := strconv.Atoi(value3Raw)
+			value3, conversionErr := parseIntegerParam[int](value3Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4347,22 +7337,19 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value3",
-						"int",
-						reflect.TypeOf(value3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value3",
+					"query",
+					"/value3",
+					"int",
+					reflect.TypeOf(value3Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value3 := int(value3Uint64)
 			value3RawPtr = &value3
 		}
 		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
@@ -4376,15 +7363,17 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value3"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value3", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value4RawPtr *float64 = nil
 		value4Raw := fiberCtx.Query("value4")
 		isvalue4Exists := fiberCtx.Context().QueryArgs().Has("value4")
 		if isvalue4Exists {
-			value4Float64, conversionErr := strconv.ParseFloat(value4Raw, 64)
+			value4, conversionErr := parseFloatParam[float64](value4Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4395,22 +7384,19 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value4",
-						"float64",
-						reflect.TypeOf(value4Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value4",
+					"query",
+					"/value4",
+					"float64",
+					reflect.TypeOf(value4Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value4 := float64(value4Float64)
 			value4RawPtr = &value4
 		}
 		if validatorErr := validatorInstance.Var(value4RawPtr, "required"); validatorErr != nil {
@@ -4424,9 +7410,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value4"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value4", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4443,7 +7431,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4461,6 +7449,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TestPrimitiveConversions",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -4478,8 +7467,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/test-enums"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/test-primitive-conversions")), e2EcontrollerTestPrimitiveConversionsHandler)
+	e2EcontrollerTestEnumsErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -4500,15 +7491,15 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TestEnums")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var conversionErr error
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw := fiberCtx.Query("value1")
 		isvalue1Exists := fiberCtx.Context().QueryArgs().Has("value1")
 		if isvalue1Exists {
 			value1 := value1Raw
-			value1Var := Param12value1.StatusEnumeration(value1)
+			value1Var := Param15value1.StatusEnumeration(value1)
 			value1RawPtr = &value1Var
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -4522,15 +7513,17 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value2RawPtr *Param4value2.NumberEnumeration = nil
 		value2Raw := fiberCtx.Query("value2")
 		isvalue2Exists := fiberCtx.Context().QueryArgs().Has("value2")
 		if isvalue2Exists {
-			value2Uint64, conversionErr := strconv.Atoi(value2Raw)
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4541,22 +7534,19 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnums' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnums",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value2",
+					"query",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value2 := int(value2Uint64)
 			value2Var := Param4value2.NumberEnumeration(value2)
 			value2RawPtr = &value2Var
 		}
@@ -4571,11 +7561,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var value3RawPtr *Param11value3.ObjectWithEnum = nil
+		var value3RawPtr *Param14value3.ObjectWithEnum = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &value3RawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -4587,19 +7579,25 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'TestEnums' but body parameter '%s' did not pass validation of '%s' - %s",
-					"value3",
-					"ObjectWithEnum",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "TestEnums",
+						"parameter": "value3",
+						"type":      "ObjectWithEnum",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/TestEnums",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4616,7 +7614,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4634,6 +7632,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TestEnums",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -4651,8 +7650,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/test-enums-in-all/{value1}"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/test-enums")), e2EcontrollerTestEnumsHandler)
+	e2EcontrollerTestEnumsInAllErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsInAllHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -4673,14 +7674,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TestEnumsInAll")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw := fiberCtx.Params("value1")
 		isvalue1Exists := true // if parameter is in route but not provided, it won't reach this handler
 		if isvalue1Exists {
 			value1 := value1Raw
-			value1Var := Param12value1.StatusEnumeration(value1)
+			value1Var := Param15value1.StatusEnumeration(value1)
 			value1RawPtr = &value1Var
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -4694,15 +7695,17 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/value1", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value2RawPtr *Param4value2.NumberEnumeration = nil
 		value2Raw := fiberCtx.Get("value2")
 		isvalue2Exists := len(fiberCtx.Request().Header.Peek("value2")) > 0
 		if isvalue2Exists {
-			value2Uint64, conversionErr := strconv.Atoi(value2Raw)
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4713,22 +7716,19 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value2",
+					"header",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value2 := int(value2Uint64)
 			value2Var := Param4value2.NumberEnumeration(value2)
 			value2RawPtr = &value2Var
 		}
@@ -4743,16 +7743,18 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/value2", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var value3RawPtr *Param12value3.StatusEnumeration = nil
+		var value3RawPtr *Param15value3.StatusEnumeration = nil
 		value3Raw := fiberCtx.FormValue("value3")
 		isvalue3Exists := fiberCtx.Context().PostArgs().Has("value3")
 		if isvalue3Exists {
 			value3 := value3Raw
-			value3Var := Param12value3.StatusEnumeration(value3)
+			value3Var := Param15value3.StatusEnumeration(value3)
 			value3RawPtr = &value3Var
 		}
 		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
@@ -4766,9 +7768,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value3"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/value3", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4785,7 +7789,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4803,6 +7807,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TestEnumsInAll",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -4820,8 +7825,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/test-enums-optional"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/test-enums-in-all/{value1}")), e2EcontrollerTestEnumsInAllHandler)
+	e2EcontrollerTestEnumsOptionalErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsOptionalHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -4842,14 +7849,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TestEnumsOptional")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw := fiberCtx.Get("value1")
 		isvalue1Exists := len(fiberCtx.Request().Header.Peek("value1")) > 0
 		if isvalue1Exists {
 			value1 := value1Raw
-			value1Var := Param12value1.StatusEnumeration(value1)
+			value1Var := Param15value1.StatusEnumeration(value1)
 			value1RawPtr = &value1Var
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -4867,7 +7874,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4885,6 +7892,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TestEnumsOptional",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -4902,8 +7910,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/external-packages"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/test-enums-optional")), e2EcontrollerTestEnumsOptionalHandler)
+	e2EcontrollerExternalPackagesErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -4924,18 +7934,18 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ExternalPackages")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var conversionErr error
-		var unitRawPtr *Param14unit.LengthUnits = nil
+		var unitRawPtr *Param17unit.LengthUnits = nil
 		unitRaw := fiberCtx.Query("unit")
 		isunitExists := fiberCtx.Context().QueryArgs().Has("unit")
 		if isunitExists {
 			unit := unitRaw
-			unitVar := Param14unit.LengthUnits(unit)
+			unitVar := Param17unit.LengthUnits(unit)
 			unitRawPtr = &unitVar
 		}
-		var dataRawPtr *Param13data.LengthDto = nil
+		var dataRawPtr *Param16data.LengthDto = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -4947,19 +7957,25 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackages' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"LengthDto",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackages",
+						"parameter": "data",
+						"type":      "LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackages",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4976,7 +7992,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4994,6 +8010,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ExternalPackages",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -5011,8 +8028,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/external-packages-unique-in-struct"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/external-packages")), e2EcontrollerExternalPackagesHandler)
+	e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesUniqueInStructHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -5033,10 +8052,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ExternalPackagesUniqueInStruct")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var conversionErr error
-		var dataRawPtr *Param15data.UniqueExternalUsage = nil
+		var dataRawPtr *Param18data.UniqueExternalUsage = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5048,19 +8067,25 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackagesUniqueInStruct' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"UniqueExternalUsage",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesUniqueInStruct",
+						"parameter": "data",
+						"type":      "UniqueExternalUsage",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackagesUniqueInStruct",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5077,7 +8102,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5095,6 +8120,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ExternalPackagesUniqueInStruct",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -5112,8 +8138,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/external-packages-validation"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/external-packages-unique-in-struct")), e2EcontrollerExternalPackagesUniqueInStructHandler)
+	e2EcontrollerExternalPackagesValidationErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesValidationHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -5134,18 +8162,18 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ExternalPackagesValidation")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var conversionErr error
-		var unitRawPtr *Param14unit.LengthUnits = nil
+		var unitRawPtr *Param17unit.LengthUnits = nil
 		unitRaw := fiberCtx.Query("unit")
 		isunitExists := fiberCtx.Context().QueryArgs().Has("unit")
 		if isunitExists {
 			unit := unitRaw
-			unitVar := Param14unit.LengthUnits(unit)
+			unitVar := Param17unit.LengthUnits(unit)
 			unitRawPtr = &unitVar
 		}
-		var dataRawPtr *Param16data.LengthDtoWithValidation = nil
+		var dataRawPtr *Param19data.LengthDtoWithValidation = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5157,19 +8185,25 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackagesValidation' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"LengthDtoWithValidation",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesValidation",
+						"parameter": "data",
+						"type":      "LengthDtoWithValidation",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackagesValidation",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5186,7 +8220,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5204,6 +8238,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ExternalPackagesValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -5221,8 +8256,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/arrays-in-body-and-res"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/external-packages-validation")), e2EcontrollerExternalPackagesValidationHandler)
+	e2EcontrollerArraysInBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInBodyAndResHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -5243,10 +8280,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ArraysInBodyAndRes")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var conversionErr error
-		var dataRawPtr *[]Param13data.LengthDto = nil
+		var dataRawPtr *[]Param16data.LengthDto = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5258,19 +8295,25 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ArraysInBodyAndRes' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[]LengthDto",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInBodyAndRes",
+						"parameter": "data",
+						"type":      "[]LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ArraysInBodyAndRes",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5287,7 +8330,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5305,6 +8348,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ArraysInBodyAndRes",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -5322,8 +8366,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/arrays-inside-body-and-res"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/arrays-in-body-and-res")), e2EcontrollerArraysInBodyAndResHandler)
+	e2EcontrollerArraysInsideBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInsideBodyAndResHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -5344,10 +8390,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ArraysInsideBodyAndRes")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var conversionErr error
-		var dataRawPtr *[]Param17data.BlaBla = nil
+		var dataRawPtr *[]Param20data.BlaBla = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5359,19 +8405,25 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ArraysInsideBodyAndRes' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[]BlaBla",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInsideBodyAndRes",
+						"parameter": "data",
+						"type":      "[]BlaBla",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ArraysInsideBodyAndRes",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5388,7 +8440,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5406,6 +8458,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ArraysInsideBodyAndRes",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -5423,8 +8476,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/deep-arrays-with-validation"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/arrays-inside-body-and-res")), e2EcontrollerArraysInsideBodyAndResHandler)
+	e2EcontrollerDeepArraysWithValidationErrorStatusCodes := []int{500}
+	e2EcontrollerDeepArraysWithValidationHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -5445,10 +8500,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "DeepArraysWithValidation")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var conversionErr error
-		var dataRawPtr *[][]Param18data.BlaBla2 = nil
+		var dataRawPtr *[][]Param21data.BlaBla2 = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5460,19 +8515,25 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'DeepArraysWithValidation' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[][]BlaBla2",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "DeepArraysWithValidation",
+						"parameter": "data",
+						"type":      "[][]BlaBla2",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/DeepArraysWithValidation",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5489,7 +8550,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5507,6 +8568,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/DeepArraysWithValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -5524,8 +8586,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/embedded-structs"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/deep-arrays-with-validation")), e2EcontrollerDeepArraysWithValidationHandler)
+	e2EcontrollerEmbeddedStructsErrorStatusCodes := []int{500}
+	e2EcontrollerEmbeddedStructsHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -5546,10 +8610,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "EmbeddedStructs")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var conversionErr error
-		var dataRawPtr *Param19data.TheModel = nil
+		var dataRawPtr *Param22data.TheModel = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5561,19 +8625,25 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'EmbeddedStructs' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModel",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "EmbeddedStructs",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/EmbeddedStructs",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5590,7 +8660,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5608,6 +8678,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/EmbeddedStructs",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -5625,8 +8696,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/structs-with-inner-pointer"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/embedded-structs")), e2EcontrollerEmbeddedStructsHandler)
+	e2EcontrollerStructsWithInnerPointerErrorStatusCodes := []int{500}
+	e2EcontrollerStructsWithInnerPointerHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -5647,10 +8720,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "StructsWithInnerPointer")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var conversionErr error
-		var dataRawPtr *Param20data.TheModelWithInnerPointer = nil
+		var dataRawPtr *Param23data.TheModelWithInnerPointer = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5662,19 +8735,25 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'StructsWithInnerPointer' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModelWithInnerPointer",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "StructsWithInnerPointer",
+						"parameter": "data",
+						"type":      "TheModelWithInnerPointer",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/StructsWithInnerPointer",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5691,7 +8770,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5709,6 +8788,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/StructsWithInnerPointer",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -5726,8 +8806,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/context-injection-empty"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/structs-with-inner-pointer")), e2EcontrollerStructsWithInnerPointerHandler)
+	e2EcontrollerContextInjectionEmptyErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionEmptyHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -5748,7 +8830,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ContextInjectionEmpty")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5765,7 +8847,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5783,6 +8865,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ContextInjectionEmpty",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -5800,8 +8883,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/context-injection"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/context-injection-empty")), e2EcontrollerContextInjectionEmptyHandler)
+	e2EcontrollerContextInjectionErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -5822,10 +8907,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ContextInjection")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var conversionErr error
-		var dataRawPtr *Param19data.TheModel = nil
+		var dataRawPtr *Param22data.TheModel = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5837,19 +8922,25 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ContextInjection' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModel",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ContextInjection",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ContextInjection",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5866,7 +8957,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5884,6 +8975,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ContextInjection",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -5901,8 +8993,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/byte-slice"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/context-injection")), e2EcontrollerContextInjectionHandler)
+	e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithByteSliceHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -5923,10 +9017,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ReturnsStructWithByteSlice")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var conversionErr error
-		var arriveRawPtr *Param22arrive.ObjectWithByteSlice = nil
+		var arriveRawPtr *Param8arrive.ObjectWithByteSlice = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "", &arriveRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5938,19 +9032,25 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ReturnsStructWithByteSlice' but body parameter '%s' did not pass validation of '%s' - %s",
-					"arrive",
-					"ObjectWithByteSlice",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithByteSlice",
+						"parameter": "arrive",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ReturnsStructWithByteSlice",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5967,7 +9067,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5985,6 +9085,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ReturnsStructWithByteSlice",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -6002,8 +9103,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/special-primitives"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/byte-slice")), e2EcontrollerReturnsStructWithByteSliceHandler)
+	e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithSpecialPrimitivesHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -6024,10 +9127,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ReturnsStructWithSpecialPrimitives")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var conversionErr error
-		var arriveRawPtr *Param23arrive.ObjectWithSpecialPrimitives = nil
+		var arriveRawPtr *Param24arrive.ObjectWithSpecialPrimitives = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "", &arriveRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6039,19 +9142,25 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ReturnsStructWithSpecialPrimitives' but body parameter '%s' did not pass validation of '%s' - %s",
-					"arrive",
-					"ObjectWithSpecialPrimitives",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithSpecialPrimitives",
+						"parameter": "arrive",
+						"type":      "ObjectWithSpecialPrimitives",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ReturnsStructWithSpecialPrimitives",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -6068,7 +9177,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6086,6 +9195,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ReturnsStructWithSpecialPrimitives",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -6103,8 +9213,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/alias-of-primitive"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/special-primitives")), e2EcontrollerReturnsStructWithSpecialPrimitivesHandler)
+	e2EcontrollerAliasOfStringErrorStatusCodes := []int{}
+	e2EcontrollerAliasOfStringHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -6125,10 +9237,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "AliasOfString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var conversionErr error
-		var objectRawPtr *Param24object.ObjectWithAliasOfString = nil
+		var objectRawPtr *Param25object.ObjectWithAliasOfString = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &objectRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6140,25 +9252,31 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'AliasOfString' but body parameter '%s' did not pass validation of '%s' - %s",
-					"object",
-					"ObjectWithAliasOfString",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "AliasOfString",
+						"parameter": "object",
+						"type":      "ObjectWithAliasOfString",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/AliasOfString",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var numRawPtr *Param25num.AliasOfInt = nil
+		var numRawPtr *Param26num.AliasOfInt = nil
 		numRaw := fiberCtx.Query("num")
 		isnumExists := fiberCtx.Context().QueryArgs().Has("num")
 		if isnumExists {
-			numUint64, conversionErr := strconv.Atoi(numRaw)
+			num, conversionErr := parseIntegerParam[int](numRaw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -6169,23 +9287,20 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'AliasOfString' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"num",
-						"AliasOfInt",
-						reflect.TypeOf(numRaw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/AliasOfString",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"AliasOfString",
+					"num",
+					"query",
+					"/num",
+					"AliasOfInt",
+					reflect.TypeOf(numRaw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			num := int(numUint64)
-			numVar := Param25num.AliasOfInt(num)
+			numVar := Param26num.AliasOfInt(num)
 			numRawPtr = &numVar
 		}
 		if validatorErr := validatorInstance.Var(numRawPtr, "required"); validatorErr != nil {
@@ -6199,16 +9314,18 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "num"
-			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/num", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var strRawPtr *Param26str.AliasOfDirectString = nil
+		var strRawPtr *Param27str.AliasOfDirectString = nil
 		strRaw := fiberCtx.Query("str")
 		isstrExists := fiberCtx.Context().QueryArgs().Has("str")
 		if isstrExists {
 			str := strRaw
-			strVar := Param26str.AliasOfDirectString(str)
+			strVar := Param27str.AliasOfDirectString(str)
 			strRawPtr = &strVar
 		}
 		if validatorErr := validatorInstance.Var(strRawPtr, "required"); validatorErr != nil {
@@ -6222,9 +9339,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "str"
-			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/str", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -6241,7 +9360,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6259,6 +9378,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/AliasOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -6276,8 +9396,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/body-array-of-string"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/alias-of-primitive")), e2EcontrollerAliasOfStringHandler)
+	e2EcontrollerBodyArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -6298,7 +9420,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "BodyArrayOfString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var conversionErr error
 		var valuesRawPtr *[]string = nil
@@ -6313,19 +9435,25 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'BodyArrayOfString' but body parameter '%s' did not pass validation of '%s' - %s",
-					"values",
-					"[]string",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfString",
+						"parameter": "values",
+						"type":      "[]string",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/BodyArrayOfString",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -6342,7 +9470,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6360,6 +9488,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/BodyArrayOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -6377,8 +9506,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/body-array-of-enum-string"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/body-array-of-string")), e2EcontrollerBodyArrayOfStringHandler)
+	e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringEnumHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -6399,10 +9530,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "BodyArrayOfStringEnum")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var conversionErr error
-		var valuesRawPtr *[]Param27values.Myemamium = nil
+		var valuesRawPtr *[]Param28values.Myemamium = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &valuesRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6414,19 +9545,25 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'BodyArrayOfStringEnum' but body parameter '%s' did not pass validation of '%s' - %s",
-					"values",
-					"[]Myemamium",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfStringEnum",
+						"parameter": "values",
+						"type":      "[]Myemamium",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/BodyArrayOfStringEnum",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -6443,7 +9580,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6461,6 +9598,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/BodyArrayOfStringEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -6478,8 +9616,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/query-array-of-string"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/body-array-of-enum-string")), e2EcontrollerBodyArrayOfStringEnumHandler)
+	e2EcontrollerQueryArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfStringHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -6500,7 +9640,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "QueryArrayOfString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var valuesRawPtr *[]string = nil
 		valuesRawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values")
@@ -6529,9 +9669,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -6548,7 +9690,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6566,6 +9708,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/QueryArrayOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -6583,8 +9726,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/query-array-of-enum"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/query-array-of-string")), e2EcontrollerQueryArrayOfStringHandler)
+	e2EcontrollerQueryArrayOfEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfEnumHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -6605,9 +9750,9 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "QueryArrayOfEnum")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
-		var valuesRawPtr *[]Param27values.Myemamium = nil
+		var valuesRawPtr *[]Param28values.Myemamium = nil
 		valuesRawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values")
 		valuesRawArray := make([]string, len(valuesRawArrayBytes))
 		for i, v := range valuesRawArrayBytes {
@@ -6615,13 +9760,13 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		isvaluesExists := fiberCtx.Context().QueryArgs().Has("values")
 		if isvaluesExists {
-			values := make([]Param27values.Myemamium, 0, len(valuesRawArray))
+			values := make([]Param28values.Myemamium, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
 				valuesItem := valuesRaw
-				values = append(values, Param27values.Myemamium(valuesItem))
+				values = append(values, Param28values.Myemamium(valuesItem))
 			}
 			valuesRawPtr = &values
-			valuesVar := []Param27values.Myemamium(values)
+			valuesVar := []Param28values.Myemamium(values)
 			valuesRawPtr = &valuesVar
 		}
 		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
@@ -6635,11 +9780,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var values2RawPtr *[]Param28values2.MyaliasString = nil
+		var values2RawPtr *[]Param29values2.MyaliasString = nil
 		values2RawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values2")
 		values2RawArray := make([]string, len(values2RawArrayBytes))
 		for i, v := range values2RawArrayBytes {
@@ -6647,13 +9794,13 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		isvalues2Exists := fiberCtx.Context().QueryArgs().Has("values2")
 		if isvalues2Exists {
-			values2 := make([]Param28values2.MyaliasString, 0, len(values2RawArray))
+			values2 := make([]Param29values2.MyaliasString, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
 				values2Item := values2Raw
-				values2 = append(values2, Param28values2.MyaliasString(values2Item))
+				values2 = append(values2, Param29values2.MyaliasString(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param28values2.MyaliasString(values2)
+			values2Var := []Param29values2.MyaliasString(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -6667,9 +9814,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -6686,7 +9835,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6704,6 +9853,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/QueryArrayOfEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -6721,8 +9871,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/query-array-of-others"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/query-array-of-enum")), e2EcontrollerQueryArrayOfEnumHandler)
+	e2EcontrollerQueryArrayOfOthersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -6743,7 +9895,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "QueryArrayOfOthers")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var valuesRawPtr *[]int = nil
 		valuesRawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values")
@@ -6755,7 +9907,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if isvaluesExists {
 			values := make([]int, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
-				valuesUint64, conversionErr := strconv.Atoi(valuesRaw)
+				valuesItem, conversionErr := parseIntegerParam[int](valuesRaw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -6766,22 +9918,19 @@ func RegisterRoutes(engine *fiber.App) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]int",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values",
+						"query",
+						"/values",
+						"[]int",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+					)
 					// params validation error response extension placeholder
-					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				valuesItem := int(valuesUint64)
 				values = append(values, int(valuesItem))
 			}
 			valuesRawPtr = &values
@@ -6798,11 +9947,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var values2RawPtr *[]Param29values2.MyaliasInt = nil
+		var values2RawPtr *[]Param30values2.MyaliasInt = nil
 		values2RawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values2")
 		values2RawArray := make([]string, len(values2RawArrayBytes))
 		for i, v := range values2RawArrayBytes {
@@ -6810,9 +9961,9 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		isvalues2Exists := fiberCtx.Context().QueryArgs().Has("values2")
 		if isvalues2Exists {
-			values2 := make([]Param29values2.MyaliasInt, 0, len(values2RawArray))
+			values2 := make([]Param30values2.MyaliasInt, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
-				values2Uint64, conversionErr := strconv.Atoi(values2Raw)
+				values2Item, conversionErr := parseIntegerParam[int](values2Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -6823,26 +9974,23 @@ func RegisterRoutes(engine *fiber.App) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values2",
-							"[]MyaliasInt",
-							reflect.TypeOf(values2Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values2",
+						"query",
+						"/values2",
+						"[]MyaliasInt",
+						reflect.TypeOf(values2Raw).String(),
+						getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+					)
 					// params validation error response extension placeholder
-					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				values2Item := int(values2Uint64)
-				values2 = append(values2, Param29values2.MyaliasInt(values2Item))
+				values2 = append(values2, Param30values2.MyaliasInt(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param29values2.MyaliasInt(values2)
+			values2Var := []Param30values2.MyaliasInt(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -6856,9 +10004,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var values3RawPtr *[]bool = nil
 		values3RawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values3")
@@ -6870,7 +10020,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if isvalues3Exists {
 			values3 := make([]bool, 0, len(values3RawArray))
 			for _, values3Raw := range values3RawArray {
-				values3Bool, conversionErr := strconv.ParseBool(values3Raw)
+				values3Item, conversionErr := strconv.ParseBool(values3Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -6881,22 +10031,19 @@ func RegisterRoutes(engine *fiber.App) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values3",
-							"[]bool",
-							reflect.TypeOf(values3Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values3",
+						"query",
+						"/values3",
+						"[]bool",
+						reflect.TypeOf(values3Raw).String(),
+						getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+					)
 					// params validation error response extension placeholder
-					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				values3Item := values3Bool
 				values3 = append(values3, bool(values3Item))
 			}
 			values3RawPtr = &values3
@@ -6913,9 +10060,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values3"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values3", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var values4RawPtr *[]int32 = nil
 		values4RawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values4")
@@ -6927,7 +10076,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if isvalues4Exists {
 			values4 := make([]int32, 0, len(values4RawArray))
 			for _, values4Raw := range values4RawArray {
-				values4Uint64, conversionErr := strconv.ParseInt(values4Raw, 10, 32)
+				values4Item, conversionErr := parseIntegerParam[int32](values4Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -6938,22 +10087,19 @@ func RegisterRoutes(engine *fiber.App) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values4",
-							"[]int32",
-							reflect.TypeOf(values4Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values4",
+						"query",
+						"/values4",
+						"[]int32",
+						reflect.TypeOf(values4Raw).String(),
+						getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+					)
 					// params validation error response extension placeholder
-					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				values4Item := int32(values4Uint64)
 				values4 = append(values4, int32(values4Item))
 			}
 			values4RawPtr = &values4
@@ -6970,9 +10116,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values4"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values4", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -6989,7 +10137,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7007,6 +10155,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/QueryArrayOfOthers",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -7024,8 +10173,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/query-array-of-others-enum"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/query-array-of-others")), e2EcontrollerQueryArrayOfOthersHandler)
+	e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersEnumHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -7046,9 +10197,9 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "QueryArrayOfOthersEnum")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
-		var valuesRawPtr *[]Param31values.NumberEnum = nil
+		var valuesRawPtr *[]Param32values.NumberEnum = nil
 		valuesRawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values")
 		valuesRawArray := make([]string, len(valuesRawArrayBytes))
 		for i, v := range valuesRawArrayBytes {
@@ -7056,9 +10207,9 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		isvaluesExists := fiberCtx.Context().QueryArgs().Has("values")
 		if isvaluesExists {
-			values := make([]Param31values.NumberEnum, 0, len(valuesRawArray))
+			values := make([]Param32values.NumberEnum, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
-				valuesUint64, conversionErr := strconv.ParseInt(valuesRaw, 10, 16)
+				valuesItem, conversionErr := parseIntegerParam[int16](valuesRaw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7069,26 +10220,23 @@ func RegisterRoutes(engine *fiber.App) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthersEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]NumberEnum",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthersEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values",
+						"query",
+						"/values",
+						"[]NumberEnum",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+					)
 					// params validation error response extension placeholder
-					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				valuesItem := int16(valuesUint64)
-				values = append(values, Param31values.NumberEnum(valuesItem))
+				values = append(values, Param32values.NumberEnum(valuesItem))
 			}
 			valuesRawPtr = &values
-			valuesVar := []Param31values.NumberEnum(values)
+			valuesVar := []Param32values.NumberEnum(values)
 			valuesRawPtr = &valuesVar
 		}
 		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
@@ -7102,11 +10250,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var values2RawPtr *[]Param32values2.BoolEnum = nil
+		var values2RawPtr *[]Param33values2.BoolEnum = nil
 		values2RawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values2")
 		values2RawArray := make([]string, len(values2RawArrayBytes))
 		for i, v := range values2RawArrayBytes {
@@ -7114,13 +10264,13 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		isvalues2Exists := fiberCtx.Context().QueryArgs().Has("values2")
 		if isvalues2Exists {
-			values2 := make([]Param32values2.BoolEnum, 0, len(values2RawArray))
+			values2 := make([]Param33values2.BoolEnum, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
 				values2Item := values2Raw
-				values2 = append(values2, Param32values2.BoolEnum(values2Item))
+				values2 = append(values2, Param33values2.BoolEnum(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param32values2.BoolEnum(values2)
+			values2Var := []Param33values2.BoolEnum(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -7134,9 +10284,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			// validation error response extension placeholder
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -7153,7 +10305,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7171,6 +10323,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/QueryArrayOfOthersEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -7188,8 +10341,10 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/query-pointer-to-array"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/query-array-of-others-enum")), e2EcontrollerQueryArrayOfOthersEnumHandler)
+	e2EcontrollerQueryArrayOfPointersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfPointersHandler := func(fiberCtx *fiber.Ctx) error {
 		// route start routes extension placeholder
 		authErr := authorize(
 			fiberCtx,
@@ -7210,7 +10365,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "QueryArrayOfPointers")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
 		var values07RawPtr *[]string = nil
 		values07RawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values07")
@@ -7243,7 +10398,7 @@ func RegisterRoutes(engine *fiber.App) {
 			fiberCtx.Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7261,6 +10416,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/QueryArrayOfPointers",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
 			// json error response extension placeholder
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -7278,5 +10434,169 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		// route end routes extension placeholder
 		return nil
-	})
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/query-pointer-to-array")), e2EcontrollerQueryArrayOfPointersHandler)
+	// E2ESharedRateLimitController
+	e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitFirstHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		if allowed, err := enforceRateLimit(fiberCtx, "SharedRateLimitFirst", e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit); !allowed {
+			return err
+		}
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "SharedRateLimitFirst")
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SharedRateLimitFirst()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitFirst'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitFirst",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/shared-rate-limit/first")), e2EsharedRateLimitControllerSharedRateLimitFirstHandler)
+	e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitSecondHandler := func(fiberCtx *fiber.Ctx) error {
+		// route start routes extension placeholder
+		if allowed, err := enforceRateLimit(fiberCtx, "SharedRateLimitSecond", e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit); !allowed {
+			return err
+		}
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "SharedRateLimitSecond")
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SharedRateLimitSecond()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitSecond'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitSecond",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+			// json error response extension placeholder
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		fiberCtx.Status(statusCode).JSON(value)
+		// route end routes extension placeholder
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/shared-rate-limit/second")), e2EsharedRateLimitControllerSharedRateLimitSecondHandler)
 }
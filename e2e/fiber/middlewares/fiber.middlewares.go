@@ -118,3 +118,14 @@ func MiddlewareOnOutputValidationError(ctx context.Context, fiberCtx *fiber.Ctx,
 	}
 	return ctx, true
 }
+
+func MiddlewareAudit(ctx context.Context, fiberCtx *fiber.Ctx) (context.Context, bool) {
+	fiberCtx.Response().Header.Set("X-pass-named-middleware-audit", "true")
+	return ctx, true
+}
+
+func MiddlewareTrace(ctx context.Context, fiberCtx *fiber.Ctx) (context.Context, bool) {
+	// Named middlewares run in declaration order so the audit middleware must have already run
+	fiberCtx.Response().Header.Set("X-pass-named-middleware-trace", string(fiberCtx.Response().Header.Peek("X-pass-named-middleware-audit")))
+	return ctx, true
+}
@@ -15,44 +15,63 @@ Repository: https://github.com/gopher-fleece/gleece
 */
 package routes
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"net/http"
 	"reflect"
 	"regexp"
+	"runtime/debug"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 	"github.com/go-playground/validator/v10"
 	"github.com/gofiber/fiber/v2"
-	RequestAuth "github.com/gopher-fleece/gleece/v2/e2e/fiber/auth"
 	"github.com/gopher-fleece/runtime"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	E2EClassSecController "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	E2EController "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param11value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param12value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param12value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param15data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param16data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param17data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	E2ESharedRateLimitController "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param14value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param18data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param19data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param20data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param22arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param23arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param24object "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param25num "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param26str "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param27values "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param28values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param21data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param22data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param23data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param24arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param25object "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param26num "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param27str "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param28values "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param29values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param31values "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param32values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param30values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param32values "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param33values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param4value2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param5data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param5theBody "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Response6CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param13data "github.com/haimkastner/unitsnet-go/units"
-	Param14unit "github.com/haimkastner/unitsnet-go/units"
+	Param6principal "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Response9CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param16data "github.com/haimkastner/unitsnet-go/units"
+	Param17unit "github.com/haimkastner/unitsnet-go/units"
 	// ImportsExtension - test
 )
 var validatorInstance = validator.New()
@@ -65,6 +84,24 @@ type SecurityCheckList struct {
 	Checks   []runtime.SecurityCheck
 	Relation SecurityListRelation
 }
+// ValidationIssue describes a single failed validation of a request's input
+type ValidationIssue struct {
+	// The location of the offending input - body, query, path, header or form
+	In string `json:"in"`
+	// A JSON pointer to the offending value, relative to its location
+	Pointer string `json:"pointer"`
+	// The validation rule that failed
+	Rule string `json:"rule"`
+	// The failed rule's parameter, if any
+	Param string `json:"param,omitempty"`
+	// A human-readable description of the failure
+	Message string `json:"message"`
+}
+// ValidationProblem is an RFC7807 error detailing the failed validations of a request
+type ValidationProblem struct {
+	runtime.Rfc7807Error
+	Errors []ValidationIssue `json:"errors"`
+}
 // TypeDeclarationsExtension - test
 func getRequestContext(fiberCtx *fiber.Ctx) context.Context {
 	return fiberCtx.UserContext()
@@ -74,6 +111,56 @@ func setRequestContext(fiberCtx *fiber.Ctx, ctx context.Context) {
 		fiberCtx.SetUserContext(ctx)
 	}
 }
+type principalContextKey struct{}
+// WithPrincipal attaches the request's authenticated principal to the given context.
+//
+// Security handlers return the resulting context to make the principal available to @Principal parameters
+func WithPrincipal(ctx context.Context, principal any) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+// getPrincipal retrieves the principal attached to the given context via WithPrincipal.
+// Yields nil if no principal of type TPrincipal (or a pointer to one) was attached
+func getPrincipal[TPrincipal any](ctx context.Context) *TPrincipal {
+	switch principal := ctx.Value(principalContextKey{}).(type) {
+	case *TPrincipal:
+		return principal
+	case TPrincipal:
+		return &principal
+	default:
+		return nil
+	}
+}
+// IdentifiablePrincipal may be implemented by principals to provide a stable identity, e.g. a user ID,
+// by which their idempotent requests and principal keyed rate limits are scoped
+type IdentifiablePrincipal interface {
+	IdempotencyIdentity() string
+}
+// describePrincipal returns a stable textual identity of the principal attached to the given context via WithPrincipal,
+// i.e., its IdempotencyIdentity or String method's result, if any, or its dereferenced value if of a primitive kind.
+// Yields an empty string if no principal was attached.
+//
+// Returns false for principals lacking a stable identity, e.g. structs, whose formatting may vary between requests
+func describePrincipal(ctx context.Context) (string, bool) {
+	principal := ctx.Value(principalContextKey{})
+	switch identifiable := principal.(type) {
+	case nil:
+		return "", true
+	case IdentifiablePrincipal:
+		return identifiable.IdempotencyIdentity(), true
+	case fmt.Stringer:
+		return identifiable.String(), true
+	}
+	value := reflect.Indirect(reflect.ValueOf(principal))
+	switch value.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprintf("%v", value), true
+	default:
+		return "", false
+	}
+}
 func registerEnumValidation(validate *validator.Validate, validationName string, allowedValues []string) {
 	// Convert the array to a map for O(1) lookup
 	lookup := make(map[string]struct{})
@@ -87,12 +174,12 @@ func registerEnumValidation(validate *validator.Validate, validationName string,
 		return exists
 	})
 }
-func extractValidationErrorMessage(err error, fieldName *string) string {
+func extractValidationErrorMessage(err error, fieldName *string, languages []string) string {
 	if err == nil {
 		return ""
 	}
-	validationErrors, ok := err.(validator.ValidationErrors)
-	if !ok {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
 		return err.Error()
 	}
 	var errStr string
@@ -101,15 +188,26 @@ func extractValidationErrorMessage(err error, fieldName *string) string {
 		if fieldName != nil {
 			fName = *fieldName
 		}
-		errStr += fmt.Sprintf("Field '%s' failed validation with tag '%s'. ", fName, validationErr.Tag())
+		errStr += translateMessage(
+			languages,
+			map[string]string{"field": fName, "tag": validationErr.Tag(), "param": validationErr.Param()},
+			ValidationTagMessageKey(validationErr.Tag()),
+			MessageKeyFieldValidation,
+		) + ". "
 	}
 	return errStr
 }
-func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error) int {
+// getStatusCode returns the status code of an operation's response - the one set via the controller's SetStatus, if any,
+// that of the error mapping matching err among the operation's declared error status codes or a 500 for other errors,
+// or the success status code
+func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error, routeErrorStatusCodes []int) int {
 	if controller.GetStatus() != nil {
 		return int(*controller.GetStatus())
 	}
 	if err != nil {
+		if mapping := getErrorMapping(err, routeErrorStatusCodes); mapping != nil {
+			return mapping.StatusCode
+		}
 		return http.StatusInternalServerError
 	}
 	if hasReturnValue {
@@ -122,7 +220,7 @@ func bindAndValidateBody[TOutput any](fiberCtx *fiber.Ctx, contentType string, v
 	bodyBytes := fiberCtx.Body()
 	if len(bodyBytes) == 0 {
 		if strings.Contains(validation, "required") {
-			return fmt.Errorf("body is required but was not provided")
+			return errBodyRequired
 		}
 		return nil
 	}
@@ -157,12 +255,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Slice, reflect.Array:
 		// For slices/arrays, validate each element recursively
 		for i := 0; i < val.Len(); i++ {
-			elemPath := path
-			if path != "" {
-				elemPath = fmt.Sprintf("%s[%d]", path, i)
-			} else {
-				elemPath = fmt.Sprintf("[%d]", i)
-			}
+			elemPath := fmt.Sprintf("%s/%d", path, i)
 			// Get the element - handle case where element might be nil
 			elem := val.Index(i)
 			if elem.Kind() == reflect.Ptr && elem.IsNil() {
@@ -177,12 +270,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Map:
 		// For maps, validate each value recursively
 		for _, key := range val.MapKeys() {
-			elemPath := path
-			if path != "" {
-				elemPath = fmt.Sprintf("%s.%v", path, key.Interface())
-			} else {
-				elemPath = fmt.Sprintf("%v", key.Interface())
-			}
+			elemPath := fmt.Sprintf("%s/%s", path, escapeJsonPointerToken(fmt.Sprintf("%v", key.Interface())))
 			elemVal := val.MapIndex(key)
 			if elemVal.Kind() == reflect.Ptr && elemVal.IsNil() {
 				continue
@@ -195,10 +283,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Struct:
 		// Validate structs with the validator
 		if err := validatorInstance.Struct(data); err != nil {
-			if path != "" {
-				return fmt.Errorf("validation error at %s: %w", path, err)
-			}
-			return err
+			return &structValidationError{pointer: path, structType: val.Type(), err: err}
 		}
 		return nil
 	default:
@@ -217,19 +302,33 @@ func toFiberUrl(url string) string {
 	}
 	return processedUrl
 }
+// withBasePath prefixes the given route path with the given base path
+func withBasePath(basePath string, path string) string {
+	basePath = strings.TrimSuffix(basePath, "/")
+	if basePath != "" && !strings.HasPrefix(basePath, "/") {
+		basePath = "/" + basePath
+	}
+	return basePath + path
+}
+// authorize succeeds if all checks of any of the given lists pass.
+// Each list is an alternative (OR) of security schemas that must all be satisfied (AND), as expanded
+// from the route's @Security and @AdvancedSecurity annotations
 func authorize(fiberCtx *fiber.Ctx, checksLists []SecurityCheckList) *runtime.SecurityError {
 	var lastError *runtime.SecurityError
 	for _, list := range checksLists {
 		if list.Relation != SecurityListRelationAnd {
-			panic(
-				"Encountered a security list relation of type '%s' - this is unexpected and indicates a bug in Gleece itself." +
+			panic(fmt.Sprintf(
+				"Encountered a security list relation of type '%s' - this is unexpected and indicates a bug in Gleece itself. "+
 					"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
-			)
+				list.Relation,
+			))
 		}
-		// Iterate over each security list
+		// Iterate over each security list.
+		// Checks may attach values (e.g. a principal) to the request context - these are discarded if the list fails
+		listCtx := getRequestContext(fiberCtx)
 		encounteredErrorInList := false
 		for _, check := range list.Checks {
-			secCtx, secErr := RequestAuth.GleeceRequestAuthorization(getRequestContext(fiberCtx), fiberCtx, check)
+			secCtx, secErr := runSecurityHandler(getRequestContext(fiberCtx), fiberCtx, check)
 			setRequestContext(fiberCtx, secCtx)
 			if secErr != nil {
 				lastError = secErr
@@ -242,6 +341,7 @@ func authorize(fiberCtx *fiber.Ctx, checksLists []SecurityCheckList) *runtime.Se
 		if !encounteredErrorInList {
 			return nil
 		}
+		setRequestContext(fiberCtx, listCtx)
 	}
 	// If we got here it means authentication has failed
 	return lastError
@@ -260,20 +360,1117 @@ func handleAuthorizationError(fiberCtx *fiber.Ctx, authErr *runtime.SecurityErro
 	}
 	return fiberCtx.Status(statusCode).JSON(stdError)
 }
-func wrapValidatorError(validatorErr error, operationId string, fieldName string) runtime.Rfc7807Error {
+func wrapValidatorError(validatorErr error, operationId string, fieldName string, languages []string) runtime.Rfc7807Error {
 	return runtime.Rfc7807Error{
 		Type: http.StatusText(http.StatusUnprocessableEntity),
-		Detail: fmt.Sprintf(
-			"A request was made to operation '%s' but parameter '%s' did not pass validation - %s",
-			operationId,
-			fieldName,
-			extractValidationErrorMessage(validatorErr, &fieldName),
+		Detail: translateMessage(
+			languages,
+			map[string]string{
+				"operation": operationId,
+				"parameter": fieldName,
+				"details":   extractValidationErrorMessage(validatorErr, &fieldName, languages),
+			},
+			MessageKeyParamValidation,
 		),
 		Status:   http.StatusUnprocessableEntity,
 		Instance: fmt.Sprintf("/validation/error/%s", operationId),
 	}
 }
+// paramInteger is the set of signed integer types a request parameter may be converted to
+type paramInteger interface {
+	int | int8 | int16 | int32 | int64
+}
+// paramUnsigned is the set of unsigned integer types a request parameter may be converted to
+type paramUnsigned interface {
+	uint | uint8 | uint16 | uint32 | uint64
+}
+// paramFloat is the set of floating point types a request parameter may be converted to
+type paramFloat interface {
+	float32 | float64
+}
+// parseIntegerParam converts the raw value of a request parameter to the given signed integer type
+func parseIntegerParam[T paramInteger](raw string) (T, error) {
+	parsed, err := strconv.ParseInt(raw, 10, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// parseUnsignedParam converts the raw value of a request parameter to the given unsigned integer type
+func parseUnsignedParam[T paramUnsigned](raw string) (T, error) {
+	parsed, err := strconv.ParseUint(raw, 10, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// parseFloatParam converts the raw value of a request parameter to the given floating point type
+func parseFloatParam[T paramFloat](raw string) (T, error) {
+	parsed, err := strconv.ParseFloat(raw, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// wrapConversionError creates the RFC7807 error and validation issues describing a request parameter
+// whose raw value could not be converted to the parameter's type
+func wrapConversionError(
+	conversionErr error,
+	operationId string,
+	fieldName string,
+	in string,
+	pointer string,
+	expectedType string,
+	actualType string,
+	languages []string,
+) (runtime.Rfc7807Error, []ValidationIssue) {
+	validationError := runtime.Rfc7807Error{
+		Type: http.StatusText(http.StatusUnprocessableEntity),
+		Detail: translateMessage(
+			languages,
+			map[string]string{
+				"operation": operationId,
+				"parameter": fieldName,
+				"expected":  expectedType,
+				"actual":    actualType,
+			},
+			MessageKeyParamConversion,
+		),
+		Status:     http.StatusUnprocessableEntity,
+		Instance:   fmt.Sprintf("/validation/error/%s", operationId),
+		Extensions: map[string]string{"error": conversionErr.Error()},
+	}
+	validationIssues := []ValidationIssue{
+		{
+			In:      in,
+			Pointer: pointer,
+			Rule:    "type",
+			Param:   expectedType,
+			Message: conversionErr.Error(),
+		},
+	}
+	return validationError, validationIssues
+}
+var errBodyRequired = errors.New("body is required but was not provided")
+// structValidationError associates a struct's validation errors with the struct's location within the validated payload
+type structValidationError struct {
+	pointer    string
+	structType reflect.Type
+	err        error
+}
+func (e *structValidationError) Error() string {
+	if e.pointer == "" {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("validation error at %s: %s", e.pointer, e.err.Error())
+}
+func (e *structValidationError) Unwrap() error {
+	return e.err
+}
+func escapeJsonPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+// getJsonFieldName returns the name of the given field within its struct's JSON representation.
+//
+// Embedded structs without an explicit name are flattened by encoding/json and therefore yield an empty name
+func getJsonFieldName(field reflect.StructField) string {
+	if name := strings.Split(field.Tag.Get("json"), ",")[0]; name != "" {
+		return name
+	}
+	fieldType := field.Type
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	if field.Anonymous && fieldType.Kind() == reflect.Struct {
+		return ""
+	}
+	return field.Name
+}
+// toJsonPointer converts a validator struct namespace (e.g. 'Model.Items[0].Name') to a JSON pointer (e.g. '/items/0/name')
+// using the json tags of the root type's fields
+func toJsonPointer(rootType reflect.Type, structNamespace string) string {
+	pointer := ""
+	currentType := rootType
+	segments := strings.Split(structNamespace, ".")
+	for _, segment := range segments[1:] {
+		name, indices := segment, []string{}
+		if bracket := strings.Index(segment, "["); bracket >= 0 {
+			name = segment[:bracket]
+			indices = strings.Split(strings.TrimSuffix(segment[bracket+1:], "]"), "][")
+		}
+		for currentType != nil && currentType.Kind() == reflect.Ptr {
+			currentType = currentType.Elem()
+		}
+		token := name
+		if currentType != nil && currentType.Kind() == reflect.Struct {
+			if field, found := currentType.FieldByName(name); found {
+				currentType = field.Type
+				token = getJsonFieldName(field)
+			} else {
+				currentType = nil
+			}
+		}
+		if token != "" {
+			pointer += "/" + escapeJsonPointerToken(token)
+		}
+		for _, index := range indices {
+			pointer += "/" + escapeJsonPointerToken(index)
+			for currentType != nil && currentType.Kind() == reflect.Ptr {
+				currentType = currentType.Elem()
+			}
+			if currentType != nil && (currentType.Kind() == reflect.Slice || currentType.Kind() == reflect.Array || currentType.Kind() == reflect.Map) {
+				currentType = currentType.Elem()
+			} else {
+				currentType = nil
+			}
+		}
+	}
+	return pointer
+}
+func getValidationIssueMessage(pointer string, tag string, param string, languages []string) string {
+	args := map[string]string{"tag": tag, "param": param}
+	if pointer == "" {
+		return translateMessage(languages, args, ValidationTagMessageKey(tag), MessageKeyValueValidation)
+	}
+	args["field"] = pointer[strings.LastIndex(pointer, "/")+1:]
+	return translateMessage(languages, args, ValidationTagMessageKey(tag), MessageKeyFieldValidation)
+}
+// getValidationIssues converts the given validation or deserialization error to a list of issues at the given location.
+//
+// The pointer is the JSON pointer of the validated value, relative to its location
+func getValidationIssues(err error, in string, pointer string, languages []string) []ValidationIssue {
+	var structType reflect.Type
+	var structErr *structValidationError
+	if errors.As(err, &structErr) {
+		pointer += structErr.pointer
+		structType = structErr.structType
+	}
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		issues := make([]ValidationIssue, 0, len(validationErrors))
+		for _, validationErr := range validationErrors {
+			issuePointer := pointer
+			if structType != nil {
+				issuePointer += toJsonPointer(structType, validationErr.StructNamespace())
+			}
+			issues = append(issues, ValidationIssue{
+				In:      in,
+				Pointer: issuePointer,
+				Rule:    validationErr.Tag(),
+				Param:   validationErr.Param(),
+				Message: getValidationIssueMessage(issuePointer, validationErr.Tag(), validationErr.Param(), languages),
+			})
+		}
+		return issues
+	}
+	issue := ValidationIssue{In: in, Pointer: pointer, Rule: "parse", Message: err.Error()}
+	var typeErr *json.UnmarshalTypeError
+	if errors.Is(err, errBodyRequired) {
+		issue.Rule = "required"
+	} else if errors.As(err, &typeErr) {
+		if typeErr.Field != "" {
+			issue.Pointer += "/" + strings.ReplaceAll(typeErr.Field, ".", "/")
+		}
+		issue.Rule = "type"
+		issue.Param = typeErr.Type.String()
+	}
+	return []ValidationIssue{issue}
+}
+// Keys of the translatable validation messages.
+//
+// Message templates use named placeholders, such as '{field}', which are replaced with the message's arguments
+const (
+	// Describes a parameter that did not pass validation. Placeholders: {operation}, {parameter}, {details}
+	MessageKeyParamValidation = "paramValidation"
+	// Describes a body that did not pass validation. Placeholders: {operation}, {parameter}, {type}, {details}
+	MessageKeyBodyValidation = "bodyValidation"
+	// Describes a parameter that could not be converted to its declared type. Placeholders: {operation}, {parameter}, {expected}, {actual}
+	MessageKeyParamConversion = "paramConversion"
+	// Describes a field that failed a validation tag with no dedicated message. Placeholders: {field}, {tag}, {param}
+	MessageKeyFieldValidation = "fieldValidation"
+	// Describes a value that failed a validation tag with no dedicated message. Placeholders: {tag}, {param}
+	MessageKeyValueValidation = "valueValidation"
+)
+// The language of the default message catalogue
+const defaultMessageLanguage = "en"
+// The default English message catalogue, used whenever no translation is available
+var defaultMessages = map[string]string{
+	MessageKeyParamValidation: "A request was made to operation '{operation}' but parameter '{parameter}' did not pass validation - {details}",
+	MessageKeyBodyValidation:  "A request was made to operation '{operation}' but body parameter '{parameter}' did not pass validation of '{type}' - {details}",
+	MessageKeyParamConversion: "A request was made to operation '{operation}' but parameter '{parameter}' was not properly sent - Expected {expected} but got {actual}",
+	MessageKeyFieldValidation: "Field '{field}' failed validation with tag '{tag}'",
+	MessageKeyValueValidation: "Value failed validation with tag '{tag}'",
+}
+// MessageTranslator provides validation message templates in the languages requested via the Accept-Language header
+type MessageTranslator interface {
+	// Translate returns the message template registered for the given language and key, if any
+	Translate(language string, key string) (string, bool)
+}
+// MessageCatalogue is a MessageTranslator backed by an in-memory map of language to message key to template.
+//
+// Languages are matched case-insensitively
+type MessageCatalogue map[string]map[string]string
+func (catalogue MessageCatalogue) Translate(language string, key string) (string, bool) {
+	template, found := catalogue[strings.ToLower(language)][key]
+	return template, found
+}
+var messageCatalogue = MessageCatalogue{}
+var messageTranslator MessageTranslator = messageCatalogue
+// SetMessageTranslator replaces the built-in message catalogue with the given translator,
+// e.g. an adapter over go-playground's universal translator.
+//
+// Messages the translator does not provide fall back to the default English catalogue
+func SetMessageTranslator(translator MessageTranslator) {
+	if translator == nil {
+		translator = messageCatalogue
+	}
+	messageTranslator = translator
+}
+// RegisterTranslation registers the template of the given message key for the given language in the built-in catalogue.
+// Has no effect on translators set via SetMessageTranslator
+func RegisterTranslation(language string, key string, template string) {
+	language = strings.ToLower(language)
+	if messageCatalogue[language] == nil {
+		messageCatalogue[language] = map[string]string{}
+	}
+	messageCatalogue[language][key] = template
+}
+// ValidationTagMessageKey returns the message key of the given validation tag, e.g. 'required'.
+//
+// Templates registered under this key take precedence over the generic field and value messages.
+// Placeholders: {field}, {tag}, {param}
+func ValidationTagMessageKey(tag string) string {
+	return "tag." + tag
+}
+// RegisterValidationTagTranslation registers the message used when the given validation tag fails, for the given language
+func RegisterValidationTagTranslation(language string, tag string, template string) {
+	RegisterTranslation(language, ValidationTagMessageKey(tag), template)
+}
+// getAcceptedLanguages returns the languages listed in the given Accept-Language header, most preferred first.
+// Regional variants are followed by their base language, e.g. 'fr-CA' by 'fr'
+func getAcceptedLanguages(header string) []string {
+	type weightedLanguage struct {
+		tag    string
+		weight float64
+	}
+	weightedLanguages := []weightedLanguage{}
+	for _, entry := range strings.Split(header, ",") {
+		parts := strings.Split(entry, ";")
+		tag := strings.TrimSpace(parts[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		weight := 1.0
+		for _, param := range parts[1:] {
+			if value, found := strings.CutPrefix(strings.TrimSpace(param), "q="); found {
+				if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+					weight = parsed
+				}
+			}
+		}
+		if weight > 0 {
+			weightedLanguages = append(weightedLanguages, weightedLanguage{tag: tag, weight: weight})
+		}
+	}
+	slices.SortStableFunc(weightedLanguages, func(a, b weightedLanguage) int {
+		if a.weight > b.weight {
+			return -1
+		}
+		if a.weight < b.weight {
+			return 1
+		}
+		return 0
+	})
+	languages := make([]string, 0, len(weightedLanguages))
+	for _, language := range weightedLanguages {
+		languages = append(languages, language.tag)
+		if base, _, hasRegion := strings.Cut(language.tag, "-"); hasRegion {
+			languages = append(languages, base)
+		}
+	}
+	return languages
+}
+// getMessageTemplate returns the template of the first of the given keys available in the most preferred of the given languages,
+// falling back to English and finally to the default English catalogue
+func getMessageTemplate(languages []string, keys ...string) string {
+	for _, language := range append(slices.Clip(languages), defaultMessageLanguage) {
+		for _, key := range keys {
+			if template, found := messageTranslator.Translate(language, key); found {
+				return template
+			}
+		}
+	}
+	for _, key := range keys {
+		if template, found := defaultMessages[key]; found {
+			return template
+		}
+	}
+	return ""
+}
+// translateMessage formats the template of the given keys in the given languages, replacing its placeholders with the given arguments
+func translateMessage(languages []string, args map[string]string, keys ...string) string {
+	replacements := make([]string, 0, len(args)*2)
+	for name, value := range args {
+		replacements = append(replacements, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(replacements...).Replace(getMessageTemplate(languages, keys...))
+}
+// ControllerFactory creates the controller instance handling a single request.
+//
+// The given context is the request's context
+type ControllerFactory[TController any] func(ctx context.Context) *TController
+var controllerFactories = map[reflect.Type]func(ctx context.Context) any{}
+// RegisterControllerFactory registers the factory used to create a controller for each request it handles.
+//
+// Factories allow controllers to hold injected dependencies such as database handles, services or loggers.
+// Must be called before RegisterRoutes; the factory must not return nil
+func RegisterControllerFactory[TController any](factory ControllerFactory[TController]) {
+	controllerFactories[reflect.TypeOf((*TController)(nil)).Elem()] = func(ctx context.Context) any {
+		return factory(ctx)
+	}
+}
+// createController creates a controller using its registered factory or, if none exists, its zero value
+func createController[TController any](ctx context.Context) *TController {
+	if factory, exists := controllerFactories[reflect.TypeOf((*TController)(nil)).Elem()]; exists {
+		return factory(ctx).(*TController)
+	}
+	return new(TController)
+}
+// ensureControllerFactoriesRegistered panics if any of the given controller types has no registered factory
+func ensureControllerFactoriesRegistered(controllerTypes []reflect.Type) {
+	missing := []string{}
+	for _, controllerType := range controllerTypes {
+		if _, registered := controllerFactories[controllerType]; !registered {
+			missing = append(missing, controllerType.String())
+		}
+	}
+	if len(missing) > 0 {
+		panic(fmt.Sprintf(
+			"controller factories are required but were never registered using RegisterControllerFactory: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
+// ErrorMapping describes the response returned when a controller's error matches a registered mapping
+type ErrorMapping struct {
+	// The HTTP status code to respond with. Must be declared by at least one @ErrorResponse annotation.
+	// The mapping applies only to operations declaring the status code - errors of other operations remain 500s
+	StatusCode int
+	// The RFC7807 title. Defaults to the status code's text
+	Title string
+	// A client-safe description of the error. Defaults to a generic, operation-specific message
+	Detail string
+}
+type registeredErrorMapping struct {
+	matches func(err error) bool
+	mapping ErrorMapping
+}
+var errorMappings = []registeredErrorMapping{}
+var declaredErrorStatusCodes = []int{404, 409, 500}
+// RegisterErrorMapping maps errors matching the given target, via errors.Is, to the given HTTP status code.
+//
+// Mappings are evaluated in order of registration and are superseded by an explicit call to the controller's SetStatus
+func RegisterErrorMapping(target error, statusCode int) {
+	RegisterDetailedErrorMapping(target, ErrorMapping{StatusCode: statusCode})
+}
+// RegisterDetailedErrorMapping maps errors matching the given target, via errors.Is, to the given response
+func RegisterDetailedErrorMapping(target error, mapping ErrorMapping) {
+	addErrorMapping(func(err error) bool { return errors.Is(err, target) }, mapping)
+}
+// RegisterErrorTypeMapping maps errors of type TError, matched via errors.As, to the given response
+func RegisterErrorTypeMapping[TError error](mapping ErrorMapping) {
+	addErrorMapping(func(err error) bool {
+		var target TError
+		return errors.As(err, &target)
+	}, mapping)
+}
+func addErrorMapping(matches func(err error) bool, mapping ErrorMapping) {
+	if !slices.Contains(declaredErrorStatusCodes, mapping.StatusCode) {
+		panic(fmt.Sprintf("Error mapping status code %d is not declared by any @ErrorResponse annotation", mapping.StatusCode))
+	}
+	errorMappings = append(errorMappings, registeredErrorMapping{matches: matches, mapping: mapping})
+}
+// getErrorMapping returns the first registered mapping matching err whose status code is among the given ones, declared by
+// the operation that produced err, or nil if there is none
+func getErrorMapping(err error, routeErrorStatusCodes []int) *ErrorMapping {
+	for _, registered := range errorMappings {
+		if slices.Contains(routeErrorStatusCodes, registered.mapping.StatusCode) && registered.matches(err) {
+			return &registered.mapping
+		}
+	}
+	return nil
+}
+// applyErrorMapping overrides the given RFC7807 error's title and detail with those of the mapping matching err, if any
+func applyErrorMapping(stdError *runtime.Rfc7807Error, err error, routeErrorStatusCodes []int) {
+	mapping := getErrorMapping(err, routeErrorStatusCodes)
+	if mapping == nil {
+		return
+	}
+	stdError.Title = http.StatusText(stdError.Status)
+	if mapping.Title != "" {
+		stdError.Title = mapping.Title
+	}
+	if mapping.Detail != "" {
+		stdError.Detail = mapping.Detail
+	}
+}
 // FunctionDeclarationsExtension - test
+// The instrumentation scope of the spans and metrics reported by the API's routes
+const telemetryInstrumentationName = "github.com/gopher-fleece/gleece"
+var operationTracer = otel.GetTracerProvider().Tracer(telemetryInstrumentationName)
+var requestMetrics = newRequestInstruments(otel.GetMeterProvider())
+// requestInstruments holds the metric instruments recorded for each request
+type requestInstruments struct {
+	duration metric.Float64Histogram
+	count    metric.Int64Counter
+}
+func newRequestInstruments(provider metric.MeterProvider) requestInstruments {
+	meter := provider.Meter(telemetryInstrumentationName)
+	// Instrument creation only fails for malformed names or units, in which case a no-op instrument is yielded
+	duration, _ := meter.Float64Histogram(
+		"http.server.request.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of HTTP server requests"),
+	)
+	count, _ := meter.Int64Counter(
+		"http.server.request.count",
+		metric.WithUnit("{request}"),
+		metric.WithDescription("Number of HTTP server requests"),
+	)
+	return requestInstruments{duration: duration, count: count}
+}
+// RegisterTracerProvider sets the provider used to create the operations' spans.
+// Defaults to the global tracer provider
+func RegisterTracerProvider(provider trace.TracerProvider) {
+	operationTracer = provider.Tracer(telemetryInstrumentationName)
+}
+// RegisterMeterProvider sets the provider used to record the requests' metrics.
+// Defaults to the global meter provider
+func RegisterMeterProvider(provider metric.MeterProvider) {
+	requestMetrics = newRequestInstruments(provider)
+}
+// operationTelemetry traces and measures a single operation's execution
+type operationTelemetry struct {
+	// The request's context, carrying the operation's span
+	ctx        context.Context
+	span       trace.Span
+	phaseSpan  trace.Span
+	startTime  time.Time
+	attributes []attribute.KeyValue
+}
+// startOperationTelemetry starts the given operation's span.
+// The span is a child of the span propagated via the request's headers, if any
+func startOperationTelemetry(
+	ctx context.Context,
+	headers propagation.TextMapCarrier,
+	operationId string,
+	controllerName string,
+	httpVerb string,
+	routeTemplate string,
+) *operationTelemetry {
+	attributes := []attribute.KeyValue{
+		attribute.String("http.request.method", httpVerb),
+		attribute.String("http.route", routeTemplate),
+		attribute.String("gleece.controller", controllerName),
+		attribute.String("gleece.operation", operationId),
+	}
+	ctx = otel.GetTextMapPropagator().Extract(ctx, headers)
+	ctx, span := operationTracer.Start(
+		ctx,
+		operationId,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attributes...),
+	)
+	return &operationTelemetry{
+		ctx:        ctx,
+		span:       span,
+		startTime:  time.Now(),
+		attributes: attributes,
+	}
+}
+// startPhase ends the current phase's span, if any, and starts a child span for the given phase, e.g. 'authorization'
+func (telemetry *operationTelemetry) startPhase(phase string) {
+	telemetry.endPhase()
+	_, telemetry.phaseSpan = operationTracer.Start(telemetry.ctx, phase)
+}
+// endPhase ends the current phase's span, if any
+func (telemetry *operationTelemetry) endPhase() {
+	if telemetry.phaseSpan != nil {
+		telemetry.phaseSpan.End()
+		telemetry.phaseSpan = nil
+	}
+}
+// end ends the operation's span and records the request's metrics using the given response status code
+func (telemetry *operationTelemetry) end(statusCode int) {
+	telemetry.endPhase()
+	statusAttribute := attribute.Int("http.response.status_code", statusCode)
+	telemetry.span.SetAttributes(statusAttribute)
+	if statusCode >= http.StatusInternalServerError {
+		telemetry.span.SetStatus(codes.Error, http.StatusText(statusCode))
+	}
+	telemetry.span.End()
+	measurementAttributes := metric.WithAttributes(append(telemetry.attributes, statusAttribute)...)
+	requestMetrics.duration.Record(telemetry.ctx, time.Since(telemetry.startTime).Seconds(), measurementAttributes)
+	requestMetrics.count.Add(telemetry.ctx, 1, measurementAttributes)
+}
+// PanicError is the error passed to the error middlewares when an operation panics
+type PanicError struct {
+	// The value the operation panicked with
+	Value any
+	// The stack trace of the panicking goroutine, captured upon recovery
+	Stack []byte
+}
+func (err *PanicError) Error() string {
+	return fmt.Sprintf("operation panicked - %v", err.Value)
+}
+// PanicReporter is invoked with every panic recovered from an operation, e.g. to report it to an error tracking service
+type PanicReporter func(ctx context.Context, operationId string, panicErr *PanicError)
+var panicReporter PanicReporter
+// RegisterPanicReporter sets the hook invoked with every panic recovered from an operation.
+//
+// The reporter runs before the error middlewares
+func RegisterPanicReporter(reporter PanicReporter) {
+	panicReporter = reporter
+}
+// handleOperationPanic reports the given recovered value and runs the error middlewares.
+// Unless a middleware stops the chain, responds with a 500 RFC7807 error
+func handleOperationPanic(fiberCtx *fiber.Ctx, recovered any, operationId string) {
+	panicErr := &PanicError{Value: recovered, Stack: debug.Stack()}
+	if panicReporter != nil {
+		panicReporter(getRequestContext(fiberCtx), operationId, panicErr)
+	}
+	for _, middleware := range onErrorMiddlewares {
+		middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, panicErr)
+		setRequestContext(fiberCtx, middlewareCtx)
+		if !continueOperation {
+			return
+		}
+	}
+	stdError := runtime.Rfc7807Error{
+		Type:       http.StatusText(http.StatusInternalServerError),
+		Detail:     fmt.Sprintf("Encountered an unexpected error during operation '%s'", operationId),
+		Status:     http.StatusInternalServerError,
+		Instance:   "/controller/error/" + operationId,
+		Extensions: map[string]string{"error": panicErr.Error()},
+	}
+	// The response is best-effort; the operation has already failed
+	_ = fiberCtx.Status(http.StatusInternalServerError).JSON(stdError)
+}
+// SecurityCredentials holds the credentials extracted from a request for a single security scheme,
+// according to the scheme's configured type and location
+type SecurityCredentials struct {
+	// Whether credentials were found at the scheme's expected location
+	Present bool
+	// The API key read from the configured header, query parameter or cookie of an 'apiKey' scheme
+	ApiKey string
+	// The credentials of the 'Authorization' header, without the scheme prefix, for 'http' schemes other than 'basic'.
+	// For 'oauth2' and 'openIdConnect' schemes, this is the bearer token
+	Token string
+	// The user name of a 'basic' HTTP scheme
+	Username string
+	// The password of a 'basic' HTTP scheme
+	Password string
+}
+// SecurityHandler authorizes a request for a single security scheme, using the credentials extracted from the request
+type SecurityHandler func(
+	ctx context.Context,
+	fiberCtx *fiber.Ctx,
+	check runtime.SecurityCheck,
+	credentials SecurityCredentials,
+) (context.Context, *runtime.SecurityError)
+var securityHandlers = map[string]SecurityHandler{}
+// RegisterSecurityHandler registers the handler authorizing requests for the given security scheme.
+//
+// A handler must be registered for every configured security scheme before RegisterRoutes is called
+func RegisterSecurityHandler(schemaName string, handler SecurityHandler) {
+	securityHandlers[schemaName] = handler
+}
+// securitySchemeDescriptor describes where a security scheme's credentials are located
+type securitySchemeDescriptor struct {
+	schemeType string
+	in         string
+	fieldName  string
+	httpScheme string
+}
+var securitySchemes = map[string]securitySchemeDescriptor{
+	"securitySchemaName":  {schemeType: "apiKey", in: "header", fieldName: "x-header-name", httpScheme: ""},
+	"securitySchemaName2": {schemeType: "apiKey", in: "header", fieldName: "x-header-name", httpScheme: ""},
+	"securitySchemaName3": {schemeType: "http", in: "", fieldName: "", httpScheme: "bearer"},
+}
+// ensureSecurityHandlersRegistered panics if any of the configured security schemes has no registered handler
+func ensureSecurityHandlersRegistered() {
+	missing := []string{}
+	for schemaName := range securitySchemes {
+		if _, registered := securityHandlers[schemaName]; !registered {
+			missing = append(missing, schemaName)
+		}
+	}
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		panic(fmt.Sprintf(
+			"security schemes were never given a handler using RegisterSecurityHandler: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
+// runSecurityHandler invokes the given check's security handler with the credentials extracted for its scheme
+func runSecurityHandler(ctx context.Context, fiberCtx *fiber.Ctx, check runtime.SecurityCheck) (context.Context, *runtime.SecurityError) {
+	handler, registered := securityHandlers[check.SchemaName]
+	if !registered {
+		return ctx, &runtime.SecurityError{
+			Message:    fmt.Sprintf("No security handler is registered for security scheme '%s'", check.SchemaName),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+	return handler(ctx, fiberCtx, check, extractSecurityCredentials(fiberCtx, securitySchemes[check.SchemaName]))
+}
+// extractSecurityCredentials reads the credentials of the given security scheme from the request
+func extractSecurityCredentials(fiberCtx *fiber.Ctx, scheme securitySchemeDescriptor) SecurityCredentials {
+	switch scheme.schemeType {
+	case "apiKey":
+		var apiKey string
+		switch scheme.in {
+		case "header":
+			apiKey = fiberCtx.Get(scheme.fieldName)
+		case "query":
+			apiKey = fiberCtx.Query(scheme.fieldName)
+		case "cookie":
+			apiKey = fiberCtx.Cookies(scheme.fieldName)
+		}
+		return SecurityCredentials{Present: apiKey != "", ApiKey: apiKey}
+	case "http":
+		return parseAuthorizationHeader(fiberCtx.Get("Authorization"), scheme.httpScheme)
+	case "oauth2", "openIdConnect":
+		return parseAuthorizationHeader(fiberCtx.Get("Authorization"), "bearer")
+	default:
+		return SecurityCredentials{}
+	}
+}
+// parseAuthorizationHeader extracts the credentials of the given HTTP authentication scheme from an 'Authorization' header
+func parseAuthorizationHeader(header string, httpScheme string) SecurityCredentials {
+	prefix, credentials, found := strings.Cut(strings.TrimSpace(header), " ")
+	credentials = strings.TrimSpace(credentials)
+	if !found || credentials == "" || !strings.EqualFold(prefix, httpScheme) {
+		return SecurityCredentials{}
+	}
+	if !strings.EqualFold(httpScheme, "basic") {
+		return SecurityCredentials{Present: true, Token: credentials}
+	}
+	decoded, err := base64.StdEncoding.DecodeString(credentials)
+	if err != nil {
+		return SecurityCredentials{}
+	}
+	username, password, found := strings.Cut(string(decoded), ":")
+	if !found {
+		return SecurityCredentials{}
+	}
+	return SecurityCredentials{Present: true, Username: username, Password: password}
+}
+// DefaultApiVersion is the API version served to requests that do not specify one
+const DefaultApiVersion = 2
+// registerVersionedRoute registers the given handler under the '/v{version}' prefixed path of each of the given API versions
+func registerVersionedRoute(engine fiber.Router, basePath string, method string, path string, versions []int, handler fiber.Handler) {
+	for _, version := range versions {
+		engine.Add(method, toFiberUrl(withBasePath(basePath, fmt.Sprintf("/v%d%s", version, path))), handler)
+	}
+}
+// RateLimit describes the number of requests an operation accepts per time window, as declared via @RateLimit
+type RateLimit struct {
+	// The number of requests allowed per window
+	Requests int
+	// The window's duration
+	Per time.Duration
+	// The key by which requests are counted - 'ip', 'principal' or 'header:<Name>'
+	Key string
+	// The scope within which requests are counted - the declaring controller's name for limits inherited from a controller,
+	// which are shared by all of its operations inheriting them, or the operation's ID for limits declared by the operation
+	Scope string
+}
+// RateLimiter decides whether requests may proceed under their operation's rate limit.
+//
+// Implementations must be safe for concurrent use
+type RateLimiter interface {
+	// Allow consumes a single request of the given key against the given operation's limit, counted within the limit's scope.
+	// Returns whether the request may proceed and, if not, how long until it may be retried
+	Allow(ctx context.Context, operationId string, key string, limit RateLimit) (bool, time.Duration)
+}
+// InMemoryRateLimiter is a RateLimiter holding a token bucket per limit scope and key in the process's memory.
+//
+// Each bucket holds up to the limit's number of requests and is refilled evenly over the limit's window.
+// Limits are not shared across instances - deployments with multiple instances should use a shared RateLimiter instead
+type InMemoryRateLimiter struct {
+	mutex     sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+type tokenBucket struct {
+	tokens          float64
+	capacity        float64
+	refillPerSecond float64
+	lastRefill      time.Time
+}
+// The interval at which an InMemoryRateLimiter drops buckets that have been refilled to capacity
+const rateLimitSweepInterval = time.Minute
+// NewInMemoryRateLimiter creates an InMemoryRateLimiter with no buckets
+func NewInMemoryRateLimiter() *InMemoryRateLimiter {
+	return &InMemoryRateLimiter{buckets: map[string]*tokenBucket{}, lastSweep: time.Now()}
+}
+func (limiter *InMemoryRateLimiter) Allow(_ context.Context, _ string, key string, limit RateLimit) (bool, time.Duration) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	now := time.Now()
+	limiter.sweep(now)
+	bucketKey := limit.Scope + "\x00" + key
+	bucket, exists := limiter.buckets[bucketKey]
+	if !exists {
+		capacity := float64(limit.Requests)
+		bucket = &tokenBucket{
+			tokens:          capacity,
+			capacity:        capacity,
+			refillPerSecond: capacity / limit.Per.Seconds(),
+			lastRefill:      now,
+		}
+		limiter.buckets[bucketKey] = bucket
+	}
+	bucket.tokens = bucket.tokensAt(now)
+	bucket.lastRefill = now
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - bucket.tokens) / bucket.refillPerSecond * float64(time.Second))
+}
+// sweep drops the buckets that have been refilled to capacity, as those are indistinguishable from new ones
+func (limiter *InMemoryRateLimiter) sweep(now time.Time) {
+	if now.Sub(limiter.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	for bucketKey, bucket := range limiter.buckets {
+		if bucket.tokensAt(now) >= bucket.capacity {
+			delete(limiter.buckets, bucketKey)
+		}
+	}
+	limiter.lastSweep = now
+}
+// tokensAt returns the number of tokens the bucket holds at the given time
+func (bucket *tokenBucket) tokensAt(now time.Time) float64 {
+	return math.Min(bucket.capacity, bucket.tokens+now.Sub(bucket.lastRefill).Seconds()*bucket.refillPerSecond)
+}
+var rateLimiter RateLimiter = NewInMemoryRateLimiter()
+// SetRateLimiter replaces the default InMemoryRateLimiter with the given limiter,
+// e.g. one backed by a store shared across instances
+func SetRateLimiter(limiter RateLimiter) {
+	if limiter == nil {
+		limiter = NewInMemoryRateLimiter()
+	}
+	rateLimiter = limiter
+}
+// newRateLimit creates an operation's RateLimit, counted within the given scope, out of its @RateLimit annotation's properties
+func newRateLimit(requests int, per string, key string, scope string) RateLimit {
+	window, err := time.ParseDuration(per)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid rate limit window '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			per,
+		))
+	}
+	return RateLimit{Requests: requests, Per: window, Key: key, Scope: scope}
+}
+// getRateLimitKey returns the value by which the request is counted against a rate limit with the given key.
+//
+// Requests lacking the key's header or an identifiable principal are counted by their client IP
+func getRateLimitKey(fiberCtx *fiber.Ctx, key string) string {
+	value := ""
+	if headerName, isHeaderKey := strings.CutPrefix(key, "header:"); isHeaderKey {
+		value = fiberCtx.Get(headerName)
+	} else if key == "principal" {
+		value, _ = describePrincipal(getRequestContext(fiberCtx))
+	}
+	if value == "" {
+		return fiberCtx.IP()
+	}
+	return key + "=" + value
+}
+// enforceRateLimit counts the request against the given operation's rate limit.
+//
+// Requests exceeding the limit are answered with a 429 RFC7807 error and a 'Retry-After' header, in which case false is returned
+// along with the error of writing the response
+func enforceRateLimit(fiberCtx *fiber.Ctx, operationId string, limit RateLimit) (bool, error) {
+	allowed, retryAfter := rateLimiter.Allow(getRequestContext(fiberCtx), operationId, getRateLimitKey(fiberCtx, limit.Key), limit)
+	if allowed {
+		return true, nil
+	}
+	fiberCtx.Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	return false, fiberCtx.Status(http.StatusTooManyRequests).JSON(runtime.Rfc7807Error{
+		Type:     http.StatusText(http.StatusTooManyRequests),
+		Detail:   fmt.Sprintf("Too many requests were made to operation '%s'. Please retry later", operationId),
+		Status:   http.StatusTooManyRequests,
+		Instance: "/ratelimit/error/" + operationId,
+	})
+}
+// newOperationTimeout creates an operation's deadline duration out of its @Timeout annotation or the configured default timeout
+func newOperationTimeout(timeout string) time.Duration {
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid operation timeout '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			timeout,
+		))
+	}
+	return duration
+}
+// applyOperationTimeout replaces the request's context with one that expires once the given timeout elapses.
+//
+// Operations are not interrupted when the deadline expires - controllers are expected to honor their context's cancellation.
+// The returned function releases the context's resources and must be called once the request has been handled
+func applyOperationTimeout(fiberCtx *fiber.Ctx, timeout time.Duration) context.CancelFunc {
+	ctx, cancel := context.WithTimeout(getRequestContext(fiberCtx), timeout)
+	setRequestContext(fiberCtx, ctx)
+	return cancel
+}
+// isDeadlineExceeded returns whether the request's context has expired due to its operation's timeout
+func isDeadlineExceeded(fiberCtx *fiber.Ctx) bool {
+	return errors.Is(getRequestContext(fiberCtx).Err(), context.DeadlineExceeded)
+}
+// handleDeadlineExceeded answers a request whose operation's deadline has expired with an RFC7807 error of the given status -
+// 503 if the operation has not been invoked yet or 504 if it has
+func handleDeadlineExceeded(fiberCtx *fiber.Ctx, operationId string, status int) error {
+	detail := fmt.Sprintf("The deadline of operation '%s' expired before the operation was invoked", operationId)
+	if status == http.StatusGatewayTimeout {
+		detail = fmt.Sprintf("Operation '%s' did not complete before its deadline expired", operationId)
+	}
+	return fiberCtx.Status(status).JSON(runtime.Rfc7807Error{
+		Type:     http.StatusText(status),
+		Detail:   detail,
+		Status:   status,
+		Instance: "/timeout/error/" + operationId,
+	})
+}
+// enforceMaxBodySize checks the request's body against the given operation's maximum body size.
+//
+// Note that Fiber reads request bodies up-front, subject to the application's own 'BodyLimit' configuration.
+// Requests whose body exceeds the maximum size are answered with a 413 RFC7807 error, in which case false is returned
+func enforceMaxBodySize(fiberCtx *fiber.Ctx, operationId string, maxBodySize int64) (bool, error) {
+	if int64(len(fiberCtx.Body())) <= maxBodySize {
+		return true, nil
+	}
+	return false, fiberCtx.Status(http.StatusRequestEntityTooLarge).JSON(runtime.Rfc7807Error{
+		Type:     http.StatusText(http.StatusRequestEntityTooLarge),
+		Detail:   fmt.Sprintf("The request body of operation '%s' exceeds the maximum size of %d bytes", operationId, maxBodySize),
+		Status:   http.StatusRequestEntityTooLarge,
+		Instance: "/body/error/" + operationId,
+	})
+}
+// IdempotentResponse is a response of an idempotent operation, as stored to be replayed to repeated requests
+type IdempotentResponse struct {
+	// The response's HTTP status code
+	Status int
+	// The response's headers
+	Headers http.Header
+	// The response's body
+	Body []byte
+}
+// IdempotencyStore stores the responses of operations declared via @Idempotent, by request key.
+//
+// Implementations must be safe for concurrent use
+type IdempotencyStore interface {
+	// Reserve claims the given key for a new request with the given body hash, for the given duration.
+	// If the key has already been claimed, returns false along with the stored response or,
+	// if the original request is still in flight, a nil response.
+	// If the key has been claimed by a request with a different body hash, returns ErrIdempotencyKeyReused
+	Reserve(ctx context.Context, key string, requestHash string, ttl time.Duration) (bool, *IdempotentResponse, error)
+	// Complete stores the response of the request that claimed the given key, along with the request's body hash, for the given duration
+	Complete(ctx context.Context, key string, requestHash string, response IdempotentResponse, ttl time.Duration) error
+	// Release drops the claim of a request that did not produce a replayable response, so that it may be retried
+	Release(ctx context.Context, key string) error
+}
+// ErrIdempotencyKeyReused is returned by an IdempotencyStore when a key is reused by a request with a different body
+var ErrIdempotencyKeyReused = errors.New("the idempotency key has already been used by a request with a different body")
+// InMemoryIdempotencyStore is an IdempotencyStore holding responses in the process's memory.
+//
+// Responses are not shared across instances - deployments with multiple instances should use a shared IdempotencyStore instead
+type InMemoryIdempotencyStore struct {
+	mutex     sync.Mutex
+	entries   map[string]*idempotencyEntry
+	lastSweep time.Time
+}
+type idempotencyEntry struct {
+	// The stored response. Nil while the request is in flight
+	response *IdempotentResponse
+	// The hash of the body of the request that claimed the entry's key
+	requestHash string
+	expiresAt   time.Time
+}
+// The interval at which an InMemoryIdempotencyStore drops expired entries
+const idempotencySweepInterval = time.Minute
+// NewInMemoryIdempotencyStore creates an InMemoryIdempotencyStore with no entries
+func NewInMemoryIdempotencyStore() *InMemoryIdempotencyStore {
+	return &InMemoryIdempotencyStore{entries: map[string]*idempotencyEntry{}, lastSweep: time.Now()}
+}
+func (store *InMemoryIdempotencyStore) Reserve(_ context.Context, key string, requestHash string, ttl time.Duration) (bool, *IdempotentResponse, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	now := time.Now()
+	store.sweep(now)
+	if entry, exists := store.entries[key]; exists && now.Before(entry.expiresAt) {
+		if entry.requestHash != requestHash {
+			return false, nil, ErrIdempotencyKeyReused
+		}
+		return false, entry.response, nil
+	}
+	store.entries[key] = &idempotencyEntry{requestHash: requestHash, expiresAt: now.Add(ttl)}
+	return true, nil, nil
+}
+func (store *InMemoryIdempotencyStore) Complete(_ context.Context, key string, requestHash string, response IdempotentResponse, ttl time.Duration) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.entries[key] = &idempotencyEntry{response: &response, requestHash: requestHash, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+func (store *InMemoryIdempotencyStore) Release(_ context.Context, key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.entries, key)
+	return nil
+}
+// sweep drops the entries that have expired
+func (store *InMemoryIdempotencyStore) sweep(now time.Time) {
+	if now.Sub(store.lastSweep) < idempotencySweepInterval {
+		return
+	}
+	for key, entry := range store.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(store.entries, key)
+		}
+	}
+	store.lastSweep = now
+}
+var idempotencyStore IdempotencyStore = NewInMemoryIdempotencyStore()
+// SetIdempotencyStore replaces the default InMemoryIdempotencyStore with the given store,
+// e.g. one backed by a database shared across instances
+func SetIdempotencyStore(store IdempotencyStore) {
+	if store == nil {
+		store = NewInMemoryIdempotencyStore()
+	}
+	idempotencyStore = store
+}
+// newIdempotencyTtl creates an operation's response retention duration out of its @Idempotent annotation's 'ttl' property
+func newIdempotencyTtl(ttl string) time.Duration {
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid idempotency TTL '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			ttl,
+		))
+	}
+	return duration
+}
+// The maximum size, in bytes, of the request bodies hashed by idempotent operations without a @MaxBodySize limit
+const defaultMaxIdempotentBodySize = 10 << 20
+// hashRequestBody returns the hex encoded SHA-256 hash of the request's body. The body remains readable.
+//
+// Requests whose body exceeds the given maximum size, or defaultMaxIdempotentBodySize if zero,
+// are answered with a 413 RFC7807 error, in which case false is returned
+func hashRequestBody(fiberCtx *fiber.Ctx, operationId string, maxBodySize int64) (string, bool, error) {
+	if maxBodySize <= 0 {
+		maxBodySize = defaultMaxIdempotentBodySize
+	}
+	body := fiberCtx.Body()
+	if int64(len(body)) > maxBodySize {
+		return "", false, writeIdempotencyError(fiberCtx, operationId, http.StatusRequestEntityTooLarge, fmt.Sprintf("The request body of operation '%s' exceeds the maximum size of %d bytes", operationId, maxBodySize))
+	}
+	hash := sha256.Sum256(body)
+	return hex.EncodeToString(hash[:]), true, nil
+}
+// beginIdempotentRequest claims the request's 'Idempotency-Key' header, scoped to the given operation and the request's principal.
+//
+// If the operation may proceed, returns a function storing its response, which must be deferred.
+// Otherwise, the request is answered - with the stored response if the key has been used before,
+// or with an RFC7807 error if the header is missing (400), the original request is still in flight (409),
+// the principal lacks a stable identity (500)
+// or the key has been used by a request with a different body (422) - and a nil function is returned
+func beginIdempotentRequest(fiberCtx *fiber.Ctx, operationId string, requestHash string, ttl time.Duration) (func(), error) {
+	idempotencyKey := fiberCtx.Get("Idempotency-Key")
+	if idempotencyKey == "" {
+		return nil, writeIdempotencyError(
+			fiberCtx,
+			operationId,
+			http.StatusBadRequest,
+			fmt.Sprintf("Operation '%s' requires an 'Idempotency-Key' header", operationId),
+		)
+	}
+	ctx := getRequestContext(fiberCtx)
+	// Keys are scoped by principal so that they may not collide across clients. Principals lacking a stable identity are
+	// rejected, as their requests could otherwise never be recognized as repeated
+	principalIdentity, isIdentifiable := describePrincipal(ctx)
+	if !isIdentifiable {
+		return nil, writeIdempotencyError(
+			fiberCtx,
+			operationId,
+			http.StatusInternalServerError,
+			fmt.Sprintf("The principal of operation '%s' lacks a stable identity to scope its 'Idempotency-Key' by", operationId),
+		)
+	}
+	key := operationId + "\x00" + principalIdentity + "\x00" + idempotencyKey
+	reserved, response, err := idempotencyStore.Reserve(ctx, key, requestHash, ttl)
+	switch {
+	case errors.Is(err, ErrIdempotencyKeyReused):
+		return nil, writeIdempotencyError(
+			fiberCtx,
+			operationId,
+			http.StatusUnprocessableEntity,
+			fmt.Sprintf("The 'Idempotency-Key' has already been used by a request to operation '%s' with a different body", operationId),
+		)
+	case err != nil:
+		return nil, writeIdempotencyError(
+			fiberCtx,
+			operationId,
+			http.StatusInternalServerError,
+			fmt.Sprintf("Failed to process the 'Idempotency-Key' header of operation '%s'", operationId),
+		)
+	case !reserved && response == nil:
+		return nil, writeIdempotencyError(
+			fiberCtx,
+			operationId,
+			http.StatusConflict,
+			fmt.Sprintf("A request to operation '%s' with the same 'Idempotency-Key' is still being processed", operationId),
+		)
+	case !reserved:
+		for name, values := range response.Headers {
+			for _, value := range values {
+				fiberCtx.Response().Header.Add(name, value)
+			}
+		}
+		fiberCtx.Set("Idempotent-Replayed", "true")
+		return nil, fiberCtx.Status(response.Status).Send(response.Body)
+	}
+	return func() {
+		// Requests that panicked or failed on the server's side are not replayed so they may be retried
+		recovered := recover()
+		status := fiberCtx.Response().StatusCode()
+		if recovered != nil || status >= http.StatusInternalServerError {
+			idempotencyStore.Release(context.WithoutCancel(ctx), key)
+			if recovered != nil {
+				panic(recovered)
+			}
+			return
+		}
+		idempotencyStore.Complete(
+			context.WithoutCancel(ctx),
+			key,
+			requestHash,
+			IdempotentResponse{
+				Status:  status,
+				Headers: http.Header(fiberCtx.GetRespHeaders()),
+				Body:    bytes.Clone(fiberCtx.Response().Body()),
+			},
+			ttl,
+		)
+	}, nil
+}
+// writeIdempotencyError answers a request to an idempotent operation with an RFC7807 error of the given status
+func writeIdempotencyError(fiberCtx *fiber.Ctx, operationId string, status int, detail string) error {
+	return fiberCtx.Status(status).JSON(runtime.Rfc7807Error{
+		Type:     http.StatusText(status),
+		Detail:   detail,
+		Status:   status,
+		Instance: "/idempotency/error/" + operationId,
+	})
+}
 type MiddlewareFunc func(ctx context.Context, fiberCtx *fiber.Ctx) (context.Context, bool)
 type ErrorMiddlewareFunc func(ctx context.Context, fiberCtx *fiber.Ctx, err error) (context.Context, bool)
 var beforeOperationMiddlewares []MiddlewareFunc
@@ -299,13 +1496,50 @@ func RegisterErrorMiddleware(executionType runtime.ErrorMiddlewareExecutionType,
 		onErrorMiddlewares = append(onErrorMiddlewares, errorMiddlewareFunc)
 	}
 }
+var namedMiddlewares = map[string]MiddlewareFunc{}
+// RegisterNamedMiddleware registers a middleware that controllers and routes may reference by name using @Middleware annotations.
+//
+// Named middlewares must be registered before RegisterRoutes is called
+func RegisterNamedMiddleware(name string, middlewareFunc MiddlewareFunc) {
+	namedMiddlewares[name] = middlewareFunc
+}
+func ensureNamedMiddlewaresRegistered(names []string) {
+	missing := []string{}
+	for _, name := range names {
+		if _, registered := namedMiddlewares[name]; !registered {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		panic(fmt.Sprintf(
+			"middlewares referenced via @Middleware annotations were never registered using RegisterNamedMiddleware: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
 func RegisterCustomValidator(validateTagName string, validateFunc runtime.ValidationFunc) {
 	validatorInstance.RegisterValidation(validateTagName, func(fl validator.FieldLevel) bool {
 		return validateFunc(fl)
 	})
 }
+// BasePath is the path under which RegisterRoutes mounts the API's routes
+const BasePath = ""
+// RegisterRoutes registers the API's routes on the given engine, under BasePath.
+//
+// Routes with custom HTTP verbs, e.g. PURGE, require the verbs to be listed in the app's Config.RequestMethods
 func RegisterRoutes(engine *fiber.App) {
+	RegisterGroupRoutes(engine, BasePath)
+}
+// RegisterGroupRoutes registers the API's routes on the given app or group, under the given base path.
+//
+// The base path is relative to the router's own prefix.
+// For the OpenAPI specification to match the served routes, the two should add up to BasePath.
+//
+// Routes with custom HTTP verbs, e.g. PURGE, require the verbs to be listed in the app's Config.RequestMethods
+func RegisterGroupRoutes(engine fiber.Router, basePath string) {
 	urlParamRegex = regexp.MustCompile(`\{([\w\d-_]+)\}`)
+	ensureSecurityHandlersRegistered()
+	ensureNamedMiddlewaresRegistered([]string{"audit", "trace"})
 	registerEnumValidation(validatorInstance, "bool_enum_enum", []string{"false", "true"})
 	registerEnumValidation(validatorInstance, "length_units_enum", []string{"Angstrom", "AstronomicalUnit", "Centimeter", "Chain", "DataMile", "Decameter", "Decimeter", "DtpPica", "DtpPoint", "Fathom", "Femtometer", "Foot", "Gigameter", "Hand", "Hectometer", "Inch", "Kilofoot", "KilolightYear", "Kilometer", "Kiloparsec", "Kiloyard", "LightYear", "MegalightYear", "Megameter", "Megaparsec", "Meter", "Microinch", "Micrometer", "Mil", "Mile", "Millimeter", "Nanometer", "NauticalMile", "Parsec", "Picometer", "PrinterPica", "PrinterPoint", "Shackle", "SolarRadius", "Twip", "UsSurveyFoot", "Yard"})
 	registerEnumValidation(validatorInstance, "myemamium_enum", []string{"one", "two"})
@@ -315,8 +1549,21 @@ func RegisterRoutes(engine *fiber.App) {
 	registerEnumValidation(validatorInstance, "status_enumeration_enum", []string{"active", "inactive"})
 	// RegisterRoutesExtension - test
 	// E2EClassSecController
-	engine.Get(toFiberUrl("/e2e/with-default-class-security"), func(fiberCtx *fiber.Ctx) error {
+	e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes := []int{}
+	e2EclassSecControllerWithDefaultClassSecurityRoute := withBasePath(basePath, "/e2e/with-default-class-security")
+	e2EclassSecControllerWithDefaultClassSecurityHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"WithDefaultClassSecurity",
+			"E2EClassSecController",
+			"GET",
+			e2EclassSecControllerWithDefaultClassSecurityRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "WithDefaultClassSecurity")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -336,8 +1583,9 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "WithDefaultClassSecurity")
 		}
-		controller := E2EClassSecController.E2EClassSecController{}
+		controller := createController[E2EClassSecController.E2EClassSecController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("x-test-scopes")
 		isheaderParamExists := len(fiberCtx.Request().Header.Peek("x-test-scopes")) > 0
@@ -356,10 +1604,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "WithDefaultClassSecurity")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -370,13 +1621,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "WithDefaultClassSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "WithDefaultClassSecurity")
+			}
+		}()
 		value, opError := controller.WithDefaultClassSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "WithDefaultClassSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -394,6 +1652,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/WithDefaultClassSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "WithDefaultClassSecurity")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -411,9 +1670,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "WithDefaultClassSecurity")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/with-default-override-class-security"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/with-default-class-security")), e2EclassSecControllerWithDefaultClassSecurityHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/with-default-class-security")), e2EclassSecControllerWithDefaultClassSecurityHandler)
+	e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes := []int{}
+	e2EclassSecControllerWithOverrideClassSecurityRoute := withBasePath(basePath, "/e2e/with-default-override-class-security")
+	e2EclassSecControllerWithOverrideClassSecurityHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"WithOverrideClassSecurity",
+			"E2EClassSecController",
+			"GET",
+			e2EclassSecControllerWithOverrideClassSecurityRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "WithOverrideClassSecurity")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -433,8 +1707,9 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "WithOverrideClassSecurity")
 		}
-		controller := E2EClassSecController.E2EClassSecController{}
+		controller := createController[E2EClassSecController.E2EClassSecController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("x-test-scopes")
 		isheaderParamExists := len(fiberCtx.Request().Header.Peek("x-test-scopes")) > 0
@@ -453,10 +1728,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "WithOverrideClassSecurity")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -467,13 +1745,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "WithOverrideClassSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "WithOverrideClassSecurity")
+			}
+		}()
 		value, opError := controller.WithOverrideClassSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "WithOverrideClassSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -491,6 +1776,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/WithOverrideClassSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "WithOverrideClassSecurity")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -508,10 +1794,25 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "WithOverrideClassSecurity")
 		return nil
-	})
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/with-default-override-class-security")), e2EclassSecControllerWithOverrideClassSecurityHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/with-default-override-class-security")), e2EclassSecControllerWithOverrideClassSecurityHandler)
 	// E2EController
-	engine.Get(toFiberUrl("/e2e/simple-get"), func(fiberCtx *fiber.Ctx) error {
+	e2EcontrollerSimpleGetErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetRoute := withBasePath(basePath, "/e2e/simple-get")
+	e2EcontrollerSimpleGetHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"SimpleGet",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "SimpleGet")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -531,8 +1832,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "SimpleGet")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -543,13 +1846,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "SimpleGet")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "SimpleGet")
+			}
+		}()
 		value, opError := controller.SimpleGet()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "SimpleGet")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -567,6 +1877,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/SimpleGet",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "SimpleGet")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -584,9 +1895,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "SimpleGet")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/simple-get-empty-string"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/simple-get")), e2EcontrollerSimpleGetHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/simple-get")), e2EcontrollerSimpleGetHandler)
+	e2EcontrollerSimpleGetEmptyStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyStringRoute := withBasePath(basePath, "/e2e/simple-get-empty-string")
+	e2EcontrollerSimpleGetEmptyStringHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"SimpleGetEmptyString",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetEmptyStringRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "SimpleGetEmptyString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -606,8 +1932,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "SimpleGetEmptyString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -618,13 +1946,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "SimpleGetEmptyString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "SimpleGetEmptyString")
+			}
+		}()
 		value, opError := controller.SimpleGetEmptyString()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "SimpleGetEmptyString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -642,6 +1977,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/SimpleGetEmptyString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "SimpleGetEmptyString")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -659,9 +1995,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "SimpleGetEmptyString")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/simple-get-ptr-string"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-empty-string")), e2EcontrollerSimpleGetEmptyStringHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-empty-string")), e2EcontrollerSimpleGetEmptyStringHandler)
+	e2EcontrollerSimpleGetPtrStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetPtrStringRoute := withBasePath(basePath, "/e2e/simple-get-ptr-string")
+	e2EcontrollerSimpleGetPtrStringHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"SimpleGetPtrString",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetPtrStringRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "SimpleGetPtrString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -681,8 +2032,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "SimpleGetPtrString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -693,13 +2046,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "SimpleGetPtrString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "SimpleGetPtrString")
+			}
+		}()
 		value, opError := controller.SimpleGetPtrString()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "SimpleGetPtrString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -717,6 +2077,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/SimpleGetPtrString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "SimpleGetPtrString")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -734,9 +2095,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "SimpleGetPtrString")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/simple-get-null-string"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-ptr-string")), e2EcontrollerSimpleGetPtrStringHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-ptr-string")), e2EcontrollerSimpleGetPtrStringHandler)
+	e2EcontrollerSimpleGetNullStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetNullStringRoute := withBasePath(basePath, "/e2e/simple-get-null-string")
+	e2EcontrollerSimpleGetNullStringHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"SimpleGetNullString",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetNullStringRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "SimpleGetNullString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -756,8 +2132,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "SimpleGetNullString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -768,13 +2146,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "SimpleGetNullString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "SimpleGetNullString")
+			}
+		}()
 		value, opError := controller.SimpleGetNullString()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "SimpleGetNullString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -792,6 +2177,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/SimpleGetNullString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "SimpleGetNullString")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -809,9 +2195,134 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "SimpleGetNullString")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/simple-get-object"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-null-string")), e2EcontrollerSimpleGetNullStringHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-null-string")), e2EcontrollerSimpleGetNullStringHandler)
+	e2EcontrollerNamedMiddlewaresErrorStatusCodes := []int{}
+	e2EcontrollerNamedMiddlewaresRoute := withBasePath(basePath, "/e2e/named-middlewares")
+	e2EcontrollerNamedMiddlewaresHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"NamedMiddlewares",
+			"E2EController",
+			"GET",
+			e2EcontrollerNamedMiddlewaresRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "NamedMiddlewares")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "NamedMiddlewares")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		routeMiddlewares := []MiddlewareFunc{namedMiddlewares["audit"], namedMiddlewares["trace"]}
+		// Middlewares routeMiddlewares section
+		for _, middleware := range routeMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares routeMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "NamedMiddlewares")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "NamedMiddlewares")
+			}
+		}()
+		value, opError := controller.NamedMiddlewares()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "NamedMiddlewares")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'NamedMiddlewares'",
+				Status:     statusCode,
+				Instance:   "/controller/error/NamedMiddlewares",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "NamedMiddlewares")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "NamedMiddlewares")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "NamedMiddlewares")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "NamedMiddlewares")
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/named-middlewares")), e2EcontrollerNamedMiddlewaresHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/named-middlewares")), e2EcontrollerNamedMiddlewaresHandler)
+	e2EcontrollerSimpleGetObjectErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectRoute := withBasePath(basePath, "/e2e/simple-get-object")
+	e2EcontrollerSimpleGetObjectHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"SimpleGetObject",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetObjectRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "SimpleGetObject")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -831,8 +2342,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "SimpleGetObject")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -843,13 +2356,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "SimpleGetObject")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "SimpleGetObject")
+			}
+		}()
 		value, opError := controller.SimpleGetObject()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "SimpleGetObject")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -867,6 +2387,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/SimpleGetObject",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "SimpleGetObject")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -906,9 +2427,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "SimpleGetObject")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/simple-get-object-ptr"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-object")), e2EcontrollerSimpleGetObjectHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-object")), e2EcontrollerSimpleGetObjectHandler)
+	e2EcontrollerSimpleGetObjectPtrErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectPtrRoute := withBasePath(basePath, "/e2e/simple-get-object-ptr")
+	e2EcontrollerSimpleGetObjectPtrHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"SimpleGetObjectPtr",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetObjectPtrRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "SimpleGetObjectPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -928,8 +2464,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "SimpleGetObjectPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -940,13 +2478,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "SimpleGetObjectPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "SimpleGetObjectPtr")
+			}
+		}()
 		value, opError := controller.SimpleGetObjectPtr()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "SimpleGetObjectPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -964,6 +2509,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/SimpleGetObjectPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "SimpleGetObjectPtr")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1007,9 +2553,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "SimpleGetObjectPtr")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/simple-get-object-null"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-object-ptr")), e2EcontrollerSimpleGetObjectPtrHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-object-ptr")), e2EcontrollerSimpleGetObjectPtrHandler)
+	e2EcontrollerSimpleGetObjectNullErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectNullRoute := withBasePath(basePath, "/e2e/simple-get-object-null")
+	e2EcontrollerSimpleGetObjectNullHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"SimpleGetObjectNull",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetObjectNullRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "SimpleGetObjectNull")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -1029,8 +2590,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "SimpleGetObjectNull")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -1041,13 +2604,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "SimpleGetObjectNull")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "SimpleGetObjectNull")
+			}
+		}()
 		value, opError := controller.SimpleGetObjectNull()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "SimpleGetObjectNull")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1065,6 +2635,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/SimpleGetObjectNull",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "SimpleGetObjectNull")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1108,9 +2679,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "SimpleGetObjectNull")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/primitive-return-type"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-object-null")), e2EcontrollerSimpleGetObjectNullHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-object-null")), e2EcontrollerSimpleGetObjectNullHandler)
+	e2EcontrollerPrimitiveReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-return-type")
+	e2EcontrollerPrimitiveReturnTypeHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"PrimitiveReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveReturnTypeRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "PrimitiveReturnType")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -1130,8 +2716,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "PrimitiveReturnType")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -1142,13 +2730,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "PrimitiveReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "PrimitiveReturnType")
+			}
+		}()
 		value, opError := controller.PrimitiveReturnType()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "PrimitiveReturnType")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1166,6 +2761,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/PrimitiveReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "PrimitiveReturnType")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1183,9 +2779,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "PrimitiveReturnType")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/primitive-array-return-type"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/primitive-return-type")), e2EcontrollerPrimitiveReturnTypeHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/primitive-return-type")), e2EcontrollerPrimitiveReturnTypeHandler)
+	e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveArrayReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-array-return-type")
+	e2EcontrollerPrimitiveArrayReturnTypeHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"PrimitiveArrayReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveArrayReturnTypeRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "PrimitiveArrayReturnType")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -1205,8 +2816,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "PrimitiveArrayReturnType")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -1217,13 +2830,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "PrimitiveArrayReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "PrimitiveArrayReturnType")
+			}
+		}()
 		value, opError := controller.PrimitiveArrayReturnType()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "PrimitiveArrayReturnType")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1241,6 +2861,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/PrimitiveArrayReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "PrimitiveArrayReturnType")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1258,9 +2879,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "PrimitiveArrayReturnType")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/primitive-alias-return-type"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/primitive-array-return-type")), e2EcontrollerPrimitiveArrayReturnTypeHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/primitive-array-return-type")), e2EcontrollerPrimitiveArrayReturnTypeHandler)
+	e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-alias-return-type")
+	e2EcontrollerPrimitiveAliasReturnTypeHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"PrimitiveAliasReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveAliasReturnTypeRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "PrimitiveAliasReturnType")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -1280,8 +2916,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "PrimitiveAliasReturnType")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -1292,13 +2930,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "PrimitiveAliasReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "PrimitiveAliasReturnType")
+			}
+		}()
 		value, opError := controller.PrimitiveAliasReturnType()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "PrimitiveAliasReturnType")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1316,6 +2961,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/PrimitiveAliasReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "PrimitiveAliasReturnType")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1355,9 +3001,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "PrimitiveAliasReturnType")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/primitive-alias-array-return-type"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/primitive-alias-return-type")), e2EcontrollerPrimitiveAliasReturnTypeHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/primitive-alias-return-type")), e2EcontrollerPrimitiveAliasReturnTypeHandler)
+	e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasArrayReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-alias-array-return-type")
+	e2EcontrollerPrimitiveAliasArrayReturnTypeHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"PrimitiveAliasArrayReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveAliasArrayReturnTypeRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "PrimitiveAliasArrayReturnType")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -1377,8 +3038,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "PrimitiveAliasArrayReturnType")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -1389,13 +3052,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "PrimitiveAliasArrayReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "PrimitiveAliasArrayReturnType")
+			}
+		}()
 		value, opError := controller.PrimitiveAliasArrayReturnType()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "PrimitiveAliasArrayReturnType")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1413,6 +3083,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/PrimitiveAliasArrayReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "PrimitiveAliasArrayReturnType")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1452,9 +3123,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "PrimitiveAliasArrayReturnType")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/simple-get-empty"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/primitive-alias-array-return-type")), e2EcontrollerPrimitiveAliasArrayReturnTypeHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/primitive-alias-array-return-type")), e2EcontrollerPrimitiveAliasArrayReturnTypeHandler)
+	e2EcontrollerSimpleGetEmptyErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyRoute := withBasePath(basePath, "/e2e/simple-get-empty")
+	e2EcontrollerSimpleGetEmptyHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"SimpleGetEmpty",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetEmptyRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "SimpleGetEmpty")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -1474,8 +3160,9 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "SimpleGetEmpty")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var queryParamRawPtr *string = nil
 		queryParamRaw := fiberCtx.Query("queryParam")
 		isqueryParamExists := fiberCtx.Context().QueryArgs().Has("queryParam")
@@ -1494,10 +3181,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "SimpleGetEmpty")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -1508,13 +3198,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "SimpleGetEmpty")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "SimpleGetEmpty")
+			}
+		}()
 		opError := controller.SimpleGetEmpty(*queryParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "SimpleGetEmpty")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1532,6 +3229,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/SimpleGetEmpty",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "SimpleGetEmpty")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1549,9 +3247,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "SimpleGetEmpty")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/get-with-all-params/{pathParam}"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-empty")), e2EcontrollerSimpleGetEmptyHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/simple-get-empty")), e2EcontrollerSimpleGetEmptyHandler)
+	e2EcontrollerGetWithAllParamsErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsRoute := withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")
+	e2EcontrollerGetWithAllParamsHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"GetWithAllParams",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetWithAllParamsRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "GetWithAllParams")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -1571,8 +3284,9 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "GetWithAllParams")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var queryParamRawPtr *string = nil
 		queryParamRaw := fiberCtx.Query("queryParam")
 		isqueryParamExists := fiberCtx.Context().QueryArgs().Has("queryParam")
@@ -1591,9 +3305,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "GetWithAllParams")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var pathParamRawPtr *string = nil
 		pathParamRaw := fiberCtx.Params("pathParam")
@@ -1613,9 +3329,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "GetWithAllParams")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("headerParam")
@@ -1635,10 +3353,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "GetWithAllParams")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -1649,13 +3370,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "GetWithAllParams")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "GetWithAllParams")
+			}
+		}()
 		value, opError := controller.GetWithAllParams(*queryParamRawPtr, *pathParamRawPtr, *headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "GetWithAllParams")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1673,6 +3401,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/GetWithAllParams",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "GetWithAllParams")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1690,9 +3419,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "GetWithAllParams")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/get-with-all-params-ptr/{pathParam}"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")), e2EcontrollerGetWithAllParamsHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")), e2EcontrollerGetWithAllParamsHandler)
+	e2EcontrollerGetWithAllParamsPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsPtrRoute := withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")
+	e2EcontrollerGetWithAllParamsPtrHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"GetWithAllParamsPtr",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetWithAllParamsPtrRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "GetWithAllParamsPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -1712,8 +3456,9 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "GetWithAllParamsPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var queryParamRawPtr *string = nil
 		queryParamRaw := fiberCtx.Query("queryParam")
 		isqueryParamExists := fiberCtx.Context().QueryArgs().Has("queryParam")
@@ -1739,9 +3484,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "GetWithAllParamsPtr")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("headerParam")
@@ -1750,6 +3497,7 @@ func RegisterRoutes(engine *fiber.App) {
 			headerParam := headerParamRaw
 			headerParamRawPtr = &headerParam
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -1760,13 +3508,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "GetWithAllParamsPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "GetWithAllParamsPtr")
+			}
+		}()
 		value, opError := controller.GetWithAllParamsPtr(queryParamRawPtr, pathParamRawPtr, headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "GetWithAllParamsPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1784,6 +3539,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/GetWithAllParamsPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "GetWithAllParamsPtr")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1801,9 +3557,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "GetWithAllParamsPtr")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/get-with-all-params-required-ptr/{pathParam}"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsPtrHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsPtrHandler)
+	e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsRequiredPtrRoute := withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")
+	e2EcontrollerGetWithAllParamsRequiredPtrHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"GetWithAllParamsRequiredPtr",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetWithAllParamsRequiredPtrRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "GetWithAllParamsRequiredPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -1823,8 +3594,9 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "GetWithAllParamsRequiredPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var queryParamRawPtr *string = nil
 		queryParamRaw := fiberCtx.Query("queryParam")
 		isqueryParamExists := fiberCtx.Context().QueryArgs().Has("queryParam")
@@ -1843,9 +3615,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "GetWithAllParamsRequiredPtr")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var pathParamRawPtr *string = nil
 		pathParamRaw := fiberCtx.Params("pathParam")
@@ -1865,9 +3639,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "GetWithAllParamsRequiredPtr")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("headerParam")
@@ -1887,10 +3663,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "GetWithAllParamsRequiredPtr")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -1901,13 +3680,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "GetWithAllParamsRequiredPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "GetWithAllParamsRequiredPtr")
+			}
+		}()
 		value, opError := controller.GetWithAllParamsRequiredPtr(queryParamRawPtr, pathParamRawPtr, headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "GetWithAllParamsRequiredPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1925,6 +3711,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/GetWithAllParamsRequiredPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "GetWithAllParamsRequiredPtr")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -1942,9 +3729,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "GetWithAllParamsRequiredPtr")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/post-with-all-params-body"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsRequiredPtrHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsRequiredPtrHandler)
+	e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyRoute := withBasePath(basePath, "/e2e/post-with-all-params-body")
+	e2EcontrollerPostWithAllParamsWithBodyHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"PostWithAllParamsWithBody",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostWithAllParamsWithBodyRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "PostWithAllParamsWithBody")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -1964,8 +3766,9 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "PostWithAllParamsWithBody")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
 		var queryParamRawPtr *string = nil
 		queryParamRaw := fiberCtx.Query("queryParam")
@@ -1985,9 +3788,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "PostWithAllParamsWithBody")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("headerParam")
@@ -2007,9 +3812,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "PostWithAllParamsWithBody")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var theBodyRawPtr *Param5theBody.BodyInfo = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &theBodyRawPtr)
@@ -2023,20 +3830,27 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBody' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBody",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBody",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBody")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -2047,13 +3861,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBody")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "PostWithAllParamsWithBody")
+			}
+		}()
 		value, opError := controller.PostWithAllParamsWithBody(*queryParamRawPtr, *headerParamRawPtr, *theBodyRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "PostWithAllParamsWithBody")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2071,6 +3892,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/PostWithAllParamsWithBody",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "PostWithAllParamsWithBody")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -2110,9 +3932,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "PostWithAllParamsWithBody")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/post-with-all-params-body-ptr"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/post-with-all-params-body")), e2EcontrollerPostWithAllParamsWithBodyHandler)
+	e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyPtrRoute := withBasePath(basePath, "/e2e/post-with-all-params-body-ptr")
+	e2EcontrollerPostWithAllParamsWithBodyPtrHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"PostWithAllParamsWithBodyPtr",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostWithAllParamsWithBodyPtrRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "PostWithAllParamsWithBodyPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -2132,8 +3968,9 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "PostWithAllParamsWithBodyPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
 		var queryParamRawPtr *string = nil
 		queryParamRaw := fiberCtx.Query("queryParam")
@@ -2161,20 +3998,27 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBodyPtr' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBodyPtr",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBodyPtr")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -2185,13 +4029,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBodyPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "PostWithAllParamsWithBodyPtr")
+			}
+		}()
 		value, opError := controller.PostWithAllParamsWithBodyPtr(queryParamRawPtr, headerParamRawPtr, theBodyRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "PostWithAllParamsWithBodyPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2209,6 +4060,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/PostWithAllParamsWithBodyPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "PostWithAllParamsWithBodyPtr")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -2252,9 +4104,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "PostWithAllParamsWithBodyPtr")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/post-with-all-params-body-required-ptr"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-ptr")), e2EcontrollerPostWithAllParamsWithBodyPtrHandler)
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrRoute := withBasePath(basePath, "/e2e/post-with-all-params-body-required-ptr")
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"PostWithAllParamsWithBodyRequiredPtr",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostWithAllParamsWithBodyRequiredPtrRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -2274,8 +4140,9 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "PostWithAllParamsWithBodyRequiredPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
 		var theBodyRawPtr *Param5theBody.BodyInfo = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &theBodyRawPtr)
@@ -2289,20 +4156,27 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBodyRequiredPtr' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyRequiredPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBodyRequiredPtr",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -2313,13 +4187,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "PostWithAllParamsWithBodyRequiredPtr")
+			}
+		}()
 		value, opError := controller.PostWithAllParamsWithBodyRequiredPtr(theBodyRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "PostWithAllParamsWithBodyRequiredPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2337,6 +4218,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/PostWithAllParamsWithBodyRequiredPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -2380,9 +4262,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/get-header-start-with-letter"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-required-ptr")), e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler)
+	e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes := []int{}
+	e2EcontrollerGetHeaderStartWithLetterRoute := withBasePath(basePath, "/e2e/get-header-start-with-letter")
+	e2EcontrollerGetHeaderStartWithLetterHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"GetHeaderStartWithLetter",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetHeaderStartWithLetterRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "GetHeaderStartWithLetter")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -2402,8 +4298,9 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "GetHeaderStartWithLetter")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("headerParam")
 		isheaderParamExists := len(fiberCtx.Request().Header.Peek("headerParam")) > 0
@@ -2422,10 +4319,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "GetHeaderStartWithLetter")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -2436,13 +4336,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "GetHeaderStartWithLetter")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "GetHeaderStartWithLetter")
+			}
+		}()
 		value, opError := controller.GetHeaderStartWithLetter(*headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "GetHeaderStartWithLetter")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2460,6 +4367,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/GetHeaderStartWithLetter",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "GetHeaderStartWithLetter")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -2477,9 +4385,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "GetHeaderStartWithLetter")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/with-default-config-security"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/get-header-start-with-letter")), e2EcontrollerGetHeaderStartWithLetterHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/get-header-start-with-letter")), e2EcontrollerGetHeaderStartWithLetterHandler)
+	e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithDefaultConfigSecurityRoute := withBasePath(basePath, "/e2e/with-default-config-security")
+	e2EcontrollerWithDefaultConfigSecurityHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"WithDefaultConfigSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithDefaultConfigSecurityRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "WithDefaultConfigSecurity")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -2499,8 +4422,9 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "WithDefaultConfigSecurity")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var headerParamRawPtr *string = nil
 		headerParamRaw := fiberCtx.Get("x-test-scopes")
 		isheaderParamExists := len(fiberCtx.Request().Header.Peek("x-test-scopes")) > 0
@@ -2519,10 +4443,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "WithDefaultConfigSecurity")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -2533,13 +4460,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "WithDefaultConfigSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "WithDefaultConfigSecurity")
+			}
+		}()
 		value, opError := controller.WithDefaultConfigSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "WithDefaultConfigSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2557,6 +4491,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/WithDefaultConfigSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "WithDefaultConfigSecurity")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -2570,13 +4505,2279 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		fiberCtx.Set("x-AfterOperationRoutesExtension", "WithDefaultConfigSecurity")
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "WithDefaultConfigSecurity")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "WithDefaultConfigSecurity")
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/with-default-config-security")), e2EcontrollerWithDefaultConfigSecurityHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/with-default-config-security")), e2EcontrollerWithDefaultConfigSecurityHandler)
+	e2EcontrollerWithOneSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithOneSecurityRoute := withBasePath(basePath, "/e2e/with-one-security")
+	e2EcontrollerWithOneSecurityHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"WithOneSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithOneSecurityRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "WithOneSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "WithOneSecurity")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := fiberCtx.Get("x-test-scopes")
+		isheaderParamExists := len(fiberCtx.Request().Header.Peek("x-test-scopes")) > 0
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, validatorErr)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			fiberCtx.Set("x-RunValidatorExtension", "WithOneSecurity")
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "WithOneSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "WithOneSecurity")
+			}
+		}()
+		value, opError := controller.WithOneSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "WithOneSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithOneSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithOneSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "WithOneSecurity")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "WithOneSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "WithOneSecurity")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "WithOneSecurity")
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/with-one-security")), e2EcontrollerWithOneSecurityHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/with-one-security")), e2EcontrollerWithOneSecurityHandler)
+	e2EcontrollerWithTwoSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecurityRoute := withBasePath(basePath, "/e2e/with-two-security")
+	e2EcontrollerWithTwoSecurityHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"WithTwoSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithTwoSecurityRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "WithTwoSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+								"read",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "WithTwoSecurity")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := fiberCtx.Get("x-test-scopes")
+		isheaderParamExists := len(fiberCtx.Request().Header.Peek("x-test-scopes")) > 0
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, validatorErr)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			fiberCtx.Set("x-RunValidatorExtension", "WithTwoSecurity")
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "WithTwoSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "WithTwoSecurity")
+			}
+		}()
+		value, opError := controller.WithTwoSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "WithTwoSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithTwoSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithTwoSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "WithTwoSecurity")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "WithTwoSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "WithTwoSecurity")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "WithTwoSecurity")
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/with-two-security")), e2EcontrollerWithTwoSecurityHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/with-two-security")), e2EcontrollerWithTwoSecurityHandler)
+	e2EcontrollerWithAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithAdvancedSecurityRoute := withBasePath(basePath, "/e2e/with-advanced-security")
+	e2EcontrollerWithAdvancedSecurityHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"WithAdvancedSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithAdvancedSecurityRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "WithAdvancedSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName3",
+							Scopes: []string{
+								"admin",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "WithAdvancedSecurity")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := fiberCtx.Get("x-test-scopes")
+		isheaderParamExists := len(fiberCtx.Request().Header.Peek("x-test-scopes")) > 0
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, validatorErr)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithAdvancedSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			fiberCtx.Set("x-RunValidatorExtension", "WithAdvancedSecurity")
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "WithAdvancedSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "WithAdvancedSecurity")
+			}
+		}()
+		value, opError := controller.WithAdvancedSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "WithAdvancedSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithAdvancedSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithAdvancedSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "WithAdvancedSecurity")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "WithAdvancedSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "WithAdvancedSecurity")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "WithAdvancedSecurity")
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/with-advanced-security")), e2EcontrollerWithAdvancedSecurityHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/with-advanced-security")), e2EcontrollerWithAdvancedSecurityHandler)
+	e2EcontrollerWithPrincipalErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalRoute := withBasePath(basePath, "/e2e/with-principal")
+	e2EcontrollerWithPrincipalHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"WithPrincipal",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithPrincipalRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "WithPrincipal")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "WithPrincipal")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(fiberCtx))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			return handleAuthorizationError(fiberCtx, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipal")
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "WithPrincipal")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "WithPrincipal")
+			}
+		}()
+		value, opError := controller.WithPrincipal(principalRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "WithPrincipal")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithPrincipal'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithPrincipal",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "WithPrincipal")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "WithPrincipal")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "WithPrincipal")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "WithPrincipal")
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/with-principal")), e2EcontrollerWithPrincipalHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/with-principal")), e2EcontrollerWithPrincipalHandler)
+	e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalAdvancedSecurityRoute := withBasePath(basePath, "/e2e/with-principal-advanced-security")
+	e2EcontrollerWithPrincipalAdvancedSecurityHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"WithPrincipalAdvancedSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithPrincipalAdvancedSecurityRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "WithPrincipalAdvancedSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName3",
+							Scopes: []string{
+								"admin",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "WithPrincipalAdvancedSecurity")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(fiberCtx))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			return handleAuthorizationError(fiberCtx, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipalAdvancedSecurity")
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "WithPrincipalAdvancedSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "WithPrincipalAdvancedSecurity")
+			}
+		}()
+		value, opError := controller.WithPrincipalAdvancedSecurity(principalRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "WithPrincipalAdvancedSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithPrincipalAdvancedSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithPrincipalAdvancedSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "WithPrincipalAdvancedSecurity")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "WithPrincipalAdvancedSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "WithPrincipalAdvancedSecurity")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "WithPrincipalAdvancedSecurity")
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/with-principal-advanced-security")), e2EcontrollerWithPrincipalAdvancedSecurityHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/with-principal-advanced-security")), e2EcontrollerWithPrincipalAdvancedSecurityHandler)
+	e2EcontrollerPublicRouteErrorStatusCodes := []int{}
+	e2EcontrollerPublicRouteRoute := withBasePath(basePath, "/e2e/public")
+	e2EcontrollerPublicRouteHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"PublicRoute",
+			"E2EController",
+			"GET",
+			e2EcontrollerPublicRouteRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "PublicRoute")
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "PublicRoute")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "PublicRoute")
+			}
+		}()
+		value, opError := controller.PublicRoute()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "PublicRoute")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PublicRoute'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PublicRoute",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "PublicRoute")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "PublicRoute")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "PublicRoute")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "PublicRoute")
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/public")), e2EcontrollerPublicRouteHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/public")), e2EcontrollerPublicRouteHandler)
+	e2EcontrollerHeadCheckErrorStatusCodes := []int{}
+	e2EcontrollerHeadCheckRoute := withBasePath(basePath, "/e2e/head-check")
+	e2EcontrollerHeadCheckHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"HeadCheck",
+			"E2EController",
+			"HEAD",
+			e2EcontrollerHeadCheckRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "HeadCheck")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "HeadCheck")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "HeadCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "HeadCheck")
+			}
+		}()
+		opError := controller.HeadCheck()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "HeadCheck")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'HeadCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/HeadCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "HeadCheck")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "HeadCheck")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "HeadCheck")
+		fiberCtx.Status(statusCode)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "HeadCheck")
+		return nil
+	}
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/head-check")), e2EcontrollerHeadCheckHandler)
+	e2EcontrollerOptionsCheckErrorStatusCodes := []int{}
+	e2EcontrollerOptionsCheckRoute := withBasePath(basePath, "/e2e/options-check")
+	e2EcontrollerOptionsCheckHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"OptionsCheck",
+			"E2EController",
+			"OPTIONS",
+			e2EcontrollerOptionsCheckRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "OptionsCheck")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "OptionsCheck")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "OptionsCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "OptionsCheck")
+			}
+		}()
+		value, opError := controller.OptionsCheck()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "OptionsCheck")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'OptionsCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/OptionsCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "OptionsCheck")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "OptionsCheck")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "OptionsCheck")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "OptionsCheck")
+		return nil
+	}
+	engine.Add("OPTIONS", toFiberUrl(withBasePath(basePath, "/e2e/options-check")), e2EcontrollerOptionsCheckHandler)
+	e2EcontrollerTraceCheckErrorStatusCodes := []int{}
+	e2EcontrollerTraceCheckRoute := withBasePath(basePath, "/e2e/trace-check")
+	e2EcontrollerTraceCheckHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"TraceCheck",
+			"E2EController",
+			"TRACE",
+			e2EcontrollerTraceCheckRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "TraceCheck")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "TraceCheck")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "TraceCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "TraceCheck")
+			}
+		}()
+		value, opError := controller.TraceCheck()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "TraceCheck")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TraceCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TraceCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "TraceCheck")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "TraceCheck")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "TraceCheck")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "TraceCheck")
+		return nil
+	}
+	engine.Add("TRACE", toFiberUrl(withBasePath(basePath, "/e2e/trace-check")), e2EcontrollerTraceCheckHandler)
+	e2EcontrollerPurgeCheckErrorStatusCodes := []int{}
+	e2EcontrollerPurgeCheckRoute := withBasePath(basePath, "/e2e/purge-check")
+	e2EcontrollerPurgeCheckHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"PurgeCheck",
+			"E2EController",
+			"PURGE",
+			e2EcontrollerPurgeCheckRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "PurgeCheck")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "PurgeCheck")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "PurgeCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "PurgeCheck")
+			}
+		}()
+		value, opError := controller.PurgeCheck()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "PurgeCheck")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PurgeCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PurgeCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "PurgeCheck")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "PurgeCheck")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "PurgeCheck")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "PurgeCheck")
+		return nil
+	}
+	engine.Add("PURGE", toFiberUrl(withBasePath(basePath, "/e2e/purge-check")), e2EcontrollerPurgeCheckHandler)
+	e2EcontrollerVersionedV1ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV1Route := withBasePath(basePath, "/e2e/versioned")
+	e2EcontrollerVersionedV1Handler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"VersionedV1",
+			"E2EController",
+			"GET",
+			e2EcontrollerVersionedV1Route,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "VersionedV1")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "VersionedV1")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "VersionedV1")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "VersionedV1")
+			}
+		}()
+		value, opError := controller.VersionedV1()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "VersionedV1")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV1'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV1",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "VersionedV1")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "VersionedV1")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "VersionedV1")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "VersionedV1")
+		return nil
+	}
+	e2EcontrollerVersionedV1Versions := []int{1}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV1Versions, e2EcontrollerVersionedV1Handler)
+	registerVersionedRoute(engine, basePath, "HEAD", "/e2e/versioned", e2EcontrollerVersionedV1Versions, e2EcontrollerVersionedV1Handler)
+	e2EcontrollerVersionedV2ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV2Route := withBasePath(basePath, "/e2e/versioned")
+	e2EcontrollerVersionedV2Handler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"VersionedV2",
+			"E2EController",
+			"GET",
+			e2EcontrollerVersionedV2Route,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "VersionedV2")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "VersionedV2")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "VersionedV2")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "VersionedV2")
+			}
+		}()
+		value, opError := controller.VersionedV2()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "VersionedV2")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV2'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV2",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "VersionedV2")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "VersionedV2")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "VersionedV2")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "VersionedV2")
+		return nil
+	}
+	e2EcontrollerVersionedV2Versions := []int{2}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV2Versions, e2EcontrollerVersionedV2Handler)
+	registerVersionedRoute(engine, basePath, "HEAD", "/e2e/versioned", e2EcontrollerVersionedV2Versions, e2EcontrollerVersionedV2Handler)
+	e2EcontrollerRateLimitedErrorStatusCodes := []int{}
+	e2EcontrollerRateLimitedRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "RateLimited")
+	e2EcontrollerRateLimitedRoute := withBasePath(basePath, "/e2e/rate-limited")
+	e2EcontrollerRateLimitedHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"RateLimited",
+			"E2EController",
+			"GET",
+			e2EcontrollerRateLimitedRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "RateLimited")
+		if allowed, err := enforceRateLimit(fiberCtx, "RateLimited", e2EcontrollerRateLimitedRateLimit); !allowed {
+			return err
+		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "RateLimited")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "RateLimited")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "RateLimited")
+			}
+		}()
+		value, opError := controller.RateLimited()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "RateLimited")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'RateLimited'",
+				Status:     statusCode,
+				Instance:   "/controller/error/RateLimited",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "RateLimited")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "RateLimited")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "RateLimited")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "RateLimited")
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/rate-limited")), e2EcontrollerRateLimitedHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/rate-limited")), e2EcontrollerRateLimitedHandler)
+	e2EcontrollerTimedOutErrorStatusCodes := []int{}
+	e2EcontrollerTimedOutTimeout := newOperationTimeout("50ms")
+	e2EcontrollerTimedOutRoute := withBasePath(basePath, "/e2e/timeout")
+	e2EcontrollerTimedOutHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"TimedOut",
+			"E2EController",
+			"GET",
+			e2EcontrollerTimedOutRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "TimedOut")
+		cancelTimeout := applyOperationTimeout(fiberCtx, e2EcontrollerTimedOutTimeout)
+		defer cancelTimeout()
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "TimedOut")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "TimedOut")
+		if isDeadlineExceeded(fiberCtx) {
+			return handleDeadlineExceeded(fiberCtx, "TimedOut", http.StatusServiceUnavailable)
+		}
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "TimedOut")
+			}
+		}()
+		value, opError := controller.TimedOut(getRequestContext(fiberCtx))
+		telemetry.endPhase()
+		if isDeadlineExceeded(fiberCtx) {
+			return handleDeadlineExceeded(fiberCtx, "TimedOut", http.StatusGatewayTimeout)
+		}
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "TimedOut")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTimedOutErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TimedOut'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TimedOut",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTimedOutErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "TimedOut")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "TimedOut")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "TimedOut")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "TimedOut")
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/timeout")), e2EcontrollerTimedOutHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/timeout")), e2EcontrollerTimedOutHandler)
+	e2EcontrollerMaxBodySizeErrorStatusCodes := []int{}
+	e2EcontrollerMaxBodySizeRoute := withBasePath(basePath, "/e2e/max-body-size")
+	e2EcontrollerMaxBodySizeHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"MaxBodySize",
+			"E2EController",
+			"POST",
+			e2EcontrollerMaxBodySizeRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "MaxBodySize")
+		if allowed, err := enforceMaxBodySize(fiberCtx, "MaxBodySize", 64); !allowed {
+			return err
+		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "MaxBodySize")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var dataRawPtr *Param8data.ObjectWithByteSlice = nil
+		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, conversionErr)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "MaxBodySize",
+						"parameter": "data",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/MaxBodySize",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "MaxBodySize")
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "MaxBodySize")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "MaxBodySize")
+			}
+		}()
+		value, opError := controller.MaxBodySize(*dataRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "MaxBodySize")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MaxBodySize'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MaxBodySize",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "MaxBodySize")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "MaxBodySize")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "MaxBodySize")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "MaxBodySize")
+		return nil
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/max-body-size")), e2EcontrollerMaxBodySizeHandler)
+	e2EcontrollerIdempotentErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentRoute := withBasePath(basePath, "/e2e/idempotent")
+	e2EcontrollerIdempotentHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"Idempotent",
+			"E2EController",
+			"POST",
+			e2EcontrollerIdempotentRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "Idempotent")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "Idempotent")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed, hashErr := hashRequestBody(fiberCtx, "Idempotent", 0)
+		if !isBodyHashed {
+			return hashErr
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "Idempotent")
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		completeIdempotentRequest, idempotencyErr := beginIdempotentRequest(fiberCtx, "Idempotent", idempotentRequestHash, e2EcontrollerIdempotentIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return idempotencyErr
+		}
+		defer completeIdempotentRequest()
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "Idempotent")
+			}
+		}()
+		value, opError := controller.Idempotent()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "Idempotent")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Idempotent'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Idempotent",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "Idempotent")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "Idempotent")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "Idempotent")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "Idempotent")
+		return nil
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/idempotent")), e2EcontrollerIdempotentHandler)
+	e2EcontrollerIdempotentWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentWithBodyIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentWithBodyRoute := withBasePath(basePath, "/e2e/idempotent-with-body")
+	e2EcontrollerIdempotentWithBodyHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"IdempotentWithBody",
+			"E2EController",
+			"POST",
+			e2EcontrollerIdempotentWithBodyRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "IdempotentWithBody")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "IdempotentWithBody")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed, hashErr := hashRequestBody(fiberCtx, "IdempotentWithBody", 0)
+		if !isBodyHashed {
+			return hashErr
+		}
+		var conversionErr error
+		var dataRawPtr *Param5data.BodyInfo = nil
+		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, conversionErr)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "IdempotentWithBody",
+						"parameter": "data",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/IdempotentWithBody",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "IdempotentWithBody")
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "IdempotentWithBody")
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		completeIdempotentRequest, idempotencyErr := beginIdempotentRequest(fiberCtx, "IdempotentWithBody", idempotentRequestHash, e2EcontrollerIdempotentWithBodyIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return idempotencyErr
+		}
+		defer completeIdempotentRequest()
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "IdempotentWithBody")
+			}
+		}()
+		value, opError := controller.IdempotentWithBody(*dataRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "IdempotentWithBody")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'IdempotentWithBody'",
+				Status:     statusCode,
+				Instance:   "/controller/error/IdempotentWithBody",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "IdempotentWithBody")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "IdempotentWithBody")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "IdempotentWithBody")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "IdempotentWithBody")
+		return nil
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/idempotent-with-body")), e2EcontrollerIdempotentWithBodyHandler)
+	e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecuritySameMethodRoute := withBasePath(basePath, "/e2e/with-two-security-same-method")
+	e2EcontrollerWithTwoSecuritySameMethodHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"WithTwoSecuritySameMethod",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithTwoSecuritySameMethodRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "WithTwoSecuritySameMethod")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"write",
+								"read",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "WithTwoSecuritySameMethod")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := fiberCtx.Get("x-test-scopes")
+		isheaderParamExists := len(fiberCtx.Request().Header.Peek("x-test-scopes")) > 0
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, validatorErr)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			fiberCtx.Set("x-RunValidatorExtension", "WithTwoSecuritySameMethod")
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "WithTwoSecuritySameMethod")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "WithTwoSecuritySameMethod")
+			}
+		}()
+		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "WithTwoSecuritySameMethod")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithTwoSecuritySameMethod",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "WithTwoSecuritySameMethod")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "WithTwoSecuritySameMethod")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "WithTwoSecuritySameMethod")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "WithTwoSecuritySameMethod")
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/with-two-security-same-method")), e2EcontrollerWithTwoSecuritySameMethodHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/with-two-security-same-method")), e2EcontrollerWithTwoSecuritySameMethodHandler)
+	e2EcontrollerDefaultErrorErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorRoute := withBasePath(basePath, "/e2e/default-error")
+	e2EcontrollerDefaultErrorHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"DefaultError",
+			"E2EController",
+			"GET",
+			e2EcontrollerDefaultErrorRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "DefaultError")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "DefaultError")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "DefaultError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "DefaultError")
+			}
+		}()
+		opError := controller.DefaultError()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "DefaultError")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DefaultError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DefaultError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "DefaultError")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "DefaultError")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "DefaultError")
+		fiberCtx.Status(statusCode)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "DefaultError")
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/default-error")), e2EcontrollerDefaultErrorHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/default-error")), e2EcontrollerDefaultErrorHandler)
+	e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorWithPayloadRoute := withBasePath(basePath, "/e2e/default-error-with-payload")
+	e2EcontrollerDefaultErrorWithPayloadHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"DefaultErrorWithPayload",
+			"E2EController",
+			"GET",
+			e2EcontrollerDefaultErrorWithPayloadRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "DefaultErrorWithPayload")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "DefaultErrorWithPayload")
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "DefaultErrorWithPayload")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "DefaultErrorWithPayload")
+			}
+		}()
+		value, opError := controller.DefaultErrorWithPayload()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "DefaultErrorWithPayload")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DefaultErrorWithPayload",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "DefaultErrorWithPayload")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "DefaultErrorWithPayload")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "DefaultErrorWithPayload")
 		fiberCtx.Status(statusCode).JSON(value)
-		fiberCtx.Set("x-RouteEndRoutesExtension", "WithDefaultConfigSecurity")
+		fiberCtx.Set("x-RouteEndRoutesExtension", "DefaultErrorWithPayload")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/with-one-security"), func(fiberCtx *fiber.Ctx) error {
-		fiberCtx.Set("x-RouteStartRoutesExtension", "WithOneSecurity")
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/default-error-with-payload")), e2EcontrollerDefaultErrorWithPayloadHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/default-error-with-payload")), e2EcontrollerDefaultErrorWithPayloadHandler)
+	e2EcontrollerInjectedDependencyErrorStatusCodes := []int{}
+	e2EcontrollerInjectedDependencyRoute := withBasePath(basePath, "/e2e/injected-dependency")
+	e2EcontrollerInjectedDependencyHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"InjectedDependency",
+			"E2EController",
+			"GET",
+			e2EcontrollerInjectedDependencyRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "InjectedDependency")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -2584,9 +6785,9 @@ func RegisterRoutes(engine *fiber.App) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName",
+							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"other",
+								"config",
 							},
 						},
 					},
@@ -2594,32 +6795,12 @@ func RegisterRoutes(engine *fiber.App) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(fiberCtx, authErr, "WithOneSecurity")
+			return handleAuthorizationError(fiberCtx, authErr, "InjectedDependency")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := fiberCtx.Get("x-test-scopes")
-		isheaderParamExists := len(fiberCtx.Request().Header.Peek("x-test-scopes")) > 0
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, validatorErr)
-				setRequestContext(fiberCtx, middlewareCtx)
-				if !continueOperation {
-					return nil
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName)
-			fiberCtx.Set("x-RunValidatorExtension", "WithOneSecurity")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
-		}
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -2629,14 +6810,21 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		fiberCtx.Set("x-BeforeOperationRoutesExtension", "WithOneSecurity")
-		value, opError := controller.WithOneSecurity(*headerParamRawPtr)
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "InjectedDependency")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "InjectedDependency")
+			}
+		}()
+		value, opError := controller.InjectedDependency()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
-		fiberCtx.Set("x-ResponseHeadersExtension", "WithOneSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		fiberCtx.Set("x-ResponseHeadersExtension", "InjectedDependency")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2649,15 +6837,16 @@ func RegisterRoutes(engine *fiber.App) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithOneSecurity'",
+				Detail:     "Encountered an error during operation 'InjectedDependency'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithOneSecurity",
+				Instance:   "/controller/error/InjectedDependency",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			fiberCtx.Set("x-JsonErrorResponseExtension", "WithOneSecurity")
+			applyErrorMapping(&stdError, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "InjectedDependency")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
-		fiberCtx.Set("x-JsonResponseExtension", "WithOneSecurity")
+		fiberCtx.Set("x-JsonResponseExtension", "InjectedDependency")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -2667,35 +6856,38 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		fiberCtx.Set("x-AfterOperationRoutesExtension", "WithOneSecurity")
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "InjectedDependency")
 		fiberCtx.Status(statusCode).JSON(value)
-		fiberCtx.Set("x-RouteEndRoutesExtension", "WithOneSecurity")
+		fiberCtx.Set("x-RouteEndRoutesExtension", "InjectedDependency")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/with-two-security"), func(fiberCtx *fiber.Ctx) error {
-		fiberCtx.Set("x-RouteStartRoutesExtension", "WithTwoSecurity")
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/injected-dependency")), e2EcontrollerInjectedDependencyHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/injected-dependency")), e2EcontrollerInjectedDependencyHandler)
+	e2EcontrollerMappedErrorErrorStatusCodes := []int{404}
+	e2EcontrollerMappedErrorRoute := withBasePath(basePath, "/e2e/mapped-error")
+	e2EcontrollerMappedErrorHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"MappedError",
+			"E2EController",
+			"GET",
+			e2EcontrollerMappedErrorRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "MappedError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName",
-							Scopes: []string{
-								"other",
-							},
-						},
-					},
-				},
 				{
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
 							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"write",
-								"read",
+								"config",
 							},
 						},
 					},
@@ -2703,32 +6895,12 @@ func RegisterRoutes(engine *fiber.App) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(fiberCtx, authErr, "WithTwoSecurity")
+			return handleAuthorizationError(fiberCtx, authErr, "MappedError")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := fiberCtx.Get("x-test-scopes")
-		isheaderParamExists := len(fiberCtx.Request().Header.Peek("x-test-scopes")) > 0
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, validatorErr)
-				setRequestContext(fiberCtx, middlewareCtx)
-				if !continueOperation {
-					return nil
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName)
-			fiberCtx.Set("x-RunValidatorExtension", "WithTwoSecurity")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
-		}
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -2738,14 +6910,21 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		fiberCtx.Set("x-BeforeOperationRoutesExtension", "WithTwoSecurity")
-		value, opError := controller.WithTwoSecurity(*headerParamRawPtr)
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "MappedError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "MappedError")
+			}
+		}()
+		opError := controller.MappedError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
-		fiberCtx.Set("x-ResponseHeadersExtension", "WithTwoSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		fiberCtx.Set("x-ResponseHeadersExtension", "MappedError")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerMappedErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2758,15 +6937,16 @@ func RegisterRoutes(engine *fiber.App) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithTwoSecurity'",
+				Detail:     "Encountered an error during operation 'MappedError'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithTwoSecurity",
+				Instance:   "/controller/error/MappedError",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			fiberCtx.Set("x-JsonErrorResponseExtension", "WithTwoSecurity")
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedErrorErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "MappedError")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
-		fiberCtx.Set("x-JsonResponseExtension", "WithTwoSecurity")
+		fiberCtx.Set("x-JsonResponseExtension", "MappedError")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -2776,13 +6956,28 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		fiberCtx.Set("x-AfterOperationRoutesExtension", "WithTwoSecurity")
-		fiberCtx.Status(statusCode).JSON(value)
-		fiberCtx.Set("x-RouteEndRoutesExtension", "WithTwoSecurity")
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "MappedError")
+		fiberCtx.Status(statusCode)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "MappedError")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/with-two-security-same-method"), func(fiberCtx *fiber.Ctx) error {
-		fiberCtx.Set("x-RouteStartRoutesExtension", "WithTwoSecuritySameMethod")
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/mapped-error")), e2EcontrollerMappedErrorHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/mapped-error")), e2EcontrollerMappedErrorHandler)
+	e2EcontrollerUndeclaredMappedErrorErrorStatusCodes := []int{}
+	e2EcontrollerUndeclaredMappedErrorRoute := withBasePath(basePath, "/e2e/undeclared-mapped-error")
+	e2EcontrollerUndeclaredMappedErrorHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"UndeclaredMappedError",
+			"E2EController",
+			"GET",
+			e2EcontrollerUndeclaredMappedErrorRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "UndeclaredMappedError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -2790,21 +6985,9 @@ func RegisterRoutes(engine *fiber.App) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName",
-							Scopes: []string{
-								"other",
-							},
-						},
-					},
-				},
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName",
+							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"write",
-								"read",
+								"config",
 							},
 						},
 					},
@@ -2812,32 +6995,12 @@ func RegisterRoutes(engine *fiber.App) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(fiberCtx, authErr, "WithTwoSecuritySameMethod")
+			return handleAuthorizationError(fiberCtx, authErr, "UndeclaredMappedError")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := fiberCtx.Get("x-test-scopes")
-		isheaderParamExists := len(fiberCtx.Request().Header.Peek("x-test-scopes")) > 0
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, validatorErr)
-				setRequestContext(fiberCtx, middlewareCtx)
-				if !continueOperation {
-					return nil
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName)
-			fiberCtx.Set("x-RunValidatorExtension", "WithTwoSecuritySameMethod")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
-		}
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -2847,14 +7010,21 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		fiberCtx.Set("x-BeforeOperationRoutesExtension", "WithTwoSecuritySameMethod")
-		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "UndeclaredMappedError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "UndeclaredMappedError")
+			}
+		}()
+		opError := controller.UndeclaredMappedError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
-		fiberCtx.Set("x-ResponseHeadersExtension", "WithTwoSecuritySameMethod")
-		statusCode := getStatusCode(&controller, true, opError)
+		fiberCtx.Set("x-ResponseHeadersExtension", "UndeclaredMappedError")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2867,15 +7037,16 @@ func RegisterRoutes(engine *fiber.App) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
+				Detail:     "Encountered an error during operation 'UndeclaredMappedError'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithTwoSecuritySameMethod",
+				Instance:   "/controller/error/UndeclaredMappedError",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			fiberCtx.Set("x-JsonErrorResponseExtension", "WithTwoSecuritySameMethod")
+			applyErrorMapping(&stdError, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "UndeclaredMappedError")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
-		fiberCtx.Set("x-JsonResponseExtension", "WithTwoSecuritySameMethod")
+		fiberCtx.Set("x-JsonResponseExtension", "UndeclaredMappedError")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -2885,13 +7056,28 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		fiberCtx.Set("x-AfterOperationRoutesExtension", "WithTwoSecuritySameMethod")
-		fiberCtx.Status(statusCode).JSON(value)
-		fiberCtx.Set("x-RouteEndRoutesExtension", "WithTwoSecuritySameMethod")
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "UndeclaredMappedError")
+		fiberCtx.Status(statusCode)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "UndeclaredMappedError")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/default-error"), func(fiberCtx *fiber.Ctx) error {
-		fiberCtx.Set("x-RouteStartRoutesExtension", "DefaultError")
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/undeclared-mapped-error")), e2EcontrollerUndeclaredMappedErrorHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/undeclared-mapped-error")), e2EcontrollerUndeclaredMappedErrorHandler)
+	e2EcontrollerMappedTypedErrorErrorStatusCodes := []int{409}
+	e2EcontrollerMappedTypedErrorRoute := withBasePath(basePath, "/e2e/mapped-typed-error")
+	e2EcontrollerMappedTypedErrorHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"MappedTypedError",
+			"E2EController",
+			"GET",
+			e2EcontrollerMappedTypedErrorRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "MappedTypedError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -2909,10 +7095,12 @@ func RegisterRoutes(engine *fiber.App) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(fiberCtx, authErr, "DefaultError")
+			return handleAuthorizationError(fiberCtx, authErr, "MappedTypedError")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -2922,14 +7110,21 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		fiberCtx.Set("x-BeforeOperationRoutesExtension", "DefaultError")
-		opError := controller.DefaultError()
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "MappedTypedError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "MappedTypedError")
+			}
+		}()
+		value, opError := controller.MappedTypedError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
-		fiberCtx.Set("x-ResponseHeadersExtension", "DefaultError")
-		statusCode := getStatusCode(&controller, false, opError)
+		fiberCtx.Set("x-ResponseHeadersExtension", "MappedTypedError")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2942,15 +7137,16 @@ func RegisterRoutes(engine *fiber.App) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DefaultError'",
+				Detail:     "Encountered an error during operation 'MappedTypedError'",
 				Status:     statusCode,
-				Instance:   "/controller/error/DefaultError",
+				Instance:   "/controller/error/MappedTypedError",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			fiberCtx.Set("x-JsonErrorResponseExtension", "DefaultError")
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "MappedTypedError")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
-		fiberCtx.Set("x-JsonResponseExtension", "DefaultError")
+		fiberCtx.Set("x-JsonResponseExtension", "MappedTypedError")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -2960,13 +7156,28 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		fiberCtx.Set("x-AfterOperationRoutesExtension", "DefaultError")
-		fiberCtx.Status(statusCode)
-		fiberCtx.Set("x-RouteEndRoutesExtension", "DefaultError")
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "MappedTypedError")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "MappedTypedError")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/default-error-with-payload"), func(fiberCtx *fiber.Ctx) error {
-		fiberCtx.Set("x-RouteStartRoutesExtension", "DefaultErrorWithPayload")
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/mapped-typed-error")), e2EcontrollerMappedTypedErrorHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/mapped-typed-error")), e2EcontrollerMappedTypedErrorHandler)
+	e2EcontrollerPanicErrorStatusCodes := []int{}
+	e2EcontrollerPanicRoute := withBasePath(basePath, "/e2e/panic")
+	e2EcontrollerPanicHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"Panic",
+			"E2EController",
+			"GET",
+			e2EcontrollerPanicRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "Panic")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -2984,10 +7195,12 @@ func RegisterRoutes(engine *fiber.App) {
 			},
 		)
 		if authErr != nil {
-			return handleAuthorizationError(fiberCtx, authErr, "DefaultErrorWithPayload")
+			return handleAuthorizationError(fiberCtx, authErr, "Panic")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -2997,14 +7210,21 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		fiberCtx.Set("x-BeforeOperationRoutesExtension", "DefaultErrorWithPayload")
-		value, opError := controller.DefaultErrorWithPayload()
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "Panic")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "Panic")
+			}
+		}()
+		value, opError := controller.Panic()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
-		fiberCtx.Set("x-ResponseHeadersExtension", "DefaultErrorWithPayload")
-		statusCode := getStatusCode(&controller, true, opError)
+		fiberCtx.Set("x-ResponseHeadersExtension", "Panic")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPanicErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3017,15 +7237,16 @@ func RegisterRoutes(engine *fiber.App) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
+				Detail:     "Encountered an error during operation 'Panic'",
 				Status:     statusCode,
-				Instance:   "/controller/error/DefaultErrorWithPayload",
+				Instance:   "/controller/error/Panic",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			fiberCtx.Set("x-JsonErrorResponseExtension", "DefaultErrorWithPayload")
+			applyErrorMapping(&stdError, opError, e2EcontrollerPanicErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "Panic")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
-		fiberCtx.Set("x-JsonResponseExtension", "DefaultErrorWithPayload")
+		fiberCtx.Set("x-JsonResponseExtension", "Panic")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -3035,13 +7256,28 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		fiberCtx.Set("x-AfterOperationRoutesExtension", "DefaultErrorWithPayload")
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "Panic")
 		fiberCtx.Status(statusCode).JSON(value)
-		fiberCtx.Set("x-RouteEndRoutesExtension", "DefaultErrorWithPayload")
+		fiberCtx.Set("x-RouteEndRoutesExtension", "Panic")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/custom-error"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/panic")), e2EcontrollerPanicHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/panic")), e2EcontrollerPanicHandler)
+	e2EcontrollerCustomErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomErrorRoute := withBasePath(basePath, "/e2e/custom-error")
+	e2EcontrollerCustomErrorHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"CustomError",
+			"E2EController",
+			"GET",
+			e2EcontrollerCustomErrorRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "CustomError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -3061,8 +7297,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "CustomError")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -3073,14 +7311,21 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "CustomError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "CustomError")
+			}
+		}()
 		opError := controller.CustomError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "CustomError")
-		statusCode := getStatusCode(&controller, false, opError)
-		emptyErr := Response6CustomError.CustomError{}
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomErrorErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
 		if opError != emptyErr {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3107,9 +7352,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "CustomError")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/custom-error-ptr"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/custom-error")), e2EcontrollerCustomErrorHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/custom-error")), e2EcontrollerCustomErrorHandler)
+	e2EcontrollerCustomPtrErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomPtrErrorRoute := withBasePath(basePath, "/e2e/custom-error-ptr")
+	e2EcontrollerCustomPtrErrorHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"CustomPtrError",
+			"E2EController",
+			"GET",
+			e2EcontrollerCustomPtrErrorRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "CustomPtrError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -3129,8 +7389,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "CustomPtrError")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -3141,13 +7403,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "CustomPtrError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "CustomPtrError")
+			}
+		}()
 		opError := controller.CustomPtrError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "CustomPtrError")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomPtrErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3174,9 +7443,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "CustomPtrError")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/503-error-code"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/custom-error-ptr")), e2EcontrollerCustomPtrErrorHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/custom-error-ptr")), e2EcontrollerCustomPtrErrorHandler)
+	e2EcontrollerError503ErrorStatusCodes := []int{}
+	e2EcontrollerError503Route := withBasePath(basePath, "/e2e/503-error-code")
+	e2EcontrollerError503Handler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"Error503",
+			"E2EController",
+			"GET",
+			e2EcontrollerError503Route,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "Error503")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -3196,8 +7480,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "Error503")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -3208,13 +7494,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "Error503")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "Error503")
+			}
+		}()
 		opError := controller.Error503()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "Error503")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerError503ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3232,6 +7525,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/Error503",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerError503ErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "Error503")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3249,9 +7543,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "Error503")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/custom-error-503"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/503-error-code")), e2EcontrollerError503Handler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/503-error-code")), e2EcontrollerError503Handler)
+	e2EcontrollerCustomError503ErrorStatusCodes := []int{}
+	e2EcontrollerCustomError503Route := withBasePath(basePath, "/e2e/custom-error-503")
+	e2EcontrollerCustomError503Handler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"CustomError503",
+			"E2EController",
+			"GET",
+			e2EcontrollerCustomError503Route,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "CustomError503")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -3271,8 +7580,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "CustomError503")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -3283,14 +7594,21 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "CustomError503")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "CustomError503")
+			}
+		}()
 		opError := controller.CustomError503()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "CustomError503")
-		statusCode := getStatusCode(&controller, false, opError)
-		emptyErr := Response6CustomError.CustomError{}
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomError503ErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
 		if opError != emptyErr {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3317,9 +7635,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "CustomError503")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/context-access"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/custom-error-503")), e2EcontrollerCustomError503Handler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/custom-error-503")), e2EcontrollerCustomError503Handler)
+	e2EcontrollerContextAccessErrorStatusCodes := []int{}
+	e2EcontrollerContextAccessRoute := withBasePath(basePath, "/e2e/context-access")
+	e2EcontrollerContextAccessHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"ContextAccess",
+			"E2EController",
+			"GET",
+			e2EcontrollerContextAccessRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "ContextAccess")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -3339,8 +7672,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ContextAccess")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -3351,13 +7686,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "ContextAccess")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "ContextAccess")
+			}
+		}()
 		opError := controller.ContextAccess()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "ContextAccess")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextAccessErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3375,6 +7717,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ContextAccess",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextAccessErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "ContextAccess")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3392,9 +7735,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "ContextAccess")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/http-method"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/context-access")), e2EcontrollerContextAccessHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/context-access")), e2EcontrollerContextAccessHandler)
+	e2EcontrollerGetErrorStatusCodes := []int{}
+	e2EcontrollerGetRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerGetHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"Get",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "Get")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -3414,8 +7772,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "Get")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -3426,13 +7786,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "Get")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "Get")
+			}
+		}()
 		opError := controller.Get()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "Get")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerGetErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3450,6 +7817,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/Get",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "Get")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3467,9 +7835,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "Get")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/http-method"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerGetHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerGetHandler)
+	e2EcontrollerPostErrorStatusCodes := []int{}
+	e2EcontrollerPostRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerPostHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"Post",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "Post")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -3489,8 +7872,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "Post")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -3501,13 +7886,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "Post")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "Post")
+			}
+		}()
 		opError := controller.Post()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "Post")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPostErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3525,6 +7917,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/Post",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "Post")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3542,9 +7935,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "Post")
 		return nil
-	})
-	engine.Put(toFiberUrl("/e2e/http-method"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPostHandler)
+	e2EcontrollerPutErrorStatusCodes := []int{}
+	e2EcontrollerPutRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerPutHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"Put",
+			"E2EController",
+			"PUT",
+			e2EcontrollerPutRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "Put")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -3564,8 +7971,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "Put")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -3576,13 +7985,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "Put")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "Put")
+			}
+		}()
 		opError := controller.Put()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "Put")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPutErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3600,6 +8016,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/Put",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPutErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "Put")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3617,9 +8034,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "Put")
 		return nil
-	})
-	engine.Delete(toFiberUrl("/e2e/http-method"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("PUT", toFiberUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPutHandler)
+	e2EcontrollerDeleteErrorStatusCodes := []int{}
+	e2EcontrollerDeleteRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerDeleteHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"Delete",
+			"E2EController",
+			"DELETE",
+			e2EcontrollerDeleteRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "Delete")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -3639,8 +8070,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "Delete")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -3651,13 +8084,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "Delete")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "Delete")
+			}
+		}()
 		opError := controller.Delete()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "Delete")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDeleteErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3675,6 +8115,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/Delete",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeleteErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "Delete")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3692,9 +8133,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "Delete")
 		return nil
-	})
-	engine.Patch(toFiberUrl("/e2e/http-method"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("DELETE", toFiberUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerDeleteHandler)
+	e2EcontrollerPatchErrorStatusCodes := []int{}
+	e2EcontrollerPatchRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerPatchHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"Patch",
+			"E2EController",
+			"PATCH",
+			e2EcontrollerPatchRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "Patch")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -3714,8 +8169,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "Patch")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -3726,13 +8183,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "Patch")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "Patch")
+			}
+		}()
 		opError := controller.Patch()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "Patch")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPatchErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3750,6 +8214,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/Patch",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPatchErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "Patch")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3767,9 +8232,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "Patch")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/template-context-1"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("PATCH", toFiberUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPatchHandler)
+	e2EcontrollerTemplateContext1ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext1Route := withBasePath(basePath, "/e2e/template-context-1")
+	e2EcontrollerTemplateContext1Handler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"TemplateContext1",
+			"E2EController",
+			"GET",
+			e2EcontrollerTemplateContext1Route,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "TemplateContext1")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -3789,8 +8268,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TemplateContext1")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -3801,13 +8282,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "TemplateContext1")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "TemplateContext1")
+			}
+		}()
 		value, opError := controller.TemplateContext1()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "TemplateContext1")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3825,6 +8313,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TemplateContext1",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "TemplateContext1")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3843,9 +8332,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Set("x-RouteEndRoutesExtension", "TemplateContext1")
 		fiberCtx.Set("x-level", "high")
 		return nil
-	})
-	engine.Get(toFiberUrl("/e2e/template-context-2"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/template-context-1")), e2EcontrollerTemplateContext1Handler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/template-context-1")), e2EcontrollerTemplateContext1Handler)
+	e2EcontrollerTemplateContext2ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext2Route := withBasePath(basePath, "/e2e/template-context-2")
+	e2EcontrollerTemplateContext2Handler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"TemplateContext2",
+			"E2EController",
+			"GET",
+			e2EcontrollerTemplateContext2Route,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "TemplateContext2")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -3865,8 +8369,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TemplateContext2")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -3877,13 +8383,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "TemplateContext2")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "TemplateContext2")
+			}
+		}()
 		value, opError := controller.TemplateContext2()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "TemplateContext2")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3901,6 +8414,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TemplateContext2",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "TemplateContext2")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -3920,9 +8434,24 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Set("x-mode", "100")
 		fiberCtx.Set("x-level", "low")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/form"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/template-context-2")), e2EcontrollerTemplateContext2Handler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/template-context-2")), e2EcontrollerTemplateContext2Handler)
+	e2EcontrollerTestFormErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormRoute := withBasePath(basePath, "/e2e/form")
+	e2EcontrollerTestFormHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"TestForm",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestFormRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "TestForm")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -3942,8 +8471,9 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TestForm")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var item1RawPtr *string = nil
 		item1Raw := fiberCtx.FormValue("item1")
 		isitem1Exists := fiberCtx.Context().PostArgs().Has("item1")
@@ -3962,9 +8492,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item1"
-			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "TestForm")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var item2RawPtr *string = nil
 		item2Raw := fiberCtx.FormValue("item2")
@@ -3984,10 +8516,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item2"
-			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "TestForm")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -3998,13 +8533,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "TestForm")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "TestForm")
+			}
+		}()
 		value, opError := controller.TestForm(*item1RawPtr, *item2RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "TestForm")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4022,6 +8564,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TestForm",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "TestForm")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -4039,9 +8582,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "TestForm")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/form-extra"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/form")), e2EcontrollerTestFormHandler)
+	e2EcontrollerTestFormExtraErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormExtraRoute := withBasePath(basePath, "/e2e/form-extra")
+	e2EcontrollerTestFormExtraHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"TestFormExtra",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestFormExtraRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "TestFormExtra")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -4061,13 +8618,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TestFormExtra")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var item1RawPtr *int64 = nil
 		item1Raw := fiberCtx.FormValue("item1")
 		isitem1Exists := fiberCtx.Context().PostArgs().Has("item1")
 		if isitem1Exists {
-			item1Uint64, conversionErr := strconv.ParseInt(item1Raw, 10, 64)
+			item1, conversionErr := parseIntegerParam[int64](item1Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4078,22 +8636,19 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestFormExtra' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"item1",
-						"int64",
-						reflect.TypeOf(item1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestFormExtra",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item1",
+					"form",
+					"/item1",
+					"int64",
+					reflect.TypeOf(item1Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "TestFormExtra")
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			item1 := int64(item1Uint64)
 			item1RawPtr = &item1
 		}
 		if validatorErr := validatorInstance.Var(item1RawPtr, "required,gte=80"); validatorErr != nil {
@@ -4107,9 +8662,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item1"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "TestFormExtra")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var item2RawPtr *string = nil
 		item2Raw := fiberCtx.FormValue("item2")
@@ -4129,15 +8686,17 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item2"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "TestFormExtra")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var item3RawPtr *int64 = nil
 		item3Raw := fiberCtx.Query("item3")
 		isitem3Exists := fiberCtx.Context().QueryArgs().Has("item3")
 		if isitem3Exists {
-			item3Uint64, conversionErr := strconv.ParseInt(item3Raw, 10, 64)
+			item3, conversionErr := parseIntegerParam[int64](item3Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4148,22 +8707,19 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestFormExtra' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"item3",
-						"int64",
-						reflect.TypeOf(item3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestFormExtra",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item3",
+					"query",
+					"/item3",
+					"int64",
+					reflect.TypeOf(item3Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "TestFormExtra")
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			item3 := int64(item3Uint64)
 			item3RawPtr = &item3
 		}
 		if validatorErr := validatorInstance.Var(item3RawPtr, "required,gte=80"); validatorErr != nil {
@@ -4177,10 +8733,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item3"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/item3", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "TestFormExtra")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -4191,13 +8750,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "TestFormExtra")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "TestFormExtra")
+			}
+		}()
 		value, opError := controller.TestFormExtra(*item1RawPtr, *item2RawPtr, *item3RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "TestFormExtra")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4215,6 +8781,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TestFormExtra",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "TestFormExtra")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -4232,9 +8799,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "TestFormExtra")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/test-response-validation"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/form-extra")), e2EcontrollerTestFormExtraHandler)
+	e2EcontrollerTestResponseValidationErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationRoute := withBasePath(basePath, "/e2e/test-response-validation")
+	e2EcontrollerTestResponseValidationHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"TestResponseValidation",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestResponseValidationRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "TestResponseValidation")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -4254,8 +8835,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TestResponseValidation")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -4266,13 +8849,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "TestResponseValidation")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "TestResponseValidation")
+			}
+		}()
 		value, opError := controller.TestResponseValidation()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "TestResponseValidation")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4290,6 +8880,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TestResponseValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "TestResponseValidation")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -4329,9 +8920,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "TestResponseValidation")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/test-response-validation-ptr"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/test-response-validation")), e2EcontrollerTestResponseValidationHandler)
+	e2EcontrollerTestResponseValidationPtrErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationPtrRoute := withBasePath(basePath, "/e2e/test-response-validation-ptr")
+	e2EcontrollerTestResponseValidationPtrHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"TestResponseValidationPtr",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestResponseValidationPtrRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "TestResponseValidationPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -4351,8 +8956,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TestResponseValidationPtr")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -4363,13 +8970,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "TestResponseValidationPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "TestResponseValidationPtr")
+			}
+		}()
 		value, opError := controller.TestResponseValidationPtr()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "TestResponseValidationPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4387,6 +9001,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TestResponseValidationPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "TestResponseValidationPtr")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -4430,9 +9045,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "TestResponseValidationPtr")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/test-response-validation-null"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/test-response-validation-ptr")), e2EcontrollerTestResponseValidationPtrHandler)
+	e2EcontrollerTestResponseValidationNullErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationNullRoute := withBasePath(basePath, "/e2e/test-response-validation-null")
+	e2EcontrollerTestResponseValidationNullHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"TestResponseValidationNull",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestResponseValidationNullRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "TestResponseValidationNull")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -4452,8 +9081,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TestResponseValidationNull")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -4464,13 +9095,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "TestResponseValidationNull")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "TestResponseValidationNull")
+			}
+		}()
 		value, opError := controller.TestResponseValidationNull()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "TestResponseValidationNull")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4488,6 +9126,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TestResponseValidationNull",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "TestResponseValidationNull")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -4531,9 +9170,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "TestResponseValidationNull")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/test-primitive-conversions"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/test-response-validation-null")), e2EcontrollerTestResponseValidationNullHandler)
+	e2EcontrollerTestPrimitiveConversionsErrorStatusCodes := []int{500}
+	e2EcontrollerTestPrimitiveConversionsRoute := withBasePath(basePath, "/e2e/test-primitive-conversions")
+	e2EcontrollerTestPrimitiveConversionsHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"TestPrimitiveConversions",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestPrimitiveConversionsRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "TestPrimitiveConversions")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -4553,13 +9206,14 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TestPrimitiveConversions")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var value1RawPtr *int64 = nil
 		value1Raw := fiberCtx.Query("value1")
 		isvalue1Exists := fiberCtx.Context().QueryArgs().Has("value1")
 		if isvalue1Exists {
-			value1Uint64, conversionErr := strconv.ParseInt(value1Raw, 10, 64)
+			value1, conversionErr := parseIntegerParam[int64](value1Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4570,22 +9224,19 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"int64",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value1",
+					"query",
+					"/value1",
+					"int64",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value1 := int64(value1Uint64)
 			value1RawPtr = &value1
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -4599,15 +9250,17 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "TestPrimitiveConversions")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value2RawPtr *bool = nil
 		value2Raw := fiberCtx.Query("value2")
 		isvalue2Exists := fiberCtx.Context().QueryArgs().Has("value2")
 		if isvalue2Exists {
-			value2Bool, conversionErr := strconv.ParseBool(value2Raw)
+			value2, conversionErr := strconv.ParseBool(value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4618,22 +9271,19 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"bool",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value2",
+					"query",
+					"/value2",
+					"bool",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value2 := value2Bool
 			value2RawPtr = &value2
 		}
 		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
@@ -4647,15 +9297,17 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "TestPrimitiveConversions")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value3RawPtr *int = nil
 		value3Raw := fiberCtx.Query("value3")
 		isvalue3Exists := fiberCtx.Context().QueryArgs().Has("value3")
 		if isvalue3Exists {
-			value3Uint64, conversionErr := strconv.Atoi(value3Raw)
+			value3, conversionErr := parseIntegerParam[int](value3Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4666,22 +9318,19 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value3",
-						"int",
-						reflect.TypeOf(value3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value3",
+					"query",
+					"/value3",
+					"int",
+					reflect.TypeOf(value3Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value3 := int(value3Uint64)
 			value3RawPtr = &value3
 		}
 		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
@@ -4695,15 +9344,17 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value3"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value3", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "TestPrimitiveConversions")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value4RawPtr *float64 = nil
 		value4Raw := fiberCtx.Query("value4")
 		isvalue4Exists := fiberCtx.Context().QueryArgs().Has("value4")
 		if isvalue4Exists {
-			value4Float64, conversionErr := strconv.ParseFloat(value4Raw, 64)
+			value4, conversionErr := parseFloatParam[float64](value4Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4714,22 +9365,19 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value4",
-						"float64",
-						reflect.TypeOf(value4Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value4",
+					"query",
+					"/value4",
+					"float64",
+					reflect.TypeOf(value4Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value4 := float64(value4Float64)
 			value4RawPtr = &value4
 		}
 		if validatorErr := validatorInstance.Var(value4RawPtr, "required"); validatorErr != nil {
@@ -4743,10 +9391,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value4"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value4", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "TestPrimitiveConversions")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -4757,13 +9408,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "TestPrimitiveConversions")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "TestPrimitiveConversions")
+			}
+		}()
 		value, opError := controller.TestPrimitiveConversions(*value1RawPtr, *value2RawPtr, *value3RawPtr, *value4RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "TestPrimitiveConversions")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4781,6 +9439,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TestPrimitiveConversions",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "TestPrimitiveConversions")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -4798,9 +9457,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "TestPrimitiveConversions")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/test-enums"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/test-primitive-conversions")), e2EcontrollerTestPrimitiveConversionsHandler)
+	e2EcontrollerTestEnumsErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsRoute := withBasePath(basePath, "/e2e/test-enums")
+	e2EcontrollerTestEnumsHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"TestEnums",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestEnumsRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "TestEnums")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -4820,17 +9493,18 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TestEnums")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw := fiberCtx.Query("value1")
 		isvalue1Exists := fiberCtx.Context().QueryArgs().Has("value1")
 		if isvalue1Exists {
 			value1 := value1Raw
 			switch value1Raw {
 			case "active", "inactive":
-				value1Var := Param12value1.StatusEnumeration(value1)
+				value1Var := Param15value1.StatusEnumeration(value1)
 				value1RawPtr = &value1Var
 			default:
 				conversionErr := fmt.Errorf("value1 must be one of \"active, inactive\" options only but got \"%s\"", value1Raw)
@@ -4843,20 +9517,18 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnums' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"StatusEnumeration",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnums",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value1",
+					"query",
+					"/value1",
+					"StatusEnumeration",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "TestEnums")
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -4870,15 +9542,17 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "TestEnums")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value2RawPtr *Param4value2.NumberEnumeration = nil
 		value2Raw := fiberCtx.Query("value2")
 		isvalue2Exists := fiberCtx.Context().QueryArgs().Has("value2")
 		if isvalue2Exists {
-			value2Uint64, conversionErr := strconv.Atoi(value2Raw)
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4889,22 +9563,19 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnums' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnums",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value2",
+					"query",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "TestEnums")
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value2 := int(value2Uint64)
 			switch value2Raw {
 			case "1", "2":
 				value2Var := Param4value2.NumberEnumeration(value2)
@@ -4920,20 +9591,18 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnums' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnums",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value2",
+					"query",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "TestEnums")
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
 		}
 		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
@@ -4947,11 +9616,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "TestEnums")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var value3RawPtr *Param11value3.ObjectWithEnum = nil
+		var value3RawPtr *Param14value3.ObjectWithEnum = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &value3RawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -4963,20 +9634,27 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'TestEnums' but body parameter '%s' did not pass validation of '%s' - %s",
-					"value3",
-					"ObjectWithEnum",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "TestEnums",
+						"parameter": "value3",
+						"type":      "ObjectWithEnum",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/TestEnums",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "TestEnums")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -4987,13 +9665,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "TestEnums")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "TestEnums")
+			}
+		}()
 		value, opError := controller.TestEnums(*value1RawPtr, *value2RawPtr, *value3RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "TestEnums")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5011,6 +9696,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TestEnums",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "TestEnums")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -5050,9 +9736,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "TestEnums")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/test-enums-in-all/{value1}"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/test-enums")), e2EcontrollerTestEnumsHandler)
+	e2EcontrollerTestEnumsInAllErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsInAllRoute := withBasePath(basePath, "/e2e/test-enums-in-all/{value1}")
+	e2EcontrollerTestEnumsInAllHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"TestEnumsInAll",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestEnumsInAllRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "TestEnumsInAll")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -5072,16 +9772,17 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TestEnumsInAll")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		telemetry.startPhase("validation")
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw := fiberCtx.Params("value1")
 		isvalue1Exists := true // if parameter is in route but not provided, it won't reach this handler
 		if isvalue1Exists {
 			value1 := value1Raw
 			switch value1Raw {
 			case "active", "inactive":
-				value1Var := Param12value1.StatusEnumeration(value1)
+				value1Var := Param15value1.StatusEnumeration(value1)
 				value1RawPtr = &value1Var
 			default:
 				conversionErr := fmt.Errorf("value1 must be one of \"active, inactive\" options only but got \"%s\"", value1Raw)
@@ -5094,20 +9795,18 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"StatusEnumeration",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value1",
+					"path",
+					"/value1",
+					"StatusEnumeration",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -5121,15 +9820,17 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/value1", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "TestEnumsInAll")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var value2RawPtr *Param4value2.NumberEnumeration = nil
 		value2Raw := fiberCtx.Get("value2")
 		isvalue2Exists := len(fiberCtx.Request().Header.Peek("value2")) > 0
 		if isvalue2Exists {
-			value2Uint64, conversionErr := strconv.Atoi(value2Raw)
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -5140,22 +9841,19 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value2",
+					"header",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			value2 := int(value2Uint64)
 			switch value2Raw {
 			case "1", "2":
 				value2Var := Param4value2.NumberEnumeration(value2)
@@ -5171,20 +9869,18 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value2",
+					"header",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
 		}
 		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
@@ -5198,18 +9894,20 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/value2", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "TestEnumsInAll")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var value3RawPtr *Param12value3.StatusEnumeration = nil
+		var value3RawPtr *Param15value3.StatusEnumeration = nil
 		value3Raw := fiberCtx.FormValue("value3")
 		isvalue3Exists := fiberCtx.Context().PostArgs().Has("value3")
 		if isvalue3Exists {
 			value3 := value3Raw
 			switch value3Raw {
 			case "active", "inactive":
-				value3Var := Param12value3.StatusEnumeration(value3)
+				value3Var := Param15value3.StatusEnumeration(value3)
 				value3RawPtr = &value3Var
 			default:
 				conversionErr := fmt.Errorf("value3 must be one of \"active, inactive\" options only but got \"%s\"", value3Raw)
@@ -5222,20 +9920,18 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value3",
-						"StatusEnumeration",
-						reflect.TypeOf(value3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value3",
+					"form",
+					"/value3",
+					"StatusEnumeration",
+					reflect.TypeOf(value3Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
 		}
 		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
@@ -5249,10 +9945,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value3"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/value3", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "TestEnumsInAll")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -5263,13 +9962,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "TestEnumsInAll")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "TestEnumsInAll")
+			}
+		}()
 		value, opError := controller.TestEnumsInAll(*value1RawPtr, *value2RawPtr, *value3RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "TestEnumsInAll")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5287,6 +9993,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TestEnumsInAll",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "TestEnumsInAll")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -5304,9 +10011,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "TestEnumsInAll")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/test-enums-optional"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/test-enums-in-all/{value1}")), e2EcontrollerTestEnumsInAllHandler)
+	e2EcontrollerTestEnumsOptionalErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsOptionalRoute := withBasePath(basePath, "/e2e/test-enums-optional")
+	e2EcontrollerTestEnumsOptionalHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"TestEnumsOptional",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestEnumsOptionalRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "TestEnumsOptional")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -5326,16 +10047,17 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "TestEnumsOptional")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		telemetry.startPhase("validation")
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw := fiberCtx.Get("value1")
 		isvalue1Exists := len(fiberCtx.Request().Header.Peek("value1")) > 0
 		if isvalue1Exists {
 			value1 := value1Raw
 			switch value1Raw {
 			case "active", "inactive":
-				value1Var := Param12value1.StatusEnumeration(value1)
+				value1Var := Param15value1.StatusEnumeration(value1)
 				value1RawPtr = &value1Var
 			default:
 				conversionErr := fmt.Errorf("value1 must be one of \"active, inactive\" options only but got \"%s\"", value1Raw)
@@ -5348,22 +10070,21 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsOptional' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"StatusEnumeration",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsOptional",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsOptional",
+					"value1",
+					"header",
+					"/value1",
+					"StatusEnumeration",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "TestEnumsOptional")
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -5374,13 +10095,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "TestEnumsOptional")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "TestEnumsOptional")
+			}
+		}()
 		value, opError := controller.TestEnumsOptional(value1RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "TestEnumsOptional")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5398,6 +10126,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/TestEnumsOptional",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "TestEnumsOptional")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -5415,9 +10144,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "TestEnumsOptional")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/external-packages"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/test-enums-optional")), e2EcontrollerTestEnumsOptionalHandler)
+	e2EcontrollerExternalPackagesErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesRoute := withBasePath(basePath, "/e2e/external-packages")
+	e2EcontrollerExternalPackagesHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"ExternalPackages",
+			"E2EController",
+			"POST",
+			e2EcontrollerExternalPackagesRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "ExternalPackages")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -5437,17 +10180,18 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ExternalPackages")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var unitRawPtr *Param14unit.LengthUnits = nil
+		var unitRawPtr *Param17unit.LengthUnits = nil
 		unitRaw := fiberCtx.Query("unit")
 		isunitExists := fiberCtx.Context().QueryArgs().Has("unit")
 		if isunitExists {
 			unit := unitRaw
 			switch unitRaw {
 			case "Angstrom", "AstronomicalUnit", "Centimeter", "Chain", "DataMile", "Decameter", "Decimeter", "DtpPica", "DtpPoint", "Fathom", "Femtometer", "Foot", "Gigameter", "Hand", "Hectometer", "Inch", "Kilofoot", "KilolightYear", "Kilometer", "Kiloparsec", "Kiloyard", "LightYear", "MegalightYear", "Megameter", "Megaparsec", "Meter", "Microinch", "Micrometer", "Mil", "Mile", "Millimeter", "Nanometer", "NauticalMile", "Parsec", "Picometer", "PrinterPica", "PrinterPoint", "Shackle", "SolarRadius", "Twip", "UsSurveyFoot", "Yard":
-				unitVar := Param14unit.LengthUnits(unit)
+				unitVar := Param17unit.LengthUnits(unit)
 				unitRawPtr = &unitVar
 			default:
 				conversionErr := fmt.Errorf("unit must be one of \"Angstrom, AstronomicalUnit, Centimeter, Chain, DataMile, Decameter, Decimeter, DtpPica, DtpPoint, Fathom, Femtometer, Foot, Gigameter, Hand, Hectometer, Inch, Kilofoot, KilolightYear, Kilometer, Kiloparsec, Kiloyard, LightYear, MegalightYear, Megameter, Megaparsec, Meter, Microinch, Micrometer, Mil, Mile, Millimeter, Nanometer, NauticalMile, Parsec, Picometer, PrinterPica, PrinterPoint, Shackle, SolarRadius, Twip, UsSurveyFoot, Yard\" options only but got \"%s\"", unitRaw)
@@ -5460,23 +10204,21 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'ExternalPackages' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"unit",
-						"LengthUnits",
-						reflect.TypeOf(unitRaw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/ExternalPackages",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"ExternalPackages",
+					"unit",
+					"query",
+					"/unit",
+					"LengthUnits",
+					reflect.TypeOf(unitRaw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "ExternalPackages")
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
 		}
-		var dataRawPtr *Param13data.LengthDto = nil
+		var dataRawPtr *Param16data.LengthDto = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5488,20 +10230,27 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackages' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"LengthDto",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackages",
+						"parameter": "data",
+						"type":      "LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackages",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "ExternalPackages")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -5512,13 +10261,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "ExternalPackages")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "ExternalPackages")
+			}
+		}()
 		value, opError := controller.ExternalPackages(unitRawPtr, *dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "ExternalPackages")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5536,6 +10292,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ExternalPackages",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "ExternalPackages")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -5575,9 +10332,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "ExternalPackages")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/external-packages-unique-in-struct"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/external-packages")), e2EcontrollerExternalPackagesHandler)
+	e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesUniqueInStructRoute := withBasePath(basePath, "/e2e/external-packages-unique-in-struct")
+	e2EcontrollerExternalPackagesUniqueInStructHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"ExternalPackagesUniqueInStruct",
+			"E2EController",
+			"POST",
+			e2EcontrollerExternalPackagesUniqueInStructRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "ExternalPackagesUniqueInStruct")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -5597,10 +10368,11 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ExternalPackagesUniqueInStruct")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *Param15data.UniqueExternalUsage = nil
+		var dataRawPtr *Param18data.UniqueExternalUsage = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5612,20 +10384,27 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackagesUniqueInStruct' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"UniqueExternalUsage",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesUniqueInStruct",
+						"parameter": "data",
+						"type":      "UniqueExternalUsage",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackagesUniqueInStruct",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "ExternalPackagesUniqueInStruct")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -5636,13 +10415,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "ExternalPackagesUniqueInStruct")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "ExternalPackagesUniqueInStruct")
+			}
+		}()
 		value, opError := controller.ExternalPackagesUniqueInStruct(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "ExternalPackagesUniqueInStruct")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5660,6 +10446,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ExternalPackagesUniqueInStruct",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "ExternalPackagesUniqueInStruct")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -5677,9 +10464,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "ExternalPackagesUniqueInStruct")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/external-packages-validation"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/external-packages-unique-in-struct")), e2EcontrollerExternalPackagesUniqueInStructHandler)
+	e2EcontrollerExternalPackagesValidationErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesValidationRoute := withBasePath(basePath, "/e2e/external-packages-validation")
+	e2EcontrollerExternalPackagesValidationHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"ExternalPackagesValidation",
+			"E2EController",
+			"POST",
+			e2EcontrollerExternalPackagesValidationRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "ExternalPackagesValidation")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -5699,17 +10500,18 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ExternalPackagesValidation")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var unitRawPtr *Param14unit.LengthUnits = nil
+		var unitRawPtr *Param17unit.LengthUnits = nil
 		unitRaw := fiberCtx.Query("unit")
 		isunitExists := fiberCtx.Context().QueryArgs().Has("unit")
 		if isunitExists {
 			unit := unitRaw
 			switch unitRaw {
 			case "Angstrom", "AstronomicalUnit", "Centimeter", "Chain", "DataMile", "Decameter", "Decimeter", "DtpPica", "DtpPoint", "Fathom", "Femtometer", "Foot", "Gigameter", "Hand", "Hectometer", "Inch", "Kilofoot", "KilolightYear", "Kilometer", "Kiloparsec", "Kiloyard", "LightYear", "MegalightYear", "Megameter", "Megaparsec", "Meter", "Microinch", "Micrometer", "Mil", "Mile", "Millimeter", "Nanometer", "NauticalMile", "Parsec", "Picometer", "PrinterPica", "PrinterPoint", "Shackle", "SolarRadius", "Twip", "UsSurveyFoot", "Yard":
-				unitVar := Param14unit.LengthUnits(unit)
+				unitVar := Param17unit.LengthUnits(unit)
 				unitRawPtr = &unitVar
 			default:
 				conversionErr := fmt.Errorf("unit must be one of \"Angstrom, AstronomicalUnit, Centimeter, Chain, DataMile, Decameter, Decimeter, DtpPica, DtpPoint, Fathom, Femtometer, Foot, Gigameter, Hand, Hectometer, Inch, Kilofoot, KilolightYear, Kilometer, Kiloparsec, Kiloyard, LightYear, MegalightYear, Megameter, Megaparsec, Meter, Microinch, Micrometer, Mil, Mile, Millimeter, Nanometer, NauticalMile, Parsec, Picometer, PrinterPica, PrinterPoint, Shackle, SolarRadius, Twip, UsSurveyFoot, Yard\" options only but got \"%s\"", unitRaw)
@@ -5722,23 +10524,21 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'ExternalPackagesValidation' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"unit",
-						"LengthUnits",
-						reflect.TypeOf(unitRaw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/ExternalPackagesValidation",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"ExternalPackagesValidation",
+					"unit",
+					"query",
+					"/unit",
+					"LengthUnits",
+					reflect.TypeOf(unitRaw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "ExternalPackagesValidation")
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
 		}
-		var dataRawPtr *Param16data.LengthDtoWithValidation = nil
+		var dataRawPtr *Param19data.LengthDtoWithValidation = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5750,20 +10550,27 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackagesValidation' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"LengthDtoWithValidation",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesValidation",
+						"parameter": "data",
+						"type":      "LengthDtoWithValidation",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackagesValidation",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "ExternalPackagesValidation")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -5774,13 +10581,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "ExternalPackagesValidation")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "ExternalPackagesValidation")
+			}
+		}()
 		value, opError := controller.ExternalPackagesValidation(unitRawPtr, *dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "ExternalPackagesValidation")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5798,6 +10612,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ExternalPackagesValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "ExternalPackagesValidation")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -5837,9 +10652,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "ExternalPackagesValidation")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/arrays-in-body-and-res"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/external-packages-validation")), e2EcontrollerExternalPackagesValidationHandler)
+	e2EcontrollerArraysInBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInBodyAndResRoute := withBasePath(basePath, "/e2e/arrays-in-body-and-res")
+	e2EcontrollerArraysInBodyAndResHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"ArraysInBodyAndRes",
+			"E2EController",
+			"POST",
+			e2EcontrollerArraysInBodyAndResRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "ArraysInBodyAndRes")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -5859,10 +10688,11 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ArraysInBodyAndRes")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *[]Param13data.LengthDto = nil
+		var dataRawPtr *[]Param16data.LengthDto = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5874,20 +10704,27 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ArraysInBodyAndRes' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[]LengthDto",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInBodyAndRes",
+						"parameter": "data",
+						"type":      "[]LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ArraysInBodyAndRes",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "ArraysInBodyAndRes")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -5898,13 +10735,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "ArraysInBodyAndRes")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "ArraysInBodyAndRes")
+			}
+		}()
 		value, opError := controller.ArraysInBodyAndRes(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "ArraysInBodyAndRes")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5922,6 +10766,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ArraysInBodyAndRes",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "ArraysInBodyAndRes")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -5961,9 +10806,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "ArraysInBodyAndRes")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/arrays-inside-body-and-res"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/arrays-in-body-and-res")), e2EcontrollerArraysInBodyAndResHandler)
+	e2EcontrollerArraysInsideBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInsideBodyAndResRoute := withBasePath(basePath, "/e2e/arrays-inside-body-and-res")
+	e2EcontrollerArraysInsideBodyAndResHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"ArraysInsideBodyAndRes",
+			"E2EController",
+			"POST",
+			e2EcontrollerArraysInsideBodyAndResRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "ArraysInsideBodyAndRes")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -5983,10 +10842,11 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ArraysInsideBodyAndRes")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *[]Param17data.BlaBla = nil
+		var dataRawPtr *[]Param20data.BlaBla = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5998,20 +10858,27 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ArraysInsideBodyAndRes' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[]BlaBla",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInsideBodyAndRes",
+						"parameter": "data",
+						"type":      "[]BlaBla",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ArraysInsideBodyAndRes",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "ArraysInsideBodyAndRes")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -6022,13 +10889,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "ArraysInsideBodyAndRes")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "ArraysInsideBodyAndRes")
+			}
+		}()
 		value, opError := controller.ArraysInsideBodyAndRes(dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "ArraysInsideBodyAndRes")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6046,6 +10920,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ArraysInsideBodyAndRes",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "ArraysInsideBodyAndRes")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -6089,9 +10964,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "ArraysInsideBodyAndRes")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/deep-arrays-with-validation"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/arrays-inside-body-and-res")), e2EcontrollerArraysInsideBodyAndResHandler)
+	e2EcontrollerDeepArraysWithValidationErrorStatusCodes := []int{500}
+	e2EcontrollerDeepArraysWithValidationRoute := withBasePath(basePath, "/e2e/deep-arrays-with-validation")
+	e2EcontrollerDeepArraysWithValidationHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"DeepArraysWithValidation",
+			"E2EController",
+			"POST",
+			e2EcontrollerDeepArraysWithValidationRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "DeepArraysWithValidation")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -6111,10 +11000,11 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "DeepArraysWithValidation")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *[][]Param18data.BlaBla2 = nil
+		var dataRawPtr *[][]Param21data.BlaBla2 = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6126,20 +11016,27 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'DeepArraysWithValidation' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[][]BlaBla2",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "DeepArraysWithValidation",
+						"parameter": "data",
+						"type":      "[][]BlaBla2",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/DeepArraysWithValidation",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "DeepArraysWithValidation")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -6150,13 +11047,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "DeepArraysWithValidation")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "DeepArraysWithValidation")
+			}
+		}()
 		value, opError := controller.DeepArraysWithValidation(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "DeepArraysWithValidation")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6174,6 +11078,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/DeepArraysWithValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "DeepArraysWithValidation")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -6213,9 +11118,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "DeepArraysWithValidation")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/embedded-structs"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/deep-arrays-with-validation")), e2EcontrollerDeepArraysWithValidationHandler)
+	e2EcontrollerEmbeddedStructsErrorStatusCodes := []int{500}
+	e2EcontrollerEmbeddedStructsRoute := withBasePath(basePath, "/e2e/embedded-structs")
+	e2EcontrollerEmbeddedStructsHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"EmbeddedStructs",
+			"E2EController",
+			"POST",
+			e2EcontrollerEmbeddedStructsRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "EmbeddedStructs")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -6235,10 +11154,11 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "EmbeddedStructs")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *Param19data.TheModel = nil
+		var dataRawPtr *Param22data.TheModel = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6250,20 +11170,27 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'EmbeddedStructs' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModel",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "EmbeddedStructs",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/EmbeddedStructs",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "EmbeddedStructs")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -6274,13 +11201,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "EmbeddedStructs")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "EmbeddedStructs")
+			}
+		}()
 		value, opError := controller.EmbeddedStructs(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "EmbeddedStructs")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6298,6 +11232,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/EmbeddedStructs",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "EmbeddedStructs")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -6337,9 +11272,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "EmbeddedStructs")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/structs-with-inner-pointer"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/embedded-structs")), e2EcontrollerEmbeddedStructsHandler)
+	e2EcontrollerStructsWithInnerPointerErrorStatusCodes := []int{500}
+	e2EcontrollerStructsWithInnerPointerRoute := withBasePath(basePath, "/e2e/structs-with-inner-pointer")
+	e2EcontrollerStructsWithInnerPointerHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"StructsWithInnerPointer",
+			"E2EController",
+			"POST",
+			e2EcontrollerStructsWithInnerPointerRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "StructsWithInnerPointer")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -6359,10 +11308,11 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "StructsWithInnerPointer")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *Param20data.TheModelWithInnerPointer = nil
+		var dataRawPtr *Param23data.TheModelWithInnerPointer = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6374,20 +11324,27 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'StructsWithInnerPointer' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModelWithInnerPointer",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "StructsWithInnerPointer",
+						"parameter": "data",
+						"type":      "TheModelWithInnerPointer",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/StructsWithInnerPointer",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "StructsWithInnerPointer")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -6398,13 +11355,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "StructsWithInnerPointer")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "StructsWithInnerPointer")
+			}
+		}()
 		value, opError := controller.StructsWithInnerPointer(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "StructsWithInnerPointer")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6422,6 +11386,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/StructsWithInnerPointer",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "StructsWithInnerPointer")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -6439,9 +11404,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "StructsWithInnerPointer")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/context-injection-empty"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/structs-with-inner-pointer")), e2EcontrollerStructsWithInnerPointerHandler)
+	e2EcontrollerContextInjectionEmptyErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionEmptyRoute := withBasePath(basePath, "/e2e/context-injection-empty")
+	e2EcontrollerContextInjectionEmptyHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"ContextInjectionEmpty",
+			"E2EController",
+			"POST",
+			e2EcontrollerContextInjectionEmptyRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "ContextInjectionEmpty")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -6461,8 +11440,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ContextInjectionEmpty")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -6473,13 +11454,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "ContextInjectionEmpty")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "ContextInjectionEmpty")
+			}
+		}()
 		opError := controller.ContextInjectionEmpty(getRequestContext(fiberCtx))
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "ContextInjectionEmpty")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6497,6 +11485,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ContextInjectionEmpty",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "ContextInjectionEmpty")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -6514,9 +11503,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "ContextInjectionEmpty")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/context-injection"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/context-injection-empty")), e2EcontrollerContextInjectionEmptyHandler)
+	e2EcontrollerContextInjectionErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionRoute := withBasePath(basePath, "/e2e/context-injection")
+	e2EcontrollerContextInjectionHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"ContextInjection",
+			"E2EController",
+			"POST",
+			e2EcontrollerContextInjectionRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "ContextInjection")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -6536,10 +11539,11 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ContextInjection")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *Param19data.TheModel = nil
+		var dataRawPtr *Param22data.TheModel = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6551,20 +11555,27 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ContextInjection' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModel",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ContextInjection",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ContextInjection",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "ContextInjection")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -6575,13 +11586,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "ContextInjection")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "ContextInjection")
+			}
+		}()
 		opError := controller.ContextInjection(getRequestContext(fiberCtx), *dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "ContextInjection")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6599,6 +11617,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ContextInjection",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "ContextInjection")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -6616,9 +11635,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "ContextInjection")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/byte-slice"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/context-injection")), e2EcontrollerContextInjectionHandler)
+	e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithByteSliceRoute := withBasePath(basePath, "/e2e/byte-slice")
+	e2EcontrollerReturnsStructWithByteSliceHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"ReturnsStructWithByteSlice",
+			"E2EController",
+			"POST",
+			e2EcontrollerReturnsStructWithByteSliceRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "ReturnsStructWithByteSlice")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -6638,10 +11671,11 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ReturnsStructWithByteSlice")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var arriveRawPtr *Param22arrive.ObjectWithByteSlice = nil
+		var arriveRawPtr *Param8arrive.ObjectWithByteSlice = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "", &arriveRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6653,20 +11687,27 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ReturnsStructWithByteSlice' but body parameter '%s' did not pass validation of '%s' - %s",
-					"arrive",
-					"ObjectWithByteSlice",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithByteSlice",
+						"parameter": "arrive",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ReturnsStructWithByteSlice",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "ReturnsStructWithByteSlice")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -6677,13 +11718,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "ReturnsStructWithByteSlice")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "ReturnsStructWithByteSlice")
+			}
+		}()
 		value, opError := controller.ReturnsStructWithByteSlice(arriveRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "ReturnsStructWithByteSlice")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6701,6 +11749,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ReturnsStructWithByteSlice",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "ReturnsStructWithByteSlice")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -6740,9 +11789,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "ReturnsStructWithByteSlice")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/special-primitives"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/byte-slice")), e2EcontrollerReturnsStructWithByteSliceHandler)
+	e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithSpecialPrimitivesRoute := withBasePath(basePath, "/e2e/special-primitives")
+	e2EcontrollerReturnsStructWithSpecialPrimitivesHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"ReturnsStructWithSpecialPrimitives",
+			"E2EController",
+			"POST",
+			e2EcontrollerReturnsStructWithSpecialPrimitivesRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "ReturnsStructWithSpecialPrimitives")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -6762,10 +11825,11 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "ReturnsStructWithSpecialPrimitives")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var arriveRawPtr *Param23arrive.ObjectWithSpecialPrimitives = nil
+		var arriveRawPtr *Param24arrive.ObjectWithSpecialPrimitives = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "", &arriveRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6777,20 +11841,27 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ReturnsStructWithSpecialPrimitives' but body parameter '%s' did not pass validation of '%s' - %s",
-					"arrive",
-					"ObjectWithSpecialPrimitives",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithSpecialPrimitives",
+						"parameter": "arrive",
+						"type":      "ObjectWithSpecialPrimitives",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ReturnsStructWithSpecialPrimitives",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "ReturnsStructWithSpecialPrimitives")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -6801,13 +11872,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "ReturnsStructWithSpecialPrimitives")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "ReturnsStructWithSpecialPrimitives")
+			}
+		}()
 		value, opError := controller.ReturnsStructWithSpecialPrimitives(arriveRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "ReturnsStructWithSpecialPrimitives")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6825,6 +11903,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/ReturnsStructWithSpecialPrimitives",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "ReturnsStructWithSpecialPrimitives")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -6864,9 +11943,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "ReturnsStructWithSpecialPrimitives")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/alias-of-primitive"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/special-primitives")), e2EcontrollerReturnsStructWithSpecialPrimitivesHandler)
+	e2EcontrollerAliasOfStringErrorStatusCodes := []int{}
+	e2EcontrollerAliasOfStringRoute := withBasePath(basePath, "/e2e/alias-of-primitive")
+	e2EcontrollerAliasOfStringHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"AliasOfString",
+			"E2EController",
+			"POST",
+			e2EcontrollerAliasOfStringRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "AliasOfString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -6886,10 +11979,11 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "AliasOfString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var objectRawPtr *Param24object.ObjectWithAliasOfString = nil
+		var objectRawPtr *Param25object.ObjectWithAliasOfString = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &objectRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6901,25 +11995,31 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'AliasOfString' but body parameter '%s' did not pass validation of '%s' - %s",
-					"object",
-					"ObjectWithAliasOfString",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "AliasOfString",
+						"parameter": "object",
+						"type":      "ObjectWithAliasOfString",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/AliasOfString",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "AliasOfString")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var numRawPtr *Param25num.AliasOfInt = nil
+		var numRawPtr *Param26num.AliasOfInt = nil
 		numRaw := fiberCtx.Query("num")
 		isnumExists := fiberCtx.Context().QueryArgs().Has("num")
 		if isnumExists {
-			numUint64, conversionErr := strconv.Atoi(numRaw)
+			num, conversionErr := parseIntegerParam[int](numRaw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -6930,23 +12030,20 @@ func RegisterRoutes(engine *fiber.App) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'AliasOfString' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"num",
-						"AliasOfInt",
-						reflect.TypeOf(numRaw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/AliasOfString",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"AliasOfString",
+					"num",
+					"query",
+					"/num",
+					"AliasOfInt",
+					reflect.TypeOf(numRaw).String(),
+					getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+				)
 				fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "AliasOfString")
-				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+				return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			}
-			num := int(numUint64)
-			numVar := Param25num.AliasOfInt(num)
+			numVar := Param26num.AliasOfInt(num)
 			numRawPtr = &numVar
 		}
 		if validatorErr := validatorInstance.Var(numRawPtr, "required"); validatorErr != nil {
@@ -6960,16 +12057,18 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "num"
-			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/num", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "AliasOfString")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var strRawPtr *Param26str.AliasOfDirectString = nil
+		var strRawPtr *Param27str.AliasOfDirectString = nil
 		strRaw := fiberCtx.Query("str")
 		isstrExists := fiberCtx.Context().QueryArgs().Has("str")
 		if isstrExists {
 			str := strRaw
-			strVar := Param26str.AliasOfDirectString(str)
+			strVar := Param27str.AliasOfDirectString(str)
 			strRawPtr = &strVar
 		}
 		if validatorErr := validatorInstance.Var(strRawPtr, "required"); validatorErr != nil {
@@ -6983,10 +12082,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "str"
-			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/str", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "AliasOfString")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -6997,13 +12099,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "AliasOfString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "AliasOfString")
+			}
+		}()
 		value, opError := controller.AliasOfString(objectRawPtr, *numRawPtr, *strRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "AliasOfString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7021,6 +12130,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/AliasOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "AliasOfString")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -7064,9 +12174,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "AliasOfString")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/body-array-of-string"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/alias-of-primitive")), e2EcontrollerAliasOfStringHandler)
+	e2EcontrollerBodyArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringRoute := withBasePath(basePath, "/e2e/body-array-of-string")
+	e2EcontrollerBodyArrayOfStringHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"BodyArrayOfString",
+			"E2EController",
+			"POST",
+			e2EcontrollerBodyArrayOfStringRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "BodyArrayOfString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -7086,8 +12210,9 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "BodyArrayOfString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
 		var valuesRawPtr *[]string = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &valuesRawPtr)
@@ -7101,20 +12226,27 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'BodyArrayOfString' but body parameter '%s' did not pass validation of '%s' - %s",
-					"values",
-					"[]string",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfString",
+						"parameter": "values",
+						"type":      "[]string",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/BodyArrayOfString",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "BodyArrayOfString")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -7125,13 +12257,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "BodyArrayOfString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "BodyArrayOfString")
+			}
+		}()
 		value, opError := controller.BodyArrayOfString(*valuesRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "BodyArrayOfString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7149,6 +12288,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/BodyArrayOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "BodyArrayOfString")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -7166,9 +12306,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "BodyArrayOfString")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/body-array-of-enum-string"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/body-array-of-string")), e2EcontrollerBodyArrayOfStringHandler)
+	e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringEnumRoute := withBasePath(basePath, "/e2e/body-array-of-enum-string")
+	e2EcontrollerBodyArrayOfStringEnumHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"BodyArrayOfStringEnum",
+			"E2EController",
+			"POST",
+			e2EcontrollerBodyArrayOfStringEnumRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "BodyArrayOfStringEnum")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -7188,10 +12342,11 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "BodyArrayOfStringEnum")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var valuesRawPtr *[]Param27values.Myemamium = nil
+		var valuesRawPtr *[]Param28values.Myemamium = nil
 		conversionErr = bindAndValidateBody(fiberCtx, "application/json", "required", &valuesRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -7203,20 +12358,27 @@ func RegisterRoutes(engine *fiber.App) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'BodyArrayOfStringEnum' but body parameter '%s' did not pass validation of '%s' - %s",
-					"values",
-					"[]Myemamium",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfStringEnum",
+						"parameter": "values",
+						"type":      "[]Myemamium",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/BodyArrayOfStringEnum",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			fiberCtx.Set("x-JsonBodyValidationErrorResponseExtension", "BodyArrayOfStringEnum")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -7227,13 +12389,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "BodyArrayOfStringEnum")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "BodyArrayOfStringEnum")
+			}
+		}()
 		value, opError := controller.BodyArrayOfStringEnum(*valuesRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "BodyArrayOfStringEnum")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7251,6 +12420,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/BodyArrayOfStringEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "BodyArrayOfStringEnum")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -7268,9 +12438,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "BodyArrayOfStringEnum")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/query-array-of-string"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/body-array-of-enum-string")), e2EcontrollerBodyArrayOfStringEnumHandler)
+	e2EcontrollerQueryArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfStringRoute := withBasePath(basePath, "/e2e/query-array-of-string")
+	e2EcontrollerQueryArrayOfStringHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"QueryArrayOfString",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfStringRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "QueryArrayOfString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -7290,8 +12474,9 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "QueryArrayOfString")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var valuesRawPtr *[]string = nil
 		valuesRawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values")
 		valuesRawArray := make([]string, len(valuesRawArrayBytes))
@@ -7319,10 +12504,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "QueryArrayOfString")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -7333,13 +12521,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "QueryArrayOfString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "QueryArrayOfString")
+			}
+		}()
 		value, opError := controller.QueryArrayOfString(*valuesRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "QueryArrayOfString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7357,6 +12552,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/QueryArrayOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "QueryArrayOfString")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -7374,9 +12570,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "QueryArrayOfString")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/query-array-of-enum"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/query-array-of-string")), e2EcontrollerQueryArrayOfStringHandler)
+	e2EcontrollerQueryArrayOfEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfEnumRoute := withBasePath(basePath, "/e2e/query-array-of-enum")
+	e2EcontrollerQueryArrayOfEnumHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"QueryArrayOfEnum",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfEnumRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "QueryArrayOfEnum")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -7396,9 +12606,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "QueryArrayOfEnum")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
-		var valuesRawPtr *[]Param27values.Myemamium = nil
+		telemetry.startPhase("validation")
+		var valuesRawPtr *[]Param28values.Myemamium = nil
 		valuesRawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values")
 		valuesRawArray := make([]string, len(valuesRawArrayBytes))
 		for i, v := range valuesRawArrayBytes {
@@ -7406,7 +12617,7 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		isvaluesExists := fiberCtx.Context().QueryArgs().Has("values")
 		if isvaluesExists {
-			values := make([]Param27values.Myemamium, 0, len(valuesRawArray))
+			values := make([]Param28values.Myemamium, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
 				valuesItem := valuesRaw
 				switch valuesRaw {
@@ -7423,22 +12634,20 @@ func RegisterRoutes(engine *fiber.App) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]Myemamium",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfEnum",
+						"values",
+						"query",
+						"/values",
+						"[]Myemamium",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+					)
 					fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfEnum")
-					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				values = append(values, Param27values.Myemamium(valuesItem))
+				values = append(values, Param28values.Myemamium(valuesItem))
 			}
 			valuesRawPtr = &values
 		}
@@ -7453,11 +12662,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "QueryArrayOfEnum")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var values2RawPtr *[]Param28values2.MyaliasString = nil
+		var values2RawPtr *[]Param29values2.MyaliasString = nil
 		values2RawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values2")
 		values2RawArray := make([]string, len(values2RawArrayBytes))
 		for i, v := range values2RawArrayBytes {
@@ -7465,13 +12676,13 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		isvalues2Exists := fiberCtx.Context().QueryArgs().Has("values2")
 		if isvalues2Exists {
-			values2 := make([]Param28values2.MyaliasString, 0, len(values2RawArray))
+			values2 := make([]Param29values2.MyaliasString, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
 				values2Item := values2Raw
-				values2 = append(values2, Param28values2.MyaliasString(values2Item))
+				values2 = append(values2, Param29values2.MyaliasString(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param28values2.MyaliasString(values2)
+			values2Var := []Param29values2.MyaliasString(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -7485,10 +12696,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "QueryArrayOfEnum")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -7499,13 +12713,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "QueryArrayOfEnum")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "QueryArrayOfEnum")
+			}
+		}()
 		value, opError := controller.QueryArrayOfEnum(*valuesRawPtr, *values2RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "QueryArrayOfEnum")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7523,6 +12744,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/QueryArrayOfEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "QueryArrayOfEnum")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -7540,9 +12762,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "QueryArrayOfEnum")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/query-array-of-others"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/query-array-of-enum")), e2EcontrollerQueryArrayOfEnumHandler)
+	e2EcontrollerQueryArrayOfOthersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersRoute := withBasePath(basePath, "/e2e/query-array-of-others")
+	e2EcontrollerQueryArrayOfOthersHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"QueryArrayOfOthers",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfOthersRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "QueryArrayOfOthers")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -7562,8 +12798,9 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "QueryArrayOfOthers")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var valuesRawPtr *[]int = nil
 		valuesRawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values")
 		valuesRawArray := make([]string, len(valuesRawArrayBytes))
@@ -7574,7 +12811,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if isvaluesExists {
 			values := make([]int, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
-				valuesUint64, conversionErr := strconv.Atoi(valuesRaw)
+				valuesItem, conversionErr := parseIntegerParam[int](valuesRaw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7585,22 +12822,19 @@ func RegisterRoutes(engine *fiber.App) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]int",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values",
+						"query",
+						"/values",
+						"[]int",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+					)
 					fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
-					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				valuesItem := int(valuesUint64)
 				values = append(values, int(valuesItem))
 			}
 			valuesRawPtr = &values
@@ -7617,11 +12851,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "QueryArrayOfOthers")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var values2RawPtr *[]Param29values2.MyaliasInt = nil
+		var values2RawPtr *[]Param30values2.MyaliasInt = nil
 		values2RawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values2")
 		values2RawArray := make([]string, len(values2RawArrayBytes))
 		for i, v := range values2RawArrayBytes {
@@ -7629,9 +12865,9 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		isvalues2Exists := fiberCtx.Context().QueryArgs().Has("values2")
 		if isvalues2Exists {
-			values2 := make([]Param29values2.MyaliasInt, 0, len(values2RawArray))
+			values2 := make([]Param30values2.MyaliasInt, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
-				values2Uint64, conversionErr := strconv.Atoi(values2Raw)
+				values2Item, conversionErr := parseIntegerParam[int](values2Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7642,26 +12878,23 @@ func RegisterRoutes(engine *fiber.App) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values2",
-							"[]MyaliasInt",
-							reflect.TypeOf(values2Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values2",
+						"query",
+						"/values2",
+						"[]MyaliasInt",
+						reflect.TypeOf(values2Raw).String(),
+						getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+					)
 					fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
-					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				values2Item := int(values2Uint64)
-				values2 = append(values2, Param29values2.MyaliasInt(values2Item))
+				values2 = append(values2, Param30values2.MyaliasInt(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param29values2.MyaliasInt(values2)
+			values2Var := []Param30values2.MyaliasInt(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -7675,9 +12908,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "QueryArrayOfOthers")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var values3RawPtr *[]bool = nil
 		values3RawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values3")
@@ -7689,7 +12924,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if isvalues3Exists {
 			values3 := make([]bool, 0, len(values3RawArray))
 			for _, values3Raw := range values3RawArray {
-				values3Bool, conversionErr := strconv.ParseBool(values3Raw)
+				values3Item, conversionErr := strconv.ParseBool(values3Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7700,22 +12935,19 @@ func RegisterRoutes(engine *fiber.App) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values3",
-							"[]bool",
-							reflect.TypeOf(values3Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values3",
+						"query",
+						"/values3",
+						"[]bool",
+						reflect.TypeOf(values3Raw).String(),
+						getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+					)
 					fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
-					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				values3Item := values3Bool
 				values3 = append(values3, bool(values3Item))
 			}
 			values3RawPtr = &values3
@@ -7732,9 +12964,11 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values3"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values3", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "QueryArrayOfOthers")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
 		var values4RawPtr *[]int32 = nil
 		values4RawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values4")
@@ -7746,7 +12980,7 @@ func RegisterRoutes(engine *fiber.App) {
 		if isvalues4Exists {
 			values4 := make([]int32, 0, len(values4RawArray))
 			for _, values4Raw := range values4RawArray {
-				values4Uint64, conversionErr := strconv.ParseInt(values4Raw, 10, 32)
+				values4Item, conversionErr := parseIntegerParam[int32](values4Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7757,22 +12991,19 @@ func RegisterRoutes(engine *fiber.App) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values4",
-							"[]int32",
-							reflect.TypeOf(values4Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values4",
+						"query",
+						"/values4",
+						"[]int32",
+						reflect.TypeOf(values4Raw).String(),
+						getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+					)
 					fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
-					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				values4Item := int32(values4Uint64)
 				values4 = append(values4, int32(values4Item))
 			}
 			values4RawPtr = &values4
@@ -7789,10 +13020,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values4"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values4", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "QueryArrayOfOthers")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -7803,13 +13037,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "QueryArrayOfOthers")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "QueryArrayOfOthers")
+			}
+		}()
 		value, opError := controller.QueryArrayOfOthers(*valuesRawPtr, *values2RawPtr, *values3RawPtr, *values4RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "QueryArrayOfOthers")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7827,6 +13068,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/QueryArrayOfOthers",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "QueryArrayOfOthers")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -7844,9 +13086,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "QueryArrayOfOthers")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/query-array-of-others-enum"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/query-array-of-others")), e2EcontrollerQueryArrayOfOthersHandler)
+	e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersEnumRoute := withBasePath(basePath, "/e2e/query-array-of-others-enum")
+	e2EcontrollerQueryArrayOfOthersEnumHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"QueryArrayOfOthersEnum",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfOthersEnumRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "QueryArrayOfOthersEnum")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -7866,9 +13122,10 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "QueryArrayOfOthersEnum")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
-		var valuesRawPtr *[]Param31values.NumberEnum = nil
+		telemetry.startPhase("validation")
+		var valuesRawPtr *[]Param32values.NumberEnum = nil
 		valuesRawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values")
 		valuesRawArray := make([]string, len(valuesRawArrayBytes))
 		for i, v := range valuesRawArrayBytes {
@@ -7876,9 +13133,9 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		isvaluesExists := fiberCtx.Context().QueryArgs().Has("values")
 		if isvaluesExists {
-			values := make([]Param31values.NumberEnum, 0, len(valuesRawArray))
+			values := make([]Param32values.NumberEnum, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
-				valuesUint64, conversionErr := strconv.ParseInt(valuesRaw, 10, 16)
+				valuesItem, conversionErr := parseIntegerParam[int16](valuesRaw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7889,22 +13146,19 @@ func RegisterRoutes(engine *fiber.App) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthersEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]NumberEnum",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthersEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values",
+						"query",
+						"/values",
+						"[]NumberEnum",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+					)
 					fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthersEnum")
-					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				valuesItem := int16(valuesUint64)
 				switch valuesRaw {
 				case "1", "2":
 					break
@@ -7919,22 +13173,20 @@ func RegisterRoutes(engine *fiber.App) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthersEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]NumberEnum",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthersEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values",
+						"query",
+						"/values",
+						"[]NumberEnum",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+					)
 					fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthersEnum")
-					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				values = append(values, Param31values.NumberEnum(valuesItem))
+				values = append(values, Param32values.NumberEnum(valuesItem))
 			}
 			valuesRawPtr = &values
 		}
@@ -7949,11 +13201,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "QueryArrayOfOthersEnum")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
-		var values2RawPtr *[]Param32values2.BoolEnum = nil
+		var values2RawPtr *[]Param33values2.BoolEnum = nil
 		values2RawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values2")
 		values2RawArray := make([]string, len(values2RawArrayBytes))
 		for i, v := range values2RawArrayBytes {
@@ -7961,7 +13215,7 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		isvalues2Exists := fiberCtx.Context().QueryArgs().Has("values2")
 		if isvalues2Exists {
-			values2 := make([]Param32values2.BoolEnum, 0, len(values2RawArray))
+			values2 := make([]Param33values2.BoolEnum, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
 				values2Item := values2Raw
 				switch values2Raw {
@@ -7978,22 +13232,20 @@ func RegisterRoutes(engine *fiber.App) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthersEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values2",
-							"[]BoolEnum",
-							reflect.TypeOf(values2Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthersEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values2",
+						"query",
+						"/values2",
+						"[]BoolEnum",
+						reflect.TypeOf(values2Raw).String(),
+						getAcceptedLanguages(fiberCtx.Get("Accept-Language")),
+					)
 					fiberCtx.Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthersEnum")
-					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+					return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				}
-				values2 = append(values2, Param32values2.BoolEnum(values2Item))
+				values2 = append(values2, Param33values2.BoolEnum(values2Item))
 			}
 			values2RawPtr = &values2
 		}
@@ -8008,10 +13260,13 @@ func RegisterRoutes(engine *fiber.App) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName)
+			languages := getAcceptedLanguages(fiberCtx.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			fiberCtx.Set("x-RunValidatorExtension", "QueryArrayOfOthersEnum")
-			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(validationError)
+			return fiberCtx.Status(http.StatusUnprocessableEntity).JSON(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -8022,13 +13277,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "QueryArrayOfOthersEnum")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "QueryArrayOfOthersEnum")
+			}
+		}()
 		value, opError := controller.QueryArrayOfOthersEnum(*valuesRawPtr, *values2RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "QueryArrayOfOthersEnum")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -8046,6 +13308,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/QueryArrayOfOthersEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "QueryArrayOfOthersEnum")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -8063,9 +13326,23 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "QueryArrayOfOthersEnum")
 		return nil
-	})
-	engine.Post(toFiberUrl("/e2e/query-pointer-to-array"), func(fiberCtx *fiber.Ctx) error {
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/query-array-of-others-enum")), e2EcontrollerQueryArrayOfOthersEnumHandler)
+	e2EcontrollerQueryArrayOfPointersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfPointersRoute := withBasePath(basePath, "/e2e/query-pointer-to-array")
+	e2EcontrollerQueryArrayOfPointersHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"QueryArrayOfPointers",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfPointersRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
 		fiberCtx.Set("x-RouteStartRoutesExtension", "QueryArrayOfPointers")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			fiberCtx,
 			[]SecurityCheckList{
@@ -8085,8 +13362,9 @@ func RegisterRoutes(engine *fiber.App) {
 		if authErr != nil {
 			return handleAuthorizationError(fiberCtx, authErr, "QueryArrayOfPointers")
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(fiberCtx))
 		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
 		var values07RawPtr *[]string = nil
 		values07RawArrayBytes := fiberCtx.Context().QueryArgs().PeekMulti("values07")
 		values07RawArray := make([]string, len(values07RawArrayBytes))
@@ -8103,6 +13381,7 @@ func RegisterRoutes(engine *fiber.App) {
 			values07RawPtr = &values07
 			values07RawPtr = &values07
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
@@ -8113,13 +13392,20 @@ func RegisterRoutes(engine *fiber.App) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		fiberCtx.Set("x-BeforeOperationRoutesExtension", "QueryArrayOfPointers")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "QueryArrayOfPointers")
+			}
+		}()
 		value, opError := controller.QueryArrayOfPointers(values07RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			fiberCtx.Set(key, value)
 		}
 		fiberCtx.Set("x-inject", "true")
 		fiberCtx.Set("x-ResponseHeadersExtension", "QueryArrayOfPointers")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -8137,6 +13423,7 @@ func RegisterRoutes(engine *fiber.App) {
 				Instance:   "/controller/error/QueryArrayOfPointers",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
 			fiberCtx.Set("x-JsonErrorResponseExtension", "QueryArrayOfPointers")
 			return fiberCtx.Status(statusCode).JSON(stdError)
 		}
@@ -8154,5 +13441,215 @@ func RegisterRoutes(engine *fiber.App) {
 		fiberCtx.Status(statusCode).JSON(value)
 		fiberCtx.Set("x-RouteEndRoutesExtension", "QueryArrayOfPointers")
 		return nil
-	})
+	}
+	engine.Add("POST", toFiberUrl(withBasePath(basePath, "/e2e/query-pointer-to-array")), e2EcontrollerQueryArrayOfPointersHandler)
+	// E2ESharedRateLimitController
+	e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitFirstRoute := withBasePath(basePath, "/e2e/shared-rate-limit/first")
+	e2EsharedRateLimitControllerSharedRateLimitFirstHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"SharedRateLimitFirst",
+			"E2ESharedRateLimitController",
+			"GET",
+			e2EsharedRateLimitControllerSharedRateLimitFirstRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "SharedRateLimitFirst")
+		if allowed, err := enforceRateLimit(fiberCtx, "SharedRateLimitFirst", e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit); !allowed {
+			return err
+		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "SharedRateLimitFirst")
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "SharedRateLimitFirst")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "SharedRateLimitFirst")
+			}
+		}()
+		value, opError := controller.SharedRateLimitFirst()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "SharedRateLimitFirst")
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitFirst'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitFirst",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "SharedRateLimitFirst")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "SharedRateLimitFirst")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "SharedRateLimitFirst")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "SharedRateLimitFirst")
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/shared-rate-limit/first")), e2EsharedRateLimitControllerSharedRateLimitFirstHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/shared-rate-limit/first")), e2EsharedRateLimitControllerSharedRateLimitFirstHandler)
+	e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitSecondRoute := withBasePath(basePath, "/e2e/shared-rate-limit/second")
+	e2EsharedRateLimitControllerSharedRateLimitSecondHandler := func(fiberCtx *fiber.Ctx) error {
+		telemetry := startOperationTelemetry(
+			getRequestContext(fiberCtx),
+			propagation.HeaderCarrier(fiberCtx.GetReqHeaders()),
+			"SharedRateLimitSecond",
+			"E2ESharedRateLimitController",
+			"GET",
+			e2EsharedRateLimitControllerSharedRateLimitSecondRoute,
+		)
+		setRequestContext(fiberCtx, telemetry.ctx)
+		defer func() { telemetry.end(fiberCtx.Response().StatusCode()) }()
+		fiberCtx.Set("x-RouteStartRoutesExtension", "SharedRateLimitSecond")
+		if allowed, err := enforceRateLimit(fiberCtx, "SharedRateLimitSecond", e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit); !allowed {
+			return err
+		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			fiberCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			return handleAuthorizationError(fiberCtx, authErr, "SharedRateLimitSecond")
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(fiberCtx))
+		controller.InitController(fiberCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		fiberCtx.Set("x-BeforeOperationRoutesExtension", "SharedRateLimitSecond")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(fiberCtx, recovered, "SharedRateLimitSecond")
+			}
+		}()
+		value, opError := controller.SharedRateLimitSecond()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			fiberCtx.Set(key, value)
+		}
+		fiberCtx.Set("x-inject", "true")
+		fiberCtx.Set("x-ResponseHeadersExtension", "SharedRateLimitSecond")
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx, opError)
+				setRequestContext(fiberCtx, middlewareCtx)
+				if !continueOperation {
+					return nil
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitSecond'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitSecond",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+			fiberCtx.Set("x-JsonErrorResponseExtension", "SharedRateLimitSecond")
+			return fiberCtx.Status(statusCode).JSON(stdError)
+		}
+		fiberCtx.Set("x-JsonResponseExtension", "SharedRateLimitSecond")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(fiberCtx), fiberCtx)
+			setRequestContext(fiberCtx, middlewareCtx)
+			if !continueOperation {
+				return nil
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		fiberCtx.Set("x-AfterOperationRoutesExtension", "SharedRateLimitSecond")
+		fiberCtx.Status(statusCode).JSON(value)
+		fiberCtx.Set("x-RouteEndRoutesExtension", "SharedRateLimitSecond")
+		return nil
+	}
+	engine.Add("GET", toFiberUrl(withBasePath(basePath, "/e2e/shared-rate-limit/second")), e2EsharedRateLimitControllerSharedRateLimitSecondHandler)
+	engine.Add("HEAD", toFiberUrl(withBasePath(basePath, "/e2e/shared-rate-limit/second")), e2EsharedRateLimitControllerSharedRateLimitSecondHandler)
 }
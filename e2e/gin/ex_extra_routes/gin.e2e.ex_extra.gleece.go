@@ -5,7 +5,7 @@ It includes routes and handlers by the Gleece API Routes Generator.
 --
 Authors: Haim Kastner & Yuval Pomerchik
 Generated by: Gleece Routes Generator
-Generated Date: 2025-12-25
+Generated Date: 2026-10-19
 Target Engine: Gin (https://github.com/gin-gonic/gin)
 --
 Usage:
@@ -16,46 +16,58 @@ Repository: https://github.com/gopher-fleece/gleece
 */
 package ex_extra_routes
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/textproto"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
 	RequestAuth "github.com/gopher-fleece/gleece/v2/e2e/gin/auth"
 	"github.com/gopher-fleece/runtime"
 	E2EClassSecController "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	E2EController "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param11value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param12value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param12value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param15data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param16data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param17data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	E2ESharedRateLimitController "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param14value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param18data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param19data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param20data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param22arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param23arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param24object "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param25num "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param26str "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param27values "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param28values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param21data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param22data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param23data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param24arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param25object "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param26num "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param27str "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param28values "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param29values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param31values "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param32values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param30values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param32values "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param33values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param4value2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param5data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param5theBody "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Response6CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param13data "github.com/haimkastner/unitsnet-go/units"
-	Param14unit "github.com/haimkastner/unitsnet-go/units"
+	Param6principal "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Response9CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param16data "github.com/haimkastner/unitsnet-go/units"
+	Param17unit "github.com/haimkastner/unitsnet-go/units"
 	// import extension placeholder
 )
 var validatorInstance = validator.New()
@@ -68,6 +80,24 @@ type SecurityCheckList struct {
 	Checks   []runtime.SecurityCheck
 	Relation SecurityListRelation
 }
+// ValidationIssue describes a single failed validation of a request's input
+type ValidationIssue struct {
+	// The location of the offending input - body, query, path, header or form
+	In string `json:"in"`
+	// A JSON pointer to the offending value, relative to its location
+	Pointer string `json:"pointer"`
+	// The validation rule that failed
+	Rule string `json:"rule"`
+	// The failed rule's parameter, if any
+	Param string `json:"param,omitempty"`
+	// A human-readable description of the failure
+	Message string `json:"message"`
+}
+// ValidationProblem is an RFC7807 error detailing the failed validations of a request
+type ValidationProblem struct {
+	runtime.Rfc7807Error
+	Errors []ValidationIssue `json:"errors"`
+}
 // type declarations extension placeholder
 func getRequestContext(ginCtx *gin.Context) context.Context {
 	return ginCtx.Request.Context()
@@ -77,6 +107,56 @@ func setRequestContext(ginCtx *gin.Context, ctx context.Context) {
 		ginCtx.Request = ginCtx.Request.WithContext(ctx)
 	}
 }
+type principalContextKey struct{}
+// WithPrincipal attaches the request's authenticated principal to the given context.
+//
+// Security handlers return the resulting context to make the principal available to @Principal parameters
+func WithPrincipal(ctx context.Context, principal any) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+// getPrincipal retrieves the principal attached to the given context via WithPrincipal.
+// Yields nil if no principal of type TPrincipal (or a pointer to one) was attached
+func getPrincipal[TPrincipal any](ctx context.Context) *TPrincipal {
+	switch principal := ctx.Value(principalContextKey{}).(type) {
+	case *TPrincipal:
+		return principal
+	case TPrincipal:
+		return &principal
+	default:
+		return nil
+	}
+}
+// IdentifiablePrincipal may be implemented by principals to provide a stable identity, e.g. a user ID,
+// by which their idempotent requests and principal keyed rate limits are scoped
+type IdentifiablePrincipal interface {
+	IdempotencyIdentity() string
+}
+// describePrincipal returns a stable textual identity of the principal attached to the given context via WithPrincipal,
+// i.e., its IdempotencyIdentity or String method's result, if any, or its dereferenced value if of a primitive kind.
+// Yields an empty string if no principal was attached.
+//
+// Returns false for principals lacking a stable identity, e.g. structs, whose formatting may vary between requests
+func describePrincipal(ctx context.Context) (string, bool) {
+	principal := ctx.Value(principalContextKey{})
+	switch identifiable := principal.(type) {
+	case nil:
+		return "", true
+	case IdentifiablePrincipal:
+		return identifiable.IdempotencyIdentity(), true
+	case fmt.Stringer:
+		return identifiable.String(), true
+	}
+	value := reflect.Indirect(reflect.ValueOf(principal))
+	switch value.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprintf("%v", value), true
+	default:
+		return "", false
+	}
+}
 func registerEnumValidation(validate *validator.Validate, validationName string, allowedValues []string) {
 	// Convert the array to a map for O(1) lookup
 	lookup := make(map[string]struct{})
@@ -90,12 +170,12 @@ func registerEnumValidation(validate *validator.Validate, validationName string,
 		return exists
 	})
 }
-func extractValidationErrorMessage(err error, fieldName *string) string {
+func extractValidationErrorMessage(err error, fieldName *string, languages []string) string {
 	if err == nil {
 		return ""
 	}
-	validationErrors, ok := err.(validator.ValidationErrors)
-	if !ok {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
 		return err.Error()
 	}
 	var errStr string
@@ -104,15 +184,26 @@ func extractValidationErrorMessage(err error, fieldName *string) string {
 		if fieldName != nil {
 			fName = *fieldName
 		}
-		errStr += fmt.Sprintf("Field '%s' failed validation with tag '%s'. ", fName, validationErr.Tag())
+		errStr += translateMessage(
+			languages,
+			map[string]string{"field": fName, "tag": validationErr.Tag(), "param": validationErr.Param()},
+			ValidationTagMessageKey(validationErr.Tag()),
+			MessageKeyFieldValidation,
+		) + ". "
 	}
 	return errStr
 }
-func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error) int {
+// getStatusCode returns the status code of an operation's response - the one set via the controller's SetStatus, if any,
+// that of the error mapping matching err among the operation's declared error status codes or a 500 for other errors,
+// or the success status code
+func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error, routeErrorStatusCodes []int) int {
 	if controller.GetStatus() != nil {
 		return int(*controller.GetStatus())
 	}
 	if err != nil {
+		if mapping := getErrorMapping(err, routeErrorStatusCodes); mapping != nil {
+			return mapping.StatusCode
+		}
 		return http.StatusInternalServerError
 	}
 	if hasReturnValue {
@@ -125,7 +216,7 @@ func bindAndValidateBody[TOutput any](ginCtx *gin.Context, contentType string, v
 	bodyBytes, err := io.ReadAll(ginCtx.Request.Body)
 	if err != nil || len(bodyBytes) == 0 {
 		if strings.Contains(validation, "required") {
-			return fmt.Errorf("body is required but was not provided")
+			return errBodyRequired
 		}
 		return nil
 	}
@@ -160,12 +251,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Slice, reflect.Array:
 		// For slices/arrays, validate each element recursively
 		for i := 0; i < val.Len(); i++ {
-			elemPath := path
-			if path != "" {
-				elemPath = fmt.Sprintf("%s[%d]", path, i)
-			} else {
-				elemPath = fmt.Sprintf("[%d]", i)
-			}
+			elemPath := fmt.Sprintf("%s/%d", path, i)
 			// Get the element - handle case where element might be nil
 			elem := val.Index(i)
 			if elem.Kind() == reflect.Ptr && elem.IsNil() {
@@ -180,12 +266,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Map:
 		// For maps, validate each value recursively
 		for _, key := range val.MapKeys() {
-			elemPath := path
-			if path != "" {
-				elemPath = fmt.Sprintf("%s.%v", path, key.Interface())
-			} else {
-				elemPath = fmt.Sprintf("%v", key.Interface())
-			}
+			elemPath := fmt.Sprintf("%s/%s", path, escapeJsonPointerToken(fmt.Sprintf("%v", key.Interface())))
 			elemVal := val.MapIndex(key)
 			if elemVal.Kind() == reflect.Ptr && elemVal.IsNil() {
 				continue
@@ -198,10 +279,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Struct:
 		// Validate structs with the validator
 		if err := validatorInstance.Struct(data); err != nil {
-			if path != "" {
-				return fmt.Errorf("validation error at %s: %w", path, err)
-			}
-			return err
+			return &structValidationError{pointer: path, structType: val.Type(), err: err}
 		}
 		return nil
 	default:
@@ -220,16 +298,30 @@ func toGinUrl(url string) string {
 	}
 	return processedUrl
 }
+// withBasePath prefixes the given route path with the given base path
+func withBasePath(basePath string, path string) string {
+	basePath = strings.TrimSuffix(basePath, "/")
+	if basePath != "" && !strings.HasPrefix(basePath, "/") {
+		basePath = "/" + basePath
+	}
+	return basePath + path
+}
+// authorize succeeds if all checks of any of the given lists pass.
+// Each list is an alternative (OR) of security schemas that must all be satisfied (AND), as expanded
+// from the route's @Security and @AdvancedSecurity annotations
 func authorize(ginCtx *gin.Context, checksLists []SecurityCheckList) *runtime.SecurityError {
 	var lastError *runtime.SecurityError
 	for _, list := range checksLists {
 		if list.Relation != SecurityListRelationAnd {
-			panic(
-				"Encountered a security list relation of type '%s' - this is unexpected and indicates a bug in Gleece itself." +
+			panic(fmt.Sprintf(
+				"Encountered a security list relation of type '%s' - this is unexpected and indicates a bug in Gleece itself. "+
 					"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
-			)
+				list.Relation,
+			))
 		}
-		// Iterate over each security list
+		// Iterate over each security list.
+		// Checks may attach values (e.g. a principal) to the request context - these are discarded if the list fails
+		listCtx := getRequestContext(ginCtx)
 		encounteredErrorInList := false
 		for _, check := range list.Checks {
 			secCtx, secErr := RequestAuth.GleeceRequestAuthorization(getRequestContext(ginCtx), ginCtx, check)
@@ -245,6 +337,7 @@ func authorize(ginCtx *gin.Context, checksLists []SecurityCheckList) *runtime.Se
 		if !encounteredErrorInList {
 			return nil
 		}
+		setRequestContext(ginCtx, listCtx)
 	}
 	// If we got here it means authentication has failed
 	return lastError
@@ -264,20 +357,931 @@ func handleAuthorizationError(ginCtx *gin.Context, authErr *runtime.SecurityErro
 	}
 	ginCtx.JSON(statusCode, stdError)
 }
-func wrapValidatorError(validatorErr error, operationId string, fieldName string) runtime.Rfc7807Error {
+func wrapValidatorError(validatorErr error, operationId string, fieldName string, languages []string) runtime.Rfc7807Error {
 	return runtime.Rfc7807Error{
 		Type: http.StatusText(http.StatusUnprocessableEntity),
-		Detail: fmt.Sprintf(
-			"A request was made to operation '%s' but parameter '%s' did not pass validation - %s",
-			operationId,
-			fieldName,
-			extractValidationErrorMessage(validatorErr, &fieldName),
+		Detail: translateMessage(
+			languages,
+			map[string]string{
+				"operation": operationId,
+				"parameter": fieldName,
+				"details":   extractValidationErrorMessage(validatorErr, &fieldName, languages),
+			},
+			MessageKeyParamValidation,
 		),
 		Status:   http.StatusUnprocessableEntity,
 		Instance: fmt.Sprintf("/validation/error/%s", operationId),
 	}
 }
+// paramInteger is the set of signed integer types a request parameter may be converted to
+type paramInteger interface {
+	int | int8 | int16 | int32 | int64
+}
+// paramUnsigned is the set of unsigned integer types a request parameter may be converted to
+type paramUnsigned interface {
+	uint | uint8 | uint16 | uint32 | uint64
+}
+// paramFloat is the set of floating point types a request parameter may be converted to
+type paramFloat interface {
+	float32 | float64
+}
+// parseIntegerParam converts the raw value of a request parameter to the given signed integer type
+func parseIntegerParam[T paramInteger](raw string) (T, error) {
+	parsed, err := strconv.ParseInt(raw, 10, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// parseUnsignedParam converts the raw value of a request parameter to the given unsigned integer type
+func parseUnsignedParam[T paramUnsigned](raw string) (T, error) {
+	parsed, err := strconv.ParseUint(raw, 10, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// parseFloatParam converts the raw value of a request parameter to the given floating point type
+func parseFloatParam[T paramFloat](raw string) (T, error) {
+	parsed, err := strconv.ParseFloat(raw, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// wrapConversionError creates the RFC7807 error and validation issues describing a request parameter
+// whose raw value could not be converted to the parameter's type
+func wrapConversionError(
+	conversionErr error,
+	operationId string,
+	fieldName string,
+	in string,
+	pointer string,
+	expectedType string,
+	actualType string,
+	languages []string,
+) (runtime.Rfc7807Error, []ValidationIssue) {
+	validationError := runtime.Rfc7807Error{
+		Type: http.StatusText(http.StatusUnprocessableEntity),
+		Detail: translateMessage(
+			languages,
+			map[string]string{
+				"operation": operationId,
+				"parameter": fieldName,
+				"expected":  expectedType,
+				"actual":    actualType,
+			},
+			MessageKeyParamConversion,
+		),
+		Status:     http.StatusUnprocessableEntity,
+		Instance:   fmt.Sprintf("/validation/error/%s", operationId),
+		Extensions: map[string]string{"error": conversionErr.Error()},
+	}
+	validationIssues := []ValidationIssue{
+		{
+			In:      in,
+			Pointer: pointer,
+			Rule:    "type",
+			Param:   expectedType,
+			Message: conversionErr.Error(),
+		},
+	}
+	return validationError, validationIssues
+}
+var errBodyRequired = errors.New("body is required but was not provided")
+// structValidationError associates a struct's validation errors with the struct's location within the validated payload
+type structValidationError struct {
+	pointer    string
+	structType reflect.Type
+	err        error
+}
+func (e *structValidationError) Error() string {
+	if e.pointer == "" {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("validation error at %s: %s", e.pointer, e.err.Error())
+}
+func (e *structValidationError) Unwrap() error {
+	return e.err
+}
+func escapeJsonPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+// getJsonFieldName returns the name of the given field within its struct's JSON representation.
+//
+// Embedded structs without an explicit name are flattened by encoding/json and therefore yield an empty name
+func getJsonFieldName(field reflect.StructField) string {
+	if name := strings.Split(field.Tag.Get("json"), ",")[0]; name != "" {
+		return name
+	}
+	fieldType := field.Type
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	if field.Anonymous && fieldType.Kind() == reflect.Struct {
+		return ""
+	}
+	return field.Name
+}
+// toJsonPointer converts a validator struct namespace (e.g. 'Model.Items[0].Name') to a JSON pointer (e.g. '/items/0/name')
+// using the json tags of the root type's fields
+func toJsonPointer(rootType reflect.Type, structNamespace string) string {
+	pointer := ""
+	currentType := rootType
+	segments := strings.Split(structNamespace, ".")
+	for _, segment := range segments[1:] {
+		name, indices := segment, []string{}
+		if bracket := strings.Index(segment, "["); bracket >= 0 {
+			name = segment[:bracket]
+			indices = strings.Split(strings.TrimSuffix(segment[bracket+1:], "]"), "][")
+		}
+		for currentType != nil && currentType.Kind() == reflect.Ptr {
+			currentType = currentType.Elem()
+		}
+		token := name
+		if currentType != nil && currentType.Kind() == reflect.Struct {
+			if field, found := currentType.FieldByName(name); found {
+				currentType = field.Type
+				token = getJsonFieldName(field)
+			} else {
+				currentType = nil
+			}
+		}
+		if token != "" {
+			pointer += "/" + escapeJsonPointerToken(token)
+		}
+		for _, index := range indices {
+			pointer += "/" + escapeJsonPointerToken(index)
+			for currentType != nil && currentType.Kind() == reflect.Ptr {
+				currentType = currentType.Elem()
+			}
+			if currentType != nil && (currentType.Kind() == reflect.Slice || currentType.Kind() == reflect.Array || currentType.Kind() == reflect.Map) {
+				currentType = currentType.Elem()
+			} else {
+				currentType = nil
+			}
+		}
+	}
+	return pointer
+}
+func getValidationIssueMessage(pointer string, tag string, param string, languages []string) string {
+	args := map[string]string{"tag": tag, "param": param}
+	if pointer == "" {
+		return translateMessage(languages, args, ValidationTagMessageKey(tag), MessageKeyValueValidation)
+	}
+	args["field"] = pointer[strings.LastIndex(pointer, "/")+1:]
+	return translateMessage(languages, args, ValidationTagMessageKey(tag), MessageKeyFieldValidation)
+}
+// getValidationIssues converts the given validation or deserialization error to a list of issues at the given location.
+//
+// The pointer is the JSON pointer of the validated value, relative to its location
+func getValidationIssues(err error, in string, pointer string, languages []string) []ValidationIssue {
+	var structType reflect.Type
+	var structErr *structValidationError
+	if errors.As(err, &structErr) {
+		pointer += structErr.pointer
+		structType = structErr.structType
+	}
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		issues := make([]ValidationIssue, 0, len(validationErrors))
+		for _, validationErr := range validationErrors {
+			issuePointer := pointer
+			if structType != nil {
+				issuePointer += toJsonPointer(structType, validationErr.StructNamespace())
+			}
+			issues = append(issues, ValidationIssue{
+				In:      in,
+				Pointer: issuePointer,
+				Rule:    validationErr.Tag(),
+				Param:   validationErr.Param(),
+				Message: getValidationIssueMessage(issuePointer, validationErr.Tag(), validationErr.Param(), languages),
+			})
+		}
+		return issues
+	}
+	issue := ValidationIssue{In: in, Pointer: pointer, Rule: "parse", Message: err.Error()}
+	var typeErr *json.UnmarshalTypeError
+	if errors.Is(err, errBodyRequired) {
+		issue.Rule = "required"
+	} else if errors.As(err, &typeErr) {
+		if typeErr.Field != "" {
+			issue.Pointer += "/" + strings.ReplaceAll(typeErr.Field, ".", "/")
+		}
+		issue.Rule = "type"
+		issue.Param = typeErr.Type.String()
+	}
+	return []ValidationIssue{issue}
+}
+// Keys of the translatable validation messages.
+//
+// Message templates use named placeholders, such as '{field}', which are replaced with the message's arguments
+const (
+	// Describes a parameter that did not pass validation. Placeholders: {operation}, {parameter}, {details}
+	MessageKeyParamValidation = "paramValidation"
+	// Describes a body that did not pass validation. Placeholders: {operation}, {parameter}, {type}, {details}
+	MessageKeyBodyValidation = "bodyValidation"
+	// Describes a parameter that could not be converted to its declared type. Placeholders: {operation}, {parameter}, {expected}, {actual}
+	MessageKeyParamConversion = "paramConversion"
+	// Describes a field that failed a validation tag with no dedicated message. Placeholders: {field}, {tag}, {param}
+	MessageKeyFieldValidation = "fieldValidation"
+	// Describes a value that failed a validation tag with no dedicated message. Placeholders: {tag}, {param}
+	MessageKeyValueValidation = "valueValidation"
+)
+// The language of the default message catalogue
+const defaultMessageLanguage = "en"
+// The default English message catalogue, used whenever no translation is available
+var defaultMessages = map[string]string{
+	MessageKeyParamValidation: "A request was made to operation '{operation}' but parameter '{parameter}' did not pass validation - {details}",
+	MessageKeyBodyValidation:  "A request was made to operation '{operation}' but body parameter '{parameter}' did not pass validation of '{type}' - {details}",
+	MessageKeyParamConversion: "A request was made to operation '{operation}' but parameter '{parameter}' was not properly sent - Expected {expected} but got {actual}",
+	MessageKeyFieldValidation: "Field '{field}' failed validation with tag '{tag}'",
+	MessageKeyValueValidation: "Value failed validation with tag '{tag}'",
+}
+// MessageTranslator provides validation message templates in the languages requested via the Accept-Language header
+type MessageTranslator interface {
+	// Translate returns the message template registered for the given language and key, if any
+	Translate(language string, key string) (string, bool)
+}
+// MessageCatalogue is a MessageTranslator backed by an in-memory map of language to message key to template.
+//
+// Languages are matched case-insensitively
+type MessageCatalogue map[string]map[string]string
+func (catalogue MessageCatalogue) Translate(language string, key string) (string, bool) {
+	template, found := catalogue[strings.ToLower(language)][key]
+	return template, found
+}
+var messageCatalogue = MessageCatalogue{}
+var messageTranslator MessageTranslator = messageCatalogue
+// SetMessageTranslator replaces the built-in message catalogue with the given translator,
+// e.g. an adapter over go-playground's universal translator.
+//
+// Messages the translator does not provide fall back to the default English catalogue
+func SetMessageTranslator(translator MessageTranslator) {
+	if translator == nil {
+		translator = messageCatalogue
+	}
+	messageTranslator = translator
+}
+// RegisterTranslation registers the template of the given message key for the given language in the built-in catalogue.
+// Has no effect on translators set via SetMessageTranslator
+func RegisterTranslation(language string, key string, template string) {
+	language = strings.ToLower(language)
+	if messageCatalogue[language] == nil {
+		messageCatalogue[language] = map[string]string{}
+	}
+	messageCatalogue[language][key] = template
+}
+// ValidationTagMessageKey returns the message key of the given validation tag, e.g. 'required'.
+//
+// Templates registered under this key take precedence over the generic field and value messages.
+// Placeholders: {field}, {tag}, {param}
+func ValidationTagMessageKey(tag string) string {
+	return "tag." + tag
+}
+// RegisterValidationTagTranslation registers the message used when the given validation tag fails, for the given language
+func RegisterValidationTagTranslation(language string, tag string, template string) {
+	RegisterTranslation(language, ValidationTagMessageKey(tag), template)
+}
+// getAcceptedLanguages returns the languages listed in the given Accept-Language header, most preferred first.
+// Regional variants are followed by their base language, e.g. 'fr-CA' by 'fr'
+func getAcceptedLanguages(header string) []string {
+	type weightedLanguage struct {
+		tag    string
+		weight float64
+	}
+	weightedLanguages := []weightedLanguage{}
+	for _, entry := range strings.Split(header, ",") {
+		parts := strings.Split(entry, ";")
+		tag := strings.TrimSpace(parts[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		weight := 1.0
+		for _, param := range parts[1:] {
+			if value, found := strings.CutPrefix(strings.TrimSpace(param), "q="); found {
+				if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+					weight = parsed
+				}
+			}
+		}
+		if weight > 0 {
+			weightedLanguages = append(weightedLanguages, weightedLanguage{tag: tag, weight: weight})
+		}
+	}
+	slices.SortStableFunc(weightedLanguages, func(a, b weightedLanguage) int {
+		if a.weight > b.weight {
+			return -1
+		}
+		if a.weight < b.weight {
+			return 1
+		}
+		return 0
+	})
+	languages := make([]string, 0, len(weightedLanguages))
+	for _, language := range weightedLanguages {
+		languages = append(languages, language.tag)
+		if base, _, hasRegion := strings.Cut(language.tag, "-"); hasRegion {
+			languages = append(languages, base)
+		}
+	}
+	return languages
+}
+// getMessageTemplate returns the template of the first of the given keys available in the most preferred of the given languages,
+// falling back to English and finally to the default English catalogue
+func getMessageTemplate(languages []string, keys ...string) string {
+	for _, language := range append(slices.Clip(languages), defaultMessageLanguage) {
+		for _, key := range keys {
+			if template, found := messageTranslator.Translate(language, key); found {
+				return template
+			}
+		}
+	}
+	for _, key := range keys {
+		if template, found := defaultMessages[key]; found {
+			return template
+		}
+	}
+	return ""
+}
+// translateMessage formats the template of the given keys in the given languages, replacing its placeholders with the given arguments
+func translateMessage(languages []string, args map[string]string, keys ...string) string {
+	replacements := make([]string, 0, len(args)*2)
+	for name, value := range args {
+		replacements = append(replacements, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(replacements...).Replace(getMessageTemplate(languages, keys...))
+}
+// ControllerFactory creates the controller instance handling a single request.
+//
+// The given context is the request's context
+type ControllerFactory[TController any] func(ctx context.Context) *TController
+var controllerFactories = map[reflect.Type]func(ctx context.Context) any{}
+// RegisterControllerFactory registers the factory used to create a controller for each request it handles.
+//
+// Factories allow controllers to hold injected dependencies such as database handles, services or loggers.
+// Must be called before RegisterRoutes; the factory must not return nil
+func RegisterControllerFactory[TController any](factory ControllerFactory[TController]) {
+	controllerFactories[reflect.TypeOf((*TController)(nil)).Elem()] = func(ctx context.Context) any {
+		return factory(ctx)
+	}
+}
+// createController creates a controller using its registered factory or, if none exists, its zero value
+func createController[TController any](ctx context.Context) *TController {
+	if factory, exists := controllerFactories[reflect.TypeOf((*TController)(nil)).Elem()]; exists {
+		return factory(ctx).(*TController)
+	}
+	return new(TController)
+}
+// ensureControllerFactoriesRegistered panics if any of the given controller types has no registered factory
+func ensureControllerFactoriesRegistered(controllerTypes []reflect.Type) {
+	missing := []string{}
+	for _, controllerType := range controllerTypes {
+		if _, registered := controllerFactories[controllerType]; !registered {
+			missing = append(missing, controllerType.String())
+		}
+	}
+	if len(missing) > 0 {
+		panic(fmt.Sprintf(
+			"controller factories are required but were never registered using RegisterControllerFactory: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
+// ErrorMapping describes the response returned when a controller's error matches a registered mapping
+type ErrorMapping struct {
+	// The HTTP status code to respond with. Must be declared by at least one @ErrorResponse annotation.
+	// The mapping applies only to operations declaring the status code - errors of other operations remain 500s
+	StatusCode int
+	// The RFC7807 title. Defaults to the status code's text
+	Title string
+	// A client-safe description of the error. Defaults to a generic, operation-specific message
+	Detail string
+}
+type registeredErrorMapping struct {
+	matches func(err error) bool
+	mapping ErrorMapping
+}
+var errorMappings = []registeredErrorMapping{}
+var declaredErrorStatusCodes = []int{404, 409, 500}
+// RegisterErrorMapping maps errors matching the given target, via errors.Is, to the given HTTP status code.
+//
+// Mappings are evaluated in order of registration and are superseded by an explicit call to the controller's SetStatus
+func RegisterErrorMapping(target error, statusCode int) {
+	RegisterDetailedErrorMapping(target, ErrorMapping{StatusCode: statusCode})
+}
+// RegisterDetailedErrorMapping maps errors matching the given target, via errors.Is, to the given response
+func RegisterDetailedErrorMapping(target error, mapping ErrorMapping) {
+	addErrorMapping(func(err error) bool { return errors.Is(err, target) }, mapping)
+}
+// RegisterErrorTypeMapping maps errors of type TError, matched via errors.As, to the given response
+func RegisterErrorTypeMapping[TError error](mapping ErrorMapping) {
+	addErrorMapping(func(err error) bool {
+		var target TError
+		return errors.As(err, &target)
+	}, mapping)
+}
+func addErrorMapping(matches func(err error) bool, mapping ErrorMapping) {
+	if !slices.Contains(declaredErrorStatusCodes, mapping.StatusCode) {
+		panic(fmt.Sprintf("Error mapping status code %d is not declared by any @ErrorResponse annotation", mapping.StatusCode))
+	}
+	errorMappings = append(errorMappings, registeredErrorMapping{matches: matches, mapping: mapping})
+}
+// getErrorMapping returns the first registered mapping matching err whose status code is among the given ones, declared by
+// the operation that produced err, or nil if there is none
+func getErrorMapping(err error, routeErrorStatusCodes []int) *ErrorMapping {
+	for _, registered := range errorMappings {
+		if slices.Contains(routeErrorStatusCodes, registered.mapping.StatusCode) && registered.matches(err) {
+			return &registered.mapping
+		}
+	}
+	return nil
+}
+// applyErrorMapping overrides the given RFC7807 error's title and detail with those of the mapping matching err, if any
+func applyErrorMapping(stdError *runtime.Rfc7807Error, err error, routeErrorStatusCodes []int) {
+	mapping := getErrorMapping(err, routeErrorStatusCodes)
+	if mapping == nil {
+		return
+	}
+	stdError.Title = http.StatusText(stdError.Status)
+	if mapping.Title != "" {
+		stdError.Title = mapping.Title
+	}
+	if mapping.Detail != "" {
+		stdError.Detail = mapping.Detail
+	}
+}
 // function declarations extension placeholder
+// DefaultApiVersion is the API version served to requests that do not specify one
+const DefaultApiVersion = 2
+// The request header specifying the requested API version
+const apiVersionHeader = "X-API-Version"
+// The handlers of the versioned routes registered by RegisterGroupRoutes, per method and URL and then per API version
+var versionedRouteHandlers map[string]map[int]gin.HandlerFunc
+// registerVersionedRoute registers the given handler for each of the given API versions.
+//
+// Routes sharing a method and a path are registered once and dispatched according to the requested API version
+func registerVersionedRoute(engine gin.IRouter, basePath string, method string, path string, versions []int, handler gin.HandlerFunc) {
+	url := toGinUrl(withBasePath(basePath, path))
+	handlers, exists := versionedRouteHandlers[method+" "+url]
+	if !exists {
+		handlers = map[int]gin.HandlerFunc{}
+		versionedRouteHandlers[method+" "+url] = handlers
+		engine.Handle(method, url, func(ginCtx *gin.Context) {
+			version, isValid := getRequestedApiVersion(ginCtx.GetHeader(apiVersionHeader))
+			versionHandler, isServed := handlers[version]
+			if !isValid || !isServed {
+				ginCtx.JSON(http.StatusNotFound, getUnservedApiVersionError(ginCtx.GetHeader(apiVersionHeader)))
+				return
+			}
+			versionHandler(ginCtx)
+		})
+	}
+	for _, version := range versions {
+		handlers[version] = handler
+	}
+}
+// getRequestedApiVersion returns the API version specified by the given header value, or DefaultApiVersion if none is specified.
+// Versions may be given with or without a 'v' prefix, e.g. '2' or 'v2'
+func getRequestedApiVersion(headerValue string) (int, bool) {
+	requested := strings.TrimSpace(headerValue)
+	if requested == "" {
+		return DefaultApiVersion, true
+	}
+	version, err := strconv.Atoi(strings.TrimPrefix(strings.ToLower(requested), "v"))
+	return version, err == nil
+}
+// getUnservedApiVersionError returns the RFC7807 error with which requests for an API version not served by a route are answered
+func getUnservedApiVersionError(headerValue string) runtime.Rfc7807Error {
+	return runtime.Rfc7807Error{
+		Type:       http.StatusText(http.StatusNotFound),
+		Detail:     fmt.Sprintf("The requested API version is not served by this route (%s: '%s')", apiVersionHeader, headerValue),
+		Status:     http.StatusNotFound,
+		Instance:   "/versioning/error",
+		Extensions: map[string]string{},
+	}
+}
+// RateLimit describes the number of requests an operation accepts per time window, as declared via @RateLimit
+type RateLimit struct {
+	// The number of requests allowed per window
+	Requests int
+	// The window's duration
+	Per time.Duration
+	// The key by which requests are counted - 'ip', 'principal' or 'header:<Name>'
+	Key string
+	// The scope within which requests are counted - the declaring controller's name for limits inherited from a controller,
+	// which are shared by all of its operations inheriting them, or the operation's ID for limits declared by the operation
+	Scope string
+}
+// RateLimiter decides whether requests may proceed under their operation's rate limit.
+//
+// Implementations must be safe for concurrent use
+type RateLimiter interface {
+	// Allow consumes a single request of the given key against the given operation's limit, counted within the limit's scope.
+	// Returns whether the request may proceed and, if not, how long until it may be retried
+	Allow(ctx context.Context, operationId string, key string, limit RateLimit) (bool, time.Duration)
+}
+// InMemoryRateLimiter is a RateLimiter holding a token bucket per limit scope and key in the process's memory.
+//
+// Each bucket holds up to the limit's number of requests and is refilled evenly over the limit's window.
+// Limits are not shared across instances - deployments with multiple instances should use a shared RateLimiter instead
+type InMemoryRateLimiter struct {
+	mutex     sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+type tokenBucket struct {
+	tokens          float64
+	capacity        float64
+	refillPerSecond float64
+	lastRefill      time.Time
+}
+// The interval at which an InMemoryRateLimiter drops buckets that have been refilled to capacity
+const rateLimitSweepInterval = time.Minute
+// NewInMemoryRateLimiter creates an InMemoryRateLimiter with no buckets
+func NewInMemoryRateLimiter() *InMemoryRateLimiter {
+	return &InMemoryRateLimiter{buckets: map[string]*tokenBucket{}, lastSweep: time.Now()}
+}
+func (limiter *InMemoryRateLimiter) Allow(_ context.Context, _ string, key string, limit RateLimit) (bool, time.Duration) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	now := time.Now()
+	limiter.sweep(now)
+	bucketKey := limit.Scope + "\x00" + key
+	bucket, exists := limiter.buckets[bucketKey]
+	if !exists {
+		capacity := float64(limit.Requests)
+		bucket = &tokenBucket{
+			tokens:          capacity,
+			capacity:        capacity,
+			refillPerSecond: capacity / limit.Per.Seconds(),
+			lastRefill:      now,
+		}
+		limiter.buckets[bucketKey] = bucket
+	}
+	bucket.tokens = bucket.tokensAt(now)
+	bucket.lastRefill = now
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - bucket.tokens) / bucket.refillPerSecond * float64(time.Second))
+}
+// sweep drops the buckets that have been refilled to capacity, as those are indistinguishable from new ones
+func (limiter *InMemoryRateLimiter) sweep(now time.Time) {
+	if now.Sub(limiter.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	for bucketKey, bucket := range limiter.buckets {
+		if bucket.tokensAt(now) >= bucket.capacity {
+			delete(limiter.buckets, bucketKey)
+		}
+	}
+	limiter.lastSweep = now
+}
+// tokensAt returns the number of tokens the bucket holds at the given time
+func (bucket *tokenBucket) tokensAt(now time.Time) float64 {
+	return math.Min(bucket.capacity, bucket.tokens+now.Sub(bucket.lastRefill).Seconds()*bucket.refillPerSecond)
+}
+var rateLimiter RateLimiter = NewInMemoryRateLimiter()
+// SetRateLimiter replaces the default InMemoryRateLimiter with the given limiter,
+// e.g. one backed by a store shared across instances
+func SetRateLimiter(limiter RateLimiter) {
+	if limiter == nil {
+		limiter = NewInMemoryRateLimiter()
+	}
+	rateLimiter = limiter
+}
+// newRateLimit creates an operation's RateLimit, counted within the given scope, out of its @RateLimit annotation's properties
+func newRateLimit(requests int, per string, key string, scope string) RateLimit {
+	window, err := time.ParseDuration(per)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid rate limit window '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			per,
+		))
+	}
+	return RateLimit{Requests: requests, Per: window, Key: key, Scope: scope}
+}
+// getRateLimitKey returns the value by which the request is counted against a rate limit with the given key.
+//
+// Requests lacking the key's header or an identifiable principal are counted by their client IP
+func getRateLimitKey(ginCtx *gin.Context, key string) string {
+	value := ""
+	if headerName, isHeaderKey := strings.CutPrefix(key, "header:"); isHeaderKey {
+		value = ginCtx.GetHeader(headerName)
+	} else if key == "principal" {
+		value, _ = describePrincipal(getRequestContext(ginCtx))
+	}
+	if value == "" {
+		return ginCtx.ClientIP()
+	}
+	return key + "=" + value
+}
+// enforceRateLimit counts the request against the given operation's rate limit.
+//
+// Requests exceeding the limit are answered with a 429 RFC7807 error and a 'Retry-After' header, in which case false is returned
+func enforceRateLimit(ginCtx *gin.Context, operationId string, limit RateLimit) bool {
+	allowed, retryAfter := rateLimiter.Allow(getRequestContext(ginCtx), operationId, getRateLimitKey(ginCtx, limit.Key), limit)
+	if allowed {
+		return true
+	}
+	ginCtx.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	ginCtx.JSON(http.StatusTooManyRequests, runtime.Rfc7807Error{
+		Type:     http.StatusText(http.StatusTooManyRequests),
+		Detail:   fmt.Sprintf("Too many requests were made to operation '%s'. Please retry later", operationId),
+		Status:   http.StatusTooManyRequests,
+		Instance: "/ratelimit/error/" + operationId,
+	})
+	return false
+}
+// newOperationTimeout creates an operation's deadline duration out of its @Timeout annotation or the configured default timeout
+func newOperationTimeout(timeout string) time.Duration {
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid operation timeout '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			timeout,
+		))
+	}
+	return duration
+}
+// applyOperationTimeout replaces the request's context with one that expires once the given timeout elapses.
+//
+// Operations are not interrupted when the deadline expires - controllers are expected to honor their context's cancellation.
+// The returned function releases the context's resources and must be called once the request has been handled
+func applyOperationTimeout(ginCtx *gin.Context, timeout time.Duration) context.CancelFunc {
+	ctx, cancel := context.WithTimeout(getRequestContext(ginCtx), timeout)
+	setRequestContext(ginCtx, ctx)
+	return cancel
+}
+// isDeadlineExceeded returns whether the request's context has expired due to its operation's timeout
+func isDeadlineExceeded(ginCtx *gin.Context) bool {
+	return errors.Is(getRequestContext(ginCtx).Err(), context.DeadlineExceeded)
+}
+// handleDeadlineExceeded answers a request whose operation's deadline has expired with an RFC7807 error of the given status -
+// 503 if the operation has not been invoked yet or 504 if it has
+func handleDeadlineExceeded(ginCtx *gin.Context, operationId string, status int) {
+	detail := fmt.Sprintf("The deadline of operation '%s' expired before the operation was invoked", operationId)
+	if status == http.StatusGatewayTimeout {
+		detail = fmt.Sprintf("Operation '%s' did not complete before its deadline expired", operationId)
+	}
+	ginCtx.JSON(status, runtime.Rfc7807Error{
+		Type:     http.StatusText(status),
+		Detail:   detail,
+		Status:   status,
+		Instance: "/timeout/error/" + operationId,
+	})
+}
+// enforceMaxBodySize checks the request's body against the given operation's maximum body size.
+//
+// Bodies of unknown length are read up-front, up to the maximum size.
+// Requests whose body exceeds the maximum size are answered with a 413 RFC7807 error, in which case false is returned
+func enforceMaxBodySize(ginCtx *gin.Context, operationId string, maxBodySize int64) bool {
+	bodySize := ginCtx.Request.ContentLength
+	if bodySize < 0 {
+		body, _ := io.ReadAll(io.LimitReader(ginCtx.Request.Body, maxBodySize+1))
+		ginCtx.Request.Body = io.NopCloser(bytes.NewReader(body))
+		bodySize = int64(len(body))
+	}
+	if bodySize <= maxBodySize {
+		return true
+	}
+	ginCtx.JSON(http.StatusRequestEntityTooLarge, runtime.Rfc7807Error{
+		Type:     http.StatusText(http.StatusRequestEntityTooLarge),
+		Detail:   fmt.Sprintf("The request body of operation '%s' exceeds the maximum size of %d bytes", operationId, maxBodySize),
+		Status:   http.StatusRequestEntityTooLarge,
+		Instance: "/body/error/" + operationId,
+	})
+	return false
+}
+// IdempotentResponse is a response of an idempotent operation, as stored to be replayed to repeated requests
+type IdempotentResponse struct {
+	// The response's HTTP status code
+	Status int
+	// The response's headers
+	Headers http.Header
+	// The response's body
+	Body []byte
+}
+// IdempotencyStore stores the responses of operations declared via @Idempotent, by request key.
+//
+// Implementations must be safe for concurrent use
+type IdempotencyStore interface {
+	// Reserve claims the given key for a new request with the given body hash, for the given duration.
+	// If the key has already been claimed, returns false along with the stored response or,
+	// if the original request is still in flight, a nil response.
+	// If the key has been claimed by a request with a different body hash, returns ErrIdempotencyKeyReused
+	Reserve(ctx context.Context, key string, requestHash string, ttl time.Duration) (bool, *IdempotentResponse, error)
+	// Complete stores the response of the request that claimed the given key, along with the request's body hash, for the given duration
+	Complete(ctx context.Context, key string, requestHash string, response IdempotentResponse, ttl time.Duration) error
+	// Release drops the claim of a request that did not produce a replayable response, so that it may be retried
+	Release(ctx context.Context, key string) error
+}
+// ErrIdempotencyKeyReused is returned by an IdempotencyStore when a key is reused by a request with a different body
+var ErrIdempotencyKeyReused = errors.New("the idempotency key has already been used by a request with a different body")
+// InMemoryIdempotencyStore is an IdempotencyStore holding responses in the process's memory.
+//
+// Responses are not shared across instances - deployments with multiple instances should use a shared IdempotencyStore instead
+type InMemoryIdempotencyStore struct {
+	mutex     sync.Mutex
+	entries   map[string]*idempotencyEntry
+	lastSweep time.Time
+}
+type idempotencyEntry struct {
+	// The stored response. Nil while the request is in flight
+	response *IdempotentResponse
+	// The hash of the body of the request that claimed the entry's key
+	requestHash string
+	expiresAt   time.Time
+}
+// The interval at which an InMemoryIdempotencyStore drops expired entries
+const idempotencySweepInterval = time.Minute
+// NewInMemoryIdempotencyStore creates an InMemoryIdempotencyStore with no entries
+func NewInMemoryIdempotencyStore() *InMemoryIdempotencyStore {
+	return &InMemoryIdempotencyStore{entries: map[string]*idempotencyEntry{}, lastSweep: time.Now()}
+}
+func (store *InMemoryIdempotencyStore) Reserve(_ context.Context, key string, requestHash string, ttl time.Duration) (bool, *IdempotentResponse, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	now := time.Now()
+	store.sweep(now)
+	if entry, exists := store.entries[key]; exists && now.Before(entry.expiresAt) {
+		if entry.requestHash != requestHash {
+			return false, nil, ErrIdempotencyKeyReused
+		}
+		return false, entry.response, nil
+	}
+	store.entries[key] = &idempotencyEntry{requestHash: requestHash, expiresAt: now.Add(ttl)}
+	return true, nil, nil
+}
+func (store *InMemoryIdempotencyStore) Complete(_ context.Context, key string, requestHash string, response IdempotentResponse, ttl time.Duration) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.entries[key] = &idempotencyEntry{response: &response, requestHash: requestHash, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+func (store *InMemoryIdempotencyStore) Release(_ context.Context, key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.entries, key)
+	return nil
+}
+// sweep drops the entries that have expired
+func (store *InMemoryIdempotencyStore) sweep(now time.Time) {
+	if now.Sub(store.lastSweep) < idempotencySweepInterval {
+		return
+	}
+	for key, entry := range store.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(store.entries, key)
+		}
+	}
+	store.lastSweep = now
+}
+var idempotencyStore IdempotencyStore = NewInMemoryIdempotencyStore()
+// SetIdempotencyStore replaces the default InMemoryIdempotencyStore with the given store,
+// e.g. one backed by a database shared across instances
+func SetIdempotencyStore(store IdempotencyStore) {
+	if store == nil {
+		store = NewInMemoryIdempotencyStore()
+	}
+	idempotencyStore = store
+}
+// newIdempotencyTtl creates an operation's response retention duration out of its @Idempotent annotation's 'ttl' property
+func newIdempotencyTtl(ttl string) time.Duration {
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid idempotency TTL '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			ttl,
+		))
+	}
+	return duration
+}
+// idempotentResponseRecorder records the body written to the response so it may be stored once the operation completes
+type idempotentResponseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+func (recorder *idempotentResponseRecorder) Write(data []byte) (int, error) {
+	recorder.body.Write(data)
+	return recorder.ResponseWriter.Write(data)
+}
+func (recorder *idempotentResponseRecorder) WriteString(data string) (int, error) {
+	recorder.body.WriteString(data)
+	return recorder.ResponseWriter.WriteString(data)
+}
+// The maximum size, in bytes, of the request bodies hashed by idempotent operations without a @MaxBodySize limit
+const defaultMaxIdempotentBodySize = 10 << 20
+// hashRequestBody returns the hex encoded SHA-256 hash of the request's body. The body remains readable.
+//
+// Requests whose body exceeds the given maximum size, or defaultMaxIdempotentBodySize if zero,
+// are answered with a 413 RFC7807 error, in which case false is returned
+func hashRequestBody(ginCtx *gin.Context, operationId string, maxBodySize int64) (string, bool) {
+	if maxBodySize <= 0 {
+		maxBodySize = defaultMaxIdempotentBodySize
+	}
+	body, _ := io.ReadAll(io.LimitReader(ginCtx.Request.Body, maxBodySize+1))
+	ginCtx.Request.Body = io.NopCloser(bytes.NewReader(body))
+	if int64(len(body)) > maxBodySize {
+		writeIdempotencyError(ginCtx, operationId, http.StatusRequestEntityTooLarge, fmt.Sprintf("The request body of operation '%s' exceeds the maximum size of %d bytes", operationId, maxBodySize))
+		return "", false
+	}
+	hash := sha256.Sum256(body)
+	return hex.EncodeToString(hash[:]), true
+}
+// beginIdempotentRequest claims the request's 'Idempotency-Key' header, scoped to the given operation and the request's principal.
+//
+// If the operation may proceed, returns a function storing its response, which must be deferred.
+// Otherwise, the request is answered - with the stored response if the key has been used before,
+// or with an RFC7807 error if the header is missing (400), the original request is still in flight (409),
+// the principal lacks a stable identity (500)
+// or the key has been used by a request with a different body (422) - and nil is returned
+func beginIdempotentRequest(ginCtx *gin.Context, operationId string, requestHash string, ttl time.Duration) func() {
+	idempotencyKey := ginCtx.GetHeader("Idempotency-Key")
+	if idempotencyKey == "" {
+		writeIdempotencyError(
+			ginCtx,
+			operationId,
+			http.StatusBadRequest,
+			fmt.Sprintf("Operation '%s' requires an 'Idempotency-Key' header", operationId),
+		)
+		return nil
+	}
+	ctx := getRequestContext(ginCtx)
+	// Keys are scoped by principal so that they may not collide across clients. Principals lacking a stable identity are
+	// rejected, as their requests could otherwise never be recognized as repeated
+	principalIdentity, isIdentifiable := describePrincipal(ctx)
+	if !isIdentifiable {
+		writeIdempotencyError(
+			ginCtx,
+			operationId,
+			http.StatusInternalServerError,
+			fmt.Sprintf("The principal of operation '%s' lacks a stable identity to scope its 'Idempotency-Key' by", operationId),
+		)
+		return nil
+	}
+	key := operationId + "\x00" + principalIdentity + "\x00" + idempotencyKey
+	reserved, response, err := idempotencyStore.Reserve(ctx, key, requestHash, ttl)
+	switch {
+	case errors.Is(err, ErrIdempotencyKeyReused):
+		writeIdempotencyError(
+			ginCtx,
+			operationId,
+			http.StatusUnprocessableEntity,
+			fmt.Sprintf("The 'Idempotency-Key' has already been used by a request to operation '%s' with a different body", operationId),
+		)
+		return nil
+	case err != nil:
+		writeIdempotencyError(
+			ginCtx,
+			operationId,
+			http.StatusInternalServerError,
+			fmt.Sprintf("Failed to process the 'Idempotency-Key' header of operation '%s'", operationId),
+		)
+		return nil
+	case !reserved && response == nil:
+		writeIdempotencyError(
+			ginCtx,
+			operationId,
+			http.StatusConflict,
+			fmt.Sprintf("A request to operation '%s' with the same 'Idempotency-Key' is still being processed", operationId),
+		)
+		return nil
+	case !reserved:
+		for name, values := range response.Headers {
+			ginCtx.Writer.Header()[name] = values
+		}
+		ginCtx.Header("Idempotent-Replayed", "true")
+		ginCtx.Status(response.Status)
+		ginCtx.Writer.Write(response.Body)
+		return nil
+	}
+	recorder := &idempotentResponseRecorder{ResponseWriter: ginCtx.Writer}
+	ginCtx.Writer = recorder
+	return func() {
+		// Requests that panicked or failed on the server's side are not replayed so they may be retried
+		recovered := recover()
+		if recovered != nil || !recorder.Written() || recorder.Status() >= http.StatusInternalServerError {
+			idempotencyStore.Release(context.WithoutCancel(ctx), key)
+			if recovered != nil {
+				panic(recovered)
+			}
+			return
+		}
+		idempotencyStore.Complete(
+			context.WithoutCancel(ctx),
+			key,
+			requestHash,
+			IdempotentResponse{Status: recorder.Status(), Headers: recorder.Header().Clone(), Body: recorder.body.Bytes()},
+			ttl,
+		)
+	}
+}
+// writeIdempotencyError answers a request to an idempotent operation with an RFC7807 error of the given status
+func writeIdempotencyError(ginCtx *gin.Context, operationId string, status int, detail string) {
+	ginCtx.JSON(status, runtime.Rfc7807Error{
+		Type:     http.StatusText(status),
+		Detail:   detail,
+		Status:   status,
+		Instance: "/idempotency/error/" + operationId,
+	})
+}
 type MiddlewareFunc func(ctx context.Context, ginCtx *gin.Context) (context.Context, bool)
 type ErrorMiddlewareFunc func(ctx context.Context, ginCtx *gin.Context, err error) (context.Context, bool)
 var beforeOperationMiddlewares []MiddlewareFunc
@@ -303,16 +1307,50 @@ func RegisterErrorMiddleware(executionType runtime.ErrorMiddlewareExecutionType,
 		onErrorMiddlewares = append(onErrorMiddlewares, errorMiddlewareFunc)
 	}
 }
+var namedMiddlewares = map[string]MiddlewareFunc{}
+// RegisterNamedMiddleware registers a middleware that controllers and routes may reference by name using @Middleware annotations.
+//
+// Named middlewares must be registered before RegisterRoutes is called
+func RegisterNamedMiddleware(name string, middlewareFunc MiddlewareFunc) {
+	namedMiddlewares[name] = middlewareFunc
+}
+func ensureNamedMiddlewaresRegistered(names []string) {
+	missing := []string{}
+	for _, name := range names {
+		if _, registered := namedMiddlewares[name]; !registered {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		panic(fmt.Sprintf(
+			"middlewares referenced via @Middleware annotations were never registered using RegisterNamedMiddleware: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
 func RegisterCustomValidator(validateTagName string, validateFunc runtime.ValidationFunc) {
 	validatorInstance.RegisterValidation(validateTagName, func(fl validator.FieldLevel) bool {
 		return validateFunc(fl)
 	})
 }
+// BasePath is the path under which RegisterRoutes mounts the API's routes
+const BasePath = ""
+// RegisterRoutes registers the API's routes on the given engine, under BasePath
 func RegisterRoutes(engine *gin.Engine) {
+	RegisterGroupRoutes(engine, BasePath)
+}
+// RegisterGroupRoutes registers the API's routes on the given router or group, e.g. a *gin.RouterGroup, under the given base path.
+//
+// The base path is relative to the router's own prefix.
+// For the OpenAPI specification to match the served routes, the two should add up to BasePath
+func RegisterGroupRoutes(engine gin.IRouter, basePath string) {
 	urlParamRegex = regexp.MustCompile(`\{([\w\d-_]+)\}`)
+	versionedRouteHandlers = map[string]map[int]gin.HandlerFunc{}
+	ensureNamedMiddlewaresRegistered([]string{"audit", "trace"})
 	// register routes extension placeholder
 	// E2EClassSecController
-	engine.GET(toGinUrl("/e2e/with-default-class-security"), func(ginCtx *gin.Context) {
+	e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes := []int{}
+	e2EclassSecControllerWithDefaultClassSecurityHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -334,7 +1372,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "WithDefaultClassSecurity")
 			return
 		}
-		controller := E2EClassSecController.E2EClassSecController{}
+		controller := createController[E2EClassSecController.E2EClassSecController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
@@ -354,9 +1392,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -374,7 +1414,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -392,6 +1432,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/WithDefaultClassSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -409,8 +1450,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/with-default-override-class-security"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/with-default-class-security")), e2EclassSecControllerWithDefaultClassSecurityHandler)
+	e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes := []int{}
+	e2EclassSecControllerWithOverrideClassSecurityHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -432,7 +1475,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "WithOverrideClassSecurity")
 			return
 		}
-		controller := E2EClassSecController.E2EClassSecController{}
+		controller := createController[E2EClassSecController.E2EClassSecController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
@@ -452,9 +1495,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -472,7 +1517,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -490,6 +1535,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/WithOverrideClassSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -507,9 +1553,11 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/with-default-override-class-security")), e2EclassSecControllerWithOverrideClassSecurityHandler)
 	// E2EController
-	engine.GET(toGinUrl("/e2e/simple-get"), func(ginCtx *gin.Context) {
+	e2EcontrollerSimpleGetErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -531,7 +1579,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "SimpleGet")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -548,7 +1596,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -566,6 +1614,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/SimpleGet",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -583,8 +1632,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/simple-get-empty-string"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/simple-get")), e2EcontrollerSimpleGetHandler)
+	e2EcontrollerSimpleGetEmptyStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyStringHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -606,7 +1657,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "SimpleGetEmptyString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -623,7 +1674,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -641,6 +1692,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/SimpleGetEmptyString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -658,8 +1710,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/simple-get-ptr-string"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/simple-get-empty-string")), e2EcontrollerSimpleGetEmptyStringHandler)
+	e2EcontrollerSimpleGetPtrStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetPtrStringHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -681,7 +1735,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "SimpleGetPtrString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -698,7 +1752,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -716,6 +1770,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/SimpleGetPtrString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -733,8 +1788,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/simple-get-null-string"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/simple-get-ptr-string")), e2EcontrollerSimpleGetPtrStringHandler)
+	e2EcontrollerSimpleGetNullStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetNullStringHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -756,7 +1813,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "SimpleGetNullString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -773,7 +1830,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -791,6 +1848,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/SimpleGetNullString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -808,8 +1866,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/simple-get-object"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/simple-get-null-string")), e2EcontrollerSimpleGetNullStringHandler)
+	e2EcontrollerNamedMiddlewaresErrorStatusCodes := []int{}
+	e2EcontrollerNamedMiddlewaresHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -828,10 +1888,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "SimpleGetObject")
+			handleAuthorizationError(ginCtx, authErr, "NamedMiddlewares")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -842,13 +1902,23 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
+		routeMiddlewares := []MiddlewareFunc{namedMiddlewares["audit"], namedMiddlewares["trace"]}
+		// Middlewares routeMiddlewares section
+		for _, middleware := range routeMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares routeMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.SimpleGetObject()
+		value, opError := controller.NamedMiddlewares()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -861,11 +1931,12 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObject'",
+				Detail:     "Encountered an error during operation 'NamedMiddlewares'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetObject",
+				Instance:   "/controller/error/NamedMiddlewares",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -883,8 +1954,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/simple-get-object-ptr"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/named-middlewares")), e2EcontrollerNamedMiddlewaresHandler)
+	e2EcontrollerSimpleGetObjectErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -903,10 +1976,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "SimpleGetObjectPtr")
+			handleAuthorizationError(ginCtx, authErr, "SimpleGetObject")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -918,12 +1991,12 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.SimpleGetObjectPtr()
+		value, opError := controller.SimpleGetObject()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -936,11 +2009,12 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObjectPtr'",
+				Detail:     "Encountered an error during operation 'SimpleGetObject'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetObjectPtr",
+				Instance:   "/controller/error/SimpleGetObject",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -958,8 +2032,88 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/simple-get-object-null"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/simple-get-object")), e2EcontrollerSimpleGetObjectHandler)
+	e2EcontrollerSimpleGetObjectPtrErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectPtrHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "SimpleGetObjectPtr")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SimpleGetObjectPtr()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObjectPtr'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetObjectPtr",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/simple-get-object-ptr")), e2EcontrollerSimpleGetObjectPtrHandler)
+	e2EcontrollerSimpleGetObjectNullErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectNullHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -981,7 +2135,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "SimpleGetObjectNull")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -998,7 +2152,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1016,6 +2170,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/SimpleGetObjectNull",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -1033,8 +2188,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/primitive-return-type"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/simple-get-object-null")), e2EcontrollerSimpleGetObjectNullHandler)
+	e2EcontrollerPrimitiveReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveReturnTypeHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -1056,7 +2213,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "PrimitiveReturnType")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1073,7 +2230,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1091,6 +2248,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/PrimitiveReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -1108,8 +2266,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/primitive-array-return-type"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/primitive-return-type")), e2EcontrollerPrimitiveReturnTypeHandler)
+	e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveArrayReturnTypeHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -1131,7 +2291,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "PrimitiveArrayReturnType")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1148,7 +2308,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1166,6 +2326,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/PrimitiveArrayReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -1183,8 +2344,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/primitive-alias-return-type"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/primitive-array-return-type")), e2EcontrollerPrimitiveArrayReturnTypeHandler)
+	e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasReturnTypeHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -1206,7 +2369,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "PrimitiveAliasReturnType")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1223,7 +2386,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1241,6 +2404,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/PrimitiveAliasReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -1258,8 +2422,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/primitive-alias-array-return-type"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/primitive-alias-return-type")), e2EcontrollerPrimitiveAliasReturnTypeHandler)
+	e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasArrayReturnTypeHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -1281,7 +2447,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "PrimitiveAliasArrayReturnType")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1298,7 +2464,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1316,6 +2482,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/PrimitiveAliasArrayReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -1333,8 +2500,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/simple-get-empty"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/primitive-alias-array-return-type")), e2EcontrollerPrimitiveAliasArrayReturnTypeHandler)
+	e2EcontrollerSimpleGetEmptyErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -1356,7 +2525,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "SimpleGetEmpty")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var queryParamRawPtr *string = nil
 		queryParamRaw, isqueryParamExists := ginCtx.GetQuery("queryParam")
@@ -1375,9 +2544,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -1395,7 +2566,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1413,6 +2584,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/SimpleGetEmpty",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -1430,8 +2602,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.Status(statusCode)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/get-with-all-params/{pathParam}"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/simple-get-empty")), e2EcontrollerSimpleGetEmptyHandler)
+	e2EcontrollerGetWithAllParamsErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -1453,7 +2627,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "GetWithAllParams")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var queryParamRawPtr *string = nil
 		queryParamRaw, isqueryParamExists := ginCtx.GetQuery("queryParam")
@@ -1472,9 +2646,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var pathParamRawPtr *string = nil
@@ -1494,9 +2670,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var headerParamRawPtr *string = nil
@@ -1517,9 +2695,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -1537,7 +2717,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1555,6 +2735,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/GetWithAllParams",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -1572,8 +2753,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/get-with-all-params-ptr/{pathParam}"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")), e2EcontrollerGetWithAllParamsHandler)
+	e2EcontrollerGetWithAllParamsPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsPtrHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -1595,7 +2778,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "GetWithAllParamsPtr")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var queryParamRawPtr *string = nil
 		queryParamRaw, isqueryParamExists := ginCtx.GetQuery("queryParam")
@@ -1620,9 +2803,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var headerParamRawPtr *string = nil
@@ -1647,7 +2832,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1665,6 +2850,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/GetWithAllParamsPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -1682,8 +2868,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/get-with-all-params-required-ptr/{pathParam}"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsPtrHandler)
+	e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsRequiredPtrHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -1705,7 +2893,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "GetWithAllParamsRequiredPtr")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var queryParamRawPtr *string = nil
 		queryParamRaw, isqueryParamExists := ginCtx.GetQuery("queryParam")
@@ -1724,9 +2912,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var pathParamRawPtr *string = nil
@@ -1746,9 +2936,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var headerParamRawPtr *string = nil
@@ -1769,9 +2961,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -1789,7 +2983,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1807,6 +3001,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/GetWithAllParamsRequiredPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -1824,8 +3019,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/post-with-all-params-body"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsRequiredPtrHandler)
+	e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -1847,7 +3044,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "PostWithAllParamsWithBody")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var conversionErr error
 		var queryParamRawPtr *string = nil
@@ -1867,9 +3064,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var headerParamRawPtr *string = nil
@@ -1890,9 +3089,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var theBodyRawPtr *Param5theBody.BodyInfo = nil
@@ -1907,19 +3108,25 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBody' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBody",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBody",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -1937,7 +3144,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1955,6 +3162,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/PostWithAllParamsWithBody",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -1972,8 +3180,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/post-with-all-params-body-ptr"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/post-with-all-params-body")), e2EcontrollerPostWithAllParamsWithBodyHandler)
+	e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyPtrHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -1995,7 +3205,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "PostWithAllParamsWithBodyPtr")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var conversionErr error
 		var queryParamRawPtr *string = nil
@@ -2023,19 +3233,25 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBodyPtr' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBodyPtr",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -2053,7 +3269,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2071,6 +3287,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/PostWithAllParamsWithBodyPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -2088,8 +3305,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/post-with-all-params-body-required-ptr"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-ptr")), e2EcontrollerPostWithAllParamsWithBodyPtrHandler)
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -2111,7 +3330,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "PostWithAllParamsWithBodyRequiredPtr")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var conversionErr error
 		var theBodyRawPtr *Param5theBody.BodyInfo = nil
@@ -2126,19 +3345,25 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBodyRequiredPtr' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyRequiredPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBodyRequiredPtr",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -2156,7 +3381,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2174,6 +3399,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/PostWithAllParamsWithBodyRequiredPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -2191,8 +3417,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/get-header-start-with-letter"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-required-ptr")), e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler)
+	e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes := []int{}
+	e2EcontrollerGetHeaderStartWithLetterHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -2214,7 +3442,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "GetHeaderStartWithLetter")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := ginCtx.GetHeader("headerParam")
@@ -2234,9 +3462,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -2254,7 +3484,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2272,6 +3502,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/GetHeaderStartWithLetter",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -2289,8 +3520,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/with-default-config-security"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/get-header-start-with-letter")), e2EcontrollerGetHeaderStartWithLetterHandler)
+	e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithDefaultConfigSecurityHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -2312,7 +3545,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "WithDefaultConfigSecurity")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
@@ -2332,9 +3565,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -2352,7 +3587,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2370,6 +3605,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/WithDefaultConfigSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -2387,8 +3623,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/with-one-security"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/with-default-config-security")), e2EcontrollerWithDefaultConfigSecurityHandler)
+	e2EcontrollerWithOneSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithOneSecurityHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -2410,7 +3648,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "WithOneSecurity")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
@@ -2430,9 +3668,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -2450,7 +3690,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2468,6 +3708,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/WithOneSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -2485,8 +3726,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/with-two-security"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/with-one-security")), e2EcontrollerWithOneSecurityHandler)
+	e2EcontrollerWithTwoSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecurityHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -2520,7 +3763,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "WithTwoSecurity")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
@@ -2540,9 +3783,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -2560,7 +3805,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2578,6 +3823,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/WithTwoSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -2595,8 +3841,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/with-two-security-same-method"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/with-two-security")), e2EcontrollerWithTwoSecurityHandler)
+	e2EcontrollerWithAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithAdvancedSecurityHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -2610,16 +3858,21 @@ func RegisterRoutes(engine *gin.Engine) {
 								"other",
 							},
 						},
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+							},
+						},
 					},
 				},
 				{
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName",
+							SchemaName: "securitySchemaName3",
 							Scopes: []string{
-								"write",
-								"read",
+								"admin",
 							},
 						},
 					},
@@ -2627,10 +3880,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "WithTwoSecuritySameMethod")
+			handleAuthorizationError(ginCtx, authErr, "WithAdvancedSecurity")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
@@ -2650,9 +3903,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithAdvancedSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -2665,12 +3920,12 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
+		value, opError := controller.WithAdvancedSecurity(*headerParamRawPtr)
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2683,11 +3938,12 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
+				Detail:     "Encountered an error during operation 'WithAdvancedSecurity'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithTwoSecuritySameMethod",
+				Instance:   "/controller/error/WithAdvancedSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -2705,8 +3961,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/default-error"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/with-advanced-security")), e2EcontrollerWithAdvancedSecurityHandler)
+	e2EcontrollerWithPrincipalErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -2715,9 +3973,9 @@ func RegisterRoutes(engine *gin.Engine) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName2",
+							SchemaName: "securitySchemaName",
 							Scopes: []string{
-								"config",
+								"other",
 							},
 						},
 					},
@@ -2725,11 +3983,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "DefaultError")
+			handleAuthorizationError(ginCtx, authErr, "WithPrincipal")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(ginCtx))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			handleAuthorizationError(ginCtx, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipal")
+			return
+		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2740,12 +4007,12 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		opError := controller.DefaultError()
+		value, opError := controller.WithPrincipal(principalRawPtr)
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2758,11 +4025,12 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DefaultError'",
+				Detail:     "Encountered an error during operation 'WithPrincipal'",
 				Status:     statusCode,
-				Instance:   "/controller/error/DefaultError",
+				Instance:   "/controller/error/WithPrincipal",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -2778,10 +4046,12 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
 		// after operation routes extension placeholder
-		ginCtx.Status(statusCode)
+		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/default-error-with-payload"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/with-principal")), e2EcontrollerWithPrincipalHandler)
+	e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalAdvancedSecurityHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -2789,10 +4059,27 @@ func RegisterRoutes(engine *gin.Engine) {
 				{
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
 						{
 							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"config",
+								"write",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName3",
+							Scopes: []string{
+								"admin",
 							},
 						},
 					},
@@ -2800,11 +4087,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "DefaultErrorWithPayload")
+			handleAuthorizationError(ginCtx, authErr, "WithPrincipalAdvancedSecurity")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(ginCtx))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			handleAuthorizationError(ginCtx, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipalAdvancedSecurity")
+			return
+		}
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2815,12 +4111,12 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.DefaultErrorWithPayload()
+		value, opError := controller.WithPrincipalAdvancedSecurity(principalRawPtr)
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2833,11 +4129,1701 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
+				Detail:     "Encountered an error during operation 'WithPrincipalAdvancedSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithPrincipalAdvancedSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/with-principal-advanced-security")), e2EcontrollerWithPrincipalAdvancedSecurityHandler)
+	e2EcontrollerPublicRouteErrorStatusCodes := []int{}
+	e2EcontrollerPublicRouteHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PublicRoute()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PublicRoute'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PublicRoute",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/public")), e2EcontrollerPublicRouteHandler)
+	e2EcontrollerHeadCheckErrorStatusCodes := []int{}
+	e2EcontrollerHeadCheckHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "HeadCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.HeadCheck()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'HeadCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/HeadCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.Status(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/head-check")), e2EcontrollerHeadCheckHandler)
+	e2EcontrollerOptionsCheckErrorStatusCodes := []int{}
+	e2EcontrollerOptionsCheckHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "OptionsCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.OptionsCheck()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'OptionsCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/OptionsCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	engine.Handle("OPTIONS", toGinUrl(withBasePath(basePath, "/e2e/options-check")), e2EcontrollerOptionsCheckHandler)
+	e2EcontrollerTraceCheckErrorStatusCodes := []int{}
+	e2EcontrollerTraceCheckHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "TraceCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.TraceCheck()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TraceCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TraceCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	engine.Handle("TRACE", toGinUrl(withBasePath(basePath, "/e2e/trace-check")), e2EcontrollerTraceCheckHandler)
+	e2EcontrollerPurgeCheckErrorStatusCodes := []int{}
+	e2EcontrollerPurgeCheckHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "PurgeCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PurgeCheck()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PurgeCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PurgeCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	engine.Handle("PURGE", toGinUrl(withBasePath(basePath, "/e2e/purge-check")), e2EcontrollerPurgeCheckHandler)
+	e2EcontrollerVersionedV1ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV1Handler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "VersionedV1")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.VersionedV1()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV1'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV1",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	e2EcontrollerVersionedV1Versions := []int{1}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV1Versions, e2EcontrollerVersionedV1Handler)
+	e2EcontrollerVersionedV2ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV2Handler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "VersionedV2")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.VersionedV2()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV2'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV2",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	e2EcontrollerVersionedV2Versions := []int{2}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV2Versions, e2EcontrollerVersionedV2Handler)
+	e2EcontrollerRateLimitedErrorStatusCodes := []int{}
+	e2EcontrollerRateLimitedRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "RateLimited")
+	e2EcontrollerRateLimitedHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		if !enforceRateLimit(ginCtx, "RateLimited", e2EcontrollerRateLimitedRateLimit) {
+			return
+		}
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "RateLimited")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.RateLimited()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'RateLimited'",
+				Status:     statusCode,
+				Instance:   "/controller/error/RateLimited",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/rate-limited")), e2EcontrollerRateLimitedHandler)
+	e2EcontrollerTimedOutErrorStatusCodes := []int{}
+	e2EcontrollerTimedOutTimeout := newOperationTimeout("50ms")
+	e2EcontrollerTimedOutHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		cancelTimeout := applyOperationTimeout(ginCtx, e2EcontrollerTimedOutTimeout)
+		defer cancelTimeout()
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "TimedOut")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		if isDeadlineExceeded(ginCtx) {
+			handleDeadlineExceeded(ginCtx, "TimedOut", http.StatusServiceUnavailable)
+			return
+		}
+		value, opError := controller.TimedOut(getRequestContext(ginCtx))
+		if isDeadlineExceeded(ginCtx) {
+			handleDeadlineExceeded(ginCtx, "TimedOut", http.StatusGatewayTimeout)
+			return
+		}
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTimedOutErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TimedOut'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TimedOut",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTimedOutErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/timeout")), e2EcontrollerTimedOutHandler)
+	e2EcontrollerMaxBodySizeErrorStatusCodes := []int{}
+	e2EcontrollerMaxBodySizeHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		if !enforceMaxBodySize(ginCtx, "MaxBodySize", 64) {
+			return
+		}
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "MaxBodySize")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		var conversionErr error
+		var dataRawPtr *Param8data.ObjectWithByteSlice = nil
+		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, conversionErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "MaxBodySize",
+						"parameter": "data",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/MaxBodySize",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.MaxBodySize(*dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MaxBodySize'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MaxBodySize",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/max-body-size")), e2EcontrollerMaxBodySizeHandler)
+	e2EcontrollerIdempotentErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "Idempotent")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed := hashRequestBody(ginCtx, "Idempotent", 0)
+		if !isBodyHashed {
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		completeIdempotentRequest := beginIdempotentRequest(ginCtx, "Idempotent", idempotentRequestHash, e2EcontrollerIdempotentIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return
+		}
+		defer completeIdempotentRequest()
+		value, opError := controller.Idempotent()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Idempotent'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Idempotent",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/idempotent")), e2EcontrollerIdempotentHandler)
+	e2EcontrollerIdempotentWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentWithBodyIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentWithBodyHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "IdempotentWithBody")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed := hashRequestBody(ginCtx, "IdempotentWithBody", 0)
+		if !isBodyHashed {
+			return
+		}
+		var conversionErr error
+		var dataRawPtr *Param5data.BodyInfo = nil
+		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, conversionErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "IdempotentWithBody",
+						"parameter": "data",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/IdempotentWithBody",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		completeIdempotentRequest := beginIdempotentRequest(ginCtx, "IdempotentWithBody", idempotentRequestHash, e2EcontrollerIdempotentWithBodyIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return
+		}
+		defer completeIdempotentRequest()
+		value, opError := controller.IdempotentWithBody(*dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'IdempotentWithBody'",
+				Status:     statusCode,
+				Instance:   "/controller/error/IdempotentWithBody",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/idempotent-with-body")), e2EcontrollerIdempotentWithBodyHandler)
+	e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecuritySameMethodHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"write",
+								"read",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "WithTwoSecuritySameMethod")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		var headerParamRawPtr *string = nil
+		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
+		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-test-scopes")]
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			// validation error response extension placeholder
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithTwoSecuritySameMethod",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/with-two-security-same-method")), e2EcontrollerWithTwoSecuritySameMethodHandler)
+	e2EcontrollerDefaultErrorErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "DefaultError")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.DefaultError()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DefaultError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DefaultError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.Status(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/default-error")), e2EcontrollerDefaultErrorHandler)
+	e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorWithPayloadHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "DefaultErrorWithPayload")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.DefaultErrorWithPayload()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DefaultErrorWithPayload",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/default-error-with-payload")), e2EcontrollerDefaultErrorWithPayloadHandler)
+	e2EcontrollerInjectedDependencyErrorStatusCodes := []int{}
+	e2EcontrollerInjectedDependencyHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "InjectedDependency")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.InjectedDependency()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'InjectedDependency'",
+				Status:     statusCode,
+				Instance:   "/controller/error/InjectedDependency",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/injected-dependency")), e2EcontrollerInjectedDependencyHandler)
+	e2EcontrollerMappedErrorErrorStatusCodes := []int{404}
+	e2EcontrollerMappedErrorHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "MappedError")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.MappedError()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerMappedErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MappedError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MappedError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedErrorErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.Status(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/mapped-error")), e2EcontrollerMappedErrorHandler)
+	e2EcontrollerUndeclaredMappedErrorErrorStatusCodes := []int{}
+	e2EcontrollerUndeclaredMappedErrorHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "UndeclaredMappedError")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.UndeclaredMappedError()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'UndeclaredMappedError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/UndeclaredMappedError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.Status(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/undeclared-mapped-error")), e2EcontrollerUndeclaredMappedErrorHandler)
+	e2EcontrollerMappedTypedErrorErrorStatusCodes := []int{409}
+	e2EcontrollerMappedTypedErrorHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "MappedTypedError")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.MappedTypedError()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MappedTypedError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MappedTypedError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/mapped-typed-error")), e2EcontrollerMappedTypedErrorHandler)
+	e2EcontrollerPanicErrorStatusCodes := []int{}
+	e2EcontrollerPanicHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "Panic")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.Panic()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPanicErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Panic'",
 				Status:     statusCode,
-				Instance:   "/controller/error/DefaultErrorWithPayload",
+				Instance:   "/controller/error/Panic",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPanicErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -2855,8 +5841,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/custom-error"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/panic")), e2EcontrollerPanicHandler)
+	e2EcontrollerCustomErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomErrorHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -2878,7 +5866,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "CustomError")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2895,8 +5883,8 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		emptyErr := Response6CustomError.CustomError{}
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomErrorErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
 		if opError != emptyErr {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2923,8 +5911,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.Status(statusCode)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/custom-error-ptr"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/custom-error")), e2EcontrollerCustomErrorHandler)
+	e2EcontrollerCustomPtrErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomPtrErrorHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -2946,7 +5936,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "CustomPtrError")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2963,7 +5953,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomPtrErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2990,8 +5980,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.Status(statusCode)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/503-error-code"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/custom-error-ptr")), e2EcontrollerCustomPtrErrorHandler)
+	e2EcontrollerError503ErrorStatusCodes := []int{}
+	e2EcontrollerError503Handler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -3013,7 +6005,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "Error503")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3030,7 +6022,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerError503ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3048,6 +6040,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/Error503",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerError503ErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3065,8 +6058,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.Status(statusCode)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/custom-error-503"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/503-error-code")), e2EcontrollerError503Handler)
+	e2EcontrollerCustomError503ErrorStatusCodes := []int{}
+	e2EcontrollerCustomError503Handler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -3088,7 +6083,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "CustomError503")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3105,8 +6100,8 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		emptyErr := Response6CustomError.CustomError{}
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomError503ErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
 		if opError != emptyErr {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3133,8 +6128,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.Status(statusCode)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/context-access"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/custom-error-503")), e2EcontrollerCustomError503Handler)
+	e2EcontrollerContextAccessErrorStatusCodes := []int{}
+	e2EcontrollerContextAccessHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -3156,7 +6153,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ContextAccess")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3173,7 +6170,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextAccessErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3191,6 +6188,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ContextAccess",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextAccessErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3208,8 +6206,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.Status(statusCode)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/http-method"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/context-access")), e2EcontrollerContextAccessHandler)
+	e2EcontrollerGetErrorStatusCodes := []int{}
+	e2EcontrollerGetHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -3231,7 +6231,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "Get")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3248,7 +6248,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerGetErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3266,6 +6266,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/Get",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3283,8 +6284,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.Status(statusCode)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/http-method"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerGetHandler)
+	e2EcontrollerPostErrorStatusCodes := []int{}
+	e2EcontrollerPostHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -3306,7 +6309,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "Post")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3323,7 +6326,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPostErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3341,6 +6344,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/Post",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3358,8 +6362,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.Status(statusCode)
 		// route end routes extension placeholder
-	})
-	engine.PUT(toGinUrl("/e2e/http-method"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPostHandler)
+	e2EcontrollerPutErrorStatusCodes := []int{}
+	e2EcontrollerPutHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -3381,7 +6387,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "Put")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3398,7 +6404,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPutErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3416,6 +6422,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/Put",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPutErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3433,8 +6440,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.Status(statusCode)
 		// route end routes extension placeholder
-	})
-	engine.DELETE(toGinUrl("/e2e/http-method"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("PUT", toGinUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPutHandler)
+	e2EcontrollerDeleteErrorStatusCodes := []int{}
+	e2EcontrollerDeleteHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -3456,7 +6465,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "Delete")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3473,7 +6482,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDeleteErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3491,6 +6500,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/Delete",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeleteErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3508,8 +6518,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.Status(statusCode)
 		// route end routes extension placeholder
-	})
-	engine.PATCH(toGinUrl("/e2e/http-method"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("DELETE", toGinUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerDeleteHandler)
+	e2EcontrollerPatchErrorStatusCodes := []int{}
+	e2EcontrollerPatchHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -3531,7 +6543,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "Patch")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3548,7 +6560,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPatchErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3566,6 +6578,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/Patch",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPatchErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3583,8 +6596,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.Status(statusCode)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/template-context-1"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("PATCH", toGinUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPatchHandler)
+	e2EcontrollerTemplateContext1ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext1Handler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -3606,7 +6621,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TemplateContext1")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3623,7 +6638,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3641,6 +6656,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TemplateContext1",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3658,8 +6674,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.GET(toGinUrl("/e2e/template-context-2"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/template-context-1")), e2EcontrollerTemplateContext1Handler)
+	e2EcontrollerTemplateContext2ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext2Handler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -3681,7 +6699,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TemplateContext2")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3698,7 +6716,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3716,6 +6734,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TemplateContext2",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3733,8 +6752,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/form"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/template-context-2")), e2EcontrollerTemplateContext2Handler)
+	e2EcontrollerTestFormErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -3756,7 +6777,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TestForm")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var item1RawPtr *string = nil
 		item1Raw, isitem1Exists := ginCtx.GetPostForm("item1")
@@ -3775,9 +6796,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item1"
-			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var item2RawPtr *string = nil
@@ -3797,9 +6820,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item2"
-			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -3817,7 +6842,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3835,6 +6860,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TestForm",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3852,8 +6878,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/form-extra"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/form")), e2EcontrollerTestFormHandler)
+	e2EcontrollerTestFormExtraErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormExtraHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -3875,12 +6903,12 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TestFormExtra")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var item1RawPtr *int64 = nil
 		item1Raw, isitem1Exists := ginCtx.GetPostForm("item1")
 		if isitem1Exists {
-			item1Uint64, conversionErr := strconv.ParseInt(item1Raw, 10, 64)
+			item1, conversionErr := parseIntegerParam[int64](item1Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -3891,23 +6919,20 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestFormExtra' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"item1",
-						"int64",
-						reflect.TypeOf(item1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestFormExtra",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item1",
+					"form",
+					"/item1",
+					"int64",
+					reflect.TypeOf(item1Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			item1 := int64(item1Uint64)
 			item1RawPtr = &item1
 		}
 		if validatorErr := validatorInstance.Var(item1RawPtr, "required,gte=80"); validatorErr != nil {
@@ -3921,9 +6946,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item1"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var item2RawPtr *string = nil
@@ -3943,15 +6970,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item2"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var item3RawPtr *int64 = nil
 		item3Raw, isitem3Exists := ginCtx.GetQuery("item3")
 		if isitem3Exists {
-			item3Uint64, conversionErr := strconv.ParseInt(item3Raw, 10, 64)
+			item3, conversionErr := parseIntegerParam[int64](item3Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -3962,23 +6991,20 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestFormExtra' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"item3",
-						"int64",
-						reflect.TypeOf(item3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestFormExtra",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item3",
+					"query",
+					"/item3",
+					"int64",
+					reflect.TypeOf(item3Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			item3 := int64(item3Uint64)
 			item3RawPtr = &item3
 		}
 		if validatorErr := validatorInstance.Var(item3RawPtr, "required,gte=80"); validatorErr != nil {
@@ -3992,9 +7018,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item3"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/item3", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -4012,7 +7040,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4030,6 +7058,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TestFormExtra",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -4047,8 +7076,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/test-response-validation"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/form-extra")), e2EcontrollerTestFormExtraHandler)
+	e2EcontrollerTestResponseValidationErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -4070,7 +7101,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TestResponseValidation")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4087,7 +7118,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4105,6 +7136,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TestResponseValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -4122,8 +7154,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/test-response-validation-ptr"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/test-response-validation")), e2EcontrollerTestResponseValidationHandler)
+	e2EcontrollerTestResponseValidationPtrErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationPtrHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -4145,7 +7179,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TestResponseValidationPtr")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4162,7 +7196,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4180,6 +7214,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TestResponseValidationPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -4197,8 +7232,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/test-response-validation-null"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/test-response-validation-ptr")), e2EcontrollerTestResponseValidationPtrHandler)
+	e2EcontrollerTestResponseValidationNullErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationNullHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -4220,7 +7257,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TestResponseValidationNull")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4237,7 +7274,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4255,6 +7292,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TestResponseValidationNull",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -4272,8 +7310,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/test-primitive-conversions"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/test-response-validation-null")), e2EcontrollerTestResponseValidationNullHandler)
+	e2EcontrollerTestPrimitiveConversionsErrorStatusCodes := []int{500}
+	e2EcontrollerTestPrimitiveConversionsHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -4295,12 +7335,12 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TestPrimitiveConversions")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var value1RawPtr *int64 = nil
 		value1Raw, isvalue1Exists := ginCtx.GetQuery("value1")
 		if isvalue1Exists {
-			value1Uint64, conversionErr := strconv.ParseInt(value1Raw, 10, 64)
+			value1, conversionErr := parseIntegerParam[int64](value1Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4311,23 +7351,20 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"int64",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value1",
+					"query",
+					"/value1",
+					"int64",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value1 := int64(value1Uint64)
 			value1RawPtr = &value1
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -4341,15 +7378,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value2RawPtr *bool = nil
 		value2Raw, isvalue2Exists := ginCtx.GetQuery("value2")
 		if isvalue2Exists {
-			value2Bool, conversionErr := strconv.ParseBool(value2Raw)
+			value2, conversionErr := strconv.ParseBool(value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4360,23 +7399,20 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"bool",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value2",
+					"query",
+					"/value2",
+					"bool",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value2 := value2Bool
 			value2RawPtr = &value2
 		}
 		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
@@ -4390,15 +7426,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value3RawPtr *int = nil
 		value3Raw, isvalue3Exists := ginCtx.GetQuery("value3")
 		if isvalue3Exists {
-			value3Uint64, conversionErr := strconv.Atoi(value3Raw)
+			value3, conversionErr := parseIntegerParam[int](value3Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4409,23 +7447,20 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value3",
-						"int",
-						reflect.TypeOf(value3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value3",
+					"query",
+					"/value3",
+					"int",
+					reflect.TypeOf(value3Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value3 := int(value3Uint64)
 			value3RawPtr = &value3
 		}
 		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
@@ -4439,15 +7474,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value3"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value3", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value4RawPtr *float64 = nil
 		value4Raw, isvalue4Exists := ginCtx.GetQuery("value4")
 		if isvalue4Exists {
-			value4Float64, conversionErr := strconv.ParseFloat(value4Raw, 64)
+			value4, conversionErr := parseFloatParam[float64](value4Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4458,23 +7495,20 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value4",
-						"float64",
-						reflect.TypeOf(value4Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value4",
+					"query",
+					"/value4",
+					"float64",
+					reflect.TypeOf(value4Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value4 := float64(value4Float64)
 			value4RawPtr = &value4
 		}
 		if validatorErr := validatorInstance.Var(value4RawPtr, "required"); validatorErr != nil {
@@ -4488,9 +7522,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value4"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value4", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -4508,7 +7544,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4526,6 +7562,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TestPrimitiveConversions",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -4543,8 +7580,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/test-enums"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/test-primitive-conversions")), e2EcontrollerTestPrimitiveConversionsHandler)
+	e2EcontrollerTestEnumsErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -4566,14 +7605,14 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TestEnums")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var conversionErr error
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw, isvalue1Exists := ginCtx.GetQuery("value1")
 		if isvalue1Exists {
 			value1 := value1Raw
-			value1Var := Param12value1.StatusEnumeration(value1)
+			value1Var := Param15value1.StatusEnumeration(value1)
 			value1RawPtr = &value1Var
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -4587,15 +7626,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value2RawPtr *Param4value2.NumberEnumeration = nil
 		value2Raw, isvalue2Exists := ginCtx.GetQuery("value2")
 		if isvalue2Exists {
-			value2Uint64, conversionErr := strconv.Atoi(value2Raw)
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4606,23 +7647,20 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnums' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnums",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value2",
+					"query",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value2 := int(value2Uint64)
 			value2Var := Param4value2.NumberEnumeration(value2)
 			value2RawPtr = &value2Var
 		}
@@ -4637,12 +7675,14 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var value3RawPtr *Param11value3.ObjectWithEnum = nil
+		var value3RawPtr *Param14value3.ObjectWithEnum = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &value3RawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -4654,19 +7694,25 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'TestEnums' but body parameter '%s' did not pass validation of '%s' - %s",
-					"value3",
-					"ObjectWithEnum",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "TestEnums",
+						"parameter": "value3",
+						"type":      "ObjectWithEnum",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/TestEnums",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -4684,7 +7730,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4702,6 +7748,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TestEnums",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -4719,8 +7766,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/test-enums-in-all/{value1}"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/test-enums")), e2EcontrollerTestEnumsHandler)
+	e2EcontrollerTestEnumsInAllErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsInAllHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -4742,13 +7791,13 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TestEnumsInAll")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw, isvalue1Exists := ginCtx.Params.Get("value1")
 		if isvalue1Exists {
 			value1 := value1Raw
-			value1Var := Param12value1.StatusEnumeration(value1)
+			value1Var := Param15value1.StatusEnumeration(value1)
 			value1RawPtr = &value1Var
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -4762,16 +7811,18 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/value1", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value2RawPtr *Param4value2.NumberEnumeration = nil
 		value2Raw := ginCtx.GetHeader("value2")
 		_, isvalue2Exists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("value2")]
 		if isvalue2Exists {
-			value2Uint64, conversionErr := strconv.Atoi(value2Raw)
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4782,23 +7833,20 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value2",
+					"header",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value2 := int(value2Uint64)
 			value2Var := Param4value2.NumberEnumeration(value2)
 			value2RawPtr = &value2Var
 		}
@@ -4813,16 +7861,18 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/value2", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var value3RawPtr *Param12value3.StatusEnumeration = nil
+		var value3RawPtr *Param15value3.StatusEnumeration = nil
 		value3Raw, isvalue3Exists := ginCtx.GetPostForm("value3")
 		if isvalue3Exists {
 			value3 := value3Raw
-			value3Var := Param12value3.StatusEnumeration(value3)
+			value3Var := Param15value3.StatusEnumeration(value3)
 			value3RawPtr = &value3Var
 		}
 		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
@@ -4836,9 +7886,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value3"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/value3", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -4856,7 +7908,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4874,6 +7926,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TestEnumsInAll",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -4891,8 +7944,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/test-enums-optional"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/test-enums-in-all/{value1}")), e2EcontrollerTestEnumsInAllHandler)
+	e2EcontrollerTestEnumsOptionalErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsOptionalHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -4914,14 +7969,14 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TestEnumsOptional")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw := ginCtx.GetHeader("value1")
 		_, isvalue1Exists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("value1")]
 		if isvalue1Exists {
 			value1 := value1Raw
-			value1Var := Param12value1.StatusEnumeration(value1)
+			value1Var := Param15value1.StatusEnumeration(value1)
 			value1RawPtr = &value1Var
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -4939,7 +7994,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4957,6 +8012,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TestEnumsOptional",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -4974,8 +8030,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/external-packages"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/test-enums-optional")), e2EcontrollerTestEnumsOptionalHandler)
+	e2EcontrollerExternalPackagesErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -4997,17 +8055,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ExternalPackages")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var conversionErr error
-		var unitRawPtr *Param14unit.LengthUnits = nil
+		var unitRawPtr *Param17unit.LengthUnits = nil
 		unitRaw, isunitExists := ginCtx.GetQuery("unit")
 		if isunitExists {
 			unit := unitRaw
-			unitVar := Param14unit.LengthUnits(unit)
+			unitVar := Param17unit.LengthUnits(unit)
 			unitRawPtr = &unitVar
 		}
-		var dataRawPtr *Param13data.LengthDto = nil
+		var dataRawPtr *Param16data.LengthDto = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5019,19 +8077,25 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackages' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"LengthDto",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackages",
+						"parameter": "data",
+						"type":      "LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackages",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -5049,7 +8113,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5067,6 +8131,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ExternalPackages",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -5084,8 +8149,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/external-packages-unique-in-struct"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/external-packages")), e2EcontrollerExternalPackagesHandler)
+	e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesUniqueInStructHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -5107,10 +8174,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ExternalPackagesUniqueInStruct")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var conversionErr error
-		var dataRawPtr *Param15data.UniqueExternalUsage = nil
+		var dataRawPtr *Param18data.UniqueExternalUsage = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5122,19 +8189,25 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackagesUniqueInStruct' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"UniqueExternalUsage",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesUniqueInStruct",
+						"parameter": "data",
+						"type":      "UniqueExternalUsage",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackagesUniqueInStruct",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -5152,7 +8225,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5170,6 +8243,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ExternalPackagesUniqueInStruct",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -5187,8 +8261,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/external-packages-validation"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/external-packages-unique-in-struct")), e2EcontrollerExternalPackagesUniqueInStructHandler)
+	e2EcontrollerExternalPackagesValidationErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesValidationHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -5210,17 +8286,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ExternalPackagesValidation")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var conversionErr error
-		var unitRawPtr *Param14unit.LengthUnits = nil
+		var unitRawPtr *Param17unit.LengthUnits = nil
 		unitRaw, isunitExists := ginCtx.GetQuery("unit")
 		if isunitExists {
 			unit := unitRaw
-			unitVar := Param14unit.LengthUnits(unit)
+			unitVar := Param17unit.LengthUnits(unit)
 			unitRawPtr = &unitVar
 		}
-		var dataRawPtr *Param16data.LengthDtoWithValidation = nil
+		var dataRawPtr *Param19data.LengthDtoWithValidation = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5232,19 +8308,25 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackagesValidation' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"LengthDtoWithValidation",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesValidation",
+						"parameter": "data",
+						"type":      "LengthDtoWithValidation",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackagesValidation",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -5262,7 +8344,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5280,6 +8362,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ExternalPackagesValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -5297,8 +8380,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/arrays-in-body-and-res"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/external-packages-validation")), e2EcontrollerExternalPackagesValidationHandler)
+	e2EcontrollerArraysInBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInBodyAndResHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -5320,10 +8405,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ArraysInBodyAndRes")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var conversionErr error
-		var dataRawPtr *[]Param13data.LengthDto = nil
+		var dataRawPtr *[]Param16data.LengthDto = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5335,19 +8420,25 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ArraysInBodyAndRes' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[]LengthDto",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInBodyAndRes",
+						"parameter": "data",
+						"type":      "[]LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ArraysInBodyAndRes",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -5365,7 +8456,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5383,6 +8474,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ArraysInBodyAndRes",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -5400,8 +8492,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/arrays-inside-body-and-res"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/arrays-in-body-and-res")), e2EcontrollerArraysInBodyAndResHandler)
+	e2EcontrollerArraysInsideBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInsideBodyAndResHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -5423,10 +8517,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ArraysInsideBodyAndRes")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var conversionErr error
-		var dataRawPtr *[]Param17data.BlaBla = nil
+		var dataRawPtr *[]Param20data.BlaBla = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5438,19 +8532,25 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ArraysInsideBodyAndRes' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[]BlaBla",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInsideBodyAndRes",
+						"parameter": "data",
+						"type":      "[]BlaBla",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ArraysInsideBodyAndRes",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -5468,7 +8568,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5486,6 +8586,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ArraysInsideBodyAndRes",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -5503,8 +8604,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/deep-arrays-with-validation"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/arrays-inside-body-and-res")), e2EcontrollerArraysInsideBodyAndResHandler)
+	e2EcontrollerDeepArraysWithValidationErrorStatusCodes := []int{500}
+	e2EcontrollerDeepArraysWithValidationHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -5526,10 +8629,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "DeepArraysWithValidation")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var conversionErr error
-		var dataRawPtr *[][]Param18data.BlaBla2 = nil
+		var dataRawPtr *[][]Param21data.BlaBla2 = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5541,19 +8644,25 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'DeepArraysWithValidation' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[][]BlaBla2",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "DeepArraysWithValidation",
+						"parameter": "data",
+						"type":      "[][]BlaBla2",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/DeepArraysWithValidation",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -5571,7 +8680,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5589,6 +8698,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/DeepArraysWithValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -5606,8 +8716,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/embedded-structs"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/deep-arrays-with-validation")), e2EcontrollerDeepArraysWithValidationHandler)
+	e2EcontrollerEmbeddedStructsErrorStatusCodes := []int{500}
+	e2EcontrollerEmbeddedStructsHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -5629,10 +8741,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "EmbeddedStructs")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var conversionErr error
-		var dataRawPtr *Param19data.TheModel = nil
+		var dataRawPtr *Param22data.TheModel = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5644,19 +8756,25 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'EmbeddedStructs' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModel",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "EmbeddedStructs",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/EmbeddedStructs",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -5674,7 +8792,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5692,6 +8810,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/EmbeddedStructs",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -5709,8 +8828,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/structs-with-inner-pointer"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/embedded-structs")), e2EcontrollerEmbeddedStructsHandler)
+	e2EcontrollerStructsWithInnerPointerErrorStatusCodes := []int{500}
+	e2EcontrollerStructsWithInnerPointerHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -5732,10 +8853,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "StructsWithInnerPointer")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var conversionErr error
-		var dataRawPtr *Param20data.TheModelWithInnerPointer = nil
+		var dataRawPtr *Param23data.TheModelWithInnerPointer = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5747,19 +8868,25 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'StructsWithInnerPointer' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModelWithInnerPointer",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "StructsWithInnerPointer",
+						"parameter": "data",
+						"type":      "TheModelWithInnerPointer",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/StructsWithInnerPointer",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -5777,7 +8904,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5795,6 +8922,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/StructsWithInnerPointer",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -5812,8 +8940,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/context-injection-empty"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/structs-with-inner-pointer")), e2EcontrollerStructsWithInnerPointerHandler)
+	e2EcontrollerContextInjectionEmptyErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionEmptyHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -5835,7 +8965,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ContextInjectionEmpty")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -5852,7 +8982,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5870,6 +9000,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ContextInjectionEmpty",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -5887,8 +9018,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.Status(statusCode)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/context-injection"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/context-injection-empty")), e2EcontrollerContextInjectionEmptyHandler)
+	e2EcontrollerContextInjectionErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -5910,10 +9043,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ContextInjection")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var conversionErr error
-		var dataRawPtr *Param19data.TheModel = nil
+		var dataRawPtr *Param22data.TheModel = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5925,19 +9058,25 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ContextInjection' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModel",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ContextInjection",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ContextInjection",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -5955,7 +9094,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5973,6 +9112,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ContextInjection",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -5990,8 +9130,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.Status(statusCode)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/byte-slice"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/context-injection")), e2EcontrollerContextInjectionHandler)
+	e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithByteSliceHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -6013,10 +9155,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ReturnsStructWithByteSlice")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var conversionErr error
-		var arriveRawPtr *Param22arrive.ObjectWithByteSlice = nil
+		var arriveRawPtr *Param8arrive.ObjectWithByteSlice = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "", &arriveRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6028,19 +9170,25 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ReturnsStructWithByteSlice' but body parameter '%s' did not pass validation of '%s' - %s",
-					"arrive",
-					"ObjectWithByteSlice",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithByteSlice",
+						"parameter": "arrive",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ReturnsStructWithByteSlice",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -6058,7 +9206,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6076,6 +9224,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ReturnsStructWithByteSlice",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -6093,8 +9242,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/special-primitives"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/byte-slice")), e2EcontrollerReturnsStructWithByteSliceHandler)
+	e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithSpecialPrimitivesHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -6116,10 +9267,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ReturnsStructWithSpecialPrimitives")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var conversionErr error
-		var arriveRawPtr *Param23arrive.ObjectWithSpecialPrimitives = nil
+		var arriveRawPtr *Param24arrive.ObjectWithSpecialPrimitives = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "", &arriveRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6131,19 +9282,25 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ReturnsStructWithSpecialPrimitives' but body parameter '%s' did not pass validation of '%s' - %s",
-					"arrive",
-					"ObjectWithSpecialPrimitives",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithSpecialPrimitives",
+						"parameter": "arrive",
+						"type":      "ObjectWithSpecialPrimitives",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ReturnsStructWithSpecialPrimitives",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -6161,7 +9318,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6179,6 +9336,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ReturnsStructWithSpecialPrimitives",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -6196,8 +9354,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/alias-of-primitive"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/special-primitives")), e2EcontrollerReturnsStructWithSpecialPrimitivesHandler)
+	e2EcontrollerAliasOfStringErrorStatusCodes := []int{}
+	e2EcontrollerAliasOfStringHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -6219,10 +9379,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "AliasOfString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var conversionErr error
-		var objectRawPtr *Param24object.ObjectWithAliasOfString = nil
+		var objectRawPtr *Param25object.ObjectWithAliasOfString = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &objectRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6234,25 +9394,31 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'AliasOfString' but body parameter '%s' did not pass validation of '%s' - %s",
-					"object",
-					"ObjectWithAliasOfString",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "AliasOfString",
+						"parameter": "object",
+						"type":      "ObjectWithAliasOfString",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/AliasOfString",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var numRawPtr *Param25num.AliasOfInt = nil
+		var numRawPtr *Param26num.AliasOfInt = nil
 		numRaw, isnumExists := ginCtx.GetQuery("num")
 		if isnumExists {
-			numUint64, conversionErr := strconv.Atoi(numRaw)
+			num, conversionErr := parseIntegerParam[int](numRaw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -6263,24 +9429,21 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'AliasOfString' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"num",
-						"AliasOfInt",
-						reflect.TypeOf(numRaw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/AliasOfString",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"AliasOfString",
+					"num",
+					"query",
+					"/num",
+					"AliasOfInt",
+					reflect.TypeOf(numRaw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				// params validation error response extension placeholder
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			num := int(numUint64)
-			numVar := Param25num.AliasOfInt(num)
+			numVar := Param26num.AliasOfInt(num)
 			numRawPtr = &numVar
 		}
 		if validatorErr := validatorInstance.Var(numRawPtr, "required"); validatorErr != nil {
@@ -6294,16 +9457,18 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "num"
-			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/num", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var strRawPtr *Param26str.AliasOfDirectString = nil
+		var strRawPtr *Param27str.AliasOfDirectString = nil
 		strRaw, isstrExists := ginCtx.GetQuery("str")
 		if isstrExists {
 			str := strRaw
-			strVar := Param26str.AliasOfDirectString(str)
+			strVar := Param27str.AliasOfDirectString(str)
 			strRawPtr = &strVar
 		}
 		if validatorErr := validatorInstance.Var(strRawPtr, "required"); validatorErr != nil {
@@ -6317,9 +9482,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "str"
-			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/str", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -6337,7 +9504,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6355,6 +9522,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/AliasOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -6372,8 +9540,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/body-array-of-string"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/alias-of-primitive")), e2EcontrollerAliasOfStringHandler)
+	e2EcontrollerBodyArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -6395,7 +9565,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "BodyArrayOfString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var conversionErr error
 		var valuesRawPtr *[]string = nil
@@ -6410,19 +9580,25 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'BodyArrayOfString' but body parameter '%s' did not pass validation of '%s' - %s",
-					"values",
-					"[]string",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfString",
+						"parameter": "values",
+						"type":      "[]string",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/BodyArrayOfString",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -6440,7 +9616,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6458,6 +9634,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/BodyArrayOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -6475,8 +9652,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/body-array-of-enum-string"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/body-array-of-string")), e2EcontrollerBodyArrayOfStringHandler)
+	e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringEnumHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -6498,10 +9677,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "BodyArrayOfStringEnum")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var conversionErr error
-		var valuesRawPtr *[]Param27values.Myemamium = nil
+		var valuesRawPtr *[]Param28values.Myemamium = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &valuesRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6513,19 +9692,25 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'BodyArrayOfStringEnum' but body parameter '%s' did not pass validation of '%s' - %s",
-					"values",
-					"[]Myemamium",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfStringEnum",
+						"parameter": "values",
+						"type":      "[]Myemamium",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/BodyArrayOfStringEnum",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -6543,7 +9728,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6561,6 +9746,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/BodyArrayOfStringEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -6578,8 +9764,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/query-array-of-string"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/body-array-of-enum-string")), e2EcontrollerBodyArrayOfStringEnumHandler)
+	e2EcontrollerQueryArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfStringHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -6601,7 +9789,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "QueryArrayOfString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var valuesRawPtr *[]string = nil
 		valuesRawArray, isvaluesExists := ginCtx.GetQueryArray("values")
@@ -6625,9 +9813,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -6645,7 +9835,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6663,6 +9853,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/QueryArrayOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -6680,8 +9871,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/query-array-of-enum"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/query-array-of-string")), e2EcontrollerQueryArrayOfStringHandler)
+	e2EcontrollerQueryArrayOfEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfEnumHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -6703,18 +9896,18 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "QueryArrayOfEnum")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var valuesRawPtr *[]Param27values.Myemamium = nil
+		var valuesRawPtr *[]Param28values.Myemamium = nil
 		valuesRawArray, isvaluesExists := ginCtx.GetQueryArray("values")
 		if isvaluesExists {
-			values := make([]Param27values.Myemamium, 0, len(valuesRawArray))
+			values := make([]Param28values.Myemamium, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
 				valuesItem := valuesRaw
-				values = append(values, Param27values.Myemamium(valuesItem))
+				values = append(values, Param28values.Myemamium(valuesItem))
 			}
 			valuesRawPtr = &values
-			valuesVar := []Param27values.Myemamium(values)
+			valuesVar := []Param28values.Myemamium(values)
 			valuesRawPtr = &valuesVar
 		}
 		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
@@ -6728,21 +9921,23 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var values2RawPtr *[]Param28values2.MyaliasString = nil
+		var values2RawPtr *[]Param29values2.MyaliasString = nil
 		values2RawArray, isvalues2Exists := ginCtx.GetQueryArray("values2")
 		if isvalues2Exists {
-			values2 := make([]Param28values2.MyaliasString, 0, len(values2RawArray))
+			values2 := make([]Param29values2.MyaliasString, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
 				values2Item := values2Raw
-				values2 = append(values2, Param28values2.MyaliasString(values2Item))
+				values2 = append(values2, Param29values2.MyaliasString(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param28values2.MyaliasString(values2)
+			values2Var := []Param29values2.MyaliasString(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -6756,9 +9951,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -6776,7 +9973,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6794,6 +9991,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/QueryArrayOfEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -6811,8 +10009,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/query-array-of-others"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/query-array-of-enum")), e2EcontrollerQueryArrayOfEnumHandler)
+	e2EcontrollerQueryArrayOfOthersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -6834,14 +10034,14 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "QueryArrayOfOthers")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var valuesRawPtr *[]int = nil
 		valuesRawArray, isvaluesExists := ginCtx.GetQueryArray("values")
 		if isvaluesExists {
 			values := make([]int, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
-				valuesUint64, conversionErr := strconv.Atoi(valuesRaw)
+				valuesItem, conversionErr := parseIntegerParam[int](valuesRaw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -6852,23 +10052,20 @@ func RegisterRoutes(engine *gin.Engine) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]int",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values",
+						"query",
+						"/values",
+						"[]int",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+					)
 					// params validation error response extension placeholder
-					ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				valuesItem := int(valuesUint64)
 				values = append(values, int(valuesItem))
 			}
 			valuesRawPtr = &values
@@ -6885,17 +10082,19 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var values2RawPtr *[]Param29values2.MyaliasInt = nil
+		var values2RawPtr *[]Param30values2.MyaliasInt = nil
 		values2RawArray, isvalues2Exists := ginCtx.GetQueryArray("values2")
 		if isvalues2Exists {
-			values2 := make([]Param29values2.MyaliasInt, 0, len(values2RawArray))
+			values2 := make([]Param30values2.MyaliasInt, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
-				values2Uint64, conversionErr := strconv.Atoi(values2Raw)
+				values2Item, conversionErr := parseIntegerParam[int](values2Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -6906,27 +10105,24 @@ func RegisterRoutes(engine *gin.Engine) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values2",
-							"[]MyaliasInt",
-							reflect.TypeOf(values2Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values2",
+						"query",
+						"/values2",
+						"[]MyaliasInt",
+						reflect.TypeOf(values2Raw).String(),
+						getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+					)
 					// params validation error response extension placeholder
-					ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				values2Item := int(values2Uint64)
-				values2 = append(values2, Param29values2.MyaliasInt(values2Item))
+				values2 = append(values2, Param30values2.MyaliasInt(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param29values2.MyaliasInt(values2)
+			values2Var := []Param30values2.MyaliasInt(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -6940,9 +10136,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var values3RawPtr *[]bool = nil
@@ -6950,7 +10148,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if isvalues3Exists {
 			values3 := make([]bool, 0, len(values3RawArray))
 			for _, values3Raw := range values3RawArray {
-				values3Bool, conversionErr := strconv.ParseBool(values3Raw)
+				values3Item, conversionErr := strconv.ParseBool(values3Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -6961,23 +10159,20 @@ func RegisterRoutes(engine *gin.Engine) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values3",
-							"[]bool",
-							reflect.TypeOf(values3Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values3",
+						"query",
+						"/values3",
+						"[]bool",
+						reflect.TypeOf(values3Raw).String(),
+						getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+					)
 					// params validation error response extension placeholder
-					ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				values3Item := values3Bool
 				values3 = append(values3, bool(values3Item))
 			}
 			values3RawPtr = &values3
@@ -6994,9 +10189,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values3"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values3", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var values4RawPtr *[]int32 = nil
@@ -7004,7 +10201,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if isvalues4Exists {
 			values4 := make([]int32, 0, len(values4RawArray))
 			for _, values4Raw := range values4RawArray {
-				values4Uint64, conversionErr := strconv.ParseInt(values4Raw, 10, 32)
+				values4Item, conversionErr := parseIntegerParam[int32](values4Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7015,23 +10212,20 @@ func RegisterRoutes(engine *gin.Engine) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values4",
-							"[]int32",
-							reflect.TypeOf(values4Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values4",
+						"query",
+						"/values4",
+						"[]int32",
+						reflect.TypeOf(values4Raw).String(),
+						getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+					)
 					// params validation error response extension placeholder
-					ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				values4Item := int32(values4Uint64)
 				values4 = append(values4, int32(values4Item))
 			}
 			values4RawPtr = &values4
@@ -7048,9 +10242,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values4"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values4", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -7068,7 +10264,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7086,6 +10282,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/QueryArrayOfOthers",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -7103,8 +10300,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/query-array-of-others-enum"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/query-array-of-others")), e2EcontrollerQueryArrayOfOthersHandler)
+	e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersEnumHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -7126,14 +10325,14 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "QueryArrayOfOthersEnum")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var valuesRawPtr *[]Param31values.NumberEnum = nil
+		var valuesRawPtr *[]Param32values.NumberEnum = nil
 		valuesRawArray, isvaluesExists := ginCtx.GetQueryArray("values")
 		if isvaluesExists {
-			values := make([]Param31values.NumberEnum, 0, len(valuesRawArray))
+			values := make([]Param32values.NumberEnum, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
-				valuesUint64, conversionErr := strconv.ParseInt(valuesRaw, 10, 16)
+				valuesItem, conversionErr := parseIntegerParam[int16](valuesRaw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7144,27 +10343,24 @@ func RegisterRoutes(engine *gin.Engine) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthersEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]NumberEnum",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthersEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values",
+						"query",
+						"/values",
+						"[]NumberEnum",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+					)
 					// params validation error response extension placeholder
-					ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				valuesItem := int16(valuesUint64)
-				values = append(values, Param31values.NumberEnum(valuesItem))
+				values = append(values, Param32values.NumberEnum(valuesItem))
 			}
 			valuesRawPtr = &values
-			valuesVar := []Param31values.NumberEnum(values)
+			valuesVar := []Param32values.NumberEnum(values)
 			valuesRawPtr = &valuesVar
 		}
 		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
@@ -7178,21 +10374,23 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var values2RawPtr *[]Param32values2.BoolEnum = nil
+		var values2RawPtr *[]Param33values2.BoolEnum = nil
 		values2RawArray, isvalues2Exists := ginCtx.GetQueryArray("values2")
 		if isvalues2Exists {
-			values2 := make([]Param32values2.BoolEnum, 0, len(values2RawArray))
+			values2 := make([]Param33values2.BoolEnum, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
 				values2Item := values2Raw
-				values2 = append(values2, Param32values2.BoolEnum(values2Item))
+				values2 = append(values2, Param33values2.BoolEnum(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param32values2.BoolEnum(values2)
+			values2Var := []Param33values2.BoolEnum(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -7206,9 +10404,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			// validation error response extension placeholder
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -7226,7 +10426,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7244,6 +10444,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/QueryArrayOfOthersEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -7261,8 +10462,10 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
-	engine.POST(toGinUrl("/e2e/query-pointer-to-array"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/query-array-of-others-enum")), e2EcontrollerQueryArrayOfOthersEnumHandler)
+	e2EcontrollerQueryArrayOfPointersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfPointersHandler := func(ginCtx *gin.Context) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			ginCtx,
@@ -7284,7 +10487,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "QueryArrayOfPointers")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
 		var values07RawPtr *[]string = nil
 		values07RawArray, isvalues07Exists := ginCtx.GetQueryArray("values07")
@@ -7312,7 +10515,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			ginCtx.Header(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7330,6 +10533,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/QueryArrayOfPointers",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
 			// json error response extension placeholder
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -7347,5 +10551,171 @@ func RegisterRoutes(engine *gin.Engine) {
 		// after operation routes extension placeholder
 		ginCtx.JSON(statusCode, value)
 		// route end routes extension placeholder
-	})
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/query-pointer-to-array")), e2EcontrollerQueryArrayOfPointersHandler)
+	// E2ESharedRateLimitController
+	e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitFirstHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		if !enforceRateLimit(ginCtx, "SharedRateLimitFirst", e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit) {
+			return
+		}
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "SharedRateLimitFirst")
+			return
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SharedRateLimitFirst()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitFirst'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitFirst",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/shared-rate-limit/first")), e2EsharedRateLimitControllerSharedRateLimitFirstHandler)
+	e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitSecondHandler := func(ginCtx *gin.Context) {
+		// route start routes extension placeholder
+		if !enforceRateLimit(ginCtx, "SharedRateLimitSecond", e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit) {
+			return
+		}
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "SharedRateLimitSecond")
+			return
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SharedRateLimitSecond()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitSecond'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitSecond",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+			// json error response extension placeholder
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		ginCtx.JSON(statusCode, value)
+		// route end routes extension placeholder
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/shared-rate-limit/second")), e2EsharedRateLimitControllerSharedRateLimitSecondHandler)
 }
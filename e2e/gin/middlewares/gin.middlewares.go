@@ -92,3 +92,14 @@ func MiddlewareOnOutputValidationError(ctx context.Context, ginCtx *gin.Context,
 	}
 	return ctx, true
 }
+
+func MiddlewareAudit(ctx context.Context, ginCtx *gin.Context) (context.Context, bool) {
+	ginCtx.Header("X-pass-named-middleware-audit", "true")
+	return ctx, true
+}
+
+func MiddlewareTrace(ctx context.Context, ginCtx *gin.Context) (context.Context, bool) {
+	// Named middlewares run in declaration order so the audit middleware must have already run
+	ginCtx.Header("X-pass-named-middleware-trace", ginCtx.Writer.Header().Get("X-pass-named-middleware-audit"))
+	return ctx, true
+}
@@ -15,46 +15,65 @@ Repository: https://github.com/gopher-fleece/gleece
 */
 package routes
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"net/textproto"
 	"reflect"
 	"regexp"
+	"runtime/debug"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 	"github.com/gin-gonic/gin"
 	"github.com/go-playground/validator/v10"
-	RequestAuth "github.com/gopher-fleece/gleece/v2/e2e/gin/auth"
 	"github.com/gopher-fleece/runtime"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	E2EClassSecController "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	E2EController "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param11value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param12value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param12value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param15data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param16data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param17data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	E2ESharedRateLimitController "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param14value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param18data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param19data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param20data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param22arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param23arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param24object "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param25num "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param26str "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param27values "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param28values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param21data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param22data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param23data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param24arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param25object "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param26num "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param27str "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param28values "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param29values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param31values "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param32values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param30values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param32values "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param33values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param4value2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param5data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param5theBody "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Response6CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param13data "github.com/haimkastner/unitsnet-go/units"
-	Param14unit "github.com/haimkastner/unitsnet-go/units"
+	Param6principal "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Response9CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param16data "github.com/haimkastner/unitsnet-go/units"
+	Param17unit "github.com/haimkastner/unitsnet-go/units"
 	// ImportsExtension - test
 )
 var validatorInstance = validator.New()
@@ -67,6 +86,24 @@ type SecurityCheckList struct {
 	Checks   []runtime.SecurityCheck
 	Relation SecurityListRelation
 }
+// ValidationIssue describes a single failed validation of a request's input
+type ValidationIssue struct {
+	// The location of the offending input - body, query, path, header or form
+	In string `json:"in"`
+	// A JSON pointer to the offending value, relative to its location
+	Pointer string `json:"pointer"`
+	// The validation rule that failed
+	Rule string `json:"rule"`
+	// The failed rule's parameter, if any
+	Param string `json:"param,omitempty"`
+	// A human-readable description of the failure
+	Message string `json:"message"`
+}
+// ValidationProblem is an RFC7807 error detailing the failed validations of a request
+type ValidationProblem struct {
+	runtime.Rfc7807Error
+	Errors []ValidationIssue `json:"errors"`
+}
 // TypeDeclarationsExtension - test
 func getRequestContext(ginCtx *gin.Context) context.Context {
 	return ginCtx.Request.Context()
@@ -76,6 +113,56 @@ func setRequestContext(ginCtx *gin.Context, ctx context.Context) {
 		ginCtx.Request = ginCtx.Request.WithContext(ctx)
 	}
 }
+type principalContextKey struct{}
+// WithPrincipal attaches the request's authenticated principal to the given context.
+//
+// Security handlers return the resulting context to make the principal available to @Principal parameters
+func WithPrincipal(ctx context.Context, principal any) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+// getPrincipal retrieves the principal attached to the given context via WithPrincipal.
+// Yields nil if no principal of type TPrincipal (or a pointer to one) was attached
+func getPrincipal[TPrincipal any](ctx context.Context) *TPrincipal {
+	switch principal := ctx.Value(principalContextKey{}).(type) {
+	case *TPrincipal:
+		return principal
+	case TPrincipal:
+		return &principal
+	default:
+		return nil
+	}
+}
+// IdentifiablePrincipal may be implemented by principals to provide a stable identity, e.g. a user ID,
+// by which their idempotent requests and principal keyed rate limits are scoped
+type IdentifiablePrincipal interface {
+	IdempotencyIdentity() string
+}
+// describePrincipal returns a stable textual identity of the principal attached to the given context via WithPrincipal,
+// i.e., its IdempotencyIdentity or String method's result, if any, or its dereferenced value if of a primitive kind.
+// Yields an empty string if no principal was attached.
+//
+// Returns false for principals lacking a stable identity, e.g. structs, whose formatting may vary between requests
+func describePrincipal(ctx context.Context) (string, bool) {
+	principal := ctx.Value(principalContextKey{})
+	switch identifiable := principal.(type) {
+	case nil:
+		return "", true
+	case IdentifiablePrincipal:
+		return identifiable.IdempotencyIdentity(), true
+	case fmt.Stringer:
+		return identifiable.String(), true
+	}
+	value := reflect.Indirect(reflect.ValueOf(principal))
+	switch value.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprintf("%v", value), true
+	default:
+		return "", false
+	}
+}
 func registerEnumValidation(validate *validator.Validate, validationName string, allowedValues []string) {
 	// Convert the array to a map for O(1) lookup
 	lookup := make(map[string]struct{})
@@ -89,12 +176,12 @@ func registerEnumValidation(validate *validator.Validate, validationName string,
 		return exists
 	})
 }
-func extractValidationErrorMessage(err error, fieldName *string) string {
+func extractValidationErrorMessage(err error, fieldName *string, languages []string) string {
 	if err == nil {
 		return ""
 	}
-	validationErrors, ok := err.(validator.ValidationErrors)
-	if !ok {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
 		return err.Error()
 	}
 	var errStr string
@@ -103,15 +190,26 @@ func extractValidationErrorMessage(err error, fieldName *string) string {
 		if fieldName != nil {
 			fName = *fieldName
 		}
-		errStr += fmt.Sprintf("Field '%s' failed validation with tag '%s'. ", fName, validationErr.Tag())
+		errStr += translateMessage(
+			languages,
+			map[string]string{"field": fName, "tag": validationErr.Tag(), "param": validationErr.Param()},
+			ValidationTagMessageKey(validationErr.Tag()),
+			MessageKeyFieldValidation,
+		) + ". "
 	}
 	return errStr
 }
-func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error) int {
+// getStatusCode returns the status code of an operation's response - the one set via the controller's SetStatus, if any,
+// that of the error mapping matching err among the operation's declared error status codes or a 500 for other errors,
+// or the success status code
+func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error, routeErrorStatusCodes []int) int {
 	if controller.GetStatus() != nil {
 		return int(*controller.GetStatus())
 	}
 	if err != nil {
+		if mapping := getErrorMapping(err, routeErrorStatusCodes); mapping != nil {
+			return mapping.StatusCode
+		}
 		return http.StatusInternalServerError
 	}
 	if hasReturnValue {
@@ -124,7 +222,7 @@ func bindAndValidateBody[TOutput any](ginCtx *gin.Context, contentType string, v
 	bodyBytes, err := io.ReadAll(ginCtx.Request.Body)
 	if err != nil || len(bodyBytes) == 0 {
 		if strings.Contains(validation, "required") {
-			return fmt.Errorf("body is required but was not provided")
+			return errBodyRequired
 		}
 		return nil
 	}
@@ -159,12 +257,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Slice, reflect.Array:
 		// For slices/arrays, validate each element recursively
 		for i := 0; i < val.Len(); i++ {
-			elemPath := path
-			if path != "" {
-				elemPath = fmt.Sprintf("%s[%d]", path, i)
-			} else {
-				elemPath = fmt.Sprintf("[%d]", i)
-			}
+			elemPath := fmt.Sprintf("%s/%d", path, i)
 			// Get the element - handle case where element might be nil
 			elem := val.Index(i)
 			if elem.Kind() == reflect.Ptr && elem.IsNil() {
@@ -179,12 +272,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Map:
 		// For maps, validate each value recursively
 		for _, key := range val.MapKeys() {
-			elemPath := path
-			if path != "" {
-				elemPath = fmt.Sprintf("%s.%v", path, key.Interface())
-			} else {
-				elemPath = fmt.Sprintf("%v", key.Interface())
-			}
+			elemPath := fmt.Sprintf("%s/%s", path, escapeJsonPointerToken(fmt.Sprintf("%v", key.Interface())))
 			elemVal := val.MapIndex(key)
 			if elemVal.Kind() == reflect.Ptr && elemVal.IsNil() {
 				continue
@@ -197,10 +285,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Struct:
 		// Validate structs with the validator
 		if err := validatorInstance.Struct(data); err != nil {
-			if path != "" {
-				return fmt.Errorf("validation error at %s: %w", path, err)
-			}
-			return err
+			return &structValidationError{pointer: path, structType: val.Type(), err: err}
 		}
 		return nil
 	default:
@@ -219,19 +304,33 @@ func toGinUrl(url string) string {
 	}
 	return processedUrl
 }
+// withBasePath prefixes the given route path with the given base path
+func withBasePath(basePath string, path string) string {
+	basePath = strings.TrimSuffix(basePath, "/")
+	if basePath != "" && !strings.HasPrefix(basePath, "/") {
+		basePath = "/" + basePath
+	}
+	return basePath + path
+}
+// authorize succeeds if all checks of any of the given lists pass.
+// Each list is an alternative (OR) of security schemas that must all be satisfied (AND), as expanded
+// from the route's @Security and @AdvancedSecurity annotations
 func authorize(ginCtx *gin.Context, checksLists []SecurityCheckList) *runtime.SecurityError {
 	var lastError *runtime.SecurityError
 	for _, list := range checksLists {
 		if list.Relation != SecurityListRelationAnd {
-			panic(
-				"Encountered a security list relation of type '%s' - this is unexpected and indicates a bug in Gleece itself." +
+			panic(fmt.Sprintf(
+				"Encountered a security list relation of type '%s' - this is unexpected and indicates a bug in Gleece itself. "+
 					"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
-			)
+				list.Relation,
+			))
 		}
-		// Iterate over each security list
+		// Iterate over each security list.
+		// Checks may attach values (e.g. a principal) to the request context - these are discarded if the list fails
+		listCtx := getRequestContext(ginCtx)
 		encounteredErrorInList := false
 		for _, check := range list.Checks {
-			secCtx, secErr := RequestAuth.GleeceRequestAuthorization(getRequestContext(ginCtx), ginCtx, check)
+			secCtx, secErr := runSecurityHandler(getRequestContext(ginCtx), ginCtx, check)
 			setRequestContext(ginCtx, secCtx)
 			if secErr != nil {
 				lastError = secErr
@@ -244,6 +343,7 @@ func authorize(ginCtx *gin.Context, checksLists []SecurityCheckList) *runtime.Se
 		if !encounteredErrorInList {
 			return nil
 		}
+		setRequestContext(ginCtx, listCtx)
 	}
 	// If we got here it means authentication has failed
 	return lastError
@@ -263,20 +363,1140 @@ func handleAuthorizationError(ginCtx *gin.Context, authErr *runtime.SecurityErro
 	}
 	ginCtx.JSON(statusCode, stdError)
 }
-func wrapValidatorError(validatorErr error, operationId string, fieldName string) runtime.Rfc7807Error {
+func wrapValidatorError(validatorErr error, operationId string, fieldName string, languages []string) runtime.Rfc7807Error {
 	return runtime.Rfc7807Error{
 		Type: http.StatusText(http.StatusUnprocessableEntity),
-		Detail: fmt.Sprintf(
-			"A request was made to operation '%s' but parameter '%s' did not pass validation - %s",
-			operationId,
-			fieldName,
-			extractValidationErrorMessage(validatorErr, &fieldName),
+		Detail: translateMessage(
+			languages,
+			map[string]string{
+				"operation": operationId,
+				"parameter": fieldName,
+				"details":   extractValidationErrorMessage(validatorErr, &fieldName, languages),
+			},
+			MessageKeyParamValidation,
 		),
 		Status:   http.StatusUnprocessableEntity,
 		Instance: fmt.Sprintf("/validation/error/%s", operationId),
 	}
 }
+// paramInteger is the set of signed integer types a request parameter may be converted to
+type paramInteger interface {
+	int | int8 | int16 | int32 | int64
+}
+// paramUnsigned is the set of unsigned integer types a request parameter may be converted to
+type paramUnsigned interface {
+	uint | uint8 | uint16 | uint32 | uint64
+}
+// paramFloat is the set of floating point types a request parameter may be converted to
+type paramFloat interface {
+	float32 | float64
+}
+// parseIntegerParam converts the raw value of a request parameter to the given signed integer type
+func parseIntegerParam[T paramInteger](raw string) (T, error) {
+	parsed, err := strconv.ParseInt(raw, 10, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// parseUnsignedParam converts the raw value of a request parameter to the given unsigned integer type
+func parseUnsignedParam[T paramUnsigned](raw string) (T, error) {
+	parsed, err := strconv.ParseUint(raw, 10, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// parseFloatParam converts the raw value of a request parameter to the given floating point type
+func parseFloatParam[T paramFloat](raw string) (T, error) {
+	parsed, err := strconv.ParseFloat(raw, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// wrapConversionError creates the RFC7807 error and validation issues describing a request parameter
+// whose raw value could not be converted to the parameter's type
+func wrapConversionError(
+	conversionErr error,
+	operationId string,
+	fieldName string,
+	in string,
+	pointer string,
+	expectedType string,
+	actualType string,
+	languages []string,
+) (runtime.Rfc7807Error, []ValidationIssue) {
+	validationError := runtime.Rfc7807Error{
+		Type: http.StatusText(http.StatusUnprocessableEntity),
+		Detail: translateMessage(
+			languages,
+			map[string]string{
+				"operation": operationId,
+				"parameter": fieldName,
+				"expected":  expectedType,
+				"actual":    actualType,
+			},
+			MessageKeyParamConversion,
+		),
+		Status:     http.StatusUnprocessableEntity,
+		Instance:   fmt.Sprintf("/validation/error/%s", operationId),
+		Extensions: map[string]string{"error": conversionErr.Error()},
+	}
+	validationIssues := []ValidationIssue{
+		{
+			In:      in,
+			Pointer: pointer,
+			Rule:    "type",
+			Param:   expectedType,
+			Message: conversionErr.Error(),
+		},
+	}
+	return validationError, validationIssues
+}
+var errBodyRequired = errors.New("body is required but was not provided")
+// structValidationError associates a struct's validation errors with the struct's location within the validated payload
+type structValidationError struct {
+	pointer    string
+	structType reflect.Type
+	err        error
+}
+func (e *structValidationError) Error() string {
+	if e.pointer == "" {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("validation error at %s: %s", e.pointer, e.err.Error())
+}
+func (e *structValidationError) Unwrap() error {
+	return e.err
+}
+func escapeJsonPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+// getJsonFieldName returns the name of the given field within its struct's JSON representation.
+//
+// Embedded structs without an explicit name are flattened by encoding/json and therefore yield an empty name
+func getJsonFieldName(field reflect.StructField) string {
+	if name := strings.Split(field.Tag.Get("json"), ",")[0]; name != "" {
+		return name
+	}
+	fieldType := field.Type
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	if field.Anonymous && fieldType.Kind() == reflect.Struct {
+		return ""
+	}
+	return field.Name
+}
+// toJsonPointer converts a validator struct namespace (e.g. 'Model.Items[0].Name') to a JSON pointer (e.g. '/items/0/name')
+// using the json tags of the root type's fields
+func toJsonPointer(rootType reflect.Type, structNamespace string) string {
+	pointer := ""
+	currentType := rootType
+	segments := strings.Split(structNamespace, ".")
+	for _, segment := range segments[1:] {
+		name, indices := segment, []string{}
+		if bracket := strings.Index(segment, "["); bracket >= 0 {
+			name = segment[:bracket]
+			indices = strings.Split(strings.TrimSuffix(segment[bracket+1:], "]"), "][")
+		}
+		for currentType != nil && currentType.Kind() == reflect.Ptr {
+			currentType = currentType.Elem()
+		}
+		token := name
+		if currentType != nil && currentType.Kind() == reflect.Struct {
+			if field, found := currentType.FieldByName(name); found {
+				currentType = field.Type
+				token = getJsonFieldName(field)
+			} else {
+				currentType = nil
+			}
+		}
+		if token != "" {
+			pointer += "/" + escapeJsonPointerToken(token)
+		}
+		for _, index := range indices {
+			pointer += "/" + escapeJsonPointerToken(index)
+			for currentType != nil && currentType.Kind() == reflect.Ptr {
+				currentType = currentType.Elem()
+			}
+			if currentType != nil && (currentType.Kind() == reflect.Slice || currentType.Kind() == reflect.Array || currentType.Kind() == reflect.Map) {
+				currentType = currentType.Elem()
+			} else {
+				currentType = nil
+			}
+		}
+	}
+	return pointer
+}
+func getValidationIssueMessage(pointer string, tag string, param string, languages []string) string {
+	args := map[string]string{"tag": tag, "param": param}
+	if pointer == "" {
+		return translateMessage(languages, args, ValidationTagMessageKey(tag), MessageKeyValueValidation)
+	}
+	args["field"] = pointer[strings.LastIndex(pointer, "/")+1:]
+	return translateMessage(languages, args, ValidationTagMessageKey(tag), MessageKeyFieldValidation)
+}
+// getValidationIssues converts the given validation or deserialization error to a list of issues at the given location.
+//
+// The pointer is the JSON pointer of the validated value, relative to its location
+func getValidationIssues(err error, in string, pointer string, languages []string) []ValidationIssue {
+	var structType reflect.Type
+	var structErr *structValidationError
+	if errors.As(err, &structErr) {
+		pointer += structErr.pointer
+		structType = structErr.structType
+	}
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		issues := make([]ValidationIssue, 0, len(validationErrors))
+		for _, validationErr := range validationErrors {
+			issuePointer := pointer
+			if structType != nil {
+				issuePointer += toJsonPointer(structType, validationErr.StructNamespace())
+			}
+			issues = append(issues, ValidationIssue{
+				In:      in,
+				Pointer: issuePointer,
+				Rule:    validationErr.Tag(),
+				Param:   validationErr.Param(),
+				Message: getValidationIssueMessage(issuePointer, validationErr.Tag(), validationErr.Param(), languages),
+			})
+		}
+		return issues
+	}
+	issue := ValidationIssue{In: in, Pointer: pointer, Rule: "parse", Message: err.Error()}
+	var typeErr *json.UnmarshalTypeError
+	if errors.Is(err, errBodyRequired) {
+		issue.Rule = "required"
+	} else if errors.As(err, &typeErr) {
+		if typeErr.Field != "" {
+			issue.Pointer += "/" + strings.ReplaceAll(typeErr.Field, ".", "/")
+		}
+		issue.Rule = "type"
+		issue.Param = typeErr.Type.String()
+	}
+	return []ValidationIssue{issue}
+}
+// Keys of the translatable validation messages.
+//
+// Message templates use named placeholders, such as '{field}', which are replaced with the message's arguments
+const (
+	// Describes a parameter that did not pass validation. Placeholders: {operation}, {parameter}, {details}
+	MessageKeyParamValidation = "paramValidation"
+	// Describes a body that did not pass validation. Placeholders: {operation}, {parameter}, {type}, {details}
+	MessageKeyBodyValidation = "bodyValidation"
+	// Describes a parameter that could not be converted to its declared type. Placeholders: {operation}, {parameter}, {expected}, {actual}
+	MessageKeyParamConversion = "paramConversion"
+	// Describes a field that failed a validation tag with no dedicated message. Placeholders: {field}, {tag}, {param}
+	MessageKeyFieldValidation = "fieldValidation"
+	// Describes a value that failed a validation tag with no dedicated message. Placeholders: {tag}, {param}
+	MessageKeyValueValidation = "valueValidation"
+)
+// The language of the default message catalogue
+const defaultMessageLanguage = "en"
+// The default English message catalogue, used whenever no translation is available
+var defaultMessages = map[string]string{
+	MessageKeyParamValidation: "A request was made to operation '{operation}' but parameter '{parameter}' did not pass validation - {details}",
+	MessageKeyBodyValidation:  "A request was made to operation '{operation}' but body parameter '{parameter}' did not pass validation of '{type}' - {details}",
+	MessageKeyParamConversion: "A request was made to operation '{operation}' but parameter '{parameter}' was not properly sent - Expected {expected} but got {actual}",
+	MessageKeyFieldValidation: "Field '{field}' failed validation with tag '{tag}'",
+	MessageKeyValueValidation: "Value failed validation with tag '{tag}'",
+}
+// MessageTranslator provides validation message templates in the languages requested via the Accept-Language header
+type MessageTranslator interface {
+	// Translate returns the message template registered for the given language and key, if any
+	Translate(language string, key string) (string, bool)
+}
+// MessageCatalogue is a MessageTranslator backed by an in-memory map of language to message key to template.
+//
+// Languages are matched case-insensitively
+type MessageCatalogue map[string]map[string]string
+func (catalogue MessageCatalogue) Translate(language string, key string) (string, bool) {
+	template, found := catalogue[strings.ToLower(language)][key]
+	return template, found
+}
+var messageCatalogue = MessageCatalogue{}
+var messageTranslator MessageTranslator = messageCatalogue
+// SetMessageTranslator replaces the built-in message catalogue with the given translator,
+// e.g. an adapter over go-playground's universal translator.
+//
+// Messages the translator does not provide fall back to the default English catalogue
+func SetMessageTranslator(translator MessageTranslator) {
+	if translator == nil {
+		translator = messageCatalogue
+	}
+	messageTranslator = translator
+}
+// RegisterTranslation registers the template of the given message key for the given language in the built-in catalogue.
+// Has no effect on translators set via SetMessageTranslator
+func RegisterTranslation(language string, key string, template string) {
+	language = strings.ToLower(language)
+	if messageCatalogue[language] == nil {
+		messageCatalogue[language] = map[string]string{}
+	}
+	messageCatalogue[language][key] = template
+}
+// ValidationTagMessageKey returns the message key of the given validation tag, e.g. 'required'.
+//
+// Templates registered under this key take precedence over the generic field and value messages.
+// Placeholders: {field}, {tag}, {param}
+func ValidationTagMessageKey(tag string) string {
+	return "tag." + tag
+}
+// RegisterValidationTagTranslation registers the message used when the given validation tag fails, for the given language
+func RegisterValidationTagTranslation(language string, tag string, template string) {
+	RegisterTranslation(language, ValidationTagMessageKey(tag), template)
+}
+// getAcceptedLanguages returns the languages listed in the given Accept-Language header, most preferred first.
+// Regional variants are followed by their base language, e.g. 'fr-CA' by 'fr'
+func getAcceptedLanguages(header string) []string {
+	type weightedLanguage struct {
+		tag    string
+		weight float64
+	}
+	weightedLanguages := []weightedLanguage{}
+	for _, entry := range strings.Split(header, ",") {
+		parts := strings.Split(entry, ";")
+		tag := strings.TrimSpace(parts[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		weight := 1.0
+		for _, param := range parts[1:] {
+			if value, found := strings.CutPrefix(strings.TrimSpace(param), "q="); found {
+				if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+					weight = parsed
+				}
+			}
+		}
+		if weight > 0 {
+			weightedLanguages = append(weightedLanguages, weightedLanguage{tag: tag, weight: weight})
+		}
+	}
+	slices.SortStableFunc(weightedLanguages, func(a, b weightedLanguage) int {
+		if a.weight > b.weight {
+			return -1
+		}
+		if a.weight < b.weight {
+			return 1
+		}
+		return 0
+	})
+	languages := make([]string, 0, len(weightedLanguages))
+	for _, language := range weightedLanguages {
+		languages = append(languages, language.tag)
+		if base, _, hasRegion := strings.Cut(language.tag, "-"); hasRegion {
+			languages = append(languages, base)
+		}
+	}
+	return languages
+}
+// getMessageTemplate returns the template of the first of the given keys available in the most preferred of the given languages,
+// falling back to English and finally to the default English catalogue
+func getMessageTemplate(languages []string, keys ...string) string {
+	for _, language := range append(slices.Clip(languages), defaultMessageLanguage) {
+		for _, key := range keys {
+			if template, found := messageTranslator.Translate(language, key); found {
+				return template
+			}
+		}
+	}
+	for _, key := range keys {
+		if template, found := defaultMessages[key]; found {
+			return template
+		}
+	}
+	return ""
+}
+// translateMessage formats the template of the given keys in the given languages, replacing its placeholders with the given arguments
+func translateMessage(languages []string, args map[string]string, keys ...string) string {
+	replacements := make([]string, 0, len(args)*2)
+	for name, value := range args {
+		replacements = append(replacements, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(replacements...).Replace(getMessageTemplate(languages, keys...))
+}
+// ControllerFactory creates the controller instance handling a single request.
+//
+// The given context is the request's context
+type ControllerFactory[TController any] func(ctx context.Context) *TController
+var controllerFactories = map[reflect.Type]func(ctx context.Context) any{}
+// RegisterControllerFactory registers the factory used to create a controller for each request it handles.
+//
+// Factories allow controllers to hold injected dependencies such as database handles, services or loggers.
+// Must be called before RegisterRoutes; the factory must not return nil
+func RegisterControllerFactory[TController any](factory ControllerFactory[TController]) {
+	controllerFactories[reflect.TypeOf((*TController)(nil)).Elem()] = func(ctx context.Context) any {
+		return factory(ctx)
+	}
+}
+// createController creates a controller using its registered factory or, if none exists, its zero value
+func createController[TController any](ctx context.Context) *TController {
+	if factory, exists := controllerFactories[reflect.TypeOf((*TController)(nil)).Elem()]; exists {
+		return factory(ctx).(*TController)
+	}
+	return new(TController)
+}
+// ensureControllerFactoriesRegistered panics if any of the given controller types has no registered factory
+func ensureControllerFactoriesRegistered(controllerTypes []reflect.Type) {
+	missing := []string{}
+	for _, controllerType := range controllerTypes {
+		if _, registered := controllerFactories[controllerType]; !registered {
+			missing = append(missing, controllerType.String())
+		}
+	}
+	if len(missing) > 0 {
+		panic(fmt.Sprintf(
+			"controller factories are required but were never registered using RegisterControllerFactory: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
+// ErrorMapping describes the response returned when a controller's error matches a registered mapping
+type ErrorMapping struct {
+	// The HTTP status code to respond with. Must be declared by at least one @ErrorResponse annotation.
+	// The mapping applies only to operations declaring the status code - errors of other operations remain 500s
+	StatusCode int
+	// The RFC7807 title. Defaults to the status code's text
+	Title string
+	// A client-safe description of the error. Defaults to a generic, operation-specific message
+	Detail string
+}
+type registeredErrorMapping struct {
+	matches func(err error) bool
+	mapping ErrorMapping
+}
+var errorMappings = []registeredErrorMapping{}
+var declaredErrorStatusCodes = []int{404, 409, 500}
+// RegisterErrorMapping maps errors matching the given target, via errors.Is, to the given HTTP status code.
+//
+// Mappings are evaluated in order of registration and are superseded by an explicit call to the controller's SetStatus
+func RegisterErrorMapping(target error, statusCode int) {
+	RegisterDetailedErrorMapping(target, ErrorMapping{StatusCode: statusCode})
+}
+// RegisterDetailedErrorMapping maps errors matching the given target, via errors.Is, to the given response
+func RegisterDetailedErrorMapping(target error, mapping ErrorMapping) {
+	addErrorMapping(func(err error) bool { return errors.Is(err, target) }, mapping)
+}
+// RegisterErrorTypeMapping maps errors of type TError, matched via errors.As, to the given response
+func RegisterErrorTypeMapping[TError error](mapping ErrorMapping) {
+	addErrorMapping(func(err error) bool {
+		var target TError
+		return errors.As(err, &target)
+	}, mapping)
+}
+func addErrorMapping(matches func(err error) bool, mapping ErrorMapping) {
+	if !slices.Contains(declaredErrorStatusCodes, mapping.StatusCode) {
+		panic(fmt.Sprintf("Error mapping status code %d is not declared by any @ErrorResponse annotation", mapping.StatusCode))
+	}
+	errorMappings = append(errorMappings, registeredErrorMapping{matches: matches, mapping: mapping})
+}
+// getErrorMapping returns the first registered mapping matching err whose status code is among the given ones, declared by
+// the operation that produced err, or nil if there is none
+func getErrorMapping(err error, routeErrorStatusCodes []int) *ErrorMapping {
+	for _, registered := range errorMappings {
+		if slices.Contains(routeErrorStatusCodes, registered.mapping.StatusCode) && registered.matches(err) {
+			return &registered.mapping
+		}
+	}
+	return nil
+}
+// applyErrorMapping overrides the given RFC7807 error's title and detail with those of the mapping matching err, if any
+func applyErrorMapping(stdError *runtime.Rfc7807Error, err error, routeErrorStatusCodes []int) {
+	mapping := getErrorMapping(err, routeErrorStatusCodes)
+	if mapping == nil {
+		return
+	}
+	stdError.Title = http.StatusText(stdError.Status)
+	if mapping.Title != "" {
+		stdError.Title = mapping.Title
+	}
+	if mapping.Detail != "" {
+		stdError.Detail = mapping.Detail
+	}
+}
 // FunctionDeclarationsExtension - test
+// The instrumentation scope of the spans and metrics reported by the API's routes
+const telemetryInstrumentationName = "github.com/gopher-fleece/gleece"
+var operationTracer = otel.GetTracerProvider().Tracer(telemetryInstrumentationName)
+var requestMetrics = newRequestInstruments(otel.GetMeterProvider())
+// requestInstruments holds the metric instruments recorded for each request
+type requestInstruments struct {
+	duration metric.Float64Histogram
+	count    metric.Int64Counter
+}
+func newRequestInstruments(provider metric.MeterProvider) requestInstruments {
+	meter := provider.Meter(telemetryInstrumentationName)
+	// Instrument creation only fails for malformed names or units, in which case a no-op instrument is yielded
+	duration, _ := meter.Float64Histogram(
+		"http.server.request.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of HTTP server requests"),
+	)
+	count, _ := meter.Int64Counter(
+		"http.server.request.count",
+		metric.WithUnit("{request}"),
+		metric.WithDescription("Number of HTTP server requests"),
+	)
+	return requestInstruments{duration: duration, count: count}
+}
+// RegisterTracerProvider sets the provider used to create the operations' spans.
+// Defaults to the global tracer provider
+func RegisterTracerProvider(provider trace.TracerProvider) {
+	operationTracer = provider.Tracer(telemetryInstrumentationName)
+}
+// RegisterMeterProvider sets the provider used to record the requests' metrics.
+// Defaults to the global meter provider
+func RegisterMeterProvider(provider metric.MeterProvider) {
+	requestMetrics = newRequestInstruments(provider)
+}
+// operationTelemetry traces and measures a single operation's execution
+type operationTelemetry struct {
+	// The request's context, carrying the operation's span
+	ctx        context.Context
+	span       trace.Span
+	phaseSpan  trace.Span
+	startTime  time.Time
+	attributes []attribute.KeyValue
+}
+// startOperationTelemetry starts the given operation's span.
+// The span is a child of the span propagated via the request's headers, if any
+func startOperationTelemetry(
+	ctx context.Context,
+	headers propagation.TextMapCarrier,
+	operationId string,
+	controllerName string,
+	httpVerb string,
+	routeTemplate string,
+) *operationTelemetry {
+	attributes := []attribute.KeyValue{
+		attribute.String("http.request.method", httpVerb),
+		attribute.String("http.route", routeTemplate),
+		attribute.String("gleece.controller", controllerName),
+		attribute.String("gleece.operation", operationId),
+	}
+	ctx = otel.GetTextMapPropagator().Extract(ctx, headers)
+	ctx, span := operationTracer.Start(
+		ctx,
+		operationId,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attributes...),
+	)
+	return &operationTelemetry{
+		ctx:        ctx,
+		span:       span,
+		startTime:  time.Now(),
+		attributes: attributes,
+	}
+}
+// startPhase ends the current phase's span, if any, and starts a child span for the given phase, e.g. 'authorization'
+func (telemetry *operationTelemetry) startPhase(phase string) {
+	telemetry.endPhase()
+	_, telemetry.phaseSpan = operationTracer.Start(telemetry.ctx, phase)
+}
+// endPhase ends the current phase's span, if any
+func (telemetry *operationTelemetry) endPhase() {
+	if telemetry.phaseSpan != nil {
+		telemetry.phaseSpan.End()
+		telemetry.phaseSpan = nil
+	}
+}
+// end ends the operation's span and records the request's metrics using the given response status code
+func (telemetry *operationTelemetry) end(statusCode int) {
+	telemetry.endPhase()
+	statusAttribute := attribute.Int("http.response.status_code", statusCode)
+	telemetry.span.SetAttributes(statusAttribute)
+	if statusCode >= http.StatusInternalServerError {
+		telemetry.span.SetStatus(codes.Error, http.StatusText(statusCode))
+	}
+	telemetry.span.End()
+	measurementAttributes := metric.WithAttributes(append(telemetry.attributes, statusAttribute)...)
+	requestMetrics.duration.Record(telemetry.ctx, time.Since(telemetry.startTime).Seconds(), measurementAttributes)
+	requestMetrics.count.Add(telemetry.ctx, 1, measurementAttributes)
+}
+// PanicError is the error passed to the error middlewares when an operation panics
+type PanicError struct {
+	// The value the operation panicked with
+	Value any
+	// The stack trace of the panicking goroutine, captured upon recovery
+	Stack []byte
+}
+func (err *PanicError) Error() string {
+	return fmt.Sprintf("operation panicked - %v", err.Value)
+}
+// PanicReporter is invoked with every panic recovered from an operation, e.g. to report it to an error tracking service
+type PanicReporter func(ctx context.Context, operationId string, panicErr *PanicError)
+var panicReporter PanicReporter
+// RegisterPanicReporter sets the hook invoked with every panic recovered from an operation.
+//
+// The reporter runs before the error middlewares
+func RegisterPanicReporter(reporter PanicReporter) {
+	panicReporter = reporter
+}
+// handleOperationPanic reports the given recovered value and runs the error middlewares.
+// Unless a middleware stops the chain, responds with a 500 RFC7807 error
+func handleOperationPanic(ginCtx *gin.Context, recovered any, operationId string) {
+	panicErr := &PanicError{Value: recovered, Stack: debug.Stack()}
+	if panicReporter != nil {
+		panicReporter(getRequestContext(ginCtx), operationId, panicErr)
+	}
+	for _, middleware := range onErrorMiddlewares {
+		middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, panicErr)
+		setRequestContext(ginCtx, middlewareCtx)
+		if !continueOperation {
+			return
+		}
+	}
+	stdError := runtime.Rfc7807Error{
+		Type:       http.StatusText(http.StatusInternalServerError),
+		Detail:     fmt.Sprintf("Encountered an unexpected error during operation '%s'", operationId),
+		Status:     http.StatusInternalServerError,
+		Instance:   "/controller/error/" + operationId,
+		Extensions: map[string]string{"error": panicErr.Error()},
+	}
+	ginCtx.JSON(http.StatusInternalServerError, stdError)
+}
+// SecurityCredentials holds the credentials extracted from a request for a single security scheme,
+// according to the scheme's configured type and location
+type SecurityCredentials struct {
+	// Whether credentials were found at the scheme's expected location
+	Present bool
+	// The API key read from the configured header, query parameter or cookie of an 'apiKey' scheme
+	ApiKey string
+	// The credentials of the 'Authorization' header, without the scheme prefix, for 'http' schemes other than 'basic'.
+	// For 'oauth2' and 'openIdConnect' schemes, this is the bearer token
+	Token string
+	// The user name of a 'basic' HTTP scheme
+	Username string
+	// The password of a 'basic' HTTP scheme
+	Password string
+}
+// SecurityHandler authorizes a request for a single security scheme, using the credentials extracted from the request
+type SecurityHandler func(
+	ctx context.Context,
+	ginCtx *gin.Context,
+	check runtime.SecurityCheck,
+	credentials SecurityCredentials,
+) (context.Context, *runtime.SecurityError)
+var securityHandlers = map[string]SecurityHandler{}
+// RegisterSecurityHandler registers the handler authorizing requests for the given security scheme.
+//
+// A handler must be registered for every configured security scheme before RegisterRoutes is called
+func RegisterSecurityHandler(schemaName string, handler SecurityHandler) {
+	securityHandlers[schemaName] = handler
+}
+// securitySchemeDescriptor describes where a security scheme's credentials are located
+type securitySchemeDescriptor struct {
+	schemeType string
+	in         string
+	fieldName  string
+	httpScheme string
+}
+var securitySchemes = map[string]securitySchemeDescriptor{
+	"securitySchemaName":  {schemeType: "apiKey", in: "header", fieldName: "x-header-name", httpScheme: ""},
+	"securitySchemaName2": {schemeType: "apiKey", in: "header", fieldName: "x-header-name", httpScheme: ""},
+	"securitySchemaName3": {schemeType: "http", in: "", fieldName: "", httpScheme: "bearer"},
+}
+// ensureSecurityHandlersRegistered panics if any of the configured security schemes has no registered handler
+func ensureSecurityHandlersRegistered() {
+	missing := []string{}
+	for schemaName := range securitySchemes {
+		if _, registered := securityHandlers[schemaName]; !registered {
+			missing = append(missing, schemaName)
+		}
+	}
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		panic(fmt.Sprintf(
+			"security schemes were never given a handler using RegisterSecurityHandler: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
+// runSecurityHandler invokes the given check's security handler with the credentials extracted for its scheme
+func runSecurityHandler(ctx context.Context, ginCtx *gin.Context, check runtime.SecurityCheck) (context.Context, *runtime.SecurityError) {
+	handler, registered := securityHandlers[check.SchemaName]
+	if !registered {
+		return ctx, &runtime.SecurityError{
+			Message:    fmt.Sprintf("No security handler is registered for security scheme '%s'", check.SchemaName),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+	return handler(ctx, ginCtx, check, extractSecurityCredentials(ginCtx, securitySchemes[check.SchemaName]))
+}
+// extractSecurityCredentials reads the credentials of the given security scheme from the request
+func extractSecurityCredentials(ginCtx *gin.Context, scheme securitySchemeDescriptor) SecurityCredentials {
+	switch scheme.schemeType {
+	case "apiKey":
+		var apiKey string
+		switch scheme.in {
+		case "header":
+			apiKey = ginCtx.GetHeader(scheme.fieldName)
+		case "query":
+			apiKey = ginCtx.Query(scheme.fieldName)
+		case "cookie":
+			apiKey, _ = ginCtx.Cookie(scheme.fieldName)
+		}
+		return SecurityCredentials{Present: apiKey != "", ApiKey: apiKey}
+	case "http":
+		return parseAuthorizationHeader(ginCtx.GetHeader("Authorization"), scheme.httpScheme)
+	case "oauth2", "openIdConnect":
+		return parseAuthorizationHeader(ginCtx.GetHeader("Authorization"), "bearer")
+	default:
+		return SecurityCredentials{}
+	}
+}
+// parseAuthorizationHeader extracts the credentials of the given HTTP authentication scheme from an 'Authorization' header
+func parseAuthorizationHeader(header string, httpScheme string) SecurityCredentials {
+	prefix, credentials, found := strings.Cut(strings.TrimSpace(header), " ")
+	credentials = strings.TrimSpace(credentials)
+	if !found || credentials == "" || !strings.EqualFold(prefix, httpScheme) {
+		return SecurityCredentials{}
+	}
+	if !strings.EqualFold(httpScheme, "basic") {
+		return SecurityCredentials{Present: true, Token: credentials}
+	}
+	decoded, err := base64.StdEncoding.DecodeString(credentials)
+	if err != nil {
+		return SecurityCredentials{}
+	}
+	username, password, found := strings.Cut(string(decoded), ":")
+	if !found {
+		return SecurityCredentials{}
+	}
+	return SecurityCredentials{Present: true, Username: username, Password: password}
+}
+// DefaultApiVersion is the API version served to requests that do not specify one
+const DefaultApiVersion = 2
+// registerVersionedRoute registers the given handler under the '/v{version}' prefixed path of each of the given API versions
+func registerVersionedRoute(engine gin.IRouter, basePath string, method string, path string, versions []int, handler gin.HandlerFunc) {
+	for _, version := range versions {
+		engine.Handle(method, toGinUrl(withBasePath(basePath, fmt.Sprintf("/v%d%s", version, path))), handler)
+	}
+}
+// RateLimit describes the number of requests an operation accepts per time window, as declared via @RateLimit
+type RateLimit struct {
+	// The number of requests allowed per window
+	Requests int
+	// The window's duration
+	Per time.Duration
+	// The key by which requests are counted - 'ip', 'principal' or 'header:<Name>'
+	Key string
+	// The scope within which requests are counted - the declaring controller's name for limits inherited from a controller,
+	// which are shared by all of its operations inheriting them, or the operation's ID for limits declared by the operation
+	Scope string
+}
+// RateLimiter decides whether requests may proceed under their operation's rate limit.
+//
+// Implementations must be safe for concurrent use
+type RateLimiter interface {
+	// Allow consumes a single request of the given key against the given operation's limit, counted within the limit's scope.
+	// Returns whether the request may proceed and, if not, how long until it may be retried
+	Allow(ctx context.Context, operationId string, key string, limit RateLimit) (bool, time.Duration)
+}
+// InMemoryRateLimiter is a RateLimiter holding a token bucket per limit scope and key in the process's memory.
+//
+// Each bucket holds up to the limit's number of requests and is refilled evenly over the limit's window.
+// Limits are not shared across instances - deployments with multiple instances should use a shared RateLimiter instead
+type InMemoryRateLimiter struct {
+	mutex     sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+type tokenBucket struct {
+	tokens          float64
+	capacity        float64
+	refillPerSecond float64
+	lastRefill      time.Time
+}
+// The interval at which an InMemoryRateLimiter drops buckets that have been refilled to capacity
+const rateLimitSweepInterval = time.Minute
+// NewInMemoryRateLimiter creates an InMemoryRateLimiter with no buckets
+func NewInMemoryRateLimiter() *InMemoryRateLimiter {
+	return &InMemoryRateLimiter{buckets: map[string]*tokenBucket{}, lastSweep: time.Now()}
+}
+func (limiter *InMemoryRateLimiter) Allow(_ context.Context, _ string, key string, limit RateLimit) (bool, time.Duration) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	now := time.Now()
+	limiter.sweep(now)
+	bucketKey := limit.Scope + "\x00" + key
+	bucket, exists := limiter.buckets[bucketKey]
+	if !exists {
+		capacity := float64(limit.Requests)
+		bucket = &tokenBucket{
+			tokens:          capacity,
+			capacity:        capacity,
+			refillPerSecond: capacity / limit.Per.Seconds(),
+			lastRefill:      now,
+		}
+		limiter.buckets[bucketKey] = bucket
+	}
+	bucket.tokens = bucket.tokensAt(now)
+	bucket.lastRefill = now
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - bucket.tokens) / bucket.refillPerSecond * float64(time.Second))
+}
+// sweep drops the buckets that have been refilled to capacity, as those are indistinguishable from new ones
+func (limiter *InMemoryRateLimiter) sweep(now time.Time) {
+	if now.Sub(limiter.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	for bucketKey, bucket := range limiter.buckets {
+		if bucket.tokensAt(now) >= bucket.capacity {
+			delete(limiter.buckets, bucketKey)
+		}
+	}
+	limiter.lastSweep = now
+}
+// tokensAt returns the number of tokens the bucket holds at the given time
+func (bucket *tokenBucket) tokensAt(now time.Time) float64 {
+	return math.Min(bucket.capacity, bucket.tokens+now.Sub(bucket.lastRefill).Seconds()*bucket.refillPerSecond)
+}
+var rateLimiter RateLimiter = NewInMemoryRateLimiter()
+// SetRateLimiter replaces the default InMemoryRateLimiter with the given limiter,
+// e.g. one backed by a store shared across instances
+func SetRateLimiter(limiter RateLimiter) {
+	if limiter == nil {
+		limiter = NewInMemoryRateLimiter()
+	}
+	rateLimiter = limiter
+}
+// newRateLimit creates an operation's RateLimit, counted within the given scope, out of its @RateLimit annotation's properties
+func newRateLimit(requests int, per string, key string, scope string) RateLimit {
+	window, err := time.ParseDuration(per)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid rate limit window '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			per,
+		))
+	}
+	return RateLimit{Requests: requests, Per: window, Key: key, Scope: scope}
+}
+// getRateLimitKey returns the value by which the request is counted against a rate limit with the given key.
+//
+// Requests lacking the key's header or an identifiable principal are counted by their client IP
+func getRateLimitKey(ginCtx *gin.Context, key string) string {
+	value := ""
+	if headerName, isHeaderKey := strings.CutPrefix(key, "header:"); isHeaderKey {
+		value = ginCtx.GetHeader(headerName)
+	} else if key == "principal" {
+		value, _ = describePrincipal(getRequestContext(ginCtx))
+	}
+	if value == "" {
+		return ginCtx.ClientIP()
+	}
+	return key + "=" + value
+}
+// enforceRateLimit counts the request against the given operation's rate limit.
+//
+// Requests exceeding the limit are answered with a 429 RFC7807 error and a 'Retry-After' header, in which case false is returned
+func enforceRateLimit(ginCtx *gin.Context, operationId string, limit RateLimit) bool {
+	allowed, retryAfter := rateLimiter.Allow(getRequestContext(ginCtx), operationId, getRateLimitKey(ginCtx, limit.Key), limit)
+	if allowed {
+		return true
+	}
+	ginCtx.Header("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	ginCtx.JSON(http.StatusTooManyRequests, runtime.Rfc7807Error{
+		Type:     http.StatusText(http.StatusTooManyRequests),
+		Detail:   fmt.Sprintf("Too many requests were made to operation '%s'. Please retry later", operationId),
+		Status:   http.StatusTooManyRequests,
+		Instance: "/ratelimit/error/" + operationId,
+	})
+	return false
+}
+// newOperationTimeout creates an operation's deadline duration out of its @Timeout annotation or the configured default timeout
+func newOperationTimeout(timeout string) time.Duration {
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid operation timeout '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			timeout,
+		))
+	}
+	return duration
+}
+// applyOperationTimeout replaces the request's context with one that expires once the given timeout elapses.
+//
+// Operations are not interrupted when the deadline expires - controllers are expected to honor their context's cancellation.
+// The returned function releases the context's resources and must be called once the request has been handled
+func applyOperationTimeout(ginCtx *gin.Context, timeout time.Duration) context.CancelFunc {
+	ctx, cancel := context.WithTimeout(getRequestContext(ginCtx), timeout)
+	setRequestContext(ginCtx, ctx)
+	return cancel
+}
+// isDeadlineExceeded returns whether the request's context has expired due to its operation's timeout
+func isDeadlineExceeded(ginCtx *gin.Context) bool {
+	return errors.Is(getRequestContext(ginCtx).Err(), context.DeadlineExceeded)
+}
+// handleDeadlineExceeded answers a request whose operation's deadline has expired with an RFC7807 error of the given status -
+// 503 if the operation has not been invoked yet or 504 if it has
+func handleDeadlineExceeded(ginCtx *gin.Context, operationId string, status int) {
+	detail := fmt.Sprintf("The deadline of operation '%s' expired before the operation was invoked", operationId)
+	if status == http.StatusGatewayTimeout {
+		detail = fmt.Sprintf("Operation '%s' did not complete before its deadline expired", operationId)
+	}
+	ginCtx.JSON(status, runtime.Rfc7807Error{
+		Type:     http.StatusText(status),
+		Detail:   detail,
+		Status:   status,
+		Instance: "/timeout/error/" + operationId,
+	})
+}
+// enforceMaxBodySize checks the request's body against the given operation's maximum body size.
+//
+// Bodies of unknown length are read up-front, up to the maximum size.
+// Requests whose body exceeds the maximum size are answered with a 413 RFC7807 error, in which case false is returned
+func enforceMaxBodySize(ginCtx *gin.Context, operationId string, maxBodySize int64) bool {
+	bodySize := ginCtx.Request.ContentLength
+	if bodySize < 0 {
+		body, _ := io.ReadAll(io.LimitReader(ginCtx.Request.Body, maxBodySize+1))
+		ginCtx.Request.Body = io.NopCloser(bytes.NewReader(body))
+		bodySize = int64(len(body))
+	}
+	if bodySize <= maxBodySize {
+		return true
+	}
+	ginCtx.JSON(http.StatusRequestEntityTooLarge, runtime.Rfc7807Error{
+		Type:     http.StatusText(http.StatusRequestEntityTooLarge),
+		Detail:   fmt.Sprintf("The request body of operation '%s' exceeds the maximum size of %d bytes", operationId, maxBodySize),
+		Status:   http.StatusRequestEntityTooLarge,
+		Instance: "/body/error/" + operationId,
+	})
+	return false
+}
+// IdempotentResponse is a response of an idempotent operation, as stored to be replayed to repeated requests
+type IdempotentResponse struct {
+	// The response's HTTP status code
+	Status int
+	// The response's headers
+	Headers http.Header
+	// The response's body
+	Body []byte
+}
+// IdempotencyStore stores the responses of operations declared via @Idempotent, by request key.
+//
+// Implementations must be safe for concurrent use
+type IdempotencyStore interface {
+	// Reserve claims the given key for a new request with the given body hash, for the given duration.
+	// If the key has already been claimed, returns false along with the stored response or,
+	// if the original request is still in flight, a nil response.
+	// If the key has been claimed by a request with a different body hash, returns ErrIdempotencyKeyReused
+	Reserve(ctx context.Context, key string, requestHash string, ttl time.Duration) (bool, *IdempotentResponse, error)
+	// Complete stores the response of the request that claimed the given key, along with the request's body hash, for the given duration
+	Complete(ctx context.Context, key string, requestHash string, response IdempotentResponse, ttl time.Duration) error
+	// Release drops the claim of a request that did not produce a replayable response, so that it may be retried
+	Release(ctx context.Context, key string) error
+}
+// ErrIdempotencyKeyReused is returned by an IdempotencyStore when a key is reused by a request with a different body
+var ErrIdempotencyKeyReused = errors.New("the idempotency key has already been used by a request with a different body")
+// InMemoryIdempotencyStore is an IdempotencyStore holding responses in the process's memory.
+//
+// Responses are not shared across instances - deployments with multiple instances should use a shared IdempotencyStore instead
+type InMemoryIdempotencyStore struct {
+	mutex     sync.Mutex
+	entries   map[string]*idempotencyEntry
+	lastSweep time.Time
+}
+type idempotencyEntry struct {
+	// The stored response. Nil while the request is in flight
+	response *IdempotentResponse
+	// The hash of the body of the request that claimed the entry's key
+	requestHash string
+	expiresAt   time.Time
+}
+// The interval at which an InMemoryIdempotencyStore drops expired entries
+const idempotencySweepInterval = time.Minute
+// NewInMemoryIdempotencyStore creates an InMemoryIdempotencyStore with no entries
+func NewInMemoryIdempotencyStore() *InMemoryIdempotencyStore {
+	return &InMemoryIdempotencyStore{entries: map[string]*idempotencyEntry{}, lastSweep: time.Now()}
+}
+func (store *InMemoryIdempotencyStore) Reserve(_ context.Context, key string, requestHash string, ttl time.Duration) (bool, *IdempotentResponse, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	now := time.Now()
+	store.sweep(now)
+	if entry, exists := store.entries[key]; exists && now.Before(entry.expiresAt) {
+		if entry.requestHash != requestHash {
+			return false, nil, ErrIdempotencyKeyReused
+		}
+		return false, entry.response, nil
+	}
+	store.entries[key] = &idempotencyEntry{requestHash: requestHash, expiresAt: now.Add(ttl)}
+	return true, nil, nil
+}
+func (store *InMemoryIdempotencyStore) Complete(_ context.Context, key string, requestHash string, response IdempotentResponse, ttl time.Duration) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.entries[key] = &idempotencyEntry{response: &response, requestHash: requestHash, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+func (store *InMemoryIdempotencyStore) Release(_ context.Context, key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.entries, key)
+	return nil
+}
+// sweep drops the entries that have expired
+func (store *InMemoryIdempotencyStore) sweep(now time.Time) {
+	if now.Sub(store.lastSweep) < idempotencySweepInterval {
+		return
+	}
+	for key, entry := range store.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(store.entries, key)
+		}
+	}
+	store.lastSweep = now
+}
+var idempotencyStore IdempotencyStore = NewInMemoryIdempotencyStore()
+// SetIdempotencyStore replaces the default InMemoryIdempotencyStore with the given store,
+// e.g. one backed by a database shared across instances
+func SetIdempotencyStore(store IdempotencyStore) {
+	if store == nil {
+		store = NewInMemoryIdempotencyStore()
+	}
+	idempotencyStore = store
+}
+// newIdempotencyTtl creates an operation's response retention duration out of its @Idempotent annotation's 'ttl' property
+func newIdempotencyTtl(ttl string) time.Duration {
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid idempotency TTL '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			ttl,
+		))
+	}
+	return duration
+}
+// idempotentResponseRecorder records the body written to the response so it may be stored once the operation completes
+type idempotentResponseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+func (recorder *idempotentResponseRecorder) Write(data []byte) (int, error) {
+	recorder.body.Write(data)
+	return recorder.ResponseWriter.Write(data)
+}
+func (recorder *idempotentResponseRecorder) WriteString(data string) (int, error) {
+	recorder.body.WriteString(data)
+	return recorder.ResponseWriter.WriteString(data)
+}
+// The maximum size, in bytes, of the request bodies hashed by idempotent operations without a @MaxBodySize limit
+const defaultMaxIdempotentBodySize = 10 << 20
+// hashRequestBody returns the hex encoded SHA-256 hash of the request's body. The body remains readable.
+//
+// Requests whose body exceeds the given maximum size, or defaultMaxIdempotentBodySize if zero,
+// are answered with a 413 RFC7807 error, in which case false is returned
+func hashRequestBody(ginCtx *gin.Context, operationId string, maxBodySize int64) (string, bool) {
+	if maxBodySize <= 0 {
+		maxBodySize = defaultMaxIdempotentBodySize
+	}
+	body, _ := io.ReadAll(io.LimitReader(ginCtx.Request.Body, maxBodySize+1))
+	ginCtx.Request.Body = io.NopCloser(bytes.NewReader(body))
+	if int64(len(body)) > maxBodySize {
+		writeIdempotencyError(ginCtx, operationId, http.StatusRequestEntityTooLarge, fmt.Sprintf("The request body of operation '%s' exceeds the maximum size of %d bytes", operationId, maxBodySize))
+		return "", false
+	}
+	hash := sha256.Sum256(body)
+	return hex.EncodeToString(hash[:]), true
+}
+// beginIdempotentRequest claims the request's 'Idempotency-Key' header, scoped to the given operation and the request's principal.
+//
+// If the operation may proceed, returns a function storing its response, which must be deferred.
+// Otherwise, the request is answered - with the stored response if the key has been used before,
+// or with an RFC7807 error if the header is missing (400), the original request is still in flight (409),
+// the principal lacks a stable identity (500)
+// or the key has been used by a request with a different body (422) - and nil is returned
+func beginIdempotentRequest(ginCtx *gin.Context, operationId string, requestHash string, ttl time.Duration) func() {
+	idempotencyKey := ginCtx.GetHeader("Idempotency-Key")
+	if idempotencyKey == "" {
+		writeIdempotencyError(
+			ginCtx,
+			operationId,
+			http.StatusBadRequest,
+			fmt.Sprintf("Operation '%s' requires an 'Idempotency-Key' header", operationId),
+		)
+		return nil
+	}
+	ctx := getRequestContext(ginCtx)
+	// Keys are scoped by principal so that they may not collide across clients. Principals lacking a stable identity are
+	// rejected, as their requests could otherwise never be recognized as repeated
+	principalIdentity, isIdentifiable := describePrincipal(ctx)
+	if !isIdentifiable {
+		writeIdempotencyError(
+			ginCtx,
+			operationId,
+			http.StatusInternalServerError,
+			fmt.Sprintf("The principal of operation '%s' lacks a stable identity to scope its 'Idempotency-Key' by", operationId),
+		)
+		return nil
+	}
+	key := operationId + "\x00" + principalIdentity + "\x00" + idempotencyKey
+	reserved, response, err := idempotencyStore.Reserve(ctx, key, requestHash, ttl)
+	switch {
+	case errors.Is(err, ErrIdempotencyKeyReused):
+		writeIdempotencyError(
+			ginCtx,
+			operationId,
+			http.StatusUnprocessableEntity,
+			fmt.Sprintf("The 'Idempotency-Key' has already been used by a request to operation '%s' with a different body", operationId),
+		)
+		return nil
+	case err != nil:
+		writeIdempotencyError(
+			ginCtx,
+			operationId,
+			http.StatusInternalServerError,
+			fmt.Sprintf("Failed to process the 'Idempotency-Key' header of operation '%s'", operationId),
+		)
+		return nil
+	case !reserved && response == nil:
+		writeIdempotencyError(
+			ginCtx,
+			operationId,
+			http.StatusConflict,
+			fmt.Sprintf("A request to operation '%s' with the same 'Idempotency-Key' is still being processed", operationId),
+		)
+		return nil
+	case !reserved:
+		for name, values := range response.Headers {
+			ginCtx.Writer.Header()[name] = values
+		}
+		ginCtx.Header("Idempotent-Replayed", "true")
+		ginCtx.Status(response.Status)
+		ginCtx.Writer.Write(response.Body)
+		return nil
+	}
+	recorder := &idempotentResponseRecorder{ResponseWriter: ginCtx.Writer}
+	ginCtx.Writer = recorder
+	return func() {
+		// Requests that panicked or failed on the server's side are not replayed so they may be retried
+		recovered := recover()
+		if recovered != nil || !recorder.Written() || recorder.Status() >= http.StatusInternalServerError {
+			idempotencyStore.Release(context.WithoutCancel(ctx), key)
+			if recovered != nil {
+				panic(recovered)
+			}
+			return
+		}
+		idempotencyStore.Complete(
+			context.WithoutCancel(ctx),
+			key,
+			requestHash,
+			IdempotentResponse{Status: recorder.Status(), Headers: recorder.Header().Clone(), Body: recorder.body.Bytes()},
+			ttl,
+		)
+	}
+}
+// writeIdempotencyError answers a request to an idempotent operation with an RFC7807 error of the given status
+func writeIdempotencyError(ginCtx *gin.Context, operationId string, status int, detail string) {
+	ginCtx.JSON(status, runtime.Rfc7807Error{
+		Type:     http.StatusText(status),
+		Detail:   detail,
+		Status:   status,
+		Instance: "/idempotency/error/" + operationId,
+	})
+}
 type MiddlewareFunc func(ctx context.Context, ginCtx *gin.Context) (context.Context, bool)
 type ErrorMiddlewareFunc func(ctx context.Context, ginCtx *gin.Context, err error) (context.Context, bool)
 var beforeOperationMiddlewares []MiddlewareFunc
@@ -302,13 +1522,46 @@ func RegisterErrorMiddleware(executionType runtime.ErrorMiddlewareExecutionType,
 		onErrorMiddlewares = append(onErrorMiddlewares, errorMiddlewareFunc)
 	}
 }
-func RegisterCustomValidator(validateTagName string, validateFunc runtime.ValidationFunc) {
+var namedMiddlewares = map[string]MiddlewareFunc{}
+// RegisterNamedMiddleware registers a middleware that controllers and routes may reference by name using @Middleware annotations.
+//
+// Named middlewares must be registered before RegisterRoutes is called
+func RegisterNamedMiddleware(name string, middlewareFunc MiddlewareFunc) {
+	namedMiddlewares[name] = middlewareFunc
+}
+func ensureNamedMiddlewaresRegistered(names []string) {
+	missing := []string{}
+	for _, name := range names {
+		if _, registered := namedMiddlewares[name]; !registered {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		panic(fmt.Sprintf(
+			"middlewares referenced via @Middleware annotations were never registered using RegisterNamedMiddleware: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
+func RegisterCustomValidator(validateTagName string, validateFunc runtime.ValidationFunc) {
 	validatorInstance.RegisterValidation(validateTagName, func(fl validator.FieldLevel) bool {
 		return validateFunc(fl)
 	})
 }
+// BasePath is the path under which RegisterRoutes mounts the API's routes
+const BasePath = ""
+// RegisterRoutes registers the API's routes on the given engine, under BasePath
 func RegisterRoutes(engine *gin.Engine) {
+	RegisterGroupRoutes(engine, BasePath)
+}
+// RegisterGroupRoutes registers the API's routes on the given router or group, e.g. a *gin.RouterGroup, under the given base path.
+//
+// The base path is relative to the router's own prefix.
+// For the OpenAPI specification to match the served routes, the two should add up to BasePath
+func RegisterGroupRoutes(engine gin.IRouter, basePath string) {
 	urlParamRegex = regexp.MustCompile(`\{([\w\d-_]+)\}`)
+	ensureSecurityHandlersRegistered()
+	ensureNamedMiddlewaresRegistered([]string{"audit", "trace"})
 	registerEnumValidation(validatorInstance, "bool_enum_enum", []string{"false", "true"})
 	registerEnumValidation(validatorInstance, "length_units_enum", []string{"Angstrom", "AstronomicalUnit", "Centimeter", "Chain", "DataMile", "Decameter", "Decimeter", "DtpPica", "DtpPoint", "Fathom", "Femtometer", "Foot", "Gigameter", "Hand", "Hectometer", "Inch", "Kilofoot", "KilolightYear", "Kilometer", "Kiloparsec", "Kiloyard", "LightYear", "MegalightYear", "Megameter", "Megaparsec", "Meter", "Microinch", "Micrometer", "Mil", "Mile", "Millimeter", "Nanometer", "NauticalMile", "Parsec", "Picometer", "PrinterPica", "PrinterPoint", "Shackle", "SolarRadius", "Twip", "UsSurveyFoot", "Yard"})
 	registerEnumValidation(validatorInstance, "myemamium_enum", []string{"one", "two"})
@@ -318,8 +1571,21 @@ func RegisterRoutes(engine *gin.Engine) {
 	registerEnumValidation(validatorInstance, "status_enumeration_enum", []string{"active", "inactive"})
 	// RegisterRoutesExtension - test
 	// E2EClassSecController
-	engine.GET(toGinUrl("/e2e/with-default-class-security"), func(ginCtx *gin.Context) {
+	e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes := []int{}
+	e2EclassSecControllerWithDefaultClassSecurityRoute := withBasePath(basePath, "/e2e/with-default-class-security")
+	e2EclassSecControllerWithDefaultClassSecurityHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"WithDefaultClassSecurity",
+			"E2EClassSecController",
+			"GET",
+			e2EclassSecControllerWithDefaultClassSecurityRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "WithDefaultClassSecurity")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -340,8 +1606,9 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "WithDefaultClassSecurity")
 			return
 		}
-		controller := E2EClassSecController.E2EClassSecController{}
+		controller := createController[E2EClassSecController.E2EClassSecController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var headerParamRawPtr *string = nil
 		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
 		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-test-scopes")]
@@ -360,11 +1627,2451 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			ginCtx.Header("x-RunValidatorExtension", "WithDefaultClassSecurity")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "WithDefaultClassSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "WithDefaultClassSecurity")
+			}
+		}()
+		value, opError := controller.WithDefaultClassSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "WithDefaultClassSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithDefaultClassSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithDefaultClassSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "WithDefaultClassSecurity")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "WithDefaultClassSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "WithDefaultClassSecurity")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "WithDefaultClassSecurity")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/with-default-class-security")), e2EclassSecControllerWithDefaultClassSecurityHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/with-default-class-security")), e2EclassSecControllerWithDefaultClassSecurityHandler)
+	e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes := []int{}
+	e2EclassSecControllerWithOverrideClassSecurityRoute := withBasePath(basePath, "/e2e/with-default-override-class-security")
+	e2EclassSecControllerWithOverrideClassSecurityHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"WithOverrideClassSecurity",
+			"E2EClassSecController",
+			"GET",
+			e2EclassSecControllerWithOverrideClassSecurityRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "WithOverrideClassSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"method",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "WithOverrideClassSecurity")
+			return
+		}
+		controller := createController[E2EClassSecController.E2EClassSecController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
+		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-test-scopes")]
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			ginCtx.Header("x-RunValidatorExtension", "WithOverrideClassSecurity")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "WithOverrideClassSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "WithOverrideClassSecurity")
+			}
+		}()
+		value, opError := controller.WithOverrideClassSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "WithOverrideClassSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithOverrideClassSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithOverrideClassSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "WithOverrideClassSecurity")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "WithOverrideClassSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "WithOverrideClassSecurity")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "WithOverrideClassSecurity")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/with-default-override-class-security")), e2EclassSecControllerWithOverrideClassSecurityHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/with-default-override-class-security")), e2EclassSecControllerWithOverrideClassSecurityHandler)
+	// E2EController
+	e2EcontrollerSimpleGetErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetRoute := withBasePath(basePath, "/e2e/simple-get")
+	e2EcontrollerSimpleGetHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"SimpleGet",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "SimpleGet")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "SimpleGet")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "SimpleGet")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "SimpleGet")
+			}
+		}()
+		value, opError := controller.SimpleGet()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "SimpleGet")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGet'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGet",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "SimpleGet")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "SimpleGet")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "SimpleGet")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "SimpleGet")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/simple-get")), e2EcontrollerSimpleGetHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/simple-get")), e2EcontrollerSimpleGetHandler)
+	e2EcontrollerSimpleGetEmptyStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyStringRoute := withBasePath(basePath, "/e2e/simple-get-empty-string")
+	e2EcontrollerSimpleGetEmptyStringHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"SimpleGetEmptyString",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetEmptyStringRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "SimpleGetEmptyString")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "SimpleGetEmptyString")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "SimpleGetEmptyString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "SimpleGetEmptyString")
+			}
+		}()
+		value, opError := controller.SimpleGetEmptyString()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "SimpleGetEmptyString")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetEmptyString'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetEmptyString",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "SimpleGetEmptyString")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "SimpleGetEmptyString")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "SimpleGetEmptyString")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "SimpleGetEmptyString")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/simple-get-empty-string")), e2EcontrollerSimpleGetEmptyStringHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/simple-get-empty-string")), e2EcontrollerSimpleGetEmptyStringHandler)
+	e2EcontrollerSimpleGetPtrStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetPtrStringRoute := withBasePath(basePath, "/e2e/simple-get-ptr-string")
+	e2EcontrollerSimpleGetPtrStringHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"SimpleGetPtrString",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetPtrStringRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "SimpleGetPtrString")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "SimpleGetPtrString")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "SimpleGetPtrString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "SimpleGetPtrString")
+			}
+		}()
+		value, opError := controller.SimpleGetPtrString()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "SimpleGetPtrString")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetPtrString'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetPtrString",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "SimpleGetPtrString")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "SimpleGetPtrString")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "SimpleGetPtrString")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "SimpleGetPtrString")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/simple-get-ptr-string")), e2EcontrollerSimpleGetPtrStringHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/simple-get-ptr-string")), e2EcontrollerSimpleGetPtrStringHandler)
+	e2EcontrollerSimpleGetNullStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetNullStringRoute := withBasePath(basePath, "/e2e/simple-get-null-string")
+	e2EcontrollerSimpleGetNullStringHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"SimpleGetNullString",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetNullStringRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "SimpleGetNullString")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "SimpleGetNullString")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "SimpleGetNullString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "SimpleGetNullString")
+			}
+		}()
+		value, opError := controller.SimpleGetNullString()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "SimpleGetNullString")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetNullString'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetNullString",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "SimpleGetNullString")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "SimpleGetNullString")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "SimpleGetNullString")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "SimpleGetNullString")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/simple-get-null-string")), e2EcontrollerSimpleGetNullStringHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/simple-get-null-string")), e2EcontrollerSimpleGetNullStringHandler)
+	e2EcontrollerNamedMiddlewaresErrorStatusCodes := []int{}
+	e2EcontrollerNamedMiddlewaresRoute := withBasePath(basePath, "/e2e/named-middlewares")
+	e2EcontrollerNamedMiddlewaresHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"NamedMiddlewares",
+			"E2EController",
+			"GET",
+			e2EcontrollerNamedMiddlewaresRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "NamedMiddlewares")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "NamedMiddlewares")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		routeMiddlewares := []MiddlewareFunc{namedMiddlewares["audit"], namedMiddlewares["trace"]}
+		// Middlewares routeMiddlewares section
+		for _, middleware := range routeMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares routeMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "NamedMiddlewares")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "NamedMiddlewares")
+			}
+		}()
+		value, opError := controller.NamedMiddlewares()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "NamedMiddlewares")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'NamedMiddlewares'",
+				Status:     statusCode,
+				Instance:   "/controller/error/NamedMiddlewares",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "NamedMiddlewares")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "NamedMiddlewares")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "NamedMiddlewares")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "NamedMiddlewares")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/named-middlewares")), e2EcontrollerNamedMiddlewaresHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/named-middlewares")), e2EcontrollerNamedMiddlewaresHandler)
+	e2EcontrollerSimpleGetObjectErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectRoute := withBasePath(basePath, "/e2e/simple-get-object")
+	e2EcontrollerSimpleGetObjectHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"SimpleGetObject",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetObjectRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "SimpleGetObject")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "SimpleGetObject")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "SimpleGetObject")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "SimpleGetObject")
+			}
+		}()
+		value, opError := controller.SimpleGetObject()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "SimpleGetObject")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObject'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetObject",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "SimpleGetObject")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "SimpleGetObject")
+		var outputValidationErr error
+		outputValidationErr = validateDataRecursive(value, "")
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, outputValidationErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObject'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/SimpleGetObject",
+				Extensions: map[string]string{},
+			}
+			ginCtx.JSON(outputValidationStatusCode, outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "SimpleGetObject")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "SimpleGetObject")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/simple-get-object")), e2EcontrollerSimpleGetObjectHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/simple-get-object")), e2EcontrollerSimpleGetObjectHandler)
+	e2EcontrollerSimpleGetObjectPtrErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectPtrRoute := withBasePath(basePath, "/e2e/simple-get-object-ptr")
+	e2EcontrollerSimpleGetObjectPtrHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"SimpleGetObjectPtr",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetObjectPtrRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "SimpleGetObjectPtr")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "SimpleGetObjectPtr")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "SimpleGetObjectPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "SimpleGetObjectPtr")
+			}
+		}()
+		value, opError := controller.SimpleGetObjectPtr()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "SimpleGetObjectPtr")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObjectPtr'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetObjectPtr",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "SimpleGetObjectPtr")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "SimpleGetObjectPtr")
+		var outputValidationErr error
+		if value == nil {
+			outputValidationErr = fmt.Errorf("Response payload is nil")
+		} else {
+			outputValidationErr = validateDataRecursive(value, "")
+		}
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, outputValidationErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObjectPtr'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/SimpleGetObjectPtr",
+				Extensions: map[string]string{},
+			}
+			ginCtx.JSON(outputValidationStatusCode, outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "SimpleGetObjectPtr")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "SimpleGetObjectPtr")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/simple-get-object-ptr")), e2EcontrollerSimpleGetObjectPtrHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/simple-get-object-ptr")), e2EcontrollerSimpleGetObjectPtrHandler)
+	e2EcontrollerSimpleGetObjectNullErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectNullRoute := withBasePath(basePath, "/e2e/simple-get-object-null")
+	e2EcontrollerSimpleGetObjectNullHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"SimpleGetObjectNull",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetObjectNullRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "SimpleGetObjectNull")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "SimpleGetObjectNull")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "SimpleGetObjectNull")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "SimpleGetObjectNull")
+			}
+		}()
+		value, opError := controller.SimpleGetObjectNull()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "SimpleGetObjectNull")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObjectNull'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetObjectNull",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "SimpleGetObjectNull")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "SimpleGetObjectNull")
+		var outputValidationErr error
+		if value == nil {
+			outputValidationErr = fmt.Errorf("Response payload is nil")
+		} else {
+			outputValidationErr = validateDataRecursive(value, "")
+		}
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, outputValidationErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObjectNull'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/SimpleGetObjectNull",
+				Extensions: map[string]string{},
+			}
+			ginCtx.JSON(outputValidationStatusCode, outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "SimpleGetObjectNull")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "SimpleGetObjectNull")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/simple-get-object-null")), e2EcontrollerSimpleGetObjectNullHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/simple-get-object-null")), e2EcontrollerSimpleGetObjectNullHandler)
+	e2EcontrollerPrimitiveReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-return-type")
+	e2EcontrollerPrimitiveReturnTypeHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"PrimitiveReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveReturnTypeRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "PrimitiveReturnType")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "PrimitiveReturnType")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "PrimitiveReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "PrimitiveReturnType")
+			}
+		}()
+		value, opError := controller.PrimitiveReturnType()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "PrimitiveReturnType")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PrimitiveReturnType'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PrimitiveReturnType",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "PrimitiveReturnType")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "PrimitiveReturnType")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "PrimitiveReturnType")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "PrimitiveReturnType")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/primitive-return-type")), e2EcontrollerPrimitiveReturnTypeHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/primitive-return-type")), e2EcontrollerPrimitiveReturnTypeHandler)
+	e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveArrayReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-array-return-type")
+	e2EcontrollerPrimitiveArrayReturnTypeHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"PrimitiveArrayReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveArrayReturnTypeRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "PrimitiveArrayReturnType")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "PrimitiveArrayReturnType")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "PrimitiveArrayReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "PrimitiveArrayReturnType")
+			}
+		}()
+		value, opError := controller.PrimitiveArrayReturnType()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "PrimitiveArrayReturnType")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PrimitiveArrayReturnType'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PrimitiveArrayReturnType",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "PrimitiveArrayReturnType")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "PrimitiveArrayReturnType")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "PrimitiveArrayReturnType")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "PrimitiveArrayReturnType")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/primitive-array-return-type")), e2EcontrollerPrimitiveArrayReturnTypeHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/primitive-array-return-type")), e2EcontrollerPrimitiveArrayReturnTypeHandler)
+	e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-alias-return-type")
+	e2EcontrollerPrimitiveAliasReturnTypeHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"PrimitiveAliasReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveAliasReturnTypeRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "PrimitiveAliasReturnType")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "PrimitiveAliasReturnType")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "PrimitiveAliasReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "PrimitiveAliasReturnType")
+			}
+		}()
+		value, opError := controller.PrimitiveAliasReturnType()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "PrimitiveAliasReturnType")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PrimitiveAliasReturnType'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PrimitiveAliasReturnType",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "PrimitiveAliasReturnType")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "PrimitiveAliasReturnType")
+		var outputValidationErr error
+		outputValidationErr = validateDataRecursive(value, "")
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, outputValidationErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'PrimitiveAliasReturnType'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/PrimitiveAliasReturnType",
+				Extensions: map[string]string{},
+			}
+			ginCtx.JSON(outputValidationStatusCode, outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "PrimitiveAliasReturnType")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "PrimitiveAliasReturnType")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/primitive-alias-return-type")), e2EcontrollerPrimitiveAliasReturnTypeHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/primitive-alias-return-type")), e2EcontrollerPrimitiveAliasReturnTypeHandler)
+	e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasArrayReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-alias-array-return-type")
+	e2EcontrollerPrimitiveAliasArrayReturnTypeHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"PrimitiveAliasArrayReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveAliasArrayReturnTypeRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "PrimitiveAliasArrayReturnType")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "PrimitiveAliasArrayReturnType")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "PrimitiveAliasArrayReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "PrimitiveAliasArrayReturnType")
+			}
+		}()
+		value, opError := controller.PrimitiveAliasArrayReturnType()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "PrimitiveAliasArrayReturnType")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PrimitiveAliasArrayReturnType'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PrimitiveAliasArrayReturnType",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "PrimitiveAliasArrayReturnType")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "PrimitiveAliasArrayReturnType")
+		var outputValidationErr error
+		outputValidationErr = validateDataRecursive(value, "")
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, outputValidationErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'PrimitiveAliasArrayReturnType'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/PrimitiveAliasArrayReturnType",
+				Extensions: map[string]string{},
+			}
+			ginCtx.JSON(outputValidationStatusCode, outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "PrimitiveAliasArrayReturnType")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "PrimitiveAliasArrayReturnType")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/primitive-alias-array-return-type")), e2EcontrollerPrimitiveAliasArrayReturnTypeHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/primitive-alias-array-return-type")), e2EcontrollerPrimitiveAliasArrayReturnTypeHandler)
+	e2EcontrollerSimpleGetEmptyErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyRoute := withBasePath(basePath, "/e2e/simple-get-empty")
+	e2EcontrollerSimpleGetEmptyHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"SimpleGetEmpty",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetEmptyRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "SimpleGetEmpty")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "SimpleGetEmpty")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		var queryParamRawPtr *string = nil
+		queryParamRaw, isqueryParamExists := ginCtx.GetQuery("queryParam")
+		if isqueryParamExists {
+			queryParam := queryParamRaw
+			queryParamRawPtr = &queryParam
+		}
+		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "queryParam"
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
+			ginCtx.Header("x-RunValidatorExtension", "SimpleGetEmpty")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "SimpleGetEmpty")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "SimpleGetEmpty")
+			}
+		}()
+		opError := controller.SimpleGetEmpty(*queryParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "SimpleGetEmpty")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetEmpty'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetEmpty",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "SimpleGetEmpty")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "SimpleGetEmpty")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "SimpleGetEmpty")
+		ginCtx.Status(statusCode)
+		ginCtx.Header("x-RouteEndRoutesExtension", "SimpleGetEmpty")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/simple-get-empty")), e2EcontrollerSimpleGetEmptyHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/simple-get-empty")), e2EcontrollerSimpleGetEmptyHandler)
+	e2EcontrollerGetWithAllParamsErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsRoute := withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")
+	e2EcontrollerGetWithAllParamsHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"GetWithAllParams",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetWithAllParamsRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "GetWithAllParams")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "GetWithAllParams")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		var queryParamRawPtr *string = nil
+		queryParamRaw, isqueryParamExists := ginCtx.GetQuery("queryParam")
+		if isqueryParamExists {
+			queryParam := queryParamRaw
+			queryParamRawPtr = &queryParam
+		}
+		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "queryParam"
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
+			ginCtx.Header("x-RunValidatorExtension", "GetWithAllParams")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var pathParamRawPtr *string = nil
+		pathParamRaw, ispathParamExists := ginCtx.Params.Get("pathParam")
+		if ispathParamExists {
+			pathParam := pathParamRaw
+			pathParamRawPtr = &pathParam
+		}
+		if validatorErr := validatorInstance.Var(pathParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "pathParam"
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
+			ginCtx.Header("x-RunValidatorExtension", "GetWithAllParams")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var headerParamRawPtr *string = nil
+		headerParamRaw := ginCtx.GetHeader("headerParam")
+		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("headerParam")]
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
+			ginCtx.Header("x-RunValidatorExtension", "GetWithAllParams")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "GetWithAllParams")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "GetWithAllParams")
+			}
+		}()
+		value, opError := controller.GetWithAllParams(*queryParamRawPtr, *pathParamRawPtr, *headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "GetWithAllParams")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GetWithAllParams'",
+				Status:     statusCode,
+				Instance:   "/controller/error/GetWithAllParams",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "GetWithAllParams")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "GetWithAllParams")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "GetWithAllParams")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "GetWithAllParams")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")), e2EcontrollerGetWithAllParamsHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")), e2EcontrollerGetWithAllParamsHandler)
+	e2EcontrollerGetWithAllParamsPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsPtrRoute := withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")
+	e2EcontrollerGetWithAllParamsPtrHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"GetWithAllParamsPtr",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetWithAllParamsPtrRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "GetWithAllParamsPtr")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "GetWithAllParamsPtr")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		var queryParamRawPtr *string = nil
+		queryParamRaw, isqueryParamExists := ginCtx.GetQuery("queryParam")
+		if isqueryParamExists {
+			queryParam := queryParamRaw
+			queryParamRawPtr = &queryParam
+		}
+		var pathParamRawPtr *string = nil
+		pathParamRaw, ispathParamExists := ginCtx.Params.Get("pathParam")
+		if ispathParamExists {
+			pathParam := pathParamRaw
+			pathParamRawPtr = &pathParam
+		}
+		if validatorErr := validatorInstance.Var(pathParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "pathParam"
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
+			ginCtx.Header("x-RunValidatorExtension", "GetWithAllParamsPtr")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var headerParamRawPtr *string = nil
+		headerParamRaw := ginCtx.GetHeader("headerParam")
+		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("headerParam")]
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "GetWithAllParamsPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "GetWithAllParamsPtr")
+			}
+		}()
+		value, opError := controller.GetWithAllParamsPtr(queryParamRawPtr, pathParamRawPtr, headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "GetWithAllParamsPtr")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GetWithAllParamsPtr'",
+				Status:     statusCode,
+				Instance:   "/controller/error/GetWithAllParamsPtr",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "GetWithAllParamsPtr")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "GetWithAllParamsPtr")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "GetWithAllParamsPtr")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "GetWithAllParamsPtr")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsPtrHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsPtrHandler)
+	e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsRequiredPtrRoute := withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")
+	e2EcontrollerGetWithAllParamsRequiredPtrHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"GetWithAllParamsRequiredPtr",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetWithAllParamsRequiredPtrRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "GetWithAllParamsRequiredPtr")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "GetWithAllParamsRequiredPtr")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		var queryParamRawPtr *string = nil
+		queryParamRaw, isqueryParamExists := ginCtx.GetQuery("queryParam")
+		if isqueryParamExists {
+			queryParam := queryParamRaw
+			queryParamRawPtr = &queryParam
+		}
+		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "queryParam"
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
+			ginCtx.Header("x-RunValidatorExtension", "GetWithAllParamsRequiredPtr")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var pathParamRawPtr *string = nil
+		pathParamRaw, ispathParamExists := ginCtx.Params.Get("pathParam")
+		if ispathParamExists {
+			pathParam := pathParamRaw
+			pathParamRawPtr = &pathParam
+		}
+		if validatorErr := validatorInstance.Var(pathParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "pathParam"
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
+			ginCtx.Header("x-RunValidatorExtension", "GetWithAllParamsRequiredPtr")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var headerParamRawPtr *string = nil
+		headerParamRaw := ginCtx.GetHeader("headerParam")
+		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("headerParam")]
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
+			ginCtx.Header("x-RunValidatorExtension", "GetWithAllParamsRequiredPtr")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "GetWithAllParamsRequiredPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "GetWithAllParamsRequiredPtr")
+			}
+		}()
+		value, opError := controller.GetWithAllParamsRequiredPtr(queryParamRawPtr, pathParamRawPtr, headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "GetWithAllParamsRequiredPtr")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GetWithAllParamsRequiredPtr'",
+				Status:     statusCode,
+				Instance:   "/controller/error/GetWithAllParamsRequiredPtr",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "GetWithAllParamsRequiredPtr")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "GetWithAllParamsRequiredPtr")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "GetWithAllParamsRequiredPtr")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "GetWithAllParamsRequiredPtr")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsRequiredPtrHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsRequiredPtrHandler)
+	e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyRoute := withBasePath(basePath, "/e2e/post-with-all-params-body")
+	e2EcontrollerPostWithAllParamsWithBodyHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"PostWithAllParamsWithBody",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostWithAllParamsWithBodyRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "PostWithAllParamsWithBody")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "PostWithAllParamsWithBody")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var queryParamRawPtr *string = nil
+		queryParamRaw, isqueryParamExists := ginCtx.GetQuery("queryParam")
+		if isqueryParamExists {
+			queryParam := queryParamRaw
+			queryParamRawPtr = &queryParam
+		}
+		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "queryParam"
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
+			ginCtx.Header("x-RunValidatorExtension", "PostWithAllParamsWithBody")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var headerParamRawPtr *string = nil
+		headerParamRaw := ginCtx.GetHeader("headerParam")
+		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("headerParam")]
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
+			ginCtx.Header("x-RunValidatorExtension", "PostWithAllParamsWithBody")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		var theBodyRawPtr *Param5theBody.BodyInfo = nil
+		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &theBodyRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, conversionErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBody",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/PostWithAllParamsWithBody",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBody")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBody")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "PostWithAllParamsWithBody")
+			}
+		}()
+		value, opError := controller.PostWithAllParamsWithBody(*queryParamRawPtr, *headerParamRawPtr, *theBodyRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "PostWithAllParamsWithBody")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBody'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PostWithAllParamsWithBody",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "PostWithAllParamsWithBody")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "PostWithAllParamsWithBody")
+		var outputValidationErr error
+		outputValidationErr = validateDataRecursive(value, "")
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, outputValidationErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBody'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/PostWithAllParamsWithBody",
+				Extensions: map[string]string{},
+			}
+			ginCtx.JSON(outputValidationStatusCode, outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "PostWithAllParamsWithBody")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "PostWithAllParamsWithBody")
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/post-with-all-params-body")), e2EcontrollerPostWithAllParamsWithBodyHandler)
+	e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyPtrRoute := withBasePath(basePath, "/e2e/post-with-all-params-body-ptr")
+	e2EcontrollerPostWithAllParamsWithBodyPtrHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"PostWithAllParamsWithBodyPtr",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostWithAllParamsWithBodyPtrRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "PostWithAllParamsWithBodyPtr")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "PostWithAllParamsWithBodyPtr")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var queryParamRawPtr *string = nil
+		queryParamRaw, isqueryParamExists := ginCtx.GetQuery("queryParam")
+		if isqueryParamExists {
+			queryParam := queryParamRaw
+			queryParamRawPtr = &queryParam
+		}
+		var headerParamRawPtr *string = nil
+		headerParamRaw := ginCtx.GetHeader("headerParam")
+		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("headerParam")]
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		var theBodyRawPtr *Param5theBody.BodyInfo = nil
+		conversionErr = bindAndValidateBody(ginCtx, "application/json", "", &theBodyRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, conversionErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/PostWithAllParamsWithBodyPtr",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBodyPtr")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -374,14 +4081,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "WithDefaultClassSecurity")
-		value, opError := controller.WithDefaultClassSecurity(*headerParamRawPtr)
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBodyPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "PostWithAllParamsWithBodyPtr")
+			}
+		}()
+		value, opError := controller.PostWithAllParamsWithBodyPtr(queryParamRawPtr, headerParamRawPtr, theBodyRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "WithDefaultClassSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "PostWithAllParamsWithBodyPtr")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -394,16 +4108,44 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithDefaultClassSecurity'",
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyPtr'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithDefaultClassSecurity",
+				Instance:   "/controller/error/PostWithAllParamsWithBodyPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "WithDefaultClassSecurity")
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "PostWithAllParamsWithBodyPtr")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "WithDefaultClassSecurity")
+		ginCtx.Header("x-JsonResponseExtension", "PostWithAllParamsWithBodyPtr")
+		var outputValidationErr error
+		if value == nil {
+			outputValidationErr = fmt.Errorf("Response payload is nil")
+		} else {
+			outputValidationErr = validateDataRecursive(value, "")
+		}
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, outputValidationErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyPtr'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/PostWithAllParamsWithBodyPtr",
+				Extensions: map[string]string{},
+			}
+			ginCtx.JSON(outputValidationStatusCode, outputValidationRfc7807Error)
+			return
+		}
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -413,12 +4155,26 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "WithDefaultClassSecurity")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "PostWithAllParamsWithBodyPtr")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "WithDefaultClassSecurity")
-	})
-	engine.GET(toGinUrl("/e2e/with-default-override-class-security"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "WithOverrideClassSecurity")
+		ginCtx.Header("x-RouteEndRoutesExtension", "PostWithAllParamsWithBodyPtr")
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-ptr")), e2EcontrollerPostWithAllParamsWithBodyPtrHandler)
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrRoute := withBasePath(basePath, "/e2e/post-with-all-params-body-required-ptr")
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"PostWithAllParamsWithBodyRequiredPtr",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostWithAllParamsWithBodyRequiredPtrRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -426,9 +4182,9 @@ func RegisterRoutes(engine *gin.Engine) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName",
+							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"method",
+								"config",
 							},
 						},
 					},
@@ -436,34 +4192,47 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "WithOverrideClassSecurity")
+			handleAuthorizationError(ginCtx, authErr, "PostWithAllParamsWithBodyRequiredPtr")
 			return
 		}
-		controller := E2EClassSecController.E2EClassSecController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
-		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-test-scopes")]
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var theBodyRawPtr *Param5theBody.BodyInfo = nil
+		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &theBodyRawPtr)
+		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
 			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, conversionErr)
 				setRequestContext(ginCtx, middlewareCtx)
 				if !continueOperation {
 					return
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName)
-			ginCtx.Header("x-RunValidatorExtension", "WithOverrideClassSecurity")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyRequiredPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/PostWithAllParamsWithBodyRequiredPtr",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -473,14 +4242,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "WithOverrideClassSecurity")
-		value, opError := controller.WithOverrideClassSecurity(*headerParamRawPtr)
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "PostWithAllParamsWithBodyRequiredPtr")
+			}
+		}()
+		value, opError := controller.PostWithAllParamsWithBodyRequiredPtr(theBodyRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "WithOverrideClassSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -493,16 +4269,44 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithOverrideClassSecurity'",
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyRequiredPtr'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithOverrideClassSecurity",
+				Instance:   "/controller/error/PostWithAllParamsWithBodyRequiredPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "WithOverrideClassSecurity")
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "WithOverrideClassSecurity")
+		ginCtx.Header("x-JsonResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		var outputValidationErr error
+		if value == nil {
+			outputValidationErr = fmt.Errorf("Response payload is nil")
+		} else {
+			outputValidationErr = validateDataRecursive(value, "")
+		}
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, outputValidationErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyRequiredPtr'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/PostWithAllParamsWithBodyRequiredPtr",
+				Extensions: map[string]string{},
+			}
+			ginCtx.JSON(outputValidationStatusCode, outputValidationRfc7807Error)
+			return
+		}
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -512,13 +4316,26 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "WithOverrideClassSecurity")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "WithOverrideClassSecurity")
-	})
-	// E2EController
-	engine.GET(toGinUrl("/e2e/simple-get"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "SimpleGet")
+		ginCtx.Header("x-RouteEndRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-required-ptr")), e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler)
+	e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes := []int{}
+	e2EcontrollerGetHeaderStartWithLetterRoute := withBasePath(basePath, "/e2e/get-header-start-with-letter")
+	e2EcontrollerGetHeaderStartWithLetterHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"GetHeaderStartWithLetter",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetHeaderStartWithLetterRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "GetHeaderStartWithLetter")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -536,11 +4353,38 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "SimpleGet")
+			handleAuthorizationError(ginCtx, authErr, "GetHeaderStartWithLetter")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := ginCtx.GetHeader("headerParam")
+		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("headerParam")]
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required,validate_starts_with_letter"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
+			ginCtx.Header("x-RunValidatorExtension", "GetHeaderStartWithLetter")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -550,14 +4394,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "SimpleGet")
-		value, opError := controller.SimpleGet()
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "GetHeaderStartWithLetter")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "GetHeaderStartWithLetter")
+			}
+		}()
+		value, opError := controller.GetHeaderStartWithLetter(*headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "SimpleGet")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "GetHeaderStartWithLetter")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -570,16 +4421,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGet'",
+				Detail:     "Encountered an error during operation 'GetHeaderStartWithLetter'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGet",
+				Instance:   "/controller/error/GetHeaderStartWithLetter",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "SimpleGet")
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "GetHeaderStartWithLetter")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "SimpleGet")
+		ginCtx.Header("x-JsonResponseExtension", "GetHeaderStartWithLetter")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -589,12 +4441,27 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "SimpleGet")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "GetHeaderStartWithLetter")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "SimpleGet")
-	})
-	engine.GET(toGinUrl("/e2e/simple-get-empty-string"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "SimpleGetEmptyString")
+		ginCtx.Header("x-RouteEndRoutesExtension", "GetHeaderStartWithLetter")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/get-header-start-with-letter")), e2EcontrollerGetHeaderStartWithLetterHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/get-header-start-with-letter")), e2EcontrollerGetHeaderStartWithLetterHandler)
+	e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithDefaultConfigSecurityRoute := withBasePath(basePath, "/e2e/with-default-config-security")
+	e2EcontrollerWithDefaultConfigSecurityHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"WithDefaultConfigSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithDefaultConfigSecurityRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "WithDefaultConfigSecurity")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -612,11 +4479,38 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "SimpleGetEmptyString")
+			handleAuthorizationError(ginCtx, authErr, "WithDefaultConfigSecurity")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
+		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-test-scopes")]
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			ginCtx.Header("x-RunValidatorExtension", "WithDefaultConfigSecurity")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -626,14 +4520,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "SimpleGetEmptyString")
-		value, opError := controller.SimpleGetEmptyString()
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "WithDefaultConfigSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "WithDefaultConfigSecurity")
+			}
+		}()
+		value, opError := controller.WithDefaultConfigSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "SimpleGetEmptyString")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "WithDefaultConfigSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -646,16 +4547,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetEmptyString'",
+				Detail:     "Encountered an error during operation 'WithDefaultConfigSecurity'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetEmptyString",
+				Instance:   "/controller/error/WithDefaultConfigSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "SimpleGetEmptyString")
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "WithDefaultConfigSecurity")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "SimpleGetEmptyString")
+		ginCtx.Header("x-JsonResponseExtension", "WithDefaultConfigSecurity")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -665,12 +4567,27 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "SimpleGetEmptyString")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "WithDefaultConfigSecurity")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "SimpleGetEmptyString")
-	})
-	engine.GET(toGinUrl("/e2e/simple-get-ptr-string"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "SimpleGetPtrString")
+		ginCtx.Header("x-RouteEndRoutesExtension", "WithDefaultConfigSecurity")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/with-default-config-security")), e2EcontrollerWithDefaultConfigSecurityHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/with-default-config-security")), e2EcontrollerWithDefaultConfigSecurityHandler)
+	e2EcontrollerWithOneSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithOneSecurityRoute := withBasePath(basePath, "/e2e/with-one-security")
+	e2EcontrollerWithOneSecurityHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"WithOneSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithOneSecurityRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "WithOneSecurity")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -678,9 +4595,9 @@ func RegisterRoutes(engine *gin.Engine) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName2",
+							SchemaName: "securitySchemaName",
 							Scopes: []string{
-								"config",
+								"other",
 							},
 						},
 					},
@@ -688,11 +4605,38 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "SimpleGetPtrString")
+			handleAuthorizationError(ginCtx, authErr, "WithOneSecurity")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
+		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-test-scopes")]
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			ginCtx.Header("x-RunValidatorExtension", "WithOneSecurity")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -702,14 +4646,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "SimpleGetPtrString")
-		value, opError := controller.SimpleGetPtrString()
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "WithOneSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "WithOneSecurity")
+			}
+		}()
+		value, opError := controller.WithOneSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "SimpleGetPtrString")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "WithOneSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -722,16 +4673,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetPtrString'",
+				Detail:     "Encountered an error during operation 'WithOneSecurity'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetPtrString",
+				Instance:   "/controller/error/WithOneSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "SimpleGetPtrString")
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "WithOneSecurity")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "SimpleGetPtrString")
+		ginCtx.Header("x-JsonResponseExtension", "WithOneSecurity")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -741,22 +4693,49 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "SimpleGetPtrString")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "WithOneSecurity")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "SimpleGetPtrString")
-	})
-	engine.GET(toGinUrl("/e2e/simple-get-null-string"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "SimpleGetNullString")
+		ginCtx.Header("x-RouteEndRoutesExtension", "WithOneSecurity")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/with-one-security")), e2EcontrollerWithOneSecurityHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/with-one-security")), e2EcontrollerWithOneSecurityHandler)
+	e2EcontrollerWithTwoSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecurityRoute := withBasePath(basePath, "/e2e/with-two-security")
+	e2EcontrollerWithTwoSecurityHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"WithTwoSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithTwoSecurityRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "WithTwoSecurity")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
 				{
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
 							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"config",
+								"write",
+								"read",
 							},
 						},
 					},
@@ -764,11 +4743,38 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "SimpleGetNullString")
+			handleAuthorizationError(ginCtx, authErr, "WithTwoSecurity")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
+		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-test-scopes")]
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			ginCtx.Header("x-RunValidatorExtension", "WithTwoSecurity")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -778,14 +4784,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "SimpleGetNullString")
-		value, opError := controller.SimpleGetNullString()
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "WithTwoSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "WithTwoSecurity")
+			}
+		}()
+		value, opError := controller.WithTwoSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "SimpleGetNullString")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "WithTwoSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -798,16 +4811,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetNullString'",
+				Detail:     "Encountered an error during operation 'WithTwoSecurity'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetNullString",
+				Instance:   "/controller/error/WithTwoSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "SimpleGetNullString")
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "WithTwoSecurity")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "SimpleGetNullString")
+		ginCtx.Header("x-JsonResponseExtension", "WithTwoSecurity")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -817,22 +4831,54 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "SimpleGetNullString")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "WithTwoSecurity")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "SimpleGetNullString")
-	})
-	engine.GET(toGinUrl("/e2e/simple-get-object"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "SimpleGetObject")
+		ginCtx.Header("x-RouteEndRoutesExtension", "WithTwoSecurity")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/with-two-security")), e2EcontrollerWithTwoSecurityHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/with-two-security")), e2EcontrollerWithTwoSecurityHandler)
+	e2EcontrollerWithAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithAdvancedSecurityRoute := withBasePath(basePath, "/e2e/with-advanced-security")
+	e2EcontrollerWithAdvancedSecurityHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"WithAdvancedSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithAdvancedSecurityRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "WithAdvancedSecurity")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
 				{
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
 						{
 							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"config",
+								"write",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName3",
+							Scopes: []string{
+								"admin",
 							},
 						},
 					},
@@ -840,11 +4886,38 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "SimpleGetObject")
+			handleAuthorizationError(ginCtx, authErr, "WithAdvancedSecurity")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
+		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-test-scopes")]
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithAdvancedSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			ginCtx.Header("x-RunValidatorExtension", "WithAdvancedSecurity")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -854,14 +4927,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "SimpleGetObject")
-		value, opError := controller.SimpleGetObject()
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "WithAdvancedSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "WithAdvancedSecurity")
+			}
+		}()
+		value, opError := controller.WithAdvancedSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "SimpleGetObject")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "WithAdvancedSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -874,39 +4954,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObject'",
+				Detail:     "Encountered an error during operation 'WithAdvancedSecurity'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetObject",
+				Instance:   "/controller/error/WithAdvancedSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "SimpleGetObject")
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "WithAdvancedSecurity")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "SimpleGetObject")
-		var outputValidationErr error
-		outputValidationErr = validateDataRecursive(value, "")
-		if outputValidationErr != nil {
-			// Middlewares onOutputValidationMiddlewares section
-			for _, middleware := range onOutputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, outputValidationErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onOutputValidationMiddlewares section
-			outputValidationStatusCode := http.StatusInternalServerError
-			outputValidationRfc7807Error := runtime.Rfc7807Error{
-				Type:       http.StatusText(outputValidationStatusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObject'",
-				Status:     outputValidationStatusCode,
-				Instance:   "/controller/error/SimpleGetObject",
-				Extensions: map[string]string{},
-			}
-			ginCtx.JSON(outputValidationStatusCode, outputValidationRfc7807Error)
-			return
-		}
+		ginCtx.Header("x-JsonResponseExtension", "WithAdvancedSecurity")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -916,12 +4974,27 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "SimpleGetObject")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "WithAdvancedSecurity")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "SimpleGetObject")
-	})
-	engine.GET(toGinUrl("/e2e/simple-get-object-ptr"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "SimpleGetObjectPtr")
+		ginCtx.Header("x-RouteEndRoutesExtension", "WithAdvancedSecurity")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/with-advanced-security")), e2EcontrollerWithAdvancedSecurityHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/with-advanced-security")), e2EcontrollerWithAdvancedSecurityHandler)
+	e2EcontrollerWithPrincipalErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalRoute := withBasePath(basePath, "/e2e/with-principal")
+	e2EcontrollerWithPrincipalHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"WithPrincipal",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithPrincipalRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "WithPrincipal")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -929,9 +5002,9 @@ func RegisterRoutes(engine *gin.Engine) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName2",
+							SchemaName: "securitySchemaName",
 							Scopes: []string{
-								"config",
+								"other",
 							},
 						},
 					},
@@ -939,11 +5012,22 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "SimpleGetObjectPtr")
+			handleAuthorizationError(ginCtx, authErr, "WithPrincipal")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(ginCtx))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			handleAuthorizationError(ginCtx, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipal")
+			return
+		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -953,14 +5037,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "SimpleGetObjectPtr")
-		value, opError := controller.SimpleGetObjectPtr()
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "WithPrincipal")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "WithPrincipal")
+			}
+		}()
+		value, opError := controller.WithPrincipal(principalRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "SimpleGetObjectPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "WithPrincipal")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -973,43 +5064,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObjectPtr'",
+				Detail:     "Encountered an error during operation 'WithPrincipal'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetObjectPtr",
+				Instance:   "/controller/error/WithPrincipal",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "SimpleGetObjectPtr")
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "WithPrincipal")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "SimpleGetObjectPtr")
-		var outputValidationErr error
-		if value == nil {
-			outputValidationErr = fmt.Errorf("Response payload is nil")
-		} else {
-			outputValidationErr = validateDataRecursive(value, "")
-		}
-		if outputValidationErr != nil {
-			// Middlewares onOutputValidationMiddlewares section
-			for _, middleware := range onOutputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, outputValidationErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onOutputValidationMiddlewares section
-			outputValidationStatusCode := http.StatusInternalServerError
-			outputValidationRfc7807Error := runtime.Rfc7807Error{
-				Type:       http.StatusText(outputValidationStatusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObjectPtr'",
-				Status:     outputValidationStatusCode,
-				Instance:   "/controller/error/SimpleGetObjectPtr",
-				Extensions: map[string]string{},
-			}
-			ginCtx.JSON(outputValidationStatusCode, outputValidationRfc7807Error)
-			return
-		}
+		ginCtx.Header("x-JsonResponseExtension", "WithPrincipal")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1019,22 +5084,54 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "SimpleGetObjectPtr")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "WithPrincipal")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "SimpleGetObjectPtr")
-	})
-	engine.GET(toGinUrl("/e2e/simple-get-object-null"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "SimpleGetObjectNull")
+		ginCtx.Header("x-RouteEndRoutesExtension", "WithPrincipal")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/with-principal")), e2EcontrollerWithPrincipalHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/with-principal")), e2EcontrollerWithPrincipalHandler)
+	e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalAdvancedSecurityRoute := withBasePath(basePath, "/e2e/with-principal-advanced-security")
+	e2EcontrollerWithPrincipalAdvancedSecurityHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"WithPrincipalAdvancedSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithPrincipalAdvancedSecurityRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "WithPrincipalAdvancedSecurity")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
 				{
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
 						{
 							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"config",
+								"write",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName3",
+							Scopes: []string{
+								"admin",
 							},
 						},
 					},
@@ -1042,11 +5139,22 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "SimpleGetObjectNull")
+			handleAuthorizationError(ginCtx, authErr, "WithPrincipalAdvancedSecurity")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(ginCtx))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			handleAuthorizationError(ginCtx, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipalAdvancedSecurity")
+			return
+		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1056,14 +5164,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "SimpleGetObjectNull")
-		value, opError := controller.SimpleGetObjectNull()
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "WithPrincipalAdvancedSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "WithPrincipalAdvancedSecurity")
+			}
+		}()
+		value, opError := controller.WithPrincipalAdvancedSecurity(principalRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "SimpleGetObjectNull")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "WithPrincipalAdvancedSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1076,43 +5191,97 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObjectNull'",
+				Detail:     "Encountered an error during operation 'WithPrincipalAdvancedSecurity'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetObjectNull",
+				Instance:   "/controller/error/WithPrincipalAdvancedSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "SimpleGetObjectNull")
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "WithPrincipalAdvancedSecurity")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "SimpleGetObjectNull")
-		var outputValidationErr error
-		if value == nil {
-			outputValidationErr = fmt.Errorf("Response payload is nil")
-		} else {
-			outputValidationErr = validateDataRecursive(value, "")
+		ginCtx.Header("x-JsonResponseExtension", "WithPrincipalAdvancedSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "WithPrincipalAdvancedSecurity")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "WithPrincipalAdvancedSecurity")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/with-principal-advanced-security")), e2EcontrollerWithPrincipalAdvancedSecurityHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/with-principal-advanced-security")), e2EcontrollerWithPrincipalAdvancedSecurityHandler)
+	e2EcontrollerPublicRouteErrorStatusCodes := []int{}
+	e2EcontrollerPublicRouteRoute := withBasePath(basePath, "/e2e/public")
+	e2EcontrollerPublicRouteHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"PublicRoute",
+			"E2EController",
+			"GET",
+			e2EcontrollerPublicRouteRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "PublicRoute")
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
 		}
-		if outputValidationErr != nil {
-			// Middlewares onOutputValidationMiddlewares section
-			for _, middleware := range onOutputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, outputValidationErr)
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "PublicRoute")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "PublicRoute")
+			}
+		}()
+		value, opError := controller.PublicRoute()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "PublicRoute")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
 				setRequestContext(ginCtx, middlewareCtx)
 				if !continueOperation {
 					return
 				}
 			}
-			// End middlewares onOutputValidationMiddlewares section
-			outputValidationStatusCode := http.StatusInternalServerError
-			outputValidationRfc7807Error := runtime.Rfc7807Error{
-				Type:       http.StatusText(outputValidationStatusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObjectNull'",
-				Status:     outputValidationStatusCode,
-				Instance:   "/controller/error/SimpleGetObjectNull",
-				Extensions: map[string]string{},
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PublicRoute'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PublicRoute",
+				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.JSON(outputValidationStatusCode, outputValidationRfc7807Error)
+			applyErrorMapping(&stdError, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "PublicRoute")
+			ginCtx.JSON(statusCode, stdError)
 			return
 		}
+		ginCtx.Header("x-JsonResponseExtension", "PublicRoute")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1122,12 +5291,27 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "SimpleGetObjectNull")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "PublicRoute")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "SimpleGetObjectNull")
-	})
-	engine.GET(toGinUrl("/e2e/primitive-return-type"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "PrimitiveReturnType")
+		ginCtx.Header("x-RouteEndRoutesExtension", "PublicRoute")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/public")), e2EcontrollerPublicRouteHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/public")), e2EcontrollerPublicRouteHandler)
+	e2EcontrollerHeadCheckErrorStatusCodes := []int{}
+	e2EcontrollerHeadCheckRoute := withBasePath(basePath, "/e2e/head-check")
+	e2EcontrollerHeadCheckHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"HeadCheck",
+			"E2EController",
+			"HEAD",
+			e2EcontrollerHeadCheckRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "HeadCheck")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -1145,11 +5329,13 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "PrimitiveReturnType")
+			handleAuthorizationError(ginCtx, authErr, "HeadCheck")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1159,14 +5345,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "PrimitiveReturnType")
-		value, opError := controller.PrimitiveReturnType()
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "HeadCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "HeadCheck")
+			}
+		}()
+		opError := controller.HeadCheck()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "PrimitiveReturnType")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "HeadCheck")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerHeadCheckErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1179,16 +5372,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'PrimitiveReturnType'",
+				Detail:     "Encountered an error during operation 'HeadCheck'",
 				Status:     statusCode,
-				Instance:   "/controller/error/PrimitiveReturnType",
+				Instance:   "/controller/error/HeadCheck",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "PrimitiveReturnType")
+			applyErrorMapping(&stdError, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "HeadCheck")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "PrimitiveReturnType")
+		ginCtx.Header("x-JsonResponseExtension", "HeadCheck")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1198,12 +5392,26 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "PrimitiveReturnType")
-		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "PrimitiveReturnType")
-	})
-	engine.GET(toGinUrl("/e2e/primitive-array-return-type"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "PrimitiveArrayReturnType")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "HeadCheck")
+		ginCtx.Status(statusCode)
+		ginCtx.Header("x-RouteEndRoutesExtension", "HeadCheck")
+	}
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/head-check")), e2EcontrollerHeadCheckHandler)
+	e2EcontrollerOptionsCheckErrorStatusCodes := []int{}
+	e2EcontrollerOptionsCheckRoute := withBasePath(basePath, "/e2e/options-check")
+	e2EcontrollerOptionsCheckHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"OptionsCheck",
+			"E2EController",
+			"OPTIONS",
+			e2EcontrollerOptionsCheckRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "OptionsCheck")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -1221,11 +5429,13 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "PrimitiveArrayReturnType")
+			handleAuthorizationError(ginCtx, authErr, "OptionsCheck")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1235,14 +5445,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "PrimitiveArrayReturnType")
-		value, opError := controller.PrimitiveArrayReturnType()
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "OptionsCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "OptionsCheck")
+			}
+		}()
+		value, opError := controller.OptionsCheck()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "PrimitiveArrayReturnType")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "OptionsCheck")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1255,16 +5472,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'PrimitiveArrayReturnType'",
+				Detail:     "Encountered an error during operation 'OptionsCheck'",
 				Status:     statusCode,
-				Instance:   "/controller/error/PrimitiveArrayReturnType",
+				Instance:   "/controller/error/OptionsCheck",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "PrimitiveArrayReturnType")
+			applyErrorMapping(&stdError, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "OptionsCheck")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "PrimitiveArrayReturnType")
+		ginCtx.Header("x-JsonResponseExtension", "OptionsCheck")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1274,12 +5492,26 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "PrimitiveArrayReturnType")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "OptionsCheck")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "PrimitiveArrayReturnType")
-	})
-	engine.GET(toGinUrl("/e2e/primitive-alias-return-type"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "PrimitiveAliasReturnType")
+		ginCtx.Header("x-RouteEndRoutesExtension", "OptionsCheck")
+	}
+	engine.Handle("OPTIONS", toGinUrl(withBasePath(basePath, "/e2e/options-check")), e2EcontrollerOptionsCheckHandler)
+	e2EcontrollerTraceCheckErrorStatusCodes := []int{}
+	e2EcontrollerTraceCheckRoute := withBasePath(basePath, "/e2e/trace-check")
+	e2EcontrollerTraceCheckHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"TraceCheck",
+			"E2EController",
+			"TRACE",
+			e2EcontrollerTraceCheckRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "TraceCheck")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -1297,11 +5529,13 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "PrimitiveAliasReturnType")
+			handleAuthorizationError(ginCtx, authErr, "TraceCheck")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1311,14 +5545,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "PrimitiveAliasReturnType")
-		value, opError := controller.PrimitiveAliasReturnType()
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "TraceCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "TraceCheck")
+			}
+		}()
+		value, opError := controller.TraceCheck()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "PrimitiveAliasReturnType")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "TraceCheck")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTraceCheckErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1331,39 +5572,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'PrimitiveAliasReturnType'",
+				Detail:     "Encountered an error during operation 'TraceCheck'",
 				Status:     statusCode,
-				Instance:   "/controller/error/PrimitiveAliasReturnType",
+				Instance:   "/controller/error/TraceCheck",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "PrimitiveAliasReturnType")
+			applyErrorMapping(&stdError, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "TraceCheck")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "PrimitiveAliasReturnType")
-		var outputValidationErr error
-		outputValidationErr = validateDataRecursive(value, "")
-		if outputValidationErr != nil {
-			// Middlewares onOutputValidationMiddlewares section
-			for _, middleware := range onOutputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, outputValidationErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onOutputValidationMiddlewares section
-			outputValidationStatusCode := http.StatusInternalServerError
-			outputValidationRfc7807Error := runtime.Rfc7807Error{
-				Type:       http.StatusText(outputValidationStatusCode),
-				Detail:     "Encountered an error during operation 'PrimitiveAliasReturnType'",
-				Status:     outputValidationStatusCode,
-				Instance:   "/controller/error/PrimitiveAliasReturnType",
-				Extensions: map[string]string{},
-			}
-			ginCtx.JSON(outputValidationStatusCode, outputValidationRfc7807Error)
-			return
-		}
+		ginCtx.Header("x-JsonResponseExtension", "TraceCheck")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1373,12 +5592,26 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "PrimitiveAliasReturnType")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "TraceCheck")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "PrimitiveAliasReturnType")
-	})
-	engine.GET(toGinUrl("/e2e/primitive-alias-array-return-type"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "PrimitiveAliasArrayReturnType")
+		ginCtx.Header("x-RouteEndRoutesExtension", "TraceCheck")
+	}
+	engine.Handle("TRACE", toGinUrl(withBasePath(basePath, "/e2e/trace-check")), e2EcontrollerTraceCheckHandler)
+	e2EcontrollerPurgeCheckErrorStatusCodes := []int{}
+	e2EcontrollerPurgeCheckRoute := withBasePath(basePath, "/e2e/purge-check")
+	e2EcontrollerPurgeCheckHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"PurgeCheck",
+			"E2EController",
+			"PURGE",
+			e2EcontrollerPurgeCheckRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "PurgeCheck")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -1396,11 +5629,13 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "PrimitiveAliasArrayReturnType")
+			handleAuthorizationError(ginCtx, authErr, "PurgeCheck")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1410,14 +5645,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "PrimitiveAliasArrayReturnType")
-		value, opError := controller.PrimitiveAliasArrayReturnType()
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "PurgeCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "PurgeCheck")
+			}
+		}()
+		value, opError := controller.PurgeCheck()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "PrimitiveAliasArrayReturnType")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "PurgeCheck")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1430,39 +5672,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'PrimitiveAliasArrayReturnType'",
+				Detail:     "Encountered an error during operation 'PurgeCheck'",
 				Status:     statusCode,
-				Instance:   "/controller/error/PrimitiveAliasArrayReturnType",
+				Instance:   "/controller/error/PurgeCheck",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "PrimitiveAliasArrayReturnType")
+			applyErrorMapping(&stdError, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "PurgeCheck")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "PrimitiveAliasArrayReturnType")
-		var outputValidationErr error
-		outputValidationErr = validateDataRecursive(value, "")
-		if outputValidationErr != nil {
-			// Middlewares onOutputValidationMiddlewares section
-			for _, middleware := range onOutputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, outputValidationErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onOutputValidationMiddlewares section
-			outputValidationStatusCode := http.StatusInternalServerError
-			outputValidationRfc7807Error := runtime.Rfc7807Error{
-				Type:       http.StatusText(outputValidationStatusCode),
-				Detail:     "Encountered an error during operation 'PrimitiveAliasArrayReturnType'",
-				Status:     outputValidationStatusCode,
-				Instance:   "/controller/error/PrimitiveAliasArrayReturnType",
-				Extensions: map[string]string{},
-			}
-			ginCtx.JSON(outputValidationStatusCode, outputValidationRfc7807Error)
-			return
-		}
+		ginCtx.Header("x-JsonResponseExtension", "PurgeCheck")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1472,12 +5692,26 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "PrimitiveAliasArrayReturnType")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "PurgeCheck")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "PrimitiveAliasArrayReturnType")
-	})
-	engine.GET(toGinUrl("/e2e/simple-get-empty"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "SimpleGetEmpty")
+		ginCtx.Header("x-RouteEndRoutesExtension", "PurgeCheck")
+	}
+	engine.Handle("PURGE", toGinUrl(withBasePath(basePath, "/e2e/purge-check")), e2EcontrollerPurgeCheckHandler)
+	e2EcontrollerVersionedV1ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV1Route := withBasePath(basePath, "/e2e/versioned")
+	e2EcontrollerVersionedV1Handler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"VersionedV1",
+			"E2EController",
+			"GET",
+			e2EcontrollerVersionedV1Route,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "VersionedV1")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -1495,33 +5729,13 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "SimpleGetEmpty")
+			handleAuthorizationError(ginCtx, authErr, "VersionedV1")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var queryParamRawPtr *string = nil
-		queryParamRaw, isqueryParamExists := ginCtx.GetQuery("queryParam")
-		if isqueryParamExists {
-			queryParam := queryParamRaw
-			queryParamRawPtr = &queryParam
-		}
-		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName)
-			ginCtx.Header("x-RunValidatorExtension", "SimpleGetEmpty")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
-			return
-		}
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1531,14 +5745,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "SimpleGetEmpty")
-		opError := controller.SimpleGetEmpty(*queryParamRawPtr)
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "VersionedV1")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "VersionedV1")
+			}
+		}()
+		value, opError := controller.VersionedV1()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "SimpleGetEmpty")
-		statusCode := getStatusCode(&controller, false, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "VersionedV1")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1551,16 +5772,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetEmpty'",
+				Detail:     "Encountered an error during operation 'VersionedV1'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetEmpty",
+				Instance:   "/controller/error/VersionedV1",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "SimpleGetEmpty")
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "VersionedV1")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "SimpleGetEmpty")
+		ginCtx.Header("x-JsonResponseExtension", "VersionedV1")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1570,12 +5792,28 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "SimpleGetEmpty")
-		ginCtx.Status(statusCode)
-		ginCtx.Header("x-RouteEndRoutesExtension", "SimpleGetEmpty")
-	})
-	engine.GET(toGinUrl("/e2e/get-with-all-params/{pathParam}"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "GetWithAllParams")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "VersionedV1")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "VersionedV1")
+	}
+	e2EcontrollerVersionedV1Versions := []int{1}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV1Versions, e2EcontrollerVersionedV1Handler)
+	registerVersionedRoute(engine, basePath, "HEAD", "/e2e/versioned", e2EcontrollerVersionedV1Versions, e2EcontrollerVersionedV1Handler)
+	e2EcontrollerVersionedV2ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV2Route := withBasePath(basePath, "/e2e/versioned")
+	e2EcontrollerVersionedV2Handler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"VersionedV2",
+			"E2EController",
+			"GET",
+			e2EcontrollerVersionedV2Route,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "VersionedV2")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -1593,78 +5831,119 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "GetWithAllParams")
+			handleAuthorizationError(ginCtx, authErr, "VersionedV2")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var queryParamRawPtr *string = nil
-		queryParamRaw, isqueryParamExists := ginCtx.GetQuery("queryParam")
-		if isqueryParamExists {
-			queryParam := queryParamRaw
-			queryParamRawPtr = &queryParam
-		}
-		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
 			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
-			ginCtx.Header("x-RunValidatorExtension", "GetWithAllParams")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
-			return
 		}
-		var pathParamRawPtr *string = nil
-		pathParamRaw, ispathParamExists := ginCtx.Params.Get("pathParam")
-		if ispathParamExists {
-			pathParam := pathParamRaw
-			pathParamRawPtr = &pathParam
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "VersionedV2")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "VersionedV2")
+			}
+		}()
+		value, opError := controller.VersionedV2()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
 		}
-		if validatorErr := validatorInstance.Var(pathParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "VersionedV2")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
 				setRequestContext(ginCtx, middlewareCtx)
 				if !continueOperation {
 					return
 				}
 			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
-			ginCtx.Header("x-RunValidatorExtension", "GetWithAllParams")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV2'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV2",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "VersionedV2")
+			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		var headerParamRawPtr *string = nil
-		headerParamRaw := ginCtx.GetHeader("headerParam")
-		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("headerParam")]
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
+		ginCtx.Header("x-JsonResponseExtension", "VersionedV2")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
 			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
-			ginCtx.Header("x-RunValidatorExtension", "GetWithAllParams")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "VersionedV2")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "VersionedV2")
+	}
+	e2EcontrollerVersionedV2Versions := []int{2}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV2Versions, e2EcontrollerVersionedV2Handler)
+	registerVersionedRoute(engine, basePath, "HEAD", "/e2e/versioned", e2EcontrollerVersionedV2Versions, e2EcontrollerVersionedV2Handler)
+	e2EcontrollerRateLimitedErrorStatusCodes := []int{}
+	e2EcontrollerRateLimitedRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "RateLimited")
+	e2EcontrollerRateLimitedRoute := withBasePath(basePath, "/e2e/rate-limited")
+	e2EcontrollerRateLimitedHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"RateLimited",
+			"E2EController",
+			"GET",
+			e2EcontrollerRateLimitedRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "RateLimited")
+		if !enforceRateLimit(ginCtx, "RateLimited", e2EcontrollerRateLimitedRateLimit) {
 			return
 		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "RateLimited")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1674,14 +5953,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "GetWithAllParams")
-		value, opError := controller.GetWithAllParams(*queryParamRawPtr, *pathParamRawPtr, *headerParamRawPtr)
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "RateLimited")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "RateLimited")
+			}
+		}()
+		value, opError := controller.RateLimited()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "GetWithAllParams")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "RateLimited")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerRateLimitedErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1694,16 +5980,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'GetWithAllParams'",
+				Detail:     "Encountered an error during operation 'RateLimited'",
 				Status:     statusCode,
-				Instance:   "/controller/error/GetWithAllParams",
+				Instance:   "/controller/error/RateLimited",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "GetWithAllParams")
+			applyErrorMapping(&stdError, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "RateLimited")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "GetWithAllParams")
+		ginCtx.Header("x-JsonResponseExtension", "RateLimited")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1713,12 +6000,30 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "GetWithAllParams")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "RateLimited")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "GetWithAllParams")
-	})
-	engine.GET(toGinUrl("/e2e/get-with-all-params-ptr/{pathParam}"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "GetWithAllParamsPtr")
+		ginCtx.Header("x-RouteEndRoutesExtension", "RateLimited")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/rate-limited")), e2EcontrollerRateLimitedHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/rate-limited")), e2EcontrollerRateLimitedHandler)
+	e2EcontrollerTimedOutErrorStatusCodes := []int{}
+	e2EcontrollerTimedOutTimeout := newOperationTimeout("50ms")
+	e2EcontrollerTimedOutRoute := withBasePath(basePath, "/e2e/timeout")
+	e2EcontrollerTimedOutHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"TimedOut",
+			"E2EController",
+			"GET",
+			e2EcontrollerTimedOutRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "TimedOut")
+		cancelTimeout := applyOperationTimeout(ginCtx, e2EcontrollerTimedOutTimeout)
+		defer cancelTimeout()
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -1736,46 +6041,13 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "GetWithAllParamsPtr")
+			handleAuthorizationError(ginCtx, authErr, "TimedOut")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var queryParamRawPtr *string = nil
-		queryParamRaw, isqueryParamExists := ginCtx.GetQuery("queryParam")
-		if isqueryParamExists {
-			queryParam := queryParamRaw
-			queryParamRawPtr = &queryParam
-		}
-		var pathParamRawPtr *string = nil
-		pathParamRaw, ispathParamExists := ginCtx.Params.Get("pathParam")
-		if ispathParamExists {
-			pathParam := pathParamRaw
-			pathParamRawPtr = &pathParam
-		}
-		if validatorErr := validatorInstance.Var(pathParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName)
-			ginCtx.Header("x-RunValidatorExtension", "GetWithAllParamsPtr")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
-			return
-		}
-		var headerParamRawPtr *string = nil
-		headerParamRaw := ginCtx.GetHeader("headerParam")
-		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("headerParam")]
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1785,14 +6057,29 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "GetWithAllParamsPtr")
-		value, opError := controller.GetWithAllParamsPtr(queryParamRawPtr, pathParamRawPtr, headerParamRawPtr)
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "TimedOut")
+		if isDeadlineExceeded(ginCtx) {
+			handleDeadlineExceeded(ginCtx, "TimedOut", http.StatusServiceUnavailable)
+			return
+		}
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "TimedOut")
+			}
+		}()
+		value, opError := controller.TimedOut(getRequestContext(ginCtx))
+		telemetry.endPhase()
+		if isDeadlineExceeded(ginCtx) {
+			handleDeadlineExceeded(ginCtx, "TimedOut", http.StatusGatewayTimeout)
+			return
+		}
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "GetWithAllParamsPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "TimedOut")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTimedOutErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1805,16 +6092,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'GetWithAllParamsPtr'",
+				Detail:     "Encountered an error during operation 'TimedOut'",
 				Status:     statusCode,
-				Instance:   "/controller/error/GetWithAllParamsPtr",
+				Instance:   "/controller/error/TimedOut",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "GetWithAllParamsPtr")
+			applyErrorMapping(&stdError, opError, e2EcontrollerTimedOutErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "TimedOut")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "GetWithAllParamsPtr")
+		ginCtx.Header("x-JsonResponseExtension", "TimedOut")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1824,12 +6112,30 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "GetWithAllParamsPtr")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "TimedOut")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "GetWithAllParamsPtr")
-	})
-	engine.GET(toGinUrl("/e2e/get-with-all-params-required-ptr/{pathParam}"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "GetWithAllParamsRequiredPtr")
+		ginCtx.Header("x-RouteEndRoutesExtension", "TimedOut")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/timeout")), e2EcontrollerTimedOutHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/timeout")), e2EcontrollerTimedOutHandler)
+	e2EcontrollerMaxBodySizeErrorStatusCodes := []int{}
+	e2EcontrollerMaxBodySizeRoute := withBasePath(basePath, "/e2e/max-body-size")
+	e2EcontrollerMaxBodySizeHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"MaxBodySize",
+			"E2EController",
+			"POST",
+			e2EcontrollerMaxBodySizeRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "MaxBodySize")
+		if !enforceMaxBodySize(ginCtx, "MaxBodySize", 64) {
+			return
+		}
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -1847,78 +6153,47 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "GetWithAllParamsRequiredPtr")
+			handleAuthorizationError(ginCtx, authErr, "MaxBodySize")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var queryParamRawPtr *string = nil
-		queryParamRaw, isqueryParamExists := ginCtx.GetQuery("queryParam")
-		if isqueryParamExists {
-			queryParam := queryParamRaw
-			queryParamRawPtr = &queryParam
-		}
-		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
-			ginCtx.Header("x-RunValidatorExtension", "GetWithAllParamsRequiredPtr")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
-			return
-		}
-		var pathParamRawPtr *string = nil
-		pathParamRaw, ispathParamExists := ginCtx.Params.Get("pathParam")
-		if ispathParamExists {
-			pathParam := pathParamRaw
-			pathParamRawPtr = &pathParam
-		}
-		if validatorErr := validatorInstance.Var(pathParamRawPtr, "required"); validatorErr != nil {
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var dataRawPtr *Param8data.ObjectWithByteSlice = nil
+		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
 			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, conversionErr)
 				setRequestContext(ginCtx, middlewareCtx)
 				if !continueOperation {
 					return
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
-			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
-			ginCtx.Header("x-RunValidatorExtension", "GetWithAllParamsRequiredPtr")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
-			return
-		}
-		var headerParamRawPtr *string = nil
-		headerParamRaw := ginCtx.GetHeader("headerParam")
-		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("headerParam")]
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "MaxBodySize",
+						"parameter": "data",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/MaxBodySize",
 			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
-			ginCtx.Header("x-RunValidatorExtension", "GetWithAllParamsRequiredPtr")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "MaxBodySize")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1928,14 +6203,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "GetWithAllParamsRequiredPtr")
-		value, opError := controller.GetWithAllParamsRequiredPtr(queryParamRawPtr, pathParamRawPtr, headerParamRawPtr)
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "MaxBodySize")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "MaxBodySize")
+			}
+		}()
+		value, opError := controller.MaxBodySize(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "GetWithAllParamsRequiredPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "MaxBodySize")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1948,16 +6230,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'GetWithAllParamsRequiredPtr'",
+				Detail:     "Encountered an error during operation 'MaxBodySize'",
 				Status:     statusCode,
-				Instance:   "/controller/error/GetWithAllParamsRequiredPtr",
+				Instance:   "/controller/error/MaxBodySize",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "GetWithAllParamsRequiredPtr")
+			applyErrorMapping(&stdError, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "MaxBodySize")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "GetWithAllParamsRequiredPtr")
+		ginCtx.Header("x-JsonResponseExtension", "MaxBodySize")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -1967,12 +6250,27 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "GetWithAllParamsRequiredPtr")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "MaxBodySize")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "GetWithAllParamsRequiredPtr")
-	})
-	engine.POST(toGinUrl("/e2e/post-with-all-params-body"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "PostWithAllParamsWithBody")
+		ginCtx.Header("x-RouteEndRoutesExtension", "MaxBodySize")
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/max-body-size")), e2EcontrollerMaxBodySizeHandler)
+	e2EcontrollerIdempotentErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentRoute := withBasePath(basePath, "/e2e/idempotent")
+	e2EcontrollerIdempotentHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"Idempotent",
+			"E2EController",
+			"POST",
+			e2EcontrollerIdempotentRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "Idempotent")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -1990,84 +6288,18 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "PostWithAllParamsWithBody")
+			handleAuthorizationError(ginCtx, authErr, "Idempotent")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var conversionErr error
-		var queryParamRawPtr *string = nil
-		queryParamRaw, isqueryParamExists := ginCtx.GetQuery("queryParam")
-		if isqueryParamExists {
-			queryParam := queryParamRaw
-			queryParamRawPtr = &queryParam
-		}
-		if validatorErr := validatorInstance.Var(queryParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName)
-			ginCtx.Header("x-RunValidatorExtension", "PostWithAllParamsWithBody")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
-			return
-		}
-		var headerParamRawPtr *string = nil
-		headerParamRaw := ginCtx.GetHeader("headerParam")
-		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("headerParam")]
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName)
-			ginCtx.Header("x-RunValidatorExtension", "PostWithAllParamsWithBody")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
-			return
-		}
-		var theBodyRawPtr *Param5theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &theBodyRawPtr)
-		if conversionErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, conversionErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBody' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/PostWithAllParamsWithBody",
-			}
-			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBody")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+		telemetry.startPhase("validation")
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed := hashRequestBody(ginCtx, "Idempotent", 0)
+		if !isBodyHashed {
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2077,14 +6309,27 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBody")
-		value, opError := controller.PostWithAllParamsWithBody(*queryParamRawPtr, *headerParamRawPtr, *theBodyRawPtr)
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "Idempotent")
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		completeIdempotentRequest := beginIdempotentRequest(ginCtx, "Idempotent", idempotentRequestHash, e2EcontrollerIdempotentIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return
+		}
+		defer completeIdempotentRequest()
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "Idempotent")
+			}
+		}()
+		value, opError := controller.Idempotent()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "PostWithAllParamsWithBody")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "Idempotent")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2097,39 +6342,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBody'",
+				Detail:     "Encountered an error during operation 'Idempotent'",
 				Status:     statusCode,
-				Instance:   "/controller/error/PostWithAllParamsWithBody",
+				Instance:   "/controller/error/Idempotent",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "PostWithAllParamsWithBody")
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "Idempotent")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "PostWithAllParamsWithBody")
-		var outputValidationErr error
-		outputValidationErr = validateDataRecursive(value, "")
-		if outputValidationErr != nil {
-			// Middlewares onOutputValidationMiddlewares section
-			for _, middleware := range onOutputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, outputValidationErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onOutputValidationMiddlewares section
-			outputValidationStatusCode := http.StatusInternalServerError
-			outputValidationRfc7807Error := runtime.Rfc7807Error{
-				Type:       http.StatusText(outputValidationStatusCode),
-				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBody'",
-				Status:     outputValidationStatusCode,
-				Instance:   "/controller/error/PostWithAllParamsWithBody",
-				Extensions: map[string]string{},
-			}
-			ginCtx.JSON(outputValidationStatusCode, outputValidationRfc7807Error)
-			return
-		}
+		ginCtx.Header("x-JsonResponseExtension", "Idempotent")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2139,12 +6362,27 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "PostWithAllParamsWithBody")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "Idempotent")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "PostWithAllParamsWithBody")
-	})
-	engine.POST(toGinUrl("/e2e/post-with-all-params-body-ptr"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "PostWithAllParamsWithBodyPtr")
+		ginCtx.Header("x-RouteEndRoutesExtension", "Idempotent")
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/idempotent")), e2EcontrollerIdempotentHandler)
+	e2EcontrollerIdempotentWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentWithBodyIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentWithBodyRoute := withBasePath(basePath, "/e2e/idempotent-with-body")
+	e2EcontrollerIdempotentWithBodyHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"IdempotentWithBody",
+			"E2EController",
+			"POST",
+			e2EcontrollerIdempotentWithBodyRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "IdempotentWithBody")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -2162,27 +6400,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "PostWithAllParamsWithBodyPtr")
+			handleAuthorizationError(ginCtx, authErr, "IdempotentWithBody")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var conversionErr error
-		var queryParamRawPtr *string = nil
-		queryParamRaw, isqueryParamExists := ginCtx.GetQuery("queryParam")
-		if isqueryParamExists {
-			queryParam := queryParamRaw
-			queryParamRawPtr = &queryParam
-		}
-		var headerParamRawPtr *string = nil
-		headerParamRaw := ginCtx.GetHeader("headerParam")
-		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("headerParam")]
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
+		telemetry.startPhase("validation")
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed := hashRequestBody(ginCtx, "IdempotentWithBody", 0)
+		if !isBodyHashed {
+			return
 		}
-		var theBodyRawPtr *Param5theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(ginCtx, "application/json", "", &theBodyRawPtr)
+		var conversionErr error
+		var dataRawPtr *Param5data.BodyInfo = nil
+		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
 			for _, middleware := range onInputValidationMiddlewares {
@@ -2193,21 +6424,28 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBodyPtr' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "IdempotentWithBody",
+						"parameter": "data",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/PostWithAllParamsWithBodyPtr",
+				Instance: "/validation/error/IdempotentWithBody",
 			}
-			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBodyPtr")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "IdempotentWithBody")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2217,14 +6455,27 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBodyPtr")
-		value, opError := controller.PostWithAllParamsWithBodyPtr(queryParamRawPtr, headerParamRawPtr, theBodyRawPtr)
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "IdempotentWithBody")
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		completeIdempotentRequest := beginIdempotentRequest(ginCtx, "IdempotentWithBody", idempotentRequestHash, e2EcontrollerIdempotentWithBodyIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return
+		}
+		defer completeIdempotentRequest()
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "IdempotentWithBody")
+			}
+		}()
+		value, opError := controller.IdempotentWithBody(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "PostWithAllParamsWithBodyPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "IdempotentWithBody")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2237,43 +6488,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyPtr'",
+				Detail:     "Encountered an error during operation 'IdempotentWithBody'",
 				Status:     statusCode,
-				Instance:   "/controller/error/PostWithAllParamsWithBodyPtr",
+				Instance:   "/controller/error/IdempotentWithBody",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "PostWithAllParamsWithBodyPtr")
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "IdempotentWithBody")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "PostWithAllParamsWithBodyPtr")
-		var outputValidationErr error
-		if value == nil {
-			outputValidationErr = fmt.Errorf("Response payload is nil")
-		} else {
-			outputValidationErr = validateDataRecursive(value, "")
-		}
-		if outputValidationErr != nil {
-			// Middlewares onOutputValidationMiddlewares section
-			for _, middleware := range onOutputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, outputValidationErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onOutputValidationMiddlewares section
-			outputValidationStatusCode := http.StatusInternalServerError
-			outputValidationRfc7807Error := runtime.Rfc7807Error{
-				Type:       http.StatusText(outputValidationStatusCode),
-				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyPtr'",
-				Status:     outputValidationStatusCode,
-				Instance:   "/controller/error/PostWithAllParamsWithBodyPtr",
-				Extensions: map[string]string{},
-			}
-			ginCtx.JSON(outputValidationStatusCode, outputValidationRfc7807Error)
-			return
-		}
+		ginCtx.Header("x-JsonResponseExtension", "IdempotentWithBody")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2283,12 +6508,26 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "PostWithAllParamsWithBodyPtr")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "IdempotentWithBody")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "PostWithAllParamsWithBodyPtr")
-	})
-	engine.POST(toGinUrl("/e2e/post-with-all-params-body-required-ptr"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		ginCtx.Header("x-RouteEndRoutesExtension", "IdempotentWithBody")
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/idempotent-with-body")), e2EcontrollerIdempotentWithBodyHandler)
+	e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecuritySameMethodRoute := withBasePath(basePath, "/e2e/with-two-security-same-method")
+	e2EcontrollerWithTwoSecuritySameMethodHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"WithTwoSecuritySameMethod",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithTwoSecuritySameMethodRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "WithTwoSecuritySameMethod")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -2296,9 +6535,21 @@ func RegisterRoutes(engine *gin.Engine) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName2",
+							SchemaName: "securitySchemaName",
 							Scopes: []string{
-								"config",
+								"other",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"write",
+								"read",
 							},
 						},
 					},
@@ -2306,39 +6557,38 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "PostWithAllParamsWithBodyRequiredPtr")
+			handleAuthorizationError(ginCtx, authErr, "WithTwoSecuritySameMethod")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var conversionErr error
-		var theBodyRawPtr *Param5theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &theBodyRawPtr)
-		if conversionErr != nil {
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
+		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-test-scopes")]
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
 			// Middlewares onInputValidationMiddlewares section
 			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, conversionErr)
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
 				setRequestContext(ginCtx, middlewareCtx)
 				if !continueOperation {
 					return
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
-			validationError := runtime.Rfc7807Error{
-				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBodyRequiredPtr' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
-				),
-				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/PostWithAllParamsWithBodyRequiredPtr",
-			}
-			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			ginCtx.Header("x-RunValidatorExtension", "WithTwoSecuritySameMethod")
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2348,14 +6598,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
-		value, opError := controller.PostWithAllParamsWithBodyRequiredPtr(theBodyRawPtr)
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "WithTwoSecuritySameMethod")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "WithTwoSecuritySameMethod")
+			}
+		}()
+		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "PostWithAllParamsWithBodyRequiredPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "WithTwoSecuritySameMethod")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2368,43 +6625,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyRequiredPtr'",
+				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
 				Status:     statusCode,
-				Instance:   "/controller/error/PostWithAllParamsWithBodyRequiredPtr",
+				Instance:   "/controller/error/WithTwoSecuritySameMethod",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "WithTwoSecuritySameMethod")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
-		var outputValidationErr error
-		if value == nil {
-			outputValidationErr = fmt.Errorf("Response payload is nil")
-		} else {
-			outputValidationErr = validateDataRecursive(value, "")
-		}
-		if outputValidationErr != nil {
-			// Middlewares onOutputValidationMiddlewares section
-			for _, middleware := range onOutputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, outputValidationErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onOutputValidationMiddlewares section
-			outputValidationStatusCode := http.StatusInternalServerError
-			outputValidationRfc7807Error := runtime.Rfc7807Error{
-				Type:       http.StatusText(outputValidationStatusCode),
-				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyRequiredPtr'",
-				Status:     outputValidationStatusCode,
-				Instance:   "/controller/error/PostWithAllParamsWithBodyRequiredPtr",
-				Extensions: map[string]string{},
-			}
-			ginCtx.JSON(outputValidationStatusCode, outputValidationRfc7807Error)
-			return
-		}
+		ginCtx.Header("x-JsonResponseExtension", "WithTwoSecuritySameMethod")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2414,12 +6645,27 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "WithTwoSecuritySameMethod")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
-	})
-	engine.GET(toGinUrl("/e2e/get-header-start-with-letter"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "GetHeaderStartWithLetter")
+		ginCtx.Header("x-RouteEndRoutesExtension", "WithTwoSecuritySameMethod")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/with-two-security-same-method")), e2EcontrollerWithTwoSecuritySameMethodHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/with-two-security-same-method")), e2EcontrollerWithTwoSecuritySameMethodHandler)
+	e2EcontrollerDefaultErrorErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorRoute := withBasePath(basePath, "/e2e/default-error")
+	e2EcontrollerDefaultErrorHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"DefaultError",
+			"E2EController",
+			"GET",
+			e2EcontrollerDefaultErrorRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "DefaultError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -2437,34 +6683,13 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "GetHeaderStartWithLetter")
+			handleAuthorizationError(ginCtx, authErr, "DefaultError")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := ginCtx.GetHeader("headerParam")
-		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("headerParam")]
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required,validate_starts_with_letter"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName)
-			ginCtx.Header("x-RunValidatorExtension", "GetHeaderStartWithLetter")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
-			return
-		}
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2474,14 +6699,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "GetHeaderStartWithLetter")
-		value, opError := controller.GetHeaderStartWithLetter(*headerParamRawPtr)
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "DefaultError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "DefaultError")
+			}
+		}()
+		opError := controller.DefaultError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "GetHeaderStartWithLetter")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "DefaultError")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2494,16 +6726,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'GetHeaderStartWithLetter'",
+				Detail:     "Encountered an error during operation 'DefaultError'",
 				Status:     statusCode,
-				Instance:   "/controller/error/GetHeaderStartWithLetter",
+				Instance:   "/controller/error/DefaultError",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "GetHeaderStartWithLetter")
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "DefaultError")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "GetHeaderStartWithLetter")
+		ginCtx.Header("x-JsonResponseExtension", "DefaultError")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2513,12 +6746,27 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "GetHeaderStartWithLetter")
-		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "GetHeaderStartWithLetter")
-	})
-	engine.GET(toGinUrl("/e2e/with-default-config-security"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "WithDefaultConfigSecurity")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "DefaultError")
+		ginCtx.Status(statusCode)
+		ginCtx.Header("x-RouteEndRoutesExtension", "DefaultError")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/default-error")), e2EcontrollerDefaultErrorHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/default-error")), e2EcontrollerDefaultErrorHandler)
+	e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorWithPayloadRoute := withBasePath(basePath, "/e2e/default-error-with-payload")
+	e2EcontrollerDefaultErrorWithPayloadHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"DefaultErrorWithPayload",
+			"E2EController",
+			"GET",
+			e2EcontrollerDefaultErrorWithPayloadRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "DefaultErrorWithPayload")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -2536,34 +6784,13 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "WithDefaultConfigSecurity")
+			handleAuthorizationError(ginCtx, authErr, "DefaultErrorWithPayload")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
-		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-test-scopes")]
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName)
-			ginCtx.Header("x-RunValidatorExtension", "WithDefaultConfigSecurity")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
-			return
-		}
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2573,14 +6800,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "WithDefaultConfigSecurity")
-		value, opError := controller.WithDefaultConfigSecurity(*headerParamRawPtr)
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "DefaultErrorWithPayload")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "DefaultErrorWithPayload")
+			}
+		}()
+		value, opError := controller.DefaultErrorWithPayload()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "WithDefaultConfigSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "DefaultErrorWithPayload")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2593,16 +6827,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithDefaultConfigSecurity'",
+				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithDefaultConfigSecurity",
+				Instance:   "/controller/error/DefaultErrorWithPayload",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "WithDefaultConfigSecurity")
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "DefaultErrorWithPayload")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "WithDefaultConfigSecurity")
+		ginCtx.Header("x-JsonResponseExtension", "DefaultErrorWithPayload")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2612,12 +6847,27 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "WithDefaultConfigSecurity")
-		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "WithDefaultConfigSecurity")
-	})
-	engine.GET(toGinUrl("/e2e/with-one-security"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "WithOneSecurity")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "DefaultErrorWithPayload")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "DefaultErrorWithPayload")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/default-error-with-payload")), e2EcontrollerDefaultErrorWithPayloadHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/default-error-with-payload")), e2EcontrollerDefaultErrorWithPayloadHandler)
+	e2EcontrollerInjectedDependencyErrorStatusCodes := []int{}
+	e2EcontrollerInjectedDependencyRoute := withBasePath(basePath, "/e2e/injected-dependency")
+	e2EcontrollerInjectedDependencyHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"InjectedDependency",
+			"E2EController",
+			"GET",
+			e2EcontrollerInjectedDependencyRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "InjectedDependency")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -2625,9 +6875,9 @@ func RegisterRoutes(engine *gin.Engine) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName",
+							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"other",
+								"config",
 							},
 						},
 					},
@@ -2635,34 +6885,13 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "WithOneSecurity")
+			handleAuthorizationError(ginCtx, authErr, "InjectedDependency")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
-		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-test-scopes")]
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName)
-			ginCtx.Header("x-RunValidatorExtension", "WithOneSecurity")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
-			return
-		}
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2672,14 +6901,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "WithOneSecurity")
-		value, opError := controller.WithOneSecurity(*headerParamRawPtr)
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "InjectedDependency")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "InjectedDependency")
+			}
+		}()
+		value, opError := controller.InjectedDependency()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "WithOneSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "InjectedDependency")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2692,16 +6928,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithOneSecurity'",
+				Detail:     "Encountered an error during operation 'InjectedDependency'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithOneSecurity",
+				Instance:   "/controller/error/InjectedDependency",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "WithOneSecurity")
+			applyErrorMapping(&stdError, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "InjectedDependency")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "WithOneSecurity")
+		ginCtx.Header("x-JsonResponseExtension", "InjectedDependency")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2711,34 +6948,37 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "WithOneSecurity")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "InjectedDependency")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "WithOneSecurity")
-	})
-	engine.GET(toGinUrl("/e2e/with-two-security"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "WithTwoSecurity")
+		ginCtx.Header("x-RouteEndRoutesExtension", "InjectedDependency")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/injected-dependency")), e2EcontrollerInjectedDependencyHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/injected-dependency")), e2EcontrollerInjectedDependencyHandler)
+	e2EcontrollerMappedErrorErrorStatusCodes := []int{404}
+	e2EcontrollerMappedErrorRoute := withBasePath(basePath, "/e2e/mapped-error")
+	e2EcontrollerMappedErrorHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"MappedError",
+			"E2EController",
+			"GET",
+			e2EcontrollerMappedErrorRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "MappedError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName",
-							Scopes: []string{
-								"other",
-							},
-						},
-					},
-				},
 				{
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
 							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"write",
-								"read",
+								"config",
 							},
 						},
 					},
@@ -2746,34 +6986,13 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "WithTwoSecurity")
+			handleAuthorizationError(ginCtx, authErr, "MappedError")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
-		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-test-scopes")]
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName)
-			ginCtx.Header("x-RunValidatorExtension", "WithTwoSecurity")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
-			return
-		}
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2783,14 +7002,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "WithTwoSecurity")
-		value, opError := controller.WithTwoSecurity(*headerParamRawPtr)
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "MappedError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "MappedError")
+			}
+		}()
+		opError := controller.MappedError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "WithTwoSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "MappedError")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerMappedErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2803,16 +7029,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithTwoSecurity'",
+				Detail:     "Encountered an error during operation 'MappedError'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithTwoSecurity",
+				Instance:   "/controller/error/MappedError",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "WithTwoSecurity")
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedErrorErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "MappedError")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "WithTwoSecurity")
+		ginCtx.Header("x-JsonResponseExtension", "MappedError")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2822,12 +7049,27 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "WithTwoSecurity")
-		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "WithTwoSecurity")
-	})
-	engine.GET(toGinUrl("/e2e/with-two-security-same-method"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "WithTwoSecuritySameMethod")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "MappedError")
+		ginCtx.Status(statusCode)
+		ginCtx.Header("x-RouteEndRoutesExtension", "MappedError")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/mapped-error")), e2EcontrollerMappedErrorHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/mapped-error")), e2EcontrollerMappedErrorHandler)
+	e2EcontrollerUndeclaredMappedErrorErrorStatusCodes := []int{}
+	e2EcontrollerUndeclaredMappedErrorRoute := withBasePath(basePath, "/e2e/undeclared-mapped-error")
+	e2EcontrollerUndeclaredMappedErrorHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"UndeclaredMappedError",
+			"E2EController",
+			"GET",
+			e2EcontrollerUndeclaredMappedErrorRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "UndeclaredMappedError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -2835,21 +7077,9 @@ func RegisterRoutes(engine *gin.Engine) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName",
-							Scopes: []string{
-								"other",
-							},
-						},
-					},
-				},
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName",
+							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"write",
-								"read",
+								"config",
 							},
 						},
 					},
@@ -2857,34 +7087,13 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "WithTwoSecuritySameMethod")
+			handleAuthorizationError(ginCtx, authErr, "UndeclaredMappedError")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := ginCtx.GetHeader("x-test-scopes")
-		_, isheaderParamExists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("x-test-scopes")]
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, validatorErr)
-				setRequestContext(ginCtx, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName)
-			ginCtx.Header("x-RunValidatorExtension", "WithTwoSecuritySameMethod")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
-			return
-		}
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2894,14 +7103,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "WithTwoSecuritySameMethod")
-		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "UndeclaredMappedError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "UndeclaredMappedError")
+			}
+		}()
+		opError := controller.UndeclaredMappedError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "WithTwoSecuritySameMethod")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "UndeclaredMappedError")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2914,16 +7130,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
+				Detail:     "Encountered an error during operation 'UndeclaredMappedError'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithTwoSecuritySameMethod",
+				Instance:   "/controller/error/UndeclaredMappedError",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "WithTwoSecuritySameMethod")
+			applyErrorMapping(&stdError, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "UndeclaredMappedError")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "WithTwoSecuritySameMethod")
+		ginCtx.Header("x-JsonResponseExtension", "UndeclaredMappedError")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2933,12 +7150,27 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "WithTwoSecuritySameMethod")
-		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "WithTwoSecuritySameMethod")
-	})
-	engine.GET(toGinUrl("/e2e/default-error"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "DefaultError")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "UndeclaredMappedError")
+		ginCtx.Status(statusCode)
+		ginCtx.Header("x-RouteEndRoutesExtension", "UndeclaredMappedError")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/undeclared-mapped-error")), e2EcontrollerUndeclaredMappedErrorHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/undeclared-mapped-error")), e2EcontrollerUndeclaredMappedErrorHandler)
+	e2EcontrollerMappedTypedErrorErrorStatusCodes := []int{409}
+	e2EcontrollerMappedTypedErrorRoute := withBasePath(basePath, "/e2e/mapped-typed-error")
+	e2EcontrollerMappedTypedErrorHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"MappedTypedError",
+			"E2EController",
+			"GET",
+			e2EcontrollerMappedTypedErrorRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "MappedTypedError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -2956,11 +7188,13 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "DefaultError")
+			handleAuthorizationError(ginCtx, authErr, "MappedTypedError")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -2970,14 +7204,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "DefaultError")
-		opError := controller.DefaultError()
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "MappedTypedError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "MappedTypedError")
+			}
+		}()
+		value, opError := controller.MappedTypedError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "DefaultError")
-		statusCode := getStatusCode(&controller, false, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "MappedTypedError")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2990,16 +7231,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DefaultError'",
+				Detail:     "Encountered an error during operation 'MappedTypedError'",
 				Status:     statusCode,
-				Instance:   "/controller/error/DefaultError",
+				Instance:   "/controller/error/MappedTypedError",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "DefaultError")
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "MappedTypedError")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "DefaultError")
+		ginCtx.Header("x-JsonResponseExtension", "MappedTypedError")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -3009,12 +7251,27 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "DefaultError")
-		ginCtx.Status(statusCode)
-		ginCtx.Header("x-RouteEndRoutesExtension", "DefaultError")
-	})
-	engine.GET(toGinUrl("/e2e/default-error-with-payload"), func(ginCtx *gin.Context) {
-		ginCtx.Header("x-RouteStartRoutesExtension", "DefaultErrorWithPayload")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "MappedTypedError")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "MappedTypedError")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/mapped-typed-error")), e2EcontrollerMappedTypedErrorHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/mapped-typed-error")), e2EcontrollerMappedTypedErrorHandler)
+	e2EcontrollerPanicErrorStatusCodes := []int{}
+	e2EcontrollerPanicRoute := withBasePath(basePath, "/e2e/panic")
+	e2EcontrollerPanicHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"Panic",
+			"E2EController",
+			"GET",
+			e2EcontrollerPanicRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "Panic")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -3032,11 +7289,13 @@ func RegisterRoutes(engine *gin.Engine) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(ginCtx, authErr, "DefaultErrorWithPayload")
+			handleAuthorizationError(ginCtx, authErr, "Panic")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -3046,14 +7305,21 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		ginCtx.Header("x-BeforeOperationRoutesExtension", "DefaultErrorWithPayload")
-		value, opError := controller.DefaultErrorWithPayload()
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "Panic")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "Panic")
+			}
+		}()
+		value, opError := controller.Panic()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
-		ginCtx.Header("x-ResponseHeadersExtension", "DefaultErrorWithPayload")
-		statusCode := getStatusCode(&controller, true, opError)
+		ginCtx.Header("x-ResponseHeadersExtension", "Panic")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPanicErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3066,16 +7332,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
+				Detail:     "Encountered an error during operation 'Panic'",
 				Status:     statusCode,
-				Instance:   "/controller/error/DefaultErrorWithPayload",
+				Instance:   "/controller/error/Panic",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			ginCtx.Header("x-JsonErrorResponseExtension", "DefaultErrorWithPayload")
+			applyErrorMapping(&stdError, opError, e2EcontrollerPanicErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "Panic")
 			ginCtx.JSON(statusCode, stdError)
 			return
 		}
-		ginCtx.Header("x-JsonResponseExtension", "DefaultErrorWithPayload")
+		ginCtx.Header("x-JsonResponseExtension", "Panic")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -3085,12 +7352,27 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		ginCtx.Header("x-AfterOperationRoutesExtension", "DefaultErrorWithPayload")
+		ginCtx.Header("x-AfterOperationRoutesExtension", "Panic")
 		ginCtx.JSON(statusCode, value)
-		ginCtx.Header("x-RouteEndRoutesExtension", "DefaultErrorWithPayload")
-	})
-	engine.GET(toGinUrl("/e2e/custom-error"), func(ginCtx *gin.Context) {
+		ginCtx.Header("x-RouteEndRoutesExtension", "Panic")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/panic")), e2EcontrollerPanicHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/panic")), e2EcontrollerPanicHandler)
+	e2EcontrollerCustomErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomErrorRoute := withBasePath(basePath, "/e2e/custom-error")
+	e2EcontrollerCustomErrorHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"CustomError",
+			"E2EController",
+			"GET",
+			e2EcontrollerCustomErrorRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "CustomError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -3111,8 +7393,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "CustomError")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -3123,14 +7407,21 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "CustomError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "CustomError")
+			}
+		}()
 		opError := controller.CustomError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "CustomError")
-		statusCode := getStatusCode(&controller, false, opError)
-		emptyErr := Response6CustomError.CustomError{}
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomErrorErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
 		if opError != emptyErr {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3157,9 +7448,24 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "CustomError")
 		ginCtx.Status(statusCode)
 		ginCtx.Header("x-RouteEndRoutesExtension", "CustomError")
-	})
-	engine.GET(toGinUrl("/e2e/custom-error-ptr"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/custom-error")), e2EcontrollerCustomErrorHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/custom-error")), e2EcontrollerCustomErrorHandler)
+	e2EcontrollerCustomPtrErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomPtrErrorRoute := withBasePath(basePath, "/e2e/custom-error-ptr")
+	e2EcontrollerCustomPtrErrorHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"CustomPtrError",
+			"E2EController",
+			"GET",
+			e2EcontrollerCustomPtrErrorRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "CustomPtrError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -3180,8 +7486,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "CustomPtrError")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -3192,13 +7500,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "CustomPtrError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "CustomPtrError")
+			}
+		}()
 		opError := controller.CustomPtrError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "CustomPtrError")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomPtrErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3225,9 +7540,24 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "CustomPtrError")
 		ginCtx.Status(statusCode)
 		ginCtx.Header("x-RouteEndRoutesExtension", "CustomPtrError")
-	})
-	engine.GET(toGinUrl("/e2e/503-error-code"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/custom-error-ptr")), e2EcontrollerCustomPtrErrorHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/custom-error-ptr")), e2EcontrollerCustomPtrErrorHandler)
+	e2EcontrollerError503ErrorStatusCodes := []int{}
+	e2EcontrollerError503Route := withBasePath(basePath, "/e2e/503-error-code")
+	e2EcontrollerError503Handler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"Error503",
+			"E2EController",
+			"GET",
+			e2EcontrollerError503Route,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "Error503")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -3248,8 +7578,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "Error503")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -3260,13 +7592,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "Error503")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "Error503")
+			}
+		}()
 		opError := controller.Error503()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "Error503")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerError503ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3284,6 +7623,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/Error503",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerError503ErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "Error503")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3301,9 +7641,24 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "Error503")
 		ginCtx.Status(statusCode)
 		ginCtx.Header("x-RouteEndRoutesExtension", "Error503")
-	})
-	engine.GET(toGinUrl("/e2e/custom-error-503"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/503-error-code")), e2EcontrollerError503Handler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/503-error-code")), e2EcontrollerError503Handler)
+	e2EcontrollerCustomError503ErrorStatusCodes := []int{}
+	e2EcontrollerCustomError503Route := withBasePath(basePath, "/e2e/custom-error-503")
+	e2EcontrollerCustomError503Handler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"CustomError503",
+			"E2EController",
+			"GET",
+			e2EcontrollerCustomError503Route,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "CustomError503")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -3324,8 +7679,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "CustomError503")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -3336,14 +7693,21 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "CustomError503")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "CustomError503")
+			}
+		}()
 		opError := controller.CustomError503()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "CustomError503")
-		statusCode := getStatusCode(&controller, false, opError)
-		emptyErr := Response6CustomError.CustomError{}
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomError503ErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
 		if opError != emptyErr {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3370,9 +7734,24 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "CustomError503")
 		ginCtx.Status(statusCode)
 		ginCtx.Header("x-RouteEndRoutesExtension", "CustomError503")
-	})
-	engine.GET(toGinUrl("/e2e/context-access"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/custom-error-503")), e2EcontrollerCustomError503Handler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/custom-error-503")), e2EcontrollerCustomError503Handler)
+	e2EcontrollerContextAccessErrorStatusCodes := []int{}
+	e2EcontrollerContextAccessRoute := withBasePath(basePath, "/e2e/context-access")
+	e2EcontrollerContextAccessHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"ContextAccess",
+			"E2EController",
+			"GET",
+			e2EcontrollerContextAccessRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "ContextAccess")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -3393,8 +7772,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ContextAccess")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -3405,13 +7786,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "ContextAccess")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "ContextAccess")
+			}
+		}()
 		opError := controller.ContextAccess()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "ContextAccess")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextAccessErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3429,6 +7817,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ContextAccess",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextAccessErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "ContextAccess")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3446,9 +7835,24 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "ContextAccess")
 		ginCtx.Status(statusCode)
 		ginCtx.Header("x-RouteEndRoutesExtension", "ContextAccess")
-	})
-	engine.GET(toGinUrl("/e2e/http-method"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/context-access")), e2EcontrollerContextAccessHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/context-access")), e2EcontrollerContextAccessHandler)
+	e2EcontrollerGetErrorStatusCodes := []int{}
+	e2EcontrollerGetRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerGetHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"Get",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "Get")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -3469,8 +7873,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "Get")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -3481,13 +7887,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "Get")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "Get")
+			}
+		}()
 		opError := controller.Get()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "Get")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerGetErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3505,6 +7918,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/Get",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "Get")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3522,9 +7936,24 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "Get")
 		ginCtx.Status(statusCode)
 		ginCtx.Header("x-RouteEndRoutesExtension", "Get")
-	})
-	engine.POST(toGinUrl("/e2e/http-method"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerGetHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerGetHandler)
+	e2EcontrollerPostErrorStatusCodes := []int{}
+	e2EcontrollerPostRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerPostHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"Post",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "Post")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -3545,8 +7974,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "Post")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -3557,13 +7988,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "Post")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "Post")
+			}
+		}()
 		opError := controller.Post()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "Post")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPostErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3581,6 +8019,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/Post",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "Post")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3598,9 +8037,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "Post")
 		ginCtx.Status(statusCode)
 		ginCtx.Header("x-RouteEndRoutesExtension", "Post")
-	})
-	engine.PUT(toGinUrl("/e2e/http-method"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPostHandler)
+	e2EcontrollerPutErrorStatusCodes := []int{}
+	e2EcontrollerPutRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerPutHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"Put",
+			"E2EController",
+			"PUT",
+			e2EcontrollerPutRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "Put")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -3621,8 +8074,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "Put")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -3633,13 +8088,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "Put")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "Put")
+			}
+		}()
 		opError := controller.Put()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "Put")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPutErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3657,6 +8119,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/Put",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPutErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "Put")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3674,9 +8137,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "Put")
 		ginCtx.Status(statusCode)
 		ginCtx.Header("x-RouteEndRoutesExtension", "Put")
-	})
-	engine.DELETE(toGinUrl("/e2e/http-method"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("PUT", toGinUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPutHandler)
+	e2EcontrollerDeleteErrorStatusCodes := []int{}
+	e2EcontrollerDeleteRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerDeleteHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"Delete",
+			"E2EController",
+			"DELETE",
+			e2EcontrollerDeleteRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "Delete")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -3697,8 +8174,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "Delete")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -3709,13 +8188,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "Delete")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "Delete")
+			}
+		}()
 		opError := controller.Delete()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "Delete")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDeleteErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3733,6 +8219,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/Delete",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeleteErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "Delete")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3750,9 +8237,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "Delete")
 		ginCtx.Status(statusCode)
 		ginCtx.Header("x-RouteEndRoutesExtension", "Delete")
-	})
-	engine.PATCH(toGinUrl("/e2e/http-method"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("DELETE", toGinUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerDeleteHandler)
+	e2EcontrollerPatchErrorStatusCodes := []int{}
+	e2EcontrollerPatchRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerPatchHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"Patch",
+			"E2EController",
+			"PATCH",
+			e2EcontrollerPatchRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "Patch")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -3773,8 +8274,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "Patch")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -3785,13 +8288,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "Patch")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "Patch")
+			}
+		}()
 		opError := controller.Patch()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "Patch")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPatchErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3809,6 +8319,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/Patch",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPatchErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "Patch")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3826,9 +8337,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "Patch")
 		ginCtx.Status(statusCode)
 		ginCtx.Header("x-RouteEndRoutesExtension", "Patch")
-	})
-	engine.GET(toGinUrl("/e2e/template-context-1"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("PATCH", toGinUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPatchHandler)
+	e2EcontrollerTemplateContext1ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext1Route := withBasePath(basePath, "/e2e/template-context-1")
+	e2EcontrollerTemplateContext1Handler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"TemplateContext1",
+			"E2EController",
+			"GET",
+			e2EcontrollerTemplateContext1Route,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "TemplateContext1")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -3849,8 +8374,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TemplateContext1")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -3861,13 +8388,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "TemplateContext1")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "TemplateContext1")
+			}
+		}()
 		value, opError := controller.TemplateContext1()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "TemplateContext1")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3885,6 +8419,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TemplateContext1",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "TemplateContext1")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3903,9 +8438,24 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "TemplateContext1")
 		ginCtx.Header("x-level", "high")
-	})
-	engine.GET(toGinUrl("/e2e/template-context-2"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/template-context-1")), e2EcontrollerTemplateContext1Handler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/template-context-1")), e2EcontrollerTemplateContext1Handler)
+	e2EcontrollerTemplateContext2ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext2Route := withBasePath(basePath, "/e2e/template-context-2")
+	e2EcontrollerTemplateContext2Handler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"TemplateContext2",
+			"E2EController",
+			"GET",
+			e2EcontrollerTemplateContext2Route,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "TemplateContext2")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -3926,8 +8476,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TemplateContext2")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -3938,13 +8490,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "TemplateContext2")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "TemplateContext2")
+			}
+		}()
 		value, opError := controller.TemplateContext2()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "TemplateContext2")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3962,6 +8521,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TemplateContext2",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "TemplateContext2")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -3981,9 +8541,24 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-RouteEndRoutesExtension", "TemplateContext2")
 		ginCtx.Header("x-mode", "100")
 		ginCtx.Header("x-level", "low")
-	})
-	engine.POST(toGinUrl("/e2e/form"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/template-context-2")), e2EcontrollerTemplateContext2Handler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/template-context-2")), e2EcontrollerTemplateContext2Handler)
+	e2EcontrollerTestFormErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormRoute := withBasePath(basePath, "/e2e/form")
+	e2EcontrollerTestFormHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"TestForm",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestFormRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "TestForm")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -4004,8 +8579,9 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TestForm")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var item1RawPtr *string = nil
 		item1Raw, isitem1Exists := ginCtx.GetPostForm("item1")
 		if isitem1Exists {
@@ -4023,9 +8599,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item1"
-			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
 			ginCtx.Header("x-RunValidatorExtension", "TestForm")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var item2RawPtr *string = nil
@@ -4045,11 +8623,14 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item2"
-			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
 			ginCtx.Header("x-RunValidatorExtension", "TestForm")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -4060,13 +8641,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "TestForm")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "TestForm")
+			}
+		}()
 		value, opError := controller.TestForm(*item1RawPtr, *item2RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "TestForm")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4084,6 +8672,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TestForm",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "TestForm")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -4101,9 +8690,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "TestForm")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "TestForm")
-	})
-	engine.POST(toGinUrl("/e2e/form-extra"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/form")), e2EcontrollerTestFormHandler)
+	e2EcontrollerTestFormExtraErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormExtraRoute := withBasePath(basePath, "/e2e/form-extra")
+	e2EcontrollerTestFormExtraHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"TestFormExtra",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestFormExtraRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "TestFormExtra")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -4124,12 +8727,13 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TestFormExtra")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var item1RawPtr *int64 = nil
 		item1Raw, isitem1Exists := ginCtx.GetPostForm("item1")
 		if isitem1Exists {
-			item1Uint64, conversionErr := strconv.ParseInt(item1Raw, 10, 64)
+			item1, conversionErr := parseIntegerParam[int64](item1Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4140,23 +8744,20 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestFormExtra' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"item1",
-						"int64",
-						reflect.TypeOf(item1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestFormExtra",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item1",
+					"form",
+					"/item1",
+					"int64",
+					reflect.TypeOf(item1Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				ginCtx.Header("x-ParamsValidationErrorResponseExtension", "TestFormExtra")
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			item1 := int64(item1Uint64)
 			item1RawPtr = &item1
 		}
 		if validatorErr := validatorInstance.Var(item1RawPtr, "required,gte=80"); validatorErr != nil {
@@ -4170,9 +8771,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item1"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
 			ginCtx.Header("x-RunValidatorExtension", "TestFormExtra")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var item2RawPtr *string = nil
@@ -4192,15 +8795,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item2"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
 			ginCtx.Header("x-RunValidatorExtension", "TestFormExtra")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var item3RawPtr *int64 = nil
 		item3Raw, isitem3Exists := ginCtx.GetQuery("item3")
 		if isitem3Exists {
-			item3Uint64, conversionErr := strconv.ParseInt(item3Raw, 10, 64)
+			item3, conversionErr := parseIntegerParam[int64](item3Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4211,23 +8816,20 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestFormExtra' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"item3",
-						"int64",
-						reflect.TypeOf(item3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestFormExtra",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item3",
+					"query",
+					"/item3",
+					"int64",
+					reflect.TypeOf(item3Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				ginCtx.Header("x-ParamsValidationErrorResponseExtension", "TestFormExtra")
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			item3 := int64(item3Uint64)
 			item3RawPtr = &item3
 		}
 		if validatorErr := validatorInstance.Var(item3RawPtr, "required,gte=80"); validatorErr != nil {
@@ -4241,11 +8843,14 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item3"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/item3", languages)
 			ginCtx.Header("x-RunValidatorExtension", "TestFormExtra")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -4256,13 +8861,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "TestFormExtra")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "TestFormExtra")
+			}
+		}()
 		value, opError := controller.TestFormExtra(*item1RawPtr, *item2RawPtr, *item3RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "TestFormExtra")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4280,6 +8892,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TestFormExtra",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "TestFormExtra")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -4297,9 +8910,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "TestFormExtra")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "TestFormExtra")
-	})
-	engine.POST(toGinUrl("/e2e/test-response-validation"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/form-extra")), e2EcontrollerTestFormExtraHandler)
+	e2EcontrollerTestResponseValidationErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationRoute := withBasePath(basePath, "/e2e/test-response-validation")
+	e2EcontrollerTestResponseValidationHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"TestResponseValidation",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestResponseValidationRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "TestResponseValidation")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -4320,8 +8947,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TestResponseValidation")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -4332,13 +8961,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "TestResponseValidation")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "TestResponseValidation")
+			}
+		}()
 		value, opError := controller.TestResponseValidation()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "TestResponseValidation")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4356,6 +8992,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TestResponseValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "TestResponseValidation")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -4396,9 +9033,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "TestResponseValidation")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "TestResponseValidation")
-	})
-	engine.POST(toGinUrl("/e2e/test-response-validation-ptr"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/test-response-validation")), e2EcontrollerTestResponseValidationHandler)
+	e2EcontrollerTestResponseValidationPtrErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationPtrRoute := withBasePath(basePath, "/e2e/test-response-validation-ptr")
+	e2EcontrollerTestResponseValidationPtrHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"TestResponseValidationPtr",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestResponseValidationPtrRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "TestResponseValidationPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -4419,8 +9070,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TestResponseValidationPtr")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -4431,13 +9084,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "TestResponseValidationPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "TestResponseValidationPtr")
+			}
+		}()
 		value, opError := controller.TestResponseValidationPtr()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "TestResponseValidationPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4455,6 +9115,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TestResponseValidationPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "TestResponseValidationPtr")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -4499,9 +9160,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "TestResponseValidationPtr")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "TestResponseValidationPtr")
-	})
-	engine.POST(toGinUrl("/e2e/test-response-validation-null"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/test-response-validation-ptr")), e2EcontrollerTestResponseValidationPtrHandler)
+	e2EcontrollerTestResponseValidationNullErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationNullRoute := withBasePath(basePath, "/e2e/test-response-validation-null")
+	e2EcontrollerTestResponseValidationNullHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"TestResponseValidationNull",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestResponseValidationNullRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "TestResponseValidationNull")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -4522,8 +9197,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TestResponseValidationNull")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -4534,13 +9211,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "TestResponseValidationNull")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "TestResponseValidationNull")
+			}
+		}()
 		value, opError := controller.TestResponseValidationNull()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "TestResponseValidationNull")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4558,6 +9242,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TestResponseValidationNull",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "TestResponseValidationNull")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -4602,9 +9287,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "TestResponseValidationNull")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "TestResponseValidationNull")
-	})
-	engine.POST(toGinUrl("/e2e/test-primitive-conversions"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/test-response-validation-null")), e2EcontrollerTestResponseValidationNullHandler)
+	e2EcontrollerTestPrimitiveConversionsErrorStatusCodes := []int{500}
+	e2EcontrollerTestPrimitiveConversionsRoute := withBasePath(basePath, "/e2e/test-primitive-conversions")
+	e2EcontrollerTestPrimitiveConversionsHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"TestPrimitiveConversions",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestPrimitiveConversionsRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "TestPrimitiveConversions")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -4625,12 +9324,13 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TestPrimitiveConversions")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var value1RawPtr *int64 = nil
 		value1Raw, isvalue1Exists := ginCtx.GetQuery("value1")
 		if isvalue1Exists {
-			value1Uint64, conversionErr := strconv.ParseInt(value1Raw, 10, 64)
+			value1, conversionErr := parseIntegerParam[int64](value1Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4641,23 +9341,20 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"int64",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value1",
+					"query",
+					"/value1",
+					"int64",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				ginCtx.Header("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value1 := int64(value1Uint64)
 			value1RawPtr = &value1
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -4671,15 +9368,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
 			ginCtx.Header("x-RunValidatorExtension", "TestPrimitiveConversions")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value2RawPtr *bool = nil
 		value2Raw, isvalue2Exists := ginCtx.GetQuery("value2")
 		if isvalue2Exists {
-			value2Bool, conversionErr := strconv.ParseBool(value2Raw)
+			value2, conversionErr := strconv.ParseBool(value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4690,23 +9389,20 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"bool",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value2",
+					"query",
+					"/value2",
+					"bool",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				ginCtx.Header("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value2 := value2Bool
 			value2RawPtr = &value2
 		}
 		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
@@ -4720,15 +9416,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
 			ginCtx.Header("x-RunValidatorExtension", "TestPrimitiveConversions")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value3RawPtr *int = nil
 		value3Raw, isvalue3Exists := ginCtx.GetQuery("value3")
 		if isvalue3Exists {
-			value3Uint64, conversionErr := strconv.Atoi(value3Raw)
+			value3, conversionErr := parseIntegerParam[int](value3Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4739,23 +9437,20 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value3",
-						"int",
-						reflect.TypeOf(value3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value3",
+					"query",
+					"/value3",
+					"int",
+					reflect.TypeOf(value3Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				ginCtx.Header("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value3 := int(value3Uint64)
 			value3RawPtr = &value3
 		}
 		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
@@ -4769,15 +9464,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value3"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value3", languages)
 			ginCtx.Header("x-RunValidatorExtension", "TestPrimitiveConversions")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value4RawPtr *float64 = nil
 		value4Raw, isvalue4Exists := ginCtx.GetQuery("value4")
 		if isvalue4Exists {
-			value4Float64, conversionErr := strconv.ParseFloat(value4Raw, 64)
+			value4, conversionErr := parseFloatParam[float64](value4Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4788,23 +9485,20 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value4",
-						"float64",
-						reflect.TypeOf(value4Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value4",
+					"query",
+					"/value4",
+					"float64",
+					reflect.TypeOf(value4Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				ginCtx.Header("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value4 := float64(value4Float64)
 			value4RawPtr = &value4
 		}
 		if validatorErr := validatorInstance.Var(value4RawPtr, "required"); validatorErr != nil {
@@ -4818,11 +9512,14 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value4"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value4", languages)
 			ginCtx.Header("x-RunValidatorExtension", "TestPrimitiveConversions")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -4833,13 +9530,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "TestPrimitiveConversions")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "TestPrimitiveConversions")
+			}
+		}()
 		value, opError := controller.TestPrimitiveConversions(*value1RawPtr, *value2RawPtr, *value3RawPtr, *value4RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "TestPrimitiveConversions")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4857,6 +9561,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TestPrimitiveConversions",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "TestPrimitiveConversions")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -4874,9 +9579,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "TestPrimitiveConversions")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "TestPrimitiveConversions")
-	})
-	engine.POST(toGinUrl("/e2e/test-enums"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/test-primitive-conversions")), e2EcontrollerTestPrimitiveConversionsHandler)
+	e2EcontrollerTestEnumsErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsRoute := withBasePath(basePath, "/e2e/test-enums")
+	e2EcontrollerTestEnumsHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"TestEnums",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestEnumsRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "TestEnums")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -4897,16 +9616,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TestEnums")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw, isvalue1Exists := ginCtx.GetQuery("value1")
 		if isvalue1Exists {
 			value1 := value1Raw
 			switch value1Raw {
 			case "active", "inactive":
-				value1Var := Param12value1.StatusEnumeration(value1)
+				value1Var := Param15value1.StatusEnumeration(value1)
 				value1RawPtr = &value1Var
 			default:
 				conversionErr := fmt.Errorf("value1 must be one of \"active, inactive\" options only but got \"%s\"", value1Raw)
@@ -4919,20 +9639,18 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnums' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"StatusEnumeration",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnums",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value1",
+					"query",
+					"/value1",
+					"StatusEnumeration",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				ginCtx.Header("x-ParamsValidationErrorResponseExtension", "TestEnums")
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
 		}
@@ -4947,15 +9665,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
 			ginCtx.Header("x-RunValidatorExtension", "TestEnums")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value2RawPtr *Param4value2.NumberEnumeration = nil
 		value2Raw, isvalue2Exists := ginCtx.GetQuery("value2")
 		if isvalue2Exists {
-			value2Uint64, conversionErr := strconv.Atoi(value2Raw)
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4966,23 +9686,20 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnums' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnums",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value2",
+					"query",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				ginCtx.Header("x-ParamsValidationErrorResponseExtension", "TestEnums")
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value2 := int(value2Uint64)
 			switch value2Raw {
 			case "1", "2":
 				value2Var := Param4value2.NumberEnumeration(value2)
@@ -4998,20 +9715,18 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnums' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnums",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value2",
+					"query",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				ginCtx.Header("x-ParamsValidationErrorResponseExtension", "TestEnums")
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
 		}
@@ -5026,12 +9741,14 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
 			ginCtx.Header("x-RunValidatorExtension", "TestEnums")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var value3RawPtr *Param11value3.ObjectWithEnum = nil
+		var value3RawPtr *Param14value3.ObjectWithEnum = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &value3RawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5043,21 +9760,28 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'TestEnums' but body parameter '%s' did not pass validation of '%s' - %s",
-					"value3",
-					"ObjectWithEnum",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "TestEnums",
+						"parameter": "value3",
+						"type":      "ObjectWithEnum",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/TestEnums",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "TestEnums")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -5068,13 +9792,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "TestEnums")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "TestEnums")
+			}
+		}()
 		value, opError := controller.TestEnums(*value1RawPtr, *value2RawPtr, *value3RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "TestEnums")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5092,6 +9823,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TestEnums",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "TestEnums")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -5132,9 +9864,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "TestEnums")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "TestEnums")
-	})
-	engine.POST(toGinUrl("/e2e/test-enums-in-all/{value1}"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/test-enums")), e2EcontrollerTestEnumsHandler)
+	e2EcontrollerTestEnumsInAllErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsInAllRoute := withBasePath(basePath, "/e2e/test-enums-in-all/{value1}")
+	e2EcontrollerTestEnumsInAllHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"TestEnumsInAll",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestEnumsInAllRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "TestEnumsInAll")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -5155,15 +9901,16 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TestEnumsInAll")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		telemetry.startPhase("validation")
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw, isvalue1Exists := ginCtx.Params.Get("value1")
 		if isvalue1Exists {
 			value1 := value1Raw
 			switch value1Raw {
 			case "active", "inactive":
-				value1Var := Param12value1.StatusEnumeration(value1)
+				value1Var := Param15value1.StatusEnumeration(value1)
 				value1RawPtr = &value1Var
 			default:
 				conversionErr := fmt.Errorf("value1 must be one of \"active, inactive\" options only but got \"%s\"", value1Raw)
@@ -5176,20 +9923,18 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"StatusEnumeration",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value1",
+					"path",
+					"/value1",
+					"StatusEnumeration",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				ginCtx.Header("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
 		}
@@ -5204,16 +9949,18 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/value1", languages)
 			ginCtx.Header("x-RunValidatorExtension", "TestEnumsInAll")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value2RawPtr *Param4value2.NumberEnumeration = nil
 		value2Raw := ginCtx.GetHeader("value2")
 		_, isvalue2Exists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("value2")]
 		if isvalue2Exists {
-			value2Uint64, conversionErr := strconv.Atoi(value2Raw)
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -5224,23 +9971,20 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value2",
+					"header",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				ginCtx.Header("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value2 := int(value2Uint64)
 			switch value2Raw {
 			case "1", "2":
 				value2Var := Param4value2.NumberEnumeration(value2)
@@ -5256,20 +10000,18 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value2",
+					"header",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				ginCtx.Header("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
 		}
@@ -5284,18 +10026,20 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/value2", languages)
 			ginCtx.Header("x-RunValidatorExtension", "TestEnumsInAll")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var value3RawPtr *Param12value3.StatusEnumeration = nil
+		var value3RawPtr *Param15value3.StatusEnumeration = nil
 		value3Raw, isvalue3Exists := ginCtx.GetPostForm("value3")
 		if isvalue3Exists {
 			value3 := value3Raw
 			switch value3Raw {
 			case "active", "inactive":
-				value3Var := Param12value3.StatusEnumeration(value3)
+				value3Var := Param15value3.StatusEnumeration(value3)
 				value3RawPtr = &value3Var
 			default:
 				conversionErr := fmt.Errorf("value3 must be one of \"active, inactive\" options only but got \"%s\"", value3Raw)
@@ -5308,20 +10052,18 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value3",
-						"StatusEnumeration",
-						reflect.TypeOf(value3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value3",
+					"form",
+					"/value3",
+					"StatusEnumeration",
+					reflect.TypeOf(value3Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				ginCtx.Header("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
 		}
@@ -5336,11 +10078,14 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value3"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/value3", languages)
 			ginCtx.Header("x-RunValidatorExtension", "TestEnumsInAll")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -5351,13 +10096,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "TestEnumsInAll")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "TestEnumsInAll")
+			}
+		}()
 		value, opError := controller.TestEnumsInAll(*value1RawPtr, *value2RawPtr, *value3RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "TestEnumsInAll")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5375,6 +10127,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TestEnumsInAll",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "TestEnumsInAll")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -5392,9 +10145,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "TestEnumsInAll")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "TestEnumsInAll")
-	})
-	engine.POST(toGinUrl("/e2e/test-enums-optional"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/test-enums-in-all/{value1}")), e2EcontrollerTestEnumsInAllHandler)
+	e2EcontrollerTestEnumsOptionalErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsOptionalRoute := withBasePath(basePath, "/e2e/test-enums-optional")
+	e2EcontrollerTestEnumsOptionalHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"TestEnumsOptional",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestEnumsOptionalRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "TestEnumsOptional")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -5415,16 +10182,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "TestEnumsOptional")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		telemetry.startPhase("validation")
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw := ginCtx.GetHeader("value1")
 		_, isvalue1Exists := ginCtx.Request.Header[textproto.CanonicalMIMEHeaderKey("value1")]
 		if isvalue1Exists {
 			value1 := value1Raw
 			switch value1Raw {
 			case "active", "inactive":
-				value1Var := Param12value1.StatusEnumeration(value1)
+				value1Var := Param15value1.StatusEnumeration(value1)
 				value1RawPtr = &value1Var
 			default:
 				conversionErr := fmt.Errorf("value1 must be one of \"active, inactive\" options only but got \"%s\"", value1Raw)
@@ -5437,23 +10205,22 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsOptional' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"StatusEnumeration",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsOptional",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsOptional",
+					"value1",
+					"header",
+					"/value1",
+					"StatusEnumeration",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				ginCtx.Header("x-ParamsValidationErrorResponseExtension", "TestEnumsOptional")
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -5464,13 +10231,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "TestEnumsOptional")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "TestEnumsOptional")
+			}
+		}()
 		value, opError := controller.TestEnumsOptional(value1RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "TestEnumsOptional")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5488,6 +10262,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/TestEnumsOptional",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "TestEnumsOptional")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -5505,9 +10280,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "TestEnumsOptional")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "TestEnumsOptional")
-	})
-	engine.POST(toGinUrl("/e2e/external-packages"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/test-enums-optional")), e2EcontrollerTestEnumsOptionalHandler)
+	e2EcontrollerExternalPackagesErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesRoute := withBasePath(basePath, "/e2e/external-packages")
+	e2EcontrollerExternalPackagesHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"ExternalPackages",
+			"E2EController",
+			"POST",
+			e2EcontrollerExternalPackagesRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "ExternalPackages")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -5528,16 +10317,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ExternalPackages")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var unitRawPtr *Param14unit.LengthUnits = nil
+		var unitRawPtr *Param17unit.LengthUnits = nil
 		unitRaw, isunitExists := ginCtx.GetQuery("unit")
 		if isunitExists {
 			unit := unitRaw
 			switch unitRaw {
 			case "Angstrom", "AstronomicalUnit", "Centimeter", "Chain", "DataMile", "Decameter", "Decimeter", "DtpPica", "DtpPoint", "Fathom", "Femtometer", "Foot", "Gigameter", "Hand", "Hectometer", "Inch", "Kilofoot", "KilolightYear", "Kilometer", "Kiloparsec", "Kiloyard", "LightYear", "MegalightYear", "Megameter", "Megaparsec", "Meter", "Microinch", "Micrometer", "Mil", "Mile", "Millimeter", "Nanometer", "NauticalMile", "Parsec", "Picometer", "PrinterPica", "PrinterPoint", "Shackle", "SolarRadius", "Twip", "UsSurveyFoot", "Yard":
-				unitVar := Param14unit.LengthUnits(unit)
+				unitVar := Param17unit.LengthUnits(unit)
 				unitRawPtr = &unitVar
 			default:
 				conversionErr := fmt.Errorf("unit must be one of \"Angstrom, AstronomicalUnit, Centimeter, Chain, DataMile, Decameter, Decimeter, DtpPica, DtpPoint, Fathom, Femtometer, Foot, Gigameter, Hand, Hectometer, Inch, Kilofoot, KilolightYear, Kilometer, Kiloparsec, Kiloyard, LightYear, MegalightYear, Megameter, Megaparsec, Meter, Microinch, Micrometer, Mil, Mile, Millimeter, Nanometer, NauticalMile, Parsec, Picometer, PrinterPica, PrinterPoint, Shackle, SolarRadius, Twip, UsSurveyFoot, Yard\" options only but got \"%s\"", unitRaw)
@@ -5550,24 +10340,22 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'ExternalPackages' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"unit",
-						"LengthUnits",
-						reflect.TypeOf(unitRaw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/ExternalPackages",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"ExternalPackages",
+					"unit",
+					"query",
+					"/unit",
+					"LengthUnits",
+					reflect.TypeOf(unitRaw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				ginCtx.Header("x-ParamsValidationErrorResponseExtension", "ExternalPackages")
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
 		}
-		var dataRawPtr *Param13data.LengthDto = nil
+		var dataRawPtr *Param16data.LengthDto = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5579,21 +10367,28 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackages' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"LengthDto",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackages",
+						"parameter": "data",
+						"type":      "LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackages",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "ExternalPackages")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -5604,13 +10399,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "ExternalPackages")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "ExternalPackages")
+			}
+		}()
 		value, opError := controller.ExternalPackages(unitRawPtr, *dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "ExternalPackages")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5628,6 +10430,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ExternalPackages",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "ExternalPackages")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -5668,9 +10471,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "ExternalPackages")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "ExternalPackages")
-	})
-	engine.POST(toGinUrl("/e2e/external-packages-unique-in-struct"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/external-packages")), e2EcontrollerExternalPackagesHandler)
+	e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesUniqueInStructRoute := withBasePath(basePath, "/e2e/external-packages-unique-in-struct")
+	e2EcontrollerExternalPackagesUniqueInStructHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"ExternalPackagesUniqueInStruct",
+			"E2EController",
+			"POST",
+			e2EcontrollerExternalPackagesUniqueInStructRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "ExternalPackagesUniqueInStruct")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -5691,10 +10508,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ExternalPackagesUniqueInStruct")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *Param15data.UniqueExternalUsage = nil
+		var dataRawPtr *Param18data.UniqueExternalUsage = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5706,21 +10524,28 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackagesUniqueInStruct' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"UniqueExternalUsage",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesUniqueInStruct",
+						"parameter": "data",
+						"type":      "UniqueExternalUsage",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackagesUniqueInStruct",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "ExternalPackagesUniqueInStruct")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -5731,13 +10556,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "ExternalPackagesUniqueInStruct")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "ExternalPackagesUniqueInStruct")
+			}
+		}()
 		value, opError := controller.ExternalPackagesUniqueInStruct(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "ExternalPackagesUniqueInStruct")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5755,6 +10587,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ExternalPackagesUniqueInStruct",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "ExternalPackagesUniqueInStruct")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -5772,9 +10605,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "ExternalPackagesUniqueInStruct")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "ExternalPackagesUniqueInStruct")
-	})
-	engine.POST(toGinUrl("/e2e/external-packages-validation"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/external-packages-unique-in-struct")), e2EcontrollerExternalPackagesUniqueInStructHandler)
+	e2EcontrollerExternalPackagesValidationErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesValidationRoute := withBasePath(basePath, "/e2e/external-packages-validation")
+	e2EcontrollerExternalPackagesValidationHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"ExternalPackagesValidation",
+			"E2EController",
+			"POST",
+			e2EcontrollerExternalPackagesValidationRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "ExternalPackagesValidation")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -5795,16 +10642,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ExternalPackagesValidation")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var unitRawPtr *Param14unit.LengthUnits = nil
+		var unitRawPtr *Param17unit.LengthUnits = nil
 		unitRaw, isunitExists := ginCtx.GetQuery("unit")
 		if isunitExists {
 			unit := unitRaw
 			switch unitRaw {
 			case "Angstrom", "AstronomicalUnit", "Centimeter", "Chain", "DataMile", "Decameter", "Decimeter", "DtpPica", "DtpPoint", "Fathom", "Femtometer", "Foot", "Gigameter", "Hand", "Hectometer", "Inch", "Kilofoot", "KilolightYear", "Kilometer", "Kiloparsec", "Kiloyard", "LightYear", "MegalightYear", "Megameter", "Megaparsec", "Meter", "Microinch", "Micrometer", "Mil", "Mile", "Millimeter", "Nanometer", "NauticalMile", "Parsec", "Picometer", "PrinterPica", "PrinterPoint", "Shackle", "SolarRadius", "Twip", "UsSurveyFoot", "Yard":
-				unitVar := Param14unit.LengthUnits(unit)
+				unitVar := Param17unit.LengthUnits(unit)
 				unitRawPtr = &unitVar
 			default:
 				conversionErr := fmt.Errorf("unit must be one of \"Angstrom, AstronomicalUnit, Centimeter, Chain, DataMile, Decameter, Decimeter, DtpPica, DtpPoint, Fathom, Femtometer, Foot, Gigameter, Hand, Hectometer, Inch, Kilofoot, KilolightYear, Kilometer, Kiloparsec, Kiloyard, LightYear, MegalightYear, Megameter, Megaparsec, Meter, Microinch, Micrometer, Mil, Mile, Millimeter, Nanometer, NauticalMile, Parsec, Picometer, PrinterPica, PrinterPoint, Shackle, SolarRadius, Twip, UsSurveyFoot, Yard\" options only but got \"%s\"", unitRaw)
@@ -5817,24 +10665,22 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'ExternalPackagesValidation' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"unit",
-						"LengthUnits",
-						reflect.TypeOf(unitRaw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/ExternalPackagesValidation",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"ExternalPackagesValidation",
+					"unit",
+					"query",
+					"/unit",
+					"LengthUnits",
+					reflect.TypeOf(unitRaw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				ginCtx.Header("x-ParamsValidationErrorResponseExtension", "ExternalPackagesValidation")
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
 		}
-		var dataRawPtr *Param16data.LengthDtoWithValidation = nil
+		var dataRawPtr *Param19data.LengthDtoWithValidation = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5846,21 +10692,28 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackagesValidation' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"LengthDtoWithValidation",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesValidation",
+						"parameter": "data",
+						"type":      "LengthDtoWithValidation",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackagesValidation",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "ExternalPackagesValidation")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -5871,13 +10724,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "ExternalPackagesValidation")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "ExternalPackagesValidation")
+			}
+		}()
 		value, opError := controller.ExternalPackagesValidation(unitRawPtr, *dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "ExternalPackagesValidation")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5895,6 +10755,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ExternalPackagesValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "ExternalPackagesValidation")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -5935,9 +10796,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "ExternalPackagesValidation")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "ExternalPackagesValidation")
-	})
-	engine.POST(toGinUrl("/e2e/arrays-in-body-and-res"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/external-packages-validation")), e2EcontrollerExternalPackagesValidationHandler)
+	e2EcontrollerArraysInBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInBodyAndResRoute := withBasePath(basePath, "/e2e/arrays-in-body-and-res")
+	e2EcontrollerArraysInBodyAndResHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"ArraysInBodyAndRes",
+			"E2EController",
+			"POST",
+			e2EcontrollerArraysInBodyAndResRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "ArraysInBodyAndRes")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -5958,10 +10833,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ArraysInBodyAndRes")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *[]Param13data.LengthDto = nil
+		var dataRawPtr *[]Param16data.LengthDto = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5973,21 +10849,28 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ArraysInBodyAndRes' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[]LengthDto",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInBodyAndRes",
+						"parameter": "data",
+						"type":      "[]LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ArraysInBodyAndRes",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "ArraysInBodyAndRes")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -5998,13 +10881,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "ArraysInBodyAndRes")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "ArraysInBodyAndRes")
+			}
+		}()
 		value, opError := controller.ArraysInBodyAndRes(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "ArraysInBodyAndRes")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6022,6 +10912,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ArraysInBodyAndRes",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "ArraysInBodyAndRes")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -6062,9 +10953,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "ArraysInBodyAndRes")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "ArraysInBodyAndRes")
-	})
-	engine.POST(toGinUrl("/e2e/arrays-inside-body-and-res"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/arrays-in-body-and-res")), e2EcontrollerArraysInBodyAndResHandler)
+	e2EcontrollerArraysInsideBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInsideBodyAndResRoute := withBasePath(basePath, "/e2e/arrays-inside-body-and-res")
+	e2EcontrollerArraysInsideBodyAndResHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"ArraysInsideBodyAndRes",
+			"E2EController",
+			"POST",
+			e2EcontrollerArraysInsideBodyAndResRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "ArraysInsideBodyAndRes")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -6085,10 +10990,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ArraysInsideBodyAndRes")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *[]Param17data.BlaBla = nil
+		var dataRawPtr *[]Param20data.BlaBla = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6100,21 +11006,28 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ArraysInsideBodyAndRes' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[]BlaBla",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInsideBodyAndRes",
+						"parameter": "data",
+						"type":      "[]BlaBla",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ArraysInsideBodyAndRes",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "ArraysInsideBodyAndRes")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -6125,13 +11038,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "ArraysInsideBodyAndRes")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "ArraysInsideBodyAndRes")
+			}
+		}()
 		value, opError := controller.ArraysInsideBodyAndRes(dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "ArraysInsideBodyAndRes")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6149,6 +11069,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ArraysInsideBodyAndRes",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "ArraysInsideBodyAndRes")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -6193,9 +11114,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "ArraysInsideBodyAndRes")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "ArraysInsideBodyAndRes")
-	})
-	engine.POST(toGinUrl("/e2e/deep-arrays-with-validation"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/arrays-inside-body-and-res")), e2EcontrollerArraysInsideBodyAndResHandler)
+	e2EcontrollerDeepArraysWithValidationErrorStatusCodes := []int{500}
+	e2EcontrollerDeepArraysWithValidationRoute := withBasePath(basePath, "/e2e/deep-arrays-with-validation")
+	e2EcontrollerDeepArraysWithValidationHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"DeepArraysWithValidation",
+			"E2EController",
+			"POST",
+			e2EcontrollerDeepArraysWithValidationRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "DeepArraysWithValidation")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -6216,10 +11151,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "DeepArraysWithValidation")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *[][]Param18data.BlaBla2 = nil
+		var dataRawPtr *[][]Param21data.BlaBla2 = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6231,21 +11167,28 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'DeepArraysWithValidation' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[][]BlaBla2",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "DeepArraysWithValidation",
+						"parameter": "data",
+						"type":      "[][]BlaBla2",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/DeepArraysWithValidation",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "DeepArraysWithValidation")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -6256,13 +11199,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "DeepArraysWithValidation")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "DeepArraysWithValidation")
+			}
+		}()
 		value, opError := controller.DeepArraysWithValidation(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "DeepArraysWithValidation")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6280,6 +11230,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/DeepArraysWithValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "DeepArraysWithValidation")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -6320,9 +11271,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "DeepArraysWithValidation")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "DeepArraysWithValidation")
-	})
-	engine.POST(toGinUrl("/e2e/embedded-structs"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/deep-arrays-with-validation")), e2EcontrollerDeepArraysWithValidationHandler)
+	e2EcontrollerEmbeddedStructsErrorStatusCodes := []int{500}
+	e2EcontrollerEmbeddedStructsRoute := withBasePath(basePath, "/e2e/embedded-structs")
+	e2EcontrollerEmbeddedStructsHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"EmbeddedStructs",
+			"E2EController",
+			"POST",
+			e2EcontrollerEmbeddedStructsRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "EmbeddedStructs")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -6343,10 +11308,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "EmbeddedStructs")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *Param19data.TheModel = nil
+		var dataRawPtr *Param22data.TheModel = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6358,21 +11324,28 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'EmbeddedStructs' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModel",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "EmbeddedStructs",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/EmbeddedStructs",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "EmbeddedStructs")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -6383,13 +11356,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "EmbeddedStructs")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "EmbeddedStructs")
+			}
+		}()
 		value, opError := controller.EmbeddedStructs(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "EmbeddedStructs")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6407,6 +11387,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/EmbeddedStructs",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "EmbeddedStructs")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -6447,9 +11428,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "EmbeddedStructs")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "EmbeddedStructs")
-	})
-	engine.POST(toGinUrl("/e2e/structs-with-inner-pointer"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/embedded-structs")), e2EcontrollerEmbeddedStructsHandler)
+	e2EcontrollerStructsWithInnerPointerErrorStatusCodes := []int{500}
+	e2EcontrollerStructsWithInnerPointerRoute := withBasePath(basePath, "/e2e/structs-with-inner-pointer")
+	e2EcontrollerStructsWithInnerPointerHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"StructsWithInnerPointer",
+			"E2EController",
+			"POST",
+			e2EcontrollerStructsWithInnerPointerRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "StructsWithInnerPointer")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -6470,10 +11465,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "StructsWithInnerPointer")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *Param20data.TheModelWithInnerPointer = nil
+		var dataRawPtr *Param23data.TheModelWithInnerPointer = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6485,21 +11481,28 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'StructsWithInnerPointer' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModelWithInnerPointer",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "StructsWithInnerPointer",
+						"parameter": "data",
+						"type":      "TheModelWithInnerPointer",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/StructsWithInnerPointer",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "StructsWithInnerPointer")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -6510,13 +11513,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "StructsWithInnerPointer")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "StructsWithInnerPointer")
+			}
+		}()
 		value, opError := controller.StructsWithInnerPointer(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "StructsWithInnerPointer")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6534,6 +11544,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/StructsWithInnerPointer",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "StructsWithInnerPointer")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -6551,9 +11562,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "StructsWithInnerPointer")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "StructsWithInnerPointer")
-	})
-	engine.POST(toGinUrl("/e2e/context-injection-empty"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/structs-with-inner-pointer")), e2EcontrollerStructsWithInnerPointerHandler)
+	e2EcontrollerContextInjectionEmptyErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionEmptyRoute := withBasePath(basePath, "/e2e/context-injection-empty")
+	e2EcontrollerContextInjectionEmptyHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"ContextInjectionEmpty",
+			"E2EController",
+			"POST",
+			e2EcontrollerContextInjectionEmptyRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "ContextInjectionEmpty")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -6574,8 +11599,10 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ContextInjectionEmpty")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -6586,13 +11613,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "ContextInjectionEmpty")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "ContextInjectionEmpty")
+			}
+		}()
 		opError := controller.ContextInjectionEmpty(getRequestContext(ginCtx))
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "ContextInjectionEmpty")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6610,6 +11644,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ContextInjectionEmpty",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "ContextInjectionEmpty")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -6627,9 +11662,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "ContextInjectionEmpty")
 		ginCtx.Status(statusCode)
 		ginCtx.Header("x-RouteEndRoutesExtension", "ContextInjectionEmpty")
-	})
-	engine.POST(toGinUrl("/e2e/context-injection"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/context-injection-empty")), e2EcontrollerContextInjectionEmptyHandler)
+	e2EcontrollerContextInjectionErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionRoute := withBasePath(basePath, "/e2e/context-injection")
+	e2EcontrollerContextInjectionHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"ContextInjection",
+			"E2EController",
+			"POST",
+			e2EcontrollerContextInjectionRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "ContextInjection")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -6650,10 +11699,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ContextInjection")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *Param19data.TheModel = nil
+		var dataRawPtr *Param22data.TheModel = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6665,21 +11715,28 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ContextInjection' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModel",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ContextInjection",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ContextInjection",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "ContextInjection")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -6690,13 +11747,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "ContextInjection")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "ContextInjection")
+			}
+		}()
 		opError := controller.ContextInjection(getRequestContext(ginCtx), *dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "ContextInjection")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6714,6 +11778,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ContextInjection",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "ContextInjection")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -6731,9 +11796,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "ContextInjection")
 		ginCtx.Status(statusCode)
 		ginCtx.Header("x-RouteEndRoutesExtension", "ContextInjection")
-	})
-	engine.POST(toGinUrl("/e2e/byte-slice"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/context-injection")), e2EcontrollerContextInjectionHandler)
+	e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithByteSliceRoute := withBasePath(basePath, "/e2e/byte-slice")
+	e2EcontrollerReturnsStructWithByteSliceHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"ReturnsStructWithByteSlice",
+			"E2EController",
+			"POST",
+			e2EcontrollerReturnsStructWithByteSliceRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "ReturnsStructWithByteSlice")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -6754,10 +11833,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ReturnsStructWithByteSlice")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var arriveRawPtr *Param22arrive.ObjectWithByteSlice = nil
+		var arriveRawPtr *Param8arrive.ObjectWithByteSlice = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "", &arriveRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6769,21 +11849,28 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ReturnsStructWithByteSlice' but body parameter '%s' did not pass validation of '%s' - %s",
-					"arrive",
-					"ObjectWithByteSlice",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithByteSlice",
+						"parameter": "arrive",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ReturnsStructWithByteSlice",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "ReturnsStructWithByteSlice")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -6794,13 +11881,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "ReturnsStructWithByteSlice")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "ReturnsStructWithByteSlice")
+			}
+		}()
 		value, opError := controller.ReturnsStructWithByteSlice(arriveRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "ReturnsStructWithByteSlice")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6818,6 +11912,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ReturnsStructWithByteSlice",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "ReturnsStructWithByteSlice")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -6858,9 +11953,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "ReturnsStructWithByteSlice")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "ReturnsStructWithByteSlice")
-	})
-	engine.POST(toGinUrl("/e2e/special-primitives"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/byte-slice")), e2EcontrollerReturnsStructWithByteSliceHandler)
+	e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithSpecialPrimitivesRoute := withBasePath(basePath, "/e2e/special-primitives")
+	e2EcontrollerReturnsStructWithSpecialPrimitivesHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"ReturnsStructWithSpecialPrimitives",
+			"E2EController",
+			"POST",
+			e2EcontrollerReturnsStructWithSpecialPrimitivesRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "ReturnsStructWithSpecialPrimitives")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -6881,10 +11990,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "ReturnsStructWithSpecialPrimitives")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var arriveRawPtr *Param23arrive.ObjectWithSpecialPrimitives = nil
+		var arriveRawPtr *Param24arrive.ObjectWithSpecialPrimitives = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "", &arriveRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6896,21 +12006,28 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ReturnsStructWithSpecialPrimitives' but body parameter '%s' did not pass validation of '%s' - %s",
-					"arrive",
-					"ObjectWithSpecialPrimitives",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithSpecialPrimitives",
+						"parameter": "arrive",
+						"type":      "ObjectWithSpecialPrimitives",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ReturnsStructWithSpecialPrimitives",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "ReturnsStructWithSpecialPrimitives")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -6921,13 +12038,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "ReturnsStructWithSpecialPrimitives")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "ReturnsStructWithSpecialPrimitives")
+			}
+		}()
 		value, opError := controller.ReturnsStructWithSpecialPrimitives(arriveRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "ReturnsStructWithSpecialPrimitives")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6945,6 +12069,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/ReturnsStructWithSpecialPrimitives",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "ReturnsStructWithSpecialPrimitives")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -6985,9 +12110,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "ReturnsStructWithSpecialPrimitives")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "ReturnsStructWithSpecialPrimitives")
-	})
-	engine.POST(toGinUrl("/e2e/alias-of-primitive"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/special-primitives")), e2EcontrollerReturnsStructWithSpecialPrimitivesHandler)
+	e2EcontrollerAliasOfStringErrorStatusCodes := []int{}
+	e2EcontrollerAliasOfStringRoute := withBasePath(basePath, "/e2e/alias-of-primitive")
+	e2EcontrollerAliasOfStringHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"AliasOfString",
+			"E2EController",
+			"POST",
+			e2EcontrollerAliasOfStringRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "AliasOfString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -7008,10 +12147,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "AliasOfString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var objectRawPtr *Param24object.ObjectWithAliasOfString = nil
+		var objectRawPtr *Param25object.ObjectWithAliasOfString = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &objectRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -7023,25 +12163,31 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'AliasOfString' but body parameter '%s' did not pass validation of '%s' - %s",
-					"object",
-					"ObjectWithAliasOfString",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "AliasOfString",
+						"parameter": "object",
+						"type":      "ObjectWithAliasOfString",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/AliasOfString",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "AliasOfString")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var numRawPtr *Param25num.AliasOfInt = nil
+		var numRawPtr *Param26num.AliasOfInt = nil
 		numRaw, isnumExists := ginCtx.GetQuery("num")
 		if isnumExists {
-			numUint64, conversionErr := strconv.Atoi(numRaw)
+			num, conversionErr := parseIntegerParam[int](numRaw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -7052,24 +12198,21 @@ func RegisterRoutes(engine *gin.Engine) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'AliasOfString' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"num",
-						"AliasOfInt",
-						reflect.TypeOf(numRaw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/AliasOfString",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"AliasOfString",
+					"num",
+					"query",
+					"/num",
+					"AliasOfInt",
+					reflect.TypeOf(numRaw).String(),
+					getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+				)
 				ginCtx.Header("x-ParamsValidationErrorResponseExtension", "AliasOfString")
-				ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+				ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			num := int(numUint64)
-			numVar := Param25num.AliasOfInt(num)
+			numVar := Param26num.AliasOfInt(num)
 			numRawPtr = &numVar
 		}
 		if validatorErr := validatorInstance.Var(numRawPtr, "required"); validatorErr != nil {
@@ -7083,16 +12226,18 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "num"
-			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/num", languages)
 			ginCtx.Header("x-RunValidatorExtension", "AliasOfString")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var strRawPtr *Param26str.AliasOfDirectString = nil
+		var strRawPtr *Param27str.AliasOfDirectString = nil
 		strRaw, isstrExists := ginCtx.GetQuery("str")
 		if isstrExists {
 			str := strRaw
-			strVar := Param26str.AliasOfDirectString(str)
+			strVar := Param27str.AliasOfDirectString(str)
 			strRawPtr = &strVar
 		}
 		if validatorErr := validatorInstance.Var(strRawPtr, "required"); validatorErr != nil {
@@ -7106,11 +12251,14 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "str"
-			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/str", languages)
 			ginCtx.Header("x-RunValidatorExtension", "AliasOfString")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -7121,13 +12269,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "AliasOfString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "AliasOfString")
+			}
+		}()
 		value, opError := controller.AliasOfString(objectRawPtr, *numRawPtr, *strRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "AliasOfString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7145,6 +12300,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/AliasOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "AliasOfString")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -7189,9 +12345,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "AliasOfString")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "AliasOfString")
-	})
-	engine.POST(toGinUrl("/e2e/body-array-of-string"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/alias-of-primitive")), e2EcontrollerAliasOfStringHandler)
+	e2EcontrollerBodyArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringRoute := withBasePath(basePath, "/e2e/body-array-of-string")
+	e2EcontrollerBodyArrayOfStringHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"BodyArrayOfString",
+			"E2EController",
+			"POST",
+			e2EcontrollerBodyArrayOfStringRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "BodyArrayOfString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -7212,8 +12382,9 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "BodyArrayOfString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
 		var valuesRawPtr *[]string = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &valuesRawPtr)
@@ -7227,21 +12398,28 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'BodyArrayOfString' but body parameter '%s' did not pass validation of '%s' - %s",
-					"values",
-					"[]string",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfString",
+						"parameter": "values",
+						"type":      "[]string",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/BodyArrayOfString",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "BodyArrayOfString")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -7252,13 +12430,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "BodyArrayOfString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "BodyArrayOfString")
+			}
+		}()
 		value, opError := controller.BodyArrayOfString(*valuesRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "BodyArrayOfString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7276,6 +12461,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/BodyArrayOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "BodyArrayOfString")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -7293,9 +12479,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "BodyArrayOfString")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "BodyArrayOfString")
-	})
-	engine.POST(toGinUrl("/e2e/body-array-of-enum-string"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/body-array-of-string")), e2EcontrollerBodyArrayOfStringHandler)
+	e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringEnumRoute := withBasePath(basePath, "/e2e/body-array-of-enum-string")
+	e2EcontrollerBodyArrayOfStringEnumHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"BodyArrayOfStringEnum",
+			"E2EController",
+			"POST",
+			e2EcontrollerBodyArrayOfStringEnumRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "BodyArrayOfStringEnum")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -7316,10 +12516,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "BodyArrayOfStringEnum")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var valuesRawPtr *[]Param27values.Myemamium = nil
+		var valuesRawPtr *[]Param28values.Myemamium = nil
 		conversionErr = bindAndValidateBody(ginCtx, "application/json", "required", &valuesRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -7331,21 +12532,28 @@ func RegisterRoutes(engine *gin.Engine) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'BodyArrayOfStringEnum' but body parameter '%s' did not pass validation of '%s' - %s",
-					"values",
-					"[]Myemamium",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfStringEnum",
+						"parameter": "values",
+						"type":      "[]Myemamium",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/BodyArrayOfStringEnum",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			ginCtx.Header("x-JsonBodyValidationErrorResponseExtension", "BodyArrayOfStringEnum")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -7356,13 +12564,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "BodyArrayOfStringEnum")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "BodyArrayOfStringEnum")
+			}
+		}()
 		value, opError := controller.BodyArrayOfStringEnum(*valuesRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "BodyArrayOfStringEnum")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7380,6 +12595,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/BodyArrayOfStringEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "BodyArrayOfStringEnum")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -7397,9 +12613,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "BodyArrayOfStringEnum")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "BodyArrayOfStringEnum")
-	})
-	engine.POST(toGinUrl("/e2e/query-array-of-string"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/body-array-of-enum-string")), e2EcontrollerBodyArrayOfStringEnumHandler)
+	e2EcontrollerQueryArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfStringRoute := withBasePath(basePath, "/e2e/query-array-of-string")
+	e2EcontrollerQueryArrayOfStringHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"QueryArrayOfString",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfStringRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "QueryArrayOfString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -7420,8 +12650,9 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "QueryArrayOfString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var valuesRawPtr *[]string = nil
 		valuesRawArray, isvaluesExists := ginCtx.GetQueryArray("values")
 		if isvaluesExists {
@@ -7444,11 +12675,14 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			ginCtx.Header("x-RunValidatorExtension", "QueryArrayOfString")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -7459,13 +12693,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "QueryArrayOfString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "QueryArrayOfString")
+			}
+		}()
 		value, opError := controller.QueryArrayOfString(*valuesRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "QueryArrayOfString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7483,6 +12724,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/QueryArrayOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "QueryArrayOfString")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -7500,9 +12742,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "QueryArrayOfString")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "QueryArrayOfString")
-	})
-	engine.POST(toGinUrl("/e2e/query-array-of-enum"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/query-array-of-string")), e2EcontrollerQueryArrayOfStringHandler)
+	e2EcontrollerQueryArrayOfEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfEnumRoute := withBasePath(basePath, "/e2e/query-array-of-enum")
+	e2EcontrollerQueryArrayOfEnumHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"QueryArrayOfEnum",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfEnumRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "QueryArrayOfEnum")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -7523,12 +12779,13 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "QueryArrayOfEnum")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var valuesRawPtr *[]Param27values.Myemamium = nil
+		telemetry.startPhase("validation")
+		var valuesRawPtr *[]Param28values.Myemamium = nil
 		valuesRawArray, isvaluesExists := ginCtx.GetQueryArray("values")
 		if isvaluesExists {
-			values := make([]Param27values.Myemamium, 0, len(valuesRawArray))
+			values := make([]Param28values.Myemamium, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
 				valuesItem := valuesRaw
 				switch valuesRaw {
@@ -7545,23 +12802,21 @@ func RegisterRoutes(engine *gin.Engine) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]Myemamium",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfEnum",
+						"values",
+						"query",
+						"/values",
+						"[]Myemamium",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+					)
 					ginCtx.Header("x-ParamsValidationErrorResponseExtension", "QueryArrayOfEnum")
-					ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				values = append(values, Param27values.Myemamium(valuesItem))
+				values = append(values, Param28values.Myemamium(valuesItem))
 			}
 			valuesRawPtr = &values
 		}
@@ -7576,21 +12831,23 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			ginCtx.Header("x-RunValidatorExtension", "QueryArrayOfEnum")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var values2RawPtr *[]Param28values2.MyaliasString = nil
+		var values2RawPtr *[]Param29values2.MyaliasString = nil
 		values2RawArray, isvalues2Exists := ginCtx.GetQueryArray("values2")
 		if isvalues2Exists {
-			values2 := make([]Param28values2.MyaliasString, 0, len(values2RawArray))
+			values2 := make([]Param29values2.MyaliasString, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
 				values2Item := values2Raw
-				values2 = append(values2, Param28values2.MyaliasString(values2Item))
+				values2 = append(values2, Param29values2.MyaliasString(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param28values2.MyaliasString(values2)
+			values2Var := []Param29values2.MyaliasString(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -7604,11 +12861,14 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			ginCtx.Header("x-RunValidatorExtension", "QueryArrayOfEnum")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -7619,13 +12879,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "QueryArrayOfEnum")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "QueryArrayOfEnum")
+			}
+		}()
 		value, opError := controller.QueryArrayOfEnum(*valuesRawPtr, *values2RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "QueryArrayOfEnum")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7643,6 +12910,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/QueryArrayOfEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "QueryArrayOfEnum")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -7660,9 +12928,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "QueryArrayOfEnum")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "QueryArrayOfEnum")
-	})
-	engine.POST(toGinUrl("/e2e/query-array-of-others"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/query-array-of-enum")), e2EcontrollerQueryArrayOfEnumHandler)
+	e2EcontrollerQueryArrayOfOthersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersRoute := withBasePath(basePath, "/e2e/query-array-of-others")
+	e2EcontrollerQueryArrayOfOthersHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"QueryArrayOfOthers",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfOthersRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "QueryArrayOfOthers")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -7683,14 +12965,15 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "QueryArrayOfOthers")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var valuesRawPtr *[]int = nil
 		valuesRawArray, isvaluesExists := ginCtx.GetQueryArray("values")
 		if isvaluesExists {
 			values := make([]int, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
-				valuesUint64, conversionErr := strconv.Atoi(valuesRaw)
+				valuesItem, conversionErr := parseIntegerParam[int](valuesRaw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7701,23 +12984,20 @@ func RegisterRoutes(engine *gin.Engine) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]int",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values",
+						"query",
+						"/values",
+						"[]int",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+					)
 					ginCtx.Header("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
-					ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				valuesItem := int(valuesUint64)
 				values = append(values, int(valuesItem))
 			}
 			valuesRawPtr = &values
@@ -7734,17 +13014,19 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			ginCtx.Header("x-RunValidatorExtension", "QueryArrayOfOthers")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var values2RawPtr *[]Param29values2.MyaliasInt = nil
+		var values2RawPtr *[]Param30values2.MyaliasInt = nil
 		values2RawArray, isvalues2Exists := ginCtx.GetQueryArray("values2")
 		if isvalues2Exists {
-			values2 := make([]Param29values2.MyaliasInt, 0, len(values2RawArray))
+			values2 := make([]Param30values2.MyaliasInt, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
-				values2Uint64, conversionErr := strconv.Atoi(values2Raw)
+				values2Item, conversionErr := parseIntegerParam[int](values2Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7755,27 +13037,24 @@ func RegisterRoutes(engine *gin.Engine) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values2",
-							"[]MyaliasInt",
-							reflect.TypeOf(values2Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values2",
+						"query",
+						"/values2",
+						"[]MyaliasInt",
+						reflect.TypeOf(values2Raw).String(),
+						getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+					)
 					ginCtx.Header("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
-					ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				values2Item := int(values2Uint64)
-				values2 = append(values2, Param29values2.MyaliasInt(values2Item))
+				values2 = append(values2, Param30values2.MyaliasInt(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param29values2.MyaliasInt(values2)
+			values2Var := []Param30values2.MyaliasInt(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -7789,9 +13068,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			ginCtx.Header("x-RunValidatorExtension", "QueryArrayOfOthers")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var values3RawPtr *[]bool = nil
@@ -7799,7 +13080,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if isvalues3Exists {
 			values3 := make([]bool, 0, len(values3RawArray))
 			for _, values3Raw := range values3RawArray {
-				values3Bool, conversionErr := strconv.ParseBool(values3Raw)
+				values3Item, conversionErr := strconv.ParseBool(values3Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7810,23 +13091,20 @@ func RegisterRoutes(engine *gin.Engine) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values3",
-							"[]bool",
-							reflect.TypeOf(values3Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values3",
+						"query",
+						"/values3",
+						"[]bool",
+						reflect.TypeOf(values3Raw).String(),
+						getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+					)
 					ginCtx.Header("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
-					ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				values3Item := values3Bool
 				values3 = append(values3, bool(values3Item))
 			}
 			values3RawPtr = &values3
@@ -7843,9 +13121,11 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values3"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values3", languages)
 			ginCtx.Header("x-RunValidatorExtension", "QueryArrayOfOthers")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var values4RawPtr *[]int32 = nil
@@ -7853,7 +13133,7 @@ func RegisterRoutes(engine *gin.Engine) {
 		if isvalues4Exists {
 			values4 := make([]int32, 0, len(values4RawArray))
 			for _, values4Raw := range values4RawArray {
-				values4Uint64, conversionErr := strconv.ParseInt(values4Raw, 10, 32)
+				values4Item, conversionErr := parseIntegerParam[int32](values4Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7864,23 +13144,20 @@ func RegisterRoutes(engine *gin.Engine) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values4",
-							"[]int32",
-							reflect.TypeOf(values4Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values4",
+						"query",
+						"/values4",
+						"[]int32",
+						reflect.TypeOf(values4Raw).String(),
+						getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+					)
 					ginCtx.Header("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
-					ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				values4Item := int32(values4Uint64)
 				values4 = append(values4, int32(values4Item))
 			}
 			values4RawPtr = &values4
@@ -7897,11 +13174,14 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values4"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values4", languages)
 			ginCtx.Header("x-RunValidatorExtension", "QueryArrayOfOthers")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -7912,13 +13192,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "QueryArrayOfOthers")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "QueryArrayOfOthers")
+			}
+		}()
 		value, opError := controller.QueryArrayOfOthers(*valuesRawPtr, *values2RawPtr, *values3RawPtr, *values4RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "QueryArrayOfOthers")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7936,6 +13223,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/QueryArrayOfOthers",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "QueryArrayOfOthers")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -7953,9 +13241,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "QueryArrayOfOthers")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "QueryArrayOfOthers")
-	})
-	engine.POST(toGinUrl("/e2e/query-array-of-others-enum"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/query-array-of-others")), e2EcontrollerQueryArrayOfOthersHandler)
+	e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersEnumRoute := withBasePath(basePath, "/e2e/query-array-of-others-enum")
+	e2EcontrollerQueryArrayOfOthersEnumHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"QueryArrayOfOthersEnum",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfOthersEnumRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "QueryArrayOfOthersEnum")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -7976,14 +13278,15 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "QueryArrayOfOthersEnum")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
-		var valuesRawPtr *[]Param31values.NumberEnum = nil
+		telemetry.startPhase("validation")
+		var valuesRawPtr *[]Param32values.NumberEnum = nil
 		valuesRawArray, isvaluesExists := ginCtx.GetQueryArray("values")
 		if isvaluesExists {
-			values := make([]Param31values.NumberEnum, 0, len(valuesRawArray))
+			values := make([]Param32values.NumberEnum, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
-				valuesUint64, conversionErr := strconv.ParseInt(valuesRaw, 10, 16)
+				valuesItem, conversionErr := parseIntegerParam[int16](valuesRaw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7994,23 +13297,20 @@ func RegisterRoutes(engine *gin.Engine) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthersEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]NumberEnum",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthersEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values",
+						"query",
+						"/values",
+						"[]NumberEnum",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+					)
 					ginCtx.Header("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthersEnum")
-					ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				valuesItem := int16(valuesUint64)
 				switch valuesRaw {
 				case "1", "2":
 					break
@@ -8025,23 +13325,21 @@ func RegisterRoutes(engine *gin.Engine) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthersEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]NumberEnum",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthersEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values",
+						"query",
+						"/values",
+						"[]NumberEnum",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+					)
 					ginCtx.Header("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthersEnum")
-					ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				values = append(values, Param31values.NumberEnum(valuesItem))
+				values = append(values, Param32values.NumberEnum(valuesItem))
 			}
 			valuesRawPtr = &values
 		}
@@ -8056,15 +13354,17 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			ginCtx.Header("x-RunValidatorExtension", "QueryArrayOfOthersEnum")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var values2RawPtr *[]Param32values2.BoolEnum = nil
+		var values2RawPtr *[]Param33values2.BoolEnum = nil
 		values2RawArray, isvalues2Exists := ginCtx.GetQueryArray("values2")
 		if isvalues2Exists {
-			values2 := make([]Param32values2.BoolEnum, 0, len(values2RawArray))
+			values2 := make([]Param33values2.BoolEnum, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
 				values2Item := values2Raw
 				switch values2Raw {
@@ -8081,23 +13381,21 @@ func RegisterRoutes(engine *gin.Engine) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthersEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values2",
-							"[]BoolEnum",
-							reflect.TypeOf(values2Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthersEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values2",
+						"query",
+						"/values2",
+						"[]BoolEnum",
+						reflect.TypeOf(values2Raw).String(),
+						getAcceptedLanguages(ginCtx.GetHeader("Accept-Language")),
+					)
 					ginCtx.Header("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthersEnum")
-					ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+					ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				values2 = append(values2, Param32values2.BoolEnum(values2Item))
+				values2 = append(values2, Param33values2.BoolEnum(values2Item))
 			}
 			values2RawPtr = &values2
 		}
@@ -8112,11 +13410,14 @@ func RegisterRoutes(engine *gin.Engine) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName)
+			languages := getAcceptedLanguages(ginCtx.GetHeader("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			ginCtx.Header("x-RunValidatorExtension", "QueryArrayOfOthersEnum")
-			ginCtx.JSON(http.StatusUnprocessableEntity, validationError)
+			ginCtx.JSON(http.StatusUnprocessableEntity, ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -8127,13 +13428,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "QueryArrayOfOthersEnum")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "QueryArrayOfOthersEnum")
+			}
+		}()
 		value, opError := controller.QueryArrayOfOthersEnum(*valuesRawPtr, *values2RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "QueryArrayOfOthersEnum")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -8151,6 +13459,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/QueryArrayOfOthersEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "QueryArrayOfOthersEnum")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -8168,9 +13477,23 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "QueryArrayOfOthersEnum")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "QueryArrayOfOthersEnum")
-	})
-	engine.POST(toGinUrl("/e2e/query-pointer-to-array"), func(ginCtx *gin.Context) {
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/query-array-of-others-enum")), e2EcontrollerQueryArrayOfOthersEnumHandler)
+	e2EcontrollerQueryArrayOfPointersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfPointersRoute := withBasePath(basePath, "/e2e/query-pointer-to-array")
+	e2EcontrollerQueryArrayOfPointersHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"QueryArrayOfPointers",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfPointersRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
 		ginCtx.Header("x-RouteStartRoutesExtension", "QueryArrayOfPointers")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			ginCtx,
 			[]SecurityCheckList{
@@ -8191,8 +13514,9 @@ func RegisterRoutes(engine *gin.Engine) {
 			handleAuthorizationError(ginCtx, authErr, "QueryArrayOfPointers")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(ginCtx))
 		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
 		var values07RawPtr *[]string = nil
 		values07RawArray, isvalues07Exists := ginCtx.GetQueryArray("values07")
 		if isvalues07Exists {
@@ -8204,6 +13528,7 @@ func RegisterRoutes(engine *gin.Engine) {
 			values07RawPtr = &values07
 			values07RawPtr = &values07
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
@@ -8214,13 +13539,20 @@ func RegisterRoutes(engine *gin.Engine) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		ginCtx.Header("x-BeforeOperationRoutesExtension", "QueryArrayOfPointers")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "QueryArrayOfPointers")
+			}
+		}()
 		value, opError := controller.QueryArrayOfPointers(values07RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			ginCtx.Header(key, value)
 		}
 		ginCtx.Header("x-inject", "true")
 		ginCtx.Header("x-ResponseHeadersExtension", "QueryArrayOfPointers")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -8238,6 +13570,7 @@ func RegisterRoutes(engine *gin.Engine) {
 				Instance:   "/controller/error/QueryArrayOfPointers",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
 			ginCtx.Header("x-JsonErrorResponseExtension", "QueryArrayOfPointers")
 			ginCtx.JSON(statusCode, stdError)
 			return
@@ -8255,5 +13588,217 @@ func RegisterRoutes(engine *gin.Engine) {
 		ginCtx.Header("x-AfterOperationRoutesExtension", "QueryArrayOfPointers")
 		ginCtx.JSON(statusCode, value)
 		ginCtx.Header("x-RouteEndRoutesExtension", "QueryArrayOfPointers")
-	})
+	}
+	engine.Handle("POST", toGinUrl(withBasePath(basePath, "/e2e/query-pointer-to-array")), e2EcontrollerQueryArrayOfPointersHandler)
+	// E2ESharedRateLimitController
+	e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitFirstRoute := withBasePath(basePath, "/e2e/shared-rate-limit/first")
+	e2EsharedRateLimitControllerSharedRateLimitFirstHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"SharedRateLimitFirst",
+			"E2ESharedRateLimitController",
+			"GET",
+			e2EsharedRateLimitControllerSharedRateLimitFirstRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "SharedRateLimitFirst")
+		if !enforceRateLimit(ginCtx, "SharedRateLimitFirst", e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit) {
+			return
+		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "SharedRateLimitFirst")
+			return
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "SharedRateLimitFirst")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "SharedRateLimitFirst")
+			}
+		}()
+		value, opError := controller.SharedRateLimitFirst()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "SharedRateLimitFirst")
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitFirst'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitFirst",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "SharedRateLimitFirst")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "SharedRateLimitFirst")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "SharedRateLimitFirst")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "SharedRateLimitFirst")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/shared-rate-limit/first")), e2EsharedRateLimitControllerSharedRateLimitFirstHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/shared-rate-limit/first")), e2EsharedRateLimitControllerSharedRateLimitFirstHandler)
+	e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitSecondRoute := withBasePath(basePath, "/e2e/shared-rate-limit/second")
+	e2EsharedRateLimitControllerSharedRateLimitSecondHandler := func(ginCtx *gin.Context) {
+		telemetry := startOperationTelemetry(
+			getRequestContext(ginCtx),
+			propagation.HeaderCarrier(ginCtx.Request.Header),
+			"SharedRateLimitSecond",
+			"E2ESharedRateLimitController",
+			"GET",
+			e2EsharedRateLimitControllerSharedRateLimitSecondRoute,
+		)
+		setRequestContext(ginCtx, telemetry.ctx)
+		defer func() { telemetry.end(ginCtx.Writer.Status()) }()
+		ginCtx.Header("x-RouteStartRoutesExtension", "SharedRateLimitSecond")
+		if !enforceRateLimit(ginCtx, "SharedRateLimitSecond", e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit) {
+			return
+		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			ginCtx,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(ginCtx, authErr, "SharedRateLimitSecond")
+			return
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(ginCtx))
+		controller.InitController(ginCtx)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		ginCtx.Header("x-BeforeOperationRoutesExtension", "SharedRateLimitSecond")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(ginCtx, recovered, "SharedRateLimitSecond")
+			}
+		}()
+		value, opError := controller.SharedRateLimitSecond()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			ginCtx.Header(key, value)
+		}
+		ginCtx.Header("x-inject", "true")
+		ginCtx.Header("x-ResponseHeadersExtension", "SharedRateLimitSecond")
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx, opError)
+				setRequestContext(ginCtx, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitSecond'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitSecond",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+			ginCtx.Header("x-JsonErrorResponseExtension", "SharedRateLimitSecond")
+			ginCtx.JSON(statusCode, stdError)
+			return
+		}
+		ginCtx.Header("x-JsonResponseExtension", "SharedRateLimitSecond")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(ginCtx), ginCtx)
+			setRequestContext(ginCtx, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		ginCtx.Header("x-AfterOperationRoutesExtension", "SharedRateLimitSecond")
+		ginCtx.JSON(statusCode, value)
+		ginCtx.Header("x-RouteEndRoutesExtension", "SharedRateLimitSecond")
+	}
+	engine.Handle("GET", toGinUrl(withBasePath(basePath, "/e2e/shared-rate-limit/second")), e2EsharedRateLimitControllerSharedRateLimitSecondHandler)
+	engine.Handle("HEAD", toGinUrl(withBasePath(basePath, "/e2e/shared-rate-limit/second")), e2EsharedRateLimitControllerSharedRateLimitSecondHandler)
 }
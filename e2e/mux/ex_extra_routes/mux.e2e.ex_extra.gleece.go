@@ -5,7 +5,7 @@ It includes routes and handlers by the Gleece API Routes Generator.
 --
 Authors: Haim Kastner & Yuval Pomerchik
 Generated by: Gleece Routes Generator
-Generated Date: 2025-12-25
+Generated Date: 2026-10-19
 Target Engine: Gorilla Mux (https://github.com/gorilla/mux)
 --
 Usage:
@@ -16,45 +16,58 @@ Repository: https://github.com/gopher-fleece/gleece
 */
 package ex_extra_routes
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
+	"net"
 	"net/http"
 	"reflect"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 	"github.com/go-playground/validator/v10"
 	RequestAuth "github.com/gopher-fleece/gleece/v2/e2e/mux/auth"
 	"github.com/gopher-fleece/runtime"
 	"github.com/gorilla/mux"
 	E2EClassSecController "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	E2EController "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param11value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param12value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param12value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param15data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param16data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param17data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	E2ESharedRateLimitController "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param14value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param18data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param19data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param20data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param22arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param23arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param24object "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param25num "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param26str "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param27values "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param28values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param21data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param22data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param23data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param24arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param25object "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param26num "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param27str "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param28values "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param29values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param31values "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param32values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param30values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param32values "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param33values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param4value2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param5data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param5theBody "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Response6CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param13data "github.com/haimkastner/unitsnet-go/units"
-	Param14unit "github.com/haimkastner/unitsnet-go/units"
+	Param6principal "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Response9CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param16data "github.com/haimkastner/unitsnet-go/units"
+	Param17unit "github.com/haimkastner/unitsnet-go/units"
 	// import extension placeholder
 )
 var validatorInstance = validator.New()
@@ -67,6 +80,24 @@ type SecurityCheckList struct {
 	Checks   []runtime.SecurityCheck
 	Relation SecurityListRelation
 }
+// ValidationIssue describes a single failed validation of a request's input
+type ValidationIssue struct {
+	// The location of the offending input - body, query, path, header or form
+	In string `json:"in"`
+	// A JSON pointer to the offending value, relative to its location
+	Pointer string `json:"pointer"`
+	// The validation rule that failed
+	Rule string `json:"rule"`
+	// The failed rule's parameter, if any
+	Param string `json:"param,omitempty"`
+	// A human-readable description of the failure
+	Message string `json:"message"`
+}
+// ValidationProblem is an RFC7807 error detailing the failed validations of a request
+type ValidationProblem struct {
+	runtime.Rfc7807Error
+	Errors []ValidationIssue `json:"errors"`
+}
 // type declarations extension placeholder
 func getRequestContext(req *http.Request) context.Context {
 	return req.Context()
@@ -76,6 +107,56 @@ func setRequestContext(req *http.Request, ctx context.Context) {
 		*req = *req.WithContext(ctx)
 	}
 }
+type principalContextKey struct{}
+// WithPrincipal attaches the request's authenticated principal to the given context.
+//
+// Security handlers return the resulting context to make the principal available to @Principal parameters
+func WithPrincipal(ctx context.Context, principal any) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+// getPrincipal retrieves the principal attached to the given context via WithPrincipal.
+// Yields nil if no principal of type TPrincipal (or a pointer to one) was attached
+func getPrincipal[TPrincipal any](ctx context.Context) *TPrincipal {
+	switch principal := ctx.Value(principalContextKey{}).(type) {
+	case *TPrincipal:
+		return principal
+	case TPrincipal:
+		return &principal
+	default:
+		return nil
+	}
+}
+// IdentifiablePrincipal may be implemented by principals to provide a stable identity, e.g. a user ID,
+// by which their idempotent requests and principal keyed rate limits are scoped
+type IdentifiablePrincipal interface {
+	IdempotencyIdentity() string
+}
+// describePrincipal returns a stable textual identity of the principal attached to the given context via WithPrincipal,
+// i.e., its IdempotencyIdentity or String method's result, if any, or its dereferenced value if of a primitive kind.
+// Yields an empty string if no principal was attached.
+//
+// Returns false for principals lacking a stable identity, e.g. structs, whose formatting may vary between requests
+func describePrincipal(ctx context.Context) (string, bool) {
+	principal := ctx.Value(principalContextKey{})
+	switch identifiable := principal.(type) {
+	case nil:
+		return "", true
+	case IdentifiablePrincipal:
+		return identifiable.IdempotencyIdentity(), true
+	case fmt.Stringer:
+		return identifiable.String(), true
+	}
+	value := reflect.Indirect(reflect.ValueOf(principal))
+	switch value.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprintf("%v", value), true
+	default:
+		return "", false
+	}
+}
 func registerEnumValidation(validate *validator.Validate, validationName string, allowedValues []string) {
 	// Convert the array to a map for O(1) lookup
 	lookup := make(map[string]struct{})
@@ -89,12 +170,12 @@ func registerEnumValidation(validate *validator.Validate, validationName string,
 		return exists
 	})
 }
-func extractValidationErrorMessage(err error, fieldName *string) string {
+func extractValidationErrorMessage(err error, fieldName *string, languages []string) string {
 	if err == nil {
 		return ""
 	}
-	validationErrors, ok := err.(validator.ValidationErrors)
-	if !ok {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
 		return err.Error()
 	}
 	var errStr string
@@ -103,15 +184,26 @@ func extractValidationErrorMessage(err error, fieldName *string) string {
 		if fieldName != nil {
 			fName = *fieldName
 		}
-		errStr += fmt.Sprintf("Field '%s' failed validation with tag '%s'. ", fName, validationErr.Tag())
+		errStr += translateMessage(
+			languages,
+			map[string]string{"field": fName, "tag": validationErr.Tag(), "param": validationErr.Param()},
+			ValidationTagMessageKey(validationErr.Tag()),
+			MessageKeyFieldValidation,
+		) + ". "
 	}
 	return errStr
 }
-func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error) int {
+// getStatusCode returns the status code of an operation's response - the one set via the controller's SetStatus, if any,
+// that of the error mapping matching err among the operation's declared error status codes or a 500 for other errors,
+// or the success status code
+func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error, routeErrorStatusCodes []int) int {
 	if controller.GetStatus() != nil {
 		return int(*controller.GetStatus())
 	}
 	if err != nil {
+		if mapping := getErrorMapping(err, routeErrorStatusCodes); mapping != nil {
+			return mapping.StatusCode
+		}
 		return http.StatusInternalServerError
 	}
 	if hasReturnValue {
@@ -124,7 +216,7 @@ func bindAndValidateBody[TOutput any](req *http.Request, contentType string, val
 	bodyBytes, err := io.ReadAll(req.Body)
 	if err != nil || len(bodyBytes) == 0 {
 		if strings.Contains(validation, "required") {
-			return fmt.Errorf("body is required but was not provided")
+			return errBodyRequired
 		}
 		return nil
 	}
@@ -159,12 +251,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Slice, reflect.Array:
 		// For slices/arrays, validate each element recursively
 		for i := 0; i < val.Len(); i++ {
-			elemPath := path
-			if path != "" {
-				elemPath = fmt.Sprintf("%s[%d]", path, i)
-			} else {
-				elemPath = fmt.Sprintf("[%d]", i)
-			}
+			elemPath := fmt.Sprintf("%s/%d", path, i)
 			// Get the element - handle case where element might be nil
 			elem := val.Index(i)
 			if elem.Kind() == reflect.Ptr && elem.IsNil() {
@@ -179,12 +266,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Map:
 		// For maps, validate each value recursively
 		for _, key := range val.MapKeys() {
-			elemPath := path
-			if path != "" {
-				elemPath = fmt.Sprintf("%s.%v", path, key.Interface())
-			} else {
-				elemPath = fmt.Sprintf("%v", key.Interface())
-			}
+			elemPath := fmt.Sprintf("%s/%s", path, escapeJsonPointerToken(fmt.Sprintf("%v", key.Interface())))
 			elemVal := val.MapIndex(key)
 			if elemVal.Kind() == reflect.Ptr && elemVal.IsNil() {
 				continue
@@ -197,10 +279,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Struct:
 		// Validate structs with the validator
 		if err := validatorInstance.Struct(data); err != nil {
-			if path != "" {
-				return fmt.Errorf("validation error at %s: %w", path, err)
-			}
-			return err
+			return &structValidationError{pointer: path, structType: val.Type(), err: err}
 		}
 		return nil
 	default:
@@ -211,16 +290,30 @@ func validateDataRecursive(data interface{}, path string) error {
 func toMuxUrl(url string) string {
 	return url
 }
+// withBasePath prefixes the given route path with the given base path
+func withBasePath(basePath string, path string) string {
+	basePath = strings.TrimSuffix(basePath, "/")
+	if basePath != "" && !strings.HasPrefix(basePath, "/") {
+		basePath = "/" + basePath
+	}
+	return basePath + path
+}
+// authorize succeeds if all checks of any of the given lists pass.
+// Each list is an alternative (OR) of security schemas that must all be satisfied (AND), as expanded
+// from the route's @Security and @AdvancedSecurity annotations
 func authorize(req *http.Request, checksLists []SecurityCheckList) *runtime.SecurityError {
 	var lastError *runtime.SecurityError
 	for _, list := range checksLists {
 		if list.Relation != SecurityListRelationAnd {
-			panic(
-				"Encountered a security list relation of type '%s' - this is unexpected and indicates a bug in Gleece itself." +
+			panic(fmt.Sprintf(
+				"Encountered a security list relation of type '%s' - this is unexpected and indicates a bug in Gleece itself. "+
 					"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
-			)
+				list.Relation,
+			))
 		}
-		// Iterate over each security list
+		// Iterate over each security list.
+		// Checks may attach values (e.g. a principal) to the request context - these are discarded if the list fails
+		listCtx := getRequestContext(req)
 		encounteredErrorInList := false
 		for _, check := range list.Checks {
 			secCtx, secErr := RequestAuth.GleeceRequestAuthorization(getRequestContext(req), req, check)
@@ -236,6 +329,7 @@ func authorize(req *http.Request, checksLists []SecurityCheckList) *runtime.Secu
 		if !encounteredErrorInList {
 			return nil
 		}
+		setRequestContext(req, listCtx)
 	}
 	// If we got here it means authentication has failed
 	return lastError
@@ -257,20 +351,954 @@ func handleAuthorizationError(w http.ResponseWriter, authErr *runtime.SecurityEr
 	w.WriteHeader(statusCode)
 	json.NewEncoder(w).Encode(stdError)
 }
-func wrapValidatorError(validatorErr error, operationId string, fieldName string) runtime.Rfc7807Error {
+func wrapValidatorError(validatorErr error, operationId string, fieldName string, languages []string) runtime.Rfc7807Error {
 	return runtime.Rfc7807Error{
 		Type: http.StatusText(http.StatusUnprocessableEntity),
-		Detail: fmt.Sprintf(
-			"A request was made to operation '%s' but parameter '%s' did not pass validation - %s",
-			operationId,
-			fieldName,
-			extractValidationErrorMessage(validatorErr, &fieldName),
+		Detail: translateMessage(
+			languages,
+			map[string]string{
+				"operation": operationId,
+				"parameter": fieldName,
+				"details":   extractValidationErrorMessage(validatorErr, &fieldName, languages),
+			},
+			MessageKeyParamValidation,
 		),
 		Status:   http.StatusUnprocessableEntity,
 		Instance: fmt.Sprintf("/validation/error/%s", operationId),
 	}
 }
+// paramInteger is the set of signed integer types a request parameter may be converted to
+type paramInteger interface {
+	int | int8 | int16 | int32 | int64
+}
+// paramUnsigned is the set of unsigned integer types a request parameter may be converted to
+type paramUnsigned interface {
+	uint | uint8 | uint16 | uint32 | uint64
+}
+// paramFloat is the set of floating point types a request parameter may be converted to
+type paramFloat interface {
+	float32 | float64
+}
+// parseIntegerParam converts the raw value of a request parameter to the given signed integer type
+func parseIntegerParam[T paramInteger](raw string) (T, error) {
+	parsed, err := strconv.ParseInt(raw, 10, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// parseUnsignedParam converts the raw value of a request parameter to the given unsigned integer type
+func parseUnsignedParam[T paramUnsigned](raw string) (T, error) {
+	parsed, err := strconv.ParseUint(raw, 10, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// parseFloatParam converts the raw value of a request parameter to the given floating point type
+func parseFloatParam[T paramFloat](raw string) (T, error) {
+	parsed, err := strconv.ParseFloat(raw, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// wrapConversionError creates the RFC7807 error and validation issues describing a request parameter
+// whose raw value could not be converted to the parameter's type
+func wrapConversionError(
+	conversionErr error,
+	operationId string,
+	fieldName string,
+	in string,
+	pointer string,
+	expectedType string,
+	actualType string,
+	languages []string,
+) (runtime.Rfc7807Error, []ValidationIssue) {
+	validationError := runtime.Rfc7807Error{
+		Type: http.StatusText(http.StatusUnprocessableEntity),
+		Detail: translateMessage(
+			languages,
+			map[string]string{
+				"operation": operationId,
+				"parameter": fieldName,
+				"expected":  expectedType,
+				"actual":    actualType,
+			},
+			MessageKeyParamConversion,
+		),
+		Status:     http.StatusUnprocessableEntity,
+		Instance:   fmt.Sprintf("/validation/error/%s", operationId),
+		Extensions: map[string]string{"error": conversionErr.Error()},
+	}
+	validationIssues := []ValidationIssue{
+		{
+			In:      in,
+			Pointer: pointer,
+			Rule:    "type",
+			Param:   expectedType,
+			Message: conversionErr.Error(),
+		},
+	}
+	return validationError, validationIssues
+}
+var errBodyRequired = errors.New("body is required but was not provided")
+// structValidationError associates a struct's validation errors with the struct's location within the validated payload
+type structValidationError struct {
+	pointer    string
+	structType reflect.Type
+	err        error
+}
+func (e *structValidationError) Error() string {
+	if e.pointer == "" {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("validation error at %s: %s", e.pointer, e.err.Error())
+}
+func (e *structValidationError) Unwrap() error {
+	return e.err
+}
+func escapeJsonPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+// getJsonFieldName returns the name of the given field within its struct's JSON representation.
+//
+// Embedded structs without an explicit name are flattened by encoding/json and therefore yield an empty name
+func getJsonFieldName(field reflect.StructField) string {
+	if name := strings.Split(field.Tag.Get("json"), ",")[0]; name != "" {
+		return name
+	}
+	fieldType := field.Type
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	if field.Anonymous && fieldType.Kind() == reflect.Struct {
+		return ""
+	}
+	return field.Name
+}
+// toJsonPointer converts a validator struct namespace (e.g. 'Model.Items[0].Name') to a JSON pointer (e.g. '/items/0/name')
+// using the json tags of the root type's fields
+func toJsonPointer(rootType reflect.Type, structNamespace string) string {
+	pointer := ""
+	currentType := rootType
+	segments := strings.Split(structNamespace, ".")
+	for _, segment := range segments[1:] {
+		name, indices := segment, []string{}
+		if bracket := strings.Index(segment, "["); bracket >= 0 {
+			name = segment[:bracket]
+			indices = strings.Split(strings.TrimSuffix(segment[bracket+1:], "]"), "][")
+		}
+		for currentType != nil && currentType.Kind() == reflect.Ptr {
+			currentType = currentType.Elem()
+		}
+		token := name
+		if currentType != nil && currentType.Kind() == reflect.Struct {
+			if field, found := currentType.FieldByName(name); found {
+				currentType = field.Type
+				token = getJsonFieldName(field)
+			} else {
+				currentType = nil
+			}
+		}
+		if token != "" {
+			pointer += "/" + escapeJsonPointerToken(token)
+		}
+		for _, index := range indices {
+			pointer += "/" + escapeJsonPointerToken(index)
+			for currentType != nil && currentType.Kind() == reflect.Ptr {
+				currentType = currentType.Elem()
+			}
+			if currentType != nil && (currentType.Kind() == reflect.Slice || currentType.Kind() == reflect.Array || currentType.Kind() == reflect.Map) {
+				currentType = currentType.Elem()
+			} else {
+				currentType = nil
+			}
+		}
+	}
+	return pointer
+}
+func getValidationIssueMessage(pointer string, tag string, param string, languages []string) string {
+	args := map[string]string{"tag": tag, "param": param}
+	if pointer == "" {
+		return translateMessage(languages, args, ValidationTagMessageKey(tag), MessageKeyValueValidation)
+	}
+	args["field"] = pointer[strings.LastIndex(pointer, "/")+1:]
+	return translateMessage(languages, args, ValidationTagMessageKey(tag), MessageKeyFieldValidation)
+}
+// getValidationIssues converts the given validation or deserialization error to a list of issues at the given location.
+//
+// The pointer is the JSON pointer of the validated value, relative to its location
+func getValidationIssues(err error, in string, pointer string, languages []string) []ValidationIssue {
+	var structType reflect.Type
+	var structErr *structValidationError
+	if errors.As(err, &structErr) {
+		pointer += structErr.pointer
+		structType = structErr.structType
+	}
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		issues := make([]ValidationIssue, 0, len(validationErrors))
+		for _, validationErr := range validationErrors {
+			issuePointer := pointer
+			if structType != nil {
+				issuePointer += toJsonPointer(structType, validationErr.StructNamespace())
+			}
+			issues = append(issues, ValidationIssue{
+				In:      in,
+				Pointer: issuePointer,
+				Rule:    validationErr.Tag(),
+				Param:   validationErr.Param(),
+				Message: getValidationIssueMessage(issuePointer, validationErr.Tag(), validationErr.Param(), languages),
+			})
+		}
+		return issues
+	}
+	issue := ValidationIssue{In: in, Pointer: pointer, Rule: "parse", Message: err.Error()}
+	var typeErr *json.UnmarshalTypeError
+	if errors.Is(err, errBodyRequired) {
+		issue.Rule = "required"
+	} else if errors.As(err, &typeErr) {
+		if typeErr.Field != "" {
+			issue.Pointer += "/" + strings.ReplaceAll(typeErr.Field, ".", "/")
+		}
+		issue.Rule = "type"
+		issue.Param = typeErr.Type.String()
+	}
+	return []ValidationIssue{issue}
+}
+// Keys of the translatable validation messages.
+//
+// Message templates use named placeholders, such as '{field}', which are replaced with the message's arguments
+const (
+	// Describes a parameter that did not pass validation. Placeholders: {operation}, {parameter}, {details}
+	MessageKeyParamValidation = "paramValidation"
+	// Describes a body that did not pass validation. Placeholders: {operation}, {parameter}, {type}, {details}
+	MessageKeyBodyValidation = "bodyValidation"
+	// Describes a parameter that could not be converted to its declared type. Placeholders: {operation}, {parameter}, {expected}, {actual}
+	MessageKeyParamConversion = "paramConversion"
+	// Describes a field that failed a validation tag with no dedicated message. Placeholders: {field}, {tag}, {param}
+	MessageKeyFieldValidation = "fieldValidation"
+	// Describes a value that failed a validation tag with no dedicated message. Placeholders: {tag}, {param}
+	MessageKeyValueValidation = "valueValidation"
+)
+// The language of the default message catalogue
+const defaultMessageLanguage = "en"
+// The default English message catalogue, used whenever no translation is available
+var defaultMessages = map[string]string{
+	MessageKeyParamValidation: "A request was made to operation '{operation}' but parameter '{parameter}' did not pass validation - {details}",
+	MessageKeyBodyValidation:  "A request was made to operation '{operation}' but body parameter '{parameter}' did not pass validation of '{type}' - {details}",
+	MessageKeyParamConversion: "A request was made to operation '{operation}' but parameter '{parameter}' was not properly sent - Expected {expected} but got {actual}",
+	MessageKeyFieldValidation: "Field '{field}' failed validation with tag '{tag}'",
+	MessageKeyValueValidation: "Value failed validation with tag '{tag}'",
+}
+// MessageTranslator provides validation message templates in the languages requested via the Accept-Language header
+type MessageTranslator interface {
+	// Translate returns the message template registered for the given language and key, if any
+	Translate(language string, key string) (string, bool)
+}
+// MessageCatalogue is a MessageTranslator backed by an in-memory map of language to message key to template.
+//
+// Languages are matched case-insensitively
+type MessageCatalogue map[string]map[string]string
+func (catalogue MessageCatalogue) Translate(language string, key string) (string, bool) {
+	template, found := catalogue[strings.ToLower(language)][key]
+	return template, found
+}
+var messageCatalogue = MessageCatalogue{}
+var messageTranslator MessageTranslator = messageCatalogue
+// SetMessageTranslator replaces the built-in message catalogue with the given translator,
+// e.g. an adapter over go-playground's universal translator.
+//
+// Messages the translator does not provide fall back to the default English catalogue
+func SetMessageTranslator(translator MessageTranslator) {
+	if translator == nil {
+		translator = messageCatalogue
+	}
+	messageTranslator = translator
+}
+// RegisterTranslation registers the template of the given message key for the given language in the built-in catalogue.
+// Has no effect on translators set via SetMessageTranslator
+func RegisterTranslation(language string, key string, template string) {
+	language = strings.ToLower(language)
+	if messageCatalogue[language] == nil {
+		messageCatalogue[language] = map[string]string{}
+	}
+	messageCatalogue[language][key] = template
+}
+// ValidationTagMessageKey returns the message key of the given validation tag, e.g. 'required'.
+//
+// Templates registered under this key take precedence over the generic field and value messages.
+// Placeholders: {field}, {tag}, {param}
+func ValidationTagMessageKey(tag string) string {
+	return "tag." + tag
+}
+// RegisterValidationTagTranslation registers the message used when the given validation tag fails, for the given language
+func RegisterValidationTagTranslation(language string, tag string, template string) {
+	RegisterTranslation(language, ValidationTagMessageKey(tag), template)
+}
+// getAcceptedLanguages returns the languages listed in the given Accept-Language header, most preferred first.
+// Regional variants are followed by their base language, e.g. 'fr-CA' by 'fr'
+func getAcceptedLanguages(header string) []string {
+	type weightedLanguage struct {
+		tag    string
+		weight float64
+	}
+	weightedLanguages := []weightedLanguage{}
+	for _, entry := range strings.Split(header, ",") {
+		parts := strings.Split(entry, ";")
+		tag := strings.TrimSpace(parts[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		weight := 1.0
+		for _, param := range parts[1:] {
+			if value, found := strings.CutPrefix(strings.TrimSpace(param), "q="); found {
+				if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+					weight = parsed
+				}
+			}
+		}
+		if weight > 0 {
+			weightedLanguages = append(weightedLanguages, weightedLanguage{tag: tag, weight: weight})
+		}
+	}
+	slices.SortStableFunc(weightedLanguages, func(a, b weightedLanguage) int {
+		if a.weight > b.weight {
+			return -1
+		}
+		if a.weight < b.weight {
+			return 1
+		}
+		return 0
+	})
+	languages := make([]string, 0, len(weightedLanguages))
+	for _, language := range weightedLanguages {
+		languages = append(languages, language.tag)
+		if base, _, hasRegion := strings.Cut(language.tag, "-"); hasRegion {
+			languages = append(languages, base)
+		}
+	}
+	return languages
+}
+// getMessageTemplate returns the template of the first of the given keys available in the most preferred of the given languages,
+// falling back to English and finally to the default English catalogue
+func getMessageTemplate(languages []string, keys ...string) string {
+	for _, language := range append(slices.Clip(languages), defaultMessageLanguage) {
+		for _, key := range keys {
+			if template, found := messageTranslator.Translate(language, key); found {
+				return template
+			}
+		}
+	}
+	for _, key := range keys {
+		if template, found := defaultMessages[key]; found {
+			return template
+		}
+	}
+	return ""
+}
+// translateMessage formats the template of the given keys in the given languages, replacing its placeholders with the given arguments
+func translateMessage(languages []string, args map[string]string, keys ...string) string {
+	replacements := make([]string, 0, len(args)*2)
+	for name, value := range args {
+		replacements = append(replacements, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(replacements...).Replace(getMessageTemplate(languages, keys...))
+}
+// ControllerFactory creates the controller instance handling a single request.
+//
+// The given context is the request's context
+type ControllerFactory[TController any] func(ctx context.Context) *TController
+var controllerFactories = map[reflect.Type]func(ctx context.Context) any{}
+// RegisterControllerFactory registers the factory used to create a controller for each request it handles.
+//
+// Factories allow controllers to hold injected dependencies such as database handles, services or loggers.
+// Must be called before RegisterRoutes; the factory must not return nil
+func RegisterControllerFactory[TController any](factory ControllerFactory[TController]) {
+	controllerFactories[reflect.TypeOf((*TController)(nil)).Elem()] = func(ctx context.Context) any {
+		return factory(ctx)
+	}
+}
+// createController creates a controller using its registered factory or, if none exists, its zero value
+func createController[TController any](ctx context.Context) *TController {
+	if factory, exists := controllerFactories[reflect.TypeOf((*TController)(nil)).Elem()]; exists {
+		return factory(ctx).(*TController)
+	}
+	return new(TController)
+}
+// ensureControllerFactoriesRegistered panics if any of the given controller types has no registered factory
+func ensureControllerFactoriesRegistered(controllerTypes []reflect.Type) {
+	missing := []string{}
+	for _, controllerType := range controllerTypes {
+		if _, registered := controllerFactories[controllerType]; !registered {
+			missing = append(missing, controllerType.String())
+		}
+	}
+	if len(missing) > 0 {
+		panic(fmt.Sprintf(
+			"controller factories are required but were never registered using RegisterControllerFactory: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
+// ErrorMapping describes the response returned when a controller's error matches a registered mapping
+type ErrorMapping struct {
+	// The HTTP status code to respond with. Must be declared by at least one @ErrorResponse annotation.
+	// The mapping applies only to operations declaring the status code - errors of other operations remain 500s
+	StatusCode int
+	// The RFC7807 title. Defaults to the status code's text
+	Title string
+	// A client-safe description of the error. Defaults to a generic, operation-specific message
+	Detail string
+}
+type registeredErrorMapping struct {
+	matches func(err error) bool
+	mapping ErrorMapping
+}
+var errorMappings = []registeredErrorMapping{}
+var declaredErrorStatusCodes = []int{404, 409, 500}
+// RegisterErrorMapping maps errors matching the given target, via errors.Is, to the given HTTP status code.
+//
+// Mappings are evaluated in order of registration and are superseded by an explicit call to the controller's SetStatus
+func RegisterErrorMapping(target error, statusCode int) {
+	RegisterDetailedErrorMapping(target, ErrorMapping{StatusCode: statusCode})
+}
+// RegisterDetailedErrorMapping maps errors matching the given target, via errors.Is, to the given response
+func RegisterDetailedErrorMapping(target error, mapping ErrorMapping) {
+	addErrorMapping(func(err error) bool { return errors.Is(err, target) }, mapping)
+}
+// RegisterErrorTypeMapping maps errors of type TError, matched via errors.As, to the given response
+func RegisterErrorTypeMapping[TError error](mapping ErrorMapping) {
+	addErrorMapping(func(err error) bool {
+		var target TError
+		return errors.As(err, &target)
+	}, mapping)
+}
+func addErrorMapping(matches func(err error) bool, mapping ErrorMapping) {
+	if !slices.Contains(declaredErrorStatusCodes, mapping.StatusCode) {
+		panic(fmt.Sprintf("Error mapping status code %d is not declared by any @ErrorResponse annotation", mapping.StatusCode))
+	}
+	errorMappings = append(errorMappings, registeredErrorMapping{matches: matches, mapping: mapping})
+}
+// getErrorMapping returns the first registered mapping matching err whose status code is among the given ones, declared by
+// the operation that produced err, or nil if there is none
+func getErrorMapping(err error, routeErrorStatusCodes []int) *ErrorMapping {
+	for _, registered := range errorMappings {
+		if slices.Contains(routeErrorStatusCodes, registered.mapping.StatusCode) && registered.matches(err) {
+			return &registered.mapping
+		}
+	}
+	return nil
+}
+// applyErrorMapping overrides the given RFC7807 error's title and detail with those of the mapping matching err, if any
+func applyErrorMapping(stdError *runtime.Rfc7807Error, err error, routeErrorStatusCodes []int) {
+	mapping := getErrorMapping(err, routeErrorStatusCodes)
+	if mapping == nil {
+		return
+	}
+	stdError.Title = http.StatusText(stdError.Status)
+	if mapping.Title != "" {
+		stdError.Title = mapping.Title
+	}
+	if mapping.Detail != "" {
+		stdError.Detail = mapping.Detail
+	}
+}
 // function declarations extension placeholder
+// DefaultApiVersion is the API version served to requests that do not specify one
+const DefaultApiVersion = 2
+// The request header specifying the requested API version
+const apiVersionHeader = "X-API-Version"
+// The handlers of the versioned routes registered by RegisterGroupRoutes, per method and URL and then per API version
+var versionedRouteHandlers map[string]map[int]http.HandlerFunc
+// registerVersionedRoute registers the given handler for each of the given API versions.
+//
+// Routes sharing a method and a path are registered once and dispatched according to the requested API version
+func registerVersionedRoute(engine *mux.Router, basePath string, method string, path string, versions []int, handler http.HandlerFunc) {
+	url := toMuxUrl(withBasePath(basePath, path))
+	handlers, exists := versionedRouteHandlers[method+" "+url]
+	if !exists {
+		handlers = map[int]http.HandlerFunc{}
+		versionedRouteHandlers[method+" "+url] = handlers
+		engine.HandleFunc(url, func(w http.ResponseWriter, req *http.Request) {
+			version, isValid := getRequestedApiVersion(req.Header.Get(apiVersionHeader))
+			versionHandler, isServed := handlers[version]
+			if !isValid || !isServed {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusNotFound)
+				json.NewEncoder(w).Encode(getUnservedApiVersionError(req.Header.Get(apiVersionHeader)))
+				return
+			}
+			versionHandler(w, req)
+		}).Methods(method)
+	}
+	for _, version := range versions {
+		handlers[version] = handler
+	}
+}
+// getRequestedApiVersion returns the API version specified by the given header value, or DefaultApiVersion if none is specified.
+// Versions may be given with or without a 'v' prefix, e.g. '2' or 'v2'
+func getRequestedApiVersion(headerValue string) (int, bool) {
+	requested := strings.TrimSpace(headerValue)
+	if requested == "" {
+		return DefaultApiVersion, true
+	}
+	version, err := strconv.Atoi(strings.TrimPrefix(strings.ToLower(requested), "v"))
+	return version, err == nil
+}
+// getUnservedApiVersionError returns the RFC7807 error with which requests for an API version not served by a route are answered
+func getUnservedApiVersionError(headerValue string) runtime.Rfc7807Error {
+	return runtime.Rfc7807Error{
+		Type:       http.StatusText(http.StatusNotFound),
+		Detail:     fmt.Sprintf("The requested API version is not served by this route (%s: '%s')", apiVersionHeader, headerValue),
+		Status:     http.StatusNotFound,
+		Instance:   "/versioning/error",
+		Extensions: map[string]string{},
+	}
+}
+// RateLimit describes the number of requests an operation accepts per time window, as declared via @RateLimit
+type RateLimit struct {
+	// The number of requests allowed per window
+	Requests int
+	// The window's duration
+	Per time.Duration
+	// The key by which requests are counted - 'ip', 'principal' or 'header:<Name>'
+	Key string
+	// The scope within which requests are counted - the declaring controller's name for limits inherited from a controller,
+	// which are shared by all of its operations inheriting them, or the operation's ID for limits declared by the operation
+	Scope string
+}
+// RateLimiter decides whether requests may proceed under their operation's rate limit.
+//
+// Implementations must be safe for concurrent use
+type RateLimiter interface {
+	// Allow consumes a single request of the given key against the given operation's limit, counted within the limit's scope.
+	// Returns whether the request may proceed and, if not, how long until it may be retried
+	Allow(ctx context.Context, operationId string, key string, limit RateLimit) (bool, time.Duration)
+}
+// InMemoryRateLimiter is a RateLimiter holding a token bucket per limit scope and key in the process's memory.
+//
+// Each bucket holds up to the limit's number of requests and is refilled evenly over the limit's window.
+// Limits are not shared across instances - deployments with multiple instances should use a shared RateLimiter instead
+type InMemoryRateLimiter struct {
+	mutex     sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+type tokenBucket struct {
+	tokens          float64
+	capacity        float64
+	refillPerSecond float64
+	lastRefill      time.Time
+}
+// The interval at which an InMemoryRateLimiter drops buckets that have been refilled to capacity
+const rateLimitSweepInterval = time.Minute
+// NewInMemoryRateLimiter creates an InMemoryRateLimiter with no buckets
+func NewInMemoryRateLimiter() *InMemoryRateLimiter {
+	return &InMemoryRateLimiter{buckets: map[string]*tokenBucket{}, lastSweep: time.Now()}
+}
+func (limiter *InMemoryRateLimiter) Allow(_ context.Context, _ string, key string, limit RateLimit) (bool, time.Duration) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	now := time.Now()
+	limiter.sweep(now)
+	bucketKey := limit.Scope + "\x00" + key
+	bucket, exists := limiter.buckets[bucketKey]
+	if !exists {
+		capacity := float64(limit.Requests)
+		bucket = &tokenBucket{
+			tokens:          capacity,
+			capacity:        capacity,
+			refillPerSecond: capacity / limit.Per.Seconds(),
+			lastRefill:      now,
+		}
+		limiter.buckets[bucketKey] = bucket
+	}
+	bucket.tokens = bucket.tokensAt(now)
+	bucket.lastRefill = now
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - bucket.tokens) / bucket.refillPerSecond * float64(time.Second))
+}
+// sweep drops the buckets that have been refilled to capacity, as those are indistinguishable from new ones
+func (limiter *InMemoryRateLimiter) sweep(now time.Time) {
+	if now.Sub(limiter.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	for bucketKey, bucket := range limiter.buckets {
+		if bucket.tokensAt(now) >= bucket.capacity {
+			delete(limiter.buckets, bucketKey)
+		}
+	}
+	limiter.lastSweep = now
+}
+// tokensAt returns the number of tokens the bucket holds at the given time
+func (bucket *tokenBucket) tokensAt(now time.Time) float64 {
+	return math.Min(bucket.capacity, bucket.tokens+now.Sub(bucket.lastRefill).Seconds()*bucket.refillPerSecond)
+}
+var rateLimiter RateLimiter = NewInMemoryRateLimiter()
+// SetRateLimiter replaces the default InMemoryRateLimiter with the given limiter,
+// e.g. one backed by a store shared across instances
+func SetRateLimiter(limiter RateLimiter) {
+	if limiter == nil {
+		limiter = NewInMemoryRateLimiter()
+	}
+	rateLimiter = limiter
+}
+// newRateLimit creates an operation's RateLimit, counted within the given scope, out of its @RateLimit annotation's properties
+func newRateLimit(requests int, per string, key string, scope string) RateLimit {
+	window, err := time.ParseDuration(per)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid rate limit window '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			per,
+		))
+	}
+	return RateLimit{Requests: requests, Per: window, Key: key, Scope: scope}
+}
+// getClientIp returns the IP address of the request's remote peer
+func getClientIp(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
+// getRateLimitKey returns the value by which the request is counted against a rate limit with the given key.
+//
+// Requests lacking the key's header or an identifiable principal are counted by their client IP
+func getRateLimitKey(req *http.Request, key string) string {
+	value := ""
+	if headerName, isHeaderKey := strings.CutPrefix(key, "header:"); isHeaderKey {
+		value = req.Header.Get(headerName)
+	} else if key == "principal" {
+		value, _ = describePrincipal(getRequestContext(req))
+	}
+	if value == "" {
+		return getClientIp(req)
+	}
+	return key + "=" + value
+}
+// enforceRateLimit counts the request against the given operation's rate limit.
+//
+// Requests exceeding the limit are answered with a 429 RFC7807 error and a 'Retry-After' header, in which case false is returned
+func enforceRateLimit(w http.ResponseWriter, req *http.Request, operationId string, limit RateLimit) bool {
+	allowed, retryAfter := rateLimiter.Allow(getRequestContext(req), operationId, getRateLimitKey(req, limit.Key), limit)
+	if allowed {
+		return true
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	json.NewEncoder(w).Encode(runtime.Rfc7807Error{
+		Type:     http.StatusText(http.StatusTooManyRequests),
+		Detail:   fmt.Sprintf("Too many requests were made to operation '%s'. Please retry later", operationId),
+		Status:   http.StatusTooManyRequests,
+		Instance: "/ratelimit/error/" + operationId,
+	})
+	return false
+}
+// newOperationTimeout creates an operation's deadline duration out of its @Timeout annotation or the configured default timeout
+func newOperationTimeout(timeout string) time.Duration {
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid operation timeout '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			timeout,
+		))
+	}
+	return duration
+}
+// applyOperationTimeout replaces the request's context with one that expires once the given timeout elapses.
+//
+// Operations are not interrupted when the deadline expires - controllers are expected to honor their context's cancellation.
+// The returned function releases the context's resources and must be called once the request has been handled
+func applyOperationTimeout(req *http.Request, timeout time.Duration) context.CancelFunc {
+	ctx, cancel := context.WithTimeout(getRequestContext(req), timeout)
+	setRequestContext(req, ctx)
+	return cancel
+}
+// isDeadlineExceeded returns whether the request's context has expired due to its operation's timeout
+func isDeadlineExceeded(req *http.Request) bool {
+	return errors.Is(getRequestContext(req).Err(), context.DeadlineExceeded)
+}
+// handleDeadlineExceeded answers a request whose operation's deadline has expired with an RFC7807 error of the given status -
+// 503 if the operation has not been invoked yet or 504 if it has
+func handleDeadlineExceeded(w http.ResponseWriter, operationId string, status int) {
+	detail := fmt.Sprintf("The deadline of operation '%s' expired before the operation was invoked", operationId)
+	if status == http.StatusGatewayTimeout {
+		detail = fmt.Sprintf("Operation '%s' did not complete before its deadline expired", operationId)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(runtime.Rfc7807Error{
+		Type:     http.StatusText(status),
+		Detail:   detail,
+		Status:   status,
+		Instance: "/timeout/error/" + operationId,
+	})
+}
+// enforceMaxBodySize checks the request's body against the given operation's maximum body size.
+//
+// Bodies of unknown length are read up-front, up to the maximum size.
+// Requests whose body exceeds the maximum size are answered with a 413 RFC7807 error, in which case false is returned
+func enforceMaxBodySize(w http.ResponseWriter, req *http.Request, operationId string, maxBodySize int64) bool {
+	bodySize := req.ContentLength
+	if bodySize < 0 {
+		body, _ := io.ReadAll(io.LimitReader(req.Body, maxBodySize+1))
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		bodySize = int64(len(body))
+	}
+	if bodySize <= maxBodySize {
+		return true
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusRequestEntityTooLarge)
+	json.NewEncoder(w).Encode(runtime.Rfc7807Error{
+		Type:     http.StatusText(http.StatusRequestEntityTooLarge),
+		Detail:   fmt.Sprintf("The request body of operation '%s' exceeds the maximum size of %d bytes", operationId, maxBodySize),
+		Status:   http.StatusRequestEntityTooLarge,
+		Instance: "/body/error/" + operationId,
+	})
+	return false
+}
+// IdempotentResponse is a response of an idempotent operation, as stored to be replayed to repeated requests
+type IdempotentResponse struct {
+	// The response's HTTP status code
+	Status int
+	// The response's headers
+	Headers http.Header
+	// The response's body
+	Body []byte
+}
+// IdempotencyStore stores the responses of operations declared via @Idempotent, by request key.
+//
+// Implementations must be safe for concurrent use
+type IdempotencyStore interface {
+	// Reserve claims the given key for a new request with the given body hash, for the given duration.
+	// If the key has already been claimed, returns false along with the stored response or,
+	// if the original request is still in flight, a nil response.
+	// If the key has been claimed by a request with a different body hash, returns ErrIdempotencyKeyReused
+	Reserve(ctx context.Context, key string, requestHash string, ttl time.Duration) (bool, *IdempotentResponse, error)
+	// Complete stores the response of the request that claimed the given key, along with the request's body hash, for the given duration
+	Complete(ctx context.Context, key string, requestHash string, response IdempotentResponse, ttl time.Duration) error
+	// Release drops the claim of a request that did not produce a replayable response, so that it may be retried
+	Release(ctx context.Context, key string) error
+}
+// ErrIdempotencyKeyReused is returned by an IdempotencyStore when a key is reused by a request with a different body
+var ErrIdempotencyKeyReused = errors.New("the idempotency key has already been used by a request with a different body")
+// InMemoryIdempotencyStore is an IdempotencyStore holding responses in the process's memory.
+//
+// Responses are not shared across instances - deployments with multiple instances should use a shared IdempotencyStore instead
+type InMemoryIdempotencyStore struct {
+	mutex     sync.Mutex
+	entries   map[string]*idempotencyEntry
+	lastSweep time.Time
+}
+type idempotencyEntry struct {
+	// The stored response. Nil while the request is in flight
+	response *IdempotentResponse
+	// The hash of the body of the request that claimed the entry's key
+	requestHash string
+	expiresAt   time.Time
+}
+// The interval at which an InMemoryIdempotencyStore drops expired entries
+const idempotencySweepInterval = time.Minute
+// NewInMemoryIdempotencyStore creates an InMemoryIdempotencyStore with no entries
+func NewInMemoryIdempotencyStore() *InMemoryIdempotencyStore {
+	return &InMemoryIdempotencyStore{entries: map[string]*idempotencyEntry{}, lastSweep: time.Now()}
+}
+func (store *InMemoryIdempotencyStore) Reserve(_ context.Context, key string, requestHash string, ttl time.Duration) (bool, *IdempotentResponse, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	now := time.Now()
+	store.sweep(now)
+	if entry, exists := store.entries[key]; exists && now.Before(entry.expiresAt) {
+		if entry.requestHash != requestHash {
+			return false, nil, ErrIdempotencyKeyReused
+		}
+		return false, entry.response, nil
+	}
+	store.entries[key] = &idempotencyEntry{requestHash: requestHash, expiresAt: now.Add(ttl)}
+	return true, nil, nil
+}
+func (store *InMemoryIdempotencyStore) Complete(_ context.Context, key string, requestHash string, response IdempotentResponse, ttl time.Duration) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.entries[key] = &idempotencyEntry{response: &response, requestHash: requestHash, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+func (store *InMemoryIdempotencyStore) Release(_ context.Context, key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.entries, key)
+	return nil
+}
+// sweep drops the entries that have expired
+func (store *InMemoryIdempotencyStore) sweep(now time.Time) {
+	if now.Sub(store.lastSweep) < idempotencySweepInterval {
+		return
+	}
+	for key, entry := range store.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(store.entries, key)
+		}
+	}
+	store.lastSweep = now
+}
+var idempotencyStore IdempotencyStore = NewInMemoryIdempotencyStore()
+// SetIdempotencyStore replaces the default InMemoryIdempotencyStore with the given store,
+// e.g. one backed by a database shared across instances
+func SetIdempotencyStore(store IdempotencyStore) {
+	if store == nil {
+		store = NewInMemoryIdempotencyStore()
+	}
+	idempotencyStore = store
+}
+// newIdempotencyTtl creates an operation's response retention duration out of its @Idempotent annotation's 'ttl' property
+func newIdempotencyTtl(ttl string) time.Duration {
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid idempotency TTL '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			ttl,
+		))
+	}
+	return duration
+}
+// idempotentResponseRecorder records the status and body written to the response so they may be stored once the operation completes
+type idempotentResponseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+func (recorder *idempotentResponseRecorder) WriteHeader(status int) {
+	if recorder.status == 0 {
+		recorder.status = status
+	}
+	recorder.ResponseWriter.WriteHeader(status)
+}
+func (recorder *idempotentResponseRecorder) Write(data []byte) (int, error) {
+	if recorder.status == 0 {
+		recorder.status = http.StatusOK
+	}
+	recorder.body.Write(data)
+	return recorder.ResponseWriter.Write(data)
+}
+// The maximum size, in bytes, of the request bodies hashed by idempotent operations without a @MaxBodySize limit
+const defaultMaxIdempotentBodySize = 10 << 20
+// hashRequestBody returns the hex encoded SHA-256 hash of the request's body. The body remains readable.
+//
+// Requests whose body exceeds the given maximum size, or defaultMaxIdempotentBodySize if zero,
+// are answered with a 413 RFC7807 error, in which case false is returned
+func hashRequestBody(w http.ResponseWriter, req *http.Request, operationId string, maxBodySize int64) (string, bool) {
+	if maxBodySize <= 0 {
+		maxBodySize = defaultMaxIdempotentBodySize
+	}
+	body, _ := io.ReadAll(io.LimitReader(req.Body, maxBodySize+1))
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	if int64(len(body)) > maxBodySize {
+		writeIdempotencyError(w, operationId, http.StatusRequestEntityTooLarge, fmt.Sprintf("The request body of operation '%s' exceeds the maximum size of %d bytes", operationId, maxBodySize))
+		return "", false
+	}
+	hash := sha256.Sum256(body)
+	return hex.EncodeToString(hash[:]), true
+}
+// beginIdempotentRequest claims the request's 'Idempotency-Key' header, scoped to the given operation and the request's principal.
+//
+// If the operation may proceed, returns the writer through which it should respond along with a function storing its response,
+// which must be deferred. Otherwise, the request is answered - with the stored response if the key has been used before,
+// or with an RFC7807 error if the header is missing (400), the original request is still in flight (409),
+// the principal lacks a stable identity (500)
+// or the key has been used by a request with a different body (422) - and a nil function is returned
+func beginIdempotentRequest(w http.ResponseWriter, req *http.Request, operationId string, requestHash string, ttl time.Duration) (http.ResponseWriter, func()) {
+	idempotencyKey := req.Header.Get("Idempotency-Key")
+	if idempotencyKey == "" {
+		writeIdempotencyError(
+			w,
+			operationId,
+			http.StatusBadRequest,
+			fmt.Sprintf("Operation '%s' requires an 'Idempotency-Key' header", operationId),
+		)
+		return w, nil
+	}
+	ctx := getRequestContext(req)
+	// Keys are scoped by principal so that they may not collide across clients. Principals lacking a stable identity are
+	// rejected, as their requests could otherwise never be recognized as repeated
+	principalIdentity, isIdentifiable := describePrincipal(ctx)
+	if !isIdentifiable {
+		writeIdempotencyError(
+			w,
+			operationId,
+			http.StatusInternalServerError,
+			fmt.Sprintf("The principal of operation '%s' lacks a stable identity to scope its 'Idempotency-Key' by", operationId),
+		)
+		return w, nil
+	}
+	key := operationId + "\x00" + principalIdentity + "\x00" + idempotencyKey
+	reserved, response, err := idempotencyStore.Reserve(ctx, key, requestHash, ttl)
+	switch {
+	case errors.Is(err, ErrIdempotencyKeyReused):
+		writeIdempotencyError(
+			w,
+			operationId,
+			http.StatusUnprocessableEntity,
+			fmt.Sprintf("The 'Idempotency-Key' has already been used by a request to operation '%s' with a different body", operationId),
+		)
+		return w, nil
+	case err != nil:
+		writeIdempotencyError(
+			w,
+			operationId,
+			http.StatusInternalServerError,
+			fmt.Sprintf("Failed to process the 'Idempotency-Key' header of operation '%s'", operationId),
+		)
+		return w, nil
+	case !reserved && response == nil:
+		writeIdempotencyError(
+			w,
+			operationId,
+			http.StatusConflict,
+			fmt.Sprintf("A request to operation '%s' with the same 'Idempotency-Key' is still being processed", operationId),
+		)
+		return w, nil
+	case !reserved:
+		for name, values := range response.Headers {
+			w.Header()[name] = values
+		}
+		w.Header().Set("Idempotent-Replayed", "true")
+		w.WriteHeader(response.Status)
+		w.Write(response.Body)
+		return w, nil
+	}
+	recorder := &idempotentResponseRecorder{ResponseWriter: w}
+	return recorder, func() {
+		// Requests that panicked or failed on the server's side are not replayed so they may be retried
+		recovered := recover()
+		if recovered != nil || recorder.status == 0 || recorder.status >= http.StatusInternalServerError {
+			idempotencyStore.Release(context.WithoutCancel(ctx), key)
+			if recovered != nil {
+				panic(recovered)
+			}
+			return
+		}
+		idempotencyStore.Complete(
+			context.WithoutCancel(ctx),
+			key,
+			requestHash,
+			IdempotentResponse{Status: recorder.status, Headers: recorder.Header().Clone(), Body: recorder.body.Bytes()},
+			ttl,
+		)
+	}
+}
+// writeIdempotencyError answers a request to an idempotent operation with an RFC7807 error of the given status
+func writeIdempotencyError(w http.ResponseWriter, operationId string, status int, detail string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(runtime.Rfc7807Error{
+		Type:     http.StatusText(status),
+		Detail:   detail,
+		Status:   status,
+		Instance: "/idempotency/error/" + operationId,
+	})
+}
 type MiddlewareFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool)
 type ErrorMiddlewareFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) (context.Context, bool)
 var beforeOperationMiddlewares []MiddlewareFunc
@@ -296,16 +1324,50 @@ func RegisterErrorMiddleware(executionType runtime.ErrorMiddlewareExecutionType,
 		onErrorMiddlewares = append(onErrorMiddlewares, errorMiddlewareFunc)
 	}
 }
+var namedMiddlewares = map[string]MiddlewareFunc{}
+// RegisterNamedMiddleware registers a middleware that controllers and routes may reference by name using @Middleware annotations.
+//
+// Named middlewares must be registered before RegisterRoutes is called
+func RegisterNamedMiddleware(name string, middlewareFunc MiddlewareFunc) {
+	namedMiddlewares[name] = middlewareFunc
+}
+func ensureNamedMiddlewaresRegistered(names []string) {
+	missing := []string{}
+	for _, name := range names {
+		if _, registered := namedMiddlewares[name]; !registered {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		panic(fmt.Sprintf(
+			"middlewares referenced via @Middleware annotations were never registered using RegisterNamedMiddleware: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
 func RegisterCustomValidator(validateTagName string, validateFunc runtime.ValidationFunc) {
 	validatorInstance.RegisterValidation(validateTagName, func(fl validator.FieldLevel) bool {
 		return validateFunc(fl)
 	})
 }
+// BasePath is the path under which RegisterRoutes mounts the API's routes
+const BasePath = ""
+// RegisterRoutes registers the API's routes on the given engine, under BasePath
 func RegisterRoutes(engine *mux.Router) {
+	RegisterGroupRoutes(engine, BasePath)
+}
+// RegisterGroupRoutes registers the API's routes on the given router, e.g. a sub-router created via PathPrefix(...).Subrouter(), under the given base path.
+//
+// The base path is relative to the router's own prefix.
+// For the OpenAPI specification to match the served routes, the two should add up to BasePath
+func RegisterGroupRoutes(engine *mux.Router, basePath string) {
 	urlParamRegex = regexp.MustCompile(`\{([\w\d-_]+)\}`)
+	versionedRouteHandlers = map[string]map[int]http.HandlerFunc{}
+	ensureNamedMiddlewaresRegistered([]string{"audit", "trace"})
 	// register routes extension placeholder
 	// E2EClassSecController
-	engine.HandleFunc(toMuxUrl("/e2e/with-default-class-security"), func(w http.ResponseWriter, req *http.Request) {
+	e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes := []int{}
+	e2EclassSecControllerWithDefaultClassSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -327,7 +1389,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "WithDefaultClassSecurity")
 			return
 		}
-		controller := E2EClassSecController.E2EClassSecController{}
+		controller := createController[E2EClassSecController.E2EClassSecController](getRequestContext(req))
 		controller.InitController(req)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := req.Header.Get("x-test-scopes")
@@ -352,10 +1414,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -373,7 +1437,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -391,6 +1455,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/WithDefaultClassSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -411,8 +1476,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/with-default-override-class-security"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/with-default-class-security")), e2EclassSecControllerWithDefaultClassSecurityHandler).Methods("GET")
+	e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes := []int{}
+	e2EclassSecControllerWithOverrideClassSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -434,7 +1501,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "WithOverrideClassSecurity")
 			return
 		}
-		controller := E2EClassSecController.E2EClassSecController{}
+		controller := createController[E2EClassSecController.E2EClassSecController](getRequestContext(req))
 		controller.InitController(req)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := req.Header.Get("x-test-scopes")
@@ -459,10 +1526,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -480,7 +1549,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -498,6 +1567,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/WithOverrideClassSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -518,9 +1588,11 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/with-default-override-class-security")), e2EclassSecControllerWithOverrideClassSecurityHandler).Methods("GET")
 	// E2EController
-	engine.HandleFunc(toMuxUrl("/e2e/simple-get"), func(w http.ResponseWriter, req *http.Request) {
+	e2EcontrollerSimpleGetErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -542,7 +1614,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGet")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -559,7 +1631,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -577,6 +1649,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/SimpleGet",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -597,8 +1670,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/simple-get-empty-string"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/simple-get")), e2EcontrollerSimpleGetHandler).Methods("GET")
+	e2EcontrollerSimpleGetEmptyStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyStringHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -620,7 +1695,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGetEmptyString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -637,7 +1712,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -655,6 +1730,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/SimpleGetEmptyString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -675,8 +1751,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/simple-get-ptr-string"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/simple-get-empty-string")), e2EcontrollerSimpleGetEmptyStringHandler).Methods("GET")
+	e2EcontrollerSimpleGetPtrStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetPtrStringHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -698,7 +1776,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGetPtrString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -715,7 +1793,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -733,6 +1811,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/SimpleGetPtrString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -753,8 +1832,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/simple-get-null-string"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/simple-get-ptr-string")), e2EcontrollerSimpleGetPtrStringHandler).Methods("GET")
+	e2EcontrollerSimpleGetNullStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetNullStringHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -776,7 +1857,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGetNullString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -793,7 +1874,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -811,6 +1892,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/SimpleGetNullString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -831,8 +1913,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/simple-get-object"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/simple-get-null-string")), e2EcontrollerSimpleGetNullStringHandler).Methods("GET")
+	e2EcontrollerNamedMiddlewaresErrorStatusCodes := []int{}
+	e2EcontrollerNamedMiddlewaresHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -851,10 +1935,10 @@ func RegisterRoutes(engine *mux.Router) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(w, authErr, "SimpleGetObject")
+			handleAuthorizationError(w, authErr, "NamedMiddlewares")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -865,13 +1949,23 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
+		routeMiddlewares := []MiddlewareFunc{namedMiddlewares["audit"], namedMiddlewares["trace"]}
+		// Middlewares routeMiddlewares section
+		for _, middleware := range routeMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares routeMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.SimpleGetObject()
+		value, opError := controller.NamedMiddlewares()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -884,11 +1978,12 @@ func RegisterRoutes(engine *mux.Router) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObject'",
+				Detail:     "Encountered an error during operation 'NamedMiddlewares'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetObject",
+				Instance:   "/controller/error/NamedMiddlewares",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -909,8 +2004,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/simple-get-object-ptr"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/named-middlewares")), e2EcontrollerNamedMiddlewaresHandler).Methods("GET")
+	e2EcontrollerSimpleGetObjectErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -929,10 +2026,10 @@ func RegisterRoutes(engine *mux.Router) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(w, authErr, "SimpleGetObjectPtr")
+			handleAuthorizationError(w, authErr, "SimpleGetObject")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -944,12 +2041,12 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.SimpleGetObjectPtr()
+		value, opError := controller.SimpleGetObject()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -962,11 +2059,12 @@ func RegisterRoutes(engine *mux.Router) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObjectPtr'",
+				Detail:     "Encountered an error during operation 'SimpleGetObject'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetObjectPtr",
+				Instance:   "/controller/error/SimpleGetObject",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -987,8 +2085,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/simple-get-object-null"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/simple-get-object")), e2EcontrollerSimpleGetObjectHandler).Methods("GET")
+	e2EcontrollerSimpleGetObjectPtrErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectPtrHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -1007,10 +2107,10 @@ func RegisterRoutes(engine *mux.Router) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(w, authErr, "SimpleGetObjectNull")
+			handleAuthorizationError(w, authErr, "SimpleGetObjectPtr")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1022,12 +2122,12 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.SimpleGetObjectNull()
+		value, opError := controller.SimpleGetObjectPtr()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1040,11 +2140,12 @@ func RegisterRoutes(engine *mux.Router) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'SimpleGetObjectNull'",
+				Detail:     "Encountered an error during operation 'SimpleGetObjectPtr'",
 				Status:     statusCode,
-				Instance:   "/controller/error/SimpleGetObjectNull",
+				Instance:   "/controller/error/SimpleGetObjectPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -1065,8 +2166,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/primitive-return-type"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/simple-get-object-ptr")), e2EcontrollerSimpleGetObjectPtrHandler).Methods("GET")
+	e2EcontrollerSimpleGetObjectNullErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectNullHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -1085,10 +2188,10 @@ func RegisterRoutes(engine *mux.Router) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(w, authErr, "PrimitiveReturnType")
+			handleAuthorizationError(w, authErr, "SimpleGetObjectNull")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1100,12 +2203,93 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.PrimitiveReturnType()
+		value, opError := controller.SimpleGetObjectNull()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SimpleGetObjectNull'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SimpleGetObjectNull",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/simple-get-object-null")), e2EcontrollerSimpleGetObjectNullHandler).Methods("GET")
+	e2EcontrollerPrimitiveReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveReturnTypeHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PrimitiveReturnType")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PrimitiveReturnType()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1123,6 +2307,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/PrimitiveReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -1143,8 +2328,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/primitive-array-return-type"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/primitive-return-type")), e2EcontrollerPrimitiveReturnTypeHandler).Methods("GET")
+	e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveArrayReturnTypeHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -1166,7 +2353,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "PrimitiveArrayReturnType")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1183,7 +2370,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1201,6 +2388,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/PrimitiveArrayReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -1221,8 +2409,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/primitive-alias-return-type"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/primitive-array-return-type")), e2EcontrollerPrimitiveArrayReturnTypeHandler).Methods("GET")
+	e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasReturnTypeHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -1244,7 +2434,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "PrimitiveAliasReturnType")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1261,7 +2451,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1279,6 +2469,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/PrimitiveAliasReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -1299,8 +2490,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/primitive-alias-array-return-type"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/primitive-alias-return-type")), e2EcontrollerPrimitiveAliasReturnTypeHandler).Methods("GET")
+	e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasArrayReturnTypeHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -1322,7 +2515,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "PrimitiveAliasArrayReturnType")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -1339,7 +2532,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1357,6 +2550,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/PrimitiveAliasArrayReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -1377,8 +2571,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/simple-get-empty"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/primitive-alias-array-return-type")), e2EcontrollerPrimitiveAliasArrayReturnTypeHandler).Methods("GET")
+	e2EcontrollerSimpleGetEmptyErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -1400,7 +2596,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGetEmpty")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var queryParamRawPtr *string = nil
 		queryParamRaw := req.URL.Query().Get("queryParam")
@@ -1420,10 +2616,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -1441,7 +2639,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1459,6 +2657,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/SimpleGetEmpty",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -1477,8 +2676,10 @@ func RegisterRoutes(engine *mux.Router) {
 		// after operation routes extension placeholder
 		w.WriteHeader(statusCode)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/get-with-all-params/{pathParam}"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/simple-get-empty")), e2EcontrollerSimpleGetEmptyHandler).Methods("GET")
+	e2EcontrollerGetWithAllParamsErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -1500,7 +2701,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "GetWithAllParams")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var queryParamRawPtr *string = nil
 		queryParamRaw := req.URL.Query().Get("queryParam")
@@ -1520,10 +2721,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		pathParamvars := mux.Vars(req)
@@ -1544,10 +2747,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var headerParamRawPtr *string = nil
@@ -1573,10 +2778,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -1594,7 +2801,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1612,6 +2819,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/GetWithAllParams",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -1632,8 +2840,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/get-with-all-params-ptr/{pathParam}"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")), e2EcontrollerGetWithAllParamsHandler).Methods("GET")
+	e2EcontrollerGetWithAllParamsPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsPtrHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -1655,7 +2865,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "GetWithAllParamsPtr")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var queryParamRawPtr *string = nil
 		queryParamRaw := req.URL.Query().Get("queryParam")
@@ -1682,10 +2892,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var headerParamRawPtr *string = nil
@@ -1715,7 +2927,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1733,6 +2945,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/GetWithAllParamsPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -1753,8 +2966,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/get-with-all-params-required-ptr/{pathParam}"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsPtrHandler).Methods("GET")
+	e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsRequiredPtrHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -1776,7 +2991,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "GetWithAllParamsRequiredPtr")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var queryParamRawPtr *string = nil
 		queryParamRaw := req.URL.Query().Get("queryParam")
@@ -1796,10 +3011,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		pathParamvars := mux.Vars(req)
@@ -1820,10 +3037,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var headerParamRawPtr *string = nil
@@ -1849,10 +3068,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -1870,7 +3091,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1888,6 +3109,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/GetWithAllParamsRequiredPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -1908,8 +3130,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/post-with-all-params-body"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsRequiredPtrHandler).Methods("GET")
+	e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -1931,7 +3155,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBody")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var conversionErr error
 		var queryParamRawPtr *string = nil
@@ -1952,10 +3176,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var headerParamRawPtr *string = nil
@@ -1981,10 +3207,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var theBodyRawPtr *Param5theBody.BodyInfo = nil
@@ -1999,20 +3227,26 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBody' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBody",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBody",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -2030,7 +3264,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2048,6 +3282,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/PostWithAllParamsWithBody",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -2068,8 +3303,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/post-with-all-params-body-ptr"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/post-with-all-params-body")), e2EcontrollerPostWithAllParamsWithBodyHandler).Methods("POST")
+	e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyPtrHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -2091,7 +3328,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBodyPtr")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var conversionErr error
 		var queryParamRawPtr *string = nil
@@ -2125,20 +3362,26 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBodyPtr' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBodyPtr",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -2156,7 +3399,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2174,6 +3417,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/PostWithAllParamsWithBodyPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -2194,8 +3438,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/post-with-all-params-body-required-ptr"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-ptr")), e2EcontrollerPostWithAllParamsWithBodyPtrHandler).Methods("POST")
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -2217,7 +3463,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBodyRequiredPtr")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var conversionErr error
 		var theBodyRawPtr *Param5theBody.BodyInfo = nil
@@ -2232,20 +3478,26 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBodyRequiredPtr' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyRequiredPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBodyRequiredPtr",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -2263,7 +3515,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2281,6 +3533,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/PostWithAllParamsWithBodyRequiredPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -2301,8 +3554,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/get-header-start-with-letter"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-required-ptr")), e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler).Methods("POST")
+	e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes := []int{}
+	e2EcontrollerGetHeaderStartWithLetterHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -2324,7 +3579,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "GetHeaderStartWithLetter")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := req.Header.Get("headerParam")
@@ -2349,10 +3604,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -2370,7 +3627,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2388,6 +3645,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/GetHeaderStartWithLetter",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -2408,8 +3666,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/with-default-config-security"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/get-header-start-with-letter")), e2EcontrollerGetHeaderStartWithLetterHandler).Methods("GET")
+	e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithDefaultConfigSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -2431,7 +3691,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "WithDefaultConfigSecurity")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := req.Header.Get("x-test-scopes")
@@ -2456,10 +3716,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -2477,7 +3739,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2495,6 +3757,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/WithDefaultConfigSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -2515,8 +3778,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/with-one-security"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/with-default-config-security")), e2EcontrollerWithDefaultConfigSecurityHandler).Methods("GET")
+	e2EcontrollerWithOneSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithOneSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -2538,7 +3803,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "WithOneSecurity")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := req.Header.Get("x-test-scopes")
@@ -2563,10 +3828,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -2584,7 +3851,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2602,6 +3869,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/WithOneSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -2622,8 +3890,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/with-two-security"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/with-one-security")), e2EcontrollerWithOneSecurityHandler).Methods("GET")
+	e2EcontrollerWithTwoSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -2657,7 +3927,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "WithTwoSecurity")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := req.Header.Get("x-test-scopes")
@@ -2682,10 +3952,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -2703,7 +3975,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2721,6 +3993,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/WithTwoSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -2741,8 +4014,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/with-two-security-same-method"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/with-two-security")), e2EcontrollerWithTwoSecurityHandler).Methods("GET")
+	e2EcontrollerWithAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithAdvancedSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -2756,16 +4031,21 @@ func RegisterRoutes(engine *mux.Router) {
 								"other",
 							},
 						},
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+							},
+						},
 					},
 				},
 				{
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName",
+							SchemaName: "securitySchemaName3",
 							Scopes: []string{
-								"write",
-								"read",
+								"admin",
 							},
 						},
 					},
@@ -2773,10 +4053,10 @@ func RegisterRoutes(engine *mux.Router) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(w, authErr, "WithTwoSecuritySameMethod")
+			handleAuthorizationError(w, authErr, "WithAdvancedSecurity")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var headerParamRawPtr *string = nil
 		headerParamRaw := req.Header.Get("x-test-scopes")
@@ -2801,12 +4081,1802 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithAdvancedSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WithAdvancedSecurity(*headerParamRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithAdvancedSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithAdvancedSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/with-advanced-security")), e2EcontrollerWithAdvancedSecurityHandler).Methods("GET")
+	e2EcontrollerWithPrincipalErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithPrincipal")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(req))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			handleAuthorizationError(w, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipal")
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WithPrincipal(principalRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithPrincipal'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithPrincipal",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/with-principal")), e2EcontrollerWithPrincipalHandler).Methods("GET")
+	e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalAdvancedSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName3",
+							Scopes: []string{
+								"admin",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithPrincipalAdvancedSecurity")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(req))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			handleAuthorizationError(w, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipalAdvancedSecurity")
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WithPrincipalAdvancedSecurity(principalRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithPrincipalAdvancedSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithPrincipalAdvancedSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/with-principal-advanced-security")), e2EcontrollerWithPrincipalAdvancedSecurityHandler).Methods("GET")
+	e2EcontrollerPublicRouteErrorStatusCodes := []int{}
+	e2EcontrollerPublicRouteHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PublicRoute()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PublicRoute'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PublicRoute",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/public")), e2EcontrollerPublicRouteHandler).Methods("GET")
+	e2EcontrollerHeadCheckErrorStatusCodes := []int{}
+	e2EcontrollerHeadCheckHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "HeadCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.HeadCheck()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'HeadCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/HeadCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/head-check")), e2EcontrollerHeadCheckHandler).Methods("HEAD")
+	e2EcontrollerOptionsCheckErrorStatusCodes := []int{}
+	e2EcontrollerOptionsCheckHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "OptionsCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.OptionsCheck()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'OptionsCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/OptionsCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/options-check")), e2EcontrollerOptionsCheckHandler).Methods("OPTIONS")
+	e2EcontrollerTraceCheckErrorStatusCodes := []int{}
+	e2EcontrollerTraceCheckHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TraceCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.TraceCheck()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TraceCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TraceCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/trace-check")), e2EcontrollerTraceCheckHandler).Methods("TRACE")
+	e2EcontrollerPurgeCheckErrorStatusCodes := []int{}
+	e2EcontrollerPurgeCheckHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PurgeCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.PurgeCheck()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PurgeCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PurgeCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/purge-check")), e2EcontrollerPurgeCheckHandler).Methods("PURGE")
+	e2EcontrollerVersionedV1ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV1Handler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "VersionedV1")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.VersionedV1()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV1'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV1",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	e2EcontrollerVersionedV1Versions := []int{1}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV1Versions, e2EcontrollerVersionedV1Handler)
+	e2EcontrollerVersionedV2ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV2Handler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "VersionedV2")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.VersionedV2()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV2'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV2",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	e2EcontrollerVersionedV2Versions := []int{2}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV2Versions, e2EcontrollerVersionedV2Handler)
+	e2EcontrollerRateLimitedErrorStatusCodes := []int{}
+	e2EcontrollerRateLimitedRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "RateLimited")
+	e2EcontrollerRateLimitedHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		if !enforceRateLimit(w, req, "RateLimited", e2EcontrollerRateLimitedRateLimit) {
+			return
+		}
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "RateLimited")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.RateLimited()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'RateLimited'",
+				Status:     statusCode,
+				Instance:   "/controller/error/RateLimited",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/rate-limited")), e2EcontrollerRateLimitedHandler).Methods("GET")
+	e2EcontrollerTimedOutErrorStatusCodes := []int{}
+	e2EcontrollerTimedOutTimeout := newOperationTimeout("50ms")
+	e2EcontrollerTimedOutHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		cancelTimeout := applyOperationTimeout(req, e2EcontrollerTimedOutTimeout)
+		defer cancelTimeout()
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TimedOut")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		if isDeadlineExceeded(req) {
+			handleDeadlineExceeded(w, "TimedOut", http.StatusServiceUnavailable)
+			return
+		}
+		value, opError := controller.TimedOut(getRequestContext(req))
+		if isDeadlineExceeded(req) {
+			handleDeadlineExceeded(w, "TimedOut", http.StatusGatewayTimeout)
+			return
+		}
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTimedOutErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TimedOut'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TimedOut",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTimedOutErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/timeout")), e2EcontrollerTimedOutHandler).Methods("GET")
+	e2EcontrollerMaxBodySizeErrorStatusCodes := []int{}
+	e2EcontrollerMaxBodySizeHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		if !enforceMaxBodySize(w, req, "MaxBodySize", 64) {
+			return
+		}
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "MaxBodySize")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var conversionErr error
+		var dataRawPtr *Param8data.ObjectWithByteSlice = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "MaxBodySize",
+						"parameter": "data",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/MaxBodySize",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.MaxBodySize(*dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MaxBodySize'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MaxBodySize",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/max-body-size")), e2EcontrollerMaxBodySizeHandler).Methods("POST")
+	e2EcontrollerIdempotentErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "Idempotent")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed := hashRequestBody(w, req, "Idempotent", 0)
+		if !isBodyHashed {
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		idempotentWriter, completeIdempotentRequest := beginIdempotentRequest(w, req, "Idempotent", idempotentRequestHash, e2EcontrollerIdempotentIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return
+		}
+		w = idempotentWriter
+		defer completeIdempotentRequest()
+		value, opError := controller.Idempotent()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'Idempotent'",
+				Status:     statusCode,
+				Instance:   "/controller/error/Idempotent",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/idempotent")), e2EcontrollerIdempotentHandler).Methods("POST")
+	e2EcontrollerIdempotentWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentWithBodyIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentWithBodyHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "IdempotentWithBody")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed := hashRequestBody(w, req, "IdempotentWithBody", 0)
+		if !isBodyHashed {
+			return
+		}
+		var conversionErr error
+		var dataRawPtr *Param5data.BodyInfo = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "IdempotentWithBody",
+						"parameter": "data",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/IdempotentWithBody",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			// json body validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		idempotentWriter, completeIdempotentRequest := beginIdempotentRequest(w, req, "IdempotentWithBody", idempotentRequestHash, e2EcontrollerIdempotentWithBodyIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return
+		}
+		w = idempotentWriter
+		defer completeIdempotentRequest()
+		value, opError := controller.IdempotentWithBody(*dataRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'IdempotentWithBody'",
+				Status:     statusCode,
+				Instance:   "/controller/error/IdempotentWithBody",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/idempotent-with-body")), e2EcontrollerIdempotentWithBodyHandler).Methods("POST")
+	e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecuritySameMethodHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"write",
+								"read",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithTwoSecuritySameMethod")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("x-test-scopes")
+		_, isheaderParamExists := req.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			// validation error response extension placeholder
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithTwoSecuritySameMethod",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/with-two-security-same-method")), e2EcontrollerWithTwoSecuritySameMethodHandler).Methods("GET")
+	e2EcontrollerDefaultErrorErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "DefaultError")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.DefaultError()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DefaultError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DefaultError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/default-error")), e2EcontrollerDefaultErrorHandler).Methods("GET")
+	e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorWithPayloadHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "DefaultErrorWithPayload")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.DefaultErrorWithPayload()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DefaultErrorWithPayload",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/default-error-with-payload")), e2EcontrollerDefaultErrorWithPayloadHandler).Methods("GET")
+	e2EcontrollerInjectedDependencyErrorStatusCodes := []int{}
+	e2EcontrollerInjectedDependencyHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "InjectedDependency")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.InjectedDependency()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'InjectedDependency'",
+				Status:     statusCode,
+				Instance:   "/controller/error/InjectedDependency",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/injected-dependency")), e2EcontrollerInjectedDependencyHandler).Methods("GET")
+	e2EcontrollerMappedErrorErrorStatusCodes := []int{404}
+	e2EcontrollerMappedErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "MappedError")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		opError := controller.MappedError()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerMappedErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MappedError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MappedError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedErrorErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.WriteHeader(statusCode)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/mapped-error")), e2EcontrollerMappedErrorHandler).Methods("GET")
+	e2EcontrollerUndeclaredMappedErrorErrorStatusCodes := []int{}
+	e2EcontrollerUndeclaredMappedErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "UndeclaredMappedError")
 			return
 		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -2817,12 +5887,12 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
+		opError := controller.UndeclaredMappedError()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2835,11 +5905,12 @@ func RegisterRoutes(engine *mux.Router) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
+				Detail:     "Encountered an error during operation 'UndeclaredMappedError'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithTwoSecuritySameMethod",
+				Instance:   "/controller/error/UndeclaredMappedError",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -2856,12 +5927,12 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
 		// after operation routes extension placeholder
-		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/default-error"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/undeclared-mapped-error")), e2EcontrollerUndeclaredMappedErrorHandler).Methods("GET")
+	e2EcontrollerMappedTypedErrorErrorStatusCodes := []int{409}
+	e2EcontrollerMappedTypedErrorHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -2880,10 +5951,10 @@ func RegisterRoutes(engine *mux.Router) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(w, authErr, "DefaultError")
+			handleAuthorizationError(w, authErr, "MappedTypedError")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2895,12 +5966,12 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		opError := controller.DefaultError()
+		value, opError := controller.MappedTypedError()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2913,11 +5984,12 @@ func RegisterRoutes(engine *mux.Router) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DefaultError'",
+				Detail:     "Encountered an error during operation 'MappedTypedError'",
 				Status:     statusCode,
-				Instance:   "/controller/error/DefaultError",
+				Instance:   "/controller/error/MappedTypedError",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -2934,10 +6006,14 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
 		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/default-error-with-payload"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/mapped-typed-error")), e2EcontrollerMappedTypedErrorHandler).Methods("GET")
+	e2EcontrollerPanicErrorStatusCodes := []int{}
+	e2EcontrollerPanicHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -2956,10 +6032,10 @@ func RegisterRoutes(engine *mux.Router) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(w, authErr, "DefaultErrorWithPayload")
+			handleAuthorizationError(w, authErr, "Panic")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -2971,12 +6047,12 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		// before operation routes extension placeholder
-		value, opError := controller.DefaultErrorWithPayload()
+		value, opError := controller.Panic()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPanicErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2989,11 +6065,12 @@ func RegisterRoutes(engine *mux.Router) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
+				Detail:     "Encountered an error during operation 'Panic'",
 				Status:     statusCode,
-				Instance:   "/controller/error/DefaultErrorWithPayload",
+				Instance:   "/controller/error/Panic",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPanicErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -3014,8 +6091,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/custom-error"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/panic")), e2EcontrollerPanicHandler).Methods("GET")
+	e2EcontrollerCustomErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomErrorHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -3037,7 +6116,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "CustomError")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3054,8 +6133,8 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		emptyErr := Response6CustomError.CustomError{}
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomErrorErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
 		if opError != emptyErr {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3083,8 +6162,10 @@ func RegisterRoutes(engine *mux.Router) {
 		// after operation routes extension placeholder
 		w.WriteHeader(statusCode)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/custom-error-ptr"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/custom-error")), e2EcontrollerCustomErrorHandler).Methods("GET")
+	e2EcontrollerCustomPtrErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomPtrErrorHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -3106,7 +6187,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "CustomPtrError")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3123,7 +6204,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomPtrErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3151,8 +6232,10 @@ func RegisterRoutes(engine *mux.Router) {
 		// after operation routes extension placeholder
 		w.WriteHeader(statusCode)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/503-error-code"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/custom-error-ptr")), e2EcontrollerCustomPtrErrorHandler).Methods("GET")
+	e2EcontrollerError503ErrorStatusCodes := []int{}
+	e2EcontrollerError503Handler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -3174,7 +6257,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "Error503")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3191,7 +6274,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerError503ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3209,6 +6292,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/Error503",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerError503ErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -3227,8 +6311,10 @@ func RegisterRoutes(engine *mux.Router) {
 		// after operation routes extension placeholder
 		w.WriteHeader(statusCode)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/custom-error-503"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/503-error-code")), e2EcontrollerError503Handler).Methods("GET")
+	e2EcontrollerCustomError503ErrorStatusCodes := []int{}
+	e2EcontrollerCustomError503Handler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -3250,7 +6336,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "CustomError503")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3267,8 +6353,8 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
-		emptyErr := Response6CustomError.CustomError{}
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomError503ErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
 		if opError != emptyErr {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3296,8 +6382,10 @@ func RegisterRoutes(engine *mux.Router) {
 		// after operation routes extension placeholder
 		w.WriteHeader(statusCode)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/context-access"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/custom-error-503")), e2EcontrollerCustomError503Handler).Methods("GET")
+	e2EcontrollerContextAccessErrorStatusCodes := []int{}
+	e2EcontrollerContextAccessHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -3319,7 +6407,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ContextAccess")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3336,7 +6424,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextAccessErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3354,6 +6442,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ContextAccess",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextAccessErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -3372,8 +6461,10 @@ func RegisterRoutes(engine *mux.Router) {
 		// after operation routes extension placeholder
 		w.WriteHeader(statusCode)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/http-method"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/context-access")), e2EcontrollerContextAccessHandler).Methods("GET")
+	e2EcontrollerGetErrorStatusCodes := []int{}
+	e2EcontrollerGetHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -3395,7 +6486,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "Get")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3412,7 +6503,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerGetErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3430,6 +6521,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/Get",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -3448,8 +6540,10 @@ func RegisterRoutes(engine *mux.Router) {
 		// after operation routes extension placeholder
 		w.WriteHeader(statusCode)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/http-method"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerGetHandler).Methods("GET")
+	e2EcontrollerPostErrorStatusCodes := []int{}
+	e2EcontrollerPostHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -3471,7 +6565,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "Post")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3488,7 +6582,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPostErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3506,6 +6600,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/Post",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -3524,8 +6619,10 @@ func RegisterRoutes(engine *mux.Router) {
 		// after operation routes extension placeholder
 		w.WriteHeader(statusCode)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/http-method"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPostHandler).Methods("POST")
+	e2EcontrollerPutErrorStatusCodes := []int{}
+	e2EcontrollerPutHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -3547,7 +6644,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "Put")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3564,7 +6661,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPutErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3582,6 +6679,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/Put",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPutErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -3600,8 +6698,10 @@ func RegisterRoutes(engine *mux.Router) {
 		// after operation routes extension placeholder
 		w.WriteHeader(statusCode)
 		// route end routes extension placeholder
-	}).Methods("PUT")
-	engine.HandleFunc(toMuxUrl("/e2e/http-method"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPutHandler).Methods("PUT")
+	e2EcontrollerDeleteErrorStatusCodes := []int{}
+	e2EcontrollerDeleteHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -3623,7 +6723,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "Delete")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3640,7 +6740,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDeleteErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3658,6 +6758,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/Delete",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeleteErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -3676,8 +6777,10 @@ func RegisterRoutes(engine *mux.Router) {
 		// after operation routes extension placeholder
 		w.WriteHeader(statusCode)
 		// route end routes extension placeholder
-	}).Methods("DELETE")
-	engine.HandleFunc(toMuxUrl("/e2e/http-method"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerDeleteHandler).Methods("DELETE")
+	e2EcontrollerPatchErrorStatusCodes := []int{}
+	e2EcontrollerPatchHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -3699,7 +6802,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "Patch")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3716,7 +6819,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPatchErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3734,6 +6837,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/Patch",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPatchErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -3752,8 +6856,10 @@ func RegisterRoutes(engine *mux.Router) {
 		// after operation routes extension placeholder
 		w.WriteHeader(statusCode)
 		// route end routes extension placeholder
-	}).Methods("PATCH")
-	engine.HandleFunc(toMuxUrl("/e2e/template-context-1"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPatchHandler).Methods("PATCH")
+	e2EcontrollerTemplateContext1ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext1Handler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -3775,7 +6881,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TemplateContext1")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3792,7 +6898,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3810,6 +6916,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TemplateContext1",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -3830,8 +6937,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/template-context-2"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/template-context-1")), e2EcontrollerTemplateContext1Handler).Methods("GET")
+	e2EcontrollerTemplateContext2ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext2Handler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -3853,7 +6962,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TemplateContext2")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -3870,7 +6979,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3888,6 +6997,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TemplateContext2",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -3908,8 +7018,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/form"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/template-context-2")), e2EcontrollerTemplateContext2Handler).Methods("GET")
+	e2EcontrollerTestFormErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -3931,7 +7043,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestForm")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		req.ParseForm()
 		var item1RawPtr *string = nil
@@ -3955,10 +7067,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item1"
-			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		req.ParseForm()
@@ -3983,10 +7097,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item2"
-			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -4004,7 +7120,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4022,6 +7138,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TestForm",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -4042,8 +7159,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/form-extra"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/form")), e2EcontrollerTestFormHandler).Methods("POST")
+	e2EcontrollerTestFormExtraErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormExtraHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -4065,7 +7184,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestFormExtra")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		req.ParseForm()
 		var item1RawPtr *int64 = nil
@@ -4075,7 +7194,7 @@ func RegisterRoutes(engine *mux.Router) {
 			item1Raw = item1RawArr[0] // Get first value since form values are slices
 		}
 		if isitem1Exists {
-			item1Uint64, conversionErr := strconv.ParseInt(item1Raw, 10, 64)
+			item1, conversionErr := parseIntegerParam[int64](item1Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4086,24 +7205,21 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestFormExtra' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"item1",
-						"int64",
-						reflect.TypeOf(item1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestFormExtra",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item1",
+					"form",
+					"/item1",
+					"int64",
+					reflect.TypeOf(item1Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			item1 := int64(item1Uint64)
 			item1RawPtr = &item1
 		}
 		if validatorErr := validatorInstance.Var(item1RawPtr, "required,gte=80"); validatorErr != nil {
@@ -4117,10 +7233,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item1"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		req.ParseForm()
@@ -4145,17 +7263,19 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item2"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var item3RawPtr *int64 = nil
 		item3Raw := req.URL.Query().Get("item3")
 		isitem3Exists := req.URL.Query().Has("item3")
 		if isitem3Exists {
-			item3Uint64, conversionErr := strconv.ParseInt(item3Raw, 10, 64)
+			item3, conversionErr := parseIntegerParam[int64](item3Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4166,24 +7286,21 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestFormExtra' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"item3",
-						"int64",
-						reflect.TypeOf(item3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestFormExtra",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item3",
+					"query",
+					"/item3",
+					"int64",
+					reflect.TypeOf(item3Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			item3 := int64(item3Uint64)
 			item3RawPtr = &item3
 		}
 		if validatorErr := validatorInstance.Var(item3RawPtr, "required,gte=80"); validatorErr != nil {
@@ -4197,10 +7314,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item3"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/item3", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -4218,7 +7337,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4236,6 +7355,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TestFormExtra",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -4256,8 +7376,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/test-response-validation"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/form-extra")), e2EcontrollerTestFormExtraHandler).Methods("POST")
+	e2EcontrollerTestResponseValidationErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -4279,7 +7401,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestResponseValidation")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4296,7 +7418,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4314,6 +7436,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TestResponseValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -4334,8 +7457,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/test-response-validation-ptr"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/test-response-validation")), e2EcontrollerTestResponseValidationHandler).Methods("POST")
+	e2EcontrollerTestResponseValidationPtrErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationPtrHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -4357,7 +7482,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestResponseValidationPtr")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4374,7 +7499,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4392,6 +7517,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TestResponseValidationPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -4412,8 +7538,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/test-response-validation-null"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/test-response-validation-ptr")), e2EcontrollerTestResponseValidationPtrHandler).Methods("POST")
+	e2EcontrollerTestResponseValidationNullErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationNullHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -4435,7 +7563,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestResponseValidationNull")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -4452,7 +7580,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4470,6 +7598,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TestResponseValidationNull",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -4490,8 +7619,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/test-primitive-conversions"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/test-response-validation-null")), e2EcontrollerTestResponseValidationNullHandler).Methods("POST")
+	e2EcontrollerTestPrimitiveConversionsErrorStatusCodes := []int{500}
+	e2EcontrollerTestPrimitiveConversionsHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -4513,13 +7644,13 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestPrimitiveConversions")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var value1RawPtr *int64 = nil
 		value1Raw := req.URL.Query().Get("value1")
 		isvalue1Exists := req.URL.Query().Has("value1")
 		if isvalue1Exists {
-			value1Uint64, conversionErr := strconv.ParseInt(value1Raw, 10, 64)
+			value1, conversionErr := parseIntegerParam[int64](value1Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4530,24 +7661,21 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"int64",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value1",
+					"query",
+					"/value1",
+					"int64",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value1 := int64(value1Uint64)
 			value1RawPtr = &value1
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -4561,17 +7689,19 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value2RawPtr *bool = nil
 		value2Raw := req.URL.Query().Get("value2")
 		isvalue2Exists := req.URL.Query().Has("value2")
 		if isvalue2Exists {
-			value2Bool, conversionErr := strconv.ParseBool(value2Raw)
+			value2, conversionErr := strconv.ParseBool(value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4582,24 +7712,21 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"bool",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value2",
+					"query",
+					"/value2",
+					"bool",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value2 := value2Bool
 			value2RawPtr = &value2
 		}
 		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
@@ -4613,17 +7740,19 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value3RawPtr *int = nil
 		value3Raw := req.URL.Query().Get("value3")
 		isvalue3Exists := req.URL.Query().Has("value3")
 		if isvalue3Exists {
-			value3Uint64, conversionErr := strconv.Atoi(value3Raw)
+			value3, conversionErr := parseIntegerParam[int](value3Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4634,24 +7763,21 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value3",
-						"int",
-						reflect.TypeOf(value3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value3",
+					"query",
+					"/value3",
+					"int",
+					reflect.TypeOf(value3Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value3 := int(value3Uint64)
 			value3RawPtr = &value3
 		}
 		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
@@ -4665,17 +7791,19 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value3"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value3", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value4RawPtr *float64 = nil
 		value4Raw := req.URL.Query().Get("value4")
 		isvalue4Exists := req.URL.Query().Has("value4")
 		if isvalue4Exists {
-			value4Float64, conversionErr := strconv.ParseFloat(value4Raw, 64)
+			value4, conversionErr := parseFloatParam[float64](value4Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4686,24 +7814,21 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value4",
-						"float64",
-						reflect.TypeOf(value4Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value4",
+					"query",
+					"/value4",
+					"float64",
+					reflect.TypeOf(value4Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value4 := float64(value4Float64)
 			value4RawPtr = &value4
 		}
 		if validatorErr := validatorInstance.Var(value4RawPtr, "required"); validatorErr != nil {
@@ -4717,10 +7842,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value4"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value4", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -4738,7 +7865,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4756,6 +7883,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TestPrimitiveConversions",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -4776,8 +7904,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/test-enums"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/test-primitive-conversions")), e2EcontrollerTestPrimitiveConversionsHandler).Methods("POST")
+	e2EcontrollerTestEnumsErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -4799,15 +7929,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestEnums")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var conversionErr error
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw := req.URL.Query().Get("value1")
 		isvalue1Exists := req.URL.Query().Has("value1")
 		if isvalue1Exists {
 			value1 := value1Raw
-			value1Var := Param12value1.StatusEnumeration(value1)
+			value1Var := Param15value1.StatusEnumeration(value1)
 			value1RawPtr = &value1Var
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -4821,17 +7951,19 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value2RawPtr *Param4value2.NumberEnumeration = nil
 		value2Raw := req.URL.Query().Get("value2")
 		isvalue2Exists := req.URL.Query().Has("value2")
 		if isvalue2Exists {
-			value2Uint64, conversionErr := strconv.Atoi(value2Raw)
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4842,24 +7974,21 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnums' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnums",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value2",
+					"query",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value2 := int(value2Uint64)
 			value2Var := Param4value2.NumberEnumeration(value2)
 			value2RawPtr = &value2Var
 		}
@@ -4874,13 +8003,15 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var value3RawPtr *Param11value3.ObjectWithEnum = nil
+		var value3RawPtr *Param14value3.ObjectWithEnum = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &value3RawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -4892,20 +8023,26 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'TestEnums' but body parameter '%s' did not pass validation of '%s' - %s",
-					"value3",
-					"ObjectWithEnum",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "TestEnums",
+						"parameter": "value3",
+						"type":      "ObjectWithEnum",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/TestEnums",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -4923,7 +8060,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4941,6 +8078,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TestEnums",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -4961,8 +8099,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/test-enums-in-all/{value1}"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/test-enums")), e2EcontrollerTestEnumsHandler).Methods("POST")
+	e2EcontrollerTestEnumsInAllErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsInAllHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -4984,14 +8124,14 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestEnumsInAll")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		value1vars := mux.Vars(req)
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw, isvalue1Exists := value1vars["value1"]
 		if isvalue1Exists {
 			value1 := value1Raw
-			value1Var := Param12value1.StatusEnumeration(value1)
+			value1Var := Param15value1.StatusEnumeration(value1)
 			value1RawPtr = &value1Var
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -5005,10 +8145,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/value1", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value2RawPtr *Param4value2.NumberEnumeration = nil
@@ -5020,7 +8162,7 @@ func RegisterRoutes(engine *mux.Router) {
 			isvalue2Exists = len(headerValues) > 0
 		}
 		if isvalue2Exists {
-			value2Uint64, conversionErr := strconv.Atoi(value2Raw)
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -5031,24 +8173,21 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value2",
+					"header",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value2 := int(value2Uint64)
 			value2Var := Param4value2.NumberEnumeration(value2)
 			value2RawPtr = &value2Var
 		}
@@ -5063,14 +8202,16 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/value2", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		req.ParseForm()
-		var value3RawPtr *Param12value3.StatusEnumeration = nil
+		var value3RawPtr *Param15value3.StatusEnumeration = nil
 		value3RawArr, isvalue3Exists := req.PostForm["value3"]
 		value3Raw := ""
 		if isvalue3Exists {
@@ -5078,7 +8219,7 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		if isvalue3Exists {
 			value3 := value3Raw
-			value3Var := Param12value3.StatusEnumeration(value3)
+			value3Var := Param15value3.StatusEnumeration(value3)
 			value3RawPtr = &value3Var
 		}
 		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
@@ -5092,10 +8233,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value3"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/value3", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -5113,7 +8256,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5131,6 +8274,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TestEnumsInAll",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -5151,8 +8295,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/test-enums-optional"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/test-enums-in-all/{value1}")), e2EcontrollerTestEnumsInAllHandler).Methods("POST")
+	e2EcontrollerTestEnumsOptionalErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsOptionalHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -5174,9 +8320,9 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestEnumsOptional")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw := req.Header.Get("value1")
 		_, isvalue1Exists := req.Header["value1"]
 		if !isvalue1Exists {
@@ -5186,7 +8332,7 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		if isvalue1Exists {
 			value1 := value1Raw
-			value1Var := Param12value1.StatusEnumeration(value1)
+			value1Var := Param15value1.StatusEnumeration(value1)
 			value1RawPtr = &value1Var
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -5204,7 +8350,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5222,6 +8368,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TestEnumsOptional",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -5242,8 +8389,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/external-packages"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/test-enums-optional")), e2EcontrollerTestEnumsOptionalHandler).Methods("POST")
+	e2EcontrollerExternalPackagesErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -5265,18 +8414,18 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ExternalPackages")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var conversionErr error
-		var unitRawPtr *Param14unit.LengthUnits = nil
+		var unitRawPtr *Param17unit.LengthUnits = nil
 		unitRaw := req.URL.Query().Get("unit")
 		isunitExists := req.URL.Query().Has("unit")
 		if isunitExists {
 			unit := unitRaw
-			unitVar := Param14unit.LengthUnits(unit)
+			unitVar := Param17unit.LengthUnits(unit)
 			unitRawPtr = &unitVar
 		}
-		var dataRawPtr *Param13data.LengthDto = nil
+		var dataRawPtr *Param16data.LengthDto = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5288,20 +8437,26 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackages' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"LengthDto",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackages",
+						"parameter": "data",
+						"type":      "LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackages",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -5319,7 +8474,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5337,6 +8492,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ExternalPackages",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -5357,8 +8513,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/external-packages-unique-in-struct"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/external-packages")), e2EcontrollerExternalPackagesHandler).Methods("POST")
+	e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesUniqueInStructHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -5380,10 +8538,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ExternalPackagesUniqueInStruct")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var conversionErr error
-		var dataRawPtr *Param15data.UniqueExternalUsage = nil
+		var dataRawPtr *Param18data.UniqueExternalUsage = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5395,20 +8553,26 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackagesUniqueInStruct' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"UniqueExternalUsage",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesUniqueInStruct",
+						"parameter": "data",
+						"type":      "UniqueExternalUsage",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackagesUniqueInStruct",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -5426,7 +8590,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5444,6 +8608,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ExternalPackagesUniqueInStruct",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -5464,8 +8629,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/external-packages-validation"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/external-packages-unique-in-struct")), e2EcontrollerExternalPackagesUniqueInStructHandler).Methods("POST")
+	e2EcontrollerExternalPackagesValidationErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesValidationHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -5487,18 +8654,18 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ExternalPackagesValidation")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var conversionErr error
-		var unitRawPtr *Param14unit.LengthUnits = nil
+		var unitRawPtr *Param17unit.LengthUnits = nil
 		unitRaw := req.URL.Query().Get("unit")
 		isunitExists := req.URL.Query().Has("unit")
 		if isunitExists {
 			unit := unitRaw
-			unitVar := Param14unit.LengthUnits(unit)
+			unitVar := Param17unit.LengthUnits(unit)
 			unitRawPtr = &unitVar
 		}
-		var dataRawPtr *Param16data.LengthDtoWithValidation = nil
+		var dataRawPtr *Param19data.LengthDtoWithValidation = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5510,20 +8677,26 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackagesValidation' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"LengthDtoWithValidation",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesValidation",
+						"parameter": "data",
+						"type":      "LengthDtoWithValidation",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackagesValidation",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -5541,7 +8714,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5559,6 +8732,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ExternalPackagesValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -5579,8 +8753,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/arrays-in-body-and-res"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/external-packages-validation")), e2EcontrollerExternalPackagesValidationHandler).Methods("POST")
+	e2EcontrollerArraysInBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInBodyAndResHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -5602,10 +8778,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ArraysInBodyAndRes")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var conversionErr error
-		var dataRawPtr *[]Param13data.LengthDto = nil
+		var dataRawPtr *[]Param16data.LengthDto = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5617,20 +8793,26 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ArraysInBodyAndRes' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[]LengthDto",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInBodyAndRes",
+						"parameter": "data",
+						"type":      "[]LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ArraysInBodyAndRes",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -5648,7 +8830,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5666,6 +8848,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ArraysInBodyAndRes",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -5686,8 +8869,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/arrays-inside-body-and-res"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/arrays-in-body-and-res")), e2EcontrollerArraysInBodyAndResHandler).Methods("POST")
+	e2EcontrollerArraysInsideBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInsideBodyAndResHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -5709,10 +8894,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ArraysInsideBodyAndRes")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var conversionErr error
-		var dataRawPtr *[]Param17data.BlaBla = nil
+		var dataRawPtr *[]Param20data.BlaBla = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5724,20 +8909,26 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ArraysInsideBodyAndRes' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[]BlaBla",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInsideBodyAndRes",
+						"parameter": "data",
+						"type":      "[]BlaBla",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ArraysInsideBodyAndRes",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -5755,7 +8946,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5773,6 +8964,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ArraysInsideBodyAndRes",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -5793,8 +8985,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/deep-arrays-with-validation"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/arrays-inside-body-and-res")), e2EcontrollerArraysInsideBodyAndResHandler).Methods("POST")
+	e2EcontrollerDeepArraysWithValidationErrorStatusCodes := []int{500}
+	e2EcontrollerDeepArraysWithValidationHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -5816,10 +9010,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "DeepArraysWithValidation")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var conversionErr error
-		var dataRawPtr *[][]Param18data.BlaBla2 = nil
+		var dataRawPtr *[][]Param21data.BlaBla2 = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5831,20 +9025,26 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'DeepArraysWithValidation' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[][]BlaBla2",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "DeepArraysWithValidation",
+						"parameter": "data",
+						"type":      "[][]BlaBla2",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/DeepArraysWithValidation",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -5862,7 +9062,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5880,6 +9080,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/DeepArraysWithValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -5900,8 +9101,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/embedded-structs"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/deep-arrays-with-validation")), e2EcontrollerDeepArraysWithValidationHandler).Methods("POST")
+	e2EcontrollerEmbeddedStructsErrorStatusCodes := []int{500}
+	e2EcontrollerEmbeddedStructsHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -5923,10 +9126,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "EmbeddedStructs")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var conversionErr error
-		var dataRawPtr *Param19data.TheModel = nil
+		var dataRawPtr *Param22data.TheModel = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5938,20 +9141,26 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'EmbeddedStructs' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModel",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "EmbeddedStructs",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/EmbeddedStructs",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -5969,7 +9178,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5987,6 +9196,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/EmbeddedStructs",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -6007,8 +9217,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/structs-with-inner-pointer"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/embedded-structs")), e2EcontrollerEmbeddedStructsHandler).Methods("POST")
+	e2EcontrollerStructsWithInnerPointerErrorStatusCodes := []int{500}
+	e2EcontrollerStructsWithInnerPointerHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -6030,10 +9242,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "StructsWithInnerPointer")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var conversionErr error
-		var dataRawPtr *Param20data.TheModelWithInnerPointer = nil
+		var dataRawPtr *Param23data.TheModelWithInnerPointer = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6045,20 +9257,26 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'StructsWithInnerPointer' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModelWithInnerPointer",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "StructsWithInnerPointer",
+						"parameter": "data",
+						"type":      "TheModelWithInnerPointer",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/StructsWithInnerPointer",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -6076,7 +9294,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6094,6 +9312,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/StructsWithInnerPointer",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -6114,8 +9333,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/context-injection-empty"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/structs-with-inner-pointer")), e2EcontrollerStructsWithInnerPointerHandler).Methods("POST")
+	e2EcontrollerContextInjectionEmptyErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionEmptyHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -6137,7 +9358,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ContextInjectionEmpty")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
@@ -6154,7 +9375,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6172,6 +9393,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ContextInjectionEmpty",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -6190,8 +9412,10 @@ func RegisterRoutes(engine *mux.Router) {
 		// after operation routes extension placeholder
 		w.WriteHeader(statusCode)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/context-injection"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/context-injection-empty")), e2EcontrollerContextInjectionEmptyHandler).Methods("POST")
+	e2EcontrollerContextInjectionErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -6213,10 +9437,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ContextInjection")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var conversionErr error
-		var dataRawPtr *Param19data.TheModel = nil
+		var dataRawPtr *Param22data.TheModel = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6228,20 +9452,26 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ContextInjection' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModel",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ContextInjection",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ContextInjection",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -6259,7 +9489,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6277,6 +9507,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ContextInjection",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -6295,8 +9526,10 @@ func RegisterRoutes(engine *mux.Router) {
 		// after operation routes extension placeholder
 		w.WriteHeader(statusCode)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/byte-slice"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/context-injection")), e2EcontrollerContextInjectionHandler).Methods("POST")
+	e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithByteSliceHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -6318,10 +9551,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ReturnsStructWithByteSlice")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var conversionErr error
-		var arriveRawPtr *Param22arrive.ObjectWithByteSlice = nil
+		var arriveRawPtr *Param8arrive.ObjectWithByteSlice = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "", &arriveRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6333,20 +9566,26 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ReturnsStructWithByteSlice' but body parameter '%s' did not pass validation of '%s' - %s",
-					"arrive",
-					"ObjectWithByteSlice",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithByteSlice",
+						"parameter": "arrive",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ReturnsStructWithByteSlice",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -6364,7 +9603,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6382,6 +9621,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ReturnsStructWithByteSlice",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -6402,8 +9642,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/special-primitives"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/byte-slice")), e2EcontrollerReturnsStructWithByteSliceHandler).Methods("POST")
+	e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithSpecialPrimitivesHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -6425,10 +9667,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ReturnsStructWithSpecialPrimitives")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var conversionErr error
-		var arriveRawPtr *Param23arrive.ObjectWithSpecialPrimitives = nil
+		var arriveRawPtr *Param24arrive.ObjectWithSpecialPrimitives = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "", &arriveRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6440,20 +9682,26 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ReturnsStructWithSpecialPrimitives' but body parameter '%s' did not pass validation of '%s' - %s",
-					"arrive",
-					"ObjectWithSpecialPrimitives",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithSpecialPrimitives",
+						"parameter": "arrive",
+						"type":      "ObjectWithSpecialPrimitives",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ReturnsStructWithSpecialPrimitives",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -6471,7 +9719,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6489,6 +9737,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ReturnsStructWithSpecialPrimitives",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -6509,8 +9758,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/alias-of-primitive"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/special-primitives")), e2EcontrollerReturnsStructWithSpecialPrimitivesHandler).Methods("POST")
+	e2EcontrollerAliasOfStringErrorStatusCodes := []int{}
+	e2EcontrollerAliasOfStringHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -6532,10 +9783,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "AliasOfString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var conversionErr error
-		var objectRawPtr *Param24object.ObjectWithAliasOfString = nil
+		var objectRawPtr *Param25object.ObjectWithAliasOfString = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &objectRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6547,27 +9798,33 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'AliasOfString' but body parameter '%s' did not pass validation of '%s' - %s",
-					"object",
-					"ObjectWithAliasOfString",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "AliasOfString",
+						"parameter": "object",
+						"type":      "ObjectWithAliasOfString",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/AliasOfString",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var numRawPtr *Param25num.AliasOfInt = nil
+		var numRawPtr *Param26num.AliasOfInt = nil
 		numRaw := req.URL.Query().Get("num")
 		isnumExists := req.URL.Query().Has("num")
 		if isnumExists {
-			numUint64, conversionErr := strconv.Atoi(numRaw)
+			num, conversionErr := parseIntegerParam[int](numRaw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -6578,25 +9835,22 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'AliasOfString' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"num",
-						"AliasOfInt",
-						reflect.TypeOf(numRaw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/AliasOfString",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"AliasOfString",
+					"num",
+					"query",
+					"/num",
+					"AliasOfInt",
+					reflect.TypeOf(numRaw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				// params validation error response extension placeholder
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			num := int(numUint64)
-			numVar := Param25num.AliasOfInt(num)
+			numVar := Param26num.AliasOfInt(num)
 			numRawPtr = &numVar
 		}
 		if validatorErr := validatorInstance.Var(numRawPtr, "required"); validatorErr != nil {
@@ -6610,18 +9864,20 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "num"
-			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/num", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var strRawPtr *Param26str.AliasOfDirectString = nil
+		var strRawPtr *Param27str.AliasOfDirectString = nil
 		strRaw := req.URL.Query().Get("str")
 		isstrExists := req.URL.Query().Has("str")
 		if isstrExists {
 			str := strRaw
-			strVar := Param26str.AliasOfDirectString(str)
+			strVar := Param27str.AliasOfDirectString(str)
 			strRawPtr = &strVar
 		}
 		if validatorErr := validatorInstance.Var(strRawPtr, "required"); validatorErr != nil {
@@ -6635,10 +9891,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "str"
-			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/str", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -6656,7 +9914,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6674,6 +9932,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/AliasOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -6694,8 +9953,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/body-array-of-string"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/alias-of-primitive")), e2EcontrollerAliasOfStringHandler).Methods("POST")
+	e2EcontrollerBodyArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -6717,7 +9978,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "BodyArrayOfString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var conversionErr error
 		var valuesRawPtr *[]string = nil
@@ -6732,20 +9993,26 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'BodyArrayOfString' but body parameter '%s' did not pass validation of '%s' - %s",
-					"values",
-					"[]string",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfString",
+						"parameter": "values",
+						"type":      "[]string",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/BodyArrayOfString",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -6763,7 +10030,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6781,6 +10048,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/BodyArrayOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -6801,8 +10069,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/body-array-of-enum-string"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/body-array-of-string")), e2EcontrollerBodyArrayOfStringHandler).Methods("POST")
+	e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringEnumHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -6824,10 +10094,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "BodyArrayOfStringEnum")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var conversionErr error
-		var valuesRawPtr *[]Param27values.Myemamium = nil
+		var valuesRawPtr *[]Param28values.Myemamium = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &valuesRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6839,20 +10109,26 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'BodyArrayOfStringEnum' but body parameter '%s' did not pass validation of '%s' - %s",
-					"values",
-					"[]Myemamium",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfStringEnum",
+						"parameter": "values",
+						"type":      "[]Myemamium",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/BodyArrayOfStringEnum",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			// json body validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -6870,7 +10146,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6888,6 +10164,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/BodyArrayOfStringEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -6908,8 +10185,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/query-array-of-string"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/body-array-of-enum-string")), e2EcontrollerBodyArrayOfStringEnumHandler).Methods("POST")
+	e2EcontrollerQueryArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfStringHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -6931,7 +10210,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "QueryArrayOfString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var valuesRawPtr *[]string = nil
 		valuesRawArray := req.URL.Query()["values"]
@@ -6956,10 +10235,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -6977,7 +10258,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6995,6 +10276,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/QueryArrayOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -7015,8 +10297,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/query-array-of-enum"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/query-array-of-string")), e2EcontrollerQueryArrayOfStringHandler).Methods("POST")
+	e2EcontrollerQueryArrayOfEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfEnumHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -7038,19 +10322,19 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "QueryArrayOfEnum")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
-		var valuesRawPtr *[]Param27values.Myemamium = nil
+		var valuesRawPtr *[]Param28values.Myemamium = nil
 		valuesRawArray := req.URL.Query()["values"]
 		isvaluesExists := req.URL.Query().Has("values")
 		if isvaluesExists {
-			values := make([]Param27values.Myemamium, 0, len(valuesRawArray))
+			values := make([]Param28values.Myemamium, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
 				valuesItem := valuesRaw
-				values = append(values, Param27values.Myemamium(valuesItem))
+				values = append(values, Param28values.Myemamium(valuesItem))
 			}
 			valuesRawPtr = &values
-			valuesVar := []Param27values.Myemamium(values)
+			valuesVar := []Param28values.Myemamium(values)
 			valuesRawPtr = &valuesVar
 		}
 		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
@@ -7064,23 +10348,25 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var values2RawPtr *[]Param28values2.MyaliasString = nil
+		var values2RawPtr *[]Param29values2.MyaliasString = nil
 		values2RawArray := req.URL.Query()["values2"]
 		isvalues2Exists := req.URL.Query().Has("values2")
 		if isvalues2Exists {
-			values2 := make([]Param28values2.MyaliasString, 0, len(values2RawArray))
+			values2 := make([]Param29values2.MyaliasString, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
 				values2Item := values2Raw
-				values2 = append(values2, Param28values2.MyaliasString(values2Item))
+				values2 = append(values2, Param29values2.MyaliasString(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param28values2.MyaliasString(values2)
+			values2Var := []Param29values2.MyaliasString(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -7094,10 +10380,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -7115,7 +10403,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7133,6 +10421,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/QueryArrayOfEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -7153,8 +10442,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/query-array-of-others"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/query-array-of-enum")), e2EcontrollerQueryArrayOfEnumHandler).Methods("POST")
+	e2EcontrollerQueryArrayOfOthersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -7176,7 +10467,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "QueryArrayOfOthers")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var valuesRawPtr *[]int = nil
 		valuesRawArray := req.URL.Query()["values"]
@@ -7184,7 +10475,7 @@ func RegisterRoutes(engine *mux.Router) {
 		if isvaluesExists {
 			values := make([]int, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
-				valuesUint64, conversionErr := strconv.Atoi(valuesRaw)
+				valuesItem, conversionErr := parseIntegerParam[int](valuesRaw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7195,24 +10486,21 @@ func RegisterRoutes(engine *mux.Router) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]int",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values",
+						"query",
+						"/values",
+						"[]int",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
 					// params validation error response extension placeholder
 					w.WriteHeader(http.StatusUnprocessableEntity)
-					json.NewEncoder(w).Encode(validationError)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				valuesItem := int(valuesUint64)
 				values = append(values, int(valuesItem))
 			}
 			valuesRawPtr = &values
@@ -7229,19 +10517,21 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var values2RawPtr *[]Param29values2.MyaliasInt = nil
+		var values2RawPtr *[]Param30values2.MyaliasInt = nil
 		values2RawArray := req.URL.Query()["values2"]
 		isvalues2Exists := req.URL.Query().Has("values2")
 		if isvalues2Exists {
-			values2 := make([]Param29values2.MyaliasInt, 0, len(values2RawArray))
+			values2 := make([]Param30values2.MyaliasInt, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
-				values2Uint64, conversionErr := strconv.Atoi(values2Raw)
+				values2Item, conversionErr := parseIntegerParam[int](values2Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7252,28 +10542,25 @@ func RegisterRoutes(engine *mux.Router) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values2",
-							"[]MyaliasInt",
-							reflect.TypeOf(values2Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values2",
+						"query",
+						"/values2",
+						"[]MyaliasInt",
+						reflect.TypeOf(values2Raw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
 					// params validation error response extension placeholder
 					w.WriteHeader(http.StatusUnprocessableEntity)
-					json.NewEncoder(w).Encode(validationError)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				values2Item := int(values2Uint64)
-				values2 = append(values2, Param29values2.MyaliasInt(values2Item))
+				values2 = append(values2, Param30values2.MyaliasInt(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param29values2.MyaliasInt(values2)
+			values2Var := []Param30values2.MyaliasInt(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -7287,10 +10574,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var values3RawPtr *[]bool = nil
@@ -7299,7 +10588,7 @@ func RegisterRoutes(engine *mux.Router) {
 		if isvalues3Exists {
 			values3 := make([]bool, 0, len(values3RawArray))
 			for _, values3Raw := range values3RawArray {
-				values3Bool, conversionErr := strconv.ParseBool(values3Raw)
+				values3Item, conversionErr := strconv.ParseBool(values3Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7310,24 +10599,21 @@ func RegisterRoutes(engine *mux.Router) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values3",
-							"[]bool",
-							reflect.TypeOf(values3Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values3",
+						"query",
+						"/values3",
+						"[]bool",
+						reflect.TypeOf(values3Raw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
 					// params validation error response extension placeholder
 					w.WriteHeader(http.StatusUnprocessableEntity)
-					json.NewEncoder(w).Encode(validationError)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				values3Item := values3Bool
 				values3 = append(values3, bool(values3Item))
 			}
 			values3RawPtr = &values3
@@ -7344,10 +10630,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values3"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values3", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var values4RawPtr *[]int32 = nil
@@ -7356,7 +10644,7 @@ func RegisterRoutes(engine *mux.Router) {
 		if isvalues4Exists {
 			values4 := make([]int32, 0, len(values4RawArray))
 			for _, values4Raw := range values4RawArray {
-				values4Uint64, conversionErr := strconv.ParseInt(values4Raw, 10, 32)
+				values4Item, conversionErr := parseIntegerParam[int32](values4Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7367,24 +10655,21 @@ func RegisterRoutes(engine *mux.Router) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values4",
-							"[]int32",
-							reflect.TypeOf(values4Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values4",
+						"query",
+						"/values4",
+						"[]int32",
+						reflect.TypeOf(values4Raw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
 					// params validation error response extension placeholder
 					w.WriteHeader(http.StatusUnprocessableEntity)
-					json.NewEncoder(w).Encode(validationError)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				values4Item := int32(values4Uint64)
 				values4 = append(values4, int32(values4Item))
 			}
 			values4RawPtr = &values4
@@ -7401,10 +10686,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values4"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values4", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -7422,7 +10709,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7440,6 +10727,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/QueryArrayOfOthers",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -7460,8 +10748,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/query-array-of-others-enum"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/query-array-of-others")), e2EcontrollerQueryArrayOfOthersHandler).Methods("POST")
+	e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersEnumHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -7483,15 +10773,15 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "QueryArrayOfOthersEnum")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
-		var valuesRawPtr *[]Param31values.NumberEnum = nil
+		var valuesRawPtr *[]Param32values.NumberEnum = nil
 		valuesRawArray := req.URL.Query()["values"]
 		isvaluesExists := req.URL.Query().Has("values")
 		if isvaluesExists {
-			values := make([]Param31values.NumberEnum, 0, len(valuesRawArray))
+			values := make([]Param32values.NumberEnum, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
-				valuesUint64, conversionErr := strconv.ParseInt(valuesRaw, 10, 16)
+				valuesItem, conversionErr := parseIntegerParam[int16](valuesRaw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -7502,28 +10792,25 @@ func RegisterRoutes(engine *mux.Router) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthersEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]NumberEnum",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthersEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values",
+						"query",
+						"/values",
+						"[]NumberEnum",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
 					// params validation error response extension placeholder
 					w.WriteHeader(http.StatusUnprocessableEntity)
-					json.NewEncoder(w).Encode(validationError)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				valuesItem := int16(valuesUint64)
-				values = append(values, Param31values.NumberEnum(valuesItem))
+				values = append(values, Param32values.NumberEnum(valuesItem))
 			}
 			valuesRawPtr = &values
-			valuesVar := []Param31values.NumberEnum(values)
+			valuesVar := []Param32values.NumberEnum(values)
 			valuesRawPtr = &valuesVar
 		}
 		if validatorErr := validatorInstance.Var(valuesRawPtr, "required"); validatorErr != nil {
@@ -7537,23 +10824,25 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var values2RawPtr *[]Param32values2.BoolEnum = nil
+		var values2RawPtr *[]Param33values2.BoolEnum = nil
 		values2RawArray := req.URL.Query()["values2"]
 		isvalues2Exists := req.URL.Query().Has("values2")
 		if isvalues2Exists {
-			values2 := make([]Param32values2.BoolEnum, 0, len(values2RawArray))
+			values2 := make([]Param33values2.BoolEnum, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
 				values2Item := values2Raw
-				values2 = append(values2, Param32values2.BoolEnum(values2Item))
+				values2 = append(values2, Param33values2.BoolEnum(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param32values2.BoolEnum(values2)
+			values2Var := []Param33values2.BoolEnum(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -7567,10 +10856,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			// validation error response extension placeholder
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		// Middlewares beforeOperationMiddlewares section
@@ -7588,7 +10879,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7606,6 +10897,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/QueryArrayOfOthersEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -7626,8 +10918,10 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/query-pointer-to-array"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/query-array-of-others-enum")), e2EcontrollerQueryArrayOfOthersEnumHandler).Methods("POST")
+	e2EcontrollerQueryArrayOfPointersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfPointersHandler := func(w http.ResponseWriter, req *http.Request) {
 		// route start routes extension placeholder
 		authErr := authorize(
 			req,
@@ -7649,7 +10943,7 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "QueryArrayOfPointers")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
 		var values07RawPtr *[]string = nil
 		values07RawArray := req.URL.Query()["values07"]
@@ -7678,7 +10972,7 @@ func RegisterRoutes(engine *mux.Router) {
 			w.Header().Set(key, value)
 		}
 		// response headers extension placeholder
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7696,6 +10990,178 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/QueryArrayOfPointers",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/query-pointer-to-array")), e2EcontrollerQueryArrayOfPointersHandler).Methods("POST")
+	// E2ESharedRateLimitController
+	e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitFirstHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		if !enforceRateLimit(w, req, "SharedRateLimitFirst", e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit) {
+			return
+		}
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SharedRateLimitFirst")
+			return
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SharedRateLimitFirst()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitFirst'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitFirst",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+			// json error response extension placeholder
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		// json response extension placeholder
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		// after operation routes extension placeholder
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		// route end routes extension placeholder
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/shared-rate-limit/first")), e2EsharedRateLimitControllerSharedRateLimitFirstHandler).Methods("GET")
+	e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitSecondHandler := func(w http.ResponseWriter, req *http.Request) {
+		// route start routes extension placeholder
+		if !enforceRateLimit(w, req, "SharedRateLimitSecond", e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit) {
+			return
+		}
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SharedRateLimitSecond")
+			return
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(req))
+		controller.InitController(req)
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		// before operation routes extension placeholder
+		value, opError := controller.SharedRateLimitSecond()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		// response headers extension placeholder
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitSecond'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitSecond",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
 			// json error response extension placeholder
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -7716,5 +11182,6 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		// route end routes extension placeholder
-	}).Methods("POST")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/shared-rate-limit/second")), e2EsharedRateLimitControllerSharedRateLimitSecondHandler).Methods("GET")
 }
@@ -15,45 +15,65 @@ Repository: https://github.com/gopher-fleece/gleece
 */
 package routes
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"math"
+	"net"
 	"net/http"
 	"reflect"
 	"regexp"
+	"runtime/debug"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 	"github.com/go-playground/validator/v10"
-	RequestAuth "github.com/gopher-fleece/gleece/v2/e2e/mux/auth"
 	"github.com/gopher-fleece/runtime"
 	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	E2EClassSecController "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	E2EController "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param11value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param12value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param12value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param15data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param16data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param17data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	E2ESharedRateLimitController "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param14value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value1 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param15value3 "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param18data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param19data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param20data "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param22arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param23arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param24object "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param25num "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param26str "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param27values "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param28values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param21data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param22data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param23data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param24arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param25object "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param26num "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param27str "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param28values "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param29values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param31values "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param32values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param30values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param32values "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param33values2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param4value2 "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param5data "github.com/gopher-fleece/gleece/v2/e2e/assets"
 	Param5theBody "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Response6CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
-	Param13data "github.com/haimkastner/unitsnet-go/units"
-	Param14unit "github.com/haimkastner/unitsnet-go/units"
+	Param6principal "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8arrive "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param8data "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Response9CustomError "github.com/gopher-fleece/gleece/v2/e2e/assets"
+	Param16data "github.com/haimkastner/unitsnet-go/units"
+	Param17unit "github.com/haimkastner/unitsnet-go/units"
 	// ImportsExtension - test
 )
 var validatorInstance = validator.New()
@@ -66,6 +86,24 @@ type SecurityCheckList struct {
 	Checks   []runtime.SecurityCheck
 	Relation SecurityListRelation
 }
+// ValidationIssue describes a single failed validation of a request's input
+type ValidationIssue struct {
+	// The location of the offending input - body, query, path, header or form
+	In string `json:"in"`
+	// A JSON pointer to the offending value, relative to its location
+	Pointer string `json:"pointer"`
+	// The validation rule that failed
+	Rule string `json:"rule"`
+	// The failed rule's parameter, if any
+	Param string `json:"param,omitempty"`
+	// A human-readable description of the failure
+	Message string `json:"message"`
+}
+// ValidationProblem is an RFC7807 error detailing the failed validations of a request
+type ValidationProblem struct {
+	runtime.Rfc7807Error
+	Errors []ValidationIssue `json:"errors"`
+}
 // TypeDeclarationsExtension - test
 func getRequestContext(req *http.Request) context.Context {
 	return req.Context()
@@ -75,6 +113,56 @@ func setRequestContext(req *http.Request, ctx context.Context) {
 		*req = *req.WithContext(ctx)
 	}
 }
+type principalContextKey struct{}
+// WithPrincipal attaches the request's authenticated principal to the given context.
+//
+// Security handlers return the resulting context to make the principal available to @Principal parameters
+func WithPrincipal(ctx context.Context, principal any) context.Context {
+	return context.WithValue(ctx, principalContextKey{}, principal)
+}
+// getPrincipal retrieves the principal attached to the given context via WithPrincipal.
+// Yields nil if no principal of type TPrincipal (or a pointer to one) was attached
+func getPrincipal[TPrincipal any](ctx context.Context) *TPrincipal {
+	switch principal := ctx.Value(principalContextKey{}).(type) {
+	case *TPrincipal:
+		return principal
+	case TPrincipal:
+		return &principal
+	default:
+		return nil
+	}
+}
+// IdentifiablePrincipal may be implemented by principals to provide a stable identity, e.g. a user ID,
+// by which their idempotent requests and principal keyed rate limits are scoped
+type IdentifiablePrincipal interface {
+	IdempotencyIdentity() string
+}
+// describePrincipal returns a stable textual identity of the principal attached to the given context via WithPrincipal,
+// i.e., its IdempotencyIdentity or String method's result, if any, or its dereferenced value if of a primitive kind.
+// Yields an empty string if no principal was attached.
+//
+// Returns false for principals lacking a stable identity, e.g. structs, whose formatting may vary between requests
+func describePrincipal(ctx context.Context) (string, bool) {
+	principal := ctx.Value(principalContextKey{})
+	switch identifiable := principal.(type) {
+	case nil:
+		return "", true
+	case IdentifiablePrincipal:
+		return identifiable.IdempotencyIdentity(), true
+	case fmt.Stringer:
+		return identifiable.String(), true
+	}
+	value := reflect.Indirect(reflect.ValueOf(principal))
+	switch value.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return fmt.Sprintf("%v", value), true
+	default:
+		return "", false
+	}
+}
 func registerEnumValidation(validate *validator.Validate, validationName string, allowedValues []string) {
 	// Convert the array to a map for O(1) lookup
 	lookup := make(map[string]struct{})
@@ -88,12 +176,12 @@ func registerEnumValidation(validate *validator.Validate, validationName string,
 		return exists
 	})
 }
-func extractValidationErrorMessage(err error, fieldName *string) string {
+func extractValidationErrorMessage(err error, fieldName *string, languages []string) string {
 	if err == nil {
 		return ""
 	}
-	validationErrors, ok := err.(validator.ValidationErrors)
-	if !ok {
+	var validationErrors validator.ValidationErrors
+	if !errors.As(err, &validationErrors) {
 		return err.Error()
 	}
 	var errStr string
@@ -102,15 +190,26 @@ func extractValidationErrorMessage(err error, fieldName *string) string {
 		if fieldName != nil {
 			fName = *fieldName
 		}
-		errStr += fmt.Sprintf("Field '%s' failed validation with tag '%s'. ", fName, validationErr.Tag())
+		errStr += translateMessage(
+			languages,
+			map[string]string{"field": fName, "tag": validationErr.Tag(), "param": validationErr.Param()},
+			ValidationTagMessageKey(validationErr.Tag()),
+			MessageKeyFieldValidation,
+		) + ". "
 	}
 	return errStr
 }
-func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error) int {
+// getStatusCode returns the status code of an operation's response - the one set via the controller's SetStatus, if any,
+// that of the error mapping matching err among the operation's declared error status codes or a 500 for other errors,
+// or the success status code
+func getStatusCode(controller runtime.Controller, hasReturnValue bool, err error, routeErrorStatusCodes []int) int {
 	if controller.GetStatus() != nil {
 		return int(*controller.GetStatus())
 	}
 	if err != nil {
+		if mapping := getErrorMapping(err, routeErrorStatusCodes); mapping != nil {
+			return mapping.StatusCode
+		}
 		return http.StatusInternalServerError
 	}
 	if hasReturnValue {
@@ -123,7 +222,7 @@ func bindAndValidateBody[TOutput any](req *http.Request, contentType string, val
 	bodyBytes, err := io.ReadAll(req.Body)
 	if err != nil || len(bodyBytes) == 0 {
 		if strings.Contains(validation, "required") {
-			return fmt.Errorf("body is required but was not provided")
+			return errBodyRequired
 		}
 		return nil
 	}
@@ -158,12 +257,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Slice, reflect.Array:
 		// For slices/arrays, validate each element recursively
 		for i := 0; i < val.Len(); i++ {
-			elemPath := path
-			if path != "" {
-				elemPath = fmt.Sprintf("%s[%d]", path, i)
-			} else {
-				elemPath = fmt.Sprintf("[%d]", i)
-			}
+			elemPath := fmt.Sprintf("%s/%d", path, i)
 			// Get the element - handle case where element might be nil
 			elem := val.Index(i)
 			if elem.Kind() == reflect.Ptr && elem.IsNil() {
@@ -178,12 +272,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Map:
 		// For maps, validate each value recursively
 		for _, key := range val.MapKeys() {
-			elemPath := path
-			if path != "" {
-				elemPath = fmt.Sprintf("%s.%v", path, key.Interface())
-			} else {
-				elemPath = fmt.Sprintf("%v", key.Interface())
-			}
+			elemPath := fmt.Sprintf("%s/%s", path, escapeJsonPointerToken(fmt.Sprintf("%v", key.Interface())))
 			elemVal := val.MapIndex(key)
 			if elemVal.Kind() == reflect.Ptr && elemVal.IsNil() {
 				continue
@@ -196,10 +285,7 @@ func validateDataRecursive(data interface{}, path string) error {
 	case reflect.Struct:
 		// Validate structs with the validator
 		if err := validatorInstance.Struct(data); err != nil {
-			if path != "" {
-				return fmt.Errorf("validation error at %s: %w", path, err)
-			}
-			return err
+			return &structValidationError{pointer: path, structType: val.Type(), err: err}
 		}
 		return nil
 	default:
@@ -210,19 +296,33 @@ func validateDataRecursive(data interface{}, path string) error {
 func toMuxUrl(url string) string {
 	return url
 }
+// withBasePath prefixes the given route path with the given base path
+func withBasePath(basePath string, path string) string {
+	basePath = strings.TrimSuffix(basePath, "/")
+	if basePath != "" && !strings.HasPrefix(basePath, "/") {
+		basePath = "/" + basePath
+	}
+	return basePath + path
+}
+// authorize succeeds if all checks of any of the given lists pass.
+// Each list is an alternative (OR) of security schemas that must all be satisfied (AND), as expanded
+// from the route's @Security and @AdvancedSecurity annotations
 func authorize(req *http.Request, checksLists []SecurityCheckList) *runtime.SecurityError {
 	var lastError *runtime.SecurityError
 	for _, list := range checksLists {
 		if list.Relation != SecurityListRelationAnd {
-			panic(
-				"Encountered a security list relation of type '%s' - this is unexpected and indicates a bug in Gleece itself." +
+			panic(fmt.Sprintf(
+				"Encountered a security list relation of type '%s' - this is unexpected and indicates a bug in Gleece itself. "+
 					"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
-			)
+				list.Relation,
+			))
 		}
-		// Iterate over each security list
+		// Iterate over each security list.
+		// Checks may attach values (e.g. a principal) to the request context - these are discarded if the list fails
+		listCtx := getRequestContext(req)
 		encounteredErrorInList := false
 		for _, check := range list.Checks {
-			secCtx, secErr := RequestAuth.GleeceRequestAuthorization(getRequestContext(req), req, check)
+			secCtx, secErr := runSecurityHandler(getRequestContext(req), req, check)
 			setRequestContext(req, secCtx)
 			if secErr != nil {
 				lastError = secErr
@@ -235,6 +335,7 @@ func authorize(req *http.Request, checksLists []SecurityCheckList) *runtime.Secu
 		if !encounteredErrorInList {
 			return nil
 		}
+		setRequestContext(req, listCtx)
 	}
 	// If we got here it means authentication has failed
 	return lastError
@@ -256,20 +357,1180 @@ func handleAuthorizationError(w http.ResponseWriter, authErr *runtime.SecurityEr
 	w.WriteHeader(statusCode)
 	json.NewEncoder(w).Encode(stdError)
 }
-func wrapValidatorError(validatorErr error, operationId string, fieldName string) runtime.Rfc7807Error {
+func wrapValidatorError(validatorErr error, operationId string, fieldName string, languages []string) runtime.Rfc7807Error {
 	return runtime.Rfc7807Error{
 		Type: http.StatusText(http.StatusUnprocessableEntity),
-		Detail: fmt.Sprintf(
-			"A request was made to operation '%s' but parameter '%s' did not pass validation - %s",
-			operationId,
-			fieldName,
-			extractValidationErrorMessage(validatorErr, &fieldName),
+		Detail: translateMessage(
+			languages,
+			map[string]string{
+				"operation": operationId,
+				"parameter": fieldName,
+				"details":   extractValidationErrorMessage(validatorErr, &fieldName, languages),
+			},
+			MessageKeyParamValidation,
 		),
 		Status:   http.StatusUnprocessableEntity,
 		Instance: fmt.Sprintf("/validation/error/%s", operationId),
 	}
 }
+// paramInteger is the set of signed integer types a request parameter may be converted to
+type paramInteger interface {
+	int | int8 | int16 | int32 | int64
+}
+// paramUnsigned is the set of unsigned integer types a request parameter may be converted to
+type paramUnsigned interface {
+	uint | uint8 | uint16 | uint32 | uint64
+}
+// paramFloat is the set of floating point types a request parameter may be converted to
+type paramFloat interface {
+	float32 | float64
+}
+// parseIntegerParam converts the raw value of a request parameter to the given signed integer type
+func parseIntegerParam[T paramInteger](raw string) (T, error) {
+	parsed, err := strconv.ParseInt(raw, 10, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// parseUnsignedParam converts the raw value of a request parameter to the given unsigned integer type
+func parseUnsignedParam[T paramUnsigned](raw string) (T, error) {
+	parsed, err := strconv.ParseUint(raw, 10, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// parseFloatParam converts the raw value of a request parameter to the given floating point type
+func parseFloatParam[T paramFloat](raw string) (T, error) {
+	parsed, err := strconv.ParseFloat(raw, reflect.TypeFor[T]().Bits())
+	return T(parsed), err
+}
+// wrapConversionError creates the RFC7807 error and validation issues describing a request parameter
+// whose raw value could not be converted to the parameter's type
+func wrapConversionError(
+	conversionErr error,
+	operationId string,
+	fieldName string,
+	in string,
+	pointer string,
+	expectedType string,
+	actualType string,
+	languages []string,
+) (runtime.Rfc7807Error, []ValidationIssue) {
+	validationError := runtime.Rfc7807Error{
+		Type: http.StatusText(http.StatusUnprocessableEntity),
+		Detail: translateMessage(
+			languages,
+			map[string]string{
+				"operation": operationId,
+				"parameter": fieldName,
+				"expected":  expectedType,
+				"actual":    actualType,
+			},
+			MessageKeyParamConversion,
+		),
+		Status:     http.StatusUnprocessableEntity,
+		Instance:   fmt.Sprintf("/validation/error/%s", operationId),
+		Extensions: map[string]string{"error": conversionErr.Error()},
+	}
+	validationIssues := []ValidationIssue{
+		{
+			In:      in,
+			Pointer: pointer,
+			Rule:    "type",
+			Param:   expectedType,
+			Message: conversionErr.Error(),
+		},
+	}
+	return validationError, validationIssues
+}
+var errBodyRequired = errors.New("body is required but was not provided")
+// structValidationError associates a struct's validation errors with the struct's location within the validated payload
+type structValidationError struct {
+	pointer    string
+	structType reflect.Type
+	err        error
+}
+func (e *structValidationError) Error() string {
+	if e.pointer == "" {
+		return e.err.Error()
+	}
+	return fmt.Sprintf("validation error at %s: %s", e.pointer, e.err.Error())
+}
+func (e *structValidationError) Unwrap() error {
+	return e.err
+}
+func escapeJsonPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+// getJsonFieldName returns the name of the given field within its struct's JSON representation.
+//
+// Embedded structs without an explicit name are flattened by encoding/json and therefore yield an empty name
+func getJsonFieldName(field reflect.StructField) string {
+	if name := strings.Split(field.Tag.Get("json"), ",")[0]; name != "" {
+		return name
+	}
+	fieldType := field.Type
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+	if field.Anonymous && fieldType.Kind() == reflect.Struct {
+		return ""
+	}
+	return field.Name
+}
+// toJsonPointer converts a validator struct namespace (e.g. 'Model.Items[0].Name') to a JSON pointer (e.g. '/items/0/name')
+// using the json tags of the root type's fields
+func toJsonPointer(rootType reflect.Type, structNamespace string) string {
+	pointer := ""
+	currentType := rootType
+	segments := strings.Split(structNamespace, ".")
+	for _, segment := range segments[1:] {
+		name, indices := segment, []string{}
+		if bracket := strings.Index(segment, "["); bracket >= 0 {
+			name = segment[:bracket]
+			indices = strings.Split(strings.TrimSuffix(segment[bracket+1:], "]"), "][")
+		}
+		for currentType != nil && currentType.Kind() == reflect.Ptr {
+			currentType = currentType.Elem()
+		}
+		token := name
+		if currentType != nil && currentType.Kind() == reflect.Struct {
+			if field, found := currentType.FieldByName(name); found {
+				currentType = field.Type
+				token = getJsonFieldName(field)
+			} else {
+				currentType = nil
+			}
+		}
+		if token != "" {
+			pointer += "/" + escapeJsonPointerToken(token)
+		}
+		for _, index := range indices {
+			pointer += "/" + escapeJsonPointerToken(index)
+			for currentType != nil && currentType.Kind() == reflect.Ptr {
+				currentType = currentType.Elem()
+			}
+			if currentType != nil && (currentType.Kind() == reflect.Slice || currentType.Kind() == reflect.Array || currentType.Kind() == reflect.Map) {
+				currentType = currentType.Elem()
+			} else {
+				currentType = nil
+			}
+		}
+	}
+	return pointer
+}
+func getValidationIssueMessage(pointer string, tag string, param string, languages []string) string {
+	args := map[string]string{"tag": tag, "param": param}
+	if pointer == "" {
+		return translateMessage(languages, args, ValidationTagMessageKey(tag), MessageKeyValueValidation)
+	}
+	args["field"] = pointer[strings.LastIndex(pointer, "/")+1:]
+	return translateMessage(languages, args, ValidationTagMessageKey(tag), MessageKeyFieldValidation)
+}
+// getValidationIssues converts the given validation or deserialization error to a list of issues at the given location.
+//
+// The pointer is the JSON pointer of the validated value, relative to its location
+func getValidationIssues(err error, in string, pointer string, languages []string) []ValidationIssue {
+	var structType reflect.Type
+	var structErr *structValidationError
+	if errors.As(err, &structErr) {
+		pointer += structErr.pointer
+		structType = structErr.structType
+	}
+	var validationErrors validator.ValidationErrors
+	if errors.As(err, &validationErrors) {
+		issues := make([]ValidationIssue, 0, len(validationErrors))
+		for _, validationErr := range validationErrors {
+			issuePointer := pointer
+			if structType != nil {
+				issuePointer += toJsonPointer(structType, validationErr.StructNamespace())
+			}
+			issues = append(issues, ValidationIssue{
+				In:      in,
+				Pointer: issuePointer,
+				Rule:    validationErr.Tag(),
+				Param:   validationErr.Param(),
+				Message: getValidationIssueMessage(issuePointer, validationErr.Tag(), validationErr.Param(), languages),
+			})
+		}
+		return issues
+	}
+	issue := ValidationIssue{In: in, Pointer: pointer, Rule: "parse", Message: err.Error()}
+	var typeErr *json.UnmarshalTypeError
+	if errors.Is(err, errBodyRequired) {
+		issue.Rule = "required"
+	} else if errors.As(err, &typeErr) {
+		if typeErr.Field != "" {
+			issue.Pointer += "/" + strings.ReplaceAll(typeErr.Field, ".", "/")
+		}
+		issue.Rule = "type"
+		issue.Param = typeErr.Type.String()
+	}
+	return []ValidationIssue{issue}
+}
+// Keys of the translatable validation messages.
+//
+// Message templates use named placeholders, such as '{field}', which are replaced with the message's arguments
+const (
+	// Describes a parameter that did not pass validation. Placeholders: {operation}, {parameter}, {details}
+	MessageKeyParamValidation = "paramValidation"
+	// Describes a body that did not pass validation. Placeholders: {operation}, {parameter}, {type}, {details}
+	MessageKeyBodyValidation = "bodyValidation"
+	// Describes a parameter that could not be converted to its declared type. Placeholders: {operation}, {parameter}, {expected}, {actual}
+	MessageKeyParamConversion = "paramConversion"
+	// Describes a field that failed a validation tag with no dedicated message. Placeholders: {field}, {tag}, {param}
+	MessageKeyFieldValidation = "fieldValidation"
+	// Describes a value that failed a validation tag with no dedicated message. Placeholders: {tag}, {param}
+	MessageKeyValueValidation = "valueValidation"
+)
+// The language of the default message catalogue
+const defaultMessageLanguage = "en"
+// The default English message catalogue, used whenever no translation is available
+var defaultMessages = map[string]string{
+	MessageKeyParamValidation: "A request was made to operation '{operation}' but parameter '{parameter}' did not pass validation - {details}",
+	MessageKeyBodyValidation:  "A request was made to operation '{operation}' but body parameter '{parameter}' did not pass validation of '{type}' - {details}",
+	MessageKeyParamConversion: "A request was made to operation '{operation}' but parameter '{parameter}' was not properly sent - Expected {expected} but got {actual}",
+	MessageKeyFieldValidation: "Field '{field}' failed validation with tag '{tag}'",
+	MessageKeyValueValidation: "Value failed validation with tag '{tag}'",
+}
+// MessageTranslator provides validation message templates in the languages requested via the Accept-Language header
+type MessageTranslator interface {
+	// Translate returns the message template registered for the given language and key, if any
+	Translate(language string, key string) (string, bool)
+}
+// MessageCatalogue is a MessageTranslator backed by an in-memory map of language to message key to template.
+//
+// Languages are matched case-insensitively
+type MessageCatalogue map[string]map[string]string
+func (catalogue MessageCatalogue) Translate(language string, key string) (string, bool) {
+	template, found := catalogue[strings.ToLower(language)][key]
+	return template, found
+}
+var messageCatalogue = MessageCatalogue{}
+var messageTranslator MessageTranslator = messageCatalogue
+// SetMessageTranslator replaces the built-in message catalogue with the given translator,
+// e.g. an adapter over go-playground's universal translator.
+//
+// Messages the translator does not provide fall back to the default English catalogue
+func SetMessageTranslator(translator MessageTranslator) {
+	if translator == nil {
+		translator = messageCatalogue
+	}
+	messageTranslator = translator
+}
+// RegisterTranslation registers the template of the given message key for the given language in the built-in catalogue.
+// Has no effect on translators set via SetMessageTranslator
+func RegisterTranslation(language string, key string, template string) {
+	language = strings.ToLower(language)
+	if messageCatalogue[language] == nil {
+		messageCatalogue[language] = map[string]string{}
+	}
+	messageCatalogue[language][key] = template
+}
+// ValidationTagMessageKey returns the message key of the given validation tag, e.g. 'required'.
+//
+// Templates registered under this key take precedence over the generic field and value messages.
+// Placeholders: {field}, {tag}, {param}
+func ValidationTagMessageKey(tag string) string {
+	return "tag." + tag
+}
+// RegisterValidationTagTranslation registers the message used when the given validation tag fails, for the given language
+func RegisterValidationTagTranslation(language string, tag string, template string) {
+	RegisterTranslation(language, ValidationTagMessageKey(tag), template)
+}
+// getAcceptedLanguages returns the languages listed in the given Accept-Language header, most preferred first.
+// Regional variants are followed by their base language, e.g. 'fr-CA' by 'fr'
+func getAcceptedLanguages(header string) []string {
+	type weightedLanguage struct {
+		tag    string
+		weight float64
+	}
+	weightedLanguages := []weightedLanguage{}
+	for _, entry := range strings.Split(header, ",") {
+		parts := strings.Split(entry, ";")
+		tag := strings.TrimSpace(parts[0])
+		if tag == "" || tag == "*" {
+			continue
+		}
+		weight := 1.0
+		for _, param := range parts[1:] {
+			if value, found := strings.CutPrefix(strings.TrimSpace(param), "q="); found {
+				if parsed, err := strconv.ParseFloat(value, 64); err == nil {
+					weight = parsed
+				}
+			}
+		}
+		if weight > 0 {
+			weightedLanguages = append(weightedLanguages, weightedLanguage{tag: tag, weight: weight})
+		}
+	}
+	slices.SortStableFunc(weightedLanguages, func(a, b weightedLanguage) int {
+		if a.weight > b.weight {
+			return -1
+		}
+		if a.weight < b.weight {
+			return 1
+		}
+		return 0
+	})
+	languages := make([]string, 0, len(weightedLanguages))
+	for _, language := range weightedLanguages {
+		languages = append(languages, language.tag)
+		if base, _, hasRegion := strings.Cut(language.tag, "-"); hasRegion {
+			languages = append(languages, base)
+		}
+	}
+	return languages
+}
+// getMessageTemplate returns the template of the first of the given keys available in the most preferred of the given languages,
+// falling back to English and finally to the default English catalogue
+func getMessageTemplate(languages []string, keys ...string) string {
+	for _, language := range append(slices.Clip(languages), defaultMessageLanguage) {
+		for _, key := range keys {
+			if template, found := messageTranslator.Translate(language, key); found {
+				return template
+			}
+		}
+	}
+	for _, key := range keys {
+		if template, found := defaultMessages[key]; found {
+			return template
+		}
+	}
+	return ""
+}
+// translateMessage formats the template of the given keys in the given languages, replacing its placeholders with the given arguments
+func translateMessage(languages []string, args map[string]string, keys ...string) string {
+	replacements := make([]string, 0, len(args)*2)
+	for name, value := range args {
+		replacements = append(replacements, "{"+name+"}", value)
+	}
+	return strings.NewReplacer(replacements...).Replace(getMessageTemplate(languages, keys...))
+}
+// ControllerFactory creates the controller instance handling a single request.
+//
+// The given context is the request's context
+type ControllerFactory[TController any] func(ctx context.Context) *TController
+var controllerFactories = map[reflect.Type]func(ctx context.Context) any{}
+// RegisterControllerFactory registers the factory used to create a controller for each request it handles.
+//
+// Factories allow controllers to hold injected dependencies such as database handles, services or loggers.
+// Must be called before RegisterRoutes; the factory must not return nil
+func RegisterControllerFactory[TController any](factory ControllerFactory[TController]) {
+	controllerFactories[reflect.TypeOf((*TController)(nil)).Elem()] = func(ctx context.Context) any {
+		return factory(ctx)
+	}
+}
+// createController creates a controller using its registered factory or, if none exists, its zero value
+func createController[TController any](ctx context.Context) *TController {
+	if factory, exists := controllerFactories[reflect.TypeOf((*TController)(nil)).Elem()]; exists {
+		return factory(ctx).(*TController)
+	}
+	return new(TController)
+}
+// ensureControllerFactoriesRegistered panics if any of the given controller types has no registered factory
+func ensureControllerFactoriesRegistered(controllerTypes []reflect.Type) {
+	missing := []string{}
+	for _, controllerType := range controllerTypes {
+		if _, registered := controllerFactories[controllerType]; !registered {
+			missing = append(missing, controllerType.String())
+		}
+	}
+	if len(missing) > 0 {
+		panic(fmt.Sprintf(
+			"controller factories are required but were never registered using RegisterControllerFactory: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
+// ErrorMapping describes the response returned when a controller's error matches a registered mapping
+type ErrorMapping struct {
+	// The HTTP status code to respond with. Must be declared by at least one @ErrorResponse annotation.
+	// The mapping applies only to operations declaring the status code - errors of other operations remain 500s
+	StatusCode int
+	// The RFC7807 title. Defaults to the status code's text
+	Title string
+	// A client-safe description of the error. Defaults to a generic, operation-specific message
+	Detail string
+}
+type registeredErrorMapping struct {
+	matches func(err error) bool
+	mapping ErrorMapping
+}
+var errorMappings = []registeredErrorMapping{}
+var declaredErrorStatusCodes = []int{404, 409, 500}
+// RegisterErrorMapping maps errors matching the given target, via errors.Is, to the given HTTP status code.
+//
+// Mappings are evaluated in order of registration and are superseded by an explicit call to the controller's SetStatus
+func RegisterErrorMapping(target error, statusCode int) {
+	RegisterDetailedErrorMapping(target, ErrorMapping{StatusCode: statusCode})
+}
+// RegisterDetailedErrorMapping maps errors matching the given target, via errors.Is, to the given response
+func RegisterDetailedErrorMapping(target error, mapping ErrorMapping) {
+	addErrorMapping(func(err error) bool { return errors.Is(err, target) }, mapping)
+}
+// RegisterErrorTypeMapping maps errors of type TError, matched via errors.As, to the given response
+func RegisterErrorTypeMapping[TError error](mapping ErrorMapping) {
+	addErrorMapping(func(err error) bool {
+		var target TError
+		return errors.As(err, &target)
+	}, mapping)
+}
+func addErrorMapping(matches func(err error) bool, mapping ErrorMapping) {
+	if !slices.Contains(declaredErrorStatusCodes, mapping.StatusCode) {
+		panic(fmt.Sprintf("Error mapping status code %d is not declared by any @ErrorResponse annotation", mapping.StatusCode))
+	}
+	errorMappings = append(errorMappings, registeredErrorMapping{matches: matches, mapping: mapping})
+}
+// getErrorMapping returns the first registered mapping matching err whose status code is among the given ones, declared by
+// the operation that produced err, or nil if there is none
+func getErrorMapping(err error, routeErrorStatusCodes []int) *ErrorMapping {
+	for _, registered := range errorMappings {
+		if slices.Contains(routeErrorStatusCodes, registered.mapping.StatusCode) && registered.matches(err) {
+			return &registered.mapping
+		}
+	}
+	return nil
+}
+// applyErrorMapping overrides the given RFC7807 error's title and detail with those of the mapping matching err, if any
+func applyErrorMapping(stdError *runtime.Rfc7807Error, err error, routeErrorStatusCodes []int) {
+	mapping := getErrorMapping(err, routeErrorStatusCodes)
+	if mapping == nil {
+		return
+	}
+	stdError.Title = http.StatusText(stdError.Status)
+	if mapping.Title != "" {
+		stdError.Title = mapping.Title
+	}
+	if mapping.Detail != "" {
+		stdError.Detail = mapping.Detail
+	}
+}
 // FunctionDeclarationsExtension - test
+// The instrumentation scope of the spans and metrics reported by the API's routes
+const telemetryInstrumentationName = "github.com/gopher-fleece/gleece"
+var operationTracer = otel.GetTracerProvider().Tracer(telemetryInstrumentationName)
+var requestMetrics = newRequestInstruments(otel.GetMeterProvider())
+// requestInstruments holds the metric instruments recorded for each request
+type requestInstruments struct {
+	duration metric.Float64Histogram
+	count    metric.Int64Counter
+}
+func newRequestInstruments(provider metric.MeterProvider) requestInstruments {
+	meter := provider.Meter(telemetryInstrumentationName)
+	// Instrument creation only fails for malformed names or units, in which case a no-op instrument is yielded
+	duration, _ := meter.Float64Histogram(
+		"http.server.request.duration",
+		metric.WithUnit("s"),
+		metric.WithDescription("Duration of HTTP server requests"),
+	)
+	count, _ := meter.Int64Counter(
+		"http.server.request.count",
+		metric.WithUnit("{request}"),
+		metric.WithDescription("Number of HTTP server requests"),
+	)
+	return requestInstruments{duration: duration, count: count}
+}
+// RegisterTracerProvider sets the provider used to create the operations' spans.
+// Defaults to the global tracer provider
+func RegisterTracerProvider(provider trace.TracerProvider) {
+	operationTracer = provider.Tracer(telemetryInstrumentationName)
+}
+// RegisterMeterProvider sets the provider used to record the requests' metrics.
+// Defaults to the global meter provider
+func RegisterMeterProvider(provider metric.MeterProvider) {
+	requestMetrics = newRequestInstruments(provider)
+}
+// operationTelemetry traces and measures a single operation's execution
+type operationTelemetry struct {
+	// The request's context, carrying the operation's span
+	ctx        context.Context
+	span       trace.Span
+	phaseSpan  trace.Span
+	startTime  time.Time
+	attributes []attribute.KeyValue
+}
+// startOperationTelemetry starts the given operation's span.
+// The span is a child of the span propagated via the request's headers, if any
+func startOperationTelemetry(
+	ctx context.Context,
+	headers propagation.TextMapCarrier,
+	operationId string,
+	controllerName string,
+	httpVerb string,
+	routeTemplate string,
+) *operationTelemetry {
+	attributes := []attribute.KeyValue{
+		attribute.String("http.request.method", httpVerb),
+		attribute.String("http.route", routeTemplate),
+		attribute.String("gleece.controller", controllerName),
+		attribute.String("gleece.operation", operationId),
+	}
+	ctx = otel.GetTextMapPropagator().Extract(ctx, headers)
+	ctx, span := operationTracer.Start(
+		ctx,
+		operationId,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attributes...),
+	)
+	return &operationTelemetry{
+		ctx:        ctx,
+		span:       span,
+		startTime:  time.Now(),
+		attributes: attributes,
+	}
+}
+// startPhase ends the current phase's span, if any, and starts a child span for the given phase, e.g. 'authorization'
+func (telemetry *operationTelemetry) startPhase(phase string) {
+	telemetry.endPhase()
+	_, telemetry.phaseSpan = operationTracer.Start(telemetry.ctx, phase)
+}
+// endPhase ends the current phase's span, if any
+func (telemetry *operationTelemetry) endPhase() {
+	if telemetry.phaseSpan != nil {
+		telemetry.phaseSpan.End()
+		telemetry.phaseSpan = nil
+	}
+}
+// end ends the operation's span and records the request's metrics using the given response status code
+func (telemetry *operationTelemetry) end(statusCode int) {
+	telemetry.endPhase()
+	statusAttribute := attribute.Int("http.response.status_code", statusCode)
+	telemetry.span.SetAttributes(statusAttribute)
+	if statusCode >= http.StatusInternalServerError {
+		telemetry.span.SetStatus(codes.Error, http.StatusText(statusCode))
+	}
+	telemetry.span.End()
+	measurementAttributes := metric.WithAttributes(append(telemetry.attributes, statusAttribute)...)
+	requestMetrics.duration.Record(telemetry.ctx, time.Since(telemetry.startTime).Seconds(), measurementAttributes)
+	requestMetrics.count.Add(telemetry.ctx, 1, measurementAttributes)
+}
+// statusRecordingResponseWriter records the status code written to the underlying response writer
+type statusRecordingResponseWriter struct {
+	http.ResponseWriter
+	statusCode int
+}
+func newStatusRecordingResponseWriter(w http.ResponseWriter) *statusRecordingResponseWriter {
+	return &statusRecordingResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
+}
+func (w *statusRecordingResponseWriter) WriteHeader(statusCode int) {
+	w.statusCode = statusCode
+	w.ResponseWriter.WriteHeader(statusCode)
+}
+// Unwrap exposes the underlying response writer to http.ResponseController
+func (w *statusRecordingResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+// PanicError is the error passed to the error middlewares when an operation panics
+type PanicError struct {
+	// The value the operation panicked with
+	Value any
+	// The stack trace of the panicking goroutine, captured upon recovery
+	Stack []byte
+}
+func (err *PanicError) Error() string {
+	return fmt.Sprintf("operation panicked - %v", err.Value)
+}
+// PanicReporter is invoked with every panic recovered from an operation, e.g. to report it to an error tracking service
+type PanicReporter func(ctx context.Context, operationId string, panicErr *PanicError)
+var panicReporter PanicReporter
+// RegisterPanicReporter sets the hook invoked with every panic recovered from an operation.
+//
+// The reporter runs before the error middlewares
+func RegisterPanicReporter(reporter PanicReporter) {
+	panicReporter = reporter
+}
+// handleOperationPanic reports the given recovered value and runs the error middlewares.
+// Unless a middleware stops the chain, responds with a 500 RFC7807 error
+func handleOperationPanic(w http.ResponseWriter, req *http.Request, recovered any, operationId string) {
+	panicErr := &PanicError{Value: recovered, Stack: debug.Stack()}
+	if panicReporter != nil {
+		panicReporter(getRequestContext(req), operationId, panicErr)
+	}
+	for _, middleware := range onErrorMiddlewares {
+		middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, panicErr)
+		setRequestContext(req, middlewareCtx)
+		if !continueOperation {
+			return
+		}
+	}
+	stdError := runtime.Rfc7807Error{
+		Type:       http.StatusText(http.StatusInternalServerError),
+		Detail:     fmt.Sprintf("Encountered an unexpected error during operation '%s'", operationId),
+		Status:     http.StatusInternalServerError,
+		Instance:   "/controller/error/" + operationId,
+		Extensions: map[string]string{"error": panicErr.Error()},
+	}
+	w.WriteHeader(http.StatusInternalServerError)
+	json.NewEncoder(w).Encode(stdError)
+}
+// SecurityCredentials holds the credentials extracted from a request for a single security scheme,
+// according to the scheme's configured type and location
+type SecurityCredentials struct {
+	// Whether credentials were found at the scheme's expected location
+	Present bool
+	// The API key read from the configured header, query parameter or cookie of an 'apiKey' scheme
+	ApiKey string
+	// The credentials of the 'Authorization' header, without the scheme prefix, for 'http' schemes other than 'basic'.
+	// For 'oauth2' and 'openIdConnect' schemes, this is the bearer token
+	Token string
+	// The user name of a 'basic' HTTP scheme
+	Username string
+	// The password of a 'basic' HTTP scheme
+	Password string
+}
+// SecurityHandler authorizes a request for a single security scheme, using the credentials extracted from the request
+type SecurityHandler func(
+	ctx context.Context,
+	req *http.Request,
+	check runtime.SecurityCheck,
+	credentials SecurityCredentials,
+) (context.Context, *runtime.SecurityError)
+var securityHandlers = map[string]SecurityHandler{}
+// RegisterSecurityHandler registers the handler authorizing requests for the given security scheme.
+//
+// A handler must be registered for every configured security scheme before RegisterRoutes is called
+func RegisterSecurityHandler(schemaName string, handler SecurityHandler) {
+	securityHandlers[schemaName] = handler
+}
+// securitySchemeDescriptor describes where a security scheme's credentials are located
+type securitySchemeDescriptor struct {
+	schemeType string
+	in         string
+	fieldName  string
+	httpScheme string
+}
+var securitySchemes = map[string]securitySchemeDescriptor{
+	"securitySchemaName":  {schemeType: "apiKey", in: "header", fieldName: "x-header-name", httpScheme: ""},
+	"securitySchemaName2": {schemeType: "apiKey", in: "header", fieldName: "x-header-name", httpScheme: ""},
+	"securitySchemaName3": {schemeType: "http", in: "", fieldName: "", httpScheme: "bearer"},
+}
+// ensureSecurityHandlersRegistered panics if any of the configured security schemes has no registered handler
+func ensureSecurityHandlersRegistered() {
+	missing := []string{}
+	for schemaName := range securitySchemes {
+		if _, registered := securityHandlers[schemaName]; !registered {
+			missing = append(missing, schemaName)
+		}
+	}
+	if len(missing) > 0 {
+		slices.Sort(missing)
+		panic(fmt.Sprintf(
+			"security schemes were never given a handler using RegisterSecurityHandler: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
+// runSecurityHandler invokes the given check's security handler with the credentials extracted for its scheme
+func runSecurityHandler(ctx context.Context, req *http.Request, check runtime.SecurityCheck) (context.Context, *runtime.SecurityError) {
+	handler, registered := securityHandlers[check.SchemaName]
+	if !registered {
+		return ctx, &runtime.SecurityError{
+			Message:    fmt.Sprintf("No security handler is registered for security scheme '%s'", check.SchemaName),
+			StatusCode: http.StatusInternalServerError,
+		}
+	}
+	return handler(ctx, req, check, extractSecurityCredentials(req, securitySchemes[check.SchemaName]))
+}
+// extractSecurityCredentials reads the credentials of the given security scheme from the request
+func extractSecurityCredentials(req *http.Request, scheme securitySchemeDescriptor) SecurityCredentials {
+	switch scheme.schemeType {
+	case "apiKey":
+		var apiKey string
+		switch scheme.in {
+		case "header":
+			apiKey = req.Header.Get(scheme.fieldName)
+		case "query":
+			apiKey = req.URL.Query().Get(scheme.fieldName)
+		case "cookie":
+			if cookie, err := req.Cookie(scheme.fieldName); err == nil {
+				apiKey = cookie.Value
+			}
+		}
+		return SecurityCredentials{Present: apiKey != "", ApiKey: apiKey}
+	case "http":
+		return parseAuthorizationHeader(req.Header.Get("Authorization"), scheme.httpScheme)
+	case "oauth2", "openIdConnect":
+		return parseAuthorizationHeader(req.Header.Get("Authorization"), "bearer")
+	default:
+		return SecurityCredentials{}
+	}
+}
+// parseAuthorizationHeader extracts the credentials of the given HTTP authentication scheme from an 'Authorization' header
+func parseAuthorizationHeader(header string, httpScheme string) SecurityCredentials {
+	prefix, credentials, found := strings.Cut(strings.TrimSpace(header), " ")
+	credentials = strings.TrimSpace(credentials)
+	if !found || credentials == "" || !strings.EqualFold(prefix, httpScheme) {
+		return SecurityCredentials{}
+	}
+	if !strings.EqualFold(httpScheme, "basic") {
+		return SecurityCredentials{Present: true, Token: credentials}
+	}
+	decoded, err := base64.StdEncoding.DecodeString(credentials)
+	if err != nil {
+		return SecurityCredentials{}
+	}
+	username, password, found := strings.Cut(string(decoded), ":")
+	if !found {
+		return SecurityCredentials{}
+	}
+	return SecurityCredentials{Present: true, Username: username, Password: password}
+}
+// DefaultApiVersion is the API version served to requests that do not specify one
+const DefaultApiVersion = 2
+// registerVersionedRoute registers the given handler under the '/v{version}' prefixed path of each of the given API versions
+func registerVersionedRoute(engine *mux.Router, basePath string, method string, path string, versions []int, handler http.HandlerFunc) {
+	for _, version := range versions {
+		engine.HandleFunc(toMuxUrl(withBasePath(basePath, fmt.Sprintf("/v%d%s", version, path))), handler).Methods(method)
+	}
+}
+// RateLimit describes the number of requests an operation accepts per time window, as declared via @RateLimit
+type RateLimit struct {
+	// The number of requests allowed per window
+	Requests int
+	// The window's duration
+	Per time.Duration
+	// The key by which requests are counted - 'ip', 'principal' or 'header:<Name>'
+	Key string
+	// The scope within which requests are counted - the declaring controller's name for limits inherited from a controller,
+	// which are shared by all of its operations inheriting them, or the operation's ID for limits declared by the operation
+	Scope string
+}
+// RateLimiter decides whether requests may proceed under their operation's rate limit.
+//
+// Implementations must be safe for concurrent use
+type RateLimiter interface {
+	// Allow consumes a single request of the given key against the given operation's limit, counted within the limit's scope.
+	// Returns whether the request may proceed and, if not, how long until it may be retried
+	Allow(ctx context.Context, operationId string, key string, limit RateLimit) (bool, time.Duration)
+}
+// InMemoryRateLimiter is a RateLimiter holding a token bucket per limit scope and key in the process's memory.
+//
+// Each bucket holds up to the limit's number of requests and is refilled evenly over the limit's window.
+// Limits are not shared across instances - deployments with multiple instances should use a shared RateLimiter instead
+type InMemoryRateLimiter struct {
+	mutex     sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+type tokenBucket struct {
+	tokens          float64
+	capacity        float64
+	refillPerSecond float64
+	lastRefill      time.Time
+}
+// The interval at which an InMemoryRateLimiter drops buckets that have been refilled to capacity
+const rateLimitSweepInterval = time.Minute
+// NewInMemoryRateLimiter creates an InMemoryRateLimiter with no buckets
+func NewInMemoryRateLimiter() *InMemoryRateLimiter {
+	return &InMemoryRateLimiter{buckets: map[string]*tokenBucket{}, lastSweep: time.Now()}
+}
+func (limiter *InMemoryRateLimiter) Allow(_ context.Context, _ string, key string, limit RateLimit) (bool, time.Duration) {
+	limiter.mutex.Lock()
+	defer limiter.mutex.Unlock()
+	now := time.Now()
+	limiter.sweep(now)
+	bucketKey := limit.Scope + "\x00" + key
+	bucket, exists := limiter.buckets[bucketKey]
+	if !exists {
+		capacity := float64(limit.Requests)
+		bucket = &tokenBucket{
+			tokens:          capacity,
+			capacity:        capacity,
+			refillPerSecond: capacity / limit.Per.Seconds(),
+			lastRefill:      now,
+		}
+		limiter.buckets[bucketKey] = bucket
+	}
+	bucket.tokens = bucket.tokensAt(now)
+	bucket.lastRefill = now
+	if bucket.tokens >= 1 {
+		bucket.tokens--
+		return true, 0
+	}
+	return false, time.Duration((1 - bucket.tokens) / bucket.refillPerSecond * float64(time.Second))
+}
+// sweep drops the buckets that have been refilled to capacity, as those are indistinguishable from new ones
+func (limiter *InMemoryRateLimiter) sweep(now time.Time) {
+	if now.Sub(limiter.lastSweep) < rateLimitSweepInterval {
+		return
+	}
+	for bucketKey, bucket := range limiter.buckets {
+		if bucket.tokensAt(now) >= bucket.capacity {
+			delete(limiter.buckets, bucketKey)
+		}
+	}
+	limiter.lastSweep = now
+}
+// tokensAt returns the number of tokens the bucket holds at the given time
+func (bucket *tokenBucket) tokensAt(now time.Time) float64 {
+	return math.Min(bucket.capacity, bucket.tokens+now.Sub(bucket.lastRefill).Seconds()*bucket.refillPerSecond)
+}
+var rateLimiter RateLimiter = NewInMemoryRateLimiter()
+// SetRateLimiter replaces the default InMemoryRateLimiter with the given limiter,
+// e.g. one backed by a store shared across instances
+func SetRateLimiter(limiter RateLimiter) {
+	if limiter == nil {
+		limiter = NewInMemoryRateLimiter()
+	}
+	rateLimiter = limiter
+}
+// newRateLimit creates an operation's RateLimit, counted within the given scope, out of its @RateLimit annotation's properties
+func newRateLimit(requests int, per string, key string, scope string) RateLimit {
+	window, err := time.ParseDuration(per)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid rate limit window '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			per,
+		))
+	}
+	return RateLimit{Requests: requests, Per: window, Key: key, Scope: scope}
+}
+// getClientIp returns the IP address of the request's remote peer
+func getClientIp(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil {
+		return req.RemoteAddr
+	}
+	return host
+}
+// getRateLimitKey returns the value by which the request is counted against a rate limit with the given key.
+//
+// Requests lacking the key's header or an identifiable principal are counted by their client IP
+func getRateLimitKey(req *http.Request, key string) string {
+	value := ""
+	if headerName, isHeaderKey := strings.CutPrefix(key, "header:"); isHeaderKey {
+		value = req.Header.Get(headerName)
+	} else if key == "principal" {
+		value, _ = describePrincipal(getRequestContext(req))
+	}
+	if value == "" {
+		return getClientIp(req)
+	}
+	return key + "=" + value
+}
+// enforceRateLimit counts the request against the given operation's rate limit.
+//
+// Requests exceeding the limit are answered with a 429 RFC7807 error and a 'Retry-After' header, in which case false is returned
+func enforceRateLimit(w http.ResponseWriter, req *http.Request, operationId string, limit RateLimit) bool {
+	allowed, retryAfter := rateLimiter.Allow(getRequestContext(req), operationId, getRateLimitKey(req, limit.Key), limit)
+	if allowed {
+		return true
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusTooManyRequests)
+	json.NewEncoder(w).Encode(runtime.Rfc7807Error{
+		Type:     http.StatusText(http.StatusTooManyRequests),
+		Detail:   fmt.Sprintf("Too many requests were made to operation '%s'. Please retry later", operationId),
+		Status:   http.StatusTooManyRequests,
+		Instance: "/ratelimit/error/" + operationId,
+	})
+	return false
+}
+// newOperationTimeout creates an operation's deadline duration out of its @Timeout annotation or the configured default timeout
+func newOperationTimeout(timeout string) time.Duration {
+	duration, err := time.ParseDuration(timeout)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid operation timeout '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			timeout,
+		))
+	}
+	return duration
+}
+// applyOperationTimeout replaces the request's context with one that expires once the given timeout elapses.
+//
+// Operations are not interrupted when the deadline expires - controllers are expected to honor their context's cancellation.
+// The returned function releases the context's resources and must be called once the request has been handled
+func applyOperationTimeout(req *http.Request, timeout time.Duration) context.CancelFunc {
+	ctx, cancel := context.WithTimeout(getRequestContext(req), timeout)
+	setRequestContext(req, ctx)
+	return cancel
+}
+// isDeadlineExceeded returns whether the request's context has expired due to its operation's timeout
+func isDeadlineExceeded(req *http.Request) bool {
+	return errors.Is(getRequestContext(req).Err(), context.DeadlineExceeded)
+}
+// handleDeadlineExceeded answers a request whose operation's deadline has expired with an RFC7807 error of the given status -
+// 503 if the operation has not been invoked yet or 504 if it has
+func handleDeadlineExceeded(w http.ResponseWriter, operationId string, status int) {
+	detail := fmt.Sprintf("The deadline of operation '%s' expired before the operation was invoked", operationId)
+	if status == http.StatusGatewayTimeout {
+		detail = fmt.Sprintf("Operation '%s' did not complete before its deadline expired", operationId)
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(runtime.Rfc7807Error{
+		Type:     http.StatusText(status),
+		Detail:   detail,
+		Status:   status,
+		Instance: "/timeout/error/" + operationId,
+	})
+}
+// enforceMaxBodySize checks the request's body against the given operation's maximum body size.
+//
+// Bodies of unknown length are read up-front, up to the maximum size.
+// Requests whose body exceeds the maximum size are answered with a 413 RFC7807 error, in which case false is returned
+func enforceMaxBodySize(w http.ResponseWriter, req *http.Request, operationId string, maxBodySize int64) bool {
+	bodySize := req.ContentLength
+	if bodySize < 0 {
+		body, _ := io.ReadAll(io.LimitReader(req.Body, maxBodySize+1))
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		bodySize = int64(len(body))
+	}
+	if bodySize <= maxBodySize {
+		return true
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusRequestEntityTooLarge)
+	json.NewEncoder(w).Encode(runtime.Rfc7807Error{
+		Type:     http.StatusText(http.StatusRequestEntityTooLarge),
+		Detail:   fmt.Sprintf("The request body of operation '%s' exceeds the maximum size of %d bytes", operationId, maxBodySize),
+		Status:   http.StatusRequestEntityTooLarge,
+		Instance: "/body/error/" + operationId,
+	})
+	return false
+}
+// IdempotentResponse is a response of an idempotent operation, as stored to be replayed to repeated requests
+type IdempotentResponse struct {
+	// The response's HTTP status code
+	Status int
+	// The response's headers
+	Headers http.Header
+	// The response's body
+	Body []byte
+}
+// IdempotencyStore stores the responses of operations declared via @Idempotent, by request key.
+//
+// Implementations must be safe for concurrent use
+type IdempotencyStore interface {
+	// Reserve claims the given key for a new request with the given body hash, for the given duration.
+	// If the key has already been claimed, returns false along with the stored response or,
+	// if the original request is still in flight, a nil response.
+	// If the key has been claimed by a request with a different body hash, returns ErrIdempotencyKeyReused
+	Reserve(ctx context.Context, key string, requestHash string, ttl time.Duration) (bool, *IdempotentResponse, error)
+	// Complete stores the response of the request that claimed the given key, along with the request's body hash, for the given duration
+	Complete(ctx context.Context, key string, requestHash string, response IdempotentResponse, ttl time.Duration) error
+	// Release drops the claim of a request that did not produce a replayable response, so that it may be retried
+	Release(ctx context.Context, key string) error
+}
+// ErrIdempotencyKeyReused is returned by an IdempotencyStore when a key is reused by a request with a different body
+var ErrIdempotencyKeyReused = errors.New("the idempotency key has already been used by a request with a different body")
+// InMemoryIdempotencyStore is an IdempotencyStore holding responses in the process's memory.
+//
+// Responses are not shared across instances - deployments with multiple instances should use a shared IdempotencyStore instead
+type InMemoryIdempotencyStore struct {
+	mutex     sync.Mutex
+	entries   map[string]*idempotencyEntry
+	lastSweep time.Time
+}
+type idempotencyEntry struct {
+	// The stored response. Nil while the request is in flight
+	response *IdempotentResponse
+	// The hash of the body of the request that claimed the entry's key
+	requestHash string
+	expiresAt   time.Time
+}
+// The interval at which an InMemoryIdempotencyStore drops expired entries
+const idempotencySweepInterval = time.Minute
+// NewInMemoryIdempotencyStore creates an InMemoryIdempotencyStore with no entries
+func NewInMemoryIdempotencyStore() *InMemoryIdempotencyStore {
+	return &InMemoryIdempotencyStore{entries: map[string]*idempotencyEntry{}, lastSweep: time.Now()}
+}
+func (store *InMemoryIdempotencyStore) Reserve(_ context.Context, key string, requestHash string, ttl time.Duration) (bool, *IdempotentResponse, error) {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	now := time.Now()
+	store.sweep(now)
+	if entry, exists := store.entries[key]; exists && now.Before(entry.expiresAt) {
+		if entry.requestHash != requestHash {
+			return false, nil, ErrIdempotencyKeyReused
+		}
+		return false, entry.response, nil
+	}
+	store.entries[key] = &idempotencyEntry{requestHash: requestHash, expiresAt: now.Add(ttl)}
+	return true, nil, nil
+}
+func (store *InMemoryIdempotencyStore) Complete(_ context.Context, key string, requestHash string, response IdempotentResponse, ttl time.Duration) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	store.entries[key] = &idempotencyEntry{response: &response, requestHash: requestHash, expiresAt: time.Now().Add(ttl)}
+	return nil
+}
+func (store *InMemoryIdempotencyStore) Release(_ context.Context, key string) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+	delete(store.entries, key)
+	return nil
+}
+// sweep drops the entries that have expired
+func (store *InMemoryIdempotencyStore) sweep(now time.Time) {
+	if now.Sub(store.lastSweep) < idempotencySweepInterval {
+		return
+	}
+	for key, entry := range store.entries {
+		if !now.Before(entry.expiresAt) {
+			delete(store.entries, key)
+		}
+	}
+	store.lastSweep = now
+}
+var idempotencyStore IdempotencyStore = NewInMemoryIdempotencyStore()
+// SetIdempotencyStore replaces the default InMemoryIdempotencyStore with the given store,
+// e.g. one backed by a database shared across instances
+func SetIdempotencyStore(store IdempotencyStore) {
+	if store == nil {
+		store = NewInMemoryIdempotencyStore()
+	}
+	idempotencyStore = store
+}
+// newIdempotencyTtl creates an operation's response retention duration out of its @Idempotent annotation's 'ttl' property
+func newIdempotencyTtl(ttl string) time.Duration {
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"Encountered an invalid idempotency TTL '%s' - this is unexpected and indicates a bug in Gleece itself. "+
+				"Please open an issue at https://github.com/gopher-fleece/gleece/issues",
+			ttl,
+		))
+	}
+	return duration
+}
+// idempotentResponseRecorder records the status and body written to the response so they may be stored once the operation completes
+type idempotentResponseRecorder struct {
+	http.ResponseWriter
+	status int
+	body   bytes.Buffer
+}
+func (recorder *idempotentResponseRecorder) WriteHeader(status int) {
+	if recorder.status == 0 {
+		recorder.status = status
+	}
+	recorder.ResponseWriter.WriteHeader(status)
+}
+func (recorder *idempotentResponseRecorder) Write(data []byte) (int, error) {
+	if recorder.status == 0 {
+		recorder.status = http.StatusOK
+	}
+	recorder.body.Write(data)
+	return recorder.ResponseWriter.Write(data)
+}
+// The maximum size, in bytes, of the request bodies hashed by idempotent operations without a @MaxBodySize limit
+const defaultMaxIdempotentBodySize = 10 << 20
+// hashRequestBody returns the hex encoded SHA-256 hash of the request's body. The body remains readable.
+//
+// Requests whose body exceeds the given maximum size, or defaultMaxIdempotentBodySize if zero,
+// are answered with a 413 RFC7807 error, in which case false is returned
+func hashRequestBody(w http.ResponseWriter, req *http.Request, operationId string, maxBodySize int64) (string, bool) {
+	if maxBodySize <= 0 {
+		maxBodySize = defaultMaxIdempotentBodySize
+	}
+	body, _ := io.ReadAll(io.LimitReader(req.Body, maxBodySize+1))
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	if int64(len(body)) > maxBodySize {
+		writeIdempotencyError(w, operationId, http.StatusRequestEntityTooLarge, fmt.Sprintf("The request body of operation '%s' exceeds the maximum size of %d bytes", operationId, maxBodySize))
+		return "", false
+	}
+	hash := sha256.Sum256(body)
+	return hex.EncodeToString(hash[:]), true
+}
+// beginIdempotentRequest claims the request's 'Idempotency-Key' header, scoped to the given operation and the request's principal.
+//
+// If the operation may proceed, returns the writer through which it should respond along with a function storing its response,
+// which must be deferred. Otherwise, the request is answered - with the stored response if the key has been used before,
+// or with an RFC7807 error if the header is missing (400), the original request is still in flight (409),
+// the principal lacks a stable identity (500)
+// or the key has been used by a request with a different body (422) - and a nil function is returned
+func beginIdempotentRequest(w http.ResponseWriter, req *http.Request, operationId string, requestHash string, ttl time.Duration) (http.ResponseWriter, func()) {
+	idempotencyKey := req.Header.Get("Idempotency-Key")
+	if idempotencyKey == "" {
+		writeIdempotencyError(
+			w,
+			operationId,
+			http.StatusBadRequest,
+			fmt.Sprintf("Operation '%s' requires an 'Idempotency-Key' header", operationId),
+		)
+		return w, nil
+	}
+	ctx := getRequestContext(req)
+	// Keys are scoped by principal so that they may not collide across clients. Principals lacking a stable identity are
+	// rejected, as their requests could otherwise never be recognized as repeated
+	principalIdentity, isIdentifiable := describePrincipal(ctx)
+	if !isIdentifiable {
+		writeIdempotencyError(
+			w,
+			operationId,
+			http.StatusInternalServerError,
+			fmt.Sprintf("The principal of operation '%s' lacks a stable identity to scope its 'Idempotency-Key' by", operationId),
+		)
+		return w, nil
+	}
+	key := operationId + "\x00" + principalIdentity + "\x00" + idempotencyKey
+	reserved, response, err := idempotencyStore.Reserve(ctx, key, requestHash, ttl)
+	switch {
+	case errors.Is(err, ErrIdempotencyKeyReused):
+		writeIdempotencyError(
+			w,
+			operationId,
+			http.StatusUnprocessableEntity,
+			fmt.Sprintf("The 'Idempotency-Key' has already been used by a request to operation '%s' with a different body", operationId),
+		)
+		return w, nil
+	case err != nil:
+		writeIdempotencyError(
+			w,
+			operationId,
+			http.StatusInternalServerError,
+			fmt.Sprintf("Failed to process the 'Idempotency-Key' header of operation '%s'", operationId),
+		)
+		return w, nil
+	case !reserved && response == nil:
+		writeIdempotencyError(
+			w,
+			operationId,
+			http.StatusConflict,
+			fmt.Sprintf("A request to operation '%s' with the same 'Idempotency-Key' is still being processed", operationId),
+		)
+		return w, nil
+	case !reserved:
+		for name, values := range response.Headers {
+			w.Header()[name] = values
+		}
+		w.Header().Set("Idempotent-Replayed", "true")
+		w.WriteHeader(response.Status)
+		w.Write(response.Body)
+		return w, nil
+	}
+	recorder := &idempotentResponseRecorder{ResponseWriter: w}
+	return recorder, func() {
+		// Requests that panicked or failed on the server's side are not replayed so they may be retried
+		recovered := recover()
+		if recovered != nil || recorder.status == 0 || recorder.status >= http.StatusInternalServerError {
+			idempotencyStore.Release(context.WithoutCancel(ctx), key)
+			if recovered != nil {
+				panic(recovered)
+			}
+			return
+		}
+		idempotencyStore.Complete(
+			context.WithoutCancel(ctx),
+			key,
+			requestHash,
+			IdempotentResponse{Status: recorder.status, Headers: recorder.Header().Clone(), Body: recorder.body.Bytes()},
+			ttl,
+		)
+	}
+}
+// writeIdempotencyError answers a request to an idempotent operation with an RFC7807 error of the given status
+func writeIdempotencyError(w http.ResponseWriter, operationId string, status int, detail string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(runtime.Rfc7807Error{
+		Type:     http.StatusText(status),
+		Detail:   detail,
+		Status:   status,
+		Instance: "/idempotency/error/" + operationId,
+	})
+}
 type MiddlewareFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request) (context.Context, bool)
 type ErrorMiddlewareFunc func(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) (context.Context, bool)
 var beforeOperationMiddlewares []MiddlewareFunc
@@ -295,13 +1556,46 @@ func RegisterErrorMiddleware(executionType runtime.ErrorMiddlewareExecutionType,
 		onErrorMiddlewares = append(onErrorMiddlewares, errorMiddlewareFunc)
 	}
 }
-func RegisterCustomValidator(validateTagName string, validateFunc runtime.ValidationFunc) {
-	validatorInstance.RegisterValidation(validateTagName, func(fl validator.FieldLevel) bool {
-		return validateFunc(fl)
+var namedMiddlewares = map[string]MiddlewareFunc{}
+// RegisterNamedMiddleware registers a middleware that controllers and routes may reference by name using @Middleware annotations.
+//
+// Named middlewares must be registered before RegisterRoutes is called
+func RegisterNamedMiddleware(name string, middlewareFunc MiddlewareFunc) {
+	namedMiddlewares[name] = middlewareFunc
+}
+func ensureNamedMiddlewaresRegistered(names []string) {
+	missing := []string{}
+	for _, name := range names {
+		if _, registered := namedMiddlewares[name]; !registered {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		panic(fmt.Sprintf(
+			"middlewares referenced via @Middleware annotations were never registered using RegisterNamedMiddleware: %s",
+			strings.Join(missing, ", "),
+		))
+	}
+}
+func RegisterCustomValidator(validateTagName string, validateFunc runtime.ValidationFunc) {
+	validatorInstance.RegisterValidation(validateTagName, func(fl validator.FieldLevel) bool {
+		return validateFunc(fl)
 	})
 }
+// BasePath is the path under which RegisterRoutes mounts the API's routes
+const BasePath = ""
+// RegisterRoutes registers the API's routes on the given engine, under BasePath
 func RegisterRoutes(engine *mux.Router) {
+	RegisterGroupRoutes(engine, BasePath)
+}
+// RegisterGroupRoutes registers the API's routes on the given router, e.g. a sub-router created via PathPrefix(...).Subrouter(), under the given base path.
+//
+// The base path is relative to the router's own prefix.
+// For the OpenAPI specification to match the served routes, the two should add up to BasePath
+func RegisterGroupRoutes(engine *mux.Router, basePath string) {
 	urlParamRegex = regexp.MustCompile(`\{([\w\d-_]+)\}`)
+	ensureSecurityHandlersRegistered()
+	ensureNamedMiddlewaresRegistered([]string{"audit", "trace"})
 	registerEnumValidation(validatorInstance, "bool_enum_enum", []string{"false", "true"})
 	registerEnumValidation(validatorInstance, "length_units_enum", []string{"Angstrom", "AstronomicalUnit", "Centimeter", "Chain", "DataMile", "Decameter", "Decimeter", "DtpPica", "DtpPoint", "Fathom", "Femtometer", "Foot", "Gigameter", "Hand", "Hectometer", "Inch", "Kilofoot", "KilolightYear", "Kilometer", "Kiloparsec", "Kiloyard", "LightYear", "MegalightYear", "Megameter", "Megaparsec", "Meter", "Microinch", "Micrometer", "Mil", "Mile", "Millimeter", "Nanometer", "NauticalMile", "Parsec", "Picometer", "PrinterPica", "PrinterPoint", "Shackle", "SolarRadius", "Twip", "UsSurveyFoot", "Yard"})
 	registerEnumValidation(validatorInstance, "myemamium_enum", []string{"one", "two"})
@@ -311,8 +1605,23 @@ func RegisterRoutes(engine *mux.Router) {
 	registerEnumValidation(validatorInstance, "status_enumeration_enum", []string{"active", "inactive"})
 	// RegisterRoutesExtension - test
 	// E2EClassSecController
-	engine.HandleFunc(toMuxUrl("/e2e/with-default-class-security"), func(w http.ResponseWriter, req *http.Request) {
+	e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes := []int{}
+	e2EclassSecControllerWithDefaultClassSecurityRoute := withBasePath(basePath, "/e2e/with-default-class-security")
+	e2EclassSecControllerWithDefaultClassSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"WithDefaultClassSecurity",
+			"E2EClassSecController",
+			"GET",
+			e2EclassSecControllerWithDefaultClassSecurityRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "WithDefaultClassSecurity")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -333,8 +1642,9 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "WithDefaultClassSecurity")
 			return
 		}
-		controller := E2EClassSecController.E2EClassSecController{}
+		controller := createController[E2EClassSecController.E2EClassSecController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var headerParamRawPtr *string = nil
 		headerParamRaw := req.Header.Get("x-test-scopes")
 		_, isheaderParamExists := req.Header["x-test-scopes"]
@@ -358,12 +1668,15 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultClassSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			w.Header().Set("x-RunValidatorExtension", "WithDefaultClassSecurity")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -374,13 +1687,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "WithDefaultClassSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "WithDefaultClassSecurity")
+			}
+		}()
 		value, opError := controller.WithDefaultClassSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "WithDefaultClassSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -398,6 +1718,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/WithDefaultClassSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EclassSecControllerWithDefaultClassSecurityErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "WithDefaultClassSecurity")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -418,9 +1739,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "WithDefaultClassSecurity")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/with-default-override-class-security"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/with-default-class-security")), e2EclassSecControllerWithDefaultClassSecurityHandler).Methods("GET", "HEAD")
+	e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes := []int{}
+	e2EclassSecControllerWithOverrideClassSecurityRoute := withBasePath(basePath, "/e2e/with-default-override-class-security")
+	e2EclassSecControllerWithOverrideClassSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"WithOverrideClassSecurity",
+			"E2EClassSecController",
+			"GET",
+			e2EclassSecControllerWithOverrideClassSecurityRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "WithOverrideClassSecurity")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -441,8 +1778,9 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "WithOverrideClassSecurity")
 			return
 		}
-		controller := E2EClassSecController.E2EClassSecController{}
+		controller := createController[E2EClassSecController.E2EClassSecController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var headerParamRawPtr *string = nil
 		headerParamRaw := req.Header.Get("x-test-scopes")
 		_, isheaderParamExists := req.Header["x-test-scopes"]
@@ -466,12 +1804,15 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOverrideClassSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
 			w.Header().Set("x-RunValidatorExtension", "WithOverrideClassSecurity")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -482,13 +1823,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "WithOverrideClassSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "WithOverrideClassSecurity")
+			}
+		}()
 		value, opError := controller.WithOverrideClassSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "WithOverrideClassSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -506,6 +1854,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/WithOverrideClassSecurity",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EclassSecControllerWithOverrideClassSecurityErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "WithOverrideClassSecurity")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -526,10 +1875,26 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "WithOverrideClassSecurity")
-	}).Methods("GET")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/with-default-override-class-security")), e2EclassSecControllerWithOverrideClassSecurityHandler).Methods("GET", "HEAD")
 	// E2EController
-	engine.HandleFunc(toMuxUrl("/e2e/simple-get"), func(w http.ResponseWriter, req *http.Request) {
+	e2EcontrollerSimpleGetErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetRoute := withBasePath(basePath, "/e2e/simple-get")
+	e2EcontrollerSimpleGetHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SimpleGet",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "SimpleGet")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -550,8 +1915,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGet")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -562,13 +1929,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "SimpleGet")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SimpleGet")
+			}
+		}()
 		value, opError := controller.SimpleGet()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "SimpleGet")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -586,6 +1960,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/SimpleGet",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "SimpleGet")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -606,9 +1981,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "SimpleGet")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/simple-get-empty-string"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/simple-get")), e2EcontrollerSimpleGetHandler).Methods("GET", "HEAD")
+	e2EcontrollerSimpleGetEmptyStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyStringRoute := withBasePath(basePath, "/e2e/simple-get-empty-string")
+	e2EcontrollerSimpleGetEmptyStringHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SimpleGetEmptyString",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetEmptyStringRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "SimpleGetEmptyString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -629,8 +2020,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGetEmptyString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -641,13 +2034,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetEmptyString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SimpleGetEmptyString")
+			}
+		}()
 		value, opError := controller.SimpleGetEmptyString()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "SimpleGetEmptyString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -665,6 +2065,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/SimpleGetEmptyString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyStringErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "SimpleGetEmptyString")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -685,9 +2086,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "SimpleGetEmptyString")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/simple-get-ptr-string"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/simple-get-empty-string")), e2EcontrollerSimpleGetEmptyStringHandler).Methods("GET", "HEAD")
+	e2EcontrollerSimpleGetPtrStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetPtrStringRoute := withBasePath(basePath, "/e2e/simple-get-ptr-string")
+	e2EcontrollerSimpleGetPtrStringHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SimpleGetPtrString",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetPtrStringRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "SimpleGetPtrString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -708,8 +2125,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGetPtrString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -720,13 +2139,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetPtrString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SimpleGetPtrString")
+			}
+		}()
 		value, opError := controller.SimpleGetPtrString()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "SimpleGetPtrString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -744,6 +2170,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/SimpleGetPtrString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetPtrStringErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "SimpleGetPtrString")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -764,9 +2191,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "SimpleGetPtrString")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/simple-get-null-string"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/simple-get-ptr-string")), e2EcontrollerSimpleGetPtrStringHandler).Methods("GET", "HEAD")
+	e2EcontrollerSimpleGetNullStringErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetNullStringRoute := withBasePath(basePath, "/e2e/simple-get-null-string")
+	e2EcontrollerSimpleGetNullStringHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SimpleGetNullString",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetNullStringRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "SimpleGetNullString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -787,8 +2230,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGetNullString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -799,13 +2244,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetNullString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SimpleGetNullString")
+			}
+		}()
 		value, opError := controller.SimpleGetNullString()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "SimpleGetNullString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -823,6 +2275,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/SimpleGetNullString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetNullStringErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "SimpleGetNullString")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -843,9 +2296,140 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "SimpleGetNullString")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/simple-get-object"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/simple-get-null-string")), e2EcontrollerSimpleGetNullStringHandler).Methods("GET", "HEAD")
+	e2EcontrollerNamedMiddlewaresErrorStatusCodes := []int{}
+	e2EcontrollerNamedMiddlewaresRoute := withBasePath(basePath, "/e2e/named-middlewares")
+	e2EcontrollerNamedMiddlewaresHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"NamedMiddlewares",
+			"E2EController",
+			"GET",
+			e2EcontrollerNamedMiddlewaresRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "NamedMiddlewares")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "NamedMiddlewares")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		routeMiddlewares := []MiddlewareFunc{namedMiddlewares["audit"], namedMiddlewares["trace"]}
+		// Middlewares routeMiddlewares section
+		for _, middleware := range routeMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares routeMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "NamedMiddlewares")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "NamedMiddlewares")
+			}
+		}()
+		value, opError := controller.NamedMiddlewares()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "NamedMiddlewares")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'NamedMiddlewares'",
+				Status:     statusCode,
+				Instance:   "/controller/error/NamedMiddlewares",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerNamedMiddlewaresErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "NamedMiddlewares")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "NamedMiddlewares")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "NamedMiddlewares")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "NamedMiddlewares")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/named-middlewares")), e2EcontrollerNamedMiddlewaresHandler).Methods("GET", "HEAD")
+	e2EcontrollerSimpleGetObjectErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectRoute := withBasePath(basePath, "/e2e/simple-get-object")
+	e2EcontrollerSimpleGetObjectHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SimpleGetObject",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetObjectRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "SimpleGetObject")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -866,8 +2450,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGetObject")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -878,13 +2464,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetObject")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SimpleGetObject")
+			}
+		}()
 		value, opError := controller.SimpleGetObject()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "SimpleGetObject")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -902,6 +2495,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/SimpleGetObject",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "SimpleGetObject")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -947,9 +2541,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "SimpleGetObject")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/simple-get-object-ptr"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/simple-get-object")), e2EcontrollerSimpleGetObjectHandler).Methods("GET", "HEAD")
+	e2EcontrollerSimpleGetObjectPtrErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectPtrRoute := withBasePath(basePath, "/e2e/simple-get-object-ptr")
+	e2EcontrollerSimpleGetObjectPtrHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SimpleGetObjectPtr",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetObjectPtrRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "SimpleGetObjectPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -970,8 +2580,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGetObjectPtr")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -982,13 +2594,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetObjectPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SimpleGetObjectPtr")
+			}
+		}()
 		value, opError := controller.SimpleGetObjectPtr()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "SimpleGetObjectPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1006,6 +2625,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/SimpleGetObjectPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectPtrErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "SimpleGetObjectPtr")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -1055,9 +2675,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "SimpleGetObjectPtr")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/simple-get-object-null"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/simple-get-object-ptr")), e2EcontrollerSimpleGetObjectPtrHandler).Methods("GET", "HEAD")
+	e2EcontrollerSimpleGetObjectNullErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetObjectNullRoute := withBasePath(basePath, "/e2e/simple-get-object-null")
+	e2EcontrollerSimpleGetObjectNullHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SimpleGetObjectNull",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetObjectNullRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "SimpleGetObjectNull")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -1078,8 +2714,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGetObjectNull")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -1090,13 +2728,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetObjectNull")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SimpleGetObjectNull")
+			}
+		}()
 		value, opError := controller.SimpleGetObjectNull()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "SimpleGetObjectNull")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1114,6 +2759,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/SimpleGetObjectNull",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetObjectNullErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "SimpleGetObjectNull")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -1163,9 +2809,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "SimpleGetObjectNull")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/primitive-return-type"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/simple-get-object-null")), e2EcontrollerSimpleGetObjectNullHandler).Methods("GET", "HEAD")
+	e2EcontrollerPrimitiveReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-return-type")
+	e2EcontrollerPrimitiveReturnTypeHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"PrimitiveReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveReturnTypeRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "PrimitiveReturnType")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -1186,8 +2848,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "PrimitiveReturnType")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -1198,13 +2862,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "PrimitiveReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "PrimitiveReturnType")
+			}
+		}()
 		value, opError := controller.PrimitiveReturnType()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "PrimitiveReturnType")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1222,6 +2893,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/PrimitiveReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveReturnTypeErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "PrimitiveReturnType")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -1242,9 +2914,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "PrimitiveReturnType")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/primitive-array-return-type"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/primitive-return-type")), e2EcontrollerPrimitiveReturnTypeHandler).Methods("GET", "HEAD")
+	e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveArrayReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-array-return-type")
+	e2EcontrollerPrimitiveArrayReturnTypeHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"PrimitiveArrayReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveArrayReturnTypeRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "PrimitiveArrayReturnType")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -1265,8 +2953,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "PrimitiveArrayReturnType")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -1277,13 +2967,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "PrimitiveArrayReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "PrimitiveArrayReturnType")
+			}
+		}()
 		value, opError := controller.PrimitiveArrayReturnType()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "PrimitiveArrayReturnType")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1301,6 +2998,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/PrimitiveArrayReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveArrayReturnTypeErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "PrimitiveArrayReturnType")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -1321,9 +3019,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "PrimitiveArrayReturnType")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/primitive-alias-return-type"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/primitive-array-return-type")), e2EcontrollerPrimitiveArrayReturnTypeHandler).Methods("GET", "HEAD")
+	e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-alias-return-type")
+	e2EcontrollerPrimitiveAliasReturnTypeHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"PrimitiveAliasReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveAliasReturnTypeRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "PrimitiveAliasReturnType")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -1344,8 +3058,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "PrimitiveAliasReturnType")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -1356,13 +3072,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "PrimitiveAliasReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "PrimitiveAliasReturnType")
+			}
+		}()
 		value, opError := controller.PrimitiveAliasReturnType()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "PrimitiveAliasReturnType")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1380,6 +3103,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/PrimitiveAliasReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasReturnTypeErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "PrimitiveAliasReturnType")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -1425,9 +3149,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "PrimitiveAliasReturnType")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/primitive-alias-array-return-type"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/primitive-alias-return-type")), e2EcontrollerPrimitiveAliasReturnTypeHandler).Methods("GET", "HEAD")
+	e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes := []int{500}
+	e2EcontrollerPrimitiveAliasArrayReturnTypeRoute := withBasePath(basePath, "/e2e/primitive-alias-array-return-type")
+	e2EcontrollerPrimitiveAliasArrayReturnTypeHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"PrimitiveAliasArrayReturnType",
+			"E2EController",
+			"GET",
+			e2EcontrollerPrimitiveAliasArrayReturnTypeRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "PrimitiveAliasArrayReturnType")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -1448,8 +3188,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "PrimitiveAliasArrayReturnType")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -1460,13 +3202,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "PrimitiveAliasArrayReturnType")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "PrimitiveAliasArrayReturnType")
+			}
+		}()
 		value, opError := controller.PrimitiveAliasArrayReturnType()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "PrimitiveAliasArrayReturnType")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1484,6 +3233,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/PrimitiveAliasArrayReturnType",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPrimitiveAliasArrayReturnTypeErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "PrimitiveAliasArrayReturnType")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -1529,9 +3279,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "PrimitiveAliasArrayReturnType")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/simple-get-empty"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/primitive-alias-array-return-type")), e2EcontrollerPrimitiveAliasArrayReturnTypeHandler).Methods("GET", "HEAD")
+	e2EcontrollerSimpleGetEmptyErrorStatusCodes := []int{}
+	e2EcontrollerSimpleGetEmptyRoute := withBasePath(basePath, "/e2e/simple-get-empty")
+	e2EcontrollerSimpleGetEmptyHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SimpleGetEmpty",
+			"E2EController",
+			"GET",
+			e2EcontrollerSimpleGetEmptyRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "SimpleGetEmpty")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -1552,8 +3318,9 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "SimpleGetEmpty")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var queryParamRawPtr *string = nil
 		queryParamRaw := req.URL.Query().Get("queryParam")
 		isqueryParamExists := req.URL.Query().Has("queryParam")
@@ -1572,12 +3339,15 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "SimpleGetEmpty", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			w.Header().Set("x-RunValidatorExtension", "SimpleGetEmpty")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -1588,13 +3358,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "SimpleGetEmpty")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SimpleGetEmpty")
+			}
+		}()
 		opError := controller.SimpleGetEmpty(*queryParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "SimpleGetEmpty")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1612,6 +3389,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/SimpleGetEmpty",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerSimpleGetEmptyErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "SimpleGetEmpty")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -1630,9 +3408,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.Header().Set("x-AfterOperationRoutesExtension", "SimpleGetEmpty")
 		w.WriteHeader(statusCode)
 		w.Header().Set("x-RouteEndRoutesExtension", "SimpleGetEmpty")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/get-with-all-params/{pathParam}"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/simple-get-empty")), e2EcontrollerSimpleGetEmptyHandler).Methods("GET", "HEAD")
+	e2EcontrollerGetWithAllParamsErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsRoute := withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")
+	e2EcontrollerGetWithAllParamsHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"GetWithAllParams",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetWithAllParamsRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "GetWithAllParams")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -1653,8 +3447,9 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "GetWithAllParams")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var queryParamRawPtr *string = nil
 		queryParamRaw := req.URL.Query().Get("queryParam")
 		isqueryParamExists := req.URL.Query().Has("queryParam")
@@ -1673,10 +3468,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			w.Header().Set("x-RunValidatorExtension", "GetWithAllParams")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		pathParamvars := mux.Vars(req)
@@ -1697,10 +3494,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			w.Header().Set("x-RunValidatorExtension", "GetWithAllParams")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var headerParamRawPtr *string = nil
@@ -1726,12 +3525,15 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParams", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			w.Header().Set("x-RunValidatorExtension", "GetWithAllParams")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -1742,13 +3544,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "GetWithAllParams")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "GetWithAllParams")
+			}
+		}()
 		value, opError := controller.GetWithAllParams(*queryParamRawPtr, *pathParamRawPtr, *headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "GetWithAllParams")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1766,6 +3575,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/GetWithAllParams",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "GetWithAllParams")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -1786,9 +3596,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "GetWithAllParams")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/get-with-all-params-ptr/{pathParam}"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/get-with-all-params/{pathParam}")), e2EcontrollerGetWithAllParamsHandler).Methods("GET", "HEAD")
+	e2EcontrollerGetWithAllParamsPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsPtrRoute := withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")
+	e2EcontrollerGetWithAllParamsPtrHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"GetWithAllParamsPtr",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetWithAllParamsPtrRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "GetWithAllParamsPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -1809,8 +3635,9 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "GetWithAllParamsPtr")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var queryParamRawPtr *string = nil
 		queryParamRaw := req.URL.Query().Get("queryParam")
 		isqueryParamExists := req.URL.Query().Has("queryParam")
@@ -1836,10 +3663,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			w.Header().Set("x-RunValidatorExtension", "GetWithAllParamsPtr")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var headerParamRawPtr *string = nil
@@ -1854,6 +3683,7 @@ func RegisterRoutes(engine *mux.Router) {
 			headerParam := headerParamRaw
 			headerParamRawPtr = &headerParam
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -1864,13 +3694,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "GetWithAllParamsPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "GetWithAllParamsPtr")
+			}
+		}()
 		value, opError := controller.GetWithAllParamsPtr(queryParamRawPtr, pathParamRawPtr, headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "GetWithAllParamsPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -1888,6 +3725,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/GetWithAllParamsPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsPtrErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "GetWithAllParamsPtr")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -1908,9 +3746,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "GetWithAllParamsPtr")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/get-with-all-params-required-ptr/{pathParam}"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/get-with-all-params-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsPtrHandler).Methods("GET", "HEAD")
+	e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerGetWithAllParamsRequiredPtrRoute := withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")
+	e2EcontrollerGetWithAllParamsRequiredPtrHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"GetWithAllParamsRequiredPtr",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetWithAllParamsRequiredPtrRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "GetWithAllParamsRequiredPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -1931,8 +3785,9 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "GetWithAllParamsRequiredPtr")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var queryParamRawPtr *string = nil
 		queryParamRaw := req.URL.Query().Get("queryParam")
 		isqueryParamExists := req.URL.Query().Has("queryParam")
@@ -1951,10 +3806,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			w.Header().Set("x-RunValidatorExtension", "GetWithAllParamsRequiredPtr")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		pathParamvars := mux.Vars(req)
@@ -1975,10 +3832,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "pathParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/pathParam", languages)
 			w.Header().Set("x-RunValidatorExtension", "GetWithAllParamsRequiredPtr")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var headerParamRawPtr *string = nil
@@ -2004,12 +3863,15 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetWithAllParamsRequiredPtr", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			w.Header().Set("x-RunValidatorExtension", "GetWithAllParamsRequiredPtr")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -2020,13 +3882,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "GetWithAllParamsRequiredPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "GetWithAllParamsRequiredPtr")
+			}
+		}()
 		value, opError := controller.GetWithAllParamsRequiredPtr(queryParamRawPtr, pathParamRawPtr, headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "GetWithAllParamsRequiredPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2044,6 +3913,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/GetWithAllParamsRequiredPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetWithAllParamsRequiredPtrErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "GetWithAllParamsRequiredPtr")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -2064,9 +3934,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "GetWithAllParamsRequiredPtr")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/post-with-all-params-body"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/get-with-all-params-required-ptr/{pathParam}")), e2EcontrollerGetWithAllParamsRequiredPtrHandler).Methods("GET", "HEAD")
+	e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyRoute := withBasePath(basePath, "/e2e/post-with-all-params-body")
+	e2EcontrollerPostWithAllParamsWithBodyHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"PostWithAllParamsWithBody",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostWithAllParamsWithBodyRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "PostWithAllParamsWithBody")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -2087,8 +3973,9 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBody")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var conversionErr error
 		var queryParamRawPtr *string = nil
 		queryParamRaw := req.URL.Query().Get("queryParam")
@@ -2108,10 +3995,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "queryParam"
-			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/queryParam", languages)
 			w.Header().Set("x-RunValidatorExtension", "PostWithAllParamsWithBody")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var headerParamRawPtr *string = nil
@@ -2137,10 +4026,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "PostWithAllParamsWithBody", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
 			w.Header().Set("x-RunValidatorExtension", "PostWithAllParamsWithBody")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var theBodyRawPtr *Param5theBody.BodyInfo = nil
@@ -2155,22 +4046,29 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBody' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBody",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBody",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBody")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -2181,13 +4079,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBody")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "PostWithAllParamsWithBody")
+			}
+		}()
 		value, opError := controller.PostWithAllParamsWithBody(*queryParamRawPtr, *headerParamRawPtr, *theBodyRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "PostWithAllParamsWithBody")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2205,6 +4110,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/PostWithAllParamsWithBody",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "PostWithAllParamsWithBody")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -2250,9 +4156,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "PostWithAllParamsWithBody")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/post-with-all-params-body-ptr"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/post-with-all-params-body")), e2EcontrollerPostWithAllParamsWithBodyHandler).Methods("POST")
+	e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyPtrRoute := withBasePath(basePath, "/e2e/post-with-all-params-body-ptr")
+	e2EcontrollerPostWithAllParamsWithBodyPtrHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"PostWithAllParamsWithBodyPtr",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostWithAllParamsWithBodyPtrRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "PostWithAllParamsWithBodyPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -2273,8 +4195,9 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBodyPtr")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var conversionErr error
 		var queryParamRawPtr *string = nil
 		queryParamRaw := req.URL.Query().Get("queryParam")
@@ -2307,22 +4230,2376 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBodyPtr' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/PostWithAllParamsWithBodyPtr",
 			}
-			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBodyPtr")
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBodyPtr")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBodyPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "PostWithAllParamsWithBodyPtr")
+			}
+		}()
+		value, opError := controller.PostWithAllParamsWithBodyPtr(queryParamRawPtr, headerParamRawPtr, theBodyRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "PostWithAllParamsWithBodyPtr")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyPtr'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PostWithAllParamsWithBodyPtr",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyPtrErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "PostWithAllParamsWithBodyPtr")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "PostWithAllParamsWithBodyPtr")
+		var outputValidationErr error
+		if value == nil {
+			outputValidationErr = fmt.Errorf("Response payload is nil")
+		} else {
+			outputValidationErr = validateDataRecursive(value, "")
+		}
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyPtr'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/PostWithAllParamsWithBodyPtr",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "PostWithAllParamsWithBodyPtr")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "PostWithAllParamsWithBodyPtr")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-ptr")), e2EcontrollerPostWithAllParamsWithBodyPtrHandler).Methods("POST")
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes := []int{}
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrRoute := withBasePath(basePath, "/e2e/post-with-all-params-body-required-ptr")
+	e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"PostWithAllParamsWithBodyRequiredPtr",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostWithAllParamsWithBodyRequiredPtrRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBodyRequiredPtr")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var theBodyRawPtr *Param5theBody.BodyInfo = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &theBodyRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "PostWithAllParamsWithBodyRequiredPtr",
+						"parameter": "theBody",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/PostWithAllParamsWithBodyRequiredPtr",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "PostWithAllParamsWithBodyRequiredPtr")
+			}
+		}()
+		value, opError := controller.PostWithAllParamsWithBodyRequiredPtr(theBodyRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyRequiredPtr'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PostWithAllParamsWithBodyRequiredPtr",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostWithAllParamsWithBodyRequiredPtrErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		var outputValidationErr error
+		if value == nil {
+			outputValidationErr = fmt.Errorf("Response payload is nil")
+		} else {
+			outputValidationErr = validateDataRecursive(value, "")
+		}
+		if outputValidationErr != nil {
+			// Middlewares onOutputValidationMiddlewares section
+			for _, middleware := range onOutputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onOutputValidationMiddlewares section
+			outputValidationStatusCode := http.StatusInternalServerError
+			outputValidationRfc7807Error := runtime.Rfc7807Error{
+				Type:       http.StatusText(outputValidationStatusCode),
+				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyRequiredPtr'",
+				Status:     outputValidationStatusCode,
+				Instance:   "/controller/error/PostWithAllParamsWithBodyRequiredPtr",
+				Extensions: map[string]string{},
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(outputValidationStatusCode)
+			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
+			return
+		}
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/post-with-all-params-body-required-ptr")), e2EcontrollerPostWithAllParamsWithBodyRequiredPtrHandler).Methods("POST")
+	e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes := []int{}
+	e2EcontrollerGetHeaderStartWithLetterRoute := withBasePath(basePath, "/e2e/get-header-start-with-letter")
+	e2EcontrollerGetHeaderStartWithLetterHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"GetHeaderStartWithLetter",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetHeaderStartWithLetterRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "GetHeaderStartWithLetter")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "GetHeaderStartWithLetter")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("headerParam")
+		_, isheaderParamExists := req.Header["headerParam"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("headerParam")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required,validate_starts_with_letter"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/headerParam", languages)
+			w.Header().Set("x-RunValidatorExtension", "GetHeaderStartWithLetter")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "GetHeaderStartWithLetter")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "GetHeaderStartWithLetter")
+			}
+		}()
+		value, opError := controller.GetHeaderStartWithLetter(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "GetHeaderStartWithLetter")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'GetHeaderStartWithLetter'",
+				Status:     statusCode,
+				Instance:   "/controller/error/GetHeaderStartWithLetter",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetHeaderStartWithLetterErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "GetHeaderStartWithLetter")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "GetHeaderStartWithLetter")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "GetHeaderStartWithLetter")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "GetHeaderStartWithLetter")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/get-header-start-with-letter")), e2EcontrollerGetHeaderStartWithLetterHandler).Methods("GET", "HEAD")
+	e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithDefaultConfigSecurityRoute := withBasePath(basePath, "/e2e/with-default-config-security")
+	e2EcontrollerWithDefaultConfigSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"WithDefaultConfigSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithDefaultConfigSecurityRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "WithDefaultConfigSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithDefaultConfigSecurity")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("x-test-scopes")
+		_, isheaderParamExists := req.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			w.Header().Set("x-RunValidatorExtension", "WithDefaultConfigSecurity")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "WithDefaultConfigSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "WithDefaultConfigSecurity")
+			}
+		}()
+		value, opError := controller.WithDefaultConfigSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "WithDefaultConfigSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithDefaultConfigSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithDefaultConfigSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithDefaultConfigSecurityErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "WithDefaultConfigSecurity")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "WithDefaultConfigSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "WithDefaultConfigSecurity")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "WithDefaultConfigSecurity")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/with-default-config-security")), e2EcontrollerWithDefaultConfigSecurityHandler).Methods("GET", "HEAD")
+	e2EcontrollerWithOneSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithOneSecurityRoute := withBasePath(basePath, "/e2e/with-one-security")
+	e2EcontrollerWithOneSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"WithOneSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithOneSecurityRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "WithOneSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithOneSecurity")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("x-test-scopes")
+		_, isheaderParamExists := req.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			w.Header().Set("x-RunValidatorExtension", "WithOneSecurity")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "WithOneSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "WithOneSecurity")
+			}
+		}()
+		value, opError := controller.WithOneSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "WithOneSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithOneSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithOneSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithOneSecurityErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "WithOneSecurity")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "WithOneSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "WithOneSecurity")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "WithOneSecurity")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/with-one-security")), e2EcontrollerWithOneSecurityHandler).Methods("GET", "HEAD")
+	e2EcontrollerWithTwoSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecurityRoute := withBasePath(basePath, "/e2e/with-two-security")
+	e2EcontrollerWithTwoSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"WithTwoSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithTwoSecurityRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "WithTwoSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+								"read",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithTwoSecurity")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("x-test-scopes")
+		_, isheaderParamExists := req.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			w.Header().Set("x-RunValidatorExtension", "WithTwoSecurity")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "WithTwoSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "WithTwoSecurity")
+			}
+		}()
+		value, opError := controller.WithTwoSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "WithTwoSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithTwoSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithTwoSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecurityErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "WithTwoSecurity")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "WithTwoSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "WithTwoSecurity")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "WithTwoSecurity")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/with-two-security")), e2EcontrollerWithTwoSecurityHandler).Methods("GET", "HEAD")
+	e2EcontrollerWithAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithAdvancedSecurityRoute := withBasePath(basePath, "/e2e/with-advanced-security")
+	e2EcontrollerWithAdvancedSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"WithAdvancedSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithAdvancedSecurityRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "WithAdvancedSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName3",
+							Scopes: []string{
+								"admin",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithAdvancedSecurity")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var headerParamRawPtr *string = nil
+		headerParamRaw := req.Header.Get("x-test-scopes")
+		_, isheaderParamExists := req.Header["x-test-scopes"]
+		if !isheaderParamExists {
+			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
+			headerValues := req.Header.Values("x-test-scopes")
+			isheaderParamExists = len(headerValues) > 0
+		}
+		if isheaderParamExists {
+			headerParam := headerParamRaw
+			headerParamRawPtr = &headerParam
+		}
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			fieldName := "headerParam"
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithAdvancedSecurity", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			w.Header().Set("x-RunValidatorExtension", "WithAdvancedSecurity")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "WithAdvancedSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "WithAdvancedSecurity")
+			}
+		}()
+		value, opError := controller.WithAdvancedSecurity(*headerParamRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "WithAdvancedSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithAdvancedSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithAdvancedSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithAdvancedSecurityErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "WithAdvancedSecurity")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "WithAdvancedSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "WithAdvancedSecurity")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "WithAdvancedSecurity")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/with-advanced-security")), e2EcontrollerWithAdvancedSecurityHandler).Methods("GET", "HEAD")
+	e2EcontrollerWithPrincipalErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalRoute := withBasePath(basePath, "/e2e/with-principal")
+	e2EcontrollerWithPrincipalHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"WithPrincipal",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithPrincipalRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "WithPrincipal")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithPrincipal")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(req))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			handleAuthorizationError(w, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipal")
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "WithPrincipal")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "WithPrincipal")
+			}
+		}()
+		value, opError := controller.WithPrincipal(principalRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "WithPrincipal")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithPrincipal'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithPrincipal",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "WithPrincipal")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "WithPrincipal")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "WithPrincipal")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "WithPrincipal")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/with-principal")), e2EcontrollerWithPrincipalHandler).Methods("GET", "HEAD")
+	e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes := []int{}
+	e2EcontrollerWithPrincipalAdvancedSecurityRoute := withBasePath(basePath, "/e2e/with-principal-advanced-security")
+	e2EcontrollerWithPrincipalAdvancedSecurityHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"WithPrincipalAdvancedSecurity",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithPrincipalAdvancedSecurityRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "WithPrincipalAdvancedSecurity")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"other",
+							},
+						},
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"write",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName3",
+							Scopes: []string{
+								"admin",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "WithPrincipalAdvancedSecurity")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		principalRawPtr := getPrincipal[Param6principal.E2EPrincipal](getRequestContext(req))
+		if principalRawPtr == nil {
+			// The route's security handlers did not attach a principal via WithPrincipal
+			handleAuthorizationError(w, &runtime.SecurityError{
+				Message:    "The request is missing an authenticated principal",
+				StatusCode: http.StatusUnauthorized,
+			}, "WithPrincipalAdvancedSecurity")
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "WithPrincipalAdvancedSecurity")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "WithPrincipalAdvancedSecurity")
+			}
+		}()
+		value, opError := controller.WithPrincipalAdvancedSecurity(principalRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "WithPrincipalAdvancedSecurity")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'WithPrincipalAdvancedSecurity'",
+				Status:     statusCode,
+				Instance:   "/controller/error/WithPrincipalAdvancedSecurity",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithPrincipalAdvancedSecurityErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "WithPrincipalAdvancedSecurity")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "WithPrincipalAdvancedSecurity")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "WithPrincipalAdvancedSecurity")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "WithPrincipalAdvancedSecurity")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/with-principal-advanced-security")), e2EcontrollerWithPrincipalAdvancedSecurityHandler).Methods("GET", "HEAD")
+	e2EcontrollerPublicRouteErrorStatusCodes := []int{}
+	e2EcontrollerPublicRouteRoute := withBasePath(basePath, "/e2e/public")
+	e2EcontrollerPublicRouteHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"PublicRoute",
+			"E2EController",
+			"GET",
+			e2EcontrollerPublicRouteRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "PublicRoute")
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "PublicRoute")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "PublicRoute")
+			}
+		}()
+		value, opError := controller.PublicRoute()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "PublicRoute")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PublicRoute'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PublicRoute",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPublicRouteErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "PublicRoute")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "PublicRoute")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "PublicRoute")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "PublicRoute")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/public")), e2EcontrollerPublicRouteHandler).Methods("GET", "HEAD")
+	e2EcontrollerHeadCheckErrorStatusCodes := []int{}
+	e2EcontrollerHeadCheckRoute := withBasePath(basePath, "/e2e/head-check")
+	e2EcontrollerHeadCheckHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"HeadCheck",
+			"E2EController",
+			"HEAD",
+			e2EcontrollerHeadCheckRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "HeadCheck")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "HeadCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "HeadCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "HeadCheck")
+			}
+		}()
+		opError := controller.HeadCheck()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "HeadCheck")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'HeadCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/HeadCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerHeadCheckErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "HeadCheck")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "HeadCheck")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "HeadCheck")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "HeadCheck")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/head-check")), e2EcontrollerHeadCheckHandler).Methods("HEAD")
+	e2EcontrollerOptionsCheckErrorStatusCodes := []int{}
+	e2EcontrollerOptionsCheckRoute := withBasePath(basePath, "/e2e/options-check")
+	e2EcontrollerOptionsCheckHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"OptionsCheck",
+			"E2EController",
+			"OPTIONS",
+			e2EcontrollerOptionsCheckRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "OptionsCheck")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "OptionsCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "OptionsCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "OptionsCheck")
+			}
+		}()
+		value, opError := controller.OptionsCheck()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "OptionsCheck")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'OptionsCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/OptionsCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerOptionsCheckErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "OptionsCheck")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "OptionsCheck")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "OptionsCheck")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "OptionsCheck")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/options-check")), e2EcontrollerOptionsCheckHandler).Methods("OPTIONS")
+	e2EcontrollerTraceCheckErrorStatusCodes := []int{}
+	e2EcontrollerTraceCheckRoute := withBasePath(basePath, "/e2e/trace-check")
+	e2EcontrollerTraceCheckHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TraceCheck",
+			"E2EController",
+			"TRACE",
+			e2EcontrollerTraceCheckRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "TraceCheck")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TraceCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "TraceCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TraceCheck")
+			}
+		}()
+		value, opError := controller.TraceCheck()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "TraceCheck")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TraceCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TraceCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTraceCheckErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "TraceCheck")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "TraceCheck")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "TraceCheck")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "TraceCheck")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/trace-check")), e2EcontrollerTraceCheckHandler).Methods("TRACE")
+	e2EcontrollerPurgeCheckErrorStatusCodes := []int{}
+	e2EcontrollerPurgeCheckRoute := withBasePath(basePath, "/e2e/purge-check")
+	e2EcontrollerPurgeCheckHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"PurgeCheck",
+			"E2EController",
+			"PURGE",
+			e2EcontrollerPurgeCheckRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "PurgeCheck")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "PurgeCheck")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "PurgeCheck")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "PurgeCheck")
+			}
+		}()
+		value, opError := controller.PurgeCheck()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "PurgeCheck")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'PurgeCheck'",
+				Status:     statusCode,
+				Instance:   "/controller/error/PurgeCheck",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPurgeCheckErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "PurgeCheck")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "PurgeCheck")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "PurgeCheck")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "PurgeCheck")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/purge-check")), e2EcontrollerPurgeCheckHandler).Methods("PURGE")
+	e2EcontrollerVersionedV1ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV1Route := withBasePath(basePath, "/e2e/versioned")
+	e2EcontrollerVersionedV1Handler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"VersionedV1",
+			"E2EController",
+			"GET",
+			e2EcontrollerVersionedV1Route,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "VersionedV1")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "VersionedV1")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "VersionedV1")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "VersionedV1")
+			}
+		}()
+		value, opError := controller.VersionedV1()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "VersionedV1")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV1'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV1",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV1ErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "VersionedV1")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "VersionedV1")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "VersionedV1")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "VersionedV1")
+	}
+	e2EcontrollerVersionedV1Versions := []int{1}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV1Versions, e2EcontrollerVersionedV1Handler)
+	registerVersionedRoute(engine, basePath, "HEAD", "/e2e/versioned", e2EcontrollerVersionedV1Versions, e2EcontrollerVersionedV1Handler)
+	e2EcontrollerVersionedV2ErrorStatusCodes := []int{}
+	e2EcontrollerVersionedV2Route := withBasePath(basePath, "/e2e/versioned")
+	e2EcontrollerVersionedV2Handler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"VersionedV2",
+			"E2EController",
+			"GET",
+			e2EcontrollerVersionedV2Route,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "VersionedV2")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "VersionedV2")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "VersionedV2")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "VersionedV2")
+			}
+		}()
+		value, opError := controller.VersionedV2()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "VersionedV2")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'VersionedV2'",
+				Status:     statusCode,
+				Instance:   "/controller/error/VersionedV2",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerVersionedV2ErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "VersionedV2")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "VersionedV2")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "VersionedV2")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "VersionedV2")
+	}
+	e2EcontrollerVersionedV2Versions := []int{2}
+	registerVersionedRoute(engine, basePath, "GET", "/e2e/versioned", e2EcontrollerVersionedV2Versions, e2EcontrollerVersionedV2Handler)
+	registerVersionedRoute(engine, basePath, "HEAD", "/e2e/versioned", e2EcontrollerVersionedV2Versions, e2EcontrollerVersionedV2Handler)
+	e2EcontrollerRateLimitedErrorStatusCodes := []int{}
+	e2EcontrollerRateLimitedRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "RateLimited")
+	e2EcontrollerRateLimitedRoute := withBasePath(basePath, "/e2e/rate-limited")
+	e2EcontrollerRateLimitedHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"RateLimited",
+			"E2EController",
+			"GET",
+			e2EcontrollerRateLimitedRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "RateLimited")
+		if !enforceRateLimit(w, req, "RateLimited", e2EcontrollerRateLimitedRateLimit) {
+			return
+		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "RateLimited")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "RateLimited")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "RateLimited")
+			}
+		}()
+		value, opError := controller.RateLimited()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "RateLimited")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'RateLimited'",
+				Status:     statusCode,
+				Instance:   "/controller/error/RateLimited",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerRateLimitedErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "RateLimited")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "RateLimited")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "RateLimited")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "RateLimited")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/rate-limited")), e2EcontrollerRateLimitedHandler).Methods("GET", "HEAD")
+	e2EcontrollerTimedOutErrorStatusCodes := []int{}
+	e2EcontrollerTimedOutTimeout := newOperationTimeout("50ms")
+	e2EcontrollerTimedOutRoute := withBasePath(basePath, "/e2e/timeout")
+	e2EcontrollerTimedOutHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TimedOut",
+			"E2EController",
+			"GET",
+			e2EcontrollerTimedOutRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "TimedOut")
+		cancelTimeout := applyOperationTimeout(req, e2EcontrollerTimedOutTimeout)
+		defer cancelTimeout()
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "TimedOut")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "TimedOut")
+		if isDeadlineExceeded(req) {
+			handleDeadlineExceeded(w, "TimedOut", http.StatusServiceUnavailable)
+			return
+		}
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TimedOut")
+			}
+		}()
+		value, opError := controller.TimedOut(getRequestContext(req))
+		telemetry.endPhase()
+		if isDeadlineExceeded(req) {
+			handleDeadlineExceeded(w, "TimedOut", http.StatusGatewayTimeout)
+			return
+		}
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "TimedOut")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTimedOutErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'TimedOut'",
+				Status:     statusCode,
+				Instance:   "/controller/error/TimedOut",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTimedOutErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "TimedOut")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "TimedOut")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "TimedOut")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "TimedOut")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/timeout")), e2EcontrollerTimedOutHandler).Methods("GET", "HEAD")
+	e2EcontrollerMaxBodySizeErrorStatusCodes := []int{}
+	e2EcontrollerMaxBodySizeRoute := withBasePath(basePath, "/e2e/max-body-size")
+	e2EcontrollerMaxBodySizeHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"MaxBodySize",
+			"E2EController",
+			"POST",
+			e2EcontrollerMaxBodySizeRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "MaxBodySize")
+		if !enforceMaxBodySize(w, req, "MaxBodySize", 64) {
+			return
+		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "MaxBodySize")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		var conversionErr error
+		var dataRawPtr *Param8data.ObjectWithByteSlice = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
+		if conversionErr != nil {
+			// Middlewares onInputValidationMiddlewares section
+			for _, middleware := range onInputValidationMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, conversionErr)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := runtime.Rfc7807Error{
+				Type: http.StatusText(http.StatusUnprocessableEntity),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "MaxBodySize",
+						"parameter": "data",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
+				),
+				Status:   http.StatusUnprocessableEntity,
+				Instance: "/validation/error/MaxBodySize",
+			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "MaxBodySize")
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
+			return
+		}
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "MaxBodySize")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "MaxBodySize")
+			}
+		}()
+		value, opError := controller.MaxBodySize(*dataRawPtr)
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "MaxBodySize")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'MaxBodySize'",
+				Status:     statusCode,
+				Instance:   "/controller/error/MaxBodySize",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerMaxBodySizeErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "MaxBodySize")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "MaxBodySize")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "MaxBodySize")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "MaxBodySize")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/max-body-size")), e2EcontrollerMaxBodySizeHandler).Methods("POST")
+	e2EcontrollerIdempotentErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentRoute := withBasePath(basePath, "/e2e/idempotent")
+	e2EcontrollerIdempotentHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"Idempotent",
+			"E2EController",
+			"POST",
+			e2EcontrollerIdempotentRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "Idempotent")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "Idempotent")
+			return
+		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed := hashRequestBody(w, req, "Idempotent", 0)
+		if !isBodyHashed {
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -2332,14 +6609,28 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		w.Header().Set("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBodyPtr")
-		value, opError := controller.PostWithAllParamsWithBodyPtr(queryParamRawPtr, headerParamRawPtr, theBodyRawPtr)
+		w.Header().Set("x-BeforeOperationRoutesExtension", "Idempotent")
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		idempotentWriter, completeIdempotentRequest := beginIdempotentRequest(w, req, "Idempotent", idempotentRequestHash, e2EcontrollerIdempotentIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return
+		}
+		w = idempotentWriter
+		defer completeIdempotentRequest()
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "Idempotent")
+			}
+		}()
+		value, opError := controller.Idempotent()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
-		w.Header().Set("x-ResponseHeadersExtension", "PostWithAllParamsWithBodyPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		w.Header().Set("x-ResponseHeadersExtension", "Idempotent")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2352,46 +6643,18 @@ func RegisterRoutes(engine *mux.Router) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyPtr'",
+				Detail:     "Encountered an error during operation 'Idempotent'",
 				Status:     statusCode,
-				Instance:   "/controller/error/PostWithAllParamsWithBodyPtr",
+				Instance:   "/controller/error/Idempotent",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			w.Header().Set("x-JsonErrorResponseExtension", "PostWithAllParamsWithBodyPtr")
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "Idempotent")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		w.Header().Set("x-JsonResponseExtension", "PostWithAllParamsWithBodyPtr")
-		var outputValidationErr error
-		if value == nil {
-			outputValidationErr = fmt.Errorf("Response payload is nil")
-		} else {
-			outputValidationErr = validateDataRecursive(value, "")
-		}
-		if outputValidationErr != nil {
-			// Middlewares onOutputValidationMiddlewares section
-			for _, middleware := range onOutputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onOutputValidationMiddlewares section
-			outputValidationStatusCode := http.StatusInternalServerError
-			outputValidationRfc7807Error := runtime.Rfc7807Error{
-				Type:       http.StatusText(outputValidationStatusCode),
-				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyPtr'",
-				Status:     outputValidationStatusCode,
-				Instance:   "/controller/error/PostWithAllParamsWithBodyPtr",
-				Extensions: map[string]string{},
-			}
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(outputValidationStatusCode)
-			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
-			return
-		}
+		w.Header().Set("x-JsonResponseExtension", "Idempotent")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -2401,14 +6664,31 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		w.Header().Set("x-AfterOperationRoutesExtension", "PostWithAllParamsWithBodyPtr")
+		w.Header().Set("x-AfterOperationRoutesExtension", "Idempotent")
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
-		w.Header().Set("x-RouteEndRoutesExtension", "PostWithAllParamsWithBodyPtr")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/post-with-all-params-body-required-ptr"), func(w http.ResponseWriter, req *http.Request) {
-		w.Header().Set("x-RouteStartRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		w.Header().Set("x-RouteEndRoutesExtension", "Idempotent")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/idempotent")), e2EcontrollerIdempotentHandler).Methods("POST")
+	e2EcontrollerIdempotentWithBodyErrorStatusCodes := []int{}
+	e2EcontrollerIdempotentWithBodyIdempotencyTtl := newIdempotencyTtl("1h")
+	e2EcontrollerIdempotentWithBodyRoute := withBasePath(basePath, "/e2e/idempotent-with-body")
+	e2EcontrollerIdempotentWithBodyHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"IdempotentWithBody",
+			"E2EController",
+			"POST",
+			e2EcontrollerIdempotentWithBodyRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "IdempotentWithBody")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -2426,14 +6706,20 @@ func RegisterRoutes(engine *mux.Router) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(w, authErr, "PostWithAllParamsWithBodyRequiredPtr")
+			handleAuthorizationError(w, authErr, "IdempotentWithBody")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		// The body is hashed before it is consumed, so that reusing an 'Idempotency-Key' with a different body may be rejected
+		idempotentRequestHash, isBodyHashed := hashRequestBody(w, req, "IdempotentWithBody", 0)
+		if !isBodyHashed {
+			return
+		}
 		var conversionErr error
-		var theBodyRawPtr *Param5theBody.BodyInfo = nil
-		conversionErr = bindAndValidateBody(req, "application/json", "required", &theBodyRawPtr)
+		var dataRawPtr *Param5data.BodyInfo = nil
+		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
 			for _, middleware := range onInputValidationMiddlewares {
@@ -2444,22 +6730,29 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'PostWithAllParamsWithBodyRequiredPtr' but body parameter '%s' did not pass validation of '%s' - %s",
-					"theBody",
-					"BodyInfo",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "IdempotentWithBody",
+						"parameter": "data",
+						"type":      "BodyInfo",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
-				Instance: "/validation/error/PostWithAllParamsWithBodyRequiredPtr",
+				Instance: "/validation/error/IdempotentWithBody",
 			}
-			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
+			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "IdempotentWithBody")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -2469,14 +6762,28 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		w.Header().Set("x-BeforeOperationRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
-		value, opError := controller.PostWithAllParamsWithBodyRequiredPtr(theBodyRawPtr)
+		w.Header().Set("x-BeforeOperationRoutesExtension", "IdempotentWithBody")
+		// Requests are deduplicated once validated, so that invalid ones may be corrected and retried using the same key
+		idempotentWriter, completeIdempotentRequest := beginIdempotentRequest(w, req, "IdempotentWithBody", idempotentRequestHash, e2EcontrollerIdempotentWithBodyIdempotencyTtl)
+		if completeIdempotentRequest == nil {
+			return
+		}
+		w = idempotentWriter
+		defer completeIdempotentRequest()
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "IdempotentWithBody")
+			}
+		}()
+		value, opError := controller.IdempotentWithBody(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
-		w.Header().Set("x-ResponseHeadersExtension", "PostWithAllParamsWithBodyRequiredPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		w.Header().Set("x-ResponseHeadersExtension", "IdempotentWithBody")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2489,46 +6796,18 @@ func RegisterRoutes(engine *mux.Router) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyRequiredPtr'",
+				Detail:     "Encountered an error during operation 'IdempotentWithBody'",
 				Status:     statusCode,
-				Instance:   "/controller/error/PostWithAllParamsWithBodyRequiredPtr",
+				Instance:   "/controller/error/IdempotentWithBody",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			w.Header().Set("x-JsonErrorResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
+			applyErrorMapping(&stdError, opError, e2EcontrollerIdempotentWithBodyErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "IdempotentWithBody")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		w.Header().Set("x-JsonResponseExtension", "PostWithAllParamsWithBodyRequiredPtr")
-		var outputValidationErr error
-		if value == nil {
-			outputValidationErr = fmt.Errorf("Response payload is nil")
-		} else {
-			outputValidationErr = validateDataRecursive(value, "")
-		}
-		if outputValidationErr != nil {
-			// Middlewares onOutputValidationMiddlewares section
-			for _, middleware := range onOutputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, outputValidationErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onOutputValidationMiddlewares section
-			outputValidationStatusCode := http.StatusInternalServerError
-			outputValidationRfc7807Error := runtime.Rfc7807Error{
-				Type:       http.StatusText(outputValidationStatusCode),
-				Detail:     "Encountered an error during operation 'PostWithAllParamsWithBodyRequiredPtr'",
-				Status:     outputValidationStatusCode,
-				Instance:   "/controller/error/PostWithAllParamsWithBodyRequiredPtr",
-				Extensions: map[string]string{},
-			}
-			w.Header().Set("Content-Type", "application/json")
-			w.WriteHeader(outputValidationStatusCode)
-			json.NewEncoder(w).Encode(outputValidationRfc7807Error)
-			return
-		}
+		w.Header().Set("x-JsonResponseExtension", "IdempotentWithBody")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -2538,14 +6817,30 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		w.Header().Set("x-AfterOperationRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
+		w.Header().Set("x-AfterOperationRoutesExtension", "IdempotentWithBody")
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
-		w.Header().Set("x-RouteEndRoutesExtension", "PostWithAllParamsWithBodyRequiredPtr")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/get-header-start-with-letter"), func(w http.ResponseWriter, req *http.Request) {
-		w.Header().Set("x-RouteStartRoutesExtension", "GetHeaderStartWithLetter")
+		w.Header().Set("x-RouteEndRoutesExtension", "IdempotentWithBody")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/idempotent-with-body")), e2EcontrollerIdempotentWithBodyHandler).Methods("POST")
+	e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes := []int{}
+	e2EcontrollerWithTwoSecuritySameMethodRoute := withBasePath(basePath, "/e2e/with-two-security-same-method")
+	e2EcontrollerWithTwoSecuritySameMethodHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"WithTwoSecuritySameMethod",
+			"E2EController",
+			"GET",
+			e2EcontrollerWithTwoSecuritySameMethodRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "WithTwoSecuritySameMethod")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -2553,9 +6848,21 @@ func RegisterRoutes(engine *mux.Router) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName2",
+							SchemaName: "securitySchemaName",
 							Scopes: []string{
-								"config",
+								"other",
+							},
+						},
+					},
+				},
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName",
+							Scopes: []string{
+								"write",
+								"read",
 							},
 						},
 					},
@@ -2563,24 +6870,25 @@ func RegisterRoutes(engine *mux.Router) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(w, authErr, "GetHeaderStartWithLetter")
+			handleAuthorizationError(w, authErr, "WithTwoSecuritySameMethod")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var headerParamRawPtr *string = nil
-		headerParamRaw := req.Header.Get("headerParam")
-		_, isheaderParamExists := req.Header["headerParam"]
+		headerParamRaw := req.Header.Get("x-test-scopes")
+		_, isheaderParamExists := req.Header["x-test-scopes"]
 		if !isheaderParamExists {
 			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("headerParam")
+			headerValues := req.Header.Values("x-test-scopes")
 			isheaderParamExists = len(headerValues) > 0
 		}
 		if isheaderParamExists {
 			headerParam := headerParamRaw
 			headerParamRawPtr = &headerParam
 		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required,validate_starts_with_letter"); validatorErr != nil {
+		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
 			// Middlewares onInputValidationMiddlewares section
 			for _, middleware := range onInputValidationMiddlewares {
 				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
@@ -2591,12 +6899,15 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "GetHeaderStartWithLetter", fieldName)
-			w.Header().Set("x-RunValidatorExtension", "GetHeaderStartWithLetter")
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/x-test-scopes", languages)
+			w.Header().Set("x-RunValidatorExtension", "WithTwoSecuritySameMethod")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -2606,14 +6917,21 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		w.Header().Set("x-BeforeOperationRoutesExtension", "GetHeaderStartWithLetter")
-		value, opError := controller.GetHeaderStartWithLetter(*headerParamRawPtr)
+		w.Header().Set("x-BeforeOperationRoutesExtension", "WithTwoSecuritySameMethod")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "WithTwoSecuritySameMethod")
+			}
+		}()
+		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
-		w.Header().Set("x-ResponseHeadersExtension", "GetHeaderStartWithLetter")
-		statusCode := getStatusCode(&controller, true, opError)
+		w.Header().Set("x-ResponseHeadersExtension", "WithTwoSecuritySameMethod")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2626,17 +6944,18 @@ func RegisterRoutes(engine *mux.Router) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'GetHeaderStartWithLetter'",
+				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
 				Status:     statusCode,
-				Instance:   "/controller/error/GetHeaderStartWithLetter",
+				Instance:   "/controller/error/WithTwoSecuritySameMethod",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			w.Header().Set("x-JsonErrorResponseExtension", "GetHeaderStartWithLetter")
+			applyErrorMapping(&stdError, opError, e2EcontrollerWithTwoSecuritySameMethodErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "WithTwoSecuritySameMethod")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		w.Header().Set("x-JsonResponseExtension", "GetHeaderStartWithLetter")
+		w.Header().Set("x-JsonResponseExtension", "WithTwoSecuritySameMethod")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -2646,14 +6965,30 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		w.Header().Set("x-AfterOperationRoutesExtension", "GetHeaderStartWithLetter")
+		w.Header().Set("x-AfterOperationRoutesExtension", "WithTwoSecuritySameMethod")
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
-		w.Header().Set("x-RouteEndRoutesExtension", "GetHeaderStartWithLetter")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/with-default-config-security"), func(w http.ResponseWriter, req *http.Request) {
-		w.Header().Set("x-RouteStartRoutesExtension", "WithDefaultConfigSecurity")
+		w.Header().Set("x-RouteEndRoutesExtension", "WithTwoSecuritySameMethod")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/with-two-security-same-method")), e2EcontrollerWithTwoSecuritySameMethodHandler).Methods("GET", "HEAD")
+	e2EcontrollerDefaultErrorErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorRoute := withBasePath(basePath, "/e2e/default-error")
+	e2EcontrollerDefaultErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"DefaultError",
+			"E2EController",
+			"GET",
+			e2EcontrollerDefaultErrorRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "DefaultError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -2671,40 +7006,116 @@ func RegisterRoutes(engine *mux.Router) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(w, authErr, "WithDefaultConfigSecurity")
+			handleAuthorizationError(w, authErr, "DefaultError")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := req.Header.Get("x-test-scopes")
-		_, isheaderParamExists := req.Header["x-test-scopes"]
-		if !isheaderParamExists {
-			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("x-test-scopes")
-			isheaderParamExists = len(headerValues) > 0
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
 		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "DefaultError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "DefaultError")
+			}
+		}()
+		opError := controller.DefaultError()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
 		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "DefaultError")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
 				setRequestContext(req, middlewareCtx)
 				if !continueOperation {
 					return
 				}
 			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithDefaultConfigSecurity", fieldName)
-			w.Header().Set("x-RunValidatorExtension", "WithDefaultConfigSecurity")
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'DefaultError'",
+				Status:     statusCode,
+				Instance:   "/controller/error/DefaultError",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "DefaultError")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "DefaultError")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "DefaultError")
+		w.WriteHeader(statusCode)
+		w.Header().Set("x-RouteEndRoutesExtension", "DefaultError")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/default-error")), e2EcontrollerDefaultErrorHandler).Methods("GET", "HEAD")
+	e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes := []int{}
+	e2EcontrollerDefaultErrorWithPayloadRoute := withBasePath(basePath, "/e2e/default-error-with-payload")
+	e2EcontrollerDefaultErrorWithPayloadHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"DefaultErrorWithPayload",
+			"E2EController",
+			"GET",
+			e2EcontrollerDefaultErrorWithPayloadRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "DefaultErrorWithPayload")
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "DefaultErrorWithPayload")
 			return
 		}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -2714,14 +7125,21 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		w.Header().Set("x-BeforeOperationRoutesExtension", "WithDefaultConfigSecurity")
-		value, opError := controller.WithDefaultConfigSecurity(*headerParamRawPtr)
+		w.Header().Set("x-BeforeOperationRoutesExtension", "DefaultErrorWithPayload")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "DefaultErrorWithPayload")
+			}
+		}()
+		value, opError := controller.DefaultErrorWithPayload()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
-		w.Header().Set("x-ResponseHeadersExtension", "WithDefaultConfigSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		w.Header().Set("x-ResponseHeadersExtension", "DefaultErrorWithPayload")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2734,17 +7152,18 @@ func RegisterRoutes(engine *mux.Router) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithDefaultConfigSecurity'",
+				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithDefaultConfigSecurity",
+				Instance:   "/controller/error/DefaultErrorWithPayload",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			w.Header().Set("x-JsonErrorResponseExtension", "WithDefaultConfigSecurity")
+			applyErrorMapping(&stdError, opError, e2EcontrollerDefaultErrorWithPayloadErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "DefaultErrorWithPayload")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		w.Header().Set("x-JsonResponseExtension", "WithDefaultConfigSecurity")
+		w.Header().Set("x-JsonResponseExtension", "DefaultErrorWithPayload")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -2754,14 +7173,30 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		w.Header().Set("x-AfterOperationRoutesExtension", "WithDefaultConfigSecurity")
+		w.Header().Set("x-AfterOperationRoutesExtension", "DefaultErrorWithPayload")
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
-		w.Header().Set("x-RouteEndRoutesExtension", "WithDefaultConfigSecurity")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/with-one-security"), func(w http.ResponseWriter, req *http.Request) {
-		w.Header().Set("x-RouteStartRoutesExtension", "WithOneSecurity")
+		w.Header().Set("x-RouteEndRoutesExtension", "DefaultErrorWithPayload")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/default-error-with-payload")), e2EcontrollerDefaultErrorWithPayloadHandler).Methods("GET", "HEAD")
+	e2EcontrollerInjectedDependencyErrorStatusCodes := []int{}
+	e2EcontrollerInjectedDependencyRoute := withBasePath(basePath, "/e2e/injected-dependency")
+	e2EcontrollerInjectedDependencyHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"InjectedDependency",
+			"E2EController",
+			"GET",
+			e2EcontrollerInjectedDependencyRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "InjectedDependency")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -2769,9 +7204,9 @@ func RegisterRoutes(engine *mux.Router) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName",
+							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"other",
+								"config",
 							},
 						},
 					},
@@ -2779,40 +7214,13 @@ func RegisterRoutes(engine *mux.Router) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(w, authErr, "WithOneSecurity")
+			handleAuthorizationError(w, authErr, "InjectedDependency")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := req.Header.Get("x-test-scopes")
-		_, isheaderParamExists := req.Header["x-test-scopes"]
-		if !isheaderParamExists {
-			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("x-test-scopes")
-			isheaderParamExists = len(headerValues) > 0
-		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithOneSecurity", fieldName)
-			w.Header().Set("x-RunValidatorExtension", "WithOneSecurity")
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -2822,14 +7230,21 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		w.Header().Set("x-BeforeOperationRoutesExtension", "WithOneSecurity")
-		value, opError := controller.WithOneSecurity(*headerParamRawPtr)
+		w.Header().Set("x-BeforeOperationRoutesExtension", "InjectedDependency")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "InjectedDependency")
+			}
+		}()
+		value, opError := controller.InjectedDependency()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
-		w.Header().Set("x-ResponseHeadersExtension", "WithOneSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		w.Header().Set("x-ResponseHeadersExtension", "InjectedDependency")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2842,17 +7257,18 @@ func RegisterRoutes(engine *mux.Router) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithOneSecurity'",
+				Detail:     "Encountered an error during operation 'InjectedDependency'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithOneSecurity",
+				Instance:   "/controller/error/InjectedDependency",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			w.Header().Set("x-JsonErrorResponseExtension", "WithOneSecurity")
+			applyErrorMapping(&stdError, opError, e2EcontrollerInjectedDependencyErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "InjectedDependency")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		w.Header().Set("x-JsonResponseExtension", "WithOneSecurity")
+		w.Header().Set("x-JsonResponseExtension", "InjectedDependency")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -2862,36 +7278,40 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		w.Header().Set("x-AfterOperationRoutesExtension", "WithOneSecurity")
+		w.Header().Set("x-AfterOperationRoutesExtension", "InjectedDependency")
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
-		w.Header().Set("x-RouteEndRoutesExtension", "WithOneSecurity")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/with-two-security"), func(w http.ResponseWriter, req *http.Request) {
-		w.Header().Set("x-RouteStartRoutesExtension", "WithTwoSecurity")
+		w.Header().Set("x-RouteEndRoutesExtension", "InjectedDependency")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/injected-dependency")), e2EcontrollerInjectedDependencyHandler).Methods("GET", "HEAD")
+	e2EcontrollerMappedErrorErrorStatusCodes := []int{404}
+	e2EcontrollerMappedErrorRoute := withBasePath(basePath, "/e2e/mapped-error")
+	e2EcontrollerMappedErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"MappedError",
+			"E2EController",
+			"GET",
+			e2EcontrollerMappedErrorRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "MappedError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName",
-							Scopes: []string{
-								"other",
-							},
-						},
-					},
-				},
 				{
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
 							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"write",
-								"read",
+								"config",
 							},
 						},
 					},
@@ -2899,40 +7319,13 @@ func RegisterRoutes(engine *mux.Router) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(w, authErr, "WithTwoSecurity")
+			handleAuthorizationError(w, authErr, "MappedError")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := req.Header.Get("x-test-scopes")
-		_, isheaderParamExists := req.Header["x-test-scopes"]
-		if !isheaderParamExists {
-			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("x-test-scopes")
-			isheaderParamExists = len(headerValues) > 0
-		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithTwoSecurity", fieldName)
-			w.Header().Set("x-RunValidatorExtension", "WithTwoSecurity")
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -2942,14 +7335,21 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		w.Header().Set("x-BeforeOperationRoutesExtension", "WithTwoSecurity")
-		value, opError := controller.WithTwoSecurity(*headerParamRawPtr)
+		w.Header().Set("x-BeforeOperationRoutesExtension", "MappedError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "MappedError")
+			}
+		}()
+		opError := controller.MappedError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
-		w.Header().Set("x-ResponseHeadersExtension", "WithTwoSecurity")
-		statusCode := getStatusCode(&controller, true, opError)
+		w.Header().Set("x-ResponseHeadersExtension", "MappedError")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerMappedErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -2962,17 +7362,18 @@ func RegisterRoutes(engine *mux.Router) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithTwoSecurity'",
+				Detail:     "Encountered an error during operation 'MappedError'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithTwoSecurity",
+				Instance:   "/controller/error/MappedError",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			w.Header().Set("x-JsonErrorResponseExtension", "WithTwoSecurity")
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedErrorErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "MappedError")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		w.Header().Set("x-JsonResponseExtension", "WithTwoSecurity")
+		w.Header().Set("x-JsonResponseExtension", "MappedError")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -2982,14 +7383,28 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		w.Header().Set("x-AfterOperationRoutesExtension", "WithTwoSecurity")
-		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("x-AfterOperationRoutesExtension", "MappedError")
 		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		w.Header().Set("x-RouteEndRoutesExtension", "WithTwoSecurity")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/with-two-security-same-method"), func(w http.ResponseWriter, req *http.Request) {
-		w.Header().Set("x-RouteStartRoutesExtension", "WithTwoSecuritySameMethod")
+		w.Header().Set("x-RouteEndRoutesExtension", "MappedError")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/mapped-error")), e2EcontrollerMappedErrorHandler).Methods("GET", "HEAD")
+	e2EcontrollerUndeclaredMappedErrorErrorStatusCodes := []int{}
+	e2EcontrollerUndeclaredMappedErrorRoute := withBasePath(basePath, "/e2e/undeclared-mapped-error")
+	e2EcontrollerUndeclaredMappedErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"UndeclaredMappedError",
+			"E2EController",
+			"GET",
+			e2EcontrollerUndeclaredMappedErrorRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "UndeclaredMappedError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -2997,21 +7412,9 @@ func RegisterRoutes(engine *mux.Router) {
 					Relation: SecurityListRelationAnd,
 					Checks: []runtime.SecurityCheck{
 						{
-							SchemaName: "securitySchemaName",
-							Scopes: []string{
-								"other",
-							},
-						},
-					},
-				},
-				{
-					Relation: SecurityListRelationAnd,
-					Checks: []runtime.SecurityCheck{
-						{
-							SchemaName: "securitySchemaName",
+							SchemaName: "securitySchemaName2",
 							Scopes: []string{
-								"write",
-								"read",
+								"config",
 							},
 						},
 					},
@@ -3019,40 +7422,13 @@ func RegisterRoutes(engine *mux.Router) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(w, authErr, "WithTwoSecuritySameMethod")
+			handleAuthorizationError(w, authErr, "UndeclaredMappedError")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
-		var headerParamRawPtr *string = nil
-		headerParamRaw := req.Header.Get("x-test-scopes")
-		_, isheaderParamExists := req.Header["x-test-scopes"]
-		if !isheaderParamExists {
-			// In echo, the req..Header["key"] is not 100% reliable, so we need other check, but only if is was not found in the first method
-			headerValues := req.Header.Values("x-test-scopes")
-			isheaderParamExists = len(headerValues) > 0
-		}
-		if isheaderParamExists {
-			headerParam := headerParamRaw
-			headerParamRawPtr = &headerParam
-		}
-		if validatorErr := validatorInstance.Var(headerParamRawPtr, "required"); validatorErr != nil {
-			// Middlewares onInputValidationMiddlewares section
-			for _, middleware := range onInputValidationMiddlewares {
-				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, validatorErr)
-				setRequestContext(req, middlewareCtx)
-				if !continueOperation {
-					return
-				}
-			}
-			// End middlewares onInputValidationMiddlewares section
-			fieldName := "headerParam"
-			validationError := wrapValidatorError(validatorErr, "WithTwoSecuritySameMethod", fieldName)
-			w.Header().Set("x-RunValidatorExtension", "WithTwoSecuritySameMethod")
-			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
-			return
-		}
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -3062,14 +7438,21 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		w.Header().Set("x-BeforeOperationRoutesExtension", "WithTwoSecuritySameMethod")
-		value, opError := controller.WithTwoSecuritySameMethod(*headerParamRawPtr)
+		w.Header().Set("x-BeforeOperationRoutesExtension", "UndeclaredMappedError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "UndeclaredMappedError")
+			}
+		}()
+		opError := controller.UndeclaredMappedError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
-		w.Header().Set("x-ResponseHeadersExtension", "WithTwoSecuritySameMethod")
-		statusCode := getStatusCode(&controller, true, opError)
+		w.Header().Set("x-ResponseHeadersExtension", "UndeclaredMappedError")
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3082,17 +7465,18 @@ func RegisterRoutes(engine *mux.Router) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'WithTwoSecuritySameMethod'",
+				Detail:     "Encountered an error during operation 'UndeclaredMappedError'",
 				Status:     statusCode,
-				Instance:   "/controller/error/WithTwoSecuritySameMethod",
+				Instance:   "/controller/error/UndeclaredMappedError",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			w.Header().Set("x-JsonErrorResponseExtension", "WithTwoSecuritySameMethod")
+			applyErrorMapping(&stdError, opError, e2EcontrollerUndeclaredMappedErrorErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "UndeclaredMappedError")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		w.Header().Set("x-JsonResponseExtension", "WithTwoSecuritySameMethod")
+		w.Header().Set("x-JsonResponseExtension", "UndeclaredMappedError")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -3102,14 +7486,28 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		w.Header().Set("x-AfterOperationRoutesExtension", "WithTwoSecuritySameMethod")
-		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("x-AfterOperationRoutesExtension", "UndeclaredMappedError")
 		w.WriteHeader(statusCode)
-		json.NewEncoder(w).Encode(value)
-		w.Header().Set("x-RouteEndRoutesExtension", "WithTwoSecuritySameMethod")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/default-error"), func(w http.ResponseWriter, req *http.Request) {
-		w.Header().Set("x-RouteStartRoutesExtension", "DefaultError")
+		w.Header().Set("x-RouteEndRoutesExtension", "UndeclaredMappedError")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/undeclared-mapped-error")), e2EcontrollerUndeclaredMappedErrorHandler).Methods("GET", "HEAD")
+	e2EcontrollerMappedTypedErrorErrorStatusCodes := []int{409}
+	e2EcontrollerMappedTypedErrorRoute := withBasePath(basePath, "/e2e/mapped-typed-error")
+	e2EcontrollerMappedTypedErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"MappedTypedError",
+			"E2EController",
+			"GET",
+			e2EcontrollerMappedTypedErrorRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "MappedTypedError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -3127,11 +7525,13 @@ func RegisterRoutes(engine *mux.Router) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(w, authErr, "DefaultError")
+			handleAuthorizationError(w, authErr, "MappedTypedError")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -3141,14 +7541,21 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		w.Header().Set("x-BeforeOperationRoutesExtension", "DefaultError")
-		opError := controller.DefaultError()
+		w.Header().Set("x-BeforeOperationRoutesExtension", "MappedTypedError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "MappedTypedError")
+			}
+		}()
+		value, opError := controller.MappedTypedError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
-		w.Header().Set("x-ResponseHeadersExtension", "DefaultError")
-		statusCode := getStatusCode(&controller, false, opError)
+		w.Header().Set("x-ResponseHeadersExtension", "MappedTypedError")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3161,17 +7568,18 @@ func RegisterRoutes(engine *mux.Router) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DefaultError'",
+				Detail:     "Encountered an error during operation 'MappedTypedError'",
 				Status:     statusCode,
-				Instance:   "/controller/error/DefaultError",
+				Instance:   "/controller/error/MappedTypedError",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			w.Header().Set("x-JsonErrorResponseExtension", "DefaultError")
+			applyErrorMapping(&stdError, opError, e2EcontrollerMappedTypedErrorErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "MappedTypedError")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		w.Header().Set("x-JsonResponseExtension", "DefaultError")
+		w.Header().Set("x-JsonResponseExtension", "MappedTypedError")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -3181,12 +7589,30 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		w.Header().Set("x-AfterOperationRoutesExtension", "DefaultError")
+		w.Header().Set("x-AfterOperationRoutesExtension", "MappedTypedError")
+		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(statusCode)
-		w.Header().Set("x-RouteEndRoutesExtension", "DefaultError")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/default-error-with-payload"), func(w http.ResponseWriter, req *http.Request) {
-		w.Header().Set("x-RouteStartRoutesExtension", "DefaultErrorWithPayload")
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "MappedTypedError")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/mapped-typed-error")), e2EcontrollerMappedTypedErrorHandler).Methods("GET", "HEAD")
+	e2EcontrollerPanicErrorStatusCodes := []int{}
+	e2EcontrollerPanicRoute := withBasePath(basePath, "/e2e/panic")
+	e2EcontrollerPanicHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"Panic",
+			"E2EController",
+			"GET",
+			e2EcontrollerPanicRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "Panic")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -3204,11 +7630,13 @@ func RegisterRoutes(engine *mux.Router) {
 			},
 		)
 		if authErr != nil {
-			handleAuthorizationError(w, authErr, "DefaultErrorWithPayload")
+			handleAuthorizationError(w, authErr, "Panic")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -3218,14 +7646,21 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares beforeOperationMiddlewares section
-		w.Header().Set("x-BeforeOperationRoutesExtension", "DefaultErrorWithPayload")
-		value, opError := controller.DefaultErrorWithPayload()
+		w.Header().Set("x-BeforeOperationRoutesExtension", "Panic")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "Panic")
+			}
+		}()
+		value, opError := controller.Panic()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
-		w.Header().Set("x-ResponseHeadersExtension", "DefaultErrorWithPayload")
-		statusCode := getStatusCode(&controller, true, opError)
+		w.Header().Set("x-ResponseHeadersExtension", "Panic")
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerPanicErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3238,17 +7673,18 @@ func RegisterRoutes(engine *mux.Router) {
 			// End middlewares onErrorMiddlewares section
 			stdError := runtime.Rfc7807Error{
 				Type:       http.StatusText(statusCode),
-				Detail:     "Encountered an error during operation 'DefaultErrorWithPayload'",
+				Detail:     "Encountered an error during operation 'Panic'",
 				Status:     statusCode,
-				Instance:   "/controller/error/DefaultErrorWithPayload",
+				Instance:   "/controller/error/Panic",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
-			w.Header().Set("x-JsonErrorResponseExtension", "DefaultErrorWithPayload")
+			applyErrorMapping(&stdError, opError, e2EcontrollerPanicErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "Panic")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
 			return
 		}
-		w.Header().Set("x-JsonResponseExtension", "DefaultErrorWithPayload")
+		w.Header().Set("x-JsonResponseExtension", "Panic")
 		// Middlewares afterOperationSuccessMiddlewares section
 		for _, middleware := range afterOperationSuccessMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -3258,14 +7694,30 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 		}
 		// End middlewares afterOperationSuccessMiddlewares section
-		w.Header().Set("x-AfterOperationRoutesExtension", "DefaultErrorWithPayload")
+		w.Header().Set("x-AfterOperationRoutesExtension", "Panic")
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
-		w.Header().Set("x-RouteEndRoutesExtension", "DefaultErrorWithPayload")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/custom-error"), func(w http.ResponseWriter, req *http.Request) {
+		w.Header().Set("x-RouteEndRoutesExtension", "Panic")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/panic")), e2EcontrollerPanicHandler).Methods("GET", "HEAD")
+	e2EcontrollerCustomErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomErrorRoute := withBasePath(basePath, "/e2e/custom-error")
+	e2EcontrollerCustomErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"CustomError",
+			"E2EController",
+			"GET",
+			e2EcontrollerCustomErrorRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "CustomError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -3286,8 +7738,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "CustomError")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -3298,14 +7752,21 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "CustomError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "CustomError")
+			}
+		}()
 		opError := controller.CustomError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "CustomError")
-		statusCode := getStatusCode(&controller, false, opError)
-		emptyErr := Response6CustomError.CustomError{}
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomErrorErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
 		if opError != emptyErr {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3333,9 +7794,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.Header().Set("x-AfterOperationRoutesExtension", "CustomError")
 		w.WriteHeader(statusCode)
 		w.Header().Set("x-RouteEndRoutesExtension", "CustomError")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/custom-error-ptr"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/custom-error")), e2EcontrollerCustomErrorHandler).Methods("GET", "HEAD")
+	e2EcontrollerCustomPtrErrorErrorStatusCodes := []int{}
+	e2EcontrollerCustomPtrErrorRoute := withBasePath(basePath, "/e2e/custom-error-ptr")
+	e2EcontrollerCustomPtrErrorHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"CustomPtrError",
+			"E2EController",
+			"GET",
+			e2EcontrollerCustomPtrErrorRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "CustomPtrError")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -3356,8 +7833,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "CustomPtrError")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -3368,13 +7847,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "CustomPtrError")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "CustomPtrError")
+			}
+		}()
 		opError := controller.CustomPtrError()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "CustomPtrError")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomPtrErrorErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3402,9 +7888,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.Header().Set("x-AfterOperationRoutesExtension", "CustomPtrError")
 		w.WriteHeader(statusCode)
 		w.Header().Set("x-RouteEndRoutesExtension", "CustomPtrError")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/503-error-code"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/custom-error-ptr")), e2EcontrollerCustomPtrErrorHandler).Methods("GET", "HEAD")
+	e2EcontrollerError503ErrorStatusCodes := []int{}
+	e2EcontrollerError503Route := withBasePath(basePath, "/e2e/503-error-code")
+	e2EcontrollerError503Handler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"Error503",
+			"E2EController",
+			"GET",
+			e2EcontrollerError503Route,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "Error503")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -3425,8 +7927,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "Error503")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -3437,13 +7941,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "Error503")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "Error503")
+			}
+		}()
 		opError := controller.Error503()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "Error503")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerError503ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3461,6 +7972,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/Error503",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerError503ErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "Error503")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -3479,9 +7991,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.Header().Set("x-AfterOperationRoutesExtension", "Error503")
 		w.WriteHeader(statusCode)
 		w.Header().Set("x-RouteEndRoutesExtension", "Error503")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/custom-error-503"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/503-error-code")), e2EcontrollerError503Handler).Methods("GET", "HEAD")
+	e2EcontrollerCustomError503ErrorStatusCodes := []int{}
+	e2EcontrollerCustomError503Route := withBasePath(basePath, "/e2e/custom-error-503")
+	e2EcontrollerCustomError503Handler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"CustomError503",
+			"E2EController",
+			"GET",
+			e2EcontrollerCustomError503Route,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "CustomError503")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -3502,8 +8030,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "CustomError503")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -3514,14 +8044,21 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "CustomError503")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "CustomError503")
+			}
+		}()
 		opError := controller.CustomError503()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "CustomError503")
-		statusCode := getStatusCode(&controller, false, opError)
-		emptyErr := Response6CustomError.CustomError{}
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerCustomError503ErrorStatusCodes)
+		emptyErr := Response9CustomError.CustomError{}
 		if opError != emptyErr {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3549,9 +8086,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.Header().Set("x-AfterOperationRoutesExtension", "CustomError503")
 		w.WriteHeader(statusCode)
 		w.Header().Set("x-RouteEndRoutesExtension", "CustomError503")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/context-access"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/custom-error-503")), e2EcontrollerCustomError503Handler).Methods("GET", "HEAD")
+	e2EcontrollerContextAccessErrorStatusCodes := []int{}
+	e2EcontrollerContextAccessRoute := withBasePath(basePath, "/e2e/context-access")
+	e2EcontrollerContextAccessHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ContextAccess",
+			"E2EController",
+			"GET",
+			e2EcontrollerContextAccessRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "ContextAccess")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -3572,8 +8125,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ContextAccess")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -3584,13 +8139,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "ContextAccess")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ContextAccess")
+			}
+		}()
 		opError := controller.ContextAccess()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "ContextAccess")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextAccessErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3608,6 +8170,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ContextAccess",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextAccessErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "ContextAccess")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -3626,9 +8189,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.Header().Set("x-AfterOperationRoutesExtension", "ContextAccess")
 		w.WriteHeader(statusCode)
 		w.Header().Set("x-RouteEndRoutesExtension", "ContextAccess")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/http-method"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/context-access")), e2EcontrollerContextAccessHandler).Methods("GET", "HEAD")
+	e2EcontrollerGetErrorStatusCodes := []int{}
+	e2EcontrollerGetRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerGetHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"Get",
+			"E2EController",
+			"GET",
+			e2EcontrollerGetRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "Get")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -3649,8 +8228,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "Get")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -3661,13 +8242,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "Get")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "Get")
+			}
+		}()
 		opError := controller.Get()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "Get")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerGetErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3685,6 +8273,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/Get",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerGetErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "Get")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -3703,9 +8292,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.Header().Set("x-AfterOperationRoutesExtension", "Get")
 		w.WriteHeader(statusCode)
 		w.Header().Set("x-RouteEndRoutesExtension", "Get")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/http-method"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerGetHandler).Methods("GET", "HEAD")
+	e2EcontrollerPostErrorStatusCodes := []int{}
+	e2EcontrollerPostRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerPostHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"Post",
+			"E2EController",
+			"POST",
+			e2EcontrollerPostRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "Post")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -3726,8 +8331,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "Post")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -3738,13 +8345,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "Post")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "Post")
+			}
+		}()
 		opError := controller.Post()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "Post")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPostErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3762,6 +8376,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/Post",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPostErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "Post")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -3780,9 +8395,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.Header().Set("x-AfterOperationRoutesExtension", "Post")
 		w.WriteHeader(statusCode)
 		w.Header().Set("x-RouteEndRoutesExtension", "Post")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/http-method"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPostHandler).Methods("POST")
+	e2EcontrollerPutErrorStatusCodes := []int{}
+	e2EcontrollerPutRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerPutHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"Put",
+			"E2EController",
+			"PUT",
+			e2EcontrollerPutRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "Put")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -3803,8 +8434,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "Put")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -3815,13 +8448,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "Put")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "Put")
+			}
+		}()
 		opError := controller.Put()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "Put")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPutErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3839,6 +8479,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/Put",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPutErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "Put")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -3857,9 +8498,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.Header().Set("x-AfterOperationRoutesExtension", "Put")
 		w.WriteHeader(statusCode)
 		w.Header().Set("x-RouteEndRoutesExtension", "Put")
-	}).Methods("PUT")
-	engine.HandleFunc(toMuxUrl("/e2e/http-method"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPutHandler).Methods("PUT")
+	e2EcontrollerDeleteErrorStatusCodes := []int{}
+	e2EcontrollerDeleteRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerDeleteHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"Delete",
+			"E2EController",
+			"DELETE",
+			e2EcontrollerDeleteRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "Delete")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -3880,8 +8537,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "Delete")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -3892,13 +8551,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "Delete")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "Delete")
+			}
+		}()
 		opError := controller.Delete()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "Delete")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerDeleteErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3916,6 +8582,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/Delete",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeleteErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "Delete")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -3934,9 +8601,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.Header().Set("x-AfterOperationRoutesExtension", "Delete")
 		w.WriteHeader(statusCode)
 		w.Header().Set("x-RouteEndRoutesExtension", "Delete")
-	}).Methods("DELETE")
-	engine.HandleFunc(toMuxUrl("/e2e/http-method"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerDeleteHandler).Methods("DELETE")
+	e2EcontrollerPatchErrorStatusCodes := []int{}
+	e2EcontrollerPatchRoute := withBasePath(basePath, "/e2e/http-method")
+	e2EcontrollerPatchHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"Patch",
+			"E2EController",
+			"PATCH",
+			e2EcontrollerPatchRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "Patch")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -3957,8 +8640,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "Patch")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -3969,13 +8654,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "Patch")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "Patch")
+			}
+		}()
 		opError := controller.Patch()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "Patch")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerPatchErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -3993,6 +8685,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/Patch",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerPatchErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "Patch")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -4011,9 +8704,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.Header().Set("x-AfterOperationRoutesExtension", "Patch")
 		w.WriteHeader(statusCode)
 		w.Header().Set("x-RouteEndRoutesExtension", "Patch")
-	}).Methods("PATCH")
-	engine.HandleFunc(toMuxUrl("/e2e/template-context-1"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/http-method")), e2EcontrollerPatchHandler).Methods("PATCH")
+	e2EcontrollerTemplateContext1ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext1Route := withBasePath(basePath, "/e2e/template-context-1")
+	e2EcontrollerTemplateContext1Handler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TemplateContext1",
+			"E2EController",
+			"GET",
+			e2EcontrollerTemplateContext1Route,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "TemplateContext1")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -4034,8 +8743,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TemplateContext1")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -4046,13 +8757,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "TemplateContext1")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TemplateContext1")
+			}
+		}()
 		value, opError := controller.TemplateContext1()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "TemplateContext1")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4070,6 +8788,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TemplateContext1",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext1ErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "TemplateContext1")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -4091,9 +8810,25 @@ func RegisterRoutes(engine *mux.Router) {
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "TemplateContext1")
 		w.Header().Set("x-level", "high")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/template-context-2"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/template-context-1")), e2EcontrollerTemplateContext1Handler).Methods("GET", "HEAD")
+	e2EcontrollerTemplateContext2ErrorStatusCodes := []int{}
+	e2EcontrollerTemplateContext2Route := withBasePath(basePath, "/e2e/template-context-2")
+	e2EcontrollerTemplateContext2Handler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TemplateContext2",
+			"E2EController",
+			"GET",
+			e2EcontrollerTemplateContext2Route,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "TemplateContext2")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -4114,8 +8849,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TemplateContext2")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -4126,13 +8863,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "TemplateContext2")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TemplateContext2")
+			}
+		}()
 		value, opError := controller.TemplateContext2()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "TemplateContext2")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4150,6 +8894,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TemplateContext2",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTemplateContext2ErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "TemplateContext2")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -4172,9 +8917,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.Header().Set("x-RouteEndRoutesExtension", "TemplateContext2")
 		w.Header().Set("x-mode", "100")
 		w.Header().Set("x-level", "low")
-	}).Methods("GET")
-	engine.HandleFunc(toMuxUrl("/e2e/form"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/template-context-2")), e2EcontrollerTemplateContext2Handler).Methods("GET", "HEAD")
+	e2EcontrollerTestFormErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormRoute := withBasePath(basePath, "/e2e/form")
+	e2EcontrollerTestFormHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TestForm",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestFormRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "TestForm")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -4195,8 +8956,9 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestForm")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		req.ParseForm()
 		var item1RawPtr *string = nil
 		item1RawArr, isitem1Exists := req.PostForm["item1"]
@@ -4219,10 +8981,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item1"
-			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
 			w.Header().Set("x-RunValidatorExtension", "TestForm")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		req.ParseForm()
@@ -4247,12 +9011,15 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item2"
-			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestForm", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
 			w.Header().Set("x-RunValidatorExtension", "TestForm")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -4263,13 +9030,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "TestForm")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TestForm")
+			}
+		}()
 		value, opError := controller.TestForm(*item1RawPtr, *item2RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "TestForm")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4287,6 +9061,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TestForm",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "TestForm")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -4307,9 +9082,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "TestForm")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/form-extra"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/form")), e2EcontrollerTestFormHandler).Methods("POST")
+	e2EcontrollerTestFormExtraErrorStatusCodes := []int{500}
+	e2EcontrollerTestFormExtraRoute := withBasePath(basePath, "/e2e/form-extra")
+	e2EcontrollerTestFormExtraHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TestFormExtra",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestFormExtraRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "TestFormExtra")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -4330,8 +9121,9 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestFormExtra")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		req.ParseForm()
 		var item1RawPtr *int64 = nil
 		item1RawArr, isitem1Exists := req.PostForm["item1"]
@@ -4340,7 +9132,7 @@ func RegisterRoutes(engine *mux.Router) {
 			item1Raw = item1RawArr[0] // Get first value since form values are slices
 		}
 		if isitem1Exists {
-			item1Uint64, conversionErr := strconv.ParseInt(item1Raw, 10, 64)
+			item1, conversionErr := parseIntegerParam[int64](item1Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4351,24 +9143,21 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestFormExtra' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"item1",
-						"int64",
-						reflect.TypeOf(item1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestFormExtra",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item1",
+					"form",
+					"/item1",
+					"int64",
+					reflect.TypeOf(item1Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestFormExtra")
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			item1 := int64(item1Uint64)
 			item1RawPtr = &item1
 		}
 		if validatorErr := validatorInstance.Var(item1RawPtr, "required,gte=80"); validatorErr != nil {
@@ -4382,10 +9171,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item1"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item1", languages)
 			w.Header().Set("x-RunValidatorExtension", "TestFormExtra")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		req.ParseForm()
@@ -4410,17 +9201,19 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item2"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/item2", languages)
 			w.Header().Set("x-RunValidatorExtension", "TestFormExtra")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var item3RawPtr *int64 = nil
 		item3Raw := req.URL.Query().Get("item3")
 		isitem3Exists := req.URL.Query().Has("item3")
 		if isitem3Exists {
-			item3Uint64, conversionErr := strconv.ParseInt(item3Raw, 10, 64)
+			item3, conversionErr := parseIntegerParam[int64](item3Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4431,24 +9224,21 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestFormExtra' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"item3",
-						"int64",
-						reflect.TypeOf(item3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestFormExtra",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestFormExtra",
+					"item3",
+					"query",
+					"/item3",
+					"int64",
+					reflect.TypeOf(item3Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestFormExtra")
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			item3 := int64(item3Uint64)
 			item3RawPtr = &item3
 		}
 		if validatorErr := validatorInstance.Var(item3RawPtr, "required,gte=80"); validatorErr != nil {
@@ -4462,12 +9252,15 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "item3"
-			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestFormExtra", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/item3", languages)
 			w.Header().Set("x-RunValidatorExtension", "TestFormExtra")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -4478,13 +9271,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "TestFormExtra")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TestFormExtra")
+			}
+		}()
 		value, opError := controller.TestFormExtra(*item1RawPtr, *item2RawPtr, *item3RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "TestFormExtra")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4502,6 +9302,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TestFormExtra",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestFormExtraErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "TestFormExtra")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -4522,9 +9323,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "TestFormExtra")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/test-response-validation"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/form-extra")), e2EcontrollerTestFormExtraHandler).Methods("POST")
+	e2EcontrollerTestResponseValidationErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationRoute := withBasePath(basePath, "/e2e/test-response-validation")
+	e2EcontrollerTestResponseValidationHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TestResponseValidation",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestResponseValidationRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "TestResponseValidation")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -4545,8 +9362,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestResponseValidation")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -4557,13 +9376,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "TestResponseValidation")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TestResponseValidation")
+			}
+		}()
 		value, opError := controller.TestResponseValidation()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "TestResponseValidation")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4581,6 +9407,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TestResponseValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "TestResponseValidation")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -4626,9 +9453,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "TestResponseValidation")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/test-response-validation-ptr"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/test-response-validation")), e2EcontrollerTestResponseValidationHandler).Methods("POST")
+	e2EcontrollerTestResponseValidationPtrErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationPtrRoute := withBasePath(basePath, "/e2e/test-response-validation-ptr")
+	e2EcontrollerTestResponseValidationPtrHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TestResponseValidationPtr",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestResponseValidationPtrRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "TestResponseValidationPtr")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -4649,8 +9492,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestResponseValidationPtr")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -4661,13 +9506,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "TestResponseValidationPtr")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TestResponseValidationPtr")
+			}
+		}()
 		value, opError := controller.TestResponseValidationPtr()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "TestResponseValidationPtr")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4685,6 +9537,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TestResponseValidationPtr",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationPtrErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "TestResponseValidationPtr")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -4734,9 +9587,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "TestResponseValidationPtr")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/test-response-validation-null"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/test-response-validation-ptr")), e2EcontrollerTestResponseValidationPtrHandler).Methods("POST")
+	e2EcontrollerTestResponseValidationNullErrorStatusCodes := []int{500}
+	e2EcontrollerTestResponseValidationNullRoute := withBasePath(basePath, "/e2e/test-response-validation-null")
+	e2EcontrollerTestResponseValidationNullHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TestResponseValidationNull",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestResponseValidationNullRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "TestResponseValidationNull")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -4757,8 +9626,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestResponseValidationNull")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -4769,13 +9640,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "TestResponseValidationNull")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TestResponseValidationNull")
+			}
+		}()
 		value, opError := controller.TestResponseValidationNull()
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "TestResponseValidationNull")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -4793,6 +9671,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TestResponseValidationNull",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestResponseValidationNullErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "TestResponseValidationNull")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -4842,9 +9721,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "TestResponseValidationNull")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/test-primitive-conversions"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/test-response-validation-null")), e2EcontrollerTestResponseValidationNullHandler).Methods("POST")
+	e2EcontrollerTestPrimitiveConversionsErrorStatusCodes := []int{500}
+	e2EcontrollerTestPrimitiveConversionsRoute := withBasePath(basePath, "/e2e/test-primitive-conversions")
+	e2EcontrollerTestPrimitiveConversionsHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TestPrimitiveConversions",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestPrimitiveConversionsRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "TestPrimitiveConversions")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -4865,13 +9760,14 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestPrimitiveConversions")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var value1RawPtr *int64 = nil
 		value1Raw := req.URL.Query().Get("value1")
 		isvalue1Exists := req.URL.Query().Has("value1")
 		if isvalue1Exists {
-			value1Uint64, conversionErr := strconv.ParseInt(value1Raw, 10, 64)
+			value1, conversionErr := parseIntegerParam[int64](value1Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4882,24 +9778,21 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"int64",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value1",
+					"query",
+					"/value1",
+					"int64",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value1 := int64(value1Uint64)
 			value1RawPtr = &value1
 		}
 		if validatorErr := validatorInstance.Var(value1RawPtr, "required"); validatorErr != nil {
@@ -4913,17 +9806,19 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
 			w.Header().Set("x-RunValidatorExtension", "TestPrimitiveConversions")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value2RawPtr *bool = nil
 		value2Raw := req.URL.Query().Get("value2")
 		isvalue2Exists := req.URL.Query().Has("value2")
 		if isvalue2Exists {
-			value2Bool, conversionErr := strconv.ParseBool(value2Raw)
+			value2, conversionErr := strconv.ParseBool(value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4934,24 +9829,21 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"bool",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value2",
+					"query",
+					"/value2",
+					"bool",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value2 := value2Bool
 			value2RawPtr = &value2
 		}
 		if validatorErr := validatorInstance.Var(value2RawPtr, "required"); validatorErr != nil {
@@ -4965,17 +9857,19 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
 			w.Header().Set("x-RunValidatorExtension", "TestPrimitiveConversions")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value3RawPtr *int = nil
 		value3Raw := req.URL.Query().Get("value3")
 		isvalue3Exists := req.URL.Query().Has("value3")
 		if isvalue3Exists {
-			value3Uint64, conversionErr := strconv.Atoi(value3Raw)
+			value3, conversionErr := parseIntegerParam[int](value3Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -4986,24 +9880,21 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value3",
-						"int",
-						reflect.TypeOf(value3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value3",
+					"query",
+					"/value3",
+					"int",
+					reflect.TypeOf(value3Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value3 := int(value3Uint64)
 			value3RawPtr = &value3
 		}
 		if validatorErr := validatorInstance.Var(value3RawPtr, "required"); validatorErr != nil {
@@ -5017,17 +9908,19 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value3"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value3", languages)
 			w.Header().Set("x-RunValidatorExtension", "TestPrimitiveConversions")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value4RawPtr *float64 = nil
 		value4Raw := req.URL.Query().Get("value4")
 		isvalue4Exists := req.URL.Query().Has("value4")
 		if isvalue4Exists {
-			value4Float64, conversionErr := strconv.ParseFloat(value4Raw, 64)
+			value4, conversionErr := parseFloatParam[float64](value4Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -5038,24 +9931,21 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestPrimitiveConversions' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value4",
-						"float64",
-						reflect.TypeOf(value4Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestPrimitiveConversions",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestPrimitiveConversions",
+					"value4",
+					"query",
+					"/value4",
+					"float64",
+					reflect.TypeOf(value4Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestPrimitiveConversions")
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value4 := float64(value4Float64)
 			value4RawPtr = &value4
 		}
 		if validatorErr := validatorInstance.Var(value4RawPtr, "required"); validatorErr != nil {
@@ -5069,12 +9959,15 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value4"
-			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestPrimitiveConversions", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value4", languages)
 			w.Header().Set("x-RunValidatorExtension", "TestPrimitiveConversions")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -5085,13 +9978,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "TestPrimitiveConversions")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TestPrimitiveConversions")
+			}
+		}()
 		value, opError := controller.TestPrimitiveConversions(*value1RawPtr, *value2RawPtr, *value3RawPtr, *value4RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "TestPrimitiveConversions")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5109,6 +10009,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TestPrimitiveConversions",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestPrimitiveConversionsErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "TestPrimitiveConversions")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -5129,9 +10030,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "TestPrimitiveConversions")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/test-enums"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/test-primitive-conversions")), e2EcontrollerTestPrimitiveConversionsHandler).Methods("POST")
+	e2EcontrollerTestEnumsErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsRoute := withBasePath(basePath, "/e2e/test-enums")
+	e2EcontrollerTestEnumsHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TestEnums",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestEnumsRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "TestEnums")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -5152,17 +10069,18 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestEnums")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw := req.URL.Query().Get("value1")
 		isvalue1Exists := req.URL.Query().Has("value1")
 		if isvalue1Exists {
 			value1 := value1Raw
 			switch value1Raw {
 			case "active", "inactive":
-				value1Var := Param12value1.StatusEnumeration(value1)
+				value1Var := Param15value1.StatusEnumeration(value1)
 				value1RawPtr = &value1Var
 			default:
 				conversionErr := fmt.Errorf("value1 must be one of \"active, inactive\" options only but got \"%s\"", value1Raw)
@@ -5175,21 +10093,19 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnums' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"StatusEnumeration",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnums",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value1",
+					"query",
+					"/value1",
+					"StatusEnumeration",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnums")
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
 		}
@@ -5204,17 +10120,19 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value1", languages)
 			w.Header().Set("x-RunValidatorExtension", "TestEnums")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value2RawPtr *Param4value2.NumberEnumeration = nil
 		value2Raw := req.URL.Query().Get("value2")
 		isvalue2Exists := req.URL.Query().Has("value2")
 		if isvalue2Exists {
-			value2Uint64, conversionErr := strconv.Atoi(value2Raw)
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -5225,24 +10143,21 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnums' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnums",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value2",
+					"query",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnums")
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value2 := int(value2Uint64)
 			switch value2Raw {
 			case "1", "2":
 				value2Var := Param4value2.NumberEnumeration(value2)
@@ -5258,21 +10173,19 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnums' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnums",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnums",
+					"value2",
+					"query",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnums")
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
 		}
@@ -5287,13 +10200,15 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnums", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/value2", languages)
 			w.Header().Set("x-RunValidatorExtension", "TestEnums")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var value3RawPtr *Param11value3.ObjectWithEnum = nil
+		var value3RawPtr *Param14value3.ObjectWithEnum = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &value3RawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5305,22 +10220,29 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'TestEnums' but body parameter '%s' did not pass validation of '%s' - %s",
-					"value3",
-					"ObjectWithEnum",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "TestEnums",
+						"parameter": "value3",
+						"type":      "ObjectWithEnum",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/TestEnums",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "TestEnums")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -5331,13 +10253,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "TestEnums")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TestEnums")
+			}
+		}()
 		value, opError := controller.TestEnums(*value1RawPtr, *value2RawPtr, *value3RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "TestEnums")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5355,6 +10284,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TestEnums",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "TestEnums")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -5400,9 +10330,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "TestEnums")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/test-enums-in-all/{value1}"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/test-enums")), e2EcontrollerTestEnumsHandler).Methods("POST")
+	e2EcontrollerTestEnumsInAllErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsInAllRoute := withBasePath(basePath, "/e2e/test-enums-in-all/{value1}")
+	e2EcontrollerTestEnumsInAllHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TestEnumsInAll",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestEnumsInAllRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "TestEnumsInAll")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -5423,16 +10369,17 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestEnumsInAll")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		value1vars := mux.Vars(req)
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw, isvalue1Exists := value1vars["value1"]
 		if isvalue1Exists {
 			value1 := value1Raw
 			switch value1Raw {
 			case "active", "inactive":
-				value1Var := Param12value1.StatusEnumeration(value1)
+				value1Var := Param15value1.StatusEnumeration(value1)
 				value1RawPtr = &value1Var
 			default:
 				conversionErr := fmt.Errorf("value1 must be one of \"active, inactive\" options only but got \"%s\"", value1Raw)
@@ -5445,21 +10392,19 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"StatusEnumeration",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value1",
+					"path",
+					"/value1",
+					"StatusEnumeration",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
 		}
@@ -5474,10 +10419,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value1"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "path", "/value1", languages)
 			w.Header().Set("x-RunValidatorExtension", "TestEnumsInAll")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var value2RawPtr *Param4value2.NumberEnumeration = nil
@@ -5489,7 +10436,7 @@ func RegisterRoutes(engine *mux.Router) {
 			isvalue2Exists = len(headerValues) > 0
 		}
 		if isvalue2Exists {
-			value2Uint64, conversionErr := strconv.Atoi(value2Raw)
+			value2, conversionErr := parseIntegerParam[int](value2Raw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -5500,24 +10447,21 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value2",
+					"header",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			value2 := int(value2Uint64)
 			switch value2Raw {
 			case "1", "2":
 				value2Var := Param4value2.NumberEnumeration(value2)
@@ -5533,21 +10477,19 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value2",
-						"NumberEnumeration",
-						reflect.TypeOf(value2Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value2",
+					"header",
+					"/value2",
+					"NumberEnumeration",
+					reflect.TypeOf(value2Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
 		}
@@ -5562,14 +10504,16 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value2"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "header", "/value2", languages)
 			w.Header().Set("x-RunValidatorExtension", "TestEnumsInAll")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		req.ParseForm()
-		var value3RawPtr *Param12value3.StatusEnumeration = nil
+		var value3RawPtr *Param15value3.StatusEnumeration = nil
 		value3RawArr, isvalue3Exists := req.PostForm["value3"]
 		value3Raw := ""
 		if isvalue3Exists {
@@ -5579,7 +10523,7 @@ func RegisterRoutes(engine *mux.Router) {
 			value3 := value3Raw
 			switch value3Raw {
 			case "active", "inactive":
-				value3Var := Param12value3.StatusEnumeration(value3)
+				value3Var := Param15value3.StatusEnumeration(value3)
 				value3RawPtr = &value3Var
 			default:
 				conversionErr := fmt.Errorf("value3 must be one of \"active, inactive\" options only but got \"%s\"", value3Raw)
@@ -5592,21 +10536,19 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsInAll' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value3",
-						"StatusEnumeration",
-						reflect.TypeOf(value3Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsInAll",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsInAll",
+					"value3",
+					"form",
+					"/value3",
+					"StatusEnumeration",
+					reflect.TypeOf(value3Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnumsInAll")
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
 		}
@@ -5621,12 +10563,15 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "value3"
-			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "TestEnumsInAll", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "form", "/value3", languages)
 			w.Header().Set("x-RunValidatorExtension", "TestEnumsInAll")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -5637,13 +10582,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "TestEnumsInAll")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TestEnumsInAll")
+			}
+		}()
 		value, opError := controller.TestEnumsInAll(*value1RawPtr, *value2RawPtr, *value3RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "TestEnumsInAll")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5661,6 +10613,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TestEnumsInAll",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsInAllErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "TestEnumsInAll")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -5681,9 +10634,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "TestEnumsInAll")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/test-enums-optional"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/test-enums-in-all/{value1}")), e2EcontrollerTestEnumsInAllHandler).Methods("POST")
+	e2EcontrollerTestEnumsOptionalErrorStatusCodes := []int{500}
+	e2EcontrollerTestEnumsOptionalRoute := withBasePath(basePath, "/e2e/test-enums-optional")
+	e2EcontrollerTestEnumsOptionalHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"TestEnumsOptional",
+			"E2EController",
+			"POST",
+			e2EcontrollerTestEnumsOptionalRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "TestEnumsOptional")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -5704,9 +10673,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "TestEnumsOptional")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
-		var value1RawPtr *Param12value1.StatusEnumeration = nil
+		telemetry.startPhase("validation")
+		var value1RawPtr *Param15value1.StatusEnumeration = nil
 		value1Raw := req.Header.Get("value1")
 		_, isvalue1Exists := req.Header["value1"]
 		if !isvalue1Exists {
@@ -5718,7 +10688,7 @@ func RegisterRoutes(engine *mux.Router) {
 			value1 := value1Raw
 			switch value1Raw {
 			case "active", "inactive":
-				value1Var := Param12value1.StatusEnumeration(value1)
+				value1Var := Param15value1.StatusEnumeration(value1)
 				value1RawPtr = &value1Var
 			default:
 				conversionErr := fmt.Errorf("value1 must be one of \"active, inactive\" options only but got \"%s\"", value1Raw)
@@ -5731,24 +10701,23 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'TestEnumsOptional' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"value1",
-						"StatusEnumeration",
-						reflect.TypeOf(value1Raw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/TestEnumsOptional",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"TestEnumsOptional",
+					"value1",
+					"header",
+					"/value1",
+					"StatusEnumeration",
+					reflect.TypeOf(value1Raw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				w.Header().Set("x-ParamsValidationErrorResponseExtension", "TestEnumsOptional")
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -5759,13 +10728,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "TestEnumsOptional")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "TestEnumsOptional")
+			}
+		}()
 		value, opError := controller.TestEnumsOptional(value1RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "TestEnumsOptional")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5783,6 +10759,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/TestEnumsOptional",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerTestEnumsOptionalErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "TestEnumsOptional")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -5803,9 +10780,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "TestEnumsOptional")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/external-packages"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/test-enums-optional")), e2EcontrollerTestEnumsOptionalHandler).Methods("POST")
+	e2EcontrollerExternalPackagesErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesRoute := withBasePath(basePath, "/e2e/external-packages")
+	e2EcontrollerExternalPackagesHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ExternalPackages",
+			"E2EController",
+			"POST",
+			e2EcontrollerExternalPackagesRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "ExternalPackages")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -5826,17 +10819,18 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ExternalPackages")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var unitRawPtr *Param14unit.LengthUnits = nil
+		var unitRawPtr *Param17unit.LengthUnits = nil
 		unitRaw := req.URL.Query().Get("unit")
 		isunitExists := req.URL.Query().Has("unit")
 		if isunitExists {
 			unit := unitRaw
 			switch unitRaw {
 			case "Angstrom", "AstronomicalUnit", "Centimeter", "Chain", "DataMile", "Decameter", "Decimeter", "DtpPica", "DtpPoint", "Fathom", "Femtometer", "Foot", "Gigameter", "Hand", "Hectometer", "Inch", "Kilofoot", "KilolightYear", "Kilometer", "Kiloparsec", "Kiloyard", "LightYear", "MegalightYear", "Megameter", "Megaparsec", "Meter", "Microinch", "Micrometer", "Mil", "Mile", "Millimeter", "Nanometer", "NauticalMile", "Parsec", "Picometer", "PrinterPica", "PrinterPoint", "Shackle", "SolarRadius", "Twip", "UsSurveyFoot", "Yard":
-				unitVar := Param14unit.LengthUnits(unit)
+				unitVar := Param17unit.LengthUnits(unit)
 				unitRawPtr = &unitVar
 			default:
 				conversionErr := fmt.Errorf("unit must be one of \"Angstrom, AstronomicalUnit, Centimeter, Chain, DataMile, Decameter, Decimeter, DtpPica, DtpPoint, Fathom, Femtometer, Foot, Gigameter, Hand, Hectometer, Inch, Kilofoot, KilolightYear, Kilometer, Kiloparsec, Kiloyard, LightYear, MegalightYear, Megameter, Megaparsec, Meter, Microinch, Micrometer, Mil, Mile, Millimeter, Nanometer, NauticalMile, Parsec, Picometer, PrinterPica, PrinterPoint, Shackle, SolarRadius, Twip, UsSurveyFoot, Yard\" options only but got \"%s\"", unitRaw)
@@ -5849,25 +10843,23 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'ExternalPackages' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"unit",
-						"LengthUnits",
-						reflect.TypeOf(unitRaw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/ExternalPackages",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"ExternalPackages",
+					"unit",
+					"query",
+					"/unit",
+					"LengthUnits",
+					reflect.TypeOf(unitRaw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				w.Header().Set("x-ParamsValidationErrorResponseExtension", "ExternalPackages")
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
 		}
-		var dataRawPtr *Param13data.LengthDto = nil
+		var dataRawPtr *Param16data.LengthDto = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -5879,22 +10871,29 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackages' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"LengthDto",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackages",
+						"parameter": "data",
+						"type":      "LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackages",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "ExternalPackages")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -5905,13 +10904,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "ExternalPackages")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ExternalPackages")
+			}
+		}()
 		value, opError := controller.ExternalPackages(unitRawPtr, *dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "ExternalPackages")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -5929,6 +10935,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ExternalPackages",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "ExternalPackages")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -5974,9 +10981,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "ExternalPackages")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/external-packages-unique-in-struct"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/external-packages")), e2EcontrollerExternalPackagesHandler).Methods("POST")
+	e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesUniqueInStructRoute := withBasePath(basePath, "/e2e/external-packages-unique-in-struct")
+	e2EcontrollerExternalPackagesUniqueInStructHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ExternalPackagesUniqueInStruct",
+			"E2EController",
+			"POST",
+			e2EcontrollerExternalPackagesUniqueInStructRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "ExternalPackagesUniqueInStruct")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -5997,10 +11020,11 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ExternalPackagesUniqueInStruct")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *Param15data.UniqueExternalUsage = nil
+		var dataRawPtr *Param18data.UniqueExternalUsage = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6012,22 +11036,29 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackagesUniqueInStruct' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"UniqueExternalUsage",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesUniqueInStruct",
+						"parameter": "data",
+						"type":      "UniqueExternalUsage",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackagesUniqueInStruct",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "ExternalPackagesUniqueInStruct")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -6038,13 +11069,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "ExternalPackagesUniqueInStruct")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ExternalPackagesUniqueInStruct")
+			}
+		}()
 		value, opError := controller.ExternalPackagesUniqueInStruct(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "ExternalPackagesUniqueInStruct")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6062,6 +11100,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ExternalPackagesUniqueInStruct",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesUniqueInStructErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "ExternalPackagesUniqueInStruct")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -6082,9 +11121,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "ExternalPackagesUniqueInStruct")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/external-packages-validation"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/external-packages-unique-in-struct")), e2EcontrollerExternalPackagesUniqueInStructHandler).Methods("POST")
+	e2EcontrollerExternalPackagesValidationErrorStatusCodes := []int{500}
+	e2EcontrollerExternalPackagesValidationRoute := withBasePath(basePath, "/e2e/external-packages-validation")
+	e2EcontrollerExternalPackagesValidationHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ExternalPackagesValidation",
+			"E2EController",
+			"POST",
+			e2EcontrollerExternalPackagesValidationRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "ExternalPackagesValidation")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -6105,17 +11160,18 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ExternalPackagesValidation")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var unitRawPtr *Param14unit.LengthUnits = nil
+		var unitRawPtr *Param17unit.LengthUnits = nil
 		unitRaw := req.URL.Query().Get("unit")
 		isunitExists := req.URL.Query().Has("unit")
 		if isunitExists {
 			unit := unitRaw
 			switch unitRaw {
 			case "Angstrom", "AstronomicalUnit", "Centimeter", "Chain", "DataMile", "Decameter", "Decimeter", "DtpPica", "DtpPoint", "Fathom", "Femtometer", "Foot", "Gigameter", "Hand", "Hectometer", "Inch", "Kilofoot", "KilolightYear", "Kilometer", "Kiloparsec", "Kiloyard", "LightYear", "MegalightYear", "Megameter", "Megaparsec", "Meter", "Microinch", "Micrometer", "Mil", "Mile", "Millimeter", "Nanometer", "NauticalMile", "Parsec", "Picometer", "PrinterPica", "PrinterPoint", "Shackle", "SolarRadius", "Twip", "UsSurveyFoot", "Yard":
-				unitVar := Param14unit.LengthUnits(unit)
+				unitVar := Param17unit.LengthUnits(unit)
 				unitRawPtr = &unitVar
 			default:
 				conversionErr := fmt.Errorf("unit must be one of \"Angstrom, AstronomicalUnit, Centimeter, Chain, DataMile, Decameter, Decimeter, DtpPica, DtpPoint, Fathom, Femtometer, Foot, Gigameter, Hand, Hectometer, Inch, Kilofoot, KilolightYear, Kilometer, Kiloparsec, Kiloyard, LightYear, MegalightYear, Megameter, Megaparsec, Meter, Microinch, Micrometer, Mil, Mile, Millimeter, Nanometer, NauticalMile, Parsec, Picometer, PrinterPica, PrinterPoint, Shackle, SolarRadius, Twip, UsSurveyFoot, Yard\" options only but got \"%s\"", unitRaw)
@@ -6128,25 +11184,23 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'ExternalPackagesValidation' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"unit",
-						"LengthUnits",
-						reflect.TypeOf(unitRaw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/ExternalPackagesValidation",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"ExternalPackagesValidation",
+					"unit",
+					"query",
+					"/unit",
+					"LengthUnits",
+					reflect.TypeOf(unitRaw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				w.Header().Set("x-ParamsValidationErrorResponseExtension", "ExternalPackagesValidation")
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
 		}
-		var dataRawPtr *Param16data.LengthDtoWithValidation = nil
+		var dataRawPtr *Param19data.LengthDtoWithValidation = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6158,22 +11212,29 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ExternalPackagesValidation' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"LengthDtoWithValidation",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ExternalPackagesValidation",
+						"parameter": "data",
+						"type":      "LengthDtoWithValidation",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ExternalPackagesValidation",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "ExternalPackagesValidation")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -6184,13 +11245,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "ExternalPackagesValidation")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ExternalPackagesValidation")
+			}
+		}()
 		value, opError := controller.ExternalPackagesValidation(unitRawPtr, *dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "ExternalPackagesValidation")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6208,6 +11276,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ExternalPackagesValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerExternalPackagesValidationErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "ExternalPackagesValidation")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -6253,9 +11322,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "ExternalPackagesValidation")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/arrays-in-body-and-res"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/external-packages-validation")), e2EcontrollerExternalPackagesValidationHandler).Methods("POST")
+	e2EcontrollerArraysInBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInBodyAndResRoute := withBasePath(basePath, "/e2e/arrays-in-body-and-res")
+	e2EcontrollerArraysInBodyAndResHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ArraysInBodyAndRes",
+			"E2EController",
+			"POST",
+			e2EcontrollerArraysInBodyAndResRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "ArraysInBodyAndRes")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -6276,10 +11361,11 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ArraysInBodyAndRes")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *[]Param13data.LengthDto = nil
+		var dataRawPtr *[]Param16data.LengthDto = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6291,22 +11377,29 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ArraysInBodyAndRes' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[]LengthDto",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInBodyAndRes",
+						"parameter": "data",
+						"type":      "[]LengthDto",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ArraysInBodyAndRes",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "ArraysInBodyAndRes")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -6317,13 +11410,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "ArraysInBodyAndRes")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ArraysInBodyAndRes")
+			}
+		}()
 		value, opError := controller.ArraysInBodyAndRes(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "ArraysInBodyAndRes")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6341,6 +11441,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ArraysInBodyAndRes",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInBodyAndResErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "ArraysInBodyAndRes")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -6386,9 +11487,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "ArraysInBodyAndRes")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/arrays-inside-body-and-res"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/arrays-in-body-and-res")), e2EcontrollerArraysInBodyAndResHandler).Methods("POST")
+	e2EcontrollerArraysInsideBodyAndResErrorStatusCodes := []int{500}
+	e2EcontrollerArraysInsideBodyAndResRoute := withBasePath(basePath, "/e2e/arrays-inside-body-and-res")
+	e2EcontrollerArraysInsideBodyAndResHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ArraysInsideBodyAndRes",
+			"E2EController",
+			"POST",
+			e2EcontrollerArraysInsideBodyAndResRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "ArraysInsideBodyAndRes")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -6409,10 +11526,11 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ArraysInsideBodyAndRes")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *[]Param17data.BlaBla = nil
+		var dataRawPtr *[]Param20data.BlaBla = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6424,22 +11542,29 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ArraysInsideBodyAndRes' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[]BlaBla",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ArraysInsideBodyAndRes",
+						"parameter": "data",
+						"type":      "[]BlaBla",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ArraysInsideBodyAndRes",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "ArraysInsideBodyAndRes")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -6450,13 +11575,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "ArraysInsideBodyAndRes")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ArraysInsideBodyAndRes")
+			}
+		}()
 		value, opError := controller.ArraysInsideBodyAndRes(dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "ArraysInsideBodyAndRes")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6474,6 +11606,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ArraysInsideBodyAndRes",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerArraysInsideBodyAndResErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "ArraysInsideBodyAndRes")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -6523,9 +11656,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "ArraysInsideBodyAndRes")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/deep-arrays-with-validation"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/arrays-inside-body-and-res")), e2EcontrollerArraysInsideBodyAndResHandler).Methods("POST")
+	e2EcontrollerDeepArraysWithValidationErrorStatusCodes := []int{500}
+	e2EcontrollerDeepArraysWithValidationRoute := withBasePath(basePath, "/e2e/deep-arrays-with-validation")
+	e2EcontrollerDeepArraysWithValidationHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"DeepArraysWithValidation",
+			"E2EController",
+			"POST",
+			e2EcontrollerDeepArraysWithValidationRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "DeepArraysWithValidation")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -6546,10 +11695,11 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "DeepArraysWithValidation")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *[][]Param18data.BlaBla2 = nil
+		var dataRawPtr *[][]Param21data.BlaBla2 = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6561,22 +11711,29 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'DeepArraysWithValidation' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"[][]BlaBla2",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "DeepArraysWithValidation",
+						"parameter": "data",
+						"type":      "[][]BlaBla2",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/DeepArraysWithValidation",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "DeepArraysWithValidation")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -6587,13 +11744,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "DeepArraysWithValidation")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "DeepArraysWithValidation")
+			}
+		}()
 		value, opError := controller.DeepArraysWithValidation(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "DeepArraysWithValidation")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6611,6 +11775,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/DeepArraysWithValidation",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerDeepArraysWithValidationErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "DeepArraysWithValidation")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -6656,9 +11821,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "DeepArraysWithValidation")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/embedded-structs"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/deep-arrays-with-validation")), e2EcontrollerDeepArraysWithValidationHandler).Methods("POST")
+	e2EcontrollerEmbeddedStructsErrorStatusCodes := []int{500}
+	e2EcontrollerEmbeddedStructsRoute := withBasePath(basePath, "/e2e/embedded-structs")
+	e2EcontrollerEmbeddedStructsHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"EmbeddedStructs",
+			"E2EController",
+			"POST",
+			e2EcontrollerEmbeddedStructsRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "EmbeddedStructs")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -6679,10 +11860,11 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "EmbeddedStructs")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *Param19data.TheModel = nil
+		var dataRawPtr *Param22data.TheModel = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6694,22 +11876,29 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'EmbeddedStructs' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModel",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "EmbeddedStructs",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/EmbeddedStructs",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "EmbeddedStructs")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -6720,13 +11909,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "EmbeddedStructs")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "EmbeddedStructs")
+			}
+		}()
 		value, opError := controller.EmbeddedStructs(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "EmbeddedStructs")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6744,6 +11940,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/EmbeddedStructs",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerEmbeddedStructsErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "EmbeddedStructs")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -6789,9 +11986,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "EmbeddedStructs")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/structs-with-inner-pointer"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/embedded-structs")), e2EcontrollerEmbeddedStructsHandler).Methods("POST")
+	e2EcontrollerStructsWithInnerPointerErrorStatusCodes := []int{500}
+	e2EcontrollerStructsWithInnerPointerRoute := withBasePath(basePath, "/e2e/structs-with-inner-pointer")
+	e2EcontrollerStructsWithInnerPointerHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"StructsWithInnerPointer",
+			"E2EController",
+			"POST",
+			e2EcontrollerStructsWithInnerPointerRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "StructsWithInnerPointer")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -6812,10 +12025,11 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "StructsWithInnerPointer")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *Param20data.TheModelWithInnerPointer = nil
+		var dataRawPtr *Param23data.TheModelWithInnerPointer = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -6827,22 +12041,29 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'StructsWithInnerPointer' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModelWithInnerPointer",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "StructsWithInnerPointer",
+						"parameter": "data",
+						"type":      "TheModelWithInnerPointer",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/StructsWithInnerPointer",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "StructsWithInnerPointer")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -6853,13 +12074,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "StructsWithInnerPointer")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "StructsWithInnerPointer")
+			}
+		}()
 		value, opError := controller.StructsWithInnerPointer(*dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "StructsWithInnerPointer")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6877,6 +12105,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/StructsWithInnerPointer",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerStructsWithInnerPointerErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "StructsWithInnerPointer")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -6897,9 +12126,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "StructsWithInnerPointer")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/context-injection-empty"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/structs-with-inner-pointer")), e2EcontrollerStructsWithInnerPointerHandler).Methods("POST")
+	e2EcontrollerContextInjectionEmptyErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionEmptyRoute := withBasePath(basePath, "/e2e/context-injection-empty")
+	e2EcontrollerContextInjectionEmptyHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ContextInjectionEmpty",
+			"E2EController",
+			"POST",
+			e2EcontrollerContextInjectionEmptyRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "ContextInjectionEmpty")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -6920,8 +12165,10 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ContextInjectionEmpty")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -6932,13 +12179,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "ContextInjectionEmpty")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ContextInjectionEmpty")
+			}
+		}()
 		opError := controller.ContextInjectionEmpty(getRequestContext(req))
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "ContextInjectionEmpty")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -6956,6 +12210,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ContextInjectionEmpty",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionEmptyErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "ContextInjectionEmpty")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -6974,9 +12229,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.Header().Set("x-AfterOperationRoutesExtension", "ContextInjectionEmpty")
 		w.WriteHeader(statusCode)
 		w.Header().Set("x-RouteEndRoutesExtension", "ContextInjectionEmpty")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/context-injection"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/context-injection-empty")), e2EcontrollerContextInjectionEmptyHandler).Methods("POST")
+	e2EcontrollerContextInjectionErrorStatusCodes := []int{500}
+	e2EcontrollerContextInjectionRoute := withBasePath(basePath, "/e2e/context-injection")
+	e2EcontrollerContextInjectionHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ContextInjection",
+			"E2EController",
+			"POST",
+			e2EcontrollerContextInjectionRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "ContextInjection")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -6997,10 +12268,11 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ContextInjection")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var dataRawPtr *Param19data.TheModel = nil
+		var dataRawPtr *Param22data.TheModel = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &dataRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -7012,22 +12284,29 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ContextInjection' but body parameter '%s' did not pass validation of '%s' - %s",
-					"data",
-					"TheModel",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ContextInjection",
+						"parameter": "data",
+						"type":      "TheModel",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ContextInjection",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "ContextInjection")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -7038,13 +12317,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "ContextInjection")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ContextInjection")
+			}
+		}()
 		opError := controller.ContextInjection(getRequestContext(req), *dataRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "ContextInjection")
-		statusCode := getStatusCode(&controller, false, opError)
+		statusCode := getStatusCode(controller, false, opError, e2EcontrollerContextInjectionErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7062,6 +12348,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ContextInjection",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerContextInjectionErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "ContextInjection")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -7080,9 +12367,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.Header().Set("x-AfterOperationRoutesExtension", "ContextInjection")
 		w.WriteHeader(statusCode)
 		w.Header().Set("x-RouteEndRoutesExtension", "ContextInjection")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/byte-slice"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/context-injection")), e2EcontrollerContextInjectionHandler).Methods("POST")
+	e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithByteSliceRoute := withBasePath(basePath, "/e2e/byte-slice")
+	e2EcontrollerReturnsStructWithByteSliceHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ReturnsStructWithByteSlice",
+			"E2EController",
+			"POST",
+			e2EcontrollerReturnsStructWithByteSliceRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "ReturnsStructWithByteSlice")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -7103,10 +12406,11 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ReturnsStructWithByteSlice")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var arriveRawPtr *Param22arrive.ObjectWithByteSlice = nil
+		var arriveRawPtr *Param8arrive.ObjectWithByteSlice = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "", &arriveRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -7118,22 +12422,29 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ReturnsStructWithByteSlice' but body parameter '%s' did not pass validation of '%s' - %s",
-					"arrive",
-					"ObjectWithByteSlice",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithByteSlice",
+						"parameter": "arrive",
+						"type":      "ObjectWithByteSlice",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ReturnsStructWithByteSlice",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "ReturnsStructWithByteSlice")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -7144,13 +12455,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "ReturnsStructWithByteSlice")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ReturnsStructWithByteSlice")
+			}
+		}()
 		value, opError := controller.ReturnsStructWithByteSlice(arriveRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "ReturnsStructWithByteSlice")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7168,6 +12486,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ReturnsStructWithByteSlice",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithByteSliceErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "ReturnsStructWithByteSlice")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -7213,9 +12532,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "ReturnsStructWithByteSlice")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/special-primitives"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/byte-slice")), e2EcontrollerReturnsStructWithByteSliceHandler).Methods("POST")
+	e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes := []int{}
+	e2EcontrollerReturnsStructWithSpecialPrimitivesRoute := withBasePath(basePath, "/e2e/special-primitives")
+	e2EcontrollerReturnsStructWithSpecialPrimitivesHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"ReturnsStructWithSpecialPrimitives",
+			"E2EController",
+			"POST",
+			e2EcontrollerReturnsStructWithSpecialPrimitivesRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "ReturnsStructWithSpecialPrimitives")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -7236,10 +12571,11 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "ReturnsStructWithSpecialPrimitives")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var arriveRawPtr *Param23arrive.ObjectWithSpecialPrimitives = nil
+		var arriveRawPtr *Param24arrive.ObjectWithSpecialPrimitives = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "", &arriveRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -7251,22 +12587,29 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'ReturnsStructWithSpecialPrimitives' but body parameter '%s' did not pass validation of '%s' - %s",
-					"arrive",
-					"ObjectWithSpecialPrimitives",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "ReturnsStructWithSpecialPrimitives",
+						"parameter": "arrive",
+						"type":      "ObjectWithSpecialPrimitives",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/ReturnsStructWithSpecialPrimitives",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "ReturnsStructWithSpecialPrimitives")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -7277,13 +12620,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "ReturnsStructWithSpecialPrimitives")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "ReturnsStructWithSpecialPrimitives")
+			}
+		}()
 		value, opError := controller.ReturnsStructWithSpecialPrimitives(arriveRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "ReturnsStructWithSpecialPrimitives")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7301,6 +12651,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/ReturnsStructWithSpecialPrimitives",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerReturnsStructWithSpecialPrimitivesErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "ReturnsStructWithSpecialPrimitives")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -7346,9 +12697,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "ReturnsStructWithSpecialPrimitives")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/alias-of-primitive"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/special-primitives")), e2EcontrollerReturnsStructWithSpecialPrimitivesHandler).Methods("POST")
+	e2EcontrollerAliasOfStringErrorStatusCodes := []int{}
+	e2EcontrollerAliasOfStringRoute := withBasePath(basePath, "/e2e/alias-of-primitive")
+	e2EcontrollerAliasOfStringHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"AliasOfString",
+			"E2EController",
+			"POST",
+			e2EcontrollerAliasOfStringRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "AliasOfString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -7369,10 +12736,11 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "AliasOfString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var objectRawPtr *Param24object.ObjectWithAliasOfString = nil
+		var objectRawPtr *Param25object.ObjectWithAliasOfString = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &objectRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -7384,27 +12752,33 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'AliasOfString' but body parameter '%s' did not pass validation of '%s' - %s",
-					"object",
-					"ObjectWithAliasOfString",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "AliasOfString",
+						"parameter": "object",
+						"type":      "ObjectWithAliasOfString",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/AliasOfString",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "AliasOfString")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var numRawPtr *Param25num.AliasOfInt = nil
+		var numRawPtr *Param26num.AliasOfInt = nil
 		numRaw := req.URL.Query().Get("num")
 		isnumExists := req.URL.Query().Has("num")
 		if isnumExists {
-			numUint64, conversionErr := strconv.Atoi(numRaw)
+			num, conversionErr := parseIntegerParam[int](numRaw)
 			if conversionErr != nil {
 				// Middlewares onInputValidationMiddlewares section
 				for _, middleware := range onInputValidationMiddlewares {
@@ -7415,25 +12789,22 @@ func RegisterRoutes(engine *mux.Router) {
 					}
 				}
 				// End middlewares onInputValidationMiddlewares section
-				validationError := runtime.Rfc7807Error{
-					Type: http.StatusText(http.StatusUnprocessableEntity),
-					Detail: fmt.Sprintf(
-						"A request was made to operation 'AliasOfString' but parameter '%s' was not properly sent - Expected %s but got %s",
-						"num",
-						"AliasOfInt",
-						reflect.TypeOf(numRaw).String(),
-					),
-					Status:     http.StatusUnprocessableEntity,
-					Instance:   "/validation/error/AliasOfString",
-					Extensions: map[string]string{"error": conversionErr.Error()},
-				}
+				validationError, validationIssues := wrapConversionError(
+					conversionErr,
+					"AliasOfString",
+					"num",
+					"query",
+					"/num",
+					"AliasOfInt",
+					reflect.TypeOf(numRaw).String(),
+					getAcceptedLanguages(req.Header.Get("Accept-Language")),
+				)
 				w.Header().Set("x-ParamsValidationErrorResponseExtension", "AliasOfString")
 				w.WriteHeader(http.StatusUnprocessableEntity)
-				json.NewEncoder(w).Encode(validationError)
+				json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 				return
 			}
-			num := int(numUint64)
-			numVar := Param25num.AliasOfInt(num)
+			numVar := Param26num.AliasOfInt(num)
 			numRawPtr = &numVar
 		}
 		if validatorErr := validatorInstance.Var(numRawPtr, "required"); validatorErr != nil {
@@ -7447,18 +12818,20 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "num"
-			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/num", languages)
 			w.Header().Set("x-RunValidatorExtension", "AliasOfString")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var strRawPtr *Param26str.AliasOfDirectString = nil
+		var strRawPtr *Param27str.AliasOfDirectString = nil
 		strRaw := req.URL.Query().Get("str")
 		isstrExists := req.URL.Query().Has("str")
 		if isstrExists {
 			str := strRaw
-			strVar := Param26str.AliasOfDirectString(str)
+			strVar := Param27str.AliasOfDirectString(str)
 			strRawPtr = &strVar
 		}
 		if validatorErr := validatorInstance.Var(strRawPtr, "required"); validatorErr != nil {
@@ -7472,12 +12845,15 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "str"
-			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "AliasOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/str", languages)
 			w.Header().Set("x-RunValidatorExtension", "AliasOfString")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -7488,13 +12864,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "AliasOfString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "AliasOfString")
+			}
+		}()
 		value, opError := controller.AliasOfString(objectRawPtr, *numRawPtr, *strRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "AliasOfString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7512,6 +12895,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/AliasOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerAliasOfStringErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "AliasOfString")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -7561,9 +12945,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "AliasOfString")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/body-array-of-string"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/alias-of-primitive")), e2EcontrollerAliasOfStringHandler).Methods("POST")
+	e2EcontrollerBodyArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringRoute := withBasePath(basePath, "/e2e/body-array-of-string")
+	e2EcontrollerBodyArrayOfStringHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"BodyArrayOfString",
+			"E2EController",
+			"POST",
+			e2EcontrollerBodyArrayOfStringRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "BodyArrayOfString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -7584,8 +12984,9 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "BodyArrayOfString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var conversionErr error
 		var valuesRawPtr *[]string = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &valuesRawPtr)
@@ -7599,22 +13000,29 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'BodyArrayOfString' but body parameter '%s' did not pass validation of '%s' - %s",
-					"values",
-					"[]string",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfString",
+						"parameter": "values",
+						"type":      "[]string",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/BodyArrayOfString",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "BodyArrayOfString")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -7625,13 +13033,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "BodyArrayOfString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "BodyArrayOfString")
+			}
+		}()
 		value, opError := controller.BodyArrayOfString(*valuesRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "BodyArrayOfString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7649,6 +13064,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/BodyArrayOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "BodyArrayOfString")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -7669,9 +13085,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "BodyArrayOfString")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/body-array-of-enum-string"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/body-array-of-string")), e2EcontrollerBodyArrayOfStringHandler).Methods("POST")
+	e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes := []int{}
+	e2EcontrollerBodyArrayOfStringEnumRoute := withBasePath(basePath, "/e2e/body-array-of-enum-string")
+	e2EcontrollerBodyArrayOfStringEnumHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"BodyArrayOfStringEnum",
+			"E2EController",
+			"POST",
+			e2EcontrollerBodyArrayOfStringEnumRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "BodyArrayOfStringEnum")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -7692,10 +13124,11 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "BodyArrayOfStringEnum")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var conversionErr error
-		var valuesRawPtr *[]Param27values.Myemamium = nil
+		var valuesRawPtr *[]Param28values.Myemamium = nil
 		conversionErr = bindAndValidateBody(req, "application/json", "required", &valuesRawPtr)
 		if conversionErr != nil {
 			// Middlewares onInputValidationMiddlewares section
@@ -7707,22 +13140,29 @@ func RegisterRoutes(engine *mux.Router) {
 				}
 			}
 			// End middlewares onInputValidationMiddlewares section
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
 			validationError := runtime.Rfc7807Error{
 				Type: http.StatusText(http.StatusUnprocessableEntity),
-				Detail: fmt.Sprintf(
-					"A request was made to operation 'BodyArrayOfStringEnum' but body parameter '%s' did not pass validation of '%s' - %s",
-					"values",
-					"[]Myemamium",
-					extractValidationErrorMessage(conversionErr, nil),
+				Detail: translateMessage(
+					languages,
+					map[string]string{
+						"operation": "BodyArrayOfStringEnum",
+						"parameter": "values",
+						"type":      "[]Myemamium",
+						"details":   extractValidationErrorMessage(conversionErr, nil, languages),
+					},
+					MessageKeyBodyValidation,
 				),
 				Status:   http.StatusUnprocessableEntity,
 				Instance: "/validation/error/BodyArrayOfStringEnum",
 			}
+			validationIssues := getValidationIssues(conversionErr, "body", "", languages)
 			w.Header().Set("x-JsonBodyValidationErrorResponseExtension", "BodyArrayOfStringEnum")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -7733,13 +13173,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "BodyArrayOfStringEnum")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "BodyArrayOfStringEnum")
+			}
+		}()
 		value, opError := controller.BodyArrayOfStringEnum(*valuesRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "BodyArrayOfStringEnum")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7757,6 +13204,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/BodyArrayOfStringEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerBodyArrayOfStringEnumErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "BodyArrayOfStringEnum")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -7777,9 +13225,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "BodyArrayOfStringEnum")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/query-array-of-string"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/body-array-of-enum-string")), e2EcontrollerBodyArrayOfStringEnumHandler).Methods("POST")
+	e2EcontrollerQueryArrayOfStringErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfStringRoute := withBasePath(basePath, "/e2e/query-array-of-string")
+	e2EcontrollerQueryArrayOfStringHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"QueryArrayOfString",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfStringRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "QueryArrayOfString")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -7800,8 +13264,9 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "QueryArrayOfString")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var valuesRawPtr *[]string = nil
 		valuesRawArray := req.URL.Query()["values"]
 		isvaluesExists := req.URL.Query().Has("values")
@@ -7825,12 +13290,15 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfString", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			w.Header().Set("x-RunValidatorExtension", "QueryArrayOfString")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -7841,13 +13309,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "QueryArrayOfString")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "QueryArrayOfString")
+			}
+		}()
 		value, opError := controller.QueryArrayOfString(*valuesRawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "QueryArrayOfString")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -7865,6 +13340,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/QueryArrayOfString",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfStringErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "QueryArrayOfString")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -7885,9 +13361,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "QueryArrayOfString")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/query-array-of-enum"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/query-array-of-string")), e2EcontrollerQueryArrayOfStringHandler).Methods("POST")
+	e2EcontrollerQueryArrayOfEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfEnumRoute := withBasePath(basePath, "/e2e/query-array-of-enum")
+	e2EcontrollerQueryArrayOfEnumHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"QueryArrayOfEnum",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfEnumRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "QueryArrayOfEnum")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -7908,13 +13400,14 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "QueryArrayOfEnum")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
-		var valuesRawPtr *[]Param27values.Myemamium = nil
+		telemetry.startPhase("validation")
+		var valuesRawPtr *[]Param28values.Myemamium = nil
 		valuesRawArray := req.URL.Query()["values"]
 		isvaluesExists := req.URL.Query().Has("values")
 		if isvaluesExists {
-			values := make([]Param27values.Myemamium, 0, len(valuesRawArray))
+			values := make([]Param28values.Myemamium, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
 				valuesItem := valuesRaw
 				switch valuesRaw {
@@ -7931,24 +13424,22 @@ func RegisterRoutes(engine *mux.Router) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]Myemamium",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfEnum",
+						"values",
+						"query",
+						"/values",
+						"[]Myemamium",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
 					w.Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfEnum")
 					w.WriteHeader(http.StatusUnprocessableEntity)
-					json.NewEncoder(w).Encode(validationError)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				values = append(values, Param27values.Myemamium(valuesItem))
+				values = append(values, Param28values.Myemamium(valuesItem))
 			}
 			valuesRawPtr = &values
 		}
@@ -7963,23 +13454,25 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			w.Header().Set("x-RunValidatorExtension", "QueryArrayOfEnum")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var values2RawPtr *[]Param28values2.MyaliasString = nil
+		var values2RawPtr *[]Param29values2.MyaliasString = nil
 		values2RawArray := req.URL.Query()["values2"]
 		isvalues2Exists := req.URL.Query().Has("values2")
 		if isvalues2Exists {
-			values2 := make([]Param28values2.MyaliasString, 0, len(values2RawArray))
+			values2 := make([]Param29values2.MyaliasString, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
 				values2Item := values2Raw
-				values2 = append(values2, Param28values2.MyaliasString(values2Item))
+				values2 = append(values2, Param29values2.MyaliasString(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param28values2.MyaliasString(values2)
+			values2Var := []Param29values2.MyaliasString(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -7993,12 +13486,15 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			w.Header().Set("x-RunValidatorExtension", "QueryArrayOfEnum")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -8009,13 +13505,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "QueryArrayOfEnum")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "QueryArrayOfEnum")
+			}
+		}()
 		value, opError := controller.QueryArrayOfEnum(*valuesRawPtr, *values2RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "QueryArrayOfEnum")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -8033,6 +13536,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/QueryArrayOfEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfEnumErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "QueryArrayOfEnum")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -8053,9 +13557,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "QueryArrayOfEnum")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/query-array-of-others"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/query-array-of-enum")), e2EcontrollerQueryArrayOfEnumHandler).Methods("POST")
+	e2EcontrollerQueryArrayOfOthersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersRoute := withBasePath(basePath, "/e2e/query-array-of-others")
+	e2EcontrollerQueryArrayOfOthersHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"QueryArrayOfOthers",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfOthersRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "QueryArrayOfOthers")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -8076,15 +13596,16 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "QueryArrayOfOthers")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var valuesRawPtr *[]int = nil
 		valuesRawArray := req.URL.Query()["values"]
 		isvaluesExists := req.URL.Query().Has("values")
 		if isvaluesExists {
 			values := make([]int, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
-				valuesUint64, conversionErr := strconv.Atoi(valuesRaw)
+				valuesItem, conversionErr := parseIntegerParam[int](valuesRaw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -8095,24 +13616,21 @@ func RegisterRoutes(engine *mux.Router) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]int",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values",
+						"query",
+						"/values",
+						"[]int",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
 					w.Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
 					w.WriteHeader(http.StatusUnprocessableEntity)
-					json.NewEncoder(w).Encode(validationError)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				valuesItem := int(valuesUint64)
 				values = append(values, int(valuesItem))
 			}
 			valuesRawPtr = &values
@@ -8129,19 +13647,21 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			w.Header().Set("x-RunValidatorExtension", "QueryArrayOfOthers")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var values2RawPtr *[]Param29values2.MyaliasInt = nil
+		var values2RawPtr *[]Param30values2.MyaliasInt = nil
 		values2RawArray := req.URL.Query()["values2"]
 		isvalues2Exists := req.URL.Query().Has("values2")
 		if isvalues2Exists {
-			values2 := make([]Param29values2.MyaliasInt, 0, len(values2RawArray))
+			values2 := make([]Param30values2.MyaliasInt, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
-				values2Uint64, conversionErr := strconv.Atoi(values2Raw)
+				values2Item, conversionErr := parseIntegerParam[int](values2Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -8152,28 +13672,25 @@ func RegisterRoutes(engine *mux.Router) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values2",
-							"[]MyaliasInt",
-							reflect.TypeOf(values2Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values2",
+						"query",
+						"/values2",
+						"[]MyaliasInt",
+						reflect.TypeOf(values2Raw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
 					w.Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
 					w.WriteHeader(http.StatusUnprocessableEntity)
-					json.NewEncoder(w).Encode(validationError)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				values2Item := int(values2Uint64)
-				values2 = append(values2, Param29values2.MyaliasInt(values2Item))
+				values2 = append(values2, Param30values2.MyaliasInt(values2Item))
 			}
 			values2RawPtr = &values2
-			values2Var := []Param29values2.MyaliasInt(values2)
+			values2Var := []Param30values2.MyaliasInt(values2)
 			values2RawPtr = &values2Var
 		}
 		if validatorErr := validatorInstance.Var(values2RawPtr, "required"); validatorErr != nil {
@@ -8187,10 +13704,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			w.Header().Set("x-RunValidatorExtension", "QueryArrayOfOthers")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var values3RawPtr *[]bool = nil
@@ -8199,7 +13718,7 @@ func RegisterRoutes(engine *mux.Router) {
 		if isvalues3Exists {
 			values3 := make([]bool, 0, len(values3RawArray))
 			for _, values3Raw := range values3RawArray {
-				values3Bool, conversionErr := strconv.ParseBool(values3Raw)
+				values3Item, conversionErr := strconv.ParseBool(values3Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -8210,24 +13729,21 @@ func RegisterRoutes(engine *mux.Router) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values3",
-							"[]bool",
-							reflect.TypeOf(values3Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values3",
+						"query",
+						"/values3",
+						"[]bool",
+						reflect.TypeOf(values3Raw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
 					w.Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
 					w.WriteHeader(http.StatusUnprocessableEntity)
-					json.NewEncoder(w).Encode(validationError)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				values3Item := values3Bool
 				values3 = append(values3, bool(values3Item))
 			}
 			values3RawPtr = &values3
@@ -8244,10 +13760,12 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values3"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values3", languages)
 			w.Header().Set("x-RunValidatorExtension", "QueryArrayOfOthers")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
 		var values4RawPtr *[]int32 = nil
@@ -8256,7 +13774,7 @@ func RegisterRoutes(engine *mux.Router) {
 		if isvalues4Exists {
 			values4 := make([]int32, 0, len(values4RawArray))
 			for _, values4Raw := range values4RawArray {
-				values4Uint64, conversionErr := strconv.ParseInt(values4Raw, 10, 32)
+				values4Item, conversionErr := parseIntegerParam[int32](values4Raw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -8267,24 +13785,21 @@ func RegisterRoutes(engine *mux.Router) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthers' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values4",
-							"[]int32",
-							reflect.TypeOf(values4Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthers",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthers",
+						"values4",
+						"query",
+						"/values4",
+						"[]int32",
+						reflect.TypeOf(values4Raw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
 					w.Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthers")
 					w.WriteHeader(http.StatusUnprocessableEntity)
-					json.NewEncoder(w).Encode(validationError)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				values4Item := int32(values4Uint64)
 				values4 = append(values4, int32(values4Item))
 			}
 			values4RawPtr = &values4
@@ -8301,12 +13816,15 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values4"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthers", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values4", languages)
 			w.Header().Set("x-RunValidatorExtension", "QueryArrayOfOthers")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -8317,13 +13835,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "QueryArrayOfOthers")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "QueryArrayOfOthers")
+			}
+		}()
 		value, opError := controller.QueryArrayOfOthers(*valuesRawPtr, *values2RawPtr, *values3RawPtr, *values4RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "QueryArrayOfOthers")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -8341,6 +13866,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/QueryArrayOfOthers",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "QueryArrayOfOthers")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -8361,9 +13887,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "QueryArrayOfOthers")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/query-array-of-others-enum"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/query-array-of-others")), e2EcontrollerQueryArrayOfOthersHandler).Methods("POST")
+	e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfOthersEnumRoute := withBasePath(basePath, "/e2e/query-array-of-others-enum")
+	e2EcontrollerQueryArrayOfOthersEnumHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"QueryArrayOfOthersEnum",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfOthersEnumRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "QueryArrayOfOthersEnum")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -8384,15 +13926,16 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "QueryArrayOfOthersEnum")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
-		var valuesRawPtr *[]Param31values.NumberEnum = nil
+		telemetry.startPhase("validation")
+		var valuesRawPtr *[]Param32values.NumberEnum = nil
 		valuesRawArray := req.URL.Query()["values"]
 		isvaluesExists := req.URL.Query().Has("values")
 		if isvaluesExists {
-			values := make([]Param31values.NumberEnum, 0, len(valuesRawArray))
+			values := make([]Param32values.NumberEnum, 0, len(valuesRawArray))
 			for _, valuesRaw := range valuesRawArray {
-				valuesUint64, conversionErr := strconv.ParseInt(valuesRaw, 10, 16)
+				valuesItem, conversionErr := parseIntegerParam[int16](valuesRaw)
 				if conversionErr != nil {
 					// Middlewares onInputValidationMiddlewares section
 					for _, middleware := range onInputValidationMiddlewares {
@@ -8403,24 +13946,21 @@ func RegisterRoutes(engine *mux.Router) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthersEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]NumberEnum",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthersEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values",
+						"query",
+						"/values",
+						"[]NumberEnum",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
 					w.Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthersEnum")
 					w.WriteHeader(http.StatusUnprocessableEntity)
-					json.NewEncoder(w).Encode(validationError)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				valuesItem := int16(valuesUint64)
 				switch valuesRaw {
 				case "1", "2":
 					break
@@ -8435,24 +13975,22 @@ func RegisterRoutes(engine *mux.Router) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthersEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values",
-							"[]NumberEnum",
-							reflect.TypeOf(valuesRaw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthersEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values",
+						"query",
+						"/values",
+						"[]NumberEnum",
+						reflect.TypeOf(valuesRaw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
 					w.Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthersEnum")
 					w.WriteHeader(http.StatusUnprocessableEntity)
-					json.NewEncoder(w).Encode(validationError)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				values = append(values, Param31values.NumberEnum(valuesItem))
+				values = append(values, Param32values.NumberEnum(valuesItem))
 			}
 			valuesRawPtr = &values
 		}
@@ -8467,17 +14005,19 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values", languages)
 			w.Header().Set("x-RunValidatorExtension", "QueryArrayOfOthersEnum")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
-		var values2RawPtr *[]Param32values2.BoolEnum = nil
+		var values2RawPtr *[]Param33values2.BoolEnum = nil
 		values2RawArray := req.URL.Query()["values2"]
 		isvalues2Exists := req.URL.Query().Has("values2")
 		if isvalues2Exists {
-			values2 := make([]Param32values2.BoolEnum, 0, len(values2RawArray))
+			values2 := make([]Param33values2.BoolEnum, 0, len(values2RawArray))
 			for _, values2Raw := range values2RawArray {
 				values2Item := values2Raw
 				switch values2Raw {
@@ -8494,24 +14034,22 @@ func RegisterRoutes(engine *mux.Router) {
 						}
 					}
 					// End middlewares onInputValidationMiddlewares section
-					validationError := runtime.Rfc7807Error{
-						Type: http.StatusText(http.StatusUnprocessableEntity),
-						Detail: fmt.Sprintf(
-							"A request was made to operation 'QueryArrayOfOthersEnum' but parameter '%s' was not properly sent - Expected %s but got %s",
-							"values2",
-							"[]BoolEnum",
-							reflect.TypeOf(values2Raw).String(),
-						),
-						Status:     http.StatusUnprocessableEntity,
-						Instance:   "/validation/error/QueryArrayOfOthersEnum",
-						Extensions: map[string]string{"error": conversionErr.Error()},
-					}
+					validationError, validationIssues := wrapConversionError(
+						conversionErr,
+						"QueryArrayOfOthersEnum",
+						"values2",
+						"query",
+						"/values2",
+						"[]BoolEnum",
+						reflect.TypeOf(values2Raw).String(),
+						getAcceptedLanguages(req.Header.Get("Accept-Language")),
+					)
 					w.Header().Set("x-ParamsValidationErrorResponseExtension", "QueryArrayOfOthersEnum")
 					w.WriteHeader(http.StatusUnprocessableEntity)
-					json.NewEncoder(w).Encode(validationError)
+					json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 					return
 				}
-				values2 = append(values2, Param32values2.BoolEnum(values2Item))
+				values2 = append(values2, Param33values2.BoolEnum(values2Item))
 			}
 			values2RawPtr = &values2
 		}
@@ -8526,12 +14064,15 @@ func RegisterRoutes(engine *mux.Router) {
 			}
 			// End middlewares onInputValidationMiddlewares section
 			fieldName := "values2"
-			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName)
+			languages := getAcceptedLanguages(req.Header.Get("Accept-Language"))
+			validationError := wrapValidatorError(validatorErr, "QueryArrayOfOthersEnum", fieldName, languages)
+			validationIssues := getValidationIssues(validatorErr, "query", "/values2", languages)
 			w.Header().Set("x-RunValidatorExtension", "QueryArrayOfOthersEnum")
 			w.WriteHeader(http.StatusUnprocessableEntity)
-			json.NewEncoder(w).Encode(validationError)
+			json.NewEncoder(w).Encode(ValidationProblem{Rfc7807Error: validationError, Errors: validationIssues})
 			return
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -8542,13 +14083,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "QueryArrayOfOthersEnum")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "QueryArrayOfOthersEnum")
+			}
+		}()
 		value, opError := controller.QueryArrayOfOthersEnum(*valuesRawPtr, *values2RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "QueryArrayOfOthersEnum")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -8566,6 +14114,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/QueryArrayOfOthersEnum",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfOthersEnumErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "QueryArrayOfOthersEnum")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -8586,9 +14135,25 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "QueryArrayOfOthersEnum")
-	}).Methods("POST")
-	engine.HandleFunc(toMuxUrl("/e2e/query-pointer-to-array"), func(w http.ResponseWriter, req *http.Request) {
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/query-array-of-others-enum")), e2EcontrollerQueryArrayOfOthersEnumHandler).Methods("POST")
+	e2EcontrollerQueryArrayOfPointersErrorStatusCodes := []int{}
+	e2EcontrollerQueryArrayOfPointersRoute := withBasePath(basePath, "/e2e/query-pointer-to-array")
+	e2EcontrollerQueryArrayOfPointersHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"QueryArrayOfPointers",
+			"E2EController",
+			"POST",
+			e2EcontrollerQueryArrayOfPointersRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
 		w.Header().Set("x-RouteStartRoutesExtension", "QueryArrayOfPointers")
+		telemetry.startPhase("authorization")
 		authErr := authorize(
 			req,
 			[]SecurityCheckList{
@@ -8609,8 +14174,9 @@ func RegisterRoutes(engine *mux.Router) {
 			handleAuthorizationError(w, authErr, "QueryArrayOfPointers")
 			return
 		}
-		controller := E2EController.E2EController{}
+		controller := createController[E2EController.E2EController](getRequestContext(req))
 		controller.InitController(req)
+		telemetry.startPhase("validation")
 		var values07RawPtr *[]string = nil
 		values07RawArray := req.URL.Query()["values07"]
 		isvalues07Exists := req.URL.Query().Has("values07")
@@ -8623,6 +14189,7 @@ func RegisterRoutes(engine *mux.Router) {
 			values07RawPtr = &values07
 			values07RawPtr = &values07
 		}
+		telemetry.endPhase()
 		// Middlewares beforeOperationMiddlewares section
 		for _, middleware := range beforeOperationMiddlewares {
 			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
@@ -8633,13 +14200,20 @@ func RegisterRoutes(engine *mux.Router) {
 		}
 		// End middlewares beforeOperationMiddlewares section
 		w.Header().Set("x-BeforeOperationRoutesExtension", "QueryArrayOfPointers")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "QueryArrayOfPointers")
+			}
+		}()
 		value, opError := controller.QueryArrayOfPointers(values07RawPtr)
+		telemetry.endPhase()
 		for key, value := range controller.GetHeaders() {
 			w.Header().Set(key, value)
 		}
 		w.Header().Set("x-inject", "true")
 		w.Header().Set("x-ResponseHeadersExtension", "QueryArrayOfPointers")
-		statusCode := getStatusCode(&controller, true, opError)
+		statusCode := getStatusCode(controller, true, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
 		if opError != nil {
 			// Middlewares onErrorMiddlewares section
 			for _, middleware := range onErrorMiddlewares {
@@ -8657,6 +14231,7 @@ func RegisterRoutes(engine *mux.Router) {
 				Instance:   "/controller/error/QueryArrayOfPointers",
 				Extensions: map[string]string{"error": opError.Error()},
 			}
+			applyErrorMapping(&stdError, opError, e2EcontrollerQueryArrayOfPointersErrorStatusCodes)
 			w.Header().Set("x-JsonErrorResponseExtension", "QueryArrayOfPointers")
 			w.WriteHeader(statusCode)
 			json.NewEncoder(w).Encode(stdError)
@@ -8677,5 +14252,225 @@ func RegisterRoutes(engine *mux.Router) {
 		w.WriteHeader(statusCode)
 		json.NewEncoder(w).Encode(value)
 		w.Header().Set("x-RouteEndRoutesExtension", "QueryArrayOfPointers")
-	}).Methods("POST")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/query-pointer-to-array")), e2EcontrollerQueryArrayOfPointersHandler).Methods("POST")
+	// E2ESharedRateLimitController
+	e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitFirstRoute := withBasePath(basePath, "/e2e/shared-rate-limit/first")
+	e2EsharedRateLimitControllerSharedRateLimitFirstHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SharedRateLimitFirst",
+			"E2ESharedRateLimitController",
+			"GET",
+			e2EsharedRateLimitControllerSharedRateLimitFirstRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "SharedRateLimitFirst")
+		if !enforceRateLimit(w, req, "SharedRateLimitFirst", e2EsharedRateLimitControllerSharedRateLimitFirstRateLimit) {
+			return
+		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SharedRateLimitFirst")
+			return
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "SharedRateLimitFirst")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SharedRateLimitFirst")
+			}
+		}()
+		value, opError := controller.SharedRateLimitFirst()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "SharedRateLimitFirst")
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitFirst'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitFirst",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitFirstErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "SharedRateLimitFirst")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "SharedRateLimitFirst")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "SharedRateLimitFirst")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "SharedRateLimitFirst")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/shared-rate-limit/first")), e2EsharedRateLimitControllerSharedRateLimitFirstHandler).Methods("GET", "HEAD")
+	e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes := []int{}
+	e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit := newRateLimit(2, "1h", "header:X-Rate-Limit-Key", "E2ESharedRateLimitController")
+	e2EsharedRateLimitControllerSharedRateLimitSecondRoute := withBasePath(basePath, "/e2e/shared-rate-limit/second")
+	e2EsharedRateLimitControllerSharedRateLimitSecondHandler := func(w http.ResponseWriter, req *http.Request) {
+		statusWriter := newStatusRecordingResponseWriter(w)
+		w = statusWriter
+		telemetry := startOperationTelemetry(
+			getRequestContext(req),
+			propagation.HeaderCarrier(req.Header),
+			"SharedRateLimitSecond",
+			"E2ESharedRateLimitController",
+			"GET",
+			e2EsharedRateLimitControllerSharedRateLimitSecondRoute,
+		)
+		setRequestContext(req, telemetry.ctx)
+		defer func() { telemetry.end(statusWriter.statusCode) }()
+		w.Header().Set("x-RouteStartRoutesExtension", "SharedRateLimitSecond")
+		if !enforceRateLimit(w, req, "SharedRateLimitSecond", e2EsharedRateLimitControllerSharedRateLimitSecondRateLimit) {
+			return
+		}
+		telemetry.startPhase("authorization")
+		authErr := authorize(
+			req,
+			[]SecurityCheckList{
+				{
+					Relation: SecurityListRelationAnd,
+					Checks: []runtime.SecurityCheck{
+						{
+							SchemaName: "securitySchemaName2",
+							Scopes: []string{
+								"config",
+							},
+						},
+					},
+				},
+			},
+		)
+		if authErr != nil {
+			handleAuthorizationError(w, authErr, "SharedRateLimitSecond")
+			return
+		}
+		controller := createController[E2ESharedRateLimitController.E2ESharedRateLimitController](getRequestContext(req))
+		controller.InitController(req)
+		telemetry.startPhase("validation")
+		telemetry.endPhase()
+		// Middlewares beforeOperationMiddlewares section
+		for _, middleware := range beforeOperationMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares beforeOperationMiddlewares section
+		w.Header().Set("x-BeforeOperationRoutesExtension", "SharedRateLimitSecond")
+		telemetry.startPhase("handler")
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				handleOperationPanic(w, req, recovered, "SharedRateLimitSecond")
+			}
+		}()
+		value, opError := controller.SharedRateLimitSecond()
+		telemetry.endPhase()
+		for key, value := range controller.GetHeaders() {
+			w.Header().Set(key, value)
+		}
+		w.Header().Set("x-inject", "true")
+		w.Header().Set("x-ResponseHeadersExtension", "SharedRateLimitSecond")
+		statusCode := getStatusCode(controller, true, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+		if opError != nil {
+			// Middlewares onErrorMiddlewares section
+			for _, middleware := range onErrorMiddlewares {
+				middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req, opError)
+				setRequestContext(req, middlewareCtx)
+				if !continueOperation {
+					return
+				}
+			}
+			// End middlewares onErrorMiddlewares section
+			stdError := runtime.Rfc7807Error{
+				Type:       http.StatusText(statusCode),
+				Detail:     "Encountered an error during operation 'SharedRateLimitSecond'",
+				Status:     statusCode,
+				Instance:   "/controller/error/SharedRateLimitSecond",
+				Extensions: map[string]string{"error": opError.Error()},
+			}
+			applyErrorMapping(&stdError, opError, e2EsharedRateLimitControllerSharedRateLimitSecondErrorStatusCodes)
+			w.Header().Set("x-JsonErrorResponseExtension", "SharedRateLimitSecond")
+			w.WriteHeader(statusCode)
+			json.NewEncoder(w).Encode(stdError)
+			return
+		}
+		w.Header().Set("x-JsonResponseExtension", "SharedRateLimitSecond")
+		// Middlewares afterOperationSuccessMiddlewares section
+		for _, middleware := range afterOperationSuccessMiddlewares {
+			middlewareCtx, continueOperation := middleware(getRequestContext(req), w, req)
+			setRequestContext(req, middlewareCtx)
+			if !continueOperation {
+				return
+			}
+		}
+		// End middlewares afterOperationSuccessMiddlewares section
+		w.Header().Set("x-AfterOperationRoutesExtension", "SharedRateLimitSecond")
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(statusCode)
+		json.NewEncoder(w).Encode(value)
+		w.Header().Set("x-RouteEndRoutesExtension", "SharedRateLimitSecond")
+	}
+	engine.HandleFunc(toMuxUrl(withBasePath(basePath, "/e2e/shared-rate-limit/second")), e2EsharedRateLimitControllerSharedRateLimitSecondHandler).Methods("GET", "HEAD")
 }
@@ -1,8 +1,11 @@
 package e2e
 
 import (
+	"context"
 	"fmt"
+	"net/http"
 	"os"
+	"slices"
 	"strings"
 	"testing"
 	"time"
@@ -12,6 +15,8 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/gopher-fleece/runtime"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 
 	"github.com/gopher-fleece/gleece/v2/cmd"
 	"github.com/gopher-fleece/gleece/v2/cmd/arguments"
@@ -46,6 +51,12 @@ import (
 	ginMiddlewares "github.com/gopher-fleece/gleece/v2/e2e/gin/middlewares"
 	muxMiddlewares "github.com/gopher-fleece/gleece/v2/e2e/mux/middlewares"
 
+	chiAuth "github.com/gopher-fleece/gleece/v2/e2e/chi/auth"
+	echoAuth "github.com/gopher-fleece/gleece/v2/e2e/echo/auth"
+	fiberAuth "github.com/gopher-fleece/gleece/v2/e2e/fiber/auth"
+	ginAuth "github.com/gopher-fleece/gleece/v2/e2e/gin/auth"
+	muxAuth "github.com/gopher-fleece/gleece/v2/e2e/mux/auth"
+
 	"github.com/gofiber/fiber/v2"
 	"github.com/gopher-fleece/gleece/v2/infrastructure/logger"
 	"github.com/labstack/echo/v4"
@@ -106,6 +117,12 @@ func GenerateE2ERoutes(args arguments.CliArguments, engineName string) error {
 	config.RoutesConfig.AuthorizationConfig.EnforceSecurityOnAllRoutes = false
 	config.RoutesConfig.ValidateResponsePayload = false
 	config.RoutesConfig.SkipGenerateDateComment = false
+	config.RoutesConfig.EnableOpenTelemetry = false
+	config.RoutesConfig.RecoverPanics = false
+	config.RoutesConfig.DeriveHeadFromGet = false
+	config.RoutesConfig.AuthorizationConfig.UseSecurityHandlers = false
+	// Versioned routes are dispatched by header rather than by path prefix
+	config.Versioning.Strategy = definitions.VersioningStrategyHeader
 
 	config.RoutesConfig.OutputPath = fmt.Sprintf("./%s/ex_extra_routes/%s.e2e.ex_extra.gleece.go", engineName, engineName)
 	config.RoutesConfig.PackageName = "ex_extra_routes"
@@ -118,8 +135,9 @@ func GenerateE2ERoutes(args arguments.CliArguments, engineName string) error {
 
 	// Generate the OpenAPI 3.0.0 spec
 	config.OpenAPIGeneratorConfig.SpecGeneratorConfig.OutputPath = fmt.Sprintf("./%s/openapi/openapi3.0.0.json", engineName)
-	if err := swagen.GenerateAndOutputSpec(
-		&config.OpenAPIGeneratorConfig,
+	if err := swagen.GenerateAndOutputVersionedSpecs(
+		config.GetOpenAPIGeneratorConfig(),
+		config.GetVersioningConfig(),
 		meta.Flat,
 		&meta.Models,
 		meta.PlainErrorPresent,
@@ -131,8 +149,9 @@ func GenerateE2ERoutes(args arguments.CliArguments, engineName string) error {
 	// Generate the OpenAPI 3.1.0 spec
 	config.OpenAPIGeneratorConfig.OpenAPI = "3.1.0"
 	config.OpenAPIGeneratorConfig.SpecGeneratorConfig.OutputPath = fmt.Sprintf("./%s/openapi/openapi3.1.0.json", engineName)
-	if err := swagen.GenerateAndOutputSpec(
-		&config.OpenAPIGeneratorConfig,
+	if err := swagen.GenerateAndOutputVersionedSpecs(
+		config.GetOpenAPIGeneratorConfig(),
+		config.GetVersioningConfig(),
 		meta.Flat,
 		&meta.Models,
 		meta.PlainErrorPresent,
@@ -145,6 +164,20 @@ func GenerateE2ERoutes(args arguments.CliArguments, engineName string) error {
 	return nil
 }
 
+// reportedPanic is a panic recovered from an operation of the fully featured routes, as passed to their panic reporters
+type reportedPanic struct {
+	operationId string
+	value       any
+	stack       []byte
+}
+
+var reportedPanics []reportedPanic
+
+// newE2EController is the controller factory injecting the E2EController's dependencies
+func newE2EController(ctx context.Context) *e2eAssets.E2EController {
+	return e2eAssets.NewE2EController("injected greeting")
+}
+
 func RegenerateRoutes() {
 
 	var err error
@@ -183,11 +216,45 @@ var _ = BeforeSuite(func() {
 	// Generate routes
 	RegenerateRoutes()
 
+	// Continue traces propagated via the W3C 'traceparent' header
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
 	// Init routers
 
 	// Set Gin
 	gin.SetMode(gin.TestMode)
 	ginTester.GinRouter = gin.Default()
+	gleeceGinRoutes.RegisterErrorMapping(e2eAssets.ErrE2EResourceMissing, http.StatusNotFound)
+	gleeceGinRoutes.RegisterControllerFactory(newE2EController)
+	gleeceGinRoutes.RegisterValidationTagTranslation("fr", "min", "Le champ '{field}' doit contenir au moins {param} caractères")
+	gleeceGinRoutes.RegisterTranslation("fr", gleeceGinRoutes.MessageKeyBodyValidation, "Le corps '{parameter}' de l'opération '{operation}' est invalide - {details}")
+	gleeceGinRoutes.RegisterErrorTypeMapping[*e2eAssets.E2EConflictError](gleeceGinRoutes.ErrorMapping{
+		StatusCode: http.StatusConflict,
+		Title:      "Resource Conflict",
+		Detail:     "The resource was modified by another request",
+	})
+	gleeceGinRoutes.RegisterNamedMiddleware("audit", ginMiddlewares.MiddlewareAudit)
+	gleeceGinRoutes.RegisterNamedMiddleware("trace", ginMiddlewares.MiddlewareTrace)
+	gleeceGinRoutes.RegisterTracerProvider(tracerProvider)
+	gleeceGinRoutes.RegisterMeterProvider(meterProvider)
+	gleeceGinRoutes.RegisterPanicReporter(func(ctx context.Context, operationId string, panicErr *gleeceGinRoutes.PanicError) {
+		reportedPanics = append(reportedPanics, reportedPanic{operationId: operationId, value: panicErr.Value, stack: panicErr.Stack})
+	})
+	for _, schemaName := range e2eSecuritySchemes {
+		gleeceGinRoutes.RegisterSecurityHandler(schemaName, func(
+			ctx context.Context,
+			ginCtx *gin.Context,
+			check runtime.SecurityCheck,
+			credentials gleeceGinRoutes.SecurityCredentials,
+		) (context.Context, *runtime.SecurityError) {
+			authorizedCredentials = append(authorizedCredentials, authorizedCredential(credentials))
+			authCtx, secErr := ginAuth.GleeceRequestAuthorization(ctx, ginCtx, check)
+			if secErr == nil && credentials.ApiKey != "" {
+				authCtx = gleeceGinRoutes.WithPrincipal(authCtx, &e2eAssets.E2EPrincipal{Name: credentials.ApiKey})
+			}
+			return authCtx, secErr
+		})
+	}
 	gleeceGinRoutes.RegisterRoutes(ginTester.GinRouter)
 	gleeceGinRoutes.RegisterMiddleware(runtime.BeforeOperation, ginMiddlewares.MiddlewareBeforeOperation)
 	gleeceGinRoutes.RegisterMiddleware(runtime.AfterOperationSuccess, ginMiddlewares.MiddlewareAfterOperationSuccess)
@@ -198,7 +265,19 @@ var _ = BeforeSuite(func() {
 	gleeceGinRoutes.RegisterCustomValidator("validate_starts_with_letter", e2eAssets.ValidateStartsWithLetter)
 
 	ginTester.GinExExtraRouter = gin.Default()
+	gleeceGinRoutesExExtra.RegisterErrorMapping(e2eAssets.ErrE2EResourceMissing, http.StatusNotFound)
+	gleeceGinRoutesExExtra.RegisterControllerFactory(newE2EController)
+	gleeceGinRoutesExExtra.RegisterValidationTagTranslation("fr", "min", "Le champ '{field}' doit contenir au moins {param} caractères")
+	gleeceGinRoutesExExtra.RegisterTranslation("fr", gleeceGinRoutesExExtra.MessageKeyBodyValidation, "Le corps '{parameter}' de l'opération '{operation}' est invalide - {details}")
+	gleeceGinRoutesExExtra.RegisterErrorTypeMapping[*e2eAssets.E2EConflictError](gleeceGinRoutesExExtra.ErrorMapping{
+		StatusCode: http.StatusConflict,
+		Title:      "Resource Conflict",
+		Detail:     "The resource was modified by another request",
+	})
+	gleeceGinRoutesExExtra.RegisterNamedMiddleware("audit", ginMiddlewares.MiddlewareAudit)
+	gleeceGinRoutesExExtra.RegisterNamedMiddleware("trace", ginMiddlewares.MiddlewareTrace)
 	gleeceGinRoutesExExtra.RegisterRoutes(ginTester.GinExExtraRouter)
+	gleeceGinRoutesExExtra.RegisterGroupRoutes(ginTester.GinExExtraRouter.Group("/api"), "/v1")
 
 	// Set Echo
 	echoTester.EchoRouter = echo.New()
@@ -210,10 +289,53 @@ var _ = BeforeSuite(func() {
 	gleeceEchoRoutes.RegisterErrorMiddleware(runtime.OnInputValidationError, echoMiddlewares.MiddlewareOnValidationError)
 	gleeceEchoRoutes.RegisterErrorMiddleware(runtime.OnOutputValidationError, echoMiddlewares.MiddlewareOnOutputValidationError)
 	gleeceEchoRoutes.RegisterCustomValidator("validate_starts_with_letter", e2eAssets.ValidateStartsWithLetter)
+	gleeceEchoRoutes.RegisterErrorMapping(e2eAssets.ErrE2EResourceMissing, http.StatusNotFound)
+	gleeceEchoRoutes.RegisterControllerFactory(newE2EController)
+	gleeceEchoRoutes.RegisterValidationTagTranslation("fr", "min", "Le champ '{field}' doit contenir au moins {param} caractères")
+	gleeceEchoRoutes.RegisterTranslation("fr", gleeceEchoRoutes.MessageKeyBodyValidation, "Le corps '{parameter}' de l'opération '{operation}' est invalide - {details}")
+	gleeceEchoRoutes.RegisterErrorTypeMapping[*e2eAssets.E2EConflictError](gleeceEchoRoutes.ErrorMapping{
+		StatusCode: http.StatusConflict,
+		Title:      "Resource Conflict",
+		Detail:     "The resource was modified by another request",
+	})
+	gleeceEchoRoutes.RegisterNamedMiddleware("audit", echoMiddlewares.MiddlewareAudit)
+	gleeceEchoRoutes.RegisterNamedMiddleware("trace", echoMiddlewares.MiddlewareTrace)
+	gleeceEchoRoutes.RegisterTracerProvider(tracerProvider)
+	gleeceEchoRoutes.RegisterMeterProvider(meterProvider)
+	gleeceEchoRoutes.RegisterPanicReporter(func(ctx context.Context, operationId string, panicErr *gleeceEchoRoutes.PanicError) {
+		reportedPanics = append(reportedPanics, reportedPanic{operationId: operationId, value: panicErr.Value, stack: panicErr.Stack})
+	})
+	for _, schemaName := range e2eSecuritySchemes {
+		gleeceEchoRoutes.RegisterSecurityHandler(schemaName, func(
+			ctx context.Context,
+			echoCtx echo.Context,
+			check runtime.SecurityCheck,
+			credentials gleeceEchoRoutes.SecurityCredentials,
+		) (context.Context, *runtime.SecurityError) {
+			authorizedCredentials = append(authorizedCredentials, authorizedCredential(credentials))
+			authCtx, secErr := echoAuth.GleeceRequestAuthorization(ctx, echoCtx, check)
+			if secErr == nil && credentials.ApiKey != "" {
+				authCtx = gleeceEchoRoutes.WithPrincipal(authCtx, &e2eAssets.E2EPrincipal{Name: credentials.ApiKey})
+			}
+			return authCtx, secErr
+		})
+	}
 	gleeceEchoRoutes.RegisterRoutes(echoTester.EchoRouter)
 
 	echoTester.EchoExExtraRouter = echo.New()
+	gleeceEchoRoutesExExtra.RegisterErrorMapping(e2eAssets.ErrE2EResourceMissing, http.StatusNotFound)
+	gleeceEchoRoutesExExtra.RegisterControllerFactory(newE2EController)
+	gleeceEchoRoutesExExtra.RegisterValidationTagTranslation("fr", "min", "Le champ '{field}' doit contenir au moins {param} caractères")
+	gleeceEchoRoutesExExtra.RegisterTranslation("fr", gleeceEchoRoutesExExtra.MessageKeyBodyValidation, "Le corps '{parameter}' de l'opération '{operation}' est invalide - {details}")
+	gleeceEchoRoutesExExtra.RegisterErrorTypeMapping[*e2eAssets.E2EConflictError](gleeceEchoRoutesExExtra.ErrorMapping{
+		StatusCode: http.StatusConflict,
+		Title:      "Resource Conflict",
+		Detail:     "The resource was modified by another request",
+	})
+	gleeceEchoRoutesExExtra.RegisterNamedMiddleware("audit", echoMiddlewares.MiddlewareAudit)
+	gleeceEchoRoutesExExtra.RegisterNamedMiddleware("trace", echoMiddlewares.MiddlewareTrace)
 	gleeceEchoRoutesExExtra.RegisterRoutes(echoTester.EchoExExtraRouter)
+	gleeceEchoRoutesExExtra.RegisterGroupRoutes(echoTester.EchoExExtraRouter.Group("/api"), "/v1")
 
 	// Set Gorilla mux
 	muxTester.MuxRouter = mux.NewRouter()
@@ -224,10 +346,53 @@ var _ = BeforeSuite(func() {
 	gleeceMuxRoutes.RegisterErrorMiddleware(runtime.OnInputValidationError, muxMiddlewares.MiddlewareOnValidationError)
 	gleeceMuxRoutes.RegisterErrorMiddleware(runtime.OnOutputValidationError, muxMiddlewares.MiddlewareOnOutputValidationError)
 	gleeceMuxRoutes.RegisterCustomValidator("validate_starts_with_letter", e2eAssets.ValidateStartsWithLetter)
+	gleeceMuxRoutes.RegisterErrorMapping(e2eAssets.ErrE2EResourceMissing, http.StatusNotFound)
+	gleeceMuxRoutes.RegisterControllerFactory(newE2EController)
+	gleeceMuxRoutes.RegisterValidationTagTranslation("fr", "min", "Le champ '{field}' doit contenir au moins {param} caractères")
+	gleeceMuxRoutes.RegisterTranslation("fr", gleeceMuxRoutes.MessageKeyBodyValidation, "Le corps '{parameter}' de l'opération '{operation}' est invalide - {details}")
+	gleeceMuxRoutes.RegisterErrorTypeMapping[*e2eAssets.E2EConflictError](gleeceMuxRoutes.ErrorMapping{
+		StatusCode: http.StatusConflict,
+		Title:      "Resource Conflict",
+		Detail:     "The resource was modified by another request",
+	})
+	gleeceMuxRoutes.RegisterNamedMiddleware("audit", muxMiddlewares.MiddlewareAudit)
+	gleeceMuxRoutes.RegisterNamedMiddleware("trace", muxMiddlewares.MiddlewareTrace)
+	gleeceMuxRoutes.RegisterTracerProvider(tracerProvider)
+	gleeceMuxRoutes.RegisterMeterProvider(meterProvider)
+	gleeceMuxRoutes.RegisterPanicReporter(func(ctx context.Context, operationId string, panicErr *gleeceMuxRoutes.PanicError) {
+		reportedPanics = append(reportedPanics, reportedPanic{operationId: operationId, value: panicErr.Value, stack: panicErr.Stack})
+	})
+	for _, schemaName := range e2eSecuritySchemes {
+		gleeceMuxRoutes.RegisterSecurityHandler(schemaName, func(
+			ctx context.Context,
+			req *http.Request,
+			check runtime.SecurityCheck,
+			credentials gleeceMuxRoutes.SecurityCredentials,
+		) (context.Context, *runtime.SecurityError) {
+			authorizedCredentials = append(authorizedCredentials, authorizedCredential(credentials))
+			authCtx, secErr := muxAuth.GleeceRequestAuthorization(ctx, req, check)
+			if secErr == nil && credentials.ApiKey != "" {
+				authCtx = gleeceMuxRoutes.WithPrincipal(authCtx, &e2eAssets.E2EPrincipal{Name: credentials.ApiKey})
+			}
+			return authCtx, secErr
+		})
+	}
 	gleeceMuxRoutes.RegisterRoutes(muxTester.MuxRouter)
 
 	muxTester.MuxExExtraRouter = mux.NewRouter()
+	gleeceMuxRoutesExExtra.RegisterErrorMapping(e2eAssets.ErrE2EResourceMissing, http.StatusNotFound)
+	gleeceMuxRoutesExExtra.RegisterControllerFactory(newE2EController)
+	gleeceMuxRoutesExExtra.RegisterValidationTagTranslation("fr", "min", "Le champ '{field}' doit contenir au moins {param} caractères")
+	gleeceMuxRoutesExExtra.RegisterTranslation("fr", gleeceMuxRoutesExExtra.MessageKeyBodyValidation, "Le corps '{parameter}' de l'opération '{operation}' est invalide - {details}")
+	gleeceMuxRoutesExExtra.RegisterErrorTypeMapping[*e2eAssets.E2EConflictError](gleeceMuxRoutesExExtra.ErrorMapping{
+		StatusCode: http.StatusConflict,
+		Title:      "Resource Conflict",
+		Detail:     "The resource was modified by another request",
+	})
+	gleeceMuxRoutesExExtra.RegisterNamedMiddleware("audit", muxMiddlewares.MiddlewareAudit)
+	gleeceMuxRoutesExExtra.RegisterNamedMiddleware("trace", muxMiddlewares.MiddlewareTrace)
 	gleeceMuxRoutesExExtra.RegisterRoutes(muxTester.MuxExExtraRouter)
+	gleeceMuxRoutesExExtra.RegisterGroupRoutes(muxTester.MuxExExtraRouter.PathPrefix("/api").Subrouter(), "/v1")
 
 	// Set Chi
 	chiTester.ChiRouter = chi.NewRouter()
@@ -238,13 +403,58 @@ var _ = BeforeSuite(func() {
 	gleeceChiRoutes.RegisterErrorMiddleware(runtime.OnInputValidationError, chiMiddlewares.MiddlewareOnValidationError)
 	gleeceChiRoutes.RegisterErrorMiddleware(runtime.OnOutputValidationError, chiMiddlewares.MiddlewareOnOutputValidationError)
 	gleeceChiRoutes.RegisterCustomValidator("validate_starts_with_letter", e2eAssets.ValidateStartsWithLetter)
+	gleeceChiRoutes.RegisterErrorMapping(e2eAssets.ErrE2EResourceMissing, http.StatusNotFound)
+	gleeceChiRoutes.RegisterControllerFactory(newE2EController)
+	gleeceChiRoutes.RegisterValidationTagTranslation("fr", "min", "Le champ '{field}' doit contenir au moins {param} caractères")
+	gleeceChiRoutes.RegisterTranslation("fr", gleeceChiRoutes.MessageKeyBodyValidation, "Le corps '{parameter}' de l'opération '{operation}' est invalide - {details}")
+	gleeceChiRoutes.RegisterErrorTypeMapping[*e2eAssets.E2EConflictError](gleeceChiRoutes.ErrorMapping{
+		StatusCode: http.StatusConflict,
+		Title:      "Resource Conflict",
+		Detail:     "The resource was modified by another request",
+	})
+	gleeceChiRoutes.RegisterNamedMiddleware("audit", chiMiddlewares.MiddlewareAudit)
+	gleeceChiRoutes.RegisterNamedMiddleware("trace", chiMiddlewares.MiddlewareTrace)
+	gleeceChiRoutes.RegisterTracerProvider(tracerProvider)
+	gleeceChiRoutes.RegisterMeterProvider(meterProvider)
+	gleeceChiRoutes.RegisterPanicReporter(func(ctx context.Context, operationId string, panicErr *gleeceChiRoutes.PanicError) {
+		reportedPanics = append(reportedPanics, reportedPanic{operationId: operationId, value: panicErr.Value, stack: panicErr.Stack})
+	})
+	for _, schemaName := range e2eSecuritySchemes {
+		gleeceChiRoutes.RegisterSecurityHandler(schemaName, func(
+			ctx context.Context,
+			req *http.Request,
+			check runtime.SecurityCheck,
+			credentials gleeceChiRoutes.SecurityCredentials,
+		) (context.Context, *runtime.SecurityError) {
+			authorizedCredentials = append(authorizedCredentials, authorizedCredential(credentials))
+			authCtx, secErr := chiAuth.GleeceRequestAuthorization(ctx, req, check)
+			if secErr == nil && credentials.ApiKey != "" {
+				authCtx = gleeceChiRoutes.WithPrincipal(authCtx, &e2eAssets.E2EPrincipal{Name: credentials.ApiKey})
+			}
+			return authCtx, secErr
+		})
+	}
 	gleeceChiRoutes.RegisterRoutes(chiTester.ChiRouter)
 
 	chiTester.ChiExExtraRouter = chi.NewRouter()
+	gleeceChiRoutesExExtra.RegisterErrorMapping(e2eAssets.ErrE2EResourceMissing, http.StatusNotFound)
+	gleeceChiRoutesExExtra.RegisterControllerFactory(newE2EController)
+	gleeceChiRoutesExExtra.RegisterValidationTagTranslation("fr", "min", "Le champ '{field}' doit contenir au moins {param} caractères")
+	gleeceChiRoutesExExtra.RegisterTranslation("fr", gleeceChiRoutesExExtra.MessageKeyBodyValidation, "Le corps '{parameter}' de l'opération '{operation}' est invalide - {details}")
+	gleeceChiRoutesExExtra.RegisterErrorTypeMapping[*e2eAssets.E2EConflictError](gleeceChiRoutesExExtra.ErrorMapping{
+		StatusCode: http.StatusConflict,
+		Title:      "Resource Conflict",
+		Detail:     "The resource was modified by another request",
+	})
+	gleeceChiRoutesExExtra.RegisterNamedMiddleware("audit", chiMiddlewares.MiddlewareAudit)
+	gleeceChiRoutesExExtra.RegisterNamedMiddleware("trace", chiMiddlewares.MiddlewareTrace)
 	gleeceChiRoutesExExtra.RegisterRoutes(chiTester.ChiExExtraRouter)
+	chiTester.ChiExExtraRouter.Route("/api", func(router chi.Router) {
+		gleeceChiRoutesExExtra.RegisterGroupRoutes(router, "/v1")
+	})
 
 	// Set Fiber
-	fiberTester.FiberRouter = fiber.New()
+	fiberTester.FiberRouter = newFiberApp()
 	gleeceFiberRoutes.RegisterMiddleware(runtime.BeforeOperation, fiberMiddlewares.MiddlewareBeforeOperation)
 	gleeceFiberRoutes.RegisterMiddleware(runtime.AfterOperationSuccess, fiberMiddlewares.MiddlewareAfterOperationSuccess)
 	gleeceFiberRoutes.RegisterErrorMiddleware(runtime.OnOperationError, fiberMiddlewares.MiddlewareOnError)
@@ -252,10 +462,53 @@ var _ = BeforeSuite(func() {
 	gleeceFiberRoutes.RegisterErrorMiddleware(runtime.OnInputValidationError, fiberMiddlewares.MiddlewareOnValidationError)
 	gleeceFiberRoutes.RegisterErrorMiddleware(runtime.OnOutputValidationError, fiberMiddlewares.MiddlewareOnOutputValidationError)
 	gleeceFiberRoutes.RegisterCustomValidator("validate_starts_with_letter", e2eAssets.ValidateStartsWithLetter)
+	gleeceFiberRoutes.RegisterErrorMapping(e2eAssets.ErrE2EResourceMissing, http.StatusNotFound)
+	gleeceFiberRoutes.RegisterControllerFactory(newE2EController)
+	gleeceFiberRoutes.RegisterValidationTagTranslation("fr", "min", "Le champ '{field}' doit contenir au moins {param} caractères")
+	gleeceFiberRoutes.RegisterTranslation("fr", gleeceFiberRoutes.MessageKeyBodyValidation, "Le corps '{parameter}' de l'opération '{operation}' est invalide - {details}")
+	gleeceFiberRoutes.RegisterErrorTypeMapping[*e2eAssets.E2EConflictError](gleeceFiberRoutes.ErrorMapping{
+		StatusCode: http.StatusConflict,
+		Title:      "Resource Conflict",
+		Detail:     "The resource was modified by another request",
+	})
+	gleeceFiberRoutes.RegisterNamedMiddleware("audit", fiberMiddlewares.MiddlewareAudit)
+	gleeceFiberRoutes.RegisterNamedMiddleware("trace", fiberMiddlewares.MiddlewareTrace)
+	gleeceFiberRoutes.RegisterTracerProvider(tracerProvider)
+	gleeceFiberRoutes.RegisterMeterProvider(meterProvider)
+	gleeceFiberRoutes.RegisterPanicReporter(func(ctx context.Context, operationId string, panicErr *gleeceFiberRoutes.PanicError) {
+		reportedPanics = append(reportedPanics, reportedPanic{operationId: operationId, value: panicErr.Value, stack: panicErr.Stack})
+	})
+	for _, schemaName := range e2eSecuritySchemes {
+		gleeceFiberRoutes.RegisterSecurityHandler(schemaName, func(
+			ctx context.Context,
+			fiberCtx *fiber.Ctx,
+			check runtime.SecurityCheck,
+			credentials gleeceFiberRoutes.SecurityCredentials,
+		) (context.Context, *runtime.SecurityError) {
+			authorizedCredentials = append(authorizedCredentials, authorizedCredential(credentials))
+			authCtx, secErr := fiberAuth.GleeceRequestAuthorization(ctx, fiberCtx, check)
+			if secErr == nil && credentials.ApiKey != "" {
+				authCtx = gleeceFiberRoutes.WithPrincipal(authCtx, &e2eAssets.E2EPrincipal{Name: credentials.ApiKey})
+			}
+			return authCtx, secErr
+		})
+	}
 	gleeceFiberRoutes.RegisterRoutes(fiberTester.FiberRouter)
 
-	fiberTester.FiberExExtraRouter = fiber.New()
+	fiberTester.FiberExExtraRouter = newFiberApp()
+	gleeceFiberRoutesExExtra.RegisterErrorMapping(e2eAssets.ErrE2EResourceMissing, http.StatusNotFound)
+	gleeceFiberRoutesExExtra.RegisterControllerFactory(newE2EController)
+	gleeceFiberRoutesExExtra.RegisterValidationTagTranslation("fr", "min", "Le champ '{field}' doit contenir au moins {param} caractères")
+	gleeceFiberRoutesExExtra.RegisterTranslation("fr", gleeceFiberRoutesExExtra.MessageKeyBodyValidation, "Le corps '{parameter}' de l'opération '{operation}' est invalide - {details}")
+	gleeceFiberRoutesExExtra.RegisterErrorTypeMapping[*e2eAssets.E2EConflictError](gleeceFiberRoutesExExtra.ErrorMapping{
+		StatusCode: http.StatusConflict,
+		Title:      "Resource Conflict",
+		Detail:     "The resource was modified by another request",
+	})
+	gleeceFiberRoutesExExtra.RegisterNamedMiddleware("audit", fiberMiddlewares.MiddlewareAudit)
+	gleeceFiberRoutesExExtra.RegisterNamedMiddleware("trace", fiberMiddlewares.MiddlewareTrace)
 	gleeceFiberRoutesExExtra.RegisterRoutes(fiberTester.FiberExExtraRouter)
+	gleeceFiberRoutesExExtra.RegisterGroupRoutes(fiberTester.FiberExExtraRouter.Group("/api"), "/v1")
 })
 
 func VerifyResult(result common.RouterTestResult, routerTest common.RouterTest) {
@@ -313,3 +566,8 @@ func RunRouterTest(routerTest common.RouterTest) {
 		runTest(routerTest)
 	}
 }
+
+// newFiberApp creates a Fiber app accepting the custom HTTP verbs used by the e2e controllers
+func newFiberApp() *fiber.App {
+	return fiber.New(fiber.Config{RequestMethods: append(slices.Clone(fiber.DefaultMethods), "PURGE")})
+}
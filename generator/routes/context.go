@@ -2,6 +2,8 @@ package routes
 
 import (
 	"fmt"
+	"path/filepath"
+	"slices"
 	"time"
 
 	"github.com/gopher-fleece/gleece/v2/core/pipeline"
@@ -36,6 +38,158 @@ type RoutesContext struct {
 	ValidateResponsePayload bool
 	ExperimentalConfig      definitions.ExperimentalConfig
 	Models                  definitions.Models
+	// The names of all middlewares referenced via @Middleware annotations, used to verify their registration at startup
+	NamedMiddlewares []string
+	// The status codes declared via @ErrorResponse annotations, used to verify error mappings upon registration
+	DeclaredErrorStatusCodes []int
+	// Whether raw error strings are omitted from RFC7807 error responses
+	HideRawErrors bool
+	// Whether every controller must have a registered factory, verified at startup
+	RequireControllerFactories bool
+	// The path under which the API's routes are mounted by default
+	BasePath string
+	// Whether controllers' routes are rendered to separate files, per controller or per source package
+	SplitControllerFiles bool
+	// The file name of the main routes file, recorded in split controller files to tie them to the generating configuration
+	RoutesFileName string
+	// Whether the generated routes are instrumented using OpenTelemetry
+	EnableOpenTelemetry bool
+	// Whether panics raised by controller operations are recovered and answered with an RFC7807 error
+	RecoverPanics bool
+	// The configured security schemes, used to extract credentials for per-scheme security handlers
+	SecuritySchemes []definitions.SecuritySchemeConfig
+	// The API versioning configuration, with defaults applied. Nil if versioning is not configured
+	Versioning *definitions.VersioningConfig
+	// Whether any of the routes is rate limited via a @RateLimit annotation
+	HasRateLimits bool
+	// Whether any of the routes has a deadline or a body size limit, via the @Timeout and @MaxBodySize annotations or their configured defaults
+	HasRequestLimits bool
+	// Whether any of the routes deduplicates repeated requests via an @Idempotent annotation
+	HasIdempotentRoutes bool
+}
+
+// getRoutableControllers returns the given controllers with their webhook routes removed.
+//
+// Webhooks are documentation-only so controllers left with no routes are omitted altogether
+func getRoutableControllers(controllers []definitions.ControllerMetadata) []definitions.ControllerMetadata {
+	routable := []definitions.ControllerMetadata{}
+	for _, controller := range controllers {
+		routes := controller.GetRoutableRoutes()
+		if len(routes) == 0 {
+			continue
+		}
+
+		controller.Routes = routes
+		routable = append(routable, controller)
+	}
+	return routable
+}
+
+// markDerivedHeadRoutes flags the given controllers' GET routes for which a HEAD route is to be registered as well.
+//
+// Paths with an explicit HEAD route served in a common API version are skipped so the two do not conflict
+func markDerivedHeadRoutes(controllers []definitions.ControllerMetadata) {
+	explicitHeads := map[string][]definitions.RouteMetadata{}
+	for _, controller := range controllers {
+		for _, route := range controller.Routes {
+			if route.HttpVerb == definitions.HttpHead {
+				routePath := controller.RestMetadata.Path + route.RestMetadata.Path
+				explicitHeads[routePath] = append(explicitHeads[routePath], route)
+			}
+		}
+	}
+
+	for _, controller := range controllers {
+		for index, route := range controller.Routes {
+			if route.HttpVerb != definitions.HttpGet {
+				continue
+			}
+
+			routePath := controller.RestMetadata.Path + route.RestMetadata.Path
+			controller.Routes[index].HasDerivedHead = !slices.ContainsFunc(
+				explicitHeads[routePath],
+				func(head definitions.RouteMetadata) bool { return sharesVersion(route, head) },
+			)
+		}
+	}
+}
+
+// sharesVersion returns whether the two given routes are served in a common API version.
+//
+// Unversioned routes are served in all versions
+func sharesVersion(a definitions.RouteMetadata, b definitions.RouteMetadata) bool {
+	if !a.IsVersioned() || !b.IsVersioned() {
+		return true
+	}
+
+	return slices.ContainsFunc(a.Versions, b.IsServedInVersion)
+}
+
+// hasRateLimits returns whether any of the given controllers' routes is rate limited
+// isSplitOutputMode returns whether the given output mode renders controllers' routes to separate files
+func isSplitOutputMode(mode definitions.RoutesOutputMode) bool {
+	return mode == definitions.RoutesOutputModePerController || mode == definitions.RoutesOutputModePerPackage
+}
+
+func hasRateLimits(controllers []definitions.ControllerMetadata) bool {
+	return slices.ContainsFunc(controllers, func(controller definitions.ControllerMetadata) bool {
+		return slices.ContainsFunc(controller.Routes, func(route definitions.RouteMetadata) bool {
+			return route.RateLimit != nil
+		})
+	})
+}
+
+// hasRequestLimits returns whether any of the given controllers' routes has a timeout or a maximum body size
+func hasRequestLimits(controllers []definitions.ControllerMetadata) bool {
+	return slices.ContainsFunc(controllers, func(controller definitions.ControllerMetadata) bool {
+		return slices.ContainsFunc(controller.Routes, func(route definitions.RouteMetadata) bool {
+			return route.Timeout != "" || route.MaxBodySize > 0
+		})
+	})
+}
+
+// hasIdempotentRoutes returns whether any of the given controllers' routes is idempotent
+func hasIdempotentRoutes(controllers []definitions.ControllerMetadata) bool {
+	return slices.ContainsFunc(controllers, func(controller definitions.ControllerMetadata) bool {
+		return slices.ContainsFunc(controller.Routes, func(route definitions.RouteMetadata) bool {
+			return route.Idempotency != nil
+		})
+	})
+}
+
+// getNamedMiddlewares returns the sorted, unique names of the middlewares referenced by the given controllers' routes
+func getNamedMiddlewares(controllers []definitions.ControllerMetadata) []string {
+	names := []string{}
+	for _, controller := range controllers {
+		for _, route := range controller.Routes {
+			for _, name := range route.Middlewares {
+				if !slices.Contains(names, name) {
+					names = append(names, name)
+				}
+			}
+		}
+	}
+
+	slices.Sort(names)
+	return names
+}
+
+// getDeclaredErrorStatusCodes returns the sorted, unique status codes declared by the given controllers' @ErrorResponse annotations
+func getDeclaredErrorStatusCodes(controllers []definitions.ControllerMetadata) []int {
+	codes := []int{}
+	for _, controller := range controllers {
+		for _, route := range controller.Routes {
+			for _, response := range route.ErrorResponses {
+				code := int(response.HttpStatusCode)
+				if !slices.Contains(codes, code) {
+					codes = append(codes, code)
+				}
+			}
+		}
+	}
+
+	slices.Sort(codes)
+	return codes
 }
 
 func GetTemplateContext(
@@ -43,13 +197,30 @@ func GetTemplateContext(
 	fullMeta pipeline.GleeceFlattenedMetadata,
 ) (RoutesContext, error) {
 	ctx := RoutesContext{
-		Imports:                 fullMeta.Imports,
-		Controllers:             fullMeta.Flat,
-		AuthConfig:              config.RoutesConfig.AuthorizationConfig,
-		ValidateResponsePayload: config.RoutesConfig.ValidateResponsePayload,
-		ExperimentalConfig:      config.ExperimentalConfig,
-		Models:                  fullMeta.Models,
+		Imports:                    fullMeta.Imports,
+		Controllers:                getRoutableControllers(fullMeta.Flat),
+		AuthConfig:                 config.RoutesConfig.AuthorizationConfig,
+		ValidateResponsePayload:    config.RoutesConfig.ValidateResponsePayload,
+		ExperimentalConfig:         config.ExperimentalConfig,
+		Models:                     fullMeta.Models,
+		HideRawErrors:              config.RoutesConfig.HideRawErrors,
+		RequireControllerFactories: config.RoutesConfig.RequireControllerFactories,
+		BasePath:                   config.RoutesConfig.BasePath,
+		SplitControllerFiles:       isSplitOutputMode(config.RoutesConfig.OutputMode),
+		RoutesFileName:             filepath.Base(config.RoutesConfig.OutputPath),
+		EnableOpenTelemetry:        config.RoutesConfig.EnableOpenTelemetry,
+		RecoverPanics:              config.RoutesConfig.RecoverPanics,
+		SecuritySchemes:            config.OpenAPIGeneratorConfig.SecuritySchemes,
+		Versioning:                 config.GetVersioningConfig(),
+	}
+	if config.RoutesConfig.DeriveHeadFromGet {
+		markDerivedHeadRoutes(ctx.Controllers)
 	}
+	ctx.NamedMiddlewares = getNamedMiddlewares(ctx.Controllers)
+	ctx.HasRateLimits = hasRateLimits(ctx.Controllers)
+	ctx.HasRequestLimits = hasRequestLimits(ctx.Controllers)
+	ctx.HasIdempotentRoutes = hasIdempotentRoutes(ctx.Controllers)
+	ctx.DeclaredErrorStatusCodes = getDeclaredErrorStatusCodes(ctx.Controllers)
 	if len(config.RoutesConfig.PackageName) > 0 {
 		ctx.PackageName = config.RoutesConfig.PackageName
 	} else {
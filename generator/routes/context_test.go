@@ -0,0 +1,290 @@
+package routes
+
+import (
+	"github.com/gopher-fleece/gleece/v2/core/pipeline"
+	"github.com/gopher-fleece/gleece/v2/definitions"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Template Context", func() {
+	It("Omits webhook routes and controllers left with no routes", func() {
+		webhook := &definitions.WebhookMetadata{Name: "orderCreated"}
+		meta := pipeline.GleeceFlattenedMetadata{
+			Flat: []definitions.ControllerMetadata{
+				{
+					Name: "OrdersController",
+					Routes: []definitions.RouteMetadata{
+						{OperationId: "GetOrders"},
+						{OperationId: "OrderCreated", Webhook: webhook},
+					},
+				},
+				{
+					Name:   "HooksController",
+					Routes: []definitions.RouteMetadata{{OperationId: "OrderShipped", Webhook: webhook}},
+				},
+			},
+		}
+
+		ctx, err := GetTemplateContext(&definitions.GleeceConfig{}, meta)
+		Expect(err).To(BeNil())
+		Expect(ctx.Controllers).To(HaveLen(1))
+		Expect(ctx.Controllers[0].Name).To(Equal("OrdersController"))
+		Expect(ctx.Controllers[0].Routes).To(HaveLen(1))
+		Expect(ctx.Controllers[0].Routes[0].OperationId).To(Equal("GetOrders"))
+
+		// The original metadata must be left intact for spec generation
+		Expect(meta.Flat[0].Routes).To(HaveLen(2))
+	})
+
+	It("Derives HEAD routes from GET routes without an explicit HEAD counterpart when enabled", func() {
+		meta := pipeline.GleeceFlattenedMetadata{
+			Flat: []definitions.ControllerMetadata{
+				{
+					Name:         "OrdersController",
+					RestMetadata: definitions.RestMetadata{Path: "/orders"},
+					Routes: []definitions.RouteMetadata{
+						{OperationId: "GetOrders", HttpVerb: definitions.HttpGet, RestMetadata: definitions.RestMetadata{Path: "/"}},
+						{OperationId: "GetOrder", HttpVerb: definitions.HttpGet, RestMetadata: definitions.RestMetadata{Path: "/{id}"}},
+						{OperationId: "HeadOrder", HttpVerb: definitions.HttpHead, RestMetadata: definitions.RestMetadata{Path: "/{id}"}},
+						{OperationId: "CreateOrder", HttpVerb: definitions.HttpPost, RestMetadata: definitions.RestMetadata{Path: "/"}},
+					},
+				},
+			},
+		}
+
+		config := &definitions.GleeceConfig{RoutesConfig: definitions.RoutesConfig{DeriveHeadFromGet: true}}
+		ctx, err := GetTemplateContext(config, meta)
+		Expect(err).To(BeNil())
+
+		routes := ctx.Controllers[0].Routes
+		Expect(routes[0].HasDerivedHead).To(BeTrue())
+		Expect(routes[1].HasDerivedHead).To(BeFalse())
+		Expect(routes[2].HasDerivedHead).To(BeFalse())
+		Expect(routes[3].HasDerivedHead).To(BeFalse())
+
+		// The original metadata must be left intact for spec generation
+		Expect(meta.Flat[0].Routes[0].HasDerivedHead).To(BeFalse())
+	})
+
+	It("Derives HEAD routes for GET routes whose explicit HEAD counterparts are served in other API versions", func() {
+		meta := pipeline.GleeceFlattenedMetadata{
+			Flat: []definitions.ControllerMetadata{
+				{
+					Name: "OrdersController",
+					Routes: []definitions.RouteMetadata{
+						{OperationId: "GetOrderV1", HttpVerb: definitions.HttpGet, Versions: []int{1}},
+						{OperationId: "GetOrderV2", HttpVerb: definitions.HttpGet, Versions: []int{2}},
+						{OperationId: "HeadOrder", HttpVerb: definitions.HttpHead, Versions: []int{2}},
+					},
+				},
+			},
+		}
+
+		config := &definitions.GleeceConfig{RoutesConfig: definitions.RoutesConfig{DeriveHeadFromGet: true}}
+		ctx, err := GetTemplateContext(config, meta)
+		Expect(err).To(BeNil())
+
+		routes := ctx.Controllers[0].Routes
+		Expect(routes[0].HasDerivedHead).To(BeTrue())
+		Expect(routes[1].HasDerivedHead).To(BeFalse())
+	})
+
+	It("Exposes the versioning configuration with defaults applied", func() {
+		config := &definitions.GleeceConfig{Versioning: &definitions.VersioningConfig{Versions: []int{1, 2}}}
+		ctx, err := GetTemplateContext(config, pipeline.GleeceFlattenedMetadata{})
+		Expect(err).To(BeNil())
+		Expect(ctx.Versioning.Strategy).To(Equal(definitions.VersioningStrategyPath))
+		Expect(ctx.Versioning.DefaultVersion).To(Equal(2))
+	})
+
+	It("Does not derive HEAD routes when disabled", func() {
+		meta := pipeline.GleeceFlattenedMetadata{
+			Flat: []definitions.ControllerMetadata{
+				{
+					Name:   "OrdersController",
+					Routes: []definitions.RouteMetadata{{OperationId: "GetOrders", HttpVerb: definitions.HttpGet}},
+				},
+			},
+		}
+
+		ctx, err := GetTemplateContext(&definitions.GleeceConfig{}, meta)
+		Expect(err).To(BeNil())
+		Expect(ctx.Controllers[0].Routes[0].HasDerivedHead).To(BeFalse())
+	})
+
+	It("Collects the named middlewares of all routes, sorted and without duplicates", func() {
+		meta := pipeline.GleeceFlattenedMetadata{
+			Flat: []definitions.ControllerMetadata{
+				{
+					Name: "OrdersController",
+					Routes: []definitions.RouteMetadata{
+						{OperationId: "GetOrders", Middlewares: []string{"trace", "audit"}},
+						{OperationId: "GetOrder", Middlewares: []string{"audit"}},
+					},
+				},
+				{
+					Name:   "UsersController",
+					Routes: []definitions.RouteMetadata{{OperationId: "GetUsers", Middlewares: []string{"cache"}}},
+				},
+			},
+		}
+
+		ctx, err := GetTemplateContext(&definitions.GleeceConfig{}, meta)
+		Expect(err).To(BeNil())
+		Expect(ctx.NamedMiddlewares).To(Equal([]string{"audit", "cache", "trace"}))
+	})
+	It("Collects the declared error status codes, sorted and without duplicates", func() {
+		meta := pipeline.GleeceFlattenedMetadata{
+			Flat: []definitions.ControllerMetadata{
+				{
+					Name: "OrdersController",
+					Routes: []definitions.RouteMetadata{
+						{
+							OperationId:    "GetOrder",
+							ErrorResponses: []definitions.ErrorResponse{{HttpStatusCode: 500}, {HttpStatusCode: 404}},
+						},
+						{
+							OperationId:    "UpdateOrder",
+							ErrorResponses: []definitions.ErrorResponse{{HttpStatusCode: 409}, {HttpStatusCode: 404}},
+						},
+					},
+				},
+			},
+		}
+
+		config := &definitions.GleeceConfig{RoutesConfig: definitions.RoutesConfig{HideRawErrors: true}}
+		ctx, err := GetTemplateContext(config, meta)
+		Expect(err).To(BeNil())
+		Expect(ctx.DeclaredErrorStatusCodes).To(Equal([]int{404, 409, 500}))
+		Expect(ctx.HideRawErrors).To(BeTrue())
+	})
+
+	It("Passes the controller factory requirement through from the routes configuration", func() {
+		meta := pipeline.GleeceFlattenedMetadata{}
+
+		ctx, err := GetTemplateContext(&definitions.GleeceConfig{}, meta)
+		Expect(err).To(BeNil())
+		Expect(ctx.RequireControllerFactories).To(BeFalse())
+
+		config := &definitions.GleeceConfig{RoutesConfig: definitions.RoutesConfig{RequireControllerFactories: true}}
+		ctx, err = GetTemplateContext(config, meta)
+		Expect(err).To(BeNil())
+		Expect(ctx.RequireControllerFactories).To(BeTrue())
+	})
+
+	It("Passes the base path through from the routes configuration", func() {
+		config := &definitions.GleeceConfig{RoutesConfig: definitions.RoutesConfig{BasePath: "/api"}}
+		ctx, err := GetTemplateContext(config, pipeline.GleeceFlattenedMetadata{})
+		Expect(err).To(BeNil())
+		Expect(ctx.BasePath).To(Equal("/api"))
+	})
+
+	It("Splits controller files only in per-controller and per-package output modes", func() {
+		ctx, err := GetTemplateContext(&definitions.GleeceConfig{}, pipeline.GleeceFlattenedMetadata{})
+		Expect(err).To(BeNil())
+		Expect(ctx.SplitControllerFiles).To(BeFalse())
+
+		for _, mode := range []definitions.RoutesOutputMode{definitions.RoutesOutputModePerController, definitions.RoutesOutputModePerPackage} {
+			config := &definitions.GleeceConfig{
+				RoutesConfig: definitions.RoutesConfig{OutputMode: mode, OutputPath: "./routes/api.gleece.go"},
+			}
+			ctx, err = GetTemplateContext(config, pipeline.GleeceFlattenedMetadata{})
+			Expect(err).To(BeNil())
+			Expect(ctx.SplitControllerFiles).To(BeTrue())
+			Expect(ctx.RoutesFileName).To(Equal("api.gleece.go"))
+		}
+	})
+
+	It("Passes the OpenTelemetry instrumentation option through from the routes configuration", func() {
+		ctx, err := GetTemplateContext(&definitions.GleeceConfig{}, pipeline.GleeceFlattenedMetadata{})
+		Expect(err).To(BeNil())
+		Expect(ctx.EnableOpenTelemetry).To(BeFalse())
+
+		config := &definitions.GleeceConfig{RoutesConfig: definitions.RoutesConfig{EnableOpenTelemetry: true}}
+		ctx, err = GetTemplateContext(config, pipeline.GleeceFlattenedMetadata{})
+		Expect(err).To(BeNil())
+		Expect(ctx.EnableOpenTelemetry).To(BeTrue())
+	})
+
+	It("Passes the panic recovery option through from the routes configuration", func() {
+		ctx, err := GetTemplateContext(&definitions.GleeceConfig{}, pipeline.GleeceFlattenedMetadata{})
+		Expect(err).To(BeNil())
+		Expect(ctx.RecoverPanics).To(BeFalse())
+
+		config := &definitions.GleeceConfig{RoutesConfig: definitions.RoutesConfig{RecoverPanics: true}}
+		ctx, err = GetTemplateContext(config, pipeline.GleeceFlattenedMetadata{})
+		Expect(err).To(BeNil())
+		Expect(ctx.RecoverPanics).To(BeTrue())
+	})
+
+	It("Flags the presence of rate limited routes, excluding webhooks", func() {
+		rateLimit := &definitions.RateLimit{Requests: 10, Per: "1s", Key: definitions.RateLimitKeyIp}
+		webhookMeta := pipeline.GleeceFlattenedMetadata{
+			Flat: []definitions.ControllerMetadata{
+				{
+					Name: "HooksController",
+					Routes: []definitions.RouteMetadata{
+						{OperationId: "GetOrders"},
+						{OperationId: "OrderCreated", Webhook: &definitions.WebhookMetadata{Name: "orderCreated"}, RateLimit: rateLimit},
+					},
+				},
+			},
+		}
+
+		ctx, err := GetTemplateContext(&definitions.GleeceConfig{}, webhookMeta)
+		Expect(err).To(BeNil())
+		Expect(ctx.HasRateLimits).To(BeFalse())
+
+		webhookMeta.Flat[0].Routes[0].RateLimit = rateLimit
+		ctx, err = GetTemplateContext(&definitions.GleeceConfig{}, webhookMeta)
+		Expect(err).To(BeNil())
+		Expect(ctx.HasRateLimits).To(BeTrue())
+	})
+
+	It("Flags the presence of routes with a timeout or a maximum body size", func() {
+		meta := pipeline.GleeceFlattenedMetadata{
+			Flat: []definitions.ControllerMetadata{
+				{Name: "OrdersController", Routes: []definitions.RouteMetadata{{OperationId: "GetOrders"}}},
+			},
+		}
+
+		ctx, err := GetTemplateContext(&definitions.GleeceConfig{}, meta)
+		Expect(err).To(BeNil())
+		Expect(ctx.HasRequestLimits).To(BeFalse())
+
+		meta.Flat[0].Routes[0].MaxBodySize = 1024
+		ctx, err = GetTemplateContext(&definitions.GleeceConfig{}, meta)
+		Expect(err).To(BeNil())
+		Expect(ctx.HasRequestLimits).To(BeTrue())
+	})
+
+	It("Flags the presence of idempotent routes", func() {
+		meta := pipeline.GleeceFlattenedMetadata{
+			Flat: []definitions.ControllerMetadata{
+				{Name: "PaymentsController", Routes: []definitions.RouteMetadata{{OperationId: "CreatePayment"}}},
+			},
+		}
+
+		ctx, err := GetTemplateContext(&definitions.GleeceConfig{}, meta)
+		Expect(err).To(BeNil())
+		Expect(ctx.HasIdempotentRoutes).To(BeFalse())
+
+		meta.Flat[0].Routes[0].Idempotency = &definitions.Idempotency{Ttl: definitions.DefaultIdempotencyTtl}
+		ctx, err = GetTemplateContext(&definitions.GleeceConfig{}, meta)
+		Expect(err).To(BeNil())
+		Expect(ctx.HasIdempotentRoutes).To(BeTrue())
+	})
+
+	It("Passes the configured security schemes through from the OpenAPI configuration", func() {
+		schemes := []definitions.SecuritySchemeConfig{
+			{SecurityName: "apiKeyAuth", Type: definitions.APIKey, In: definitions.InQuery, FieldName: "key"},
+			{SecurityName: "bearerAuth", Type: definitions.HTTP, Scheme: definitions.HttpAuthSchemeBearer},
+		}
+		config := &definitions.GleeceConfig{OpenAPIGeneratorConfig: definitions.OpenAPIGeneratorConfig{SecuritySchemes: schemes}}
+
+		ctx, err := GetTemplateContext(config, pipeline.GleeceFlattenedMetadata{})
+		Expect(err).To(BeNil())
+		Expect(ctx.SecuritySchemes).To(Equal(schemes))
+	})
+})
@@ -5,7 +5,9 @@ import (
 	"fmt"
 	"maps"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/aymerick/raymond"
@@ -50,6 +52,78 @@ func getDefaultTemplate(engine definitions.RoutingEngineType) string {
 	panic(fmt.Sprintf("Could not find an embedded template for routing engine %v", engine))
 }
 
+func getControllerRoutesFileTemplate(engine definitions.RoutingEngineType) string {
+	switch engine {
+	case definitions.RoutingEngineGin:
+		return gin.ControllerRoutesFileTemplate
+	case definitions.RoutingEngineEcho:
+		return echo.ControllerRoutesFileTemplate
+	case definitions.RoutingEngineMux:
+		return mux.ControllerRoutesFileTemplate
+	case definitions.RoutingEngineFiber:
+		return fiber.ControllerRoutesFileTemplate
+	case definitions.RoutingEngineChi:
+		return chi.ControllerRoutesFileTemplate
+	}
+	// This should not happen. It indicates a breakage in the build itself.
+	panic(fmt.Sprintf("Could not find an embedded controller routes file template for routing engine %v", engine))
+}
+
+// getControllerRoutesFilePath returns the path of the file holding the routes of the given file key's controllers,
+// i.e., '<output dir>/<output name>.<file key>.go'
+func getControllerRoutesFilePath(outputPath string, fileKey string) string {
+	outputName := strings.TrimSuffix(filepath.Base(outputPath), filepath.Ext(outputPath))
+	return filepath.Join(filepath.Dir(outputPath), fmt.Sprintf("%s.%s.go", outputName, strings.ToLower(fileKey)))
+}
+
+// getControllerRoutesFileKey returns the key of the file holding the given controller's routes -
+// its source package's name in per-package output mode or its own name otherwise
+func getControllerRoutesFileKey(mode definitions.RoutesOutputMode, controller definitions.ControllerMetadata) string {
+	if mode == definitions.RoutesOutputModePerPackage {
+		return path.Base(controller.PkgPath)
+	}
+	return controller.Name
+}
+
+// removeStaleRoutesFiles deletes the '<output name>.*.go' controller routes files next to the output path that were
+// generated for the same routes file but not written by the current run, e.g. those of renamed or removed controllers,
+// which would otherwise break the package's compilation.
+//
+// Files that were not generated by Gleece or were generated for another routes file are left untouched
+func removeStaleRoutesFiles(outputPath string, writtenPaths []string) error {
+	outputName := strings.TrimSuffix(filepath.Base(outputPath), filepath.Ext(outputPath))
+	candidates, err := filepath.Glob(filepath.Join(filepath.Dir(outputPath), outputName+".*.go"))
+	if err != nil {
+		return err
+	}
+
+	for _, candidate := range candidates {
+		if slices.Contains(writtenPaths, filepath.Clean(candidate)) || !isControllerRoutesFileOf(candidate, outputPath) {
+			continue
+		}
+
+		logger.Info("Removing stale routes file '%s'", candidate)
+		if err := os.Remove(candidate); err != nil {
+			return fmt.Errorf("could not remove stale routes file '%s' - %w", candidate, err)
+		}
+	}
+
+	return nil
+}
+
+// isControllerRoutesFileOf returns whether the file at the given path is a controller routes file
+// generated by the Gleece routes generator for the given routes file
+func isControllerRoutesFileOf(filePath string, outputPath string) bool {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return false
+	}
+
+	header := string(data)
+	return strings.Contains(header, "Generated by: Gleece Routes Generator") &&
+		slices.Contains(strings.Split(header, "\n"), "Routes File: "+filepath.Base(outputPath))
+}
+
 // getRoutesTemplateString Gets the contents of the HandleBars template to use.
 // If `templateFilePath` is empty, returns the default, built-in template, otherwise, the content of the provided template
 func getRoutesTemplateString(engine definitions.RoutingEngineType, templateFilePath string) (string, error) {
@@ -222,6 +296,51 @@ func GenerateRoutes(
 		return err
 	}
 
+	if err := renderToFile(template, ctx, args.OutputPath, args.OutputFilePerms); err != nil {
+		return err
+	}
+
+	if !ctx.SplitControllerFiles {
+		return nil
+	}
+
+	controllerPaths, err := renderControllerRoutesFiles(ctx, args)
+	if err != nil {
+		return err
+	}
+
+	return removeStaleRoutesFiles(args.OutputPath, controllerPaths)
+}
+
+// renderControllerRoutesFiles renders the controllers' routes to a file per controller or per source package,
+// depending on the configured output mode, and returns the paths of the written files.
+//
+// Controllers whose files share a path, e.g. ones of packages with identical names, are rendered to the same file
+func renderControllerRoutesFiles(ctx RoutesContext, args definitions.RoutesConfig) ([]string, error) {
+	controllerPaths := []string{}
+	controllersByPath := map[string][]definitions.ControllerMetadata{}
+	for _, controller := range ctx.Controllers {
+		controllerPath := getControllerRoutesFilePath(args.OutputPath, getControllerRoutesFileKey(args.OutputMode, controller))
+		if _, exists := controllersByPath[controllerPath]; !exists {
+			controllerPaths = append(controllerPaths, controllerPath)
+		}
+		controllersByPath[controllerPath] = append(controllersByPath[controllerPath], controller)
+	}
+
+	controllerTemplate := getControllerRoutesFileTemplate(args.Engine)
+	for _, controllerPath := range controllerPaths {
+		controllerCtx := ctx
+		controllerCtx.Controllers = controllersByPath[controllerPath]
+		if err := renderToFile(controllerTemplate, controllerCtx, controllerPath, args.OutputFilePerms); err != nil {
+			return nil, err
+		}
+	}
+
+	return controllerPaths, nil
+}
+
+// renderToFile renders the given template with the given context, then formats and writes the result to the given path
+func renderToFile(template string, ctx RoutesContext, outputPath string, outputFilePerms string) error {
 	result, err := raymond.Render(template, ctx)
 	if err != nil {
 		logger.Fatal("Could not render routes template - %v", err)
@@ -235,14 +354,14 @@ func GenerateRoutes(
 		formattedOutput = result
 	}
 
-	err = os.MkdirAll(filepath.Dir(args.OutputPath), 0755)
+	err = os.MkdirAll(filepath.Dir(outputPath), 0755)
 	if err != nil {
 		return err
 	}
 
-	err = os.WriteFile(args.OutputPath, []byte(formattedOutput), getOutputFileMod(args.OutputFilePerms))
+	err = os.WriteFile(outputPath, []byte(formattedOutput), getOutputFileMod(outputFilePerms))
 	if err != nil {
-		logger.Fatal("Could not write output file at '%s' with permissions '%v' - %v", args.OutputPath, args.OutputFilePerms, err)
+		logger.Fatal("Could not write output file at '%s' with permissions '%v' - %v", outputPath, outputFilePerms, err)
 		return err
 	}
 
@@ -1,6 +1,8 @@
 package routes
 
 import (
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/aymerick/raymond"
@@ -92,6 +94,80 @@ var _ = Describe("Engine Registration", func() {
 			Expect(len(uniqueTemplates)).To(Equal(len(templates)))
 		})
 	})
+
+	Context("Per-controller output", func() {
+		DescribeTable("should return a non-empty controller routes file template",
+			func(engine definitions.RoutingEngineType) {
+				Expect(getControllerRoutesFileTemplate(engine)).To(ContainSubstring("{{> ControllerRoutes}}"))
+			},
+			Entry("Gin template", definitions.RoutingEngineGin),
+			Entry("Echo template", definitions.RoutingEngineEcho),
+			Entry("Mux template", definitions.RoutingEngineMux),
+			Entry("Fiber template", definitions.RoutingEngineFiber),
+			Entry("Chi template", definitions.RoutingEngineChi),
+		)
+
+		It("should panic for unknown engine type", func() {
+			Expect(func() {
+				getControllerRoutesFileTemplate(definitions.RoutingEngineType("unknown"))
+			}).To(PanicWith("Could not find an embedded controller routes file template for routing engine unknown"))
+		})
+
+		It("should place controller files next to the output path", func() {
+			Expect(getControllerRoutesFilePath("./routes/api.gleece.go", "UsersController")).
+				To(Equal(filepath.Join("routes", "api.gleece.userscontroller.go")))
+		})
+
+		It("should key controller files by controller or by source package, depending on the output mode", func() {
+			controller := definitions.ControllerMetadata{Name: "UsersController", PkgPath: "example.com/api/users"}
+			Expect(getControllerRoutesFileKey(definitions.RoutesOutputModePerController, controller)).To(Equal("UsersController"))
+			Expect(getControllerRoutesFileKey(definitions.RoutesOutputModePerPackage, controller)).To(Equal("users"))
+		})
+
+		It("should remove stale controller files of the same routes file only", func() {
+			dir := GinkgoT().TempDir()
+			outputPath := filepath.Join(dir, "api.gleece.go")
+			writtenPath := filepath.Join(dir, "api.gleece.userscontroller.go")
+			stalePath := filepath.Join(dir, "api.gleece.removedcontroller.go")
+			userPath := filepath.Join(dir, "api.gleece.custom.go")
+
+			generated := []byte("/*\nGenerated by: Gleece Routes Generator\nRoutes File: api.gleece.go\n*/\npackage api\n")
+			for _, filePath := range []string{outputPath, writtenPath, stalePath} {
+				Expect(os.WriteFile(filePath, generated, 0644)).To(Succeed())
+			}
+			Expect(os.WriteFile(userPath, []byte("package api\n"), 0644)).To(Succeed())
+
+			Expect(removeStaleRoutesFiles(outputPath, []string{writtenPath})).To(Succeed())
+
+			Expect(outputPath).To(BeAnExistingFile())
+			Expect(writtenPath).To(BeAnExistingFile())
+			Expect(userPath).To(BeAnExistingFile())
+			Expect(stalePath).ToNot(BeAnExistingFile())
+		})
+
+		It("should not remove generated files of other routes files", func() {
+			dir := GinkgoT().TempDir()
+			outputPath := filepath.Join(dir, "gleece.go")
+			adminRoutesPath := filepath.Join(dir, "gleece.admin.go")
+			adminControllerPath := filepath.Join(dir, "gleece.admin.userscontroller.go")
+
+			Expect(os.WriteFile(
+				adminRoutesPath,
+				[]byte("/*\nGenerated by: Gleece Routes Generator\n*/\npackage api\n"),
+				0644,
+			)).To(Succeed())
+			Expect(os.WriteFile(
+				adminControllerPath,
+				[]byte("/*\nGenerated by: Gleece Routes Generator\nRoutes File: gleece.admin.go\n*/\npackage api\n"),
+				0644,
+			)).To(Succeed())
+
+			Expect(removeStaleRoutesFiles(outputPath, []string{})).To(Succeed())
+
+			Expect(adminRoutesPath).To(BeAnExistingFile())
+			Expect(adminControllerPath).To(BeAnExistingFile())
+		})
+	})
 })
 
 func TestRoutes(t *testing.T) {
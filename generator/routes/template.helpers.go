@@ -31,6 +31,32 @@ func splitSliceBracket(input string) (brackets string, name string) {
 	return input[:pos], input[pos:]
 }
 
+// getParamParser returns the generated function converting a raw, non-body request parameter of the given type
+// to its primitive type, or an empty string if the raw value is used as-is
+func getParamParser(typeMeta definitions.TypeMetadata) string {
+	_, name := splitSliceBracket(typeMeta.Name)
+	primitives := []string{name}
+	if typeMeta.AliasMetadata != nil {
+		_, aliasType := splitSliceBracket(typeMeta.AliasMetadata.AliasType)
+		primitives = append(primitives, aliasType)
+	}
+
+	for _, primitive := range primitives {
+		switch primitive {
+		case "bool":
+			return "strconv.ParseBool"
+		case "int", "int8", "int16", "int32", "int64":
+			return fmt.Sprintf("parseIntegerParam[%s]", primitive)
+		case "uint", "uint8", "uint16", "uint32", "uint64":
+			return fmt.Sprintf("parseUnsignedParam[%s]", primitive)
+		case "float32", "float64":
+			return fmt.Sprintf("parseFloatParam[%s]", primitive)
+		}
+	}
+
+	return ""
+}
+
 func registerHandlebarsHelpers() {
 	// Given a string like '[][][]something' returns '[][][]'
 	raymond.RegisterHelper("GetArrayPrefixes", func(arg string) string {
@@ -82,8 +108,8 @@ func registerHandlebarsHelpers() {
 
 	raymond.RegisterHelper("ifAnyParamRequiresConversion", func(params []definitions.FuncParam, options *raymond.Options) string {
 		for _, param := range params {
-			if param.IsContext {
-				// The CTX param is not come from the http "input" and should not be converted from anything
+			if param.IsContext || param.PassedIn == definitions.PassedInPrincipal {
+				// The CTX and principal params do not come from the http "input" and should not be converted from anything
 				continue
 			}
 
@@ -128,6 +154,16 @@ func registerHandlebarsHelpers() {
 		return isEqual1 || isEqual2
 	})
 
+	// Given a parameter's type metadata, returns the generated function converting its raw value, if any
+	raymond.RegisterHelper("ParamParser", func(typeMeta definitions.TypeMetadata) string {
+		return getParamParser(typeMeta)
+	})
+
+	// Returns whether the given HTTP verb is a custom, non-standard one that may need to be registered with the engine
+	raymond.RegisterHelper("IsCustomHttpVerb", func(verb definitions.HttpVerb) bool {
+		return definitions.IsCustomHttpVerb(string(verb))
+	})
+
 	raymond.RegisterHelper("IsArray", func(value string) bool {
 		return strings.HasPrefix(value, "[]")
 	})
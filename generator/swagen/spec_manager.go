@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/gopher-fleece/gleece/v2/definitions"
 	"github.com/gopher-fleece/gleece/v2/generator/swagen/swagen30"
@@ -14,8 +16,12 @@ import (
 
 // GenerateSpec generates the OpenAPI specification
 func GenerateSpec(config *definitions.OpenAPIGeneratorConfig, defs []definitions.ControllerMetadata, models *definitions.Models, hasAnyErrorTypes bool) ([]byte, error) {
-	// In case of a default error in use, add the RFC-7807, otherwise skip and assume the user define it using structs by themselves
-	swagtool.AppendErrorSchema(&models.Structs, hasAnyErrorTypes)
+	// In case of a default error in use, add the RFC-7807, otherwise skip and assume the user define it using structs by themselves.
+	// Rate limited and idempotent routes are always answered with an RFC-7807 error upon exceeding their limit or idempotency conflicts
+	swagtool.AppendErrorSchema(
+		&models.Structs,
+		hasAnyErrorTypes || swagtool.HasRateLimitedRoutes(defs) || swagtool.HasIdempotentRoutes(defs),
+	)
 
 	// Since the tools and validation are WAY better for 3.0.0,
 	// And our logic his focusing in 3.0 and not feature will be added if not can be support in it too,
@@ -44,9 +50,99 @@ func GenerateAndOutputSpec(config *definitions.OpenAPIGeneratorConfig, defs []de
 		return err
 	}
 
+	return outputSpec(config.SpecGeneratorConfig.OutputPath, jsonBytes)
+}
+
+// GenerateAndOutputVersionedSpecs generates and writes an OpenAPI specification per API version.
+//
+// Each version's specification is written to '<output path>.v{version}<extension>', e.g. 'openapi.v2.json'.
+// The default version's specification is written to the configured output path as well.
+// If versioning is not configured, a single specification is generated, as per GenerateAndOutputSpec
+func GenerateAndOutputVersionedSpecs(
+	config *definitions.OpenAPIGeneratorConfig,
+	versioning *definitions.VersioningConfig,
+	defs []definitions.ControllerMetadata,
+	models *definitions.Models,
+	hasAnyErrorTypes bool,
+) error {
+	if versioning == nil {
+		return GenerateAndOutputSpec(config, defs, models, hasAnyErrorTypes)
+	}
+
+	for _, version := range versioning.Versions {
+		// The generators append shared schemas to the models so each version is given its own copy
+		versionModels := *models
+		versionModels.Structs = slices.Clone(models.Structs)
+
+		jsonBytes, err := GenerateSpec(config, getVersionDefinitions(defs, versioning, version), &versionModels, hasAnyErrorTypes)
+		if err != nil {
+			return fmt.Errorf("failed to generate the OpenAPI specification of API version %d - %w", version, err)
+		}
+
+		if err := outputSpec(GetVersionedSpecPath(config.SpecGeneratorConfig.OutputPath, version), jsonBytes); err != nil {
+			return err
+		}
+
+		if version != versioning.DefaultVersion {
+			continue
+		}
+
+		if err := outputSpec(config.SpecGeneratorConfig.OutputPath, jsonBytes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetVersionedSpecPath returns the path to which the given API version's specification is written
+func GetVersionedSpecPath(outputPath string, version int) string {
+	extension := filepath.Ext(outputPath)
+	return fmt.Sprintf("%s.v%d%s", strings.TrimSuffix(outputPath, extension), version, extension)
+}
+
+// getVersionDefinitions returns the given controllers as served in the given API version.
+//
+// Routes not served in the version are omitted, as are controllers left without any routes.
+// In 'path' mode, versioned routes are documented under their '/v{version}' prefixed paths
+func getVersionDefinitions(
+	defs []definitions.ControllerMetadata,
+	versioning *definitions.VersioningConfig,
+	version int,
+) []definitions.ControllerMetadata {
+	versionDefs := []definitions.ControllerMetadata{}
+	for _, def := range defs {
+		routes := []definitions.RouteMetadata{}
+		for _, route := range def.Routes {
+			if !route.IsServedInVersion(version) {
+				continue
+			}
+
+			// Routes are moved under the version prefix, which precedes the controller's own path
+			route.RestMetadata.Path = def.RestMetadata.Path + route.RestMetadata.Path
+			if route.IsVersioned() && versioning.Strategy == definitions.VersioningStrategyPath {
+				route.RestMetadata.Path = fmt.Sprintf("/v%d%s", version, route.RestMetadata.Path)
+			}
+			routes = append(routes, route)
+		}
+
+		if len(routes) == 0 {
+			continue
+		}
+
+		def.RestMetadata.Path = ""
+		def.Routes = routes
+		versionDefs = append(versionDefs, def)
+	}
+
+	return versionDefs
+}
+
+// outputSpec writes the given specification to the given path, creating its directory if needed
+func outputSpec(outputPath string, jsonBytes []byte) error {
 	// Extract path from file path
 	// Extract the directory path
-	dirPath := filepath.Dir(config.SpecGeneratorConfig.OutputPath)
+	dirPath := filepath.Dir(outputPath)
 	// Create the output directory if it doesn't exist
 	if err := os.MkdirAll(dirPath, os.ModePerm); err != nil {
 		logger.Error("Failed to create directory - %v", err)
@@ -54,13 +150,12 @@ func GenerateAndOutputSpec(config *definitions.OpenAPIGeneratorConfig, defs []de
 	}
 
 	// Write the JSON to the file
-	if err := os.WriteFile(config.SpecGeneratorConfig.OutputPath, jsonBytes, 0644); err != nil {
+	if err := os.WriteFile(outputPath, jsonBytes, 0644); err != nil {
 		logger.Error("Failed to write file - %v", err)
 		return err
 	}
 
 	// Print the path to the generated JSON file
-	logger.Info("OpenAPI specification written to '%s'", config.SpecGeneratorConfig.OutputPath)
+	logger.Info("OpenAPI specification written to '%s'", outputPath)
 	return nil
-
 }
@@ -139,4 +139,86 @@ var _ = Describe("Spec Preparing", func() {
 		Expect(err).NotTo(BeNil())
 		Expect(err.Error()).To(Equal("invalid paths: operation GET /example-base/example-route/{id} must define exactly all path parameters (missing: [id])"))
 	})
+
+	Context("API versioning", func() {
+		defs := []definitions.ControllerMetadata{
+			{
+				Name:         "OrdersController",
+				RestMetadata: definitions.RestMetadata{Path: "/orders"},
+				Routes: []definitions.RouteMetadata{
+					{OperationId: "ListOrders", RestMetadata: definitions.RestMetadata{Path: "/"}},
+					{OperationId: "GetOrderV1", RestMetadata: definitions.RestMetadata{Path: "/{id}"}, Versions: []int{1}},
+					{OperationId: "GetOrderV2", RestMetadata: definitions.RestMetadata{Path: "/{id}"}, Versions: []int{2}},
+				},
+			},
+			{
+				Name:   "LegacyController",
+				Routes: []definitions.RouteMetadata{{OperationId: "Legacy", Versions: []int{1}}},
+			},
+		}
+
+		It("Derives each version's output path from the configured output path", func() {
+			Expect(GetVersionedSpecPath("./dist/openapi.json", 2)).To(Equal("./dist/openapi.v2.json"))
+			Expect(GetVersionedSpecPath("./dist/openapi", 1)).To(Equal("./dist/openapi.v1"))
+		})
+
+		It("Moves versioned routes under their version prefix in 'path' mode", func() {
+			versioning := &definitions.VersioningConfig{Versions: []int{1, 2}, Strategy: definitions.VersioningStrategyPath}
+			versionDefs := getVersionDefinitions(defs, versioning, 2)
+
+			Expect(versionDefs).To(HaveLen(1))
+			Expect(versionDefs[0].RestMetadata.Path).To(BeEmpty())
+			Expect(versionDefs[0].Routes).To(HaveLen(2))
+			Expect(versionDefs[0].Routes[0].OperationId).To(Equal("ListOrders"))
+			Expect(versionDefs[0].Routes[0].RestMetadata.Path).To(Equal("/orders/"))
+			Expect(versionDefs[0].Routes[1].OperationId).To(Equal("GetOrderV2"))
+			Expect(versionDefs[0].Routes[1].RestMetadata.Path).To(Equal("/v2/orders/{id}"))
+
+			// The original definitions must be left intact
+			Expect(defs[0].RestMetadata.Path).To(Equal("/orders"))
+			Expect(defs[0].Routes[1].RestMetadata.Path).To(Equal("/{id}"))
+		})
+
+		It("Keeps versioned routes under their plain paths in 'header' mode", func() {
+			versioning := &definitions.VersioningConfig{Versions: []int{1, 2}, Strategy: definitions.VersioningStrategyHeader}
+			versionDefs := getVersionDefinitions(defs, versioning, 1)
+
+			Expect(versionDefs).To(HaveLen(2))
+			Expect(versionDefs[0].Routes[1].OperationId).To(Equal("GetOrderV1"))
+			Expect(versionDefs[0].Routes[1].RestMetadata.Path).To(Equal("/orders/{id}"))
+			Expect(versionDefs[1].Routes[0].OperationId).To(Equal("Legacy"))
+		})
+
+		It("Outputs a specification per version as well as the default version's", func() {
+			outputPath := "./dist/test-spec-versioned.json"
+			versionedPaths := []string{"./dist/test-spec-versioned.v1.json", "./dist/test-spec-versioned.v2.json"}
+			for _, path := range append(versionedPaths, outputPath) {
+				os.Remove(path)
+			}
+
+			err := GenerateAndOutputVersionedSpecs(
+				&definitions.OpenAPIGeneratorConfig{
+					OpenAPI:             "3.0.0",
+					Info:                definitions.OpenAPIInfo{Title: "My API", Version: "1.0.0"},
+					BaseURL:             "http://localhost:8080",
+					SpecGeneratorConfig: definitions.SpecGeneratorConfig{OutputPath: outputPath},
+				},
+				&definitions.VersioningConfig{Versions: []int{1, 2}, DefaultVersion: 1},
+				[]definitions.ControllerMetadata{},
+				&definitions.Models{},
+				true,
+			)
+			Expect(err).To(BeNil())
+
+			for _, path := range versionedPaths {
+				Expect(swagtool.FileExists(path)).To(BeTrue())
+			}
+
+			defaultSpec, err := os.ReadFile(outputPath)
+			Expect(err).To(BeNil())
+			v1Spec, err := os.ReadFile(versionedPaths[0])
+			Expect(err).To(BeNil())
+			Expect(defaultSpec).To(Equal(v1Spec))
+		})
+	})
 })
@@ -0,0 +1,13 @@
+package swagen30
+
+import "maps"
+
+// GenerateExtensionsSpec converts the given vendor extensions to their OpenAPI 3.0 representation.
+//
+// Returns nil if there are no extensions so they are omitted from the output
+func GenerateExtensionsSpec(extensions map[string]any) map[string]any {
+	if len(extensions) == 0 {
+		return nil
+	}
+	return maps.Clone(extensions)
+}
@@ -67,6 +67,7 @@ func generateStructSpec(openapi *openapi3.T, model definitions.StructMetadata) {
 		// OpenAPI 3.0 does not support any extra field in the SchemaRef beside just ref, and we don't want to override the model properties themselves
 		if fieldSchemaRef.Value != nil && fieldSchemaRef.Ref == "" {
 			fieldSchemaRef.Value.Description = field.Description
+			fieldSchemaRef.Value.Extensions = GenerateExtensionsSpec(field.Extensions)
 
 			// If the schema marked as deprecated, the field / property should be marked as deprecated as well
 			// Setting it as not deprecated (even if the field itself is not marked deprecated) will override the model deprecation
@@ -90,6 +91,8 @@ func generateStructSpec(openapi *openapi3.T, model definitions.StructMetadata) {
 		schema.Required = requiredFields
 	}
 
+	modelSchema.Extensions = GenerateExtensionsSpec(model.Extensions)
+
 	// Add schema to components
 	openapi.Components.Schemas[model.Name] = &openapi3.SchemaRef{
 		Value: modelSchema,
@@ -10,6 +10,7 @@ import (
 	"github.com/gopher-fleece/gleece/v2/definitions"
 	"github.com/gopher-fleece/gleece/v2/generator/swagen/swagtool"
 	"github.com/gopher-fleece/gleece/v2/infrastructure/logger"
+	"github.com/gopher-fleece/runtime"
 )
 
 func createOperation(def definitions.ControllerMetadata, route definitions.RouteMetadata) *openapi3.Operation {
@@ -18,9 +19,10 @@ func createOperation(def definitions.ControllerMetadata, route definitions.Route
 		Description: route.Description,
 		Responses:   openapi3.NewResponses(),
 		OperationID: route.OperationId,
-		Tags:        []string{def.Tag},
+		Tags:        swagtool.GetOperationTags(def, route),
 		Parameters:  []*openapi3.ParameterRef{},
 		Deprecated:  swagtool.IsDeprecated(&route.Deprecation),
+		Extensions:  GenerateExtensionsSpec(swagtool.GetOperationExtensions(route)),
 	}
 }
 
@@ -44,6 +46,48 @@ func createErrorResponse(openapi *openapi3.T, route definitions.RouteMetadata, e
 	}
 }
 
+// createValidationErrorResponse creates the response returned when the route's input fails validation
+func createValidationErrorResponse(openapi *openapi3.T, route definitions.RouteMetadata) *openapi3.ResponseRef {
+	description := swagtool.GetValidationErrorDescription(route)
+	return &openapi3.ResponseRef{
+		Value: &openapi3.Response{
+			Description: &description,
+			Content:     createContentWithSchemaRef(openapi, "", definitions.ValidationErrorName),
+		},
+	}
+}
+
+// createRateLimitErrorResponse creates the response returned when a request exceeds the route's rate limit
+func createRateLimitErrorResponse(openapi *openapi3.T, route definitions.RouteMetadata) *openapi3.ResponseRef {
+	description := swagtool.GetRateLimitErrorDescription(route)
+	return &openapi3.ResponseRef{
+		Value: &openapi3.Response{
+			Description: &description,
+			Content:     createContentWithSchemaRef(openapi, "", definitions.Rfc7807ErrorName),
+			Headers: openapi3.Headers{
+				swagtool.RetryAfterHeaderName: &openapi3.HeaderRef{
+					Value: &openapi3.Header{
+						Parameter: openapi3.Parameter{
+							Description: swagtool.RetryAfterHeaderDescription,
+							Schema:      ToOpenApiSchemaRef("integer"),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// createIdempotencyErrorResponse creates a response returned when an idempotent request's 'Idempotency-Key' header is missing, in use or reused
+func createIdempotencyErrorResponse(openapi *openapi3.T, description string) *openapi3.ResponseRef {
+	return &openapi3.ResponseRef{
+		Value: &openapi3.Response{
+			Description: &description,
+			Content:     createContentWithSchemaRef(openapi, "", definitions.Rfc7807ErrorName),
+		},
+	}
+}
+
 func createContentWithSchemaRef(openapi *openapi3.T, validationString string, interfaceType string) openapi3.Content {
 	schemaRef := InterfaceToSchemaRef(openapi, interfaceType)
 	BuildSchemaValidation(schemaRef, validationString, interfaceType)
@@ -69,6 +113,29 @@ func createResponseSuccess(openapi *openapi3.T, route definitions.RouteMetadata)
 	}
 }
 
+func generateResponseHeaders(operation *openapi3.Operation, route definitions.RouteMetadata) {
+	for statusCode, response := range operation.Responses.Map() {
+		for _, header := range route.ResponseHeaders {
+			if !swagtool.IsHeaderInResponse(header, statusCode) {
+				continue
+			}
+
+			if response.Value.Headers == nil {
+				response.Value.Headers = openapi3.Headers{}
+			}
+
+			response.Value.Headers[header.Name] = &openapi3.HeaderRef{
+				Value: &openapi3.Header{
+					Parameter: openapi3.Parameter{
+						Description: header.Description,
+						Schema:      ToOpenApiSchemaRef(header.Type),
+					},
+				},
+			}
+		}
+	}
+}
+
 func buildSecurityMethod(securitySchemes []definitions.SecuritySchemeConfig, securityMethods []definitions.SecurityAnnotationComponent) (*openapi3.SecurityRequirement, error) {
 	securityRequirement := openapi3.SecurityRequirement{}
 
@@ -80,7 +147,12 @@ func buildSecurityMethod(securitySchemes []definitions.SecuritySchemeConfig, sec
 			// Create error object and return it, add the method name that is not exist in the security schemes
 			return nil, errors.New(errStr)
 		}
-		securityRequirement[securityMethod.SchemaName] = securityMethod.Scopes
+		scopes := securityMethod.Scopes
+		if existingScopes, exists := securityRequirement[securityMethod.SchemaName]; exists {
+			// A schema required more than once by an @AdvancedSecurity expression is required once, with all scopes
+			scopes = swagtool.MergeSecurityScopes(existingScopes, scopes)
+		}
+		securityRequirement[securityMethod.SchemaName] = scopes
 	}
 
 	return &securityRequirement, nil
@@ -91,7 +163,8 @@ func generateOperationSecurity(operation *openapi3.Operation, config *definition
 
 	routeSecurity := route.Security
 
-	if len(routeSecurity) == 0 && config.DefaultRouteSecurity != nil {
+	// Public routes are explicitly exempt from security and do not fall back to the default one
+	if len(routeSecurity) == 0 && config.DefaultRouteSecurity != nil && !route.IsPublic {
 		routeSecurity = []definitions.RouteSecurity{{SecurityAnnotation: []definitions.SecurityAnnotationComponent{*config.DefaultRouteSecurity}}}
 	}
 
@@ -124,6 +197,26 @@ func setNewRouteOperation(openapi *openapi3.T, def definitions.ControllerMetadat
 	openapi.Paths.Set(routePath, pathItem)
 }
 
+// addWebhookCallback adds the given operation to the callbacks under the route's webhook name and callback URL
+func addWebhookCallback(callbacks openapi3.Callbacks, route definitions.RouteMetadata, operation *openapi3.Operation) error {
+	callbackRef, exists := callbacks[route.Webhook.Name]
+	if !exists {
+		callbackRef = &openapi3.CallbackRef{Value: openapi3.NewCallback()}
+		callbacks[route.Webhook.Name] = callbackRef
+	}
+
+	pathItem := callbackRef.Value.Value(route.Webhook.CallbackUrl)
+	if pathItem == nil {
+		pathItem = &openapi3.PathItem{}
+	} else if pathItem.GetOperation(string(route.HttpVerb)) != nil {
+		return fmt.Errorf("webhook '%s' has more than one %s operation", route.Webhook.Name, route.HttpVerb)
+	}
+
+	pathItem.SetOperation(string(route.HttpVerb), operation)
+	callbackRef.Value.Set(route.Webhook.CallbackUrl, pathItem)
+	return nil
+}
+
 func handleRouteParamDeprecation(routeParam definitions.FuncParam, specParam *openapi3.ParameterRef) {
 	if routeParam.Deprecation.Deprecated {
 		specParam.Value.Deprecated = true
@@ -140,6 +233,7 @@ func createRouteParam(openapi *openapi3.T, param definitions.FuncParam) *openapi
 			Description: param.Description,
 			Required:    swagtool.IsFieldRequired(param.Validator),
 			Schema:      schemaRef,
+			Extensions:  GenerateExtensionsSpec(param.Extensions),
 		},
 	}
 	handleRouteParamDeprecation(param, specParam)
@@ -153,6 +247,7 @@ func createRequestBodyParam(openapi *openapi3.T, param definitions.FuncParam) *o
 			Description: param.Description,
 			Content:     content,
 			Required:    swagtool.IsFieldRequired(param.Validator),
+			Extensions:  GenerateExtensionsSpec(param.Extensions),
 		},
 	}
 }
@@ -183,6 +278,7 @@ func createRequestFormParam(openapi *openapi3.T, param definitions.FuncParam, op
 	// Set the description on the property schema itself
 	if propertySchemaRef.Value != nil {
 		propertySchemaRef.Value.Description = param.Description
+		propertySchemaRef.Value.Extensions = GenerateExtensionsSpec(param.Extensions)
 	}
 	// Add the form parameter to the schema
 	formSchema.Value.Properties[param.NameInSchema] = propertySchemaRef
@@ -194,12 +290,16 @@ func createRequestFormParam(openapi *openapi3.T, param definitions.FuncParam, op
 
 func generateParams(openapi *openapi3.T, route definitions.RouteMetadata, operation *openapi3.Operation) {
 	// Iterate over FuncParams and create parameters
-	for _, param := range route.FuncParams {
+	for _, param := range swagtool.GetOperationParams(route) {
 
 		if param.IsContext {
 			continue // The context is for the generated code only, and should not affect the specification
 		}
 
+		if param.PassedIn == definitions.PassedInPrincipal {
+			continue // The principal is attached by the authorization layer rather than passed by the client
+		}
+
 		switch param.PassedIn {
 		case definitions.PassedInBody:
 			operation.RequestBody = createRequestBodyParam(openapi, param)
@@ -211,37 +311,151 @@ func generateParams(openapi *openapi3.T, route definitions.RouteMetadata, operat
 	}
 }
 
-// GenerateControllerSpec generates the specification for a controller
+// createRouteOperation creates the operation of the given route, along with its responses and parameters
+func createRouteOperation(openapi *openapi3.T, def definitions.ControllerMetadata, route definitions.RouteMetadata) *openapi3.Operation {
+	// Create a new Operation for the route
+	operation := createOperation(def, route)
+
+	// Iterate over the error responses
+	for _, errResp := range route.ErrorResponses {
+		// Set the response using the Set method
+		operation.Responses.Set(swagtool.HttpStatusCodeToString(errResp.HttpStatusCode), createErrorResponse(openapi, route, errResp))
+	}
+
+	// Requests exceeding the route's rate limit are answered with a standard RFC-7807 error
+	if route.RateLimit != nil && !route.IsWebhook() {
+		operation.Responses.Set(swagtool.HttpStatusCodeToString(runtime.StatusTooManyRequests), createRateLimitErrorResponse(openapi, route))
+	}
+
+	// Idempotent routes reject requests lacking an 'Idempotency-Key' header, repeating one that is still being processed
+	// or reusing one with a different body. Routes with validated input document their 422 response using the validation schema instead
+	if route.Idempotency != nil && !route.IsWebhook() {
+		operation.Responses.Set(
+			swagtool.HttpStatusCodeToString(runtime.StatusBadRequest),
+			createIdempotencyErrorResponse(openapi, swagtool.GetIdempotencyKeyMissingErrorDescription(route)),
+		)
+		operation.Responses.Set(
+			swagtool.HttpStatusCodeToString(runtime.StatusConflict),
+			createIdempotencyErrorResponse(openapi, swagtool.GetIdempotencyConflictErrorDescription(route)),
+		)
+		operation.Responses.Set(
+			swagtool.HttpStatusCodeToString(runtime.StatusUnprocessableEntity),
+			createIdempotencyErrorResponse(openapi, swagtool.GetIdempotencyKeyReusedErrorDescription(route)),
+		)
+	}
+
+	// Input validation failures are always reported using a dedicated schema
+	if swagtool.HasValidatedInput(route) {
+		operation.Responses.Set(swagtool.HttpStatusCodeToString(runtime.StatusUnprocessableEntity), createValidationErrorResponse(openapi, route))
+	}
+
+	operation.Responses.Set(swagtool.HttpStatusCodeToString(route.ResponseSuccessCode), createResponseSuccess(openapi, route))
+
+	generateResponseHeaders(operation, route)
+
+	generateParams(openapi, route, operation)
+
+	return operation
+}
+
+// isRouteOmitted checks whether the given route should be left out of the specification
+func isRouteOmitted(route definitions.RouteMetadata) bool {
+	if swagtool.IsHiddenAsset(&route.Hiding) {
+		logger.Info(fmt.Sprintf("Skipping hidden route: %v %s (%s)", route.HttpVerb, route.RestMetadata.Path, route.OperationId))
+		return true
+	}
+
+	if definitions.IsCustomHttpVerb(string(route.HttpVerb)) {
+		// OpenAPI has no means of describing operations with non-standard verbs
+		logger.Warn(fmt.Sprintf("Skipping route with custom HTTP verb: %v %s (%s)", route.HttpVerb, route.RestMetadata.Path, route.OperationId))
+		return true
+	}
+
+	return false
+}
+
+// GenerateControllerSpec generates the specification for a controller.
+//
+// The controller's webhooks are not included - see generateWebhookCallbacks
 func generateControllerSpec(openapi *openapi3.T, config *definitions.OpenAPIGeneratorConfig, def definitions.ControllerMetadata) error {
 	// Iterate over the routes in the controller
 	for _, route := range def.Routes {
-
-		if swagtool.IsHiddenAsset(&route.Hiding) {
-			logger.Info(fmt.Sprintf("Skipping hidden route: %v %s (%s)", route.HttpVerb, route.RestMetadata.Path, route.OperationId))
+		// Webhooks are outbound calls - they're emitted as callbacks of the operations that trigger them
+		if route.IsWebhook() || isRouteOmitted(route) {
 			continue
 		}
 
-		// Create a new Operation for the route
-		operation := createOperation(def, route)
-
-		// Iterate over the error responses
-		for _, errResp := range route.ErrorResponses {
-			// Set the response using the Set method
-			operation.Responses.Set(swagtool.HttpStatusCodeToString(errResp.HttpStatusCode), createErrorResponse(openapi, route, errResp))
-		}
-
-		operation.Responses.Set(swagtool.HttpStatusCodeToString(route.ResponseSuccessCode), createResponseSuccess(openapi, route))
-
-		generateParams(openapi, route, operation)
+		operation := createRouteOperation(openapi, def, route)
 
 		// Add the security requirement to the operation
 		if err := generateOperationSecurity(operation, config, route); err != nil {
 			return err
 		}
 
+		// Add any alternative servers serving the operation
+		if len(route.Servers) > 0 {
+			servers := GenerateServersSpec(route.Servers)
+			operation.Servers = &servers
+		}
+
 		// Finally, set the operation in the path item
 		setNewRouteOperation(openapi, def, route, operation)
 	}
+
+	return nil
+}
+
+// getOperationsById maps the IDs of the specification's operations to the operations themselves
+func getOperationsById(openapi *openapi3.T) map[string]*openapi3.Operation {
+	operations := map[string]*openapi3.Operation{}
+	for _, pathItem := range openapi.Paths.Map() {
+		for _, operation := range pathItem.Operations() {
+			operations[operation.OperationID] = operation
+		}
+	}
+	return operations
+}
+
+// generateWebhookCallbacks emits the controllers' webhooks.
+//
+// OpenAPI 3.0 has no top-level webhooks so each webhook is emitted as a callback of the operations named by its @Webhook annotation.
+// Must be invoked after all operations have been added to the specification
+func generateWebhookCallbacks(openapi *openapi3.T, defs []definitions.ControllerMetadata) error {
+	operationsById := getOperationsById(openapi)
+
+	for _, def := range defs {
+		for _, route := range def.Routes {
+			if !route.IsWebhook() || isRouteOmitted(route) {
+				continue
+			}
+
+			if len(route.Webhook.Operations) == 0 {
+				logger.Warn("Webhook %s of controller %s names no operations to attach it to as a callback", route.Webhook.Name, def.Name)
+				continue
+			}
+
+			// Webhooks are neither secured nor served by our servers
+			webhookOperation := createRouteOperation(openapi, def, route)
+
+			for _, operationId := range route.Webhook.Operations {
+				operation, exists := operationsById[operationId]
+				if !exists {
+					logger.Warn("Webhook %s of controller %s names unknown operation %s", route.Webhook.Name, def.Name, operationId)
+					continue
+				}
+
+				if operation.Callbacks == nil {
+					operation.Callbacks = openapi3.Callbacks{}
+				}
+
+				if err := addWebhookCallback(operation.Callbacks, route, webhookOperation); err != nil {
+					errStr := fmt.Sprintf("Building controller %s failed: %s", def.Name, err.Error())
+					return errors.New(errStr)
+				}
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -253,5 +467,6 @@ func GenerateControllersSpec(openapi *openapi3.T, config *definitions.OpenAPIGen
 			return errors.New(errStr)
 		}
 	}
-	return nil
+
+	return generateWebhookCallbacks(openapi, defs)
 }
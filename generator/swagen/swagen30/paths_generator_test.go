@@ -3,6 +3,7 @@ package swagen30
 import (
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/gopher-fleece/gleece/v2/definitions"
+	"github.com/gopher-fleece/runtime"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -100,6 +101,36 @@ var _ = Describe("Swagen", func() {
 		})
 	})
 
+	Describe("generateResponseHeaders", func() {
+		It("should add headers to all responses or only to the specified status codes", func() {
+			route := definitions.RouteMetadata{
+				ResponseHeaders: []definitions.ResponseHeader{
+					{Name: "X-Request-Id", Type: "string", Description: "The request's ID"},
+					{Name: "X-RateLimit-Remaining", Type: "integer", StatusCodes: []runtime.HttpStatusCode{200}},
+				},
+			}
+
+			description := "Response"
+			operation := &openapi3.Operation{Responses: openapi3.NewResponses()}
+			operation.Responses.Set("200", &openapi3.ResponseRef{Value: &openapi3.Response{Description: &description}})
+			operation.Responses.Set("500", &openapi3.ResponseRef{Value: &openapi3.Response{Description: &description}})
+
+			generateResponseHeaders(operation, route)
+
+			successHeaders := operation.Responses.Status(200).Value.Headers
+			Expect(successHeaders).To(HaveLen(2))
+			Expect(successHeaders["X-Request-Id"].Value.Description).To(Equal("The request's ID"))
+			Expect(successHeaders["X-Request-Id"].Value.Schema.Value.Type.Is("string")).To(BeTrue())
+			Expect(successHeaders["X-RateLimit-Remaining"].Value.Schema.Value.Type.Is("integer")).To(BeTrue())
+
+			errorHeaders := operation.Responses.Status(500).Value.Headers
+			Expect(errorHeaders).To(HaveLen(1))
+			Expect(errorHeaders).To(HaveKey("X-Request-Id"))
+
+			Expect(operation.Responses.Default().Value.Headers).To(BeEmpty())
+		})
+	})
+
 	Describe("buildSecurityMethod", func() {
 		It("should build security requirement", func() {
 			securityMethods := []definitions.SecurityAnnotationComponent{
@@ -147,6 +178,19 @@ var _ = Describe("Swagen", func() {
 			Expect(operation.Security).NotTo(BeNil())
 			Expect((*operation.Security)[0]).To(HaveKey("apiKeyAuth"))
 		})
+
+		It("should emit an empty security requirement list for public routes, ignoring the default security", func() {
+			publicConfig := *config
+			publicConfig.DefaultRouteSecurity = &definitions.SecurityAnnotationComponent{SchemaName: "apiKeyAuth"}
+			route := definitions.RouteMetadata{OperationId: "testOperation", IsPublic: true}
+			operation := &openapi3.Operation{}
+
+			err := generateOperationSecurity(operation, &publicConfig, route)
+
+			Expect(err).To(BeNil())
+			Expect(operation.Security).NotTo(BeNil())
+			Expect(*operation.Security).To(BeEmpty())
+		})
 	})
 
 	Describe("setNewRouteOperation", func() {
@@ -197,6 +241,134 @@ var _ = Describe("Swagen", func() {
 			Expect(pathItem).NotTo(BeNil())
 		})
 
+		It("should document the 429 response and the 'x-ratelimit' extension of rate limited routes", func() {
+			def := definitions.ControllerMetadata{
+				Tag: "test",
+				Routes: []definitions.RouteMetadata{
+					{
+						HttpVerb:            "GET",
+						OperationId:         "limitedOperation",
+						RestMetadata:        definitions.RestMetadata{Path: "/limited"},
+						ResponseSuccessCode: 204,
+						Responses:           []definitions.FuncReturnValue{{TypeMetadata: definitions.TypeMetadata{Name: "error"}}},
+						RateLimit:           &definitions.RateLimit{Requests: 100, Per: "1m", Key: definitions.RateLimitKeyIp},
+					},
+				},
+			}
+			err := generateControllerSpec(openapi, config, def)
+			Expect(err).To(BeNil())
+
+			operation := openapi.Paths.Value("/limited").Get
+			Expect(operation.Extensions).To(HaveKeyWithValue(
+				"x-ratelimit",
+				map[string]any{"requests": 100, "per": "1m", "key": "ip"},
+			))
+
+			response := operation.Responses.Value("429")
+			Expect(response).ToNot(BeNil())
+			Expect(*response.Value.Description).To(Equal("The request exceeded the operation's rate limit"))
+			Expect(response.Value.Headers).To(HaveKey("Retry-After"))
+			Expect(response.Value.Headers["Retry-After"].Value.Schema.Value.Type.Is("integer")).To(BeTrue())
+		})
+
+		It("should document the 'Idempotency-Key' header and the 400 and 409 responses of idempotent routes", func() {
+			def := definitions.ControllerMetadata{
+				Tag: "test",
+				Routes: []definitions.RouteMetadata{
+					{
+						HttpVerb:            "POST",
+						OperationId:         "idempotentOperation",
+						RestMetadata:        definitions.RestMetadata{Path: "/payments"},
+						ResponseSuccessCode: 204,
+						Responses:           []definitions.FuncReturnValue{{TypeMetadata: definitions.TypeMetadata{Name: "error"}}},
+						Idempotency:         &definitions.Idempotency{Ttl: definitions.DefaultIdempotencyTtl},
+					},
+				},
+			}
+			err := generateControllerSpec(openapi, config, def)
+			Expect(err).To(BeNil())
+
+			operation := openapi.Paths.Value("/payments").Post
+			Expect(operation.Parameters).To(HaveLen(1))
+			Expect(operation.Parameters[0].Value.Name).To(Equal("Idempotency-Key"))
+			Expect(operation.Parameters[0].Value.In).To(Equal("header"))
+			Expect(operation.Parameters[0].Value.Required).To(BeTrue())
+
+			Expect(*operation.Responses.Value("400").Value.Description).To(Equal("The request lacks the required 'Idempotency-Key' header"))
+			Expect(*operation.Responses.Value("409").Value.Description).To(
+				Equal("A request with the same idempotency key is still being processed"),
+			)
+			Expect(*operation.Responses.Value("422").Value.Description).To(
+				Equal("The idempotency key has already been used by a request with a different body"),
+			)
+		})
+
+		It("should not emit webhooks as paths", func() {
+			responses := []definitions.FuncReturnValue{
+				{TypeMetadata: definitions.TypeMetadata{Name: "error"}},
+			}
+			def := definitions.ControllerMetadata{
+				Tag: "test",
+				Routes: []definitions.RouteMetadata{
+					{
+						HttpVerb:            "POST",
+						OperationId:         "subscribe",
+						RestMetadata:        definitions.RestMetadata{Path: "/subscribe"},
+						ResponseSuccessCode: 204,
+						Responses:           responses,
+					},
+					{
+						HttpVerb:            "POST",
+						OperationId:         "OrderCreated",
+						ResponseSuccessCode: 204,
+						Responses:           responses,
+						Webhook: &definitions.WebhookMetadata{
+							Name:        "orderCreated",
+							CallbackUrl: "{$request.body#/url}",
+							Operations:  []string{"subscribe"},
+						},
+					},
+				},
+			}
+
+			err := generateControllerSpec(openapi, config, def)
+			Expect(err).To(BeNil())
+			Expect(openapi.Paths.Len()).To(Equal(1))
+			Expect(openapi.Paths.Value("/subscribe").Post.Callbacks).To(BeNil())
+		})
+
+		It("should omit routes with custom HTTP verbs", func() {
+			responses := []definitions.FuncReturnValue{
+				{TypeMetadata: definitions.TypeMetadata{Name: "error"}},
+			}
+			def := definitions.ControllerMetadata{
+				Tag: "test",
+				Routes: []definitions.RouteMetadata{
+					{
+						HttpVerb:            "HEAD",
+						OperationId:         "HeadCache",
+						RestMetadata:        definitions.RestMetadata{Path: "/cache"},
+						ResponseSuccessCode: 204,
+						Responses:           responses,
+					},
+					{
+						HttpVerb:            "PURGE",
+						OperationId:         "PurgeCache",
+						RestMetadata:        definitions.RestMetadata{Path: "/cache"},
+						ResponseSuccessCode: 204,
+						Responses:           responses,
+					},
+				},
+			}
+
+			err := generateControllerSpec(openapi, config, def)
+			Expect(err).To(BeNil())
+
+			pathItem := openapi.Paths.Value("/cache")
+			Expect(pathItem.Head.OperationID).To(Equal("HeadCache"))
+			Expect(pathItem.Operations()).To(HaveLen(1))
+		})
+
 		It("should abort generate specifications due to error", func() {
 			def := definitions.ControllerMetadata{
 				Tag: "test",
@@ -236,6 +408,154 @@ var _ = Describe("Swagen", func() {
 		})
 	})
 
+	Describe("GenerateControllersSpec", func() {
+		responses := []definitions.FuncReturnValue{
+			{TypeMetadata: definitions.TypeMetadata{Name: "error"}},
+		}
+
+		route := func(operationId string, path string) definitions.RouteMetadata {
+			return definitions.RouteMetadata{
+				HttpVerb:            "POST",
+				OperationId:         operationId,
+				RestMetadata:        definitions.RestMetadata{Path: path},
+				ResponseSuccessCode: 204,
+				Responses:           responses,
+			}
+		}
+
+		webhookRoute := func(operationId string, name string, operations ...string) definitions.RouteMetadata {
+			return definitions.RouteMetadata{
+				HttpVerb:            "POST",
+				OperationId:         operationId,
+				ResponseSuccessCode: 204,
+				Responses:           responses,
+				Webhook: &definitions.WebhookMetadata{
+					Name:        name,
+					CallbackUrl: "{$request.body#/url}",
+					Operations:  operations,
+				},
+			}
+		}
+
+		It("should attach webhooks only to the operations they name", func() {
+			def := definitions.ControllerMetadata{
+				Tag: "test",
+				Routes: []definitions.RouteMetadata{
+					route("createOrder", "/orders"),
+					route("cancelOrder", "/orders/cancel"),
+					route("ping", "/ping"),
+					webhookRoute("OrderCreated", "orderCreated", "createOrder"),
+					webhookRoute("OrderChanged", "orderChanged", "createOrder", "cancelOrder"),
+				},
+			}
+
+			err := GenerateControllersSpec(openapi, config, []definitions.ControllerMetadata{def})
+			Expect(err).To(BeNil())
+			Expect(openapi.Paths.Len()).To(Equal(3))
+
+			createOrder := openapi.Paths.Value("/orders").Post
+			Expect(createOrder.Callbacks).To(HaveLen(2))
+			Expect(createOrder.Callbacks).To(HaveKey("orderCreated"))
+			Expect(createOrder.Callbacks).To(HaveKey("orderChanged"))
+
+			callbackItem := createOrder.Callbacks["orderCreated"].Value.Value("{$request.body#/url}")
+			Expect(callbackItem).ToNot(BeNil())
+			Expect(callbackItem.Post.OperationID).To(Equal("OrderCreated"))
+			Expect(callbackItem.Post.Security).To(BeNil())
+
+			cancelOrder := openapi.Paths.Value("/orders/cancel").Post
+			Expect(cancelOrder.Callbacks).To(HaveLen(1))
+			Expect(cancelOrder.Callbacks).To(HaveKey("orderChanged"))
+
+			Expect(openapi.Paths.Value("/ping").Post.Callbacks).To(BeNil())
+		})
+
+		It("should give each operation its own callbacks", func() {
+			def := definitions.ControllerMetadata{
+				Tag: "test",
+				Routes: []definitions.RouteMetadata{
+					route("createOrder", "/orders"),
+					route("cancelOrder", "/orders/cancel"),
+					webhookRoute("OrderChanged", "orderChanged", "createOrder", "cancelOrder"),
+				},
+			}
+
+			err := GenerateControllersSpec(openapi, config, []definitions.ControllerMetadata{def})
+			Expect(err).To(BeNil())
+
+			createOrder := openapi.Paths.Value("/orders").Post
+			cancelOrder := openapi.Paths.Value("/orders/cancel").Post
+			createOrder.Callbacks["extra"] = &openapi3.CallbackRef{Value: openapi3.NewCallback()}
+			Expect(cancelOrder.Callbacks).ToNot(HaveKey("extra"))
+		})
+
+		It("should attach webhooks of a webhook-only controller to operations of other controllers", func() {
+			orders := definitions.ControllerMetadata{
+				Tag:    "orders",
+				Routes: []definitions.RouteMetadata{route("createOrder", "/orders")},
+			}
+			hooks := definitions.ControllerMetadata{
+				Tag:    "hooks",
+				Routes: []definitions.RouteMetadata{webhookRoute("OrderCreated", "orderCreated", "createOrder")},
+			}
+
+			err := GenerateControllersSpec(openapi, config, []definitions.ControllerMetadata{hooks, orders})
+			Expect(err).To(BeNil())
+			Expect(openapi.Paths.Len()).To(Equal(1))
+			Expect(openapi.Paths.Value("/orders").Post.Callbacks).To(HaveKey("orderCreated"))
+		})
+
+		It("should skip unknown operations and webhooks that name no operations", func() {
+			def := definitions.ControllerMetadata{
+				Tag: "test",
+				Routes: []definitions.RouteMetadata{
+					route("createOrder", "/orders"),
+					webhookRoute("OrderCreated", "orderCreated"),
+					webhookRoute("OrderDeleted", "orderDeleted", "deleteOrder"),
+				},
+			}
+
+			err := GenerateControllersSpec(openapi, config, []definitions.ControllerMetadata{def})
+			Expect(err).To(BeNil())
+			Expect(openapi.Paths.Value("/orders").Post.Callbacks).To(BeNil())
+		})
+	})
+
+	Describe("addWebhookCallback", func() {
+		webhookRoute := func(verb definitions.HttpVerb) definitions.RouteMetadata {
+			return definitions.RouteMetadata{
+				HttpVerb: verb,
+				Webhook: &definitions.WebhookMetadata{
+					Name:        "orderCreated",
+					CallbackUrl: definitions.DefaultWebhookCallbackUrl,
+				},
+			}
+		}
+
+		It("should group operations under the webhook's name and callback URL", func() {
+			callbacks := openapi3.Callbacks{}
+			postOperation := &openapi3.Operation{OperationID: "OrderCreatedPost"}
+			putOperation := &openapi3.Operation{OperationID: "OrderCreatedPut"}
+
+			Expect(addWebhookCallback(callbacks, webhookRoute("POST"), postOperation)).To(Succeed())
+			Expect(addWebhookCallback(callbacks, webhookRoute("PUT"), putOperation)).To(Succeed())
+
+			Expect(callbacks).To(HaveKey("orderCreated"))
+			pathItem := callbacks["orderCreated"].Value.Value(definitions.DefaultWebhookCallbackUrl)
+			Expect(pathItem).ToNot(BeNil())
+			Expect(pathItem.Post).To(Equal(postOperation))
+			Expect(pathItem.Put).To(Equal(putOperation))
+		})
+
+		It("should return an error when a webhook has more than one operation with the same verb", func() {
+			callbacks := openapi3.Callbacks{}
+			Expect(addWebhookCallback(callbacks, webhookRoute("POST"), &openapi3.Operation{})).To(Succeed())
+
+			err := addWebhookCallback(callbacks, webhookRoute("POST"), &openapi3.Operation{})
+			Expect(err).To(MatchError("webhook 'orderCreated' has more than one POST operation"))
+		})
+	})
+
 	Describe("generateControllersSpec", func() {
 		It("should generate specifications for controllers", func() {
 			defs := []definitions.ControllerMetadata{
@@ -0,0 +1,35 @@
+package swagen30
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gopher-fleece/gleece/v2/definitions"
+)
+
+func toOpenApiServer(server definitions.OpenAPIServer) *openapi3.Server {
+	specServer := &openapi3.Server{
+		URL:         server.URL,
+		Description: server.Description,
+	}
+
+	if len(server.Variables) > 0 {
+		specServer.Variables = map[string]*openapi3.ServerVariable{}
+		for name, variable := range server.Variables {
+			specServer.Variables[name] = &openapi3.ServerVariable{
+				Enum:        variable.Enum,
+				Default:     variable.Default,
+				Description: variable.Description,
+			}
+		}
+	}
+
+	return specServer
+}
+
+// GenerateServersSpec converts the given servers to their OpenAPI 3.0 representation
+func GenerateServersSpec(servers []definitions.OpenAPIServer) openapi3.Servers {
+	specServers := openapi3.Servers{}
+	for _, server := range servers {
+		specServers = append(specServers, toOpenApiServer(server))
+	}
+	return specServers
+}
@@ -22,12 +22,9 @@ func GenerateSpec(config *definitions.OpenAPIGeneratorConfig, defs []definitions
 			Version:        config.Info.Version,
 			TermsOfService: config.Info.TermsOfService,
 		},
-		Servers: openapi3.Servers{
-			{
-				URL: config.BaseURL,
-			},
-		},
-		Paths: openapi3.NewPaths(),
+		Servers:    GenerateServersSpec(swagtool.GetRootServers(config)),
+		Paths:      openapi3.NewPaths(),
+		Extensions: GenerateExtensionsSpec(config.Extensions),
 		Components: &openapi3.Components{
 			Schemas: openapi3.Schemas{},
 		},
@@ -54,6 +51,9 @@ func GenerateSpec(config *definitions.OpenAPIGeneratorConfig, defs []definitions
 	}
 	logger.Info("Security spec generated successfully")
 
+	// Validation failures are reported using a dedicated RFC-7807 error which has to be documented alongside the models
+	swagtool.AppendValidationErrorSchema(&models.Structs, defs)
+
 	if err := GenerateModelsSpec(openapi, models); err != nil {
 		logger.Error("Failed to generate models spec - %v", err)
 		return nil, err
@@ -66,6 +66,8 @@ func GenerateSpec(config *definitions.OpenAPIGeneratorConfig, defs []definitions
 	}
 	logger.Info("Controllers spec generated successfully")
 
+	openapi.Tags = GenerateTagsSpec(swagtool.GetTopLevelTags(config, defs))
+
 	// Validate the spec to ensure it meets OpenAPI requirements
 	if err := openapi.Validate(context.Background()); err != nil {
 		logger.Error("Spec Validation failed - %v", err.Error())
@@ -13,8 +13,8 @@ import (
 	. "github.com/onsi/gomega"
 )
 
-var fullyFeaturesSpec = []byte(`{"components":{"schemas":{"ExampleAlias":{"description":"Example alias","title":"ExampleAlias","type":"string"},"ExampleIntAlias":{"deprecated":true,"description":"Example int alias","title":"ExampleIntAlias","type":"integer"},"ExampleSchema":{"description":"Example schema","properties":{"ExampleArrField":{"description":"Example array field","items":{"$ref":"#/components/schemas/ExampleSchema222"},"type":"array"},"ExampleArrStringField":{"description":"Example int arr field","items":{"items":{"items":{"items":{"$ref":"#/components/schemas/ExampleSchema222"},"type":"array"},"type":"array"},"type":"array"},"type":"array"},"ExampleField":{"deprecated":true,"description":"Example field","enum":["one","three","two"],"type":"string"},"ExampleObjField":{"$ref":"#/components/schemas/ExampleSchema222"}},"required":["ExampleField","ExampleObjField","ExampleArrField"],"title":"ExampleSchema","type":"object"},"ExampleSchema222":{"deprecated":true,"description":"Example schema 222","properties":{"MaxValue":{"description":"MaxValue DESCRIPTION","maximum":100,"minimum":1,"type":"integer"},"TheName":{"description":"TheName DESCRIPTION","format":"email","type":"string"}},"required":["TheName"],"title":"ExampleSchema222","type":"object"},"ExampleSchemaWithEnum":{"description":"Example enum schema","properties":{"TheStatus":{"$ref":"#/components/schemas/Status"},"TheStatus2":{"$ref":"#/components/schemas/Status2"}},"required":["TheStatus"],"title":"ExampleSchemaWithEnum","type":"object"},"ExampleSchemaWithMap":{"description":"Example schema with map","properties":{"ExampleMapOfMapValue":{"additionalProperties":{"additionalProperties":{"$ref":"#/components/schemas/ExampleSchema222"},"type":"object"},"description":"Example map of map value","type":"object"},"ExampleMapWithAnyValue":{"additionalProperties":{"type":"object"},"description":"Example map with any value","type":"object"},"ExampleMapWithArrayStructValue":{"additionalProperties":{"items":{"$ref":"#/components/schemas/ExampleSchema222"},"type":"array"},"description":"Example map with array struct value","type":"object"},"ExampleMapWithNonStringKey":{"additionalProperties":{"type":"string"},"description":"Example map with non-string key","type":"object"},"ExampleMapWithPrimitiveValue":{"additionalProperties":{"type":"integer"},"description":"Example map with primitive value","type":"object"},"ExampleMapWithStringValue":{"additionalProperties":{"type":"string"},"description":"Example map with string value","type":"object"},"ExampleMapWithStructValue":{"additionalProperties":{"$ref":"#/components/schemas/ExampleSchema222"},"description":"Example map with struct value","type":"object"}},"title":"ExampleSchemaWithMap","type":"object"},"Rfc7807Error":{"description":"A standard RFC-7807 error","properties":{"detail":{"description":"A human-readable explanation specific to this occurrence of the problem.","type":"string"},"error":{"description":"Error message","type":"string"},"extensions":{"additionalProperties":{"type":"object"},"description":"Additional metadata about the error.","type":"object"},"instance":{"description":"A URI reference that identifies the specific occurrence of the problem.","type":"string"},"status":{"description":"The HTTP status code generated by the origin server for this occurrence of the problem.","type":"integer"},"title":{"description":"A short, human-readable summary of the problem type.","type":"string"},"type":{"description":"A URI reference that identifies the problem type.","type":"string"}},"required":["type","title","status"],"title":"Rfc7807Error","type":"object"},"Status":{"description":"User status enum","enum":["ACTIVE","INACTIVE","SUSPENDED"],"title":"Status","type":"string"},"Status2":{"description":"User status enum","enum":["ACTIVE2","INACTIVE2","SUSPENDED2"],"title":"Status2","type":"string"},"StrctExampleAlias":{"description":"Example struct with alias field","properties":{"AliasField":{"$ref":"#/components/schemas/ExampleAlias"},"IntAliasField":{"$ref":"#/components/schemas/ExampleIntAlias"}},"required":["AliasField","IntAliasField"],"title":"StrctExampleAlias","type":"object"}},"securitySchemes":{"ApiKeyAuth":{"description":"API Key","in":"header","name":"X-API-Key2","type":"apiKey"},"ApiKeyAuth2":{"description":"API Key","scheme":"bearer","type":"http"},"ApiKeyAuth3":{"description":"API Key","openIdConnectUrl":"https://example.com/auth","type":"openIdConnect"},"ApiKeyAuth4":{"description":"API Key","flows":{"authorizationCode":{"authorizationUrl":"https://example.com/auth","refreshUrl":"https://example.com/refresh","scopes":{"read":"Read access","write":"Write access"},"tokenUrl":"https://example.com/token"},"clientCredentials":{"refreshUrl":"https://example.com/refresh","scopes":{"read":"Read access","write":"Write access"},"tokenUrl":"https://example.com/token"},"implicit":{"authorizationUrl":"https://example.com/auth","refreshUrl":"https://example.com/refresh","scopes":{"read":"Read access","write":"Write access"}},"password":{"refreshUrl":"https://example.com/refresh","scopes":{"read":"Read access","write":"Write access"},"tokenUrl":"https://example.com/token"}},"type":"oauth2"}}},"info":{"contact":{"name":"John Doe"},"description":"This is a simple API?","license":{"name":"Apache 2.0","url":"https://www.apache.org/licenses/LICENSE-2.0.html"},"title":"My API","version":"1.0.0"},"openapi":"3.0.0","paths":{"/example-base/example-route":{"delete":{"deprecated":true,"description":"Example route","operationId":"exampleRouteDel","responses":{"204":{"description":"Example response OK for 204"},"500":{"content":{"application/json":{"schema":{"type":"string"}}},"description":"Internal server error"},"default":{"description":""}},"security":[{"ApiKeyAuth":["read"]}],"summary":"Example route","tags":["Example"]},"post":{"description":"Example route","operationId":"exampleRoute45","responses":{"200":{"content":{"application/json":{"schema":{"type":"integer"}}},"description":"Example response OK"},"500":{"content":{"application/json":{"schema":{"type":"string"}}},"description":"Internal server error"},"default":{"description":""}},"security":[{"ApiKeyAuth":["read"]}],"summary":"Example route","tags":["Example"]}},"/example-base/example-route/{my_path}":{"get":{"description":"Example route","operationId":"exampleRoute","parameters":[{"deprecated":true,"description":"Example query param","in":"query","name":"my_name","required":true,"schema":{"format":"email","type":"string"}},{"description":"Example query ARR param","in":"query","name":"my_names","required":true,"schema":{"items":{"$ref":"#/components/schemas/ExampleSchema"},"type":"array"}},{"description":"Example Header param","in":"header","name":"my_header","required":true,"schema":{"type":"boolean"}},{"description":"Example Header num param","in":"header","name":"my_number","required":true,"schema":{"exclusiveMaximum":true,"maximum":100,"minimum":1,"type":"number"}},{"description":"Example Path param","in":"path","name":"my_path","required":true,"schema":{"enum":[1,2,3,4],"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"format":"email","type":"string"}}},"description":"Example Body param","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/ExampleSchema"},"type":"array"}}},"description":""},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/Rfc7807Error"}}},"description":"Internal server error"},"default":{"description":""}},"security":[{"ApiKeyAuth":["read","write"],"ApiKeyAuth2":["write"]},{"ApiKeyAuth":["read"]}],"summary":"Example route","tags":["Example"]}},"/example-base/post-alias":{"post":{"description":"Example alias route","operationId":"exampleAliasRoute","parameters":[{"description":"Example alias param","in":"query","name":"my_alias","required":true,"schema":{"$ref":"#/components/schemas/ExampleAlias"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/StrctExampleAlias"}}},"description":"Example Struct with Enum","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/StrctExampleAlias"},"type":"array"}}},"description":""},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/Rfc7807Error"}}},"description":"Internal server error"},"default":{"description":""}},"security":[{"ApiKeyAuth":["read","write"],"ApiKeyAuth2":["write"]},{"ApiKeyAuth":["read"]}],"summary":"Example alias route","tags":["Example"]}},"/example-base/post-enum":{"post":{"description":"Example enum route","operationId":"exampleEnumRoute","parameters":[{"description":"Example enum num param","in":"query","name":"my_enum","required":true,"schema":{"$ref":"#/components/schemas/Status"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ExampleSchemaWithEnum"}}},"description":"Example Struct with Enum","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/ExampleSchemaWithEnum"},"type":"array"}}},"description":""},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/Rfc7807Error"}}},"description":"Internal server error"},"default":{"description":""}},"security":[{"ApiKeyAuth":["read","write"],"ApiKeyAuth2":["write"]},{"ApiKeyAuth":["read"]}],"summary":"Example enum route","tags":["Example"]}},"/example-base/post-map":{"post":{"description":"Example map route","operationId":"exampleMapRoute","requestBody":{"content":{"application/json":{"schema":{"additionalProperties":{"type":"string"},"type":"object"}}},"description":"Example Map Body param","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"additionalProperties":{"$ref":"#/components/schemas/ExampleSchemaWithEnum"},"type":"object"}}},"description":""},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/Rfc7807Error"}}},"description":"Internal server error"},"default":{"description":""}},"security":[{"ApiKeyAuth":["read","write"],"ApiKeyAuth2":["write"]},{"ApiKeyAuth":["read"]}],"summary":"Example map route","tags":["Example"]}}},"servers":[{"url":"http://localhost:8080"}]}`)
-var formSpec = []byte(`{"components":{"schemas":{"Rfc7807Error":{"description":"A standard RFC-7807 error","properties":{"detail":{"description":"A human-readable explanation specific to this occurrence of the problem.","type":"string"},"error":{"description":"Error message","type":"string"},"extensions":{"additionalProperties":{"type":"object"},"description":"Additional metadata about the error.","type":"object"},"instance":{"description":"A URI reference that identifies the specific occurrence of the problem.","type":"string"},"status":{"description":"The HTTP status code generated by the origin server for this occurrence of the problem.","type":"integer"},"title":{"description":"A short, human-readable summary of the problem type.","type":"string"},"type":{"description":"A URI reference that identifies the problem type.","type":"string"}},"required":["type","title","status"],"title":"Rfc7807Error","type":"object"}},"securitySchemes":{"ApiKeyAuth":{"description":"API Key","in":"header","name":"X-API-Key2","type":"apiKey"}}},"info":{"contact":{"name":"John Doe"},"description":"This is a simple API?","license":{"name":"Apache 2.0","url":"https://www.apache.org/licenses/LICENSE-2.0.html"},"title":"My API","version":"1.0.0"},"openapi":"3.0.0","paths":{"/example-base/example-route":{"post":{"description":"Example form route","operationId":"exampleRoute","requestBody":{"content":{"application/x-www-form-urlencoded":{"schema":{"properties":{"my_form":{"description":"Example my_form param","type":"string"},"my_form_number":{"description":"Example my_form_number param","exclusiveMaximum":true,"maximum":100,"minimum":1,"type":"integer"},"my_form_option":{"description":"Example Header num param","type":"boolean"}},"required":["my_form","my_form_number"],"type":"object"}}}},"responses":{"200":{"description":""},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/Rfc7807Error"}}},"description":"Internal server error"},"default":{"description":""}},"security":[{"ApiKeyAuth":["read"]}],"summary":"Example form route","tags":["Example"]}}},"servers":[{"url":"http://localhost:8080"}]}`)
+var fullyFeaturesSpec = []byte(`{"components":{"schemas":{"ExampleAlias":{"description":"Example alias","title":"ExampleAlias","type":"string"},"ExampleIntAlias":{"deprecated":true,"description":"Example int alias","title":"ExampleIntAlias","type":"integer"},"ExampleSchema":{"description":"Example schema","properties":{"ExampleArrField":{"description":"Example array field","items":{"$ref":"#/components/schemas/ExampleSchema222"},"type":"array"},"ExampleArrStringField":{"description":"Example int arr field","items":{"items":{"items":{"items":{"$ref":"#/components/schemas/ExampleSchema222"},"type":"array"},"type":"array"},"type":"array"},"type":"array"},"ExampleField":{"deprecated":true,"description":"Example field","enum":["one","three","two"],"type":"string"},"ExampleObjField":{"$ref":"#/components/schemas/ExampleSchema222"}},"required":["ExampleField","ExampleObjField","ExampleArrField"],"title":"ExampleSchema","type":"object"},"ExampleSchema222":{"deprecated":true,"description":"Example schema 222","properties":{"MaxValue":{"description":"MaxValue DESCRIPTION","maximum":100,"minimum":1,"type":"integer"},"TheName":{"description":"TheName DESCRIPTION","format":"email","type":"string"}},"required":["TheName"],"title":"ExampleSchema222","type":"object"},"ExampleSchemaWithEnum":{"description":"Example enum schema","properties":{"TheStatus":{"$ref":"#/components/schemas/Status"},"TheStatus2":{"$ref":"#/components/schemas/Status2"}},"required":["TheStatus"],"title":"ExampleSchemaWithEnum","type":"object"},"ExampleSchemaWithMap":{"description":"Example schema with map","properties":{"ExampleMapOfMapValue":{"additionalProperties":{"additionalProperties":{"$ref":"#/components/schemas/ExampleSchema222"},"type":"object"},"description":"Example map of map value","type":"object"},"ExampleMapWithAnyValue":{"additionalProperties":{"type":"object"},"description":"Example map with any value","type":"object"},"ExampleMapWithArrayStructValue":{"additionalProperties":{"items":{"$ref":"#/components/schemas/ExampleSchema222"},"type":"array"},"description":"Example map with array struct value","type":"object"},"ExampleMapWithNonStringKey":{"additionalProperties":{"type":"string"},"description":"Example map with non-string key","type":"object"},"ExampleMapWithPrimitiveValue":{"additionalProperties":{"type":"integer"},"description":"Example map with primitive value","type":"object"},"ExampleMapWithStringValue":{"additionalProperties":{"type":"string"},"description":"Example map with string value","type":"object"},"ExampleMapWithStructValue":{"additionalProperties":{"$ref":"#/components/schemas/ExampleSchema222"},"description":"Example map with struct value","type":"object"}},"title":"ExampleSchemaWithMap","type":"object"},"Rfc7807Error":{"description":"A standard RFC-7807 error","properties":{"detail":{"description":"A human-readable explanation specific to this occurrence of the problem.","type":"string"},"error":{"description":"Error message","type":"string"},"extensions":{"additionalProperties":{"type":"object"},"description":"Additional metadata about the error.","type":"object"},"instance":{"description":"A URI reference that identifies the specific occurrence of the problem.","type":"string"},"status":{"description":"The HTTP status code generated by the origin server for this occurrence of the problem.","type":"integer"},"title":{"description":"A short, human-readable summary of the problem type.","type":"string"},"type":{"description":"A URI reference that identifies the problem type.","type":"string"}},"required":["type","title","status"],"title":"Rfc7807Error","type":"object"},"Status":{"description":"User status enum","enum":["ACTIVE","INACTIVE","SUSPENDED"],"title":"Status","type":"string"},"Status2":{"description":"User status enum","enum":["ACTIVE2","INACTIVE2","SUSPENDED2"],"title":"Status2","type":"string"},"StrctExampleAlias":{"description":"Example struct with alias field","properties":{"AliasField":{"$ref":"#/components/schemas/ExampleAlias"},"IntAliasField":{"$ref":"#/components/schemas/ExampleIntAlias"}},"required":["AliasField","IntAliasField"],"title":"StrctExampleAlias","type":"object"},"ValidationError":{"description":"A standard RFC-7807 error detailing the failed validations of a request","properties":{"detail":{"description":"A human-readable explanation specific to this occurrence of the problem.","type":"string"},"error":{"description":"Error message","type":"string"},"errors":{"description":"The failed validations.","items":{"$ref":"#/components/schemas/ValidationIssue"},"type":"array"},"extensions":{"additionalProperties":{"type":"object"},"description":"Additional metadata about the error.","type":"object"},"instance":{"description":"A URI reference that identifies the specific occurrence of the problem.","type":"string"},"status":{"description":"The HTTP status code generated by the origin server for this occurrence of the problem.","type":"integer"},"title":{"description":"A short, human-readable summary of the problem type.","type":"string"},"type":{"description":"A URI reference that identifies the problem type.","type":"string"}},"required":["type","title","status","errors"],"title":"ValidationError","type":"object"},"ValidationIssue":{"description":"A single failed validation of a request's input","properties":{"in":{"description":"The location of the offending input - body, query, path, header or form.","type":"string"},"message":{"description":"A human-readable description of the failure.","type":"string"},"param":{"description":"The failed rule's parameter, if any.","type":"string"},"pointer":{"description":"A JSON pointer to the offending value, relative to its location.","type":"string"},"rule":{"description":"The validation rule that failed.","type":"string"}},"required":["in","pointer","rule","message"],"title":"ValidationIssue","type":"object"}},"securitySchemes":{"ApiKeyAuth":{"description":"API Key","in":"header","name":"X-API-Key2","type":"apiKey"},"ApiKeyAuth2":{"description":"API Key","scheme":"bearer","type":"http"},"ApiKeyAuth3":{"description":"API Key","openIdConnectUrl":"https://example.com/auth","type":"openIdConnect"},"ApiKeyAuth4":{"description":"API Key","flows":{"authorizationCode":{"authorizationUrl":"https://example.com/auth","refreshUrl":"https://example.com/refresh","scopes":{"read":"Read access","write":"Write access"},"tokenUrl":"https://example.com/token"},"clientCredentials":{"refreshUrl":"https://example.com/refresh","scopes":{"read":"Read access","write":"Write access"},"tokenUrl":"https://example.com/token"},"implicit":{"authorizationUrl":"https://example.com/auth","refreshUrl":"https://example.com/refresh","scopes":{"read":"Read access","write":"Write access"}},"password":{"refreshUrl":"https://example.com/refresh","scopes":{"read":"Read access","write":"Write access"},"tokenUrl":"https://example.com/token"}},"type":"oauth2"}}},"info":{"contact":{"name":"John Doe"},"description":"This is a simple API?","license":{"name":"Apache 2.0","url":"https://www.apache.org/licenses/LICENSE-2.0.html"},"title":"My API","version":"1.0.0"},"openapi":"3.0.0","paths":{"/example-base/example-route":{"delete":{"deprecated":true,"description":"Example route","operationId":"exampleRouteDel","responses":{"204":{"description":"Example response OK for 204"},"500":{"content":{"application/json":{"schema":{"type":"string"}}},"description":"Internal server error"},"default":{"description":""}},"security":[{"ApiKeyAuth":["read"]}],"summary":"Example route","tags":["Example"]},"post":{"description":"Example route","operationId":"exampleRoute45","responses":{"200":{"content":{"application/json":{"schema":{"type":"integer"}}},"description":"Example response OK"},"500":{"content":{"application/json":{"schema":{"type":"string"}}},"description":"Internal server error"},"default":{"description":""}},"security":[{"ApiKeyAuth":["read"]}],"summary":"Example route","tags":["Example"]}},"/example-base/example-route/{my_path}":{"get":{"description":"Example route","operationId":"exampleRoute","parameters":[{"deprecated":true,"description":"Example query param","in":"query","name":"my_name","required":true,"schema":{"format":"email","type":"string"}},{"description":"Example query ARR param","in":"query","name":"my_names","required":true,"schema":{"items":{"$ref":"#/components/schemas/ExampleSchema"},"type":"array"}},{"description":"Example Header param","in":"header","name":"my_header","required":true,"schema":{"type":"boolean"}},{"description":"Example Header num param","in":"header","name":"my_number","required":true,"schema":{"exclusiveMaximum":true,"maximum":100,"minimum":1,"type":"number"}},{"description":"Example Path param","in":"path","name":"my_path","required":true,"schema":{"enum":[1,2,3,4],"type":"integer"}}],"requestBody":{"content":{"application/json":{"schema":{"format":"email","type":"string"}}},"description":"Example Body param","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/ExampleSchema"},"type":"array"}}},"description":""},"422":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ValidationError"}}},"description":"The request did not pass validation"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/Rfc7807Error"}}},"description":"Internal server error"},"default":{"description":""}},"security":[{"ApiKeyAuth":["read","write"],"ApiKeyAuth2":["write"]},{"ApiKeyAuth":["read"]}],"summary":"Example route","tags":["Example"]}},"/example-base/post-alias":{"post":{"description":"Example alias route","operationId":"exampleAliasRoute","parameters":[{"description":"Example alias param","in":"query","name":"my_alias","required":true,"schema":{"$ref":"#/components/schemas/ExampleAlias"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/StrctExampleAlias"}}},"description":"Example Struct with Enum","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/StrctExampleAlias"},"type":"array"}}},"description":""},"422":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ValidationError"}}},"description":"The request did not pass validation"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/Rfc7807Error"}}},"description":"Internal server error"},"default":{"description":""}},"security":[{"ApiKeyAuth":["read","write"],"ApiKeyAuth2":["write"]},{"ApiKeyAuth":["read"]}],"summary":"Example alias route","tags":["Example"]}},"/example-base/post-enum":{"post":{"description":"Example enum route","operationId":"exampleEnumRoute","parameters":[{"description":"Example enum num param","in":"query","name":"my_enum","required":true,"schema":{"$ref":"#/components/schemas/Status"}}],"requestBody":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ExampleSchemaWithEnum"}}},"description":"Example Struct with Enum","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"items":{"$ref":"#/components/schemas/ExampleSchemaWithEnum"},"type":"array"}}},"description":""},"422":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ValidationError"}}},"description":"The request did not pass validation"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/Rfc7807Error"}}},"description":"Internal server error"},"default":{"description":""}},"security":[{"ApiKeyAuth":["read","write"],"ApiKeyAuth2":["write"]},{"ApiKeyAuth":["read"]}],"summary":"Example enum route","tags":["Example"]}},"/example-base/post-map":{"post":{"description":"Example map route","operationId":"exampleMapRoute","requestBody":{"content":{"application/json":{"schema":{"additionalProperties":{"type":"string"},"type":"object"}}},"description":"Example Map Body param","required":true},"responses":{"200":{"content":{"application/json":{"schema":{"additionalProperties":{"$ref":"#/components/schemas/ExampleSchemaWithEnum"},"type":"object"}}},"description":""},"422":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ValidationError"}}},"description":"The request did not pass validation"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/Rfc7807Error"}}},"description":"Internal server error"},"default":{"description":""}},"security":[{"ApiKeyAuth":["read","write"],"ApiKeyAuth2":["write"]},{"ApiKeyAuth":["read"]}],"summary":"Example map route","tags":["Example"]}}},"servers":[{"url":"http://localhost:8080"}]}`)
+var formSpec = []byte(`{"components":{"schemas":{"Rfc7807Error":{"description":"A standard RFC-7807 error","properties":{"detail":{"description":"A human-readable explanation specific to this occurrence of the problem.","type":"string"},"error":{"description":"Error message","type":"string"},"extensions":{"additionalProperties":{"type":"object"},"description":"Additional metadata about the error.","type":"object"},"instance":{"description":"A URI reference that identifies the specific occurrence of the problem.","type":"string"},"status":{"description":"The HTTP status code generated by the origin server for this occurrence of the problem.","type":"integer"},"title":{"description":"A short, human-readable summary of the problem type.","type":"string"},"type":{"description":"A URI reference that identifies the problem type.","type":"string"}},"required":["type","title","status"],"title":"Rfc7807Error","type":"object"},"ValidationError":{"description":"A standard RFC-7807 error detailing the failed validations of a request","properties":{"detail":{"description":"A human-readable explanation specific to this occurrence of the problem.","type":"string"},"error":{"description":"Error message","type":"string"},"errors":{"description":"The failed validations.","items":{"$ref":"#/components/schemas/ValidationIssue"},"type":"array"},"extensions":{"additionalProperties":{"type":"object"},"description":"Additional metadata about the error.","type":"object"},"instance":{"description":"A URI reference that identifies the specific occurrence of the problem.","type":"string"},"status":{"description":"The HTTP status code generated by the origin server for this occurrence of the problem.","type":"integer"},"title":{"description":"A short, human-readable summary of the problem type.","type":"string"},"type":{"description":"A URI reference that identifies the problem type.","type":"string"}},"required":["type","title","status","errors"],"title":"ValidationError","type":"object"},"ValidationIssue":{"description":"A single failed validation of a request's input","properties":{"in":{"description":"The location of the offending input - body, query, path, header or form.","type":"string"},"message":{"description":"A human-readable description of the failure.","type":"string"},"param":{"description":"The failed rule's parameter, if any.","type":"string"},"pointer":{"description":"A JSON pointer to the offending value, relative to its location.","type":"string"},"rule":{"description":"The validation rule that failed.","type":"string"}},"required":["in","pointer","rule","message"],"title":"ValidationIssue","type":"object"}},"securitySchemes":{"ApiKeyAuth":{"description":"API Key","in":"header","name":"X-API-Key2","type":"apiKey"}}},"info":{"contact":{"name":"John Doe"},"description":"This is a simple API?","license":{"name":"Apache 2.0","url":"https://www.apache.org/licenses/LICENSE-2.0.html"},"title":"My API","version":"1.0.0"},"openapi":"3.0.0","paths":{"/example-base/example-route":{"post":{"description":"Example form route","operationId":"exampleRoute","requestBody":{"content":{"application/x-www-form-urlencoded":{"schema":{"properties":{"my_form":{"description":"Example my_form param","type":"string"},"my_form_number":{"description":"Example my_form_number param","exclusiveMaximum":true,"maximum":100,"minimum":1,"type":"integer"},"my_form_option":{"description":"Example Header num param","type":"boolean"}},"required":["my_form","my_form_number"],"type":"object"}}}},"responses":{"200":{"description":""},"422":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/ValidationError"}}},"description":"The request did not pass validation"},"500":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/Rfc7807Error"}}},"description":"Internal server error"},"default":{"description":""}},"security":[{"ApiKeyAuth":["read"]}],"summary":"Example form route","tags":["Example"]}}},"servers":[{"url":"http://localhost:8080"}]}`)
 var allOfSpec = []byte(`{"components":{"schemas":{"BaseModel":{"description":"Base model with common fields","properties":{"created_at":{"description":"Creation timestamp","type":"integer"},"id":{"description":"Unique identifier","type":"string"}},"required":["id"],"title":"BaseModel","type":"object"},"ChildModel":{"allOf":[{"description":"Child model that extends BaseModel","properties":{"name":{"description":"Child name field","type":"string"}},"required":["name"],"title":"ChildModel","type":"object"},{"$ref":"#/components/schemas/BaseModel"}]},"CompositeModel":{"allOf":[{"description":"Model with multiple embedded types","properties":{"description":{"description":"Model description","type":"string"}},"title":"CompositeModel","type":"object"},{"$ref":"#/components/schemas/BaseModel"},{"$ref":"#/components/schemas/TaggableModel"}]},"Rfc7807Error":{"description":"A standard RFC-7807 error","properties":{"detail":{"description":"A human-readable explanation specific to this occurrence of the problem.","type":"string"},"error":{"description":"Error message","type":"string"},"extensions":{"additionalProperties":{"type":"object"},"description":"Additional metadata about the error.","type":"object"},"instance":{"description":"A URI reference that identifies the specific occurrence of the problem.","type":"string"},"status":{"description":"The HTTP status code generated by the origin server for this occurrence of the problem.","type":"integer"},"title":{"description":"A short, human-readable summary of the problem type.","type":"string"},"type":{"description":"A URI reference that identifies the problem type.","type":"string"}},"required":["type","title","status"],"title":"Rfc7807Error","type":"object"},"TaggableModel":{"description":"Model with tagging functionality","properties":{"tags":{"description":"Tags for categorization","items":{"type":"string"},"type":"array"}},"title":"TaggableModel","type":"object"}}},"info":{"contact":{"name":"API Support"},"description":"API with schema composition using allOf","license":{"name":"MIT"},"title":"AllOf API","version":"1.0.0"},"openapi":"3.0.0","paths":{},"servers":[{"url":"http://localhost:8080"}]}`)
 
 var _ = Describe("Spec Generator", func() {
@@ -0,0 +1,31 @@
+package swagen30
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/gopher-fleece/gleece/v2/definitions"
+)
+
+// GenerateTagsSpec converts the given tags to their OpenAPI 3.0 representation
+func GenerateTagsSpec(tags []definitions.OpenAPITag) openapi3.Tags {
+	if len(tags) <= 0 {
+		return nil
+	}
+
+	specTags := openapi3.Tags{}
+	for _, tag := range tags {
+		specTag := &openapi3.Tag{
+			Name:        tag.Name,
+			Description: tag.Description,
+		}
+
+		if tag.ExternalDocs != nil {
+			specTag.ExternalDocs = &openapi3.ExternalDocs{
+				Description: tag.ExternalDocs.Description,
+				URL:         tag.ExternalDocs.URL,
+			}
+		}
+
+		specTags = append(specTags, specTag)
+	}
+	return specTags
+}
@@ -0,0 +1,30 @@
+package swagen31
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/pb33f/libopenapi/orderedmap"
+	"go.yaml.in/yaml/v4"
+)
+
+// GenerateExtensionsSpec converts the given vendor extensions to their OpenAPI 3.1 representation.
+//
+// Returns nil if there are no extensions so they are omitted from the output
+func GenerateExtensionsSpec(extensions map[string]any) (*orderedmap.Map[string, *yaml.Node], error) {
+	if len(extensions) == 0 {
+		return nil, nil
+	}
+
+	specExtensions := orderedmap.New[string, *yaml.Node]()
+	// Sort the extension names to keep the output deterministic
+	for _, name := range slices.Sorted(maps.Keys(extensions)) {
+		node := &yaml.Node{}
+		if err := node.Encode(extensions[name]); err != nil {
+			return nil, err
+		}
+		specExtensions.Set(name, node)
+	}
+
+	return specExtensions, nil
+}
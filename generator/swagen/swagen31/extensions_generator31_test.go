@@ -0,0 +1,96 @@
+package swagen31
+
+import (
+	"encoding/json"
+
+	"github.com/gopher-fleece/gleece/v2/definitions"
+	"github.com/gopher-fleece/gleece/v2/generator/swagen/swagtool"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Extensions v3.1 Generator", func() {
+	var spec map[string]any
+
+	BeforeEach(func() {
+		config := &definitions.OpenAPIGeneratorConfig{
+			Info:       definitions.OpenAPIInfo{Title: "Extensions API", Version: "1.0.0"},
+			BaseURL:    "https://api.example.com",
+			Extensions: map[string]any{"x-logo": map[string]any{"url": "https://example.com/logo.png"}},
+		}
+
+		structs := []definitions.StructMetadata{
+			{
+				Name:       "Widget",
+				Extensions: map[string]any{"x-entity": "widget"},
+				Fields: []definitions.FieldMetadata{
+					{
+						Name:       "Name",
+						Type:       "string",
+						Tag:        `json:"name"`,
+						Extensions: map[string]any{"x-order": 1},
+					},
+				},
+			},
+		}
+		swagtool.AppendErrorSchema(&structs, true)
+
+		defs := []definitions.ControllerMetadata{
+			{
+				Name:         "WidgetsController",
+				Tag:          "Widgets",
+				RestMetadata: definitions.RestMetadata{Path: "/widgets"},
+				Routes: []definitions.RouteMetadata{
+					{
+						OperationId:         "GetWidget",
+						HttpVerb:            definitions.HttpGet,
+						RestMetadata:        definitions.RestMetadata{Path: "/search"},
+						ResponseSuccessCode: 200,
+						Extensions:          map[string]any{"x-internal": true},
+						FuncParams: []definitions.FuncParam{
+							{
+								ParamMeta:    definitions.ParamMeta{Name: "name", TypeMeta: definitions.TypeMetadata{Name: "string"}},
+								PassedIn:     definitions.PassedInQuery,
+								NameInSchema: "name",
+								Validator:    "required",
+								Extensions:   map[string]any{"x-example": "gizmo"},
+							},
+						},
+						Responses: []definitions.FuncReturnValue{
+							{TypeMetadata: definitions.TypeMetadata{Name: "Widget"}},
+							{TypeMetadata: definitions.TypeMetadata{Name: "error"}},
+						},
+					},
+				},
+			},
+		}
+
+		jsonBytes, err := GenerateSpec(config, defs, &definitions.Models{Structs: structs})
+		Expect(err).To(BeNil())
+
+		spec = map[string]any{}
+		Expect(json.Unmarshal(jsonBytes, &spec)).To(Succeed())
+	})
+
+	It("Emits root-level extensions", func() {
+		Expect(spec["x-logo"]).To(Equal(map[string]any{"url": "https://example.com/logo.png"}))
+	})
+
+	It("Emits operation and parameter extensions", func() {
+		operation := spec["paths"].(map[string]any)["/widgets/search"].(map[string]any)["get"].(map[string]any)
+		Expect(operation["x-internal"]).To(BeTrue())
+
+		parameters := operation["parameters"].([]any)
+		Expect(parameters).To(HaveLen(1))
+		Expect(parameters[0].(map[string]any)["x-example"]).To(Equal("gizmo"))
+	})
+
+	It("Emits schema and property extensions", func() {
+		schema := spec["components"].(map[string]any)["schemas"].(map[string]any)["Widget"].(map[string]any)
+		Expect(schema["x-entity"]).To(Equal("widget"))
+
+		property := schema["properties"].(map[string]any)["name"].(map[string]any)
+		Expect(property["x-order"]).To(BeEquivalentTo(1))
+	})
+})
@@ -1,6 +1,8 @@
 package swagen31
 
 import (
+	"fmt"
+
 	"github.com/gopher-fleece/gleece/v2/definitions"
 	"github.com/gopher-fleece/gleece/v2/generator/swagen/swagtool"
 	highbase "github.com/pb33f/libopenapi/datamodel/high/base"
@@ -9,7 +11,7 @@ import (
 	"go.yaml.in/yaml/v4"
 )
 
-func generateStructsSpec(doc *v3.Document, model definitions.StructMetadata) {
+func generateStructsSpec(doc *v3.Document, model definitions.StructMetadata) error {
 	isDeprecated := swagtool.IsDeprecated(&model.Deprecation)
 
 	// The schema that will hold all regular fields
@@ -73,6 +75,12 @@ func generateStructsSpec(doc *v3.Document, model definitions.StructMetadata) {
 			innerSchema.Description = field.Description
 			isFieldDeprecated := swagtool.IsDeprecated(field.Deprecation)
 			innerSchema.Deprecated = &isFieldDeprecated
+
+			fieldExtensions, err := GenerateExtensionsSpec(field.Extensions)
+			if err != nil {
+				return fmt.Errorf("failed to generate extensions for field '%s' of '%s' - %v", field.Name, model.Name, err)
+			}
+			innerSchema.Extensions = fieldExtensions
 		}
 
 		regularFieldsSchema.Properties.Set(fName, fieldSchemaRef)
@@ -81,8 +89,15 @@ func generateStructsSpec(doc *v3.Document, model definitions.StructMetadata) {
 	// Required fields are part of the regular schema
 	regularFieldsSchema.Required = requiredFields
 
+	extensions, err := GenerateExtensionsSpec(model.Extensions)
+	if err != nil {
+		return fmt.Errorf("failed to generate extensions for '%s' - %v", model.Name, err)
+	}
+	finalSchema.Extensions = extensions
+
 	// Add the final schema to components
 	doc.Components.Schemas.Set(model.Name, highbase.CreateSchemaProxy(finalSchema))
+	return nil
 }
 
 func generateEnumsSpec(doc *v3.Document, model definitions.EnumMetadata) {
@@ -135,7 +150,9 @@ func GenerateModelsSpec(doc *v3.Document, models *definitions.Models) error {
 	}
 
 	for _, model := range models.Structs {
-		generateStructsSpec(doc, model)
+		if err := generateStructsSpec(doc, model); err != nil {
+			return err
+		}
 	}
 
 	for _, alias := range models.Aliases {
@@ -9,9 +9,11 @@ import (
 	"github.com/gopher-fleece/gleece/v2/definitions"
 	"github.com/gopher-fleece/gleece/v2/generator/swagen/swagtool"
 	"github.com/gopher-fleece/gleece/v2/infrastructure/logger"
+	"github.com/gopher-fleece/runtime"
 	highbase "github.com/pb33f/libopenapi/datamodel/high/base"
 	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
+	"go.yaml.in/yaml/v4"
 )
 
 func createOperation(def definitions.ControllerMetadata, route definitions.RouteMetadata) *v3.Operation {
@@ -20,7 +22,7 @@ func createOperation(def definitions.ControllerMetadata, route definitions.Route
 		Summary:     route.Description,
 		Description: route.Description,
 		OperationId: route.OperationId,
-		Tags:        []string{def.Tag},
+		Tags:        swagtool.GetOperationTags(def, route),
 		Parameters:  []*v3.Parameter{},
 		Deprecated:  &isDeprecated,
 		Responses: &v3.Responses{
@@ -46,6 +48,37 @@ func createErrorResponse(doc *v3.Document, route definitions.RouteMetadata, errR
 	}
 }
 
+// createValidationErrorResponse creates the response returned when the route's input fails validation
+func createValidationErrorResponse(doc *v3.Document, route definitions.RouteMetadata) *v3.Response {
+	return &v3.Response{
+		Description: ToResponseDescription(swagtool.GetValidationErrorDescription(route)),
+		Content:     createContentWithSchemaRef(doc, "", definitions.ValidationErrorName),
+	}
+}
+
+// createRateLimitErrorResponse creates the response returned when a request exceeds the route's rate limit
+func createRateLimitErrorResponse(doc *v3.Document, route definitions.RouteMetadata) *v3.Response {
+	headers := orderedmap.New[string, *v3.Header]()
+	headers.Set(swagtool.RetryAfterHeaderName, &v3.Header{
+		Description: swagtool.RetryAfterHeaderDescription,
+		Schema:      highbase.CreateSchemaProxy(ToOpenApiSchemaV3("integer")),
+	})
+
+	return &v3.Response{
+		Description: ToResponseDescription(swagtool.GetRateLimitErrorDescription(route)),
+		Content:     createContentWithSchemaRef(doc, "", definitions.Rfc7807ErrorName),
+		Headers:     headers,
+	}
+}
+
+// createIdempotencyErrorResponse creates a response returned when an idempotent request's 'Idempotency-Key' header is missing, in use or reused
+func createIdempotencyErrorResponse(doc *v3.Document, description string) *v3.Response {
+	return &v3.Response{
+		Description: ToResponseDescription(description),
+		Content:     createContentWithSchemaRef(doc, "", definitions.Rfc7807ErrorName),
+	}
+}
+
 func createContentWithSchemaRef(doc *v3.Document, validationString string, interfaceType string) *orderedmap.Map[string, *v3.MediaType] {
 	schemaRef := InterfaceToSchemaV3(doc, interfaceType)
 	if schemaRef.Schema() != nil {
@@ -75,6 +108,26 @@ func createResponseSuccess(doc *v3.Document, route definitions.RouteMetadata) *v
 	}
 }
 
+func generateResponseHeaders(operation *v3.Operation, route definitions.RouteMetadata) {
+	for pair := operation.Responses.Codes.First(); pair != nil; pair = pair.Next() {
+		response := pair.Value()
+		for _, header := range route.ResponseHeaders {
+			if !swagtool.IsHeaderInResponse(header, pair.Key()) {
+				continue
+			}
+
+			if response.Headers == nil {
+				response.Headers = orderedmap.New[string, *v3.Header]()
+			}
+
+			response.Headers.Set(header.Name, &v3.Header{
+				Description: header.Description,
+				Schema:      highbase.CreateSchemaProxy(ToOpenApiSchemaV3(header.Type)),
+			})
+		}
+	}
+}
+
 func buildSecurityMethod(securitySchemes []definitions.SecuritySchemeConfig, securityMethods []definitions.SecurityAnnotationComponent) (*highbase.SecurityRequirement, error) {
 	securityRequirement := orderedmap.New[string, []string]()
 
@@ -85,7 +138,12 @@ func buildSecurityMethod(securitySchemes []definitions.SecuritySchemeConfig, sec
 				securityMethod.SchemaName, securitySchemes)
 			return nil, errors.New(errStr)
 		}
-		securityRequirement.Set(securityMethod.SchemaName, securityMethod.Scopes)
+		scopes := securityMethod.Scopes
+		if existingScopes, exists := securityRequirement.Get(securityMethod.SchemaName); exists {
+			// A schema required more than once by an @AdvancedSecurity expression is required once, with all scopes
+			scopes = swagtool.MergeSecurityScopes(existingScopes, scopes)
+		}
+		securityRequirement.Set(securityMethod.SchemaName, scopes)
 	}
 
 	return &highbase.SecurityRequirement{
@@ -95,10 +153,15 @@ func buildSecurityMethod(securitySchemes []definitions.SecuritySchemeConfig, sec
 
 func generateOperationSecurity(operation *v3.Operation, config *definitions.OpenAPIGeneratorConfig, route definitions.RouteMetadata) error {
 	var securityRequirements []*highbase.SecurityRequirement
+	if route.IsPublic {
+		// An explicitly empty list is rendered as 'security: []', marking the operation as public
+		securityRequirements = []*highbase.SecurityRequirement{}
+	}
 
 	routeSecurity := route.Security
 
-	if len(routeSecurity) == 0 && config.DefaultRouteSecurity != nil {
+	// Public routes are explicitly exempt from security and do not fall back to the default one
+	if len(routeSecurity) == 0 && config.DefaultRouteSecurity != nil && !route.IsPublic {
 		routeSecurity = []definitions.RouteSecurity{{SecurityAnnotation: []definitions.SecurityAnnotationComponent{*config.DefaultRouteSecurity}}}
 	}
 
@@ -138,8 +201,15 @@ func setNewRouteOperation(doc *v3.Document, def definitions.ControllerMetadata,
 		pathItem = &v3.PathItem{}
 	}
 
-	// Set the operation based on HTTP verb
-	switch route.HttpVerb {
+	setPathItemOperation(pathItem, route.HttpVerb, operation)
+
+	// Set the path item in the document
+	doc.Paths.PathItems.Set(routePath, pathItem)
+}
+
+// setPathItemOperation sets the given operation on the path item based on the HTTP verb
+func setPathItemOperation(pathItem *v3.PathItem, verb definitions.HttpVerb, operation *v3.Operation) {
+	switch verb {
 	case "GET":
 		pathItem.Get = operation
 	case "POST":
@@ -157,9 +227,24 @@ func setNewRouteOperation(doc *v3.Document, def definitions.ControllerMetadata,
 	case "TRACE":
 		pathItem.Trace = operation
 	}
+}
 
-	// Set the path item in the document
-	doc.Paths.PathItems.Set(routePath, pathItem)
+// setNewWebhookOperation sets the given operation in the document's top-level webhooks under the route's webhook name
+func setNewWebhookOperation(doc *v3.Document, route definitions.RouteMetadata, operation *v3.Operation) error {
+	if doc.Webhooks == nil {
+		doc.Webhooks = orderedmap.New[string, *v3.PathItem]()
+	}
+
+	pathItem, exists := doc.Webhooks.Get(route.Webhook.Name)
+	if !exists || pathItem == nil {
+		pathItem = &v3.PathItem{}
+	} else if pathItem.GetOperations().GetOrZero(strings.ToLower(string(route.HttpVerb))) != nil {
+		return fmt.Errorf("webhook '%s' has more than one %s operation", route.Webhook.Name, route.HttpVerb)
+	}
+
+	setPathItemOperation(pathItem, route.HttpVerb, operation)
+	doc.Webhooks.Set(route.Webhook.Name, pathItem)
+	return nil
 }
 
 func handleRouteParamDeprecation(routeParam definitions.FuncParam, specParam *v3.Parameter) {
@@ -196,7 +281,12 @@ func createRequestBodyParam(doc *v3.Document, param definitions.FuncParam) *v3.R
 	}
 }
 
-func createRequestFormParam(doc *v3.Document, param definitions.FuncParam, operation *v3.Operation) {
+func createRequestFormParam(
+	doc *v3.Document,
+	param definitions.FuncParam,
+	operation *v3.Operation,
+	extensions *orderedmap.Map[string, *yaml.Node],
+) {
 	// Form parameters are always passed in the body, so we need to create a request body if it doesn't exist
 	if operation.RequestBody == nil {
 		// The body will be an object with the form parameters as properties
@@ -225,6 +315,7 @@ func createRequestFormParam(doc *v3.Document, param definitions.FuncParam, opera
 	// Set the description on the property schema itself
 	if propertySchemaRef.Schema() != nil {
 		propertySchemaRef.Schema().Description = param.Description
+		propertySchemaRef.Schema().Extensions = extensions
 	}
 	// Add the form parameter to the schema
 	formSchema.Properties.Set(param.NameInSchema, propertySchemaRef)
@@ -234,22 +325,35 @@ func createRequestFormParam(doc *v3.Document, param definitions.FuncParam, opera
 	}
 }
 
-func generateParams(doc *v3.Document, route definitions.RouteMetadata, operation *v3.Operation) {
+func generateParams(doc *v3.Document, route definitions.RouteMetadata, operation *v3.Operation) error {
 	// Iterate over FuncParams and create parameters
-	for _, param := range route.FuncParams {
+	for _, param := range swagtool.GetOperationParams(route) {
 		if param.IsContext {
 			continue // The context is for the generated code only, and should not affect the specification
 		}
 
+		if param.PassedIn == definitions.PassedInPrincipal {
+			continue // The principal is attached by the authorization layer rather than passed by the client
+		}
+
+		extensions, err := GenerateExtensionsSpec(param.Extensions)
+		if err != nil {
+			return fmt.Errorf("failed to generate extensions for parameter '%s' - %v", param.Name, err)
+		}
+
 		switch param.PassedIn {
 		case definitions.PassedInBody:
 			operation.RequestBody = createRequestBodyParam(doc, param)
+			operation.RequestBody.Extensions = extensions
 		case definitions.PassedInForm:
-			createRequestFormParam(doc, param, operation)
+			createRequestFormParam(doc, param, operation, extensions)
 		default:
-			operation.Parameters = append(operation.Parameters, createRouteParam(doc, param))
+			specParam := createRouteParam(doc, param)
+			specParam.Extensions = extensions
+			operation.Parameters = append(operation.Parameters, specParam)
 		}
 	}
+	return nil
 }
 
 // GenerateControllerSpec generates the specification for a controller
@@ -262,27 +366,83 @@ func generateControllerSpec(doc *v3.Document, config *definitions.OpenAPIGenerat
 			continue
 		}
 
+		if definitions.IsCustomHttpVerb(string(route.HttpVerb)) {
+			// OpenAPI has no means of describing operations with non-standard verbs
+			logger.Warn(fmt.Sprintf("Skipping route with custom HTTP verb: %v %s (%s)", route.HttpVerb, route.RestMetadata.Path, route.OperationId))
+			continue
+		}
+
 		// Create a new Operation for the route
 		operation := createOperation(def, route)
 
+		extensions, err := GenerateExtensionsSpec(swagtool.GetOperationExtensions(route))
+		if err != nil {
+			return err
+		}
+		operation.Extensions = extensions
+
 		// Iterate over the error responses
 		for _, errResp := range route.ErrorResponses {
 			// Set the response using the Set method
 			operation.Responses.Codes.Set(swagtool.HttpStatusCodeToString(errResp.HttpStatusCode), createErrorResponse(doc, route, errResp))
 		}
 
+		// Requests exceeding the route's rate limit are answered with a standard RFC-7807 error
+		if route.RateLimit != nil && !route.IsWebhook() {
+			operation.Responses.Codes.Set(swagtool.HttpStatusCodeToString(runtime.StatusTooManyRequests), createRateLimitErrorResponse(doc, route))
+		}
+
+		// Idempotent routes reject requests lacking an 'Idempotency-Key' header, repeating one that is still being processed
+		// or reusing one with a different body. Routes with validated input document their 422 response using the validation schema instead
+		if route.Idempotency != nil && !route.IsWebhook() {
+			operation.Responses.Codes.Set(
+				swagtool.HttpStatusCodeToString(runtime.StatusBadRequest),
+				createIdempotencyErrorResponse(doc, swagtool.GetIdempotencyKeyMissingErrorDescription(route)),
+			)
+			operation.Responses.Codes.Set(
+				swagtool.HttpStatusCodeToString(runtime.StatusConflict),
+				createIdempotencyErrorResponse(doc, swagtool.GetIdempotencyConflictErrorDescription(route)),
+			)
+			operation.Responses.Codes.Set(
+				swagtool.HttpStatusCodeToString(runtime.StatusUnprocessableEntity),
+				createIdempotencyErrorResponse(doc, swagtool.GetIdempotencyKeyReusedErrorDescription(route)),
+			)
+		}
+
+		// Input validation failures are always reported using a dedicated schema
+		if swagtool.HasValidatedInput(route) {
+			operation.Responses.Codes.Set(swagtool.HttpStatusCodeToString(runtime.StatusUnprocessableEntity), createValidationErrorResponse(doc, route))
+		}
+
 		successResponse := createResponseSuccess(doc, route)
 		operation.Responses.Codes.Set(swagtool.HttpStatusCodeToString(route.ResponseSuccessCode), successResponse)
 
+		generateResponseHeaders(operation, route)
+
 		// operation.Responses.Default - for now, we do not support "default" response
 
-		generateParams(doc, route, operation)
+		if err := generateParams(doc, route, operation); err != nil {
+			return err
+		}
+
+		// Webhooks are outbound calls - they're neither secured nor served by our servers
+		if route.IsWebhook() {
+			if err := setNewWebhookOperation(doc, route, operation); err != nil {
+				return err
+			}
+			continue
+		}
 
 		// Add the security requirement to the operation
 		if err := generateOperationSecurity(operation, config, route); err != nil {
 			return err
 		}
 
+		// Add any alternative servers serving the operation
+		if len(route.Servers) > 0 {
+			operation.Servers = GenerateServersSpec(route.Servers)
+		}
+
 		// Finally, set the operation in the path item
 		setNewRouteOperation(doc, def, route, operation)
 	}
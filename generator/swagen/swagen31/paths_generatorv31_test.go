@@ -0,0 +1,196 @@
+package swagen31
+
+import (
+	"github.com/gopher-fleece/gleece/v2/definitions"
+	"github.com/gopher-fleece/runtime"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Paths v3.1 Generator", func() {
+	Describe("generateResponseHeaders", func() {
+		It("should add headers to all responses or only to the specified status codes", func() {
+			route := definitions.RouteMetadata{
+				ResponseHeaders: []definitions.ResponseHeader{
+					{Name: "X-Request-Id", Type: "string", Description: "The request's ID"},
+					{Name: "X-RateLimit-Remaining", Type: "integer", StatusCodes: []runtime.HttpStatusCode{200}},
+				},
+			}
+
+			operation := &v3.Operation{
+				Responses: &v3.Responses{Codes: orderedmap.New[string, *v3.Response]()},
+			}
+			operation.Responses.Codes.Set("200", &v3.Response{Description: "OK"})
+			operation.Responses.Codes.Set("500", &v3.Response{Description: "Error"})
+
+			generateResponseHeaders(operation, route)
+
+			successHeaders := operation.Responses.Codes.GetOrZero("200").Headers
+			Expect(successHeaders.Len()).To(Equal(2))
+			Expect(successHeaders.GetOrZero("X-Request-Id").Description).To(Equal("The request's ID"))
+			Expect(successHeaders.GetOrZero("X-Request-Id").Schema.Schema().Type).To(Equal([]string{"string"}))
+			Expect(successHeaders.GetOrZero("X-RateLimit-Remaining").Schema.Schema().Type).To(Equal([]string{"integer"}))
+
+			errorHeaders := operation.Responses.Codes.GetOrZero("500").Headers
+			Expect(errorHeaders.Len()).To(Equal(1))
+			Expect(errorHeaders.GetOrZero("X-Request-Id")).ToNot(BeNil())
+		})
+	})
+
+	Describe("generateControllerSpec", func() {
+		responses := []definitions.FuncReturnValue{
+			{TypeMetadata: definitions.TypeMetadata{Name: "error"}},
+		}
+
+		webhookRoute := func(operationId string) definitions.RouteMetadata {
+			return definitions.RouteMetadata{
+				HttpVerb:            "POST",
+				OperationId:         operationId,
+				ResponseSuccessCode: 204,
+				Responses:           responses,
+				Webhook: &definitions.WebhookMetadata{
+					Name:        "orderCreated",
+					CallbackUrl: definitions.DefaultWebhookCallbackUrl,
+				},
+			}
+		}
+
+		newDoc := func() *v3.Document {
+			return &v3.Document{
+				Paths: &v3.Paths{PathItems: orderedmap.New[string, *v3.PathItem]()},
+			}
+		}
+
+		It("should emit webhooks into the top-level webhooks rather than as paths", func() {
+			doc := newDoc()
+			def := definitions.ControllerMetadata{
+				Tag: "test",
+				Routes: []definitions.RouteMetadata{
+					{
+						HttpVerb:            "GET",
+						OperationId:         "GetOrders",
+						RestMetadata:        definitions.RestMetadata{Path: "/orders"},
+						ResponseSuccessCode: 204,
+						Responses:           responses,
+					},
+					webhookRoute("OrderCreated"),
+				},
+			}
+
+			err := generateControllerSpec(doc, &definitions.OpenAPIGeneratorConfig{}, def)
+			Expect(err).To(BeNil())
+			Expect(doc.Paths.PathItems.Len()).To(Equal(1))
+
+			Expect(doc.Webhooks.Len()).To(Equal(1))
+			webhook := doc.Webhooks.GetOrZero("orderCreated")
+			Expect(webhook).ToNot(BeNil())
+			Expect(webhook.Post.OperationId).To(Equal("OrderCreated"))
+			Expect(webhook.Post.Security).To(BeNil())
+		})
+
+		It("should document the 429 response and the 'x-ratelimit' extension of rate limited routes", func() {
+			doc := newDoc()
+			def := definitions.ControllerMetadata{
+				Tag: "test",
+				Routes: []definitions.RouteMetadata{
+					{
+						HttpVerb:            "GET",
+						OperationId:         "LimitedOperation",
+						RestMetadata:        definitions.RestMetadata{Path: "/limited"},
+						ResponseSuccessCode: 204,
+						Responses:           responses,
+						RateLimit:           &definitions.RateLimit{Requests: 100, Per: "1m", Key: definitions.RateLimitKeyIp},
+					},
+				},
+			}
+
+			err := generateControllerSpec(doc, &definitions.OpenAPIGeneratorConfig{}, def)
+			Expect(err).To(BeNil())
+
+			operation := doc.Paths.PathItems.GetOrZero("/limited").Get
+			Expect(operation.Extensions.GetOrZero("x-ratelimit")).ToNot(BeNil())
+
+			response := operation.Responses.Codes.GetOrZero("429")
+			Expect(response).ToNot(BeNil())
+			Expect(response.Description).To(Equal("The request exceeded the operation's rate limit"))
+			Expect(response.Headers.GetOrZero("Retry-After").Schema.Schema().Type).To(Equal([]string{"integer"}))
+		})
+
+		It("should document the 'Idempotency-Key' header and the 400 and 409 responses of idempotent routes", func() {
+			doc := newDoc()
+			def := definitions.ControllerMetadata{
+				Tag: "test",
+				Routes: []definitions.RouteMetadata{
+					{
+						HttpVerb:            "POST",
+						OperationId:         "IdempotentOperation",
+						RestMetadata:        definitions.RestMetadata{Path: "/payments"},
+						ResponseSuccessCode: 204,
+						Responses:           responses,
+						Idempotency:         &definitions.Idempotency{Ttl: definitions.DefaultIdempotencyTtl},
+					},
+				},
+			}
+
+			err := generateControllerSpec(doc, &definitions.OpenAPIGeneratorConfig{}, def)
+			Expect(err).To(BeNil())
+
+			operation := doc.Paths.PathItems.GetOrZero("/payments").Post
+			Expect(operation.Parameters).To(HaveLen(1))
+			Expect(operation.Parameters[0].Name).To(Equal("Idempotency-Key"))
+			Expect(operation.Parameters[0].In).To(Equal("header"))
+			Expect(*operation.Parameters[0].Required).To(BeTrue())
+
+			Expect(operation.Responses.Codes.GetOrZero("400").Description).To(Equal("The request lacks the required 'Idempotency-Key' header"))
+			Expect(operation.Responses.Codes.GetOrZero("409").Description).To(
+				Equal("A request with the same idempotency key is still being processed"),
+			)
+			Expect(operation.Responses.Codes.GetOrZero("422").Description).To(
+				Equal("The idempotency key has already been used by a request with a different body"),
+			)
+		})
+
+		It("should return an error when a webhook has more than one operation with the same verb", func() {
+			def := definitions.ControllerMetadata{
+				Tag:    "test",
+				Routes: []definitions.RouteMetadata{webhookRoute("OrderCreated"), webhookRoute("OrderCreatedAgain")},
+			}
+
+			err := generateControllerSpec(newDoc(), &definitions.OpenAPIGeneratorConfig{}, def)
+			Expect(err).To(MatchError("webhook 'orderCreated' has more than one POST operation"))
+		})
+
+		It("should omit routes with custom HTTP verbs", func() {
+			doc := newDoc()
+			def := definitions.ControllerMetadata{
+				Tag: "test",
+				Routes: []definitions.RouteMetadata{
+					{
+						HttpVerb:            "OPTIONS",
+						OperationId:         "CacheOptions",
+						RestMetadata:        definitions.RestMetadata{Path: "/cache"},
+						ResponseSuccessCode: 204,
+						Responses:           responses,
+					},
+					{
+						HttpVerb:            "PURGE",
+						OperationId:         "PurgeCache",
+						RestMetadata:        definitions.RestMetadata{Path: "/cache"},
+						ResponseSuccessCode: 204,
+						Responses:           responses,
+					},
+				},
+			}
+
+			err := generateControllerSpec(doc, &definitions.OpenAPIGeneratorConfig{}, def)
+			Expect(err).To(BeNil())
+
+			pathItem := doc.Paths.PathItems.GetOrZero("/cache")
+			Expect(pathItem.Options.OperationId).To(Equal("CacheOptions"))
+			Expect(pathItem.GetOperations().Len()).To(Equal(1))
+		})
+	})
+})
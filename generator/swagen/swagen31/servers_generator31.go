@@ -0,0 +1,42 @@
+package swagen31
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/gopher-fleece/gleece/v2/definitions"
+	v3 "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+func toOpenApiServer(server definitions.OpenAPIServer) *v3.Server {
+	specServer := &v3.Server{
+		URL:         server.URL,
+		Description: server.Description,
+	}
+
+	if len(server.Variables) > 0 {
+		variables := orderedmap.New[string, *v3.ServerVariable]()
+		// Sort the variable names to keep the output deterministic
+		for _, name := range slices.Sorted(maps.Keys(server.Variables)) {
+			variable := server.Variables[name]
+			variables.Set(name, &v3.ServerVariable{
+				Enum:        variable.Enum,
+				Default:     variable.Default,
+				Description: variable.Description,
+			})
+		}
+		specServer.Variables = variables
+	}
+
+	return specServer
+}
+
+// GenerateServersSpec converts the given servers to their OpenAPI 3.1 representation
+func GenerateServersSpec(servers []definitions.OpenAPIServer) []*v3.Server {
+	specServers := []*v3.Server{}
+	for _, server := range servers {
+		specServers = append(specServers, toOpenApiServer(server))
+	}
+	return specServers
+}
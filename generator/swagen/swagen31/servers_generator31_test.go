@@ -0,0 +1,165 @@
+package swagen31
+
+import (
+	"encoding/json"
+
+	"github.com/gopher-fleece/gleece/v2/core/annotations"
+	"github.com/gopher-fleece/gleece/v2/core/metadata"
+	"github.com/gopher-fleece/gleece/v2/definitions"
+	"github.com/gopher-fleece/gleece/v2/generator/swagen/swagtool"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Servers v3.1 Generator", func() {
+	var config *definitions.OpenAPIGeneratorConfig
+
+	BeforeEach(func() {
+		config = &definitions.OpenAPIGeneratorConfig{
+			Info: definitions.OpenAPIInfo{
+				Title:   "Servers API",
+				Version: "1.0.0",
+			},
+			BaseURL: "http://localhost:8080",
+			Servers: []definitions.OpenAPIServer{
+				{
+					Name:        "regional",
+					URL:         "https://{region}.api.example.com/{version}",
+					Description: "Regional server",
+					Variables: map[string]definitions.OpenAPIServerVariable{
+						"version": {Default: "v1"},
+						"region": {
+							Enum:        []string{"eu", "us"},
+							Default:     "eu",
+							Description: "The deployment region",
+						},
+					},
+				},
+			},
+		}
+	})
+
+	generate := func(defs []definitions.ControllerMetadata) map[string]any {
+		structs := []definitions.StructMetadata{}
+		swagtool.AppendErrorSchema(&structs, true)
+
+		jsonBytes, err := GenerateSpec(config, defs, &definitions.Models{Structs: structs})
+		Expect(err).To(BeNil())
+
+		spec := map[string]any{}
+		Expect(json.Unmarshal(jsonBytes, &spec)).To(Succeed())
+		return spec
+	}
+
+	It("Emits the base URL followed by the configured servers at the root level", func() {
+		spec := generate([]definitions.ControllerMetadata{})
+
+		servers := spec["servers"].([]any)
+		Expect(servers).To(HaveLen(2))
+		Expect(servers[0]).To(Equal(map[string]any{"url": "http://localhost:8080"}))
+
+		regional := servers[1].(map[string]any)
+		Expect(regional["url"]).To(Equal("https://{region}.api.example.com/{version}"))
+		Expect(regional["description"]).To(Equal("Regional server"))
+		Expect(regional).ToNot(HaveKey("name"))
+
+		variables := regional["variables"].(map[string]any)
+		Expect(variables["region"]).To(Equal(map[string]any{
+			"enum":        []any{"eu", "us"},
+			"default":     "eu",
+			"description": "The deployment region",
+		}))
+		Expect(variables["version"]).To(Equal(map[string]any{"default": "v1"}))
+	})
+
+	It("Omits the base URL from the root servers when not configured", func() {
+		config.BaseURL = ""
+		spec := generate([]definitions.ControllerMetadata{})
+
+		servers := spec["servers"].([]any)
+		Expect(servers).To(HaveLen(1))
+		Expect(servers[0].(map[string]any)["url"]).To(Equal("https://{region}.api.example.com/{version}"))
+	})
+
+	It("Emits operation-level servers only for routes that specify them", func() {
+		spec := generate([]definitions.ControllerMetadata{{
+			Name:         "ServersController",
+			Tag:          "Servers",
+			RestMetadata: definitions.RestMetadata{Path: "/servers"},
+			Routes: []definitions.RouteMetadata{
+				{
+					OperationId:  "WithServers",
+					HttpVerb:     definitions.HttpGet,
+					RestMetadata: definitions.RestMetadata{Path: "/with"},
+					Servers: []definitions.OpenAPIServer{
+						{Name: "eu", URL: "https://eu.api.example.com", Description: "EU"},
+					},
+					ResponseSuccessCode: 204,
+					Responses: []definitions.FuncReturnValue{
+						{TypeMetadata: definitions.TypeMetadata{Name: "error"}},
+					},
+				},
+				{
+					OperationId:         "WithoutServers",
+					HttpVerb:            definitions.HttpGet,
+					RestMetadata:        definitions.RestMetadata{Path: "/without"},
+					ResponseSuccessCode: 204,
+					Responses: []definitions.FuncReturnValue{
+						{TypeMetadata: definitions.TypeMetadata{Name: "error"}},
+					},
+				},
+			},
+		}})
+
+		paths := spec["paths"].(map[string]any)
+		withServers := paths["/servers/with"].(map[string]any)["get"].(map[string]any)
+		Expect(withServers["servers"]).To(Equal([]any{
+			map[string]any{"url": "https://eu.api.example.com", "description": "EU"},
+		}))
+
+		withoutServers := paths["/servers/without"].(map[string]any)["get"].(map[string]any)
+		Expect(withoutServers).ToNot(HaveKey("servers"))
+	})
+
+	It("Appends the routes' base path to the root and operation-level servers", func() {
+		gleeceConfig := &definitions.GleeceConfig{
+			RoutesConfig:           definitions.RoutesConfig{BasePath: "/api"},
+			OpenAPIGeneratorConfig: *config,
+		}
+		holder := annotations.NewAnnotationHolderFromData(
+			[]annotations.Attribute{{Name: string(annotations.GleeceAnnotationServer), Value: "regional"}},
+			[]annotations.NonAttributeComment{},
+		)
+		routeServers, err := metadata.GetServers(&holder, gleeceConfig)
+		Expect(err).To(BeNil())
+
+		config = gleeceConfig.GetOpenAPIGeneratorConfig()
+		spec := generate([]definitions.ControllerMetadata{{
+			Name:         "ServersController",
+			Tag:          "Servers",
+			RestMetadata: definitions.RestMetadata{Path: "/servers"},
+			Routes: []definitions.RouteMetadata{
+				{
+					OperationId:         "WithServers",
+					HttpVerb:            definitions.HttpGet,
+					RestMetadata:        definitions.RestMetadata{Path: "/with"},
+					Servers:             routeServers,
+					ResponseSuccessCode: 204,
+					Responses: []definitions.FuncReturnValue{
+						{TypeMetadata: definitions.TypeMetadata{Name: "error"}},
+					},
+				},
+			},
+		}})
+
+		servers := spec["servers"].([]any)
+		Expect(servers[0]).To(Equal(map[string]any{"url": "http://localhost:8080/api"}))
+		Expect(servers[1].(map[string]any)["url"]).To(Equal("https://{region}.api.example.com/{version}/api"))
+
+		operation := spec["paths"].(map[string]any)["/servers/with"].(map[string]any)["get"].(map[string]any)
+		operationServers := operation["servers"].([]any)
+		Expect(operationServers).To(HaveLen(1))
+		Expect(operationServers[0].(map[string]any)["url"]).To(Equal("https://{region}.api.example.com/{version}/api"))
+	})
+})
@@ -17,11 +17,7 @@ func GenerateSpec(config *definitions.OpenAPIGeneratorConfig, defs []definitions
 	// Create an OpenAPI 3.1 document
 	doc := &v3.Document{
 		Version: "3.1.0",
-		Servers: []*v3.Server{
-			{
-				URL: config.BaseURL,
-			},
-		},
+		Servers: GenerateServersSpec(swagtool.GetRootServers(config)),
 		Info: &base.Info{
 			Title:          config.Info.Title,
 			Description:    config.Info.Description,
@@ -37,6 +33,13 @@ func GenerateSpec(config *definitions.OpenAPIGeneratorConfig, defs []definitions
 		},
 	}
 
+	extensions, err := GenerateExtensionsSpec(config.Extensions)
+	if err != nil {
+		logger.Error("Failed to generate root extensions v3.1 spec - %v", err)
+		return nil, err
+	}
+	doc.Extensions = extensions
+
 	if config.Info.License != nil {
 		doc.Info.License = &base.License{
 			Name: config.Info.License.Name,
@@ -58,6 +61,9 @@ func GenerateSpec(config *definitions.OpenAPIGeneratorConfig, defs []definitions
 	}
 	logger.Info("Security spec v3.1 generated successfully")
 
+	// Validation failures are reported using a dedicated RFC-7807 error which has to be documented alongside the models
+	swagtool.AppendValidationErrorSchema(&models.Structs, defs)
+
 	if err := GenerateModelsSpec(doc, models); err != nil {
 		logger.Error("Failed to generate models v3.1 spec - %v", err)
 		return nil, err
@@ -70,6 +76,8 @@ func GenerateSpec(config *definitions.OpenAPIGeneratorConfig, defs []definitions
 	}
 	logger.Info("Controllers spec v3.1 generated successfully")
 
+	doc.Tags = GenerateTagsSpec(swagtool.GetTopLevelTags(config, defs))
+
 	jsonData, err := doc.RenderJSON("    ")
 	if err != nil {
 		logger.Error("Error rendering v3.1 JSON:", err)
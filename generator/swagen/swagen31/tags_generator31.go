@@ -0,0 +1,31 @@
+package swagen31
+
+import (
+	"github.com/gopher-fleece/gleece/v2/definitions"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+// GenerateTagsSpec converts the given tags to their OpenAPI 3.1 representation
+func GenerateTagsSpec(tags []definitions.OpenAPITag) []*base.Tag {
+	if len(tags) <= 0 {
+		return nil
+	}
+
+	specTags := []*base.Tag{}
+	for _, tag := range tags {
+		specTag := &base.Tag{
+			Name:        tag.Name,
+			Description: tag.Description,
+		}
+
+		if tag.ExternalDocs != nil {
+			specTag.ExternalDocs = &base.ExternalDoc{
+				Description: tag.ExternalDocs.Description,
+				URL:         tag.ExternalDocs.URL,
+			}
+		}
+
+		specTags = append(specTags, specTag)
+	}
+	return specTags
+}
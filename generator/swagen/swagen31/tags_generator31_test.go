@@ -0,0 +1,98 @@
+package swagen31
+
+import (
+	"encoding/json"
+
+	"github.com/gopher-fleece/gleece/v2/definitions"
+	"github.com/gopher-fleece/gleece/v2/generator/swagen/swagtool"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Tags v3.1 Generator", func() {
+	generate := func(config *definitions.OpenAPIGeneratorConfig, defs []definitions.ControllerMetadata) map[string]any {
+		structs := []definitions.StructMetadata{}
+		swagtool.AppendErrorSchema(&structs, true)
+
+		jsonBytes, err := GenerateSpec(config, defs, &definitions.Models{Structs: structs})
+		Expect(err).To(BeNil())
+
+		spec := map[string]any{}
+		Expect(json.Unmarshal(jsonBytes, &spec)).To(Succeed())
+		return spec
+	}
+
+	route := func(operationId string, path string, tags ...definitions.OpenAPITag) definitions.RouteMetadata {
+		return definitions.RouteMetadata{
+			OperationId:         operationId,
+			HttpVerb:            definitions.HttpGet,
+			RestMetadata:        definitions.RestMetadata{Path: path},
+			Tags:                tags,
+			ResponseSuccessCode: 204,
+			Responses: []definitions.FuncReturnValue{
+				{TypeMetadata: definitions.TypeMetadata{Name: "error"}},
+			},
+		}
+	}
+
+	config := &definitions.OpenAPIGeneratorConfig{
+		Info:    definitions.OpenAPIInfo{Title: "Tags API", Version: "1.0.0"},
+		BaseURL: "http://localhost:8080",
+		Tags: []definitions.OpenAPITag{
+			{
+				Name:         "Users",
+				Description:  "User management",
+				ExternalDocs: &definitions.OpenAPIExternalDocs{URL: "https://docs.example.com/users"},
+			},
+		},
+	}
+
+	usersTag := definitions.OpenAPITag{Name: "Users"}
+	adminTag := definitions.OpenAPITag{Name: "Admin", Description: "Administrative operations"}
+
+	It("Emits multiple operation tags and a described top-level tags array", func() {
+		spec := generate(config, []definitions.ControllerMetadata{{
+			Name:         "UsersController",
+			Tag:          "Users",
+			Tags:         []definitions.OpenAPITag{usersTag},
+			RestMetadata: definitions.RestMetadata{Path: "/users"},
+			Routes: []definitions.RouteMetadata{
+				route("ListUsers", "/", usersTag),
+				route("PurgeUsers", "/purge", usersTag, adminTag),
+			},
+		}})
+
+		paths := spec["paths"].(map[string]any)
+		Expect(paths["/users/"].(map[string]any)["get"].(map[string]any)["tags"]).To(Equal([]any{"Users"}))
+		Expect(paths["/users/purge"].(map[string]any)["get"].(map[string]any)["tags"]).To(Equal([]any{"Users", "Admin"}))
+
+		Expect(spec["tags"]).To(Equal([]any{
+			map[string]any{
+				"name":         "Users",
+				"description":  "User management",
+				"externalDocs": map[string]any{"url": "https://docs.example.com/users"},
+			},
+			map[string]any{"name": "Admin", "description": "Administrative operations"},
+		}))
+	})
+
+	It("Omits the top-level tags array when no tags are configured or described", func() {
+		spec := generate(
+			&definitions.OpenAPIGeneratorConfig{
+				Info:    definitions.OpenAPIInfo{Title: "Tags API", Version: "1.0.0"},
+				BaseURL: "http://localhost:8080",
+			},
+			[]definitions.ControllerMetadata{{
+				Name:         "UsersController",
+				Tag:          "Users",
+				RestMetadata: definitions.RestMetadata{Path: "/users"},
+				Routes:       []definitions.RouteMetadata{route("ListUsers", "/")},
+			}},
+		)
+
+		Expect(spec).ToNot(HaveKey("tags"))
+		paths := spec["paths"].(map[string]any)
+		Expect(paths["/users/"].(map[string]any)["get"].(map[string]any)["tags"]).To(Equal([]any{"Users"}))
+	})
+})
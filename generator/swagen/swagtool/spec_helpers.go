@@ -1,9 +1,12 @@
 package swagtool
 
 import (
+	"maps"
+	"slices"
 	"strings"
 
 	"github.com/gopher-fleece/gleece/v2/definitions"
+	"github.com/gopher-fleece/runtime"
 )
 
 func AppendErrorSchema(models *[]definitions.StructMetadata, hasAnyErrorTypes bool) {
@@ -13,49 +16,254 @@ func AppendErrorSchema(models *[]definitions.StructMetadata, hasAnyErrorTypes bo
 	(*models) = append((*models), definitions.StructMetadata{
 		Name:        definitions.Rfc7807ErrorName,
 		Description: "A standard RFC-7807 error",
-		Fields: []definitions.FieldMetadata{
-			{
-				Name:        "type",
-				Type:        "string",
-				Description: "A URI reference that identifies the problem type.",
-				Tag:         `validate:"required"`,
-			},
-			{
-				Name:        "title",
-				Type:        "string",
-				Description: "A short, human-readable summary of the problem type.",
-				Tag:         `validate:"required"`,
+		Fields:      getRfc7807Fields(),
+	})
+}
+
+// AppendValidationErrorSchema adds the schemas of the RFC-7807 error returned upon request validation failures.
+//
+// The schemas are only added if any of the given controllers' routes accepts input
+func AppendValidationErrorSchema(models *[]definitions.StructMetadata, defs []definitions.ControllerMetadata) {
+	if slices.ContainsFunc(*models, func(model definitions.StructMetadata) bool { return model.Name == definitions.ValidationErrorName }) {
+		return
+	}
+
+	hasValidatedInput := slices.ContainsFunc(defs, func(def definitions.ControllerMetadata) bool {
+		return slices.ContainsFunc(def.Routes, HasValidatedInput)
+	})
+	if !hasValidatedInput {
+		return
+	}
+
+	(*models) = append((*models),
+		definitions.StructMetadata{
+			Name:        definitions.ValidationIssueName,
+			Description: "A single failed validation of a request's input",
+			Fields: []definitions.FieldMetadata{
+				{
+					Name:        "in",
+					Type:        "string",
+					Description: "The location of the offending input - body, query, path, header or form.",
+					Tag:         `validate:"required"`,
+				},
+				{
+					Name:        "pointer",
+					Type:        "string",
+					Description: "A JSON pointer to the offending value, relative to its location.",
+					Tag:         `validate:"required"`,
+				},
+				{
+					Name:        "rule",
+					Type:        "string",
+					Description: "The validation rule that failed.",
+					Tag:         `validate:"required"`,
+				},
+				{
+					Name:        "param",
+					Type:        "string",
+					Description: "The failed rule's parameter, if any.",
+				},
+				{
+					Name:        "message",
+					Type:        "string",
+					Description: "A human-readable description of the failure.",
+					Tag:         `validate:"required"`,
+				},
 			},
-			{
-				Name:        "status",
-				Type:        "int",
-				Description: "The HTTP status code generated by the origin server for this occurrence of the problem.",
+		},
+		definitions.StructMetadata{
+			Name:        definitions.ValidationErrorName,
+			Description: "A standard RFC-7807 error detailing the failed validations of a request",
+			Fields: append(getRfc7807Fields(), definitions.FieldMetadata{
+				Name:        "errors",
+				Type:        "[]" + definitions.ValidationIssueName,
+				Description: "The failed validations.",
 				Tag:         `validate:"required"`,
-			},
-			{
-				Name:        "detail",
-				Type:        "string",
-				Description: "A human-readable explanation specific to this occurrence of the problem.",
-			},
-			{
-				Name:        "instance",
-				Type:        "string",
-				Description: "A URI reference that identifies the specific occurrence of the problem.",
-			},
-			{
-				Name:        "error",
-				Type:        "string",
-				Description: "Error message",
-			},
-			{
-				Name:        "extensions",
-				Type:        "map[string]any",
-				Description: "Additional metadata about the error.",
-			},
+			}),
+		},
+	)
+}
+
+func getRfc7807Fields() []definitions.FieldMetadata {
+	return []definitions.FieldMetadata{
+		{
+			Name:        "type",
+			Type:        "string",
+			Description: "A URI reference that identifies the problem type.",
+			Tag:         `validate:"required"`,
 		},
+		{
+			Name:        "title",
+			Type:        "string",
+			Description: "A short, human-readable summary of the problem type.",
+			Tag:         `validate:"required"`,
+		},
+		{
+			Name:        "status",
+			Type:        "int",
+			Description: "The HTTP status code generated by the origin server for this occurrence of the problem.",
+			Tag:         `validate:"required"`,
+		},
+		{
+			Name:        "detail",
+			Type:        "string",
+			Description: "A human-readable explanation specific to this occurrence of the problem.",
+		},
+		{
+			Name:        "instance",
+			Type:        "string",
+			Description: "A URI reference that identifies the specific occurrence of the problem.",
+		},
+		{
+			Name:        "error",
+			Type:        "string",
+			Description: "Error message",
+		},
+		{
+			Name:        "extensions",
+			Type:        "map[string]any",
+			Description: "Additional metadata about the error.",
+		},
+	}
+}
+
+// HasValidatedInput returns a boolean indicating whether the given route accepts any input that is validated upon request
+func HasValidatedInput(route definitions.RouteMetadata) bool {
+	if route.IsWebhook() {
+		// Webhook payloads are validated by their consumers
+		return false
+	}
+
+	return slices.ContainsFunc(route.FuncParams, func(param definitions.FuncParam) bool {
+		// The context and principal are not part of the request's input
+		return !param.IsContext && param.PassedIn != definitions.PassedInPrincipal
+	})
+}
+
+// GetValidationErrorDescription returns the description of the given route's validation error (422) response.
+//
+// An explicit @ErrorResponse(422) description takes precedence over the default one
+func GetValidationErrorDescription(route definitions.RouteMetadata) string {
+	for _, errResp := range route.ErrorResponses {
+		if errResp.HttpStatusCode == runtime.StatusUnprocessableEntity && errResp.Description != "" {
+			return errResp.Description
+		}
+	}
+	return "The request did not pass validation"
+}
+
+// The name of the vendor extension describing a rate limited operation's limit
+const RateLimitExtensionName = "x-ratelimit"
+
+// The name of the header carrying the number of seconds after which a rate limited request may be retried
+const RetryAfterHeaderName = "Retry-After"
+
+// The description of the 'Retry-After' header of rate limit (429) responses
+const RetryAfterHeaderDescription = "The number of seconds after which the request may be retried"
+
+// HasRateLimitedRoutes returns a boolean indicating whether any of the given controllers' routes is rate limited
+func HasRateLimitedRoutes(defs []definitions.ControllerMetadata) bool {
+	return slices.ContainsFunc(defs, func(def definitions.ControllerMetadata) bool {
+		return slices.ContainsFunc(def.Routes, func(route definitions.RouteMetadata) bool {
+			return route.RateLimit != nil && !route.IsWebhook()
+		})
+	})
+}
+
+// GetOperationExtensions returns the given route's vendor extensions, along with an 'x-ratelimit' extension describing its rate limit, if any.
+//
+// An explicit 'x-ratelimit' @Extension takes precedence
+func GetOperationExtensions(route definitions.RouteMetadata) map[string]any {
+	if route.RateLimit == nil || route.IsWebhook() {
+		return route.Extensions
+	}
+
+	extensions := map[string]any{
+		RateLimitExtensionName: map[string]any{
+			"requests": route.RateLimit.Requests,
+			"per":      route.RateLimit.Per,
+			"key":      route.RateLimit.Key,
+		},
+	}
+	maps.Copy(extensions, route.Extensions)
+	return extensions
+}
+
+// GetRateLimitErrorDescription returns the description of the given route's rate limit (429) response.
+//
+// An explicit @ErrorResponse(429) description takes precedence over the default one
+func GetRateLimitErrorDescription(route definitions.RouteMetadata) string {
+	return getErrorResponseDescription(route, runtime.StatusTooManyRequests, "The request exceeded the operation's rate limit")
+}
+
+// The description of the 'Idempotency-Key' request header of idempotent operations
+const IdempotencyKeyHeaderDescription = "A unique key identifying the request. " +
+	"Repeated requests with the same key are answered with the original request's response"
+
+// HasIdempotentRoutes returns a boolean indicating whether any of the given controllers' routes is idempotent
+func HasIdempotentRoutes(defs []definitions.ControllerMetadata) bool {
+	return slices.ContainsFunc(defs, func(def definitions.ControllerMetadata) bool {
+		return slices.ContainsFunc(def.Routes, func(route definitions.RouteMetadata) bool {
+			return route.Idempotency != nil && !route.IsWebhook()
+		})
 	})
 }
 
+// GetOperationParams returns the given route's parameters along with, for idempotent routes, the required 'Idempotency-Key' header
+func GetOperationParams(route definitions.RouteMetadata) []definitions.FuncParam {
+	if route.Idempotency == nil || route.IsWebhook() {
+		return route.FuncParams
+	}
+
+	idempotencyKeyParam := definitions.FuncParam{
+		ParamMeta: definitions.ParamMeta{
+			Name:     "idempotencyKey",
+			TypeMeta: definitions.TypeMetadata{Name: "string", IsUniverseType: true},
+		},
+		PassedIn:     definitions.PassedInHeader,
+		NameInSchema: definitions.IdempotencyKeyHeaderName,
+		Description:  IdempotencyKeyHeaderDescription,
+		Validator:    "required",
+	}
+	return append(slices.Clone(route.FuncParams), idempotencyKeyParam)
+}
+
+// GetIdempotencyKeyMissingErrorDescription returns the description of the given idempotent route's 400 response.
+//
+// An explicit @ErrorResponse(400) description takes precedence over the default one
+func GetIdempotencyKeyMissingErrorDescription(route definitions.RouteMetadata) string {
+	return getErrorResponseDescription(route, runtime.StatusBadRequest, "The request lacks the required 'Idempotency-Key' header")
+}
+
+// GetIdempotencyConflictErrorDescription returns the description of the given idempotent route's 409 response.
+//
+// An explicit @ErrorResponse(409) description takes precedence over the default one
+func GetIdempotencyConflictErrorDescription(route definitions.RouteMetadata) string {
+	return getErrorResponseDescription(route, runtime.StatusConflict, "A request with the same idempotency key is still being processed")
+}
+
+// GetIdempotencyKeyReusedErrorDescription returns the description of the given idempotent route's 422 response.
+//
+// An explicit @ErrorResponse(422) description takes precedence over the default one
+func GetIdempotencyKeyReusedErrorDescription(route definitions.RouteMetadata) string {
+	return getErrorResponseDescription(
+		route,
+		runtime.StatusUnprocessableEntity,
+		"The idempotency key has already been used by a request with a different body",
+	)
+}
+
+// getErrorResponseDescription returns the description of the given route's explicit @ErrorResponse with the given status code, if any,
+// or the given default description otherwise
+func getErrorResponseDescription(route definitions.RouteMetadata, statusCode runtime.HttpStatusCode, defaultDescription string) string {
+	for _, errResp := range route.ErrorResponses {
+		if errResp.HttpStatusCode == statusCode && errResp.Description != "" {
+			return errResp.Description
+		}
+	}
+	return defaultDescription
+}
+
 func IsPrimitiveType(typeName string) bool {
 	switch typeName {
 	case "string", "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64", "bool", "float32", "float64":
@@ -99,6 +307,18 @@ func IsSecurityNameInSecuritySchemes(securitySchemes []definitions.SecuritySchem
 	return false
 }
 
+// MergeSecurityScopes returns the union of the given scopes, in order of appearance.
+// Used when a single security requirement references the same schema more than once
+func MergeSecurityScopes(scopes []string, additionalScopes []string) []string {
+	merged := slices.Clone(scopes)
+	for _, scope := range additionalScopes {
+		if !slices.Contains(merged, scope) {
+			merged = append(merged, scope)
+		}
+	}
+	return merged
+}
+
 func IsHiddenAsset(hideOptions *definitions.MethodHideOptions) bool {
 	if hideOptions == nil {
 		return false
@@ -206,3 +426,95 @@ func GetMapItemType(fieldType string) string {
 	}
 	return parts[1]
 }
+
+// GetRootServers returns the servers to emit at the specification's root level.
+//
+// The BaseURL, if set, is always the first server, followed by any explicitly configured servers
+func GetRootServers(config *definitions.OpenAPIGeneratorConfig) []definitions.OpenAPIServer {
+	servers := []definitions.OpenAPIServer{}
+	if config.BaseURL != "" {
+		servers = append(servers, definitions.OpenAPIServer{URL: config.BaseURL})
+	}
+
+	return append(servers, config.Servers...)
+}
+
+// GetOperationTags returns the names of the tags to attach to the given route's operation.
+//
+// Routes with explicit (or inherited) tags use those, otherwise, the controller's primary tag is used
+func GetOperationTags(def definitions.ControllerMetadata, route definitions.RouteMetadata) []string {
+	if len(route.Tags) <= 0 {
+		return []string{def.Tag}
+	}
+
+	tags := []string{}
+	for _, tag := range route.Tags {
+		tags = append(tags, tag.Name)
+	}
+	return tags
+}
+
+// GetTopLevelTags returns the tags to emit at the specification's root level.
+//
+// Configured tags come first, in their configured order, followed by any other tag that has
+// a description or external docs in its annotations, sorted by name.
+// Configured descriptions and external docs take precedence over annotated ones.
+func GetTopLevelTags(config *definitions.OpenAPIGeneratorConfig, defs []definitions.ControllerMetadata) []definitions.OpenAPITag {
+	tags := append([]definitions.OpenAPITag{}, config.Tags...)
+	annotatedTags := []definitions.OpenAPITag{}
+
+	addTag := func(tag definitions.OpenAPITag) {
+		configuredIndex := slices.IndexFunc(tags, func(t definitions.OpenAPITag) bool { return t.Name == tag.Name })
+		if configuredIndex >= 0 {
+			// Fill in any blanks left by the configuration
+			if tags[configuredIndex].Description == "" {
+				tags[configuredIndex].Description = tag.Description
+			}
+			if tags[configuredIndex].ExternalDocs == nil {
+				tags[configuredIndex].ExternalDocs = tag.ExternalDocs
+			}
+			return
+		}
+
+		if tag.Description == "" && tag.ExternalDocs == nil {
+			// Nothing to describe
+			return
+		}
+
+		if !slices.ContainsFunc(annotatedTags, func(t definitions.OpenAPITag) bool { return t.Name == tag.Name }) {
+			annotatedTags = append(annotatedTags, tag)
+		}
+	}
+
+	for _, def := range defs {
+		for _, tag := range def.Tags {
+			addTag(tag)
+		}
+		for _, route := range def.Routes {
+			if IsHiddenAsset(&route.Hiding) {
+				continue
+			}
+			for _, tag := range route.Tags {
+				addTag(tag)
+			}
+		}
+	}
+
+	slices.SortFunc(annotatedTags, func(a, b definitions.OpenAPITag) int { return strings.Compare(a.Name, b.Name) })
+	return append(tags, annotatedTags...)
+}
+
+// IsHeaderInResponse returns a boolean indicating whether the given response header is carried by the response with the given status code
+func IsHeaderInResponse(header definitions.ResponseHeader, statusCode string) bool {
+	if statusCode == "default" {
+		return false
+	}
+
+	if len(header.StatusCodes) <= 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(header.StatusCodes, func(code runtime.HttpStatusCode) bool {
+		return HttpStatusCodeToString(code) == statusCode
+	})
+}
@@ -20,6 +20,55 @@ var _ = Describe("Spec Helpers", func() {
 		Expect(len(models)).To(Equal(0))
 	})
 
+	It("Should merge security scopes without duplicates, in order of appearance", func() {
+		Expect(MergeSecurityScopes([]string{"read", "write"}, []string{"write", "admin"})).To(Equal([]string{"read", "write", "admin"}))
+		Expect(MergeSecurityScopes([]string{}, []string{})).To(BeEmpty())
+	})
+
+	Describe("Validation errors", func() {
+		withInput := definitions.RouteMetadata{
+			FuncParams: []definitions.FuncParam{{PassedIn: definitions.PassedInQuery}},
+		}
+		contextOnly := definitions.RouteMetadata{
+			FuncParams: []definitions.FuncParam{{ParamMeta: definitions.ParamMeta{IsContext: true}}},
+		}
+
+		It("should consider only routes with non-context, non-principal input as validated", func() {
+			Expect(HasValidatedInput(withInput)).To(BeTrue())
+			Expect(HasValidatedInput(contextOnly)).To(BeFalse())
+
+			principalOnly := definitions.RouteMetadata{
+				FuncParams: []definitions.FuncParam{{PassedIn: definitions.PassedInPrincipal}},
+			}
+			Expect(HasValidatedInput(principalOnly)).To(BeFalse())
+
+			webhook := withInput
+			webhook.Webhook = &definitions.WebhookMetadata{Name: "orderCreated"}
+			Expect(HasValidatedInput(webhook)).To(BeFalse())
+		})
+
+		It("should add the validation error schemas only once and only when input is validated", func() {
+			models := []definitions.StructMetadata{}
+			AppendValidationErrorSchema(&models, []definitions.ControllerMetadata{{Routes: []definitions.RouteMetadata{contextOnly}}})
+			Expect(models).To(BeEmpty())
+
+			defs := []definitions.ControllerMetadata{{Routes: []definitions.RouteMetadata{withInput}}}
+			AppendValidationErrorSchema(&models, defs)
+			AppendValidationErrorSchema(&models, defs)
+			Expect(models).To(HaveLen(2))
+			Expect(models[0].Name).To(Equal(definitions.ValidationIssueName))
+			Expect(models[1].Name).To(Equal(definitions.ValidationErrorName))
+			Expect(models[1].Fields[len(models[1].Fields)-1].Type).To(Equal("[]" + definitions.ValidationIssueName))
+		})
+
+		It("should prefer an explicit 422 description over the default one", func() {
+			Expect(GetValidationErrorDescription(withInput)).To(Equal("The request did not pass validation"))
+
+			withInput.ErrorResponses = []definitions.ErrorResponse{{HttpStatusCode: 422, Description: "Bad input"}}
+			Expect(GetValidationErrorDescription(withInput)).To(Equal("Bad input"))
+		})
+	})
+
 	Describe("IsPrimitiveType", func() {
 		It("should return true for primitive types", func() {
 			Expect(IsPrimitiveType("string")).To(BeTrue())
@@ -389,4 +438,75 @@ var _ = Describe("Spec Helpers", func() {
 			Expect(HasEmbeddedField(fields)).To(BeTrue())
 		})
 	})
+
+	Describe("GetRootServers", func() {
+		It("should emit the base URL before any configured servers", func() {
+			servers := GetRootServers(&definitions.OpenAPIGeneratorConfig{
+				BaseURL: "http://localhost:8080",
+				Servers: []definitions.OpenAPIServer{{Name: "staging", URL: "https://staging.example.com"}},
+			})
+			Expect(servers).To(Equal([]definitions.OpenAPIServer{
+				{URL: "http://localhost:8080"},
+				{Name: "staging", URL: "https://staging.example.com"},
+			}))
+		})
+
+		It("should omit an empty base URL", func() {
+			servers := GetRootServers(&definitions.OpenAPIGeneratorConfig{
+				Servers: []definitions.OpenAPIServer{{Name: "staging", URL: "https://staging.example.com"}},
+			})
+			Expect(servers).To(HaveLen(1))
+		})
+	})
+
+	Describe("GetOperationTags", func() {
+		It("should fall back to the controller's primary tag", func() {
+			def := definitions.ControllerMetadata{Tag: "Controller"}
+			Expect(GetOperationTags(def, definitions.RouteMetadata{})).To(Equal([]string{"Controller"}))
+		})
+
+		It("should use the route's tags when present", func() {
+			def := definitions.ControllerMetadata{Tag: "Controller"}
+			route := definitions.RouteMetadata{Tags: []definitions.OpenAPITag{{Name: "A"}, {Name: "B"}}}
+			Expect(GetOperationTags(def, route)).To(Equal([]string{"A", "B"}))
+		})
+	})
+
+	Describe("GetTopLevelTags", func() {
+		It("should return nothing when no tags are configured or described", func() {
+			defs := []definitions.ControllerMetadata{{
+				Tag:    "Plain",
+				Tags:   []definitions.OpenAPITag{{Name: "Plain"}},
+				Routes: []definitions.RouteMetadata{{Tags: []definitions.OpenAPITag{{Name: "Plain"}}}},
+			}}
+			Expect(GetTopLevelTags(&definitions.OpenAPIGeneratorConfig{}, defs)).To(BeEmpty())
+		})
+
+		It("should keep configured order and append described tags sorted by name", func() {
+			config := &definitions.OpenAPIGeneratorConfig{
+				Tags: []definitions.OpenAPITag{{Name: "Zeta"}, {Name: "Alpha", Description: "Configured"}},
+			}
+			defs := []definitions.ControllerMetadata{{
+				Tags: []definitions.OpenAPITag{
+					{Name: "Zeta", Description: "Annotated Zeta"},
+					{Name: "Alpha", Description: "Annotated Alpha"},
+					{Name: "Omega", Description: "Annotated Omega"},
+				},
+				Routes: []definitions.RouteMetadata{
+					{Tags: []definitions.OpenAPITag{{Name: "Beta", Description: "Route tag"}}},
+					{
+						Tags:   []definitions.OpenAPITag{{Name: "Hidden", Description: "Hidden route tag"}},
+						Hiding: definitions.MethodHideOptions{Type: definitions.HideMethodAlways},
+					},
+				},
+			}}
+
+			Expect(GetTopLevelTags(config, defs)).To(Equal([]definitions.OpenAPITag{
+				{Name: "Zeta", Description: "Annotated Zeta"},
+				{Name: "Alpha", Description: "Configured"},
+				{Name: "Beta", Description: "Route tag"},
+				{Name: "Omega", Description: "Annotated Omega"},
+			}))
+		})
+	})
 })
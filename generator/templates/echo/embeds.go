@@ -9,6 +9,9 @@ import (
 //go:embed routes.hbs
 var RoutesTemplate string
 
+//go:embed controller.routes.file.hbs
+var ControllerRoutesFileTemplate string
+
 //////////////////
 //   Partials   //
 //////////////////
@@ -61,6 +64,30 @@ var RegisterMiddleware string
 //go:embed partials/method.parameter.list.hbs
 var MethodParameterList string
 
+//go:embed partials/controller.routes.hbs
+var ControllerRoutes string
+
+//go:embed partials/telemetry.hbs
+var Telemetry string
+
+//go:embed partials/panic.recovery.hbs
+var PanicRecovery string
+
+//go:embed partials/security.handlers.hbs
+var SecurityHandlers string
+
+//go:embed partials/versioning.hbs
+var Versioning string
+
+//go:embed partials/rate.limiting.hbs
+var RateLimiting string
+
+//go:embed partials/request.limits.hbs
+var RequestLimits string
+
+//go:embed partials/idempotency.hbs
+var Idempotency string
+
 // Those are the extension that made to allow *extend* Gleece's routes logic. as default they are all empty.
 var TemplateExtensions = map[string]string{
 	// On routes.hbs
@@ -99,4 +126,12 @@ var Partials = map[string]string{
 	"Middleware":                      Middleware,
 	"RegisterMiddleware":              RegisterMiddleware,
 	"MethodParameterList":             MethodParameterList,
+	"ControllerRoutes":                ControllerRoutes,
+	"Telemetry":                       Telemetry,
+	"PanicRecovery":                   PanicRecovery,
+	"SecurityHandlers":                SecurityHandlers,
+	"Versioning":                      Versioning,
+	"RateLimiting":                    RateLimiting,
+	"RequestLimits":                   RequestLimits,
+	"Idempotency":                     Idempotency,
 }
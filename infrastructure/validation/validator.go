@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 	"regexp"
+	"slices"
 	"strings"
 	"unicode"
 
@@ -66,6 +67,34 @@ func validateRegex(fl validator.FieldLevel) bool {
 	return re.MatchString(value)
 }
 
+// Matches the variables of a server's URL template, e.g. '{region}' in 'https://{region}.api.example.com'
+var serverUrlVariableRegex = regexp.MustCompile(`\{([^{}]+)\}`)
+
+// Custom validation function to check that every variable in a server's URL template is declared in the server's variables
+func validateServerUrlVariables(fl validator.FieldLevel) bool {
+	server, isServer := reflect.Indirect(fl.Parent()).Interface().(definitions.OpenAPIServer)
+	if !isServer {
+		return false
+	}
+
+	for _, match := range serverUrlVariableRegex.FindAllStringSubmatch(fl.Field().String(), -1) {
+		if _, declared := server.Variables[match[1]]; !declared {
+			return false
+		}
+	}
+	return true
+}
+
+// Custom validation function to check that a server variable's default value is one of its enum values, if any
+func validateServerVariableDefault(fl validator.FieldLevel) bool {
+	variable, isVariable := reflect.Indirect(fl.Parent()).Interface().(definitions.OpenAPIServerVariable)
+	if !isVariable {
+		return false
+	}
+
+	return len(variable.Enum) == 0 || slices.Contains(variable.Enum, variable.Default)
+}
+
 func initValidator() {
 	// Initialize the validator instance
 	validatorInstance = validator.New()
@@ -74,6 +103,8 @@ func initValidator() {
 	validatorInstance.RegisterValidation("not_nil_array", validateNotNilSlice)
 	validatorInstance.RegisterValidation("starts_with_letter", validateStartsWithLetter)
 	validatorInstance.RegisterValidation("regex", validateRegex)
+	validatorInstance.RegisterValidation("server_url_variables", validateServerUrlVariables)
+	validatorInstance.RegisterValidation("server_variable_default", validateServerVariableDefault)
 
 	// Register enum validation functions
 
@@ -62,6 +62,54 @@ var _ = Describe("Validation Utilities", func() {
 		})
 	})
 
+	Describe("Server validation", func() {
+		It("should accept a server whose URL variables are all declared", func() {
+			server := definitions.OpenAPIServer{
+				URL: "https://{region}.api.example.com/{version}",
+				Variables: map[string]definitions.OpenAPIServerVariable{
+					"region":  {Enum: []string{"eu", "us"}, Default: "eu"},
+					"version": {Default: "v1"},
+				},
+			}
+
+			Expect(ValidateStruct(server)).To(Succeed())
+		})
+
+		It("should reject a server whose URL uses an undeclared variable", func() {
+			server := definitions.OpenAPIServer{
+				URL: "https://{region}.api.example.com/{version}",
+				Variables: map[string]definitions.OpenAPIServerVariable{
+					"region": {Default: "eu"},
+				},
+			}
+
+			err := ValidateStruct(server)
+			Expect(err).To(HaveOccurred())
+
+			validationErrors := err.(validator.ValidationErrors)
+			Expect(validationErrors).To(HaveLen(1))
+			Expect(validationErrors[0].Field()).To(Equal("URL"))
+			Expect(validationErrors[0].Tag()).To(Equal("server_url_variables"))
+		})
+
+		It("should reject a server variable whose default is not one of its enum values", func() {
+			server := definitions.OpenAPIServer{
+				URL: "https://{region}.api.example.com",
+				Variables: map[string]definitions.OpenAPIServerVariable{
+					"region": {Enum: []string{"eu", "us"}, Default: "ap"},
+				},
+			}
+
+			err := ValidateStruct(server)
+			Expect(err).To(HaveOccurred())
+
+			validationErrors := err.(validator.ValidationErrors)
+			Expect(validationErrors).To(HaveLen(1))
+			Expect(validationErrors[0].Field()).To(Equal("Default"))
+			Expect(validationErrors[0].Tag()).To(Equal("server_variable_default"))
+		})
+	})
+
 	// The implementation of this function is located in external/validation.logic.go
 	// Since it's also been used in the generated routes and need to be exposed to package apps consumer's
 	Describe("ExtractValidationErrorMessage", func() {
@@ -54,6 +54,14 @@ var _ = Describe("Unit Tests - Annotation Holder", func() {
 				Expect(holder.GetFirstValueOrEmpty(annotations.GleeceAnnotationMethod)).To(Equal("POST"))
 			})
 
+			It("Parses comma separated values", func() {
+				comments := []string{"// @Version(1, 2)"}
+				nodes := utils.CommentsToCommentBlock(comments, 1)
+				holder, err := annotations.NewAnnotationHolder(nodes, annotations.CommentSourceRoute)
+				Expect(err).To(BeNil())
+				Expect(holder.GetFirstValueOrEmpty(annotations.GleeceAnnotationVersion)).To(Equal("1, 2"))
+			})
+
 			It("GetFirstValueOrEmpty returns empty string when attribute does not exist", func() {
 				comments := []string{"// @Route(/route)"}
 				nodes := utils.CommentsToCommentBlock(comments, 1)
@@ -109,6 +117,24 @@ var _ = Describe("Unit Tests - Annotation Holder", func() {
 				Expect(*value).To(HaveExactElements("read:users", "write:users"))
 			})
 
+			It("Parses a properties object given without a value", func() {
+				comments := []string{`// @RateLimit({ requests: 100, per: "1m", key: "header:X-Api-Key" }) Abcd`}
+				nodes := utils.CommentsToCommentBlock(comments, 1)
+				holder, err := annotations.NewAnnotationHolder(nodes, annotations.CommentSourceRoute)
+				Expect(err).To(BeNil())
+
+				attrib := holder.GetFirst(annotations.GleeceAnnotationRateLimit)
+				Expect(attrib).ToNot(BeNil())
+				Expect(attrib.Value).To(BeEmpty())
+				Expect(attrib.Description).To(Equal("Abcd"))
+				Expect(attrib.Properties).To(HaveLen(3))
+
+				per, err := annotations.GetCastProperty[string](attrib, annotations.PropertyPer)
+				Expect(err).To(BeNil())
+				Expect(*per).To(Equal("1m"))
+				Expect(attrib.PropertiesRange.StartCol).To(BeNumerically(">", 0))
+			})
+
 			It("Returns nil if property does not exist", func() {
 				comments := []string{`// @Security(securitySchemaName, { scopes: ["read:users", "write:users"] }) Abcd`}
 				nodes := utils.CommentsToCommentBlock(comments, 1)
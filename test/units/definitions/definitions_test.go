@@ -151,6 +151,113 @@ var _ = Describe("Unit Tests - Definitions", func() {
 			})
 		})
 	})
+
+	var _ = Describe("GleeceConfig GetOpenAPIGeneratorConfig", func() {
+		It("Returns the OpenAPI configuration as-is when no base path is configured", func() {
+			config := definitions.GleeceConfig{
+				OpenAPIGeneratorConfig: definitions.OpenAPIGeneratorConfig{BaseURL: "https://example.com"},
+			}
+			Expect(config.GetOpenAPIGeneratorConfig().BaseURL).To(Equal("https://example.com"))
+		})
+
+		It("Appends the base path to the base URL and all root servers", func() {
+			config := definitions.GleeceConfig{
+				RoutesConfig: definitions.RoutesConfig{BasePath: "/api/"},
+				OpenAPIGeneratorConfig: definitions.OpenAPIGeneratorConfig{
+					BaseURL: "https://example.com/",
+					Servers: []definitions.OpenAPIServer{
+						{URL: "https://staging.example.com", Description: "Staging"},
+					},
+				},
+			}
+
+			specConfig := config.GetOpenAPIGeneratorConfig()
+			Expect(specConfig.BaseURL).To(Equal("https://example.com/api"))
+			Expect(specConfig.Servers).To(Equal([]definitions.OpenAPIServer{
+				{URL: "https://staging.example.com/api", Description: "Staging"},
+			}))
+		})
+
+		It("Does not modify the original configuration", func() {
+			config := definitions.GleeceConfig{
+				RoutesConfig: definitions.RoutesConfig{BasePath: "/api"},
+				OpenAPIGeneratorConfig: definitions.OpenAPIGeneratorConfig{
+					Servers: []definitions.OpenAPIServer{{URL: "https://example.com"}},
+				},
+			}
+
+			config.GetOpenAPIGeneratorConfig()
+			Expect(config.OpenAPIGeneratorConfig.Servers[0].URL).To(Equal("https://example.com"))
+		})
+	})
+
+	Describe("GleeceConfig GetVersioningConfig", func() {
+		It("Returns nil when versioning is not configured", func() {
+			config := definitions.GleeceConfig{}
+			Expect(config.GetVersioningConfig()).To(BeNil())
+		})
+
+		It("Sorts the versions and applies defaults to omitted settings", func() {
+			config := definitions.GleeceConfig{
+				Versioning: &definitions.VersioningConfig{Versions: []int{2, 3, 1}},
+			}
+
+			Expect(config.GetVersioningConfig()).To(Equal(&definitions.VersioningConfig{
+				Versions:           []int{1, 2, 3},
+				Strategy:           definitions.VersioningStrategyPath,
+				HeaderName:         "X-API-Version",
+				MediaTypeParameter: "version",
+				DefaultVersion:     3,
+			}))
+			Expect(config.Versioning.Versions).To(Equal([]int{2, 3, 1}))
+		})
+
+		It("Keeps explicitly configured settings", func() {
+			config := definitions.GleeceConfig{
+				Versioning: &definitions.VersioningConfig{
+					Versions:       []int{1, 2},
+					Strategy:       definitions.VersioningStrategyHeader,
+					HeaderName:     "Api-Version",
+					DefaultVersion: 1,
+				},
+			}
+
+			versioning := config.GetVersioningConfig()
+			Expect(versioning.Strategy).To(Equal(definitions.VersioningStrategyHeader))
+			Expect(versioning.HeaderName).To(Equal("Api-Version"))
+			Expect(versioning.DefaultVersion).To(Equal(1))
+		})
+	})
+
+	Describe("RouteMetadata IsServedInVersion", func() {
+		It("Returns true for unversioned routes", func() {
+			Expect(definitions.RouteMetadata{}.IsServedInVersion(5)).To(BeTrue())
+		})
+
+		It("Returns whether the version is one of a versioned route's versions", func() {
+			route := definitions.RouteMetadata{Versions: []int{1, 2}}
+			Expect(route.IsServedInVersion(2)).To(BeTrue())
+			Expect(route.IsServedInVersion(3)).To(BeFalse())
+		})
+	})
+
+	Describe("IsCustomHttpVerb", func() {
+		It("Returns true for non-standard, upper-case verbs", func() {
+			Expect(definitions.IsCustomHttpVerb("PURGE")).To(BeTrue())
+			Expect(definitions.IsCustomHttpVerb("VERSION-CONTROL")).To(BeTrue())
+		})
+
+		It("Returns false for standard verbs", func() {
+			Expect(definitions.IsCustomHttpVerb("GET")).To(BeFalse())
+			Expect(definitions.IsCustomHttpVerb("HEAD")).To(BeFalse())
+		})
+
+		It("Returns false for malformed verbs", func() {
+			Expect(definitions.IsCustomHttpVerb("purge")).To(BeFalse())
+			Expect(definitions.IsCustomHttpVerb("INVALID_METHOD")).To(BeFalse())
+			Expect(definitions.IsCustomHttpVerb("")).To(BeFalse())
+		})
+	})
 })
 
 func TestUnitCommons(t *testing.T) {
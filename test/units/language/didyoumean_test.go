@@ -47,6 +47,31 @@ var _ = Describe("Unit Tests - DidYouMean", func() {
 		Expect(language.DidYouMean(a, []string{b})).To(Equal(b))
 	})
 
+	Context("Closest", func() {
+		It("Returns an empty string when given an empty key", func() {
+			Expect(language.Closest("", []string{"read", "write"})).To(Equal(""))
+		})
+
+		It("Returns the option with the smallest edit distance rather than the first", func() {
+			Expect(language.Closest("wirte", []string{"admin", "read", "write"})).To(Equal("write"))
+		})
+
+		It("Returns the first of several equally close options", func() {
+			Expect(language.Closest("abc", []string{"abd", "abe"})).To(Equal("abd"))
+		})
+
+		It("Considers threshold", func() {
+			language.ThresholdRate = 0.2
+			Expect(language.Closest("wirte", []string{"admin", "read"})).To(Equal(""))
+		})
+
+		It("Considers case insensitivity", func() {
+			language.ThresholdRate = 0.7
+			language.CaseInsensitive = true
+			Expect(language.Closest("WRITE", []string{"read", "write"})).To(Equal("write"))
+		})
+	})
+
 })
 
 func TestUnitDidYouMean(t *testing.T) {
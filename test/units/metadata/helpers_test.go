@@ -5,6 +5,7 @@ import (
 	"github.com/gopher-fleece/gleece/v2/core/metadata"
 	"github.com/gopher-fleece/gleece/v2/definitions"
 	"github.com/gopher-fleece/gleece/v2/test/utils"
+	"github.com/gopher-fleece/runtime"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 )
@@ -86,6 +87,68 @@ var _ = Describe("Unit Tests - Metadata", func() {
 			Expect(sec).To(Equal([]definitions.RouteSecurity{}))
 		})
 
+		It("Expands an @AdvancedSecurity expression into alternatives of security schemas that must all pass", func() {
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Security(basic)",
+					"// @AdvancedSecurity(AND, { securities: [{ name: \"apiKey\" }, { relation: \"OR\", securities: [{ name: \"mTLS\" }, { name: \"oauth2\", scopes: [\"admin\"] }] }] })",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			sec, err := metadata.GetSecurityFromContext(holder)
+			Expect(err).To(BeNil())
+			Expect(sec).To(Equal([]definitions.RouteSecurity{
+				{SecurityAnnotation: []definitions.SecurityAnnotationComponent{{SchemaName: "basic", Scopes: []string{}}}},
+				{SecurityAnnotation: []definitions.SecurityAnnotationComponent{
+					{SchemaName: "apiKey", Scopes: []string{}},
+					{SchemaName: "mTLS", Scopes: []string{}},
+				}},
+				{SecurityAnnotation: []definitions.SecurityAnnotationComponent{
+					{SchemaName: "apiKey", Scopes: []string{}},
+					{SchemaName: "oauth2", Scopes: []string{"admin"}},
+				}},
+			}))
+		})
+
+		It("Returns an error when given an @AdvancedSecurity with an invalid relation", func() {
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @AdvancedSecurity(XOR, { securities: [{ name: \"apiKey\" }] })",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			_, err := metadata.GetSecurityFromContext(holder)
+			Expect(err).To(MatchError("invalid security relation 'XOR' - expected 'AND' or 'OR'"))
+		})
+
+		It("Returns an error when given an @AdvancedSecurity with an empty nested expression", func() {
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @AdvancedSecurity(OR, { securities: [{ name: \"apiKey\" }, { relation: \"AND\", securities: [] }] })",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			_, err := metadata.GetSecurityFromContext(holder)
+			Expect(err).To(MatchError(
+				"invalid security expression operand at index 1 - a security expression's 'securities' property must be a non-empty array",
+			))
+		})
+
+		It("Returns an error when given an @AdvancedSecurity schema with non-string scopes", func() {
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @AdvancedSecurity(OR, { securities: [{ name: \"oauth2\", scopes: [1] }] })",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			_, err := metadata.GetSecurityFromContext(holder)
+			Expect(err).To(MatchError(ContainSubstring("the scopes of security schema 'oauth2' must be an array of strings")))
+		})
+
 	})
 
 	Context("GetRouteSecurityWithInheritance", func() {
@@ -105,6 +168,550 @@ var _ = Describe("Unit Tests - Metadata", func() {
 		})
 	})
 
+	Context("IsPublicRoute", func() {
+		It("Returns true when the route has a @Public annotation", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Public"}, annotations.CommentSourceRoute)
+			Expect(metadata.IsPublicRoute(holder, false)).To(BeTrue())
+		})
+
+		It("Inherits the parent's publicity when the route declares no security", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Method(GET)"}, annotations.CommentSourceRoute)
+			Expect(metadata.IsPublicRoute(holder, true)).To(BeTrue())
+			Expect(metadata.IsPublicRoute(holder, false)).To(BeFalse())
+		})
+
+		It("Returns false for routes that declare their own security under a public parent", func() {
+			secured := utils.GetAnnotationHolderOrFail([]string{"// @Security(schema1)"}, annotations.CommentSourceRoute)
+			Expect(metadata.IsPublicRoute(secured, true)).To(BeFalse())
+
+			advanced := utils.GetAnnotationHolderOrFail(
+				[]string{"// @AdvancedSecurity(OR, { securities: [{ name: \"schema1\" }] })"},
+				annotations.CommentSourceRoute,
+			)
+			Expect(metadata.IsPublicRoute(advanced, true)).To(BeFalse())
+		})
+	})
+
+	Context("GetTags", func() {
+		It("Returns tags in order of declaration, with descriptions and external docs", func() {
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Tag(Users) User management",
+					"// @Tag(Admin, { externalDocs: { url: \"https://docs.example.com/admin\", description: \"Admin guide\" } })",
+				},
+				annotations.CommentSourceController,
+			)
+
+			tags, err := metadata.GetTags(holder)
+			Expect(err).To(BeNil())
+			Expect(tags).To(Equal([]definitions.OpenAPITag{
+				{Name: "Users", Description: "User management"},
+				{
+					Name: "Admin",
+					ExternalDocs: &definitions.OpenAPIExternalDocs{
+						URL:         "https://docs.example.com/admin",
+						Description: "Admin guide",
+					},
+				},
+			}))
+		})
+
+		It("Returns an error when a tag is declared more than once", func() {
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{"// @Tag(Users)", "// @Tag(Users)"},
+				annotations.CommentSourceController,
+			)
+
+			_, err := metadata.GetTags(holder)
+			Expect(err).To(MatchError("duplicate tag 'Users'"))
+		})
+
+		It("Returns an error when a tag's external docs do not specify a URL", func() {
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{"// @Tag(Users, { externalDocs: { description: \"Guide\" } })"},
+				annotations.CommentSourceController,
+			)
+
+			_, err := metadata.GetTags(holder)
+			Expect(err).To(MatchError(ContainSubstring("must have a 'url' string")))
+		})
+
+		It("Prefers route tags over the parent's", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Tag(Route)"}, annotations.CommentSourceRoute)
+			parentTags := []definitions.OpenAPITag{{Name: "Controller"}}
+
+			tags, err := metadata.GetRouteTagsWithInheritance(holder, parentTags)
+			Expect(err).To(BeNil())
+			Expect(tags).To(Equal([]definitions.OpenAPITag{{Name: "Route"}}))
+		})
+	})
+
+	Context("GetResponseHeaders", func() {
+		It("Creates headers with defaults, explicit types, descriptions and status codes", func() {
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @ResponseHeader(X-Request-Id) The request's ID",
+					"// @ResponseHeader(X-RateLimit-Remaining, { type: \"integer\", description: \"Remaining requests\", statusCodes: [200, 429] }) Ignored",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			headers, err := metadata.GetResponseHeaders(holder)
+			Expect(err).To(BeNil())
+			Expect(headers).To(Equal([]definitions.ResponseHeader{
+				{
+					Name:        "X-Request-Id",
+					Type:        "string",
+					Description: "The request's ID",
+					StatusCodes: []runtime.HttpStatusCode{},
+				},
+				{
+					Name:        "X-RateLimit-Remaining",
+					Type:        "integer",
+					Description: "Remaining requests",
+					StatusCodes: []runtime.HttpStatusCode{runtime.StatusOK, runtime.StatusTooManyRequests},
+				},
+			}))
+		})
+
+		It("Returns an error when given an invalid status code", func() {
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{"// @ResponseHeader(X-Request-Id, { statusCodes: [999] })"},
+				annotations.CommentSourceRoute,
+			)
+
+			_, err := metadata.GetResponseHeaders(holder)
+			Expect(err).To(MatchError("'999' is not a valid HTTP status code"))
+		})
+
+		It("Merges route headers into the parent's, overriding same-named ones", func() {
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @ResponseHeader(x-request-id, { type: \"integer\" })",
+					"// @ResponseHeader(Location)",
+				},
+				annotations.CommentSourceRoute,
+			)
+			parentHeaders := []definitions.ResponseHeader{
+				{Name: "X-Request-Id", Type: "string"},
+				{Name: "X-Version", Type: "string"},
+			}
+
+			headers, err := metadata.GetRouteResponseHeadersWithInheritance(holder, parentHeaders)
+			Expect(err).To(BeNil())
+			Expect(headers).To(HaveLen(3))
+			Expect(headers[0].Name).To(Equal("x-request-id"))
+			Expect(headers[0].Type).To(Equal("integer"))
+			Expect(headers[1].Name).To(Equal("X-Version"))
+			Expect(headers[2].Name).To(Equal("Location"))
+		})
+	})
+
+	Context("GetExtensions", func() {
+		holder := utils.GetAnnotationHolderOrFail(
+			[]string{
+				"// @Extension(x-internal, { value: true })",
+				"// @Extension(x-owner, { value: { team: \"core\" } })",
+				"// @Extension(x-example, { value: 42, param: \"id\" })",
+			},
+			annotations.CommentSourceRoute,
+		)
+
+		It("Returns only entity-level extensions when no parameter name is given", func() {
+			extensions, err := metadata.GetExtensions(holder, "")
+			Expect(err).To(BeNil())
+			Expect(extensions).To(Equal(map[string]any{
+				"x-internal": true,
+				"x-owner":    map[string]any{"team": "core"},
+			}))
+		})
+
+		It("Returns only the extensions targeting the given parameter", func() {
+			extensions, err := metadata.GetExtensions(holder, "id")
+			Expect(err).To(BeNil())
+			Expect(extensions).To(Equal(map[string]any{"x-example": float64(42)}))
+		})
+
+		It("Returns an error when an extension's name does not begin with 'x-'", func() {
+			invalidHolder := utils.GetAnnotationHolderOrFail(
+				[]string{"// @Extension(internal, { value: true })"},
+				annotations.CommentSourceRoute,
+			)
+
+			_, err := metadata.GetExtensions(invalidHolder, "")
+			Expect(err).To(MatchError("extension 'internal' is invalid - extension names must begin with 'x-'"))
+		})
+
+		It("Returns an error when an extension is defined more than once", func() {
+			duplicateHolder := utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Extension(x-internal, { value: true })",
+					"// @Extension(x-internal, { value: false })",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			_, err := metadata.GetExtensions(duplicateHolder, "")
+			Expect(err).To(MatchError("extension 'x-internal' is defined more than once"))
+		})
+
+		It("Merges route extensions into the parent's, overriding same-named ones", func() {
+			extensions, err := metadata.GetRouteExtensionsWithInheritance(
+				holder,
+				map[string]any{"x-internal": false, "x-audience": "public"},
+			)
+			Expect(err).To(BeNil())
+			Expect(extensions).To(Equal(map[string]any{
+				"x-internal": true,
+				"x-audience": "public",
+				"x-owner":    map[string]any{"team": "core"},
+			}))
+		})
+	})
+
+	Context("GetWebhook", func() {
+		It("Returns nil when there is no @Webhook annotation", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Method(POST)"}, annotations.CommentSourceRoute)
+
+			webhook, err := metadata.GetWebhook(holder)
+			Expect(err).To(BeNil())
+			Expect(webhook).To(BeNil())
+		})
+
+		It("Uses the default callback URL when none is given", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Webhook(orderCreated)"}, annotations.CommentSourceRoute)
+
+			webhook, err := metadata.GetWebhook(holder)
+			Expect(err).To(BeNil())
+			Expect(webhook).To(Equal(&definitions.WebhookMetadata{
+				Name:        "orderCreated",
+				CallbackUrl: definitions.DefaultWebhookCallbackUrl,
+			}))
+		})
+
+		It("Uses the explicit callback URL when given", func() {
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{"// @Webhook(orderCreated, { url: \"{$request.body#/hooks/orders}\" })"},
+				annotations.CommentSourceRoute,
+			)
+
+			webhook, err := metadata.GetWebhook(holder)
+			Expect(err).To(BeNil())
+			Expect(webhook.CallbackUrl).To(Equal("{$request.body#/hooks/orders}"))
+		})
+
+		It("Uses the operations that trigger the webhook when given", func() {
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{"// @Webhook(orderCreated, { operations: [\"createOrder\", \"importOrders\"] })"},
+				annotations.CommentSourceRoute,
+			)
+
+			webhook, err := metadata.GetWebhook(holder)
+			Expect(err).To(BeNil())
+			Expect(webhook.Operations).To(Equal([]string{"createOrder", "importOrders"}))
+		})
+	})
+
+	Context("GetMiddlewares", func() {
+		It("Returns the middlewares in order of declaration", func() {
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{"// @Middleware(audit)", "// @Middleware(trace)"},
+				annotations.CommentSourceRoute,
+			)
+
+			middlewares, err := metadata.GetMiddlewares(holder)
+			Expect(err).To(BeNil())
+			Expect(middlewares).To(Equal([]string{"audit", "trace"}))
+		})
+
+		It("Places controller middlewares first and drops duplicates when inheriting", func() {
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{"// @Middleware(trace)", "// @Middleware(audit)", "// @Middleware(cache)"},
+				annotations.CommentSourceRoute,
+			)
+
+			middlewares, err := metadata.GetRouteMiddlewaresWithInheritance(holder, []string{"audit", "trace"})
+			Expect(err).To(BeNil())
+			Expect(middlewares).To(Equal([]string{"audit", "trace", "cache"}))
+		})
+	})
+
+	Context("GetVersions", func() {
+		config := &definitions.GleeceConfig{
+			Versioning: &definitions.VersioningConfig{Versions: []int{3, 1, 2}},
+		}
+
+		It("Parses comma separated versions into a sorted, unique list", func() {
+			versions, err := metadata.ParseVersionList("2, 1,2")
+			Expect(err).To(BeNil())
+			Expect(versions).To(Equal([]int{1, 2}))
+		})
+
+		It("Returns an error for non-positive versions", func() {
+			_, err := metadata.ParseVersionList("1, 0")
+			Expect(err).To(MatchError("'0' is not a valid API version - versions must be positive integers"))
+		})
+
+		It("Returns nil for holders without versioning annotations", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Route(/)"}, annotations.CommentSourceController)
+			versions, err := metadata.GetVersions(holder, config)
+			Expect(err).To(BeNil())
+			Expect(versions).To(BeNil())
+		})
+
+		It("Narrows the configured versions down using @Since and @Until", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Since(2)"}, annotations.CommentSourceController)
+			versions, err := metadata.GetVersions(holder, config)
+			Expect(err).To(BeNil())
+			Expect(versions).To(Equal([]int{2, 3}))
+		})
+
+		It("Inherits the parent controller's versions as-is when the route has no versioning annotations", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Method(GET)"}, annotations.CommentSourceRoute)
+			versions, err := metadata.GetRouteVersionsWithInheritance(holder, config, []int{1, 2})
+			Expect(err).To(BeNil())
+			Expect(versions).To(Equal([]int{1, 2}))
+		})
+
+		It("Narrows the parent controller's versions down using the route's @Until", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Until(1)"}, annotations.CommentSourceRoute)
+			versions, err := metadata.GetRouteVersionsWithInheritance(holder, config, []int{1, 2})
+			Expect(err).To(BeNil())
+			Expect(versions).To(Equal([]int{1}))
+		})
+
+		It("Prefers the route's @Version over the parent controller's versions", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Version(3)"}, annotations.CommentSourceRoute)
+			versions, err := metadata.GetRouteVersionsWithInheritance(holder, config, []int{1, 2})
+			Expect(err).To(BeNil())
+			Expect(versions).To(Equal([]int{3}))
+		})
+
+		It("Returns an error for @Since without a versioning configuration", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Since(2)"}, annotations.CommentSourceRoute)
+			_, err := metadata.GetRouteVersionsWithInheritance(holder, &definitions.GleeceConfig{}, nil)
+			Expect(err).To(MatchError("the @Since and @Until annotations require API versions to be configured"))
+		})
+	})
+
+	Context("GetRateLimit", func() {
+		It("Returns nil for holders without a @RateLimit annotation", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Route(/)"}, annotations.CommentSourceController)
+			rateLimit, err := metadata.GetRateLimit(holder)
+			Expect(err).To(BeNil())
+			Expect(rateLimit).To(BeNil())
+		})
+
+		It("Defaults the key to the client IP", func() {
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{`// @RateLimit({ requests: 100, per: "1m" })`},
+				annotations.CommentSourceController,
+			)
+			rateLimit, err := metadata.GetRateLimit(holder)
+			Expect(err).To(BeNil())
+			Expect(rateLimit).To(Equal(&definitions.RateLimit{Requests: 100, Per: "1m", Key: definitions.RateLimitKeyIp}))
+		})
+
+		It("Returns an error for a non-positive window", func() {
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{`// @RateLimit({ requests: 100, per: "0s" })`},
+				annotations.CommentSourceController,
+			)
+			_, err := metadata.GetRateLimit(holder)
+			Expect(err).To(MatchError("property 'per' must be a positive duration, e.g. '1m' or '30s', but got '0s'"))
+		})
+
+		It("Inherits the parent controller's rate limit when the route has no @RateLimit annotation", func() {
+			parent := &definitions.RateLimit{Requests: 10, Per: "1s", Key: definitions.RateLimitKeyPrincipal}
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Method(GET)"}, annotations.CommentSourceRoute)
+			rateLimit, err := metadata.GetRouteRateLimitWithInheritance(holder, parent)
+			Expect(err).To(BeNil())
+			Expect(rateLimit).To(Equal(&definitions.RateLimit{
+				Requests:    10,
+				Per:         "1s",
+				Key:         definitions.RateLimitKeyPrincipal,
+				IsInherited: true,
+			}))
+		})
+
+		It("Returns no rate limit when neither the route nor the parent controller have one", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Method(GET)"}, annotations.CommentSourceRoute)
+			rateLimit, err := metadata.GetRouteRateLimitWithInheritance(holder, nil)
+			Expect(err).To(BeNil())
+			Expect(rateLimit).To(BeNil())
+		})
+
+		It("Prefers the route's @RateLimit over the parent controller's", func() {
+			parent := &definitions.RateLimit{Requests: 10, Per: "1s", Key: definitions.RateLimitKeyPrincipal}
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{`// @RateLimit({ requests: 5, per: "1h", key: "header:X-Api-Key" })`},
+				annotations.CommentSourceRoute,
+			)
+			rateLimit, err := metadata.GetRouteRateLimitWithInheritance(holder, parent)
+			Expect(err).To(BeNil())
+			Expect(rateLimit).To(Equal(&definitions.RateLimit{Requests: 5, Per: "1h", Key: "header:X-Api-Key"}))
+		})
+	})
+
+	Context("GetTimeout", func() {
+		config := &definitions.GleeceConfig{RoutesConfig: definitions.RoutesConfig{DefaultTimeout: "30s"}}
+
+		It("Falls back to the configured default timeout for holders without a @Timeout annotation", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Route(/)"}, annotations.CommentSourceController)
+			timeout, err := metadata.GetTimeout(holder, config)
+			Expect(err).To(BeNil())
+			Expect(timeout).To(Equal("30s"))
+
+			timeout, err = metadata.GetTimeout(holder, &definitions.GleeceConfig{})
+			Expect(err).To(BeNil())
+			Expect(timeout).To(BeEmpty())
+		})
+
+		It("Prefers the @Timeout annotation over the configured default", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Timeout(500ms)"}, annotations.CommentSourceController)
+			timeout, err := metadata.GetTimeout(holder, config)
+			Expect(err).To(BeNil())
+			Expect(timeout).To(Equal("500ms"))
+		})
+
+		It("Returns an error for an invalid configured default", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Route(/)"}, annotations.CommentSourceController)
+			_, err := metadata.GetTimeout(holder, &definitions.GleeceConfig{RoutesConfig: definitions.RoutesConfig{DefaultTimeout: "soon"}})
+			Expect(err).To(MatchError(
+				"invalid 'defaultTimeout' configuration - a timeout must be a positive duration, e.g. '5s' or '500ms', but got 'soon'",
+			))
+		})
+
+		It("Inherits the parent controller's timeout when the route has no @Timeout annotation", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Method(GET)"}, annotations.CommentSourceRoute)
+			timeout, err := metadata.GetRouteTimeoutWithInheritance(holder, "10s")
+			Expect(err).To(BeNil())
+			Expect(timeout).To(Equal("10s"))
+		})
+	})
+
+	Context("GetMaxBodySize", func() {
+		It("Converts sizes with units to bytes", func() {
+			for value, expected := range map[string]int64{"100": 100, "64B": 64, "512KB": 512 << 10, "1MB": 1 << 20, "2 gb": 2 << 30} {
+				size, err := metadata.ParseByteSize(value)
+				Expect(err).To(BeNil())
+				Expect(size).To(Equal(expected))
+			}
+		})
+
+		It("Returns an error for non-positive sizes or unknown units", func() {
+			for _, value := range []string{"0", "1TB", "MB", "-1MB"} {
+				_, err := metadata.ParseByteSize(value)
+				Expect(err).To(HaveOccurred())
+			}
+		})
+
+		It("Falls back to the configured default for holders without a @MaxBodySize annotation", func() {
+			config := &definitions.GleeceConfig{RoutesConfig: definitions.RoutesConfig{DefaultMaxBodySize: "1MB"}}
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Route(/)"}, annotations.CommentSourceController)
+			size, err := metadata.GetMaxBodySize(holder, config)
+			Expect(err).To(BeNil())
+			Expect(size).To(Equal(int64(1 << 20)))
+		})
+
+		It("Prefers the route's @MaxBodySize over the parent controller's", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @MaxBodySize(16KB)"}, annotations.CommentSourceRoute)
+			size, err := metadata.GetRouteMaxBodySizeWithInheritance(holder, 1<<20)
+			Expect(err).To(BeNil())
+			Expect(size).To(Equal(int64(16 << 10)))
+		})
+	})
+
+	Context("GetIdempotency", func() {
+		It("Returns nil for holders without an @Idempotent annotation", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Method(POST)"}, annotations.CommentSourceRoute)
+			idempotency, err := metadata.GetIdempotency(holder)
+			Expect(err).To(BeNil())
+			Expect(idempotency).To(BeNil())
+		})
+
+		It("Defaults the TTL to a day", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{"// @Idempotent"}, annotations.CommentSourceRoute)
+			idempotency, err := metadata.GetIdempotency(holder)
+			Expect(err).To(BeNil())
+			Expect(idempotency).To(Equal(&definitions.Idempotency{Ttl: "24h"}))
+		})
+
+		It("Returns an error for a non-positive TTL", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{`// @Idempotent({ ttl: "-1h" })`}, annotations.CommentSourceRoute)
+			_, err := metadata.GetIdempotency(holder)
+			Expect(err).To(MatchError("property 'ttl' must be a positive duration, e.g. '24h' or '30m', but got '-1h'"))
+		})
+	})
+
+	Context("GetServers", func() {
+		config := &definitions.GleeceConfig{
+			OpenAPIGeneratorConfig: definitions.OpenAPIGeneratorConfig{
+				Servers: []definitions.OpenAPIServer{
+					{Name: "staging", URL: "https://staging.example.com", Description: "Staging"},
+				},
+			},
+		}
+
+		It("Resolves configured servers by name and ad-hoc servers by URL", func() {
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Server(staging)",
+					"// @Server(eu, { url: \"https://eu.example.com\" }) EU region",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			servers, err := metadata.GetServers(holder, config)
+			Expect(err).To(BeNil())
+			Expect(servers).To(Equal([]definitions.OpenAPIServer{
+				{Name: "staging", URL: "https://staging.example.com", Description: "Staging"},
+				{Name: "eu", URL: "https://eu.example.com", Description: "EU region"},
+			}))
+		})
+
+		It("Appends the routes' base path to configured and ad-hoc servers", func() {
+			basePathConfig := &definitions.GleeceConfig{
+				RoutesConfig:           definitions.RoutesConfig{BasePath: "/api"},
+				OpenAPIGeneratorConfig: config.OpenAPIGeneratorConfig,
+			}
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Server(staging)",
+					"// @Server(eu, { url: \"https://eu.example.com/\" }) EU region",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			servers, err := metadata.GetServers(holder, basePathConfig)
+			Expect(err).To(BeNil())
+			Expect(servers).To(Equal([]definitions.OpenAPIServer{
+				{Name: "staging", URL: "https://staging.example.com/api", Description: "Staging"},
+				{Name: "eu", URL: "https://eu.example.com/api", Description: "EU region"},
+			}))
+			Expect(basePathConfig.OpenAPIGeneratorConfig.Servers[0].URL).To(Equal("https://staging.example.com"))
+		})
+
+		It("Returns an error when referencing a server that is not configured", func() {
+			holder := utils.GetAnnotationHolderOrFail(
+				[]string{"// @Server(production)"},
+				annotations.CommentSourceRoute,
+			)
+
+			_, err := metadata.GetServers(holder, config)
+			Expect(err).To(MatchError("server 'production' is not defined in the Gleece configuration"))
+		})
+
+		It("Inherits the parent's servers when the route has no explicit servers", func() {
+			holder := utils.GetAnnotationHolderOrFail([]string{}, annotations.CommentSourceRoute)
+			parentServers := []definitions.OpenAPIServer{{Name: "parent", URL: "https://parent.example.com"}}
+
+			servers, err := metadata.GetRouteServersWithInheritance(holder, config, parentServers)
+			Expect(err).To(BeNil())
+			Expect(servers).To(Equal(parentServers))
+		})
+	})
+
 	Context("GetTemplateContextMetadata", func() {
 		It("Should successfully create template context map", func() {
 			attributes := utils.GetAnnotationHolderOrFail(
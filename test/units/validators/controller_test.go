@@ -76,6 +76,23 @@ var _ = Describe("Unit Tests - Controller Validator", func() {
 		))
 	})
 
+	It("Returns a DiagAnnotationValueInvalid when annotation that does not accept lists has a comma separated value", func() {
+		controller.Struct.Annotations = utils.GetAnnotationHolderOrFail(
+			[]string{"// @Tag(Users, Admin)"},
+			annotations.CommentSourceController,
+		)
+
+		validator = validators.NewControllerValidator(gleeceConfig, pkgFacade, &controller)
+		diag, err := validator.Validate()
+		Expect(err).To(BeNil())
+
+		Expect(diag.Diagnostics).To(HaveLen(1))
+		Expect(diag.Diagnostics[0]).To(BeDiagnosticErrorWithCodeAndMessage(
+			diagnostics.DiagAnnotationValueInvalid,
+			"Annotation '@Tag' does not accept a comma separated list of values",
+		))
+	})
+
 	It("Returns a DiagAnnotationInvalidInContext when annotation cannot be used on a controller", func() {
 		controller.Struct.Annotations = utils.GetAnnotationHolderOrFail(
 			[]string{
@@ -1,13 +1,18 @@
 package validators_test
 
 import (
+	"fmt"
+
 	"github.com/gopher-fleece/gleece/v2/common"
 	"github.com/gopher-fleece/gleece/v2/core/annotations"
 	"github.com/gopher-fleece/gleece/v2/core/arbitrators"
 	"github.com/gopher-fleece/gleece/v2/core/metadata"
+	"github.com/gopher-fleece/gleece/v2/core/metadata/typeref"
 	"github.com/gopher-fleece/gleece/v2/core/validators"
+	"github.com/gopher-fleece/gleece/v2/core/validators/configuration"
 	"github.com/gopher-fleece/gleece/v2/core/validators/diagnostics"
 	"github.com/gopher-fleece/gleece/v2/definitions"
+	"github.com/gopher-fleece/gleece/v2/graphs"
 	"github.com/gopher-fleece/gleece/v2/test/utils"
 	. "github.com/gopher-fleece/gleece/v2/test/utils/matchers"
 	. "github.com/onsi/ginkgo/v2"
@@ -53,25 +58,516 @@ var _ = Describe("Unit Tests - Route Validator", func() {
 			Receivers: []metadata.ReceiverMeta{receiver},
 		}
 
-		validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
-	})
+		validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+	})
+
+	Context("Individual annotations", func() {
+
+		It("Returns a DiagAnnotationInvalidInContext warning when an annotation is applied in an invalid context", func() {
+			// Some annotations are only valid for certain types of entities.
+			// As no built-in annotation is currently controller-only, a test-scoped one stands in for them
+			configuration.ValidatorConfigMap["ControllerOnly"] = configuration.AnnotationConfigDefinition{
+				Contexts:          []annotations.CommentSource{annotations.CommentSourceController},
+				RequiresValue:     true,
+				AllowedProperties: map[string]configuration.PropertyDefinition{},
+			}
+			DeferCleanup(func() { delete(configuration.ValidatorConfigMap, "ControllerOnly") })
+
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @ControllerOnly(Test)",
+					"// @Route(/)",
+					"// @Method(POST)",
+				},
+				annotations.CommentSourceRoute,
+			)
+			// Simulate a no-params function for simplicity
+			receiver.Params = []metadata.FuncParam{}
+			controller.Receivers[0].Params = receiver.Params
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(2))
+
+			Expect(diag.Diagnostics[0]).To(BeDiagnosticWarningWithCodeAndMessage(
+				diagnostics.DiagAnnotationInvalidInContext,
+				"Annotation '@ControllerOnly' is not valid in the context of a route",
+			))
+
+			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Does not return a DiagAnnotationInvalidInContext warning for route-level @Tag annotations", func() {
+			// @Tag annotations were once controller-only but may now be used to override a route's tags
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Tag(Test)",
+					"// @Tag(Other, { externalDocs: { url: \"https://docs.example.com\" } }) Other operations",
+					"// @Route(/)",
+					"// @Method(POST)",
+				},
+				annotations.CommentSourceRoute,
+			)
+			// Simulate a no-params function for simplicity
+			receiver.Params = []metadata.FuncParam{}
+			controller.Receivers[0].Params = receiver.Params
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(1))
+			Expect(diag.Diagnostics[0]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Returns a DiagAnnotationPropertiesInvalidValueForKey error when a @Tag's external docs lack a URL", func() {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Tag(Test, { externalDocs: { description: \"No URL\" } })",
+					"// @Route(/)",
+					"// @Method(POST)",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(2))
+			Expect(diag.Diagnostics[0]).To(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagAnnotationPropertiesInvalidValueForKey,
+				"Property 'externalDocs' must specify a 'url' string",
+			))
+			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Returns a DiagAnnotationValueInvalid error when an annotation's value is invalid as per internal schema", func() {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Route(/)",
+					"// @Method(INVALID_METHOD)",
+					"// @Response(INVALID)",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(3))
+
+			Expect(diag.Diagnostics[0]).To(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagAnnotationValueInvalid,
+				"Invalid HTTP verb 'INVALID_METHOD'. Supported verbs are: DELETE, GET, HEAD, OPTIONS, PATCH, POST, PUT, TRACE",
+			))
+
+			Expect(diag.Diagnostics[1]).To(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagAnnotationValueInvalid,
+				"Non-numeric HTTP status code 'INVALID'",
+			))
+
+			Expect(diag.Diagnostics[2]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Returns a DiagAnnotationPropertiesShouldNotExist when a property-less annotations has properties", func() {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Route(/)",
+					"// @Method(POST, { invalid: \"properties\" })",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(2))
+
+			Expect(diag.Diagnostics[0]).To(BeDiagnosticWarningWithCodeAndMessage(
+				diagnostics.DiagAnnotationPropertiesShouldNotExist,
+				"Annotation '@Method' does not support properties",
+			))
+
+			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Returns a DiagAnnotationPropertiesUnknownKey warning when an annotation has an unknown property key", func() {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Route(/)",
+					"// @Method(POST)",
+					"// @Query(value, { invalid: \"properties\" })",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(3))
+
+			Expect(diag.Diagnostics[0]).To(BeDiagnosticWarningWithCodeAndMessage(
+				diagnostics.DiagAnnotationPropertyShouldNotExist,
+				"Property 'invalid' is not allowed for annotation '@Query'",
+			))
+
+			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+
+			Expect(diag.Diagnostics[2]).To(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagLinkerPathInvalidRef,
+				"@Query 'value' does not match any parameter of TestReceiver",
+			))
+		})
+
+		It("Returns a DiagAnnotationPropertiesInvalidValueForKey error when property type is invalid", func() {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Route(/)",
+					"// @Method(POST)",
+					"// @Query(value, { name: 123 })",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(3))
+
+			Expect(diag.Diagnostics[0]).To(BeDiagnosticWarningWithCodeAndMessage(
+				diagnostics.DiagAnnotationPropertiesInvalidValueForKey,
+				"Property 'name' expected to be a string",
+			))
+
+			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+
+			Expect(diag.Diagnostics[2]).To(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagLinkerPathInvalidRef,
+				"@Query 'value' does not match any parameter of TestReceiver",
+			))
+		})
+
+		It("Returns a DiagFeatureUnsupported when a @Method annotation has a valid but unsupported HTTP verb", func() {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Route(/)",
+					"// @Method(CONNECT) Valid but unsupported",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(2))
+
+			Expect(diag.Diagnostics[0]).To(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagFeatureUnsupported,
+				"HTTP verb 'CONNECT' is currently unsupported for @Method annotations. Supported verbs are: DELETE, GET, HEAD, OPTIONS, PATCH, POST, PUT, TRACE",
+			))
+
+			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Does not return a diagnostic when a @Method annotation has a HEAD, OPTIONS or TRACE verb", func() {
+			for _, verb := range []string{"HEAD", "OPTIONS", "TRACE"} {
+				receiver.Annotations = utils.GetAnnotationHolderOrFail(
+					[]string{
+						"// @Route(/)",
+						fmt.Sprintf("// @Method(%s)", verb),
+					},
+					annotations.CommentSourceRoute,
+				)
+
+				validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+				diag, err := validator.Validate()
+				Expect(err).To(BeNil())
+				Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+				Expect(diag.Diagnostics).To(HaveLen(1))
+				Expect(diag.Diagnostics[0]).To(BeAReturnSigDiagnostic())
+			}
+		})
+
+		It("Returns a DiagRouteCustomHttpVerb warning when a @Method annotation has a custom HTTP verb", func() {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Route(/)",
+					"// @Method(PURGE)",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(2))
+
+			Expect(diag.Diagnostics[0]).To(BeDiagnosticWarningWithCodeAndMessage(
+				diagnostics.DiagRouteCustomHttpVerb,
+				"HTTP verb 'PURGE' is not a standard verb. The operation is omitted from the OpenAPI specification "+
+					"and the verb may need to be registered with the routing engine",
+			))
+
+			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Returns a DiagRouteCustomHttpVerb warning requiring Fiber's RequestMethods for a custom HTTP verb", func() {
+			fiberConfig := *gleeceConfig
+			fiberConfig.RoutesConfig.Engine = definitions.RoutingEngineFiber
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Route(/)",
+					"// @Method(PURGE)",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(&fiberConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(2))
+			Expect(diag.Diagnostics[0]).To(BeDiagnosticWarningWithCodeAndMessage(
+				diagnostics.DiagRouteCustomHttpVerb,
+				"HTTP verb 'PURGE' is not a standard verb. The operation is omitted from the OpenAPI specification "+
+					"and the verb must be listed in the Fiber app's Config.RequestMethods",
+			))
+			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Returns a DiagAnnotationPropertiesInvalidValueForKey error when a @ResponseHeader has an invalid status code", func() {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Route(/)",
+					"// @Method(POST)",
+					"// @ResponseHeader(X-Request-Id, { statusCodes: [200, 999] })",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(2))
+			Expect(diag.Diagnostics[0]).To(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagAnnotationPropertiesInvalidValueForKey,
+				"Invalid HTTP status code '999' in property 'statusCodes'",
+			))
+			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Returns a DiagAnnotationValueInvalid error when an @Extension's name does not begin with 'x-'", func() {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Route(/)",
+					"// @Method(POST)",
+					"// @Extension(x-internal, { value: true })",
+					"// @Extension(internal, { value: true })",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(2))
+			Expect(diag.Diagnostics[0]).To(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagAnnotationValueInvalid,
+				"Extension 'internal' is invalid. Extension names must begin with 'x-'",
+			))
+			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Returns a DiagAnnotationPropertiesInvalidValueForKey error when an @Extension targets an unknown parameter", func() {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Route(/)",
+					"// @Method(POST)",
+					"// @Extension(x-internal, { value: true, param: \"missing\" })",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(2))
+			Expect(diag.Diagnostics[0]).To(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagAnnotationPropertiesInvalidValueForKey,
+				"Extension 'x-internal' targets unknown parameter 'missing'",
+			))
+			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Returns a DiagAnnotationMutuallyExclusive error when a @Webhook has a @Route", func() {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Route(/)",
+					"// @Method(POST)",
+					"// @Webhook(orderCreated)",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(2))
+			Expect(diag.Diagnostics[0]).To(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagAnnotationMutuallyExclusive,
+				"Annotations '@Webhook' and '@Route' are mutually exclusive",
+			))
+			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Does not require a @Route for a @Webhook", func() {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Method(POST)",
+					"// @Webhook(orderCreated)",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag.Diagnostics).To(HaveLen(1))
+			Expect(diag.Diagnostics[0]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Returns a DiagAnnotationValueInvalid error when a @Server annotation references an unknown server", func() {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Route(/)",
+					"// @Method(POST)",
+					"// @Server(staging)",
+					"// @Server(eu, { url: \"https://eu.example.com\" })",
+					"// @Server(production)",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(2))
+
+			Expect(diag.Diagnostics[0]).To(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagAnnotationValueInvalid,
+				"Server 'production' is not defined in the Gleece configuration and no 'url' property was provided",
+			))
+
+			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+		})
+		It("Returns a DiagAnnotationPropertiesInvalidValueForKey error when an @AdvancedSecurity references an unknown schema", func() {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Route(/)",
+					"// @Method(POST)",
+					"// @AdvancedSecurity(OR, { securities: [{ name: \"secSchema1\" }, { relation: \"AND\", securities: [{ name: \"secSchema2\" }, { name: \"unknownSchema\" }] }] })",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(2))
+			Expect(diag.Diagnostics[0]).To(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagAnnotationPropertiesInvalidValueForKey,
+				"Security schema 'unknownSchema' is not defined in the Gleece configuration",
+			))
+			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Returns a DiagAnnotationPropertiesInvalid error when an @AdvancedSecurity expression is malformed", func() {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Route(/)",
+					"// @Method(POST)",
+					"// @AdvancedSecurity(OR, { securities: [\"secSchema1\"] })",
+				},
+				annotations.CommentSourceRoute,
+			)
+
+			// Security enforcement resolves the route's security and would fail on the malformed expression before linting it
+			gleeceConfig.RoutesConfig.AuthorizationConfig.EnforceSecurityOnAllRoutes = false
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
 
-	Context("Individual annotations", func() {
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
 
-		It("Returns a DiagAnnotationInvalidInContext warning when an annotation is applied in an invalid context", func() {
-			// Some annotations are only valid for certain types of entities.
-			// In this example, @Tag is only valid for controllers.
+			Expect(diag.Diagnostics).To(HaveLen(2))
+			Expect(diag.Diagnostics[0]).To(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagAnnotationPropertiesInvalid,
+				"Invalid security expression - invalid security expression operand at index 0 - expected an object but got 'secSchema1'",
+			))
+			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+		})
+	})
+
+	Context("Public routes", func() {
+		It("Returns a DiagReceiverPublic warning rather than a missing security error for @Public routes", func() {
 			receiver.Annotations = utils.GetAnnotationHolderOrFail(
 				[]string{
-					"// @Tag(Test)",
 					"// @Route(/)",
 					"// @Method(POST)",
+					"// @Public",
 				},
 				annotations.CommentSourceRoute,
 			)
-			// Simulate a no-params function for simplicity
-			receiver.Params = []metadata.FuncParam{}
-			controller.Receivers[0].Params = receiver.Params
 
 			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
 
@@ -80,21 +576,38 @@ var _ = Describe("Unit Tests - Route Validator", func() {
 			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
 
 			Expect(diag.Diagnostics).To(HaveLen(2))
-
-			Expect(diag.Diagnostics[0]).To(BeDiagnosticWarningWithCodeAndMessage(
-				diagnostics.DiagAnnotationInvalidInContext,
-				"Annotation '@Tag' is not valid in the context of a route",
+			Expect(diag.Diagnostics[0]).To(BeAReturnSigDiagnostic())
+			Expect(diag.Diagnostics[1]).To(BeDiagnosticWarningWithCodeAndMessage(
+				diagnostics.DiagReceiverPublic,
+				"Route with operation ID 'TestReceiver' is public and is not subject to any security",
 			))
+		})
 
-			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+		It("Returns a DiagReceiverPublic warning for routes without security under a @Public controller", func() {
+			controller.Struct.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{"// @Public"},
+				annotations.CommentSourceController,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+
+			Expect(diag.Diagnostics).To(HaveLen(2))
+			Expect(diag.Diagnostics[1]).To(BeDiagnosticWarningWithCodeAndMessage(
+				diagnostics.DiagReceiverPublic,
+				"Route with operation ID 'TestReceiver' is public and is not subject to any security",
+			))
 		})
 
-		It("Returns a DiagAnnotationValueInvalid error when an annotation's value is invalid as per internal schema", func() {
+		It("Returns a DiagAnnotationMutuallyExclusive error when @Public is combined with @Security", func() {
 			receiver.Annotations = utils.GetAnnotationHolderOrFail(
 				[]string{
 					"// @Route(/)",
-					"// @Method(INVALID_METHOD)",
-					"// @Response(INVALID)",
+					"// @Method(POST)",
+					"// @Public",
+					"// @Security(secSchema1)",
 				},
 				annotations.CommentSourceRoute,
 			)
@@ -103,28 +616,223 @@ var _ = Describe("Unit Tests - Route Validator", func() {
 
 			diag, err := validator.Validate()
 			Expect(err).To(BeNil())
-			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
 
-			Expect(diag.Diagnostics).To(HaveLen(3))
+			Expect(diag.Diagnostics).To(ContainElement(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagAnnotationMutuallyExclusive,
+				"Annotations '@Security' and '@Public' are mutually exclusive",
+			)))
+		})
+	})
 
-			Expect(diag.Diagnostics[0]).To(BeDiagnosticErrorWithCodeAndMessage(
+	Context("API versions", func() {
+		BeforeEach(func() {
+			gleeceConfig.Versioning = &definitions.VersioningConfig{Versions: []int{1, 2, 3}}
+		})
+
+		getDiagnosticsForAnnotations := func(comments ...string) []diagnostics.ResolvedDiagnostic {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				append([]string{"// @Route(/)", "// @Method(POST)"}, comments...),
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			return diag.Diagnostics
+		}
+
+		It("Does not return versioning diagnostics for routes served in declared versions", func() {
+			diags := getDiagnosticsForAnnotations("// @Version(1, 3)", "// @Since(1)", "// @Until(3)")
+			Expect(diags).To(HaveLen(1))
+			Expect(diags[0]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Returns a DiagVersioningNotConfigured error when versioning is not configured", func() {
+			gleeceConfig.Versioning = nil
+			diags := getDiagnosticsForAnnotations("// @Version(1)")
+			Expect(diags).To(ContainElement(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagVersioningNotConfigured,
+				"@Version requires API versions to be declared via the 'versioning' configuration",
+			)))
+		})
+
+		It("Returns a DiagVersionUnknown error when referencing an undeclared version", func() {
+			diags := getDiagnosticsForAnnotations("// @Version(1, 4)")
+			Expect(diags).To(ContainElement(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagVersionUnknown,
+				"API version '4' is not declared in the Gleece configuration. Declared versions are: 1, 2, 3",
+			)))
+		})
+
+		It("Returns a DiagAnnotationValueInvalid error for non-numeric versions", func() {
+			diags := getDiagnosticsForAnnotations("// @Version(1, two)")
+			Expect(diags).To(ContainElement(BeDiagnosticErrorWithCodeAndMessage(
 				diagnostics.DiagAnnotationValueInvalid,
-				"Invalid HTTP verb 'INVALID_METHOD'. Supported verbs are: DELETE, GET, PATCH, POST, PUT",
-			))
+				"Invalid API version '1, two' - 'two' is not a valid API version - versions must be positive integers",
+			)))
+		})
 
-			Expect(diag.Diagnostics[1]).To(BeDiagnosticErrorWithCodeAndMessage(
+		It("Returns a DiagAnnotationValueInvalid error when @Since specifies more than a single version", func() {
+			diags := getDiagnosticsForAnnotations("// @Since(1, 2)")
+			Expect(diags).To(ContainElement(BeDiagnosticErrorWithCodeAndMessage(
 				diagnostics.DiagAnnotationValueInvalid,
-				"Non-numeric HTTP status code 'INVALID'",
-			))
+				"Invalid API version '1, 2' - expected a single API version but got '1, 2'",
+			)))
+		})
 
-			Expect(diag.Diagnostics[2]).To(BeAReturnSigDiagnostic())
+		It("Returns a DiagReceiverNoVersions error when @Since and @Until exclude all versions", func() {
+			diags := getDiagnosticsForAnnotations("// @Since(3)", "// @Until(2)")
+			Expect(diags).To(ContainElement(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagReceiverNoVersions,
+				"Route with operation ID 'TestReceiver' is not served in any API version. Check its @Since and @Until annotations",
+			)))
 		})
 
-		It("Returns a DiagAnnotationPropertiesShouldNotExist when a property-less annotations has properties", func() {
+		It("Returns a DiagReceiverNoVersions error when @Until excludes all of the controller's versions", func() {
+			controller.Struct.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{"// @Version(2, 3)"},
+				annotations.CommentSourceController,
+			)
+
+			diags := getDiagnosticsForAnnotations("// @Until(1)")
+			Expect(diags).To(ContainElement(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagReceiverNoVersions,
+				"Route with operation ID 'TestReceiver' is not served in any API version. Check its @Since and @Until annotations",
+			)))
+		})
+	})
+
+	Context("Rate limits", func() {
+		getDiagnosticsForRateLimit := func(rateLimit string) []diagnostics.ResolvedDiagnostic {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{"// @Route(/)", "// @Method(POST)", rateLimit},
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			return diag.Diagnostics
+		}
+
+		It("Does not return rate limit diagnostics for a valid rate limit", func() {
+			diags := getDiagnosticsForRateLimit(`// @RateLimit({ requests: 100, per: "1m", key: "header:X-Api-Key" })`)
+			Expect(diags).To(HaveLen(1))
+			Expect(diags[0]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Returns a DiagAnnotationPropertiesMissingKey error when the window is missing", func() {
+			diags := getDiagnosticsForRateLimit(`// @RateLimit({ requests: 100 })`)
+			Expect(diags).To(ContainElement(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagAnnotationPropertiesMissingKey,
+				"Missing required property 'per'",
+			)))
+		})
+
+		It("Returns a DiagAnnotationPropertiesInvalidValueForKey error for a non-integer request count", func() {
+			diags := getDiagnosticsForRateLimit(`// @RateLimit({ requests: 1.5, per: "1m" })`)
+			Expect(diags).To(ContainElement(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagAnnotationPropertiesInvalidValueForKey,
+				"Invalid rate limit - property 'requests' must be a positive integer",
+			)))
+		})
+
+		It("Returns a DiagAnnotationPropertiesInvalidValueForKey error for an invalid window", func() {
+			diags := getDiagnosticsForRateLimit(`// @RateLimit({ requests: 10, per: "a minute" })`)
+			Expect(diags).To(ContainElement(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagAnnotationPropertiesInvalidValueForKey,
+				"Invalid rate limit - property 'per' must be a positive duration, e.g. '1m' or '30s', but got 'a minute'",
+			)))
+		})
+
+		It("Returns a DiagAnnotationPropertiesInvalidValueForKey error for an unknown key", func() {
+			diags := getDiagnosticsForRateLimit(`// @RateLimit({ requests: 10, per: "1m", key: "header:" })`)
+			Expect(diags).To(ContainElement(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagAnnotationPropertiesInvalidValueForKey,
+				"Invalid rate limit - property 'key' must be one of 'ip', 'principal' or 'header:<Name>' but got 'header:'",
+			)))
+		})
+	})
+
+	Context("Request limits", func() {
+		getDiagnosticsForLimits := func(limits ...string) []diagnostics.ResolvedDiagnostic {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				append([]string{"// @Route(/)", "// @Method(POST)"}, limits...),
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			return diag.Diagnostics
+		}
+
+		It("Does not return request limit diagnostics for a valid timeout and maximum body size", func() {
+			diags := getDiagnosticsForLimits("// @Timeout(5s)", "// @MaxBodySize(1MB)")
+			Expect(diags).To(HaveLen(1))
+			Expect(diags[0]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Returns a DiagAnnotationValueInvalid error for a non-positive timeout", func() {
+			diags := getDiagnosticsForLimits("// @Timeout(0s)")
+			Expect(diags).To(ContainElement(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagAnnotationValueInvalid,
+				"Invalid timeout - a timeout must be a positive duration, e.g. '5s' or '500ms', but got '0s'",
+			)))
+		})
+
+		It("Returns a DiagAnnotationValueInvalid error for a maximum body size with an unknown unit", func() {
+			diags := getDiagnosticsForLimits("// @MaxBodySize(1TB)")
+			Expect(diags).To(ContainElement(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagAnnotationValueInvalid,
+				"Invalid maximum body size - a size must be a positive number of bytes, optionally followed by a 'KB', 'MB' or 'GB' unit, e.g. '1MB', but got '1TB'",
+			)))
+		})
+	})
+
+	Context("Idempotency", func() {
+		getDiagnosticsForIdempotency := func(method string, idempotent string) []diagnostics.ResolvedDiagnostic {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{"// @Route(/)", method, idempotent},
+				annotations.CommentSourceRoute,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			return diag.Diagnostics
+		}
+
+		It("Does not return idempotency diagnostics for a valid @Idempotent annotation on an unsafe method", func() {
+			diags := getDiagnosticsForIdempotency("// @Method(POST)", `// @Idempotent({ ttl: "1h" })`)
+			Expect(diags).To(HaveLen(1))
+			Expect(diags[0]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Returns a DiagAnnotationPropertiesInvalidValueForKey error for an invalid TTL", func() {
+			diags := getDiagnosticsForIdempotency("// @Method(POST)", `// @Idempotent({ ttl: "forever" })`)
+			Expect(diags).To(ContainElement(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagAnnotationPropertiesInvalidValueForKey,
+				"Invalid idempotency - property 'ttl' must be a positive duration, e.g. '24h' or '30m', but got 'forever'",
+			)))
+		})
+
+		It("Returns a DiagReceiverIdempotentSafeMethod warning for an @Idempotent annotation on a safe method", func() {
+			diags := getDiagnosticsForIdempotency("// @Method(GET)", "// @Idempotent")
+			Expect(diags).To(ContainElement(BeDiagnosticWarningWithCodeAndMessage(
+				diagnostics.DiagReceiverIdempotentSafeMethod,
+				"Route with operation ID 'TestReceiver' uses the safe HTTP method GET and does not require an 'Idempotency-Key' header",
+			)))
+		})
+	})
+
+	Context("Security scopes", func() {
+		It("Does not return a diagnostic when all scopes are declared by the schema's flows", func() {
 			receiver.Annotations = utils.GetAnnotationHolderOrFail(
 				[]string{
 					"// @Route(/)",
-					"// @Method(POST, { invalid: \"properties\" })",
+					"// @Method(POST)",
+					"// @Security(defaultSecSchema, { scopes: [\"read\", \"write\"] })",
 				},
 				annotations.CommentSourceRoute,
 			)
@@ -135,22 +843,16 @@ var _ = Describe("Unit Tests - Route Validator", func() {
 			Expect(err).To(BeNil())
 			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
 
-			Expect(diag.Diagnostics).To(HaveLen(2))
-
-			Expect(diag.Diagnostics[0]).To(BeDiagnosticWarningWithCodeAndMessage(
-				diagnostics.DiagAnnotationPropertiesShouldNotExist,
-				"Annotation '@Method' does not support properties",
-			))
-
-			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+			Expect(diag.Diagnostics).To(HaveLen(1))
+			Expect(diag.Diagnostics[0]).To(BeAReturnSigDiagnostic())
 		})
 
-		It("Returns a DiagAnnotationPropertiesUnknownKey warning when an annotation has an unknown property key", func() {
+		It("Returns a DiagReceiverSecurityScopeUnknown error with a suggestion for an undeclared scope", func() {
 			receiver.Annotations = utils.GetAnnotationHolderOrFail(
 				[]string{
 					"// @Route(/)",
 					"// @Method(POST)",
-					"// @Query(value, { invalid: \"properties\" })",
+					"// @Security(defaultSecSchema, { scopes: [\"read\", \"wirte\"] })",
 				},
 				annotations.CommentSourceRoute,
 			)
@@ -161,27 +863,44 @@ var _ = Describe("Unit Tests - Route Validator", func() {
 			Expect(err).To(BeNil())
 			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
 
-			Expect(diag.Diagnostics).To(HaveLen(3))
-
-			Expect(diag.Diagnostics[0]).To(BeDiagnosticWarningWithCodeAndMessage(
-				diagnostics.DiagAnnotationPropertyShouldNotExist,
-				"Property 'invalid' is not allowed for annotation '@Query'",
+			Expect(diag.Diagnostics).To(HaveLen(2))
+			Expect(diag.Diagnostics[0]).To(BeAReturnSigDiagnostic())
+			Expect(diag.Diagnostics[1]).To(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagReceiverSecurityScopeUnknown,
+				"Scope 'wirte' is not declared by any flow of security schema 'defaultSecSchema'. Did you mean 'write'?",
 			))
+		})
 
-			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+		It("Returns a DiagReceiverSecuritySchemaUnknown error with a suggestion for an undeclared schema", func() {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Route(/)",
+					"// @Method(POST)",
+					"// @Security(secSchema3)",
+				},
+				annotations.CommentSourceRoute,
+			)
 
-			Expect(diag.Diagnostics[2]).To(BeDiagnosticErrorWithCodeAndMessage(
-				diagnostics.DiagLinkerPathInvalidRef,
-				"@Query 'value' does not match any parameter of TestReceiver",
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(2))
+			Expect(diag.Diagnostics[0]).To(BeAReturnSigDiagnostic())
+			Expect(diag.Diagnostics[1]).To(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagReceiverSecuritySchemaUnknown,
+				"Security schema 'secSchema3' is not defined in the Gleece configuration. Did you mean 'secSchema1'?",
 			))
 		})
 
-		It("Returns a DiagAnnotationPropertiesInvalidValueForKey error when property type is invalid", func() {
+		It("Returns a DiagReceiverSecurityScopesUnsupported warning when scopes are given to a schema that does not support them", func() {
 			receiver.Annotations = utils.GetAnnotationHolderOrFail(
 				[]string{
 					"// @Route(/)",
 					"// @Method(POST)",
-					"// @Query(value, { name: 123 })",
+					"// @Security(secSchema1, { scopes: [\"admin\"] })",
 				},
 				annotations.CommentSourceRoute,
 			)
@@ -192,43 +911,165 @@ var _ = Describe("Unit Tests - Route Validator", func() {
 			Expect(err).To(BeNil())
 			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
 
-			Expect(diag.Diagnostics).To(HaveLen(3))
+			Expect(diag.Diagnostics).To(HaveLen(2))
+			Expect(diag.Diagnostics[0]).To(BeAReturnSigDiagnostic())
+			Expect(diag.Diagnostics[1]).To(BeDiagnosticWarningWithCodeAndMessage(
+				diagnostics.DiagReceiverSecurityScopesUnsupported,
+				"Security schema 'secSchema1' is of type 'apiKey' which does not support scopes but specifies scopes 'admin'",
+			))
+		})
 
-			Expect(diag.Diagnostics[0]).To(BeDiagnosticWarningWithCodeAndMessage(
-				diagnostics.DiagAnnotationPropertiesInvalidValueForKey,
-				"Property 'name' expected to be a string",
+		It("Validates scopes inherited from the controller", func() {
+			controller.Struct.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{"// @Security(defaultSecSchema, { scopes: [\"raed\"] })"},
+				annotations.CommentSourceController,
+			)
+
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(2))
+			Expect(diag.Diagnostics[0]).To(BeAReturnSigDiagnostic())
+			Expect(diag.Diagnostics[1]).To(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagReceiverSecurityScopeUnknown,
+				"Scope 'raed' is not declared by any flow of security schema 'defaultSecSchema'. Did you mean 'read'?",
 			))
+		})
 
-			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+		It("Does not validate the default security per route", func() {
+			gleeceConfig.OpenAPIGeneratorConfig.DefaultRouteSecurity = &definitions.SecurityAnnotationComponent{
+				SchemaName: "defaultSchema",
+				Scopes:     []string{},
+			}
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
 
-			Expect(diag.Diagnostics[2]).To(BeDiagnosticErrorWithCodeAndMessage(
-				diagnostics.DiagLinkerPathInvalidRef,
-				"@Query 'value' does not match any parameter of TestReceiver",
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
+
+			Expect(diag.Diagnostics).To(HaveLen(1))
+			Expect(diag.Diagnostics[0]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Returns a single DiagReceiverSecuritySchemaUnknown configuration warning for an undeclared default schema", func() {
+			gleeceConfig.OpenAPIGeneratorConfig.DefaultRouteSecurity = &definitions.SecurityAnnotationComponent{
+				SchemaName: "defaultSchema",
+				Scopes:     []string{},
+			}
+			apiValidator := validators.NewApiValidator(gleeceConfig, pkgFacade, []metadata.ControllerMeta{})
+
+			diags, err := apiValidator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diags).To(HaveLen(1))
+			Expect(diags[0].EntityKind).To(Equal("Configuration"))
+			Expect(diags[0].Children).To(BeEmpty())
+			Expect(diags[0].Diagnostics).To(HaveLen(1))
+			Expect(diags[0].Diagnostics[0]).To(BeDiagnosticWarningWithCodeAndMessage(
+				diagnostics.DiagReceiverSecuritySchemaUnknown,
+				"Default security schema 'defaultSchema' is not defined in the Gleece configuration. Did you mean 'defaultSecSchema'?",
 			))
 		})
 
-		It("Returns a DiagFeatureUnsupported when a @Method annotation has a valid but unsupported HTTP verb", func() {
+		It("Does not validate the security of public routes", func() {
+			receiver.Annotations = utils.GetAnnotationHolderOrFail(
+				[]string{
+					"// @Route(/)",
+					"// @Method(POST)",
+					"// @Public",
+				},
+				annotations.CommentSourceRoute,
+			)
+			gleeceConfig.OpenAPIGeneratorConfig.DefaultRouteSecurity = &definitions.SecurityAnnotationComponent{
+				SchemaName: "defaultSchema",
+				Scopes:     []string{},
+			}
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+
+			for _, diagnostic := range diag.Diagnostics {
+				Expect(diagnostic.Code).ToNot(Equal(string(diagnostics.DiagReceiverSecuritySchemaUnknown)))
+			}
+		})
+	})
+
+	Context("Principal parameters", func() {
+		var principalParam metadata.FuncParam
+
+		BeforeEach(func() {
 			receiver.Annotations = utils.GetAnnotationHolderOrFail(
 				[]string{
 					"// @Route(/)",
-					"// @Method(OPTIONS) Valid but unsupported",
+					"// @Method(POST)",
+					"// @Principal(user)",
+					"// @Security(secSchema1)",
 				},
 				annotations.CommentSourceRoute,
 			)
 
+			principalRoot := typeref.NewNamedTypeRef(&graphs.SymbolKey{Name: "User"}, nil)
+			principalParam = metadata.FuncParam{
+				SymNodeMeta: metadata.SymNodeMeta{
+					Name:        "user",
+					Annotations: receiver.Annotations,
+				},
+				Type: metadata.TypeUsageMeta{
+					SymNodeMeta: metadata.SymNodeMeta{
+						Name:    "User",
+						PkgPath: "example.com/auth",
+					},
+					Root: &typeref.PtrTypeRef{Elem: &principalRoot},
+				},
+			}
+			receiver.Params = []metadata.FuncParam{principalParam}
+		})
+
+		It("Does not return a diagnostic when the parameter's type matches the configured principal type", func() {
+			gleeceConfig.RoutesConfig.AuthorizationConfig.PrincipalType = "example.com/auth.User"
 			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
 
 			diag, err := validator.Validate()
 			Expect(err).To(BeNil())
 			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
 
-			Expect(diag.Diagnostics).To(HaveLen(2))
+			Expect(diag.Diagnostics).To(HaveLen(1))
+			Expect(diag.Diagnostics[0]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Returns a DiagReceiverPrincipalTypeMismatch error when the parameter's type differs from the configured one", func() {
+			gleeceConfig.RoutesConfig.AuthorizationConfig.PrincipalType = "example.com/auth.Account"
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
 
+			Expect(diag.Diagnostics).To(HaveLen(2))
 			Expect(diag.Diagnostics[0]).To(BeDiagnosticErrorWithCodeAndMessage(
-				diagnostics.DiagFeatureUnsupported,
-				"HTTP verb 'OPTIONS' is currently unsupported for @Method annotations. Supported verbs are: DELETE, GET, PATCH, POST, PUT",
+				diagnostics.DiagReceiverPrincipalTypeMismatch,
+				"principal parameter 'user' is of type 'example.com/auth.User' but the authorization configuration "+
+					"declares principal type 'example.com/auth.Account'",
 			))
+			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
+		})
+
+		It("Returns a DiagReceiverPrincipalTypeMismatch error when no principal type is configured", func() {
+			validator = validators.NewReceiverValidator(gleeceConfig, pkgFacade, &controller, &receiver)
+
+			diag, err := validator.Validate()
+			Expect(err).To(BeNil())
+			Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
 
+			Expect(diag.Diagnostics).To(HaveLen(2))
+			Expect(diag.Diagnostics[0]).To(BeDiagnosticErrorWithCodeAndMessage(
+				diagnostics.DiagReceiverPrincipalTypeMismatch,
+				"principal parameter 'user' (type 'example.com/auth.User') requires a 'principalType' "+
+					"to be declared in the authorization configuration",
+			))
 			Expect(diag.Diagnostics[1]).To(BeAReturnSigDiagnostic())
 		})
 	})
@@ -428,15 +1269,21 @@ var _ = Describe("Unit Tests - Route Validator", func() {
 						Expect(err).To(BeNil())
 						Expect(diag).To(BeChildlessDiagOfReceiver(&receiver))
 
-						Expect(diag.Diagnostics).To(HaveLen(2))
+						Expect(diag.Diagnostics).To(HaveLen(3))
 
 						Expect(diag.Diagnostics[0]).To(BeAReturnSigDiagnostic())
 
+						// The value is shared but the security schema itself is still validated against the configuration
 						Expect(diag.Diagnostics[1]).To(BeDiagnosticErrorWithCodeAndMessage(
+							diagnostics.DiagReceiverSecuritySchemaUnknown,
+							"Security schema 'the_value' is not defined in the Gleece configuration. Did you mean 'secSchema1'?",
+						))
+
+						Expect(diag.Diagnostics[2]).To(BeDiagnosticErrorWithCodeAndMessage(
 							diagnostics.DiagLinkerPathInvalidRef,
 							"@Query 'the_value' does not match any parameter of TestReceiver",
 						))
-						Expect(diag.Diagnostics[1].Range).To(Equal(common.ResolvedRange{
+						Expect(diag.Diagnostics[2].Range).To(Equal(common.ResolvedRange{
 							StartLine: 47,
 							EndLine:   47,
 							StartCol:  10,
@@ -449,8 +1296,8 @@ var _ = Describe("Unit Tests - Route Validator", func() {
 							[]string{
 								"// @Route(/)",
 								"// @Method(POST)",
-								"// @Security(the_value)",
-								"// @Security(the_value)",
+								"// @Security(secSchema1)",
+								"// @Security(secSchema1)",
 							},
 							annotations.CommentSourceRoute,
 						)